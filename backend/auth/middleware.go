@@ -185,6 +185,12 @@ func GetIdentityID(ctx context.Context) (uuid.UUID, bool) {
 	return id, ok
 }
 
+// GetOAuth2EntityID extracts the entity the OAuth2 client acts for from the request context
+func GetOAuth2EntityID(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(OAuth2EntityIDKey).(uuid.UUID)
+	return id, ok
+}
+
 // GetIdentityEmail extracts the email from the request context
 func GetIdentityEmail(ctx context.Context) (string, bool) {
 	email, ok := ctx.Value(IdentityEmailKey).(string)
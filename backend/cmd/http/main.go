@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"log"
 	nethttp "net/http"
 	"os/signal"
@@ -9,25 +10,37 @@ import (
 	"time"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchors"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/certification"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/custody"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/documents"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_images"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_types"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/photos"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/share_links"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/signing_keys"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/stolen_reports"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/transfer"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/user"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/user_invitation"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/verification"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
 	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/http"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/hydra"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/kratos"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger/simulated"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/mailer"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
-	natsqueue "github.com/ClassicCarsRestore/ClassicsChain/pkg/queue/nats"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/seed"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/signing"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/storage"
 	"github.com/ClassicCarsRestore/ClassicsChain/repository"
 	"github.com/casbin/casbin/v2"
@@ -58,8 +71,15 @@ type Config struct {
 		SecretKey      string `envconfig:"STORAGE_SECRET_KEY" default:"garagepassword"`
 		UseSSL         bool   `envconfig:"STORAGE_USE_SSL" default:"false"`
 	}
-	NATS struct {
-		URL string `envconfig:"NATS_URL" default:"nats://localhost:4222"`
+	Algorand struct {
+		IndexerURL string `envconfig:"ALGORAND_INDEXER_URL"`
+		// PlatformAddress is the account the worker anchors from; the API never holds its key
+		PlatformAddress string `envconfig:"ALGORAND_PLATFORM_ADDRESS"`
+		Network         string `envconfig:"ALGORAND_NETWORK" default:"testnet"`
+	}
+	Ledger struct {
+		Backend       string `envconfig:"LEDGER_BACKEND" default:"algorand"`
+		SimulatedPath string `envconfig:"LEDGER_SIMULATED_PATH"`
 	}
 	HTTP struct {
 		Port           int `envconfig:"HTTP_PORT" default:"8080"`
@@ -75,6 +95,11 @@ type Config struct {
 			AllowCredentials bool     `envconfig:"CORS_ALLOW_CREDENTIALS" default:"true"`
 			MaxAge           int      `envconfig:"CORS_MAX_AGE" default:"3600"`
 		}
+		StolenLookup struct {
+			Requests          int           `envconfig:"STOLEN_LOOKUP_RATE_LIMIT" default:"30"`
+			Window            time.Duration `envconfig:"STOLEN_LOOKUP_RATE_WINDOW" default:"1m"`
+			TrustForwardedFor bool          `envconfig:"STOLEN_LOOKUP_TRUST_FORWARDED_FOR" default:"false"`
+		}
 	}
 	Seed struct {
 		Enabled       bool   `envconfig:"SEED_ADMIN" default:"false"`
@@ -88,6 +113,10 @@ type Config struct {
 		BaseURL      string `envconfig:"MAILER_BASE_URL" default:"http://localhost:5173"`
 		WebBaseURL   string `envconfig:"MAILER_WEB_BASE_URL" default:"http://localhost:5174"`
 	}
+	Signing struct {
+		// KeySecret seals the private keys of managed signing keys; managed keys are disabled when empty
+		KeySecret string `envconfig:"SIGNING_KEY_SECRET"`
+	}
 }
 
 func main() {
@@ -147,6 +176,17 @@ func main() {
 	invitationRepo := repository.NewInvitationRepository(querier)
 	eventImageRepo := repository.NewEventImageRepository(querier)
 	userInvitationRepo := repository.NewUserInvitationRepository(querier)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	transferRepo := repository.NewOwnershipTransferRepository(querier)
+	certificationRepo := repository.NewCertificationRequestRepository(querier)
+	certificationValidityRepo := repository.NewCertificationRepository(querier)
+	eventTypeRepo := repository.NewEventTypeRepository(querier)
+	anchorRepo := repository.NewAnchorRepository(querier)
+	signingKeyRepo := repository.NewSigningKeyRepository(querier)
+	custodyRepo := repository.NewCustodyRepository(querier)
+	lifecycleRepo := repository.NewLifecycleRequestRepository(querier)
+	stolenReportRepo := repository.NewStolenVehicleReportRepository(querier)
+	transactor := postgres.NewTransactor(pool)
 
 	// Storage
 	photoStorage, err := storage.New(storage.Config{
@@ -162,14 +202,22 @@ func main() {
 	}
 	log.Println("Storage backend initialized: Garage")
 
-	// NATS (message queue)
-	natsPublisher, err := natsqueue.NewPublisher(ctx, natsqueue.Config{URL: cfg.NATS.URL})
+	// Ledger (read-only indexer lookups for public verification and wallet holdings)
+	ledgerReader, err := ledger.NewReader(ledger.ReaderConfig{
+		Backend: cfg.Ledger.Backend,
+		Algorand: algorand.ReaderConfig{
+			IndexerURL: cfg.Algorand.IndexerURL,
+			Address:    cfg.Algorand.PlatformAddress,
+		},
+		Simulated: simulated.Config{
+			Path:    cfg.Ledger.SimulatedPath,
+			Address: cfg.Algorand.PlatformAddress,
+		},
+	})
 	if err != nil {
-		log.Fatalf("Failed to initialize NATS publisher: %v", err)
+		log.Fatalf("Failed to initialize ledger: %v", err)
 	}
-	defer natsPublisher.Close()
-
-	log.Println("NATS JetStream connected")
+	log.Printf("Ledger initialized (backend: %s, network: %s, address: %s)", cfg.Ledger.Backend, cfg.Algorand.Network, ledgerReader.Address())
 
 	// Mailer
 	mailerClient := mailer.New(mailer.Config{
@@ -182,22 +230,53 @@ func main() {
 
 	// Services
 	cidGenerator := cidpkg.NewCIDGenerator()
-	vehicleService := vehicles.NewService(vehicleRepo, natsPublisher)
+	vehicleService := vehicles.NewService(vehicleRepo, outboxRepo, transactor, cidGenerator)
 	photoService := photos.NewService(photoRepo, photoStorage)
 	documentService := documents.NewService(documentRepo, photoStorage)
 	shareLinksService := share_links.NewService(shareLinkRepo)
 	invitationService := invitation.NewService(invitationRepo, vehicleService, mailerClient)
 	eventImageService := event_images.NewService(eventImageRepo, photoStorage, cidGenerator)
-	eventService := event.NewService(eventRepo, natsPublisher, cidGenerator)
+	eventService := event.NewService(eventRepo, outboxRepo, transactor, cidGenerator)
 	eventService.SetEventImageService(eventImageService)
+	transferService := transfer.NewService(transferRepo, vehicleService, eventService, transactor, mailerClient)
+	verificationService := verification.NewService(vehicleRepo, eventRepo, ledgerReader, ledgerReader.Address())
+	custodyService := custody.NewService(custodyRepo, ledgerReader, outboxRepo, transactor)
+	transferService.SetCustodyNotifier(custodyService)
 
 	// User services
 	userInvitationService := user_invitation.NewService(userInvitationRepo, mailerClient)
 	userService := user.New(userRepo, kratosClient)
 	userService.SetUserInvitationService(userInvitationService)
+	eventService.SetProposalNotifier(userService, mailerClient)
 
 	// Entity service
 	entityService := entity.New(entityRepo, userRepo, kratosClient, userService, hydraClient, userInvitationService, photoStorage)
+	certificationService := certification.NewService(certificationRepo, vehicleService, eventService, entityService, eventImageService, transactor)
+	lifecycleService := lifecycle.NewService(lifecycleRepo, vehicleService, eventService, entityService, outboxRepo, transactor)
+	stolenReportService := stolen_reports.NewService(stolenReportRepo, vehicleService, lifecycleService, entityService, mailerClient, transactor)
+	eventTypeService := event_types.NewService(eventTypeRepo, entityService, cidGenerator)
+	eventService.SetCustomTypeRegistry(eventTypeService)
+	certificationTracker := certification.NewTracker(certificationValidityRepo)
+	eventService.SetCertificationTracker(certificationTracker)
+	anchorService := anchors.NewService(anchorRepo)
+
+	// Event signing
+	var keySealer *signing.Sealer
+	if cfg.Signing.KeySecret != "" {
+		secret, err := base64.StdEncoding.DecodeString(cfg.Signing.KeySecret)
+		if err != nil {
+			log.Fatalf("Failed to decode SIGNING_KEY_SECRET: %v", err)
+		}
+		keySealer, err = signing.NewSealer(secret)
+		if err != nil {
+			log.Fatalf("Failed to initialize signing key sealer: %v", err)
+		}
+	} else {
+		log.Println("SIGNING_KEY_SECRET not set, managed signing keys are disabled")
+	}
+	signingKeyService := signing_keys.NewService(signingKeyRepo, entityService, keySealer)
+	eventService.SetSigner(signingKeyService)
+	verificationService.SetKeyResolver(signingKeyService)
 
 	// Authorization
 	enforcer, err := casbin.NewEnforcer("casbin_model.conf", "casbin_policy.csv")
@@ -224,9 +303,14 @@ func main() {
 			AllowCredentials: cfg.HTTP.CORS.AllowCredentials,
 			MaxAge:           cfg.HTTP.CORS.MaxAge,
 		},
+		LookupRateLimit: http.RateLimitConfig{
+			Requests:          cfg.HTTP.StolenLookup.Requests,
+			Window:            cfg.HTTP.StolenLookup.Window,
+			TrustForwardedFor: cfg.HTTP.StolenLookup.TrustForwardedFor,
+		},
 	}
 
-	server := http.New(httpCfg, entityService, eventService, vehicleService, photoService, documentService, shareLinksService, userService, invitationService, userInvitationService, eventImageService, kratosClient, authMiddleware, authorizer)
+	server := http.New(httpCfg, entityService, eventService, vehicleService, photoService, documentService, shareLinksService, userService, invitationService, userInvitationService, eventImageService, verificationService, transferService, certificationService, certificationTracker, eventTypeService, anchorService, signingKeyService, custodyService, lifecycleService, stolenReportService, kratosClient, authMiddleware, authorizer)
 
 	go func() {
 		<-ctx.Done()
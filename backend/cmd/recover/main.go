@@ -0,0 +1,168 @@
+// Command recover rebuilds the anchor state of the database by scanning the chain.
+//
+// It lists every CC_ asset created by the platform account, decodes the asset names back to
+// vehicle IDs and matches the notes of their creation and self-transfer transactions to the
+// vehicles, versions and events they anchor. Without -repair it only prints the discrepancies;
+// with -repair the blockchain_asset_id, cid and blockchain_tx_id values the database lost are
+// restored from the chain. Conflicting values are never overwritten.
+//
+// It uses the same environment variables as the worker for the database and the ledger.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/recovery"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger/simulated"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/ClassicCarsRestore/ClassicsChain/repository"
+	"github.com/kelseyhightower/envconfig"
+)
+
+type Config struct {
+	Database struct {
+		Host     string `envconfig:"DB_HOST" default:"localhost"`
+		Port     int    `envconfig:"DB_PORT" default:"5433"`
+		User     string `envconfig:"DB_USER" default:"postgres"`
+		Password string `envconfig:"DB_PASSWORD" default:"postgres"`
+		Database string `envconfig:"DB_NAME" default:"classics_chain"`
+		SSLMode  string `envconfig:"DB_SSL_MODE" default:"disable"`
+	}
+	Algorand struct {
+		AlgodURL   string `envconfig:"ALGORAND_ALGOD_URL"`
+		AlgodToken string `envconfig:"ALGORAND_ALGOD_TOKEN"`
+		IndexerURL string `envconfig:"ALGORAND_INDEXER_URL"`
+		Mnemonic   string `envconfig:"ALGORAND_WALLET_MNEMONIC"`
+	}
+	Ledger struct {
+		Backend       string `envconfig:"LEDGER_BACKEND" default:"algorand"`
+		SimulatedPath string `envconfig:"LEDGER_SIMULATED_PATH"`
+	}
+}
+
+func main() {
+	repair := flag.Bool("repair", false, "Restore values the database lost from the chain")
+	jsonOutput := flag.Bool("json", false, "Print the report as JSON")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	var cfg Config
+	if err := envconfig.Process("", &cfg); err != nil {
+		log.Fatalf("Failed to process environment variables: %v", err)
+	}
+
+	pool, err := postgres.NewPool(ctx, postgres.Config{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		Database: cfg.Database.Database,
+		SSLMode:  cfg.Database.SSLMode,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	querier := db.New(pool)
+	vehicleRepo := repository.NewVehicleRepository(querier)
+	eventRepo := repository.NewEventRepository(querier)
+
+	ledgerClient, err := ledger.New(ledger.Config{
+		Backend: cfg.Ledger.Backend,
+		Algorand: algorand.Config{
+			AlgodURL:   cfg.Algorand.AlgodURL,
+			AlgodToken: cfg.Algorand.AlgodToken,
+			IndexerURL: cfg.Algorand.IndexerURL,
+			Mnemonic:   cfg.Algorand.Mnemonic,
+		},
+		Simulated: simulated.Config{
+			Path:     cfg.Ledger.SimulatedPath,
+			Mnemonic: cfg.Algorand.Mnemonic,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Failed to initialize ledger: %v", err)
+	}
+
+	service := recovery.NewService(ledgerClient, vehicleRepo, eventRepo)
+	report, err := service.Scan(ctx, *repair)
+	if err != nil {
+		log.Fatalf("Failed to scan the chain: %v", err)
+	}
+
+	if *jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+	} else {
+		printReport(report)
+	}
+
+	if !resolved(report) {
+		os.Exit(1)
+	}
+}
+
+func printReport(report *recovery.Report) {
+	fmt.Printf("scanned %d assets and %d transactions, matched %d vehicles\n",
+		report.AssetsScanned, report.TransactionsScanned, report.VehiclesMatched)
+
+	for _, d := range report.Discrepancies {
+		line := fmt.Sprintf("%-16s", d.Kind)
+		if d.RecordType != "" && d.RecordID != nil {
+			line += fmt.Sprintf(" %s %s", d.RecordType, d.RecordID)
+		} else if d.VehicleID != nil {
+			line += fmt.Sprintf(" vehicle %s", d.VehicleID)
+		}
+		if d.AssetID != nil {
+			line += fmt.Sprintf("  asset=%d", *d.AssetID)
+		}
+		if d.TxID != nil {
+			line += "  tx=" + *d.TxID
+		}
+		if d.Field != "" {
+			line += fmt.Sprintf("  %s: stored=%s chain=%s", d.Field, orDash(d.Stored), orDash(d.OnChain))
+		}
+		line += "  (" + d.Reason + ")"
+		if d.Repaired {
+			line += "  [repaired]"
+		}
+		fmt.Println(line)
+	}
+
+	if len(report.Discrepancies) == 0 {
+		fmt.Println("database matches the chain")
+	}
+}
+
+// resolved reports whether every discrepancy was repaired
+func resolved(report *recovery.Report) bool {
+	for _, d := range report.Discrepancies {
+		if !d.Repaired {
+			return false
+		}
+	}
+	return true
+}
+
+func orDash(s *string) string {
+	if s == nil {
+		return "-"
+	}
+	return *s
+}
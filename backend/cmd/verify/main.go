@@ -0,0 +1,122 @@
+// Command verify checks an exported passport bundle against a dump of Algorand
+// transactions without access to the Classics Chain database or API.
+//
+// The transaction dump is the JSON returned by any Algorand indexer for the
+// vehicle asset, e.g. GET /v2/assets/{assetId}/transactions. Events anchored under a
+// Merkle root also need the root transactions, which are payments from the platform
+// account, e.g. GET /v2/accounts/{address}/transactions?tx-type=pay, passed with -root-txns.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/verification"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+)
+
+func main() {
+	bundlePath := flag.String("bundle", "", "Path to the passport bundle JSON (required)")
+	txnsPath := flag.String("txns", "", "Path to the indexer transaction dump JSON (required)")
+	rootTxnsPath := flag.String("root-txns", "", "Path to an indexer dump of the Merkle root transactions")
+	platformAddress := flag.String("platform-address", "", "Expected sender address of anchoring transactions")
+	jsonOutput := flag.Bool("json", false, "Print the report as JSON")
+	flag.Parse()
+
+	if *bundlePath == "" || *txnsPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(*bundlePath)
+	if err != nil {
+		log.Fatalf("Failed to read bundle: %v", err)
+	}
+	var bundle verification.Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		log.Fatalf("Failed to parse bundle: %v", err)
+	}
+
+	data, err = os.ReadFile(*txnsPath)
+	if err != nil {
+		log.Fatalf("Failed to read transaction dump: %v", err)
+	}
+	txns, err := algorand.DecodeTransactions(data)
+	if err != nil {
+		log.Fatalf("Failed to parse transaction dump: %v", err)
+	}
+
+	if *rootTxnsPath != "" {
+		data, err = os.ReadFile(*rootTxnsPath)
+		if err != nil {
+			log.Fatalf("Failed to read root transaction dump: %v", err)
+		}
+		rootTxns, err := algorand.DecodeTransactions(data)
+		if err != nil {
+			log.Fatalf("Failed to parse root transaction dump: %v", err)
+		}
+		txns = append(txns, rootTxns...)
+	}
+
+	// Image files are resolved relative to the bundle
+	files := os.DirFS(filepath.Dir(*bundlePath))
+	report := verification.VerifyBundle(&bundle, files, txns, *platformAddress)
+
+	if *jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+	} else {
+		printResult(report.Vehicle)
+		for _, r := range report.Versions {
+			printResult(r)
+		}
+		for _, r := range report.Events {
+			printResult(r)
+		}
+	}
+
+	if !passed(report) {
+		os.Exit(1)
+	}
+}
+
+func printResult(r verification.Result) {
+	line := fmt.Sprintf("%-7s %s  %s", r.RecordType, r.RecordID, r.Verdict)
+	if r.TxID != nil {
+		line += "  tx=" + *r.TxID
+	}
+	if r.Reason != nil {
+		line += "  (" + *r.Reason + ")"
+	}
+	if sig := r.Signature; sig != nil {
+		if sig.Valid {
+			line += fmt.Sprintf("  signed by key %s", sig.KeyID)
+		} else {
+			line += fmt.Sprintf("  signature by key %s INVALID (%s)", sig.KeyID, *sig.Reason)
+		}
+	}
+	fmt.Println(line)
+}
+
+// passed reports whether no record was tampered with or missing on-chain and every signature
+// checks out. Records that were never anchored do not fail the bundle.
+func passed(report *verification.VehicleReport) bool {
+	results := append([]verification.Result{report.Vehicle}, report.Versions...)
+	results = append(results, report.Events...)
+	for _, r := range results {
+		if r.Verdict == verification.VerdictMismatch || r.Verdict == verification.VerdictMissingOnChain {
+			return false
+		}
+		if r.Signature != nil && !r.Signature.Valid {
+			return false
+		}
+	}
+	return true
+}
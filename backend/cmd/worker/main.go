@@ -5,10 +5,23 @@ import (
 	"log"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchorjob"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/certification"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/custody"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/outbox"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/user"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/kratos"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger/simulated"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/mailer"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
 	natsqueue "github.com/ClassicCarsRestore/ClassicsChain/pkg/queue/nats"
@@ -25,6 +38,10 @@ type Config struct {
 		Database string `envconfig:"DB_NAME" default:"classics_chain"`
 		SSLMode  string `envconfig:"DB_SSL_MODE" default:"disable"`
 	}
+	Kratos struct {
+		PublicURL string `envconfig:"KRATOS_PUBLIC_URL" default:"http://localhost:4433"`
+		AdminURL  string `envconfig:"KRATOS_ADMIN_URL" default:"http://localhost:4434"`
+	}
 	Algorand struct {
 		AlgodURL   string `envconfig:"ALGORAND_ALGOD_URL"`
 		AlgodToken string `envconfig:"ALGORAND_ALGOD_TOKEN"`
@@ -32,9 +49,41 @@ type Config struct {
 		Mnemonic   string `envconfig:"ALGORAND_WALLET_MNEMONIC"`
 		Network    string `envconfig:"ALGORAND_NETWORK" default:"testnet"`
 	}
+	Ledger struct {
+		Backend       string `envconfig:"LEDGER_BACKEND" default:"algorand"`
+		SimulatedPath string `envconfig:"LEDGER_SIMULATED_PATH"`
+	}
 	NATS struct {
 		URL string `envconfig:"NATS_URL" default:"nats://localhost:4222"`
 	}
+	Anchor struct {
+		Mode          string        `envconfig:"ANCHOR_MODE" default:"direct"`
+		BatchWindow   time.Duration `envconfig:"ANCHOR_BATCH_WINDOW" default:"2s"`
+		MaxConcurrent int           `envconfig:"ANCHOR_MAX_CONCURRENT" default:"64"`
+	}
+	AssetMetadata struct {
+		BaseURL string `envconfig:"ASSET_METADATA_BASE_URL" default:"https://api.classicschain.com/v1/public/asset-metadata"`
+	}
+	Outbox struct {
+		PollInterval time.Duration `envconfig:"OUTBOX_POLL_INTERVAL" default:"1s"`
+		BatchSize    int           `envconfig:"OUTBOX_BATCH_SIZE" default:"100"`
+	}
+	Reconciler struct {
+		Interval         time.Duration `envconfig:"RECONCILER_INTERVAL" default:"5m"`
+		PendingThreshold time.Duration `envconfig:"RECONCILER_PENDING_THRESHOLD" default:"15m"`
+		FailedRetryAfter time.Duration `envconfig:"RECONCILER_FAILED_RETRY_AFTER" default:"0s"`
+	}
+	Mailer struct {
+		ResendAPIKey string `envconfig:"RESEND_API_KEY" required:"true"`
+		FromEmail    string `envconfig:"MAILER_FROM_EMAIL" default:"noreply@classicschain.com"`
+		FromName     string `envconfig:"MAILER_FROM_NAME" default:"Classics Chain"`
+		BaseURL      string `envconfig:"MAILER_BASE_URL" default:"http://localhost:5173"`
+		WebBaseURL   string `envconfig:"MAILER_WEB_BASE_URL" default:"http://localhost:5174"`
+	}
+	Certifications struct {
+		ExpiryInterval   time.Duration `envconfig:"CERTIFICATION_EXPIRY_INTERVAL" default:"1h"`
+		ReminderLeadDays []int         `envconfig:"CERTIFICATION_REMINDER_LEAD_DAYS" default:"30,7,1"`
+	}
 }
 
 func main() {
@@ -45,6 +94,10 @@ func main() {
 	if err := envconfig.Process("", &cfg); err != nil {
 		log.Fatalf("Failed to process environment variables: %v", err)
 	}
+	anchorMode := anchorer.Mode(cfg.Anchor.Mode)
+	if anchorMode != anchorer.ModeDirect && anchorMode != anchorer.ModeMerkle {
+		log.Fatalf("Invalid ANCHOR_MODE %q: expected %q or %q", cfg.Anchor.Mode, anchorer.ModeDirect, anchorer.ModeMerkle)
+	}
 
 	// Database
 	pool, err := postgres.NewPool(ctx, postgres.Config{
@@ -66,34 +119,116 @@ func main() {
 	// Repositories
 	vehicleRepo := repository.NewVehicleRepository(querier)
 	eventRepo := repository.NewEventRepository(querier)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	anchorRepo := repository.NewAnchorRepository(querier)
+	entityRepo := repository.NewEntityRepository(querier)
+	custodyRepo := repository.NewCustodyRepository(querier)
+	lifecycleRepo := repository.NewLifecycleRequestRepository(querier)
+	certificationValidityRepo := repository.NewCertificationRepository(querier)
+	userRepo := repository.NewUserRepository(querier)
+
+	// Mailer
+	mailerClient := mailer.New(mailer.Config{
+		APIKey:     cfg.Mailer.ResendAPIKey,
+		FromEmail:  cfg.Mailer.FromEmail,
+		FromName:   cfg.Mailer.FromName,
+		BaseURL:    cfg.Mailer.BaseURL,
+		WebBaseURL: cfg.Mailer.WebBaseURL,
+	})
 
-	// Algorand
-	algorandClient, err := algorand.New(algorand.Config{
-		AlgodURL:   cfg.Algorand.AlgodURL,
-		AlgodToken: cfg.Algorand.AlgodToken,
-		IndexerURL: cfg.Algorand.IndexerURL,
-		Mnemonic:   cfg.Algorand.Mnemonic,
+	// Services
+	transactor := postgres.NewTransactor(pool)
+	cidGenerator := cidpkg.NewCIDGenerator()
+	vehicleService := vehicles.NewService(vehicleRepo, outboxRepo, transactor, cidGenerator)
+	eventService := event.NewService(eventRepo, outboxRepo, transactor, cidGenerator)
+	lifecycleService := lifecycle.NewService(lifecycleRepo, vehicleService, eventService, entityRepo, outboxRepo, transactor)
+	userService := user.New(userRepo, kratos.New(cfg.Kratos.PublicURL, cfg.Kratos.AdminURL))
+
+	// Ledger
+	ledgerClient, err := ledger.New(ledger.Config{
+		Backend: cfg.Ledger.Backend,
+		Algorand: algorand.Config{
+			AlgodURL:   cfg.Algorand.AlgodURL,
+			AlgodToken: cfg.Algorand.AlgodToken,
+			IndexerURL: cfg.Algorand.IndexerURL,
+			Mnemonic:   cfg.Algorand.Mnemonic,
+		},
+		Simulated: simulated.Config{
+			Path:     cfg.Ledger.SimulatedPath,
+			Mnemonic: cfg.Algorand.Mnemonic,
+		},
 	})
 	if err != nil {
-		log.Fatalf("Failed to initialize Algorand client: %v", err)
+		log.Fatalf("Failed to initialize ledger: %v", err)
 	}
-	if algorandClient.IsOnline(ctx) {
-		log.Printf("Algorand client connected (network: %s, address: %s)", cfg.Algorand.Network, algorandClient.Address())
+	if ledgerClient.IsOnline(ctx) {
+		log.Printf("Ledger connected (backend: %s, network: %s, address: %s)", cfg.Ledger.Backend, cfg.Algorand.Network, ledgerClient.Address())
 	} else {
-		log.Fatalf("Algorand client is offline")
+		log.Fatalf("Ledger is offline")
+	}
+
+	// NATS publisher (outbox relay)
+	natsPublisher, err := natsqueue.NewPublisher(ctx, natsqueue.Config{URL: cfg.NATS.URL})
+	if err != nil {
+		log.Fatalf("Failed to initialize NATS publisher: %v", err)
 	}
+	defer natsPublisher.Close()
 
 	// NATS subscriber
-	natsSubscriber, err := natsqueue.NewSubscriber(ctx, natsqueue.Config{URL: cfg.NATS.URL})
+	// Jobs are handled concurrently so their anchors can be batched into transaction groups
+	natsSubscriber, err := natsqueue.NewSubscriber(ctx, natsqueue.Config{
+		URL:           cfg.NATS.URL,
+		MaxConcurrent: cfg.Anchor.MaxConcurrent,
+	})
 	if err != nil {
 		log.Fatalf("Failed to initialize NATS subscriber: %v", err)
 	}
 	defer natsSubscriber.Close()
 	log.Println("NATS JetStream connected")
 
+	// Outbox relay
+	relay := outbox.NewRelay(outboxRepo, natsPublisher, transactor, cfg.Outbox.PollInterval, cfg.Outbox.BatchSize)
+	go func() {
+		if err := relay.Start(ctx); err != nil {
+			log.Printf("Outbox relay stopped: %v", err)
+		}
+	}()
+
+	// Anchor reconciler
+	reconciler := anchorjob.NewReconciler(vehicleService, eventService, anchorjob.ReconcilerConfig{
+		Interval:         cfg.Reconciler.Interval,
+		PendingThreshold: cfg.Reconciler.PendingThreshold,
+		FailedRetryAfter: cfg.Reconciler.FailedRetryAfter,
+	})
+	reconciler.SetLifecycleActions(lifecycleService)
+	go func() {
+		if err := reconciler.Start(ctx); err != nil {
+			log.Printf("Anchor reconciler stopped: %v", err)
+		}
+	}()
+
+	// Certification expiry
+	expiryJob := certification.NewExpiryJob(certificationValidityRepo, vehicleService, entityRepo, userService, mailerClient, certification.ExpiryJobConfig{
+		Interval:         cfg.Certifications.ExpiryInterval,
+		ReminderLeadDays: cfg.Certifications.ReminderLeadDays,
+	})
+	go func() {
+		if err := expiryJob.Start(ctx); err != nil {
+			log.Printf("Certification expiry job stopped: %v", err)
+		}
+	}()
+
 	// Worker
-	anchorerService := anchorer.New(algorandClient, vehicleRepo, eventRepo)
-	worker := anchorjob.NewWorker(natsSubscriber, anchorerService, vehicleRepo, eventRepo)
+	anchorerService := anchorer.New(ledgerClient, vehicleRepo, eventRepo)
+	network := cfg.Algorand.Network
+	if cfg.Ledger.Backend == ledger.BackendSimulated {
+		network = ledger.BackendSimulated
+	}
+	anchorerService.SetAnchorRepository(anchorRepo, network)
+	anchorerService.SetAssetMetadataBaseURL(cfg.AssetMetadata.BaseURL)
+	worker := anchorjob.NewWorker(natsSubscriber, anchorerService, vehicleRepo, eventRepo, cfg.Anchor.BatchWindow, anchorMode)
+	worker.SetCustodyMover(custody.NewMover(custodyRepo, vehicleRepo, ledgerClient))
+	worker.SetLifecycleEnforcer(lifecycle.NewEnforcer(lifecycleRepo, vehicleRepo, eventRepo, custodyRepo, ledgerClient))
 
 	if err := worker.Start(ctx); err != nil {
 		log.Fatalf("Anchor worker stopped: %v", err)
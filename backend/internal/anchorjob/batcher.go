@@ -0,0 +1,117 @@
+package anchorjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+)
+
+// DefaultBatchWindow is how long a batch waits for more anchors after its first one arrives
+const DefaultBatchWindow = 2 * time.Second
+
+type batchRequest struct {
+	anchor anchorer.Anchor
+	result chan error
+}
+
+// Batcher collects the anchors of concurrently handled jobs and submits them together, so they
+// are sent as one atomic transaction group, or under one Merkle root, instead of one transaction
+// per round
+type Batcher struct {
+	anchorer Anchorer
+	window   time.Duration
+	mode     anchorer.Mode
+	// maxSize is zero when only the window bounds a batch
+	maxSize  int
+	requests chan batchRequest
+}
+
+// NewBatcher creates a new batcher that flushes a batch once it is full or once window has passed
+// since its first anchor. In direct mode a batch fills one transaction group. In Merkle mode only
+// the window bounds it: a batch holds at most one anchor per job waiting on it, so its size is
+// capped by how many jobs the subscriber handles at once (ANCHOR_MAX_CONCURRENT).
+func NewBatcher(a Anchorer, window time.Duration, mode anchorer.Mode) *Batcher {
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	maxSize := algorand.MaxGroupSize
+	if mode == anchorer.ModeMerkle {
+		maxSize = 0
+	} else {
+		mode = anchorer.ModeDirect
+	}
+	return &Batcher{
+		anchorer: a,
+		window:   window,
+		mode:     mode,
+		maxSize:  maxSize,
+		requests: make(chan batchRequest),
+	}
+}
+
+// Anchor adds the record to the next batch and waits until that batch has been submitted
+func (b *Batcher) Anchor(ctx context.Context, anchor anchorer.Anchor) error {
+	req := batchRequest{anchor: anchor, result: make(chan error, 1)}
+
+	select {
+	case b.requests <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-req.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Start collects batches until the context is cancelled
+func (b *Batcher) Start(ctx context.Context) error {
+	for {
+		var batch []batchRequest
+		select {
+		case <-ctx.Done():
+			return nil
+		case req := <-b.requests:
+			batch = append(batch, req)
+		}
+
+		timer := time.NewTimer(b.window)
+	collect:
+		for b.maxSize == 0 || len(batch) < b.maxSize {
+			select {
+			case req := <-b.requests:
+				batch = append(batch, req)
+			case <-timer.C:
+				break collect
+			case <-ctx.Done():
+				break collect
+			}
+		}
+		timer.Stop()
+
+		// Confirmation takes a few rounds, keep collecting the next batch meanwhile
+		go b.flush(ctx, batch)
+	}
+}
+
+func (b *Batcher) flush(ctx context.Context, batch []batchRequest) {
+	anchors := make([]anchorer.Anchor, len(batch))
+	for i, req := range batch {
+		anchors[i] = req.anchor
+	}
+
+	var errs []error
+	if b.mode == anchorer.ModeMerkle {
+		errs = b.anchorer.AnchorMerkleBatch(ctx, anchors)
+	} else {
+		errs = b.anchorer.AnchorBatch(ctx, anchors)
+	}
+	for i, req := range batch {
+		req.result <- errs[i]
+	}
+}
@@ -0,0 +1,144 @@
+package anchorjob
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockAnchorer struct {
+	mu            sync.Mutex
+	batches       [][]anchorer.Anchor
+	merkleBatches [][]anchorer.Anchor
+	errs          map[uuid.UUID]error
+}
+
+func (m *mockAnchorer) VehicleGenesis(_ context.Context, _ vehicles.Vehicle) (*string, error) {
+	return nil, nil
+}
+
+func (m *mockAnchorer) AnchorBatch(_ context.Context, anchors []anchorer.Anchor) []error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches = append(m.batches, anchors)
+
+	errs := make([]error, len(anchors))
+	for i, a := range anchors {
+		errs[i] = m.errs[a.Event.ID]
+	}
+	return errs
+}
+
+func (m *mockAnchorer) AnchorMerkleBatch(_ context.Context, anchors []anchorer.Anchor) []error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.merkleBatches = append(m.merkleBatches, anchors)
+	return make([]error, len(anchors))
+}
+
+func (m *mockAnchorer) batchSizes() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sizes := make([]int, len(m.batches))
+	for i, b := range m.batches {
+		sizes[i] = len(b)
+	}
+	return sizes
+}
+
+func anchorAll(ctx context.Context, b *Batcher, events []event.Event) []error {
+	errs := make([]error, len(events))
+	var wg sync.WaitGroup
+	for i := range events {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = b.Anchor(ctx, anchorer.Anchor{Event: &events[i]})
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func newEvents(n int) []event.Event {
+	events := make([]event.Event, n)
+	for i := range events {
+		events[i] = event.Event{ID: uuid.New()}
+	}
+	return events
+}
+
+// --- Tests ---
+
+func TestBatcher_GroupsConcurrentAnchors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	a := &mockAnchorer{}
+	b := NewBatcher(a, 200*time.Millisecond, anchorer.ModeDirect)
+	go b.Start(ctx)
+
+	errs := anchorAll(ctx, b, newEvents(5))
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []int{5}, a.batchSizes())
+}
+
+func TestBatcher_FlushesFullGroupBeforeWindow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	a := &mockAnchorer{}
+	b := NewBatcher(a, time.Hour, anchorer.ModeDirect)
+	go b.Start(ctx)
+
+	errs := anchorAll(ctx, b, newEvents(algorand.MaxGroupSize))
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []int{algorand.MaxGroupSize}, a.batchSizes())
+}
+
+func TestBatcher_ReturnsPerRecordErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := newEvents(2)
+	a := &mockAnchorer{errs: map[uuid.UUID]error{events[1].ID: errors.New("update failed")}}
+	b := NewBatcher(a, 50*time.Millisecond, anchorer.ModeDirect)
+	go b.Start(ctx)
+
+	errs := anchorAll(ctx, b, events)
+
+	assert.NoError(t, errs[0])
+	assert.EqualError(t, errs[1], "update failed")
+}
+
+func TestBatcher_MerkleModeBatchesBeyondGroupSize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	a := &mockAnchorer{}
+	b := NewBatcher(a, 200*time.Millisecond, anchorer.ModeMerkle)
+	go b.Start(ctx)
+
+	errs := anchorAll(ctx, b, newEvents(algorand.MaxGroupSize+4))
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Empty(t, a.batches)
+	require.Len(t, a.merkleBatches, 1)
+	assert.Len(t, a.merkleBatches[0], algorand.MaxGroupSize+4)
+}
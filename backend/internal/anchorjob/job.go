@@ -4,13 +4,21 @@ import "github.com/google/uuid"
 
 const (
 	SubjectVehicleGenesis = "anchor.vehicle"
+	SubjectVehicleUpdate  = "anchor.vehicle_update"
 	SubjectEventAnchor    = "anchor.event"
+	SubjectCustodySync    = "anchor.custody"
+	SubjectLifecycle      = "anchor.lifecycle"
 )
 
 type VehicleGenesisJob struct {
 	VehicleID uuid.UUID `json:"vehicleId"`
 }
 
+type VehicleUpdateJob struct {
+	VehicleID uuid.UUID `json:"vehicleId"`
+	VersionID uuid.UUID `json:"versionId"`
+}
+
 type EventAnchorJob struct {
 	VehicleID     uuid.UUID `json:"vehicleId"`
 	EventID       uuid.UUID `json:"eventId"`
@@ -19,3 +27,13 @@ type EventAnchorJob struct {
 	CIDSourceCBOR string    `json:"cidSourceCbor"`
 	ImageCIDs     []string  `json:"imageCids,omitempty"`
 }
+
+// CustodySyncJob moves a vehicle's asset to the account that should hold it
+type CustodySyncJob struct {
+	VehicleID uuid.UUID `json:"vehicleId"`
+}
+
+// LifecycleJob freezes, unfreezes or destroys a vehicle's asset for an approved lifecycle request
+type LifecycleJob struct {
+	RequestID uuid.UUID `json:"requestId"`
+}
@@ -0,0 +1,176 @@
+package anchorjob
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultReconcileInterval = 5 * time.Minute
+	DefaultPendingThreshold  = 15 * time.Minute
+
+	reconcileBatchSize = 100
+)
+
+// VehicleAnchors lists and requeues vehicle genesis and vehicle update jobs
+type VehicleAnchors interface {
+	ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]vehicles.Vehicle, int, error)
+	RequeueAnchor(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+	ListVersionsByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]vehicles.Version, int, error)
+	RequeueVersionAnchor(ctx context.Context, id uuid.UUID) (*vehicles.Version, error)
+}
+
+// EventAnchors lists and requeues event anchor jobs
+type EventAnchors interface {
+	ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]event.Event, int, error)
+	RequeueAnchor(ctx context.Context, id uuid.UUID) (*event.Event, error)
+}
+
+// LifecycleActions requeues the asset actions of approved lifecycle requests
+type LifecycleActions interface {
+	RequeueStalledAssetActions(ctx context.Context, olderThan time.Duration, limit int) (int, error)
+}
+
+// ReconcilerConfig controls when records are considered stuck
+type ReconcilerConfig struct {
+	Interval time.Duration
+	// PendingThreshold is how long a record may stay pending before its job is published again
+	PendingThreshold time.Duration
+	// FailedRetryAfter is how long a failed record waits before it is retried automatically.
+	// Zero leaves failed records to be requeued by an admin.
+	FailedRetryAfter time.Duration
+}
+
+// Reconciler periodically republishes anchor jobs for records whose job was lost or gave up
+type Reconciler struct {
+	vehicles  VehicleAnchors
+	events    EventAnchors
+	lifecycle LifecycleActions
+	cfg       ReconcilerConfig
+}
+
+// NewReconciler creates a new reconciler
+func NewReconciler(vehicleAnchors VehicleAnchors, eventAnchors EventAnchors, cfg ReconcilerConfig) *Reconciler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultReconcileInterval
+	}
+	if cfg.PendingThreshold <= 0 {
+		cfg.PendingThreshold = DefaultPendingThreshold
+	}
+	return &Reconciler{vehicles: vehicleAnchors, events: eventAnchors, cfg: cfg}
+}
+
+// SetLifecycleActions sets the requeuer of lifecycle asset actions (optional). Actions left
+// pending by the worker are then republished with the other stuck records.
+func (r *Reconciler) SetLifecycleActions(actions LifecycleActions) {
+	r.lifecycle = actions
+}
+
+// Start runs the reconciler until the context is cancelled
+func (r *Reconciler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	log.Println("Anchor reconciler started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if n := r.Reconcile(ctx); n > 0 {
+			log.Printf("anchor reconciler: requeued %d anchor jobs", n)
+		}
+	}
+}
+
+// Reconcile requeues every stuck record and returns how many jobs were published
+func (r *Reconciler) Reconcile(ctx context.Context) int {
+	requeued := r.reconcile(ctx, vehicles.StatusPending, r.cfg.PendingThreshold)
+	if r.cfg.FailedRetryAfter > 0 {
+		requeued += r.reconcile(ctx, vehicles.StatusFailed, r.cfg.FailedRetryAfter)
+	}
+	if r.lifecycle != nil {
+		n, err := r.lifecycle.RequeueStalledAssetActions(ctx, r.cfg.PendingThreshold, reconcileBatchSize)
+		if err != nil {
+			log.Printf("anchor reconciler: requeue lifecycle asset actions: %v", err)
+		}
+		requeued += n
+	}
+	return requeued
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, status string, olderThan time.Duration) int {
+	requeued := 0
+
+	// Requeued records leave the result set, so always read the first page;
+	// offset only skips records that could not be requeued
+	skipped := 0
+	for {
+		stuck, _, err := r.vehicles.ListByBlockchainStatus(ctx, status, olderThan, reconcileBatchSize, skipped)
+		if err != nil {
+			log.Printf("anchor reconciler: list %s vehicles: %v", status, err)
+			break
+		}
+		for _, v := range stuck {
+			if _, err := r.vehicles.RequeueAnchor(ctx, v.ID); err != nil {
+				log.Printf("anchor reconciler: requeue vehicle %s: %v", v.ID, err)
+				skipped++
+				continue
+			}
+			requeued++
+		}
+		if len(stuck) < reconcileBatchSize {
+			break
+		}
+	}
+
+	skipped = 0
+	for {
+		stuck, _, err := r.vehicles.ListVersionsByBlockchainStatus(ctx, status, olderThan, reconcileBatchSize, skipped)
+		if err != nil {
+			log.Printf("anchor reconciler: list %s vehicle versions: %v", status, err)
+			break
+		}
+		for _, v := range stuck {
+			if _, err := r.vehicles.RequeueVersionAnchor(ctx, v.ID); err != nil {
+				log.Printf("anchor reconciler: requeue vehicle version %s: %v", v.ID, err)
+				skipped++
+				continue
+			}
+			requeued++
+		}
+		if len(stuck) < reconcileBatchSize {
+			break
+		}
+	}
+
+	skipped = 0
+	for {
+		stuck, _, err := r.events.ListByBlockchainStatus(ctx, status, olderThan, reconcileBatchSize, skipped)
+		if err != nil {
+			log.Printf("anchor reconciler: list %s events: %v", status, err)
+			break
+		}
+		for _, e := range stuck {
+			if _, err := r.events.RequeueAnchor(ctx, e.ID); err != nil {
+				log.Printf("anchor reconciler: requeue event %s: %v", e.ID, err)
+				skipped++
+				continue
+			}
+			requeued++
+		}
+		if len(stuck) < reconcileBatchSize {
+			break
+		}
+	}
+
+	return requeued
+}
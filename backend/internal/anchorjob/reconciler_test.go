@@ -0,0 +1,148 @@
+package anchorjob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// --- Mocks ---
+
+type mockVehicleAnchors struct {
+	byStatus   map[string][]vehicles.Vehicle
+	requeued   []uuid.UUID
+	requeueErr error
+}
+
+func (m *mockVehicleAnchors) ListByBlockchainStatus(_ context.Context, status string, _ time.Duration, limit, offset int) ([]vehicles.Vehicle, int, error) {
+	var remaining []vehicles.Vehicle
+	for _, v := range m.byStatus[status] {
+		if !containsID(m.requeued, v.ID) {
+			remaining = append(remaining, v)
+		}
+	}
+	if offset >= len(remaining) {
+		return nil, len(remaining), nil
+	}
+	remaining = remaining[offset:]
+	if len(remaining) > limit {
+		remaining = remaining[:limit]
+	}
+	return remaining, len(remaining), nil
+}
+
+func (m *mockVehicleAnchors) RequeueAnchor(_ context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
+	if m.requeueErr != nil {
+		return nil, m.requeueErr
+	}
+	m.requeued = append(m.requeued, id)
+	return &vehicles.Vehicle{ID: id, BlockchainStatus: vehicles.StatusPending}, nil
+}
+
+func (m *mockVehicleAnchors) ListVersionsByBlockchainStatus(_ context.Context, _ string, _ time.Duration, _, _ int) ([]vehicles.Version, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockVehicleAnchors) RequeueVersionAnchor(_ context.Context, id uuid.UUID) (*vehicles.Version, error) {
+	return &vehicles.Version{ID: id, BlockchainStatus: vehicles.StatusPending}, nil
+}
+
+type mockEventAnchors struct {
+	byStatus map[string][]event.Event
+	requeued []uuid.UUID
+}
+
+func (m *mockEventAnchors) ListByBlockchainStatus(_ context.Context, status string, _ time.Duration, _, _ int) ([]event.Event, int, error) {
+	var remaining []event.Event
+	for _, e := range m.byStatus[status] {
+		if !containsID(m.requeued, e.ID) {
+			remaining = append(remaining, e)
+		}
+	}
+	return remaining, len(remaining), nil
+}
+
+func (m *mockEventAnchors) RequeueAnchor(_ context.Context, id uuid.UUID) (*event.Event, error) {
+	m.requeued = append(m.requeued, id)
+	return &event.Event{ID: id, BlockchainStatus: event.StatusPending}, nil
+}
+
+type mockLifecycleActions struct {
+	stalled   int
+	olderThan time.Duration
+}
+
+func (m *mockLifecycleActions) RequeueStalledAssetActions(_ context.Context, olderThan time.Duration, _ int) (int, error) {
+	m.olderThan = olderThan
+	return m.stalled, nil
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// --- Tests ---
+
+func TestReconciler_RequeuesStuckPending(t *testing.T) {
+	vehicleAnchors := &mockVehicleAnchors{byStatus: map[string][]vehicles.Vehicle{
+		vehicles.StatusPending: {{ID: uuid.New()}},
+		vehicles.StatusFailed:  {{ID: uuid.New()}},
+	}}
+	eventAnchors := &mockEventAnchors{byStatus: map[string][]event.Event{
+		event.StatusPending: {{ID: uuid.New()}, {ID: uuid.New()}},
+	}}
+	r := NewReconciler(vehicleAnchors, eventAnchors, ReconcilerConfig{})
+
+	n := r.Reconcile(context.Background())
+
+	assert.Equal(t, 3, n)
+	assert.Len(t, vehicleAnchors.requeued, 1)
+	assert.Len(t, eventAnchors.requeued, 2)
+}
+
+func TestReconciler_RetriesFailedWhenEnabled(t *testing.T) {
+	vehicleAnchors := &mockVehicleAnchors{byStatus: map[string][]vehicles.Vehicle{
+		vehicles.StatusFailed: {{ID: uuid.New()}},
+	}}
+	r := NewReconciler(vehicleAnchors, &mockEventAnchors{}, ReconcilerConfig{FailedRetryAfter: time.Hour})
+
+	n := r.Reconcile(context.Background())
+
+	assert.Equal(t, 1, n)
+}
+
+func TestReconciler_SkipsRecordsThatCannotBeRequeued(t *testing.T) {
+	vehicleAnchors := &mockVehicleAnchors{
+		byStatus: map[string][]vehicles.Vehicle{
+			vehicles.StatusPending: {{ID: uuid.New()}, {ID: uuid.New()}},
+		},
+		requeueErr: errors.New("database unavailable"),
+	}
+	r := NewReconciler(vehicleAnchors, &mockEventAnchors{}, ReconcilerConfig{})
+
+	n := r.Reconcile(context.Background())
+
+	assert.Zero(t, n)
+}
+
+func TestReconciler_RequeuesStalledLifecycleActions(t *testing.T) {
+	actions := &mockLifecycleActions{stalled: 2}
+	r := NewReconciler(&mockVehicleAnchors{}, &mockEventAnchors{}, ReconcilerConfig{PendingThreshold: time.Minute})
+	r.SetLifecycleActions(actions)
+
+	n := r.Reconcile(context.Background())
+
+	assert.Equal(t, 2, n)
+	assert.Equal(t, time.Minute, actions.olderThan)
+}
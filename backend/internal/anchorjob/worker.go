@@ -3,43 +3,96 @@ package anchorjob
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
+	"github.com/google/uuid"
 )
 
 const MaxDeliveries = 5
 
 type Anchorer interface {
 	VehicleGenesis(ctx context.Context, vehicle vehicles.Vehicle) (*string, error)
-	AnchorEvent(ctx context.Context, vehicle vehicles.Vehicle, event event.Event, imageCIDs []string) error
+	AnchorBatch(ctx context.Context, anchors []anchorer.Anchor) []error
+	AnchorMerkleBatch(ctx context.Context, anchors []anchorer.Anchor) []error
+}
+
+// CustodyMover moves vehicle assets between the platform account and owner wallets
+type CustodyMover interface {
+	Sync(ctx context.Context, vehicleID uuid.UUID) error
+	Fail(ctx context.Context, vehicleID uuid.UUID, cause error) error
+}
+
+// LifecycleEnforcer freezes and destroys vehicle assets for approved lifecycle requests
+type LifecycleEnforcer interface {
+	Enforce(ctx context.Context, requestID uuid.UUID) error
+	Fail(ctx context.Context, requestID uuid.UUID, cause error) error
 }
 
 type Worker struct {
 	subscriber  queue.Subscriber
 	anchorer    Anchorer
+	batcher     *Batcher
 	vehicleRepo vehicles.Repository
 	eventRepo   event.Repository
+	custody     CustodyMover
+	lifecycle   LifecycleEnforcer
 }
 
-func NewWorker(subscriber queue.Subscriber, anchorer Anchorer, vehicleRepo vehicles.Repository, eventRepo event.Repository) *Worker {
+// NewWorker creates a new anchor worker. Vehicle update and event anchors received within
+// batchWindow of each other are submitted as one transaction group; the subscriber must handle
+// messages concurrently for batches to fill. In Merkle mode the events of a batch are anchored
+// under a single root instead.
+func NewWorker(subscriber queue.Subscriber, a Anchorer, vehicleRepo vehicles.Repository, eventRepo event.Repository, batchWindow time.Duration, mode anchorer.Mode) *Worker {
 	return &Worker{
 		subscriber:  subscriber,
-		anchorer:    anchorer,
+		anchorer:    a,
+		batcher:     NewBatcher(a, batchWindow, mode),
 		vehicleRepo: vehicleRepo,
 		eventRepo:   eventRepo,
 	}
 }
 
+// SetCustodyMover sets the mover of vehicle assets (optional). Without it custody transfers are
+// left queued.
+func (w *Worker) SetCustodyMover(mover CustodyMover) {
+	w.custody = mover
+}
+
+// SetLifecycleEnforcer sets the enforcer of lifecycle asset actions (optional). Without it the
+// actions are left queued.
+func (w *Worker) SetLifecycleEnforcer(enforcer LifecycleEnforcer) {
+	w.lifecycle = enforcer
+}
+
 func (w *Worker) Start(ctx context.Context) error {
+	go w.batcher.Start(ctx)
+
 	if err := w.subscriber.Subscribe(ctx, SubjectVehicleGenesis, w.handleVehicleGenesis); err != nil {
 		return err
 	}
+	if err := w.subscriber.Subscribe(ctx, SubjectVehicleUpdate, w.handleVehicleUpdate); err != nil {
+		return err
+	}
 	if err := w.subscriber.Subscribe(ctx, SubjectEventAnchor, w.handleEventAnchor); err != nil {
 		return err
 	}
+	if w.custody != nil {
+		if err := w.subscriber.Subscribe(ctx, SubjectCustodySync, w.handleCustodySync); err != nil {
+			return err
+		}
+	}
+	if w.lifecycle != nil {
+		if err := w.subscriber.Subscribe(ctx, SubjectLifecycle, w.handleLifecycle); err != nil {
+			return err
+		}
+	}
 
 	log.Println("Anchor worker started")
 	<-ctx.Done()
@@ -61,15 +114,26 @@ func (w *Worker) handleVehicleGenesis(ctx context.Context, msg queue.Message) er
 
 	_, err = w.anchorer.VehicleGenesis(ctx, *vehicle)
 	if err != nil {
+		vehicle.BlockchainError = ptr(err.Error())
+		if msg.DeliveryCount >= MaxDeliveries && errors.Is(err, anchorer.ErrGenesisInProgress) {
+			log.Printf("anchor worker: vehicle genesis still in progress after %d attempts, leaving pending: vehicle=%s", msg.DeliveryCount, job.VehicleID)
+			if updateErr := w.vehicleRepo.Update(ctx, vehicle); updateErr != nil {
+				log.Printf("anchor worker: failed to record error for vehicle %s: %v", job.VehicleID, updateErr)
+			}
+			return nil // ack — the reconciler requeues it once the pending transaction is resolved
+		}
 		if msg.DeliveryCount >= MaxDeliveries {
 			log.Printf("anchor worker: vehicle genesis failed after %d attempts: vehicle=%s err=%v", msg.DeliveryCount, job.VehicleID, err)
-			vehicle.BlockchainStatus = "failed"
+			vehicle.BlockchainStatus = vehicles.StatusFailed
 			if updateErr := w.vehicleRepo.Update(ctx, vehicle); updateErr != nil {
 				log.Printf("anchor worker: failed to mark vehicle %s as failed: %v", job.VehicleID, updateErr)
 			}
 			return nil // ack — give up
 		}
 		log.Printf("anchor worker: vehicle genesis attempt %d failed: vehicle=%s err=%v", msg.DeliveryCount, job.VehicleID, err)
+		if updateErr := w.vehicleRepo.Update(ctx, vehicle); updateErr != nil {
+			log.Printf("anchor worker: failed to record error for vehicle %s: %v", job.VehicleID, updateErr)
+		}
 		return err // nack → redeliver
 	}
 
@@ -79,7 +143,8 @@ func (w *Worker) handleVehicleGenesis(ctx context.Context, msg queue.Message) er
 		log.Printf("anchor worker: failed to reload vehicle %s after genesis: %v", job.VehicleID, err)
 		return nil
 	}
-	vehicle.BlockchainStatus = "anchored"
+	vehicle.BlockchainStatus = vehicles.StatusAnchored
+	vehicle.BlockchainError = nil
 	if err := w.vehicleRepo.Update(ctx, vehicle); err != nil {
 		log.Printf("anchor worker: failed to mark vehicle %s as anchored: %v", job.VehicleID, err)
 	}
@@ -87,6 +152,68 @@ func (w *Worker) handleVehicleGenesis(ctx context.Context, msg queue.Message) er
 	return nil
 }
 
+func (w *Worker) handleVehicleUpdate(ctx context.Context, msg queue.Message) error {
+	var job VehicleUpdateJob
+	if err := json.Unmarshal(msg.Data, &job); err != nil {
+		log.Printf("anchor worker: invalid vehicle update payload: %v", err)
+		return nil
+	}
+
+	vehicle, err := w.vehicleRepo.GetByID(ctx, job.VehicleID)
+	if err != nil {
+		log.Printf("anchor worker: vehicle %s not found for version %s: %v", job.VehicleID, job.VersionID, err)
+		return nil
+	}
+
+	version, err := w.vehicleRepo.GetVersion(ctx, job.VersionID)
+	if err != nil {
+		log.Printf("anchor worker: vehicle version %s not found: %v", job.VersionID, err)
+		return nil
+	}
+	if version.BlockchainStatus == vehicles.StatusAnchored {
+		return nil // already anchored by an earlier delivery
+	}
+
+	err = w.batcher.Anchor(ctx, anchorer.Anchor{Vehicle: *vehicle, Version: version})
+	if err != nil {
+		version.BlockchainError = ptr(err.Error())
+		if msg.DeliveryCount >= MaxDeliveries && errors.Is(err, anchorer.ErrGenesisInProgress) {
+			log.Printf("anchor worker: vehicle genesis still in progress after %d attempts, leaving version pending: version=%s", msg.DeliveryCount, job.VersionID)
+			if updateErr := w.vehicleRepo.UpdateVersion(ctx, version); updateErr != nil {
+				log.Printf("anchor worker: failed to record error for vehicle version %s: %v", job.VersionID, updateErr)
+			}
+			return nil
+		}
+		if msg.DeliveryCount >= MaxDeliveries {
+			log.Printf("anchor worker: vehicle update failed after %d attempts: version=%s err=%v", msg.DeliveryCount, job.VersionID, err)
+			version.BlockchainStatus = vehicles.StatusFailed
+			if updateErr := w.vehicleRepo.UpdateVersion(ctx, version); updateErr != nil {
+				log.Printf("anchor worker: failed to mark vehicle version %s as failed: %v", job.VersionID, updateErr)
+			}
+			return nil
+		}
+		log.Printf("anchor worker: vehicle update attempt %d failed: version=%s err=%v", msg.DeliveryCount, job.VersionID, err)
+		if updateErr := w.vehicleRepo.UpdateVersion(ctx, version); updateErr != nil {
+			log.Printf("anchor worker: failed to record error for vehicle version %s: %v", job.VersionID, updateErr)
+		}
+		return err
+	}
+
+	// The anchorer already updated the version row with the tx ID
+	version, err = w.vehicleRepo.GetVersion(ctx, job.VersionID)
+	if err != nil {
+		log.Printf("anchor worker: failed to reload vehicle version %s after anchoring: %v", job.VersionID, err)
+		return nil
+	}
+	version.BlockchainStatus = vehicles.StatusAnchored
+	version.BlockchainError = nil
+	if err := w.vehicleRepo.UpdateVersion(ctx, version); err != nil {
+		log.Printf("anchor worker: failed to mark vehicle version %s as anchored: %v", job.VersionID, err)
+	}
+	log.Printf("anchor worker: vehicle %s version %d anchored", job.VehicleID, version.Version)
+	return nil
+}
+
 func (w *Worker) handleEventAnchor(ctx context.Context, msg queue.Message) error {
 	var job EventAnchorJob
 	if err := json.Unmarshal(msg.Data, &job); err != nil {
@@ -105,31 +232,109 @@ func (w *Worker) handleEventAnchor(ctx context.Context, msg queue.Message) error
 		log.Printf("anchor worker: event %s not found: %v", job.EventID, err)
 		return nil
 	}
+	if evt.BlockchainStatus == event.StatusAnchored {
+		return nil // already anchored by an earlier delivery
+	}
 
-	err = w.anchorer.AnchorEvent(ctx, *vehicle, *evt, job.ImageCIDs)
+	err = w.batcher.Anchor(ctx, anchorer.Anchor{Vehicle: *vehicle, Event: evt, ImageCIDs: job.ImageCIDs})
 	if err != nil {
+		evt.BlockchainError = ptr(err.Error())
+		if msg.DeliveryCount >= MaxDeliveries && errors.Is(err, anchorer.ErrGenesisInProgress) {
+			log.Printf("anchor worker: vehicle genesis still in progress after %d attempts, leaving event pending: event=%s", msg.DeliveryCount, job.EventID)
+			if updateErr := w.eventRepo.Update(ctx, *evt); updateErr != nil {
+				log.Printf("anchor worker: failed to record error for event %s: %v", job.EventID, updateErr)
+			}
+			return nil
+		}
 		if msg.DeliveryCount >= MaxDeliveries {
 			log.Printf("anchor worker: event anchor failed after %d attempts: event=%s err=%v", msg.DeliveryCount, job.EventID, err)
-			evt.BlockchainStatus = "failed"
+			evt.BlockchainStatus = event.StatusFailed
 			if updateErr := w.eventRepo.Update(ctx, *evt); updateErr != nil {
 				log.Printf("anchor worker: failed to mark event %s as failed: %v", job.EventID, updateErr)
 			}
 			return nil
 		}
 		log.Printf("anchor worker: event anchor attempt %d failed: event=%s err=%v", msg.DeliveryCount, job.EventID, err)
+		if updateErr := w.eventRepo.Update(ctx, *evt); updateErr != nil {
+			log.Printf("anchor worker: failed to record error for event %s: %v", job.EventID, updateErr)
+		}
 		return err
 	}
 
-	// AnchorEvent already updates event row with tx ID + CID
+	// The anchorer already updated the event row with tx ID + CID
 	evt, err = w.eventRepo.GetByID(ctx, job.EventID)
 	if err != nil {
 		log.Printf("anchor worker: failed to reload event %s after anchoring: %v", job.EventID, err)
 		return nil
 	}
-	evt.BlockchainStatus = "anchored"
+	evt.BlockchainStatus = event.StatusAnchored
+	evt.BlockchainError = nil
 	if err := w.eventRepo.Update(ctx, *evt); err != nil {
 		log.Printf("anchor worker: failed to mark event %s as anchored: %v", job.EventID, err)
 	}
 	log.Printf("anchor worker: event %s anchored", job.EventID)
 	return nil
 }
+
+func (w *Worker) handleCustodySync(ctx context.Context, msg queue.Message) error {
+	var job CustodySyncJob
+	if err := json.Unmarshal(msg.Data, &job); err != nil {
+		log.Printf("anchor worker: invalid custody payload: %v", err)
+		return nil
+	}
+
+	if err := w.custody.Sync(ctx, job.VehicleID); err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			log.Printf("anchor worker: vehicle %s not found for custody transfer", job.VehicleID)
+			return nil
+		}
+		if msg.DeliveryCount >= MaxDeliveries {
+			log.Printf("anchor worker: custody transfer failed after %d attempts: vehicle=%s err=%v", msg.DeliveryCount, job.VehicleID, err)
+			if failErr := w.custody.Fail(ctx, job.VehicleID, err); failErr != nil {
+				log.Printf("anchor worker: failed to mark custody of vehicle %s as failed: %v", job.VehicleID, failErr)
+			}
+			return nil
+		}
+		log.Printf("anchor worker: custody transfer attempt %d failed: vehicle=%s err=%v", msg.DeliveryCount, job.VehicleID, err)
+		return err
+	}
+
+	log.Printf("anchor worker: custody of vehicle %s synced", job.VehicleID)
+	return nil
+}
+
+func (w *Worker) handleLifecycle(ctx context.Context, msg queue.Message) error {
+	var job LifecycleJob
+	if err := json.Unmarshal(msg.Data, &job); err != nil {
+		log.Printf("anchor worker: invalid lifecycle payload: %v", err)
+		return nil
+	}
+
+	if err := w.lifecycle.Enforce(ctx, job.RequestID); err != nil {
+		if errors.Is(err, lifecycle.ErrRequestNotFound) || errors.Is(err, vehicles.ErrVehicleNotFound) {
+			log.Printf("anchor worker: lifecycle request %s or its vehicle not found", job.RequestID)
+			return nil
+		}
+		waiting := errors.Is(err, lifecycle.ErrEventNotAnchored) || errors.Is(err, lifecycle.ErrAssetNotCreated) || errors.Is(err, lifecycle.ErrCustodyPending)
+		if msg.DeliveryCount >= MaxDeliveries && waiting {
+			log.Printf("anchor worker: lifecycle asset action still waiting after %d attempts, leaving pending: request=%s err=%v", msg.DeliveryCount, job.RequestID, err)
+			return nil // ack — the reconciler requeues it
+		}
+		if msg.DeliveryCount >= MaxDeliveries {
+			log.Printf("anchor worker: lifecycle asset action failed after %d attempts: request=%s err=%v", msg.DeliveryCount, job.RequestID, err)
+			if failErr := w.lifecycle.Fail(ctx, job.RequestID, err); failErr != nil {
+				log.Printf("anchor worker: failed to mark lifecycle request %s as failed: %v", job.RequestID, failErr)
+			}
+			return nil
+		}
+		log.Printf("anchor worker: lifecycle asset action attempt %d failed: request=%s err=%v", msg.DeliveryCount, job.RequestID, err)
+		return err
+	}
+
+	log.Printf("anchor worker: lifecycle asset action of request %s done", job.RequestID)
+	return nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,79 @@
+package anchorjob
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+// mockWorkerVehicles embeds the repository so only the methods the worker calls are implemented
+type mockWorkerVehicles struct {
+	vehicles.Repository
+	vehicle *vehicles.Vehicle
+}
+
+func (m *mockWorkerVehicles) GetByID(_ context.Context, _ uuid.UUID) (*vehicles.Vehicle, error) {
+	return m.vehicle, nil
+}
+
+type mockWorkerEvents struct {
+	event.Repository
+	events  map[uuid.UUID]event.Event
+	updates int
+}
+
+func (m *mockWorkerEvents) GetByID(_ context.Context, id uuid.UUID) (*event.Event, error) {
+	evt, ok := m.events[id]
+	if !ok {
+		return nil, event.ErrEventNotFound
+	}
+	return &evt, nil
+}
+
+func (m *mockWorkerEvents) Update(_ context.Context, evt event.Event) error {
+	m.updates++
+	m.events[evt.ID] = evt
+	return nil
+}
+
+func eventAnchorMessage(t *testing.T, job EventAnchorJob, deliveryCount int) queue.Message {
+	t.Helper()
+	data, err := json.Marshal(job)
+	require.NoError(t, err)
+	return queue.Message{Subject: SubjectEventAnchor, Data: data, DeliveryCount: deliveryCount}
+}
+
+// --- Tests ---
+
+func TestWorker_EventAnchorRedeliverySkipsAnchoredEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	vehicle := &vehicles.Vehicle{ID: uuid.New()}
+	evt := event.Event{ID: uuid.New(), VehicleID: vehicle.ID, BlockchainStatus: event.StatusPending}
+	events := &mockWorkerEvents{events: map[uuid.UUID]event.Event{evt.ID: evt}}
+	a := &mockAnchorer{}
+	w := NewWorker(nil, a, &mockWorkerVehicles{vehicle: vehicle}, events, time.Millisecond, anchorer.ModeDirect)
+	go w.batcher.Start(ctx)
+
+	job := EventAnchorJob{VehicleID: vehicle.ID, EventID: evt.ID}
+	require.NoError(t, w.handleEventAnchor(ctx, eventAnchorMessage(t, job, 1)))
+	assert.Equal(t, event.StatusAnchored, events.events[evt.ID].BlockchainStatus)
+
+	// The outbox and the reconciler may deliver the same job again
+	require.NoError(t, w.handleEventAnchor(ctx, eventAnchorMessage(t, job, 1)))
+
+	assert.Equal(t, []int{1}, a.batchSizes())
+	assert.Equal(t, 1, events.updates)
+}
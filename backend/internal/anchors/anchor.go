@@ -0,0 +1,48 @@
+package anchors
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	StatusConfirmed = "confirmed"
+	StatusFailed    = "failed"
+)
+
+const (
+	RecordTypeVehicle        = "vehicle"
+	RecordTypeVehicleVersion = "vehicle_version"
+	RecordTypeEvent          = "event"
+)
+
+// Anchor is a transaction submitted to anchor a record of a vehicle's chain, with the outcome
+// reported by the ledger. Failed submissions are kept too, with the transaction IDs that were
+// sent when they are known. Records anchored under one Merkle root share its transaction.
+type Anchor struct {
+	ID        uuid.UUID `json:"id"`
+	VehicleID uuid.UUID `json:"vehicleId"`
+	// RecordType is the anchored record: the vehicle's genesis, a vehicle version or an event
+	RecordType       string     `json:"recordType"`
+	EventID          *uuid.UUID `json:"eventId,omitempty"`
+	VehicleVersionID *uuid.UUID `json:"vehicleVersionId,omitempty"`
+	Status           string     `json:"status"`
+	Error            *string    `json:"error,omitempty"`
+	// Network is the ledger network the transaction was sent to, e.g. mainnet or testnet
+	Network        string     `json:"network"`
+	Sender         string     `json:"sender"`
+	TxID           *string    `json:"txId,omitempty"`
+	TxType         *string    `json:"txType,omitempty"`
+	AssetID        *uint64    `json:"assetId,omitempty"`
+	ConfirmedRound *uint64    `json:"confirmedRound,omitempty"`
+	RoundTime      *time.Time `json:"roundTime,omitempty"`
+	// FeeMicroAlgos is the fee paid for the transaction
+	FeeMicroAlgos *uint64   `json:"feeMicroAlgos,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// Confirmed reports whether the transaction was confirmed on the ledger
+func (a Anchor) Confirmed() bool {
+	return a.Status == StatusConfirmed
+}
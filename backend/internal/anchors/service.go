@@ -0,0 +1,85 @@
+package anchors
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for anchors
+type Repository interface {
+	Create(ctx context.Context, anchor Anchor) (*Anchor, error)
+	// ListByVehicle returns the anchors of the vehicle's records, most recent first
+	ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Anchor, error)
+	// ListByEvent returns the anchors of an event, most recent first
+	ListByEvent(ctx context.Context, eventID uuid.UUID) ([]Anchor, error)
+}
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// ListByVehicle retrieves every anchoring attempt of the vehicle's records, most recent first
+func (s *Service) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Anchor, error) {
+	return s.repo.ListByVehicle(ctx, vehicleID)
+}
+
+// ListByEvent retrieves every anchoring attempt of an event, most recent first
+func (s *Service) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]Anchor, error) {
+	return s.repo.ListByEvent(ctx, eventID)
+}
+
+// Summary is the anchor that currently stands for each record of a vehicle
+type Summary struct {
+	Genesis  *Anchor
+	Versions map[uuid.UUID]Anchor
+	Events   map[uuid.UUID]Anchor
+}
+
+// Summarize picks, for each record of a vehicle, its latest confirmed anchor, or its latest
+// attempt when none was confirmed. anchors must be ordered most recent first.
+func Summarize(anchors []Anchor) Summary {
+	summary := Summary{Versions: map[uuid.UUID]Anchor{}, Events: map[uuid.UUID]Anchor{}}
+	for _, a := range anchors {
+		switch {
+		case a.RecordType == RecordTypeVehicle:
+			if summary.Genesis == nil || supersedes(a, *summary.Genesis) {
+				genesis := a
+				summary.Genesis = &genesis
+			}
+		case a.VehicleVersionID != nil:
+			if current, ok := summary.Versions[*a.VehicleVersionID]; !ok || supersedes(a, current) {
+				summary.Versions[*a.VehicleVersionID] = a
+			}
+		case a.EventID != nil:
+			if current, ok := summary.Events[*a.EventID]; !ok || supersedes(a, current) {
+				summary.Events[*a.EventID] = a
+			}
+		}
+	}
+	return summary
+}
+
+// supersedes reports whether an older anchor replaces the current one: only a confirmed anchor
+// replaces a failed attempt
+func supersedes(older, current Anchor) bool {
+	return older.Confirmed() && !current.Confirmed()
+}
+
+// Current picks the latest confirmed anchor, or the latest attempt when none was confirmed.
+// anchors must be ordered most recent first.
+func Current(anchors []Anchor) *Anchor {
+	for i := range anchors {
+		if anchors[i].Confirmed() {
+			return &anchors[i]
+		}
+	}
+	if len(anchors) > 0 {
+		return &anchors[0]
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package anchors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockRepo struct {
+	anchors []Anchor
+}
+
+func (m *mockRepo) Create(ctx context.Context, anchor Anchor) (*Anchor, error) {
+	anchor.ID = uuid.New()
+	m.anchors = append([]Anchor{anchor}, m.anchors...)
+	return &anchor, nil
+}
+
+func (m *mockRepo) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Anchor, error) {
+	var result []Anchor
+	for _, a := range m.anchors {
+		if a.VehicleID == vehicleID {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepo) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]Anchor, error) {
+	var result []Anchor
+	for _, a := range m.anchors {
+		if a.EventID != nil && *a.EventID == eventID {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+// --- Helpers ---
+
+func confirmed(recordType string, txID string) Anchor {
+	round := uint64(42)
+	fee := uint64(1000)
+	roundTime := time.Now()
+	return Anchor{
+		ID:             uuid.New(),
+		RecordType:     recordType,
+		Status:         StatusConfirmed,
+		TxID:           &txID,
+		ConfirmedRound: &round,
+		RoundTime:      &roundTime,
+		FeeMicroAlgos:  &fee,
+	}
+}
+
+func failed(recordType string) Anchor {
+	reason := "node unavailable"
+	return Anchor{ID: uuid.New(), RecordType: recordType, Status: StatusFailed, Error: &reason}
+}
+
+func forEvent(a Anchor, eventID uuid.UUID) Anchor {
+	a.EventID = &eventID
+	return a
+}
+
+func forVersion(a Anchor, versionID uuid.UUID) Anchor {
+	a.VehicleVersionID = &versionID
+	return a
+}
+
+// --- Tests ---
+
+func TestSummarize_PrefersConfirmedOverLaterFailure(t *testing.T) {
+	eventID := uuid.New()
+	ok := forEvent(confirmed(RecordTypeEvent, "TX1"), eventID)
+	list := []Anchor{forEvent(failed(RecordTypeEvent), eventID), ok}
+
+	summary := Summarize(list)
+
+	require.Contains(t, summary.Events, eventID)
+	assert.Equal(t, ok.ID, summary.Events[eventID].ID)
+}
+
+func TestSummarize_KeepsLatestAttemptWhenNoneConfirmed(t *testing.T) {
+	versionID := uuid.New()
+	latest := forVersion(failed(RecordTypeVehicleVersion), versionID)
+	list := []Anchor{latest, forVersion(failed(RecordTypeVehicleVersion), versionID)}
+
+	summary := Summarize(list)
+
+	assert.Equal(t, latest.ID, summary.Versions[versionID].ID)
+}
+
+func TestSummarize_SeparatesRecordTypes(t *testing.T) {
+	eventID, versionID := uuid.New(), uuid.New()
+	genesis := confirmed(RecordTypeVehicle, "GENESIS")
+	list := []Anchor{
+		forEvent(confirmed(RecordTypeEvent, "TX2"), eventID),
+		forVersion(confirmed(RecordTypeVehicleVersion, "TX1"), versionID),
+		genesis,
+	}
+
+	summary := Summarize(list)
+
+	require.NotNil(t, summary.Genesis)
+	assert.Equal(t, genesis.ID, summary.Genesis.ID)
+	assert.Len(t, summary.Versions, 1)
+	assert.Len(t, summary.Events, 1)
+}
+
+func TestSummarize_Empty(t *testing.T) {
+	summary := Summarize(nil)
+
+	assert.Nil(t, summary.Genesis)
+	assert.Empty(t, summary.Versions)
+	assert.Empty(t, summary.Events)
+}
+
+func TestCurrent(t *testing.T) {
+	ok := confirmed(RecordTypeEvent, "TX1")
+	latestFailure := failed(RecordTypeEvent)
+
+	assert.Equal(t, ok.ID, Current([]Anchor{latestFailure, ok}).ID)
+	assert.Equal(t, latestFailure.ID, Current([]Anchor{latestFailure, failed(RecordTypeEvent)}).ID)
+	assert.Nil(t, Current(nil))
+}
+
+func TestService_ListByEvent(t *testing.T) {
+	vehicleID, eventID := uuid.New(), uuid.New()
+	repo := &mockRepo{}
+	svc := NewService(repo)
+
+	a := forEvent(confirmed(RecordTypeEvent, "TX1"), eventID)
+	a.VehicleID = vehicleID
+	_, err := repo.Create(context.Background(), a)
+	require.NoError(t, err)
+	b := confirmed(RecordTypeVehicle, "GENESIS")
+	b.VehicleID = vehicleID
+	_, err = repo.Create(context.Background(), b)
+	require.NoError(t, err)
+
+	byEvent, err := svc.ListByEvent(context.Background(), eventID)
+	require.NoError(t, err)
+	assert.Len(t, byEvent, 1)
+
+	byVehicle, err := svc.ListByVehicle(context.Background(), vehicleID)
+	require.NoError(t, err)
+	assert.Len(t, byVehicle, 2)
+}
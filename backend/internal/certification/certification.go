@@ -0,0 +1,60 @@
+package certification
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRequestNotFound       = errors.New("certification request not found")
+	ErrRequestNotPending     = errors.New("certification request has already been decided")
+	ErrRequestAlreadyPending = errors.New("event already has a pending certification request with this entity")
+	ErrNotOwnerEvent         = errors.New("only the owner's own events can be submitted for certification")
+	ErrNotCertifier          = errors.New("entity is not a certifier")
+	ErrOwnerChanged          = errors.New("vehicle owner changed after certification was requested")
+)
+
+const (
+	StatusPending  = "pending"
+	StatusEndorsed = "endorsed"
+	StatusDeclined = "declined"
+)
+
+// Request is a vehicle owner's request for a certifier entity to verify one of their own events.
+// Endorsing it records a certified event that references the original submission.
+type Request struct {
+	ID                uuid.UUID  `json:"id"`
+	EventID           uuid.UUID  `json:"eventId"`
+	VehicleID         uuid.UUID  `json:"vehicleId"`
+	EntityID          uuid.UUID  `json:"entityId"`
+	RequestedBy       uuid.UUID  `json:"requestedBy"`
+	EvidenceSessionID *uuid.UUID `json:"evidenceSessionId,omitempty"`
+	Message           *string    `json:"message,omitempty"`
+	Status            string     `json:"status"`
+	DecidedBy         *uuid.UUID `json:"decidedBy,omitempty"`
+	DeclineReason     *string    `json:"declineReason,omitempty"`
+	CertifiedEventID  *uuid.UUID `json:"certifiedEventId,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	DecidedAt         *time.Time `json:"decidedAt,omitempty"`
+}
+
+// CreateRequestParams represents parameters for creating a new certification request
+type CreateRequestParams struct {
+	EventID           uuid.UUID
+	VehicleID         uuid.UUID
+	EntityID          uuid.UUID
+	RequestedBy       uuid.UUID
+	EvidenceSessionID *uuid.UUID
+	Message           *string
+}
+
+// SubmitParams represents an owner's request for an entity to certify their event. The images
+// uploaded in EvidenceSessionID are attached to the certified event once it is endorsed.
+type SubmitParams struct {
+	EntityID          uuid.UUID
+	RequestedBy       uuid.UUID
+	EvidenceSessionID *uuid.UUID
+	Message           *string
+}
@@ -0,0 +1,163 @@
+package certification
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/google/uuid"
+)
+
+const DefaultExpiryInterval = time.Hour
+
+// DefaultReminderLeadDays are the days before a certification ends on which reminders are sent
+var DefaultReminderLeadDays = []int{30, 7, 1}
+
+// UserDirectory resolves the contact email of a user
+type UserDirectory interface {
+	GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// ReminderMailer notifies owners and entities of certifications that are about to expire
+type ReminderMailer interface {
+	SendCertificationExpiryReminder(ctx context.Context, to string, vehicleID uuid.UUID, vehicle invitation.VehicleInfo, entityName string, certificateNumber *string, validUntil time.Time, daysLeft int) error
+}
+
+// ExpiryJobConfig controls how often certifications are checked and when reminders are sent
+type ExpiryJobConfig struct {
+	Interval time.Duration
+	// ReminderLeadDays lists the days before the end of a certification on which the vehicle owner
+	// and the issuing entity are reminded. Each certification gets one reminder per lead time.
+	ReminderLeadDays []int
+}
+
+// ExpiryJob periodically expires certifications whose window ended and sends reminders for the
+// ones about to end. Reminders are claimed in the database before they are sent, so several
+// instances can run the job without sending duplicates.
+type ExpiryJob struct {
+	repo     ValidityRepository
+	vehicles VehicleService
+	entities EntityService
+	users    UserDirectory
+	mailer   ReminderMailer
+	cfg      ExpiryJobConfig
+}
+
+// NewExpiryJob creates a new certification expiry job
+func NewExpiryJob(repo ValidityRepository, vehicles VehicleService, entities EntityService, users UserDirectory, mailer ReminderMailer, cfg ExpiryJobConfig) *ExpiryJob {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultExpiryInterval
+	}
+	if cfg.ReminderLeadDays == nil {
+		cfg.ReminderLeadDays = DefaultReminderLeadDays
+	}
+	// Claiming the closest lead time first keeps a late first run from sending every reminder at once
+	leadDays := slices.Clone(cfg.ReminderLeadDays)
+	slices.Sort(leadDays)
+	cfg.ReminderLeadDays = slices.Compact(leadDays)
+
+	return &ExpiryJob{
+		repo:     repo,
+		vehicles: vehicles,
+		entities: entities,
+		users:    users,
+		mailer:   mailer,
+		cfg:      cfg,
+	}
+}
+
+// Start runs the job immediately and then on every interval until the context is cancelled
+func (j *ExpiryJob) Start(ctx context.Context) error {
+	ticker := time.NewTicker(j.cfg.Interval)
+	defer ticker.Stop()
+
+	log.Println("Certification expiry job started")
+
+	for {
+		expired, reminded := j.Run(ctx, time.Now())
+		if expired > 0 || reminded > 0 {
+			log.Printf("certification expiry: expired %d certifications, sent %d reminders", expired, reminded)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run expires the certifications that ended before now and sends the reminders that are due. It
+// returns how many certifications expired and how many reminders were claimed.
+func (j *ExpiryJob) Run(ctx context.Context, now time.Time) (int, int) {
+	today := dateOf(now)
+
+	expired, err := j.repo.Expire(ctx, today)
+	if err != nil {
+		log.Printf("certification expiry: expire certifications: %v", err)
+	}
+
+	reminded := 0
+	for _, leadDays := range j.cfg.ReminderLeadDays {
+		if leadDays < 0 {
+			continue
+		}
+		due, err := j.repo.ClaimReminders(ctx, today, leadDays)
+		if err != nil {
+			log.Printf("certification expiry: claim %d-day reminders: %v", leadDays, err)
+			continue
+		}
+		for _, c := range due {
+			j.remind(ctx, c, today)
+			reminded++
+		}
+	}
+
+	return len(expired), reminded
+}
+
+// remind emails the vehicle owner and the issuing entity. Failures are logged, as the reminder has
+// already been claimed.
+func (j *ExpiryJob) remind(ctx context.Context, c Certification, today time.Time) {
+	if c.ValidUntil == nil {
+		return
+	}
+	daysLeft := int(c.ValidUntil.Sub(today).Hours() / 24)
+
+	vehicle, err := j.vehicles.GetByID(ctx, c.VehicleID)
+	if err != nil {
+		log.Printf("certification expiry: load vehicle %s: %v", c.VehicleID, err)
+		return
+	}
+	ent, err := j.entities.GetByID(ctx, c.EntityID)
+	if err != nil {
+		log.Printf("certification expiry: load entity %s: %v", c.EntityID, err)
+		return
+	}
+
+	info := invitation.VehicleInfo{Make: vehicle.Make, Model: vehicle.Model, Year: vehicle.Year}
+	if vehicle.LicensePlate != nil {
+		info.LicensePlate = *vehicle.LicensePlate
+	}
+
+	recipients := []string{ent.ContactEmail}
+	if vehicle.OwnerID != nil {
+		ownerEmail, err := j.users.GetUserEmail(ctx, *vehicle.OwnerID)
+		if err != nil {
+			log.Printf("certification expiry: resolve owner of vehicle %s: %v", vehicle.ID, err)
+		} else {
+			recipients = append(recipients, ownerEmail)
+		}
+	}
+
+	for _, to := range recipients {
+		if to == "" {
+			continue
+		}
+		if err := j.mailer.SendCertificationExpiryReminder(ctx, to, vehicle.ID, info, ent.Name, c.CertificateNumber, *c.ValidUntil, daysLeft); err != nil {
+			log.Printf("certification expiry: send reminder for certification %s: %v", c.ID, err)
+		}
+	}
+}
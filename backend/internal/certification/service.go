@@ -0,0 +1,252 @@
+package certification
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for certification requests
+type Repository interface {
+	Create(ctx context.Context, params CreateRequestParams) (*Request, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Request, error)
+	ListByEvent(ctx context.Context, eventID uuid.UUID) ([]Request, error)
+	// ListByEntity returns the entity's requests in the given status, or all of them if status is empty
+	ListByEntity(ctx context.Context, entityID uuid.UUID, status string) ([]Request, error)
+	// Decide returns ErrRequestNotPending when the request has already been decided
+	Decide(ctx context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*Request, error)
+	SetCertifiedEvent(ctx context.Context, id, eventID uuid.UUID) error
+}
+
+// VehicleService handles vehicle operations
+type VehicleService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+}
+
+// EventService handles event operations
+type EventService interface {
+	GetWithRevisions(ctx context.Context, id uuid.UUID) (*event.Event, []event.Event, error)
+	RecordCertified(ctx context.Context, vehicle vehicles.Vehicle, params event.CreateEventParams) (*event.Event, error)
+}
+
+// EntityService handles entity operations
+type EntityService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Entity, error)
+}
+
+// EvidenceValidator checks that an image upload session holds confirmed images
+type EvidenceValidator interface {
+	ValidateSessionForEvent(ctx context.Context, sessionID uuid.UUID) ([]string, error)
+}
+
+// Transactor runs a function inside a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service handles business logic for certification requests
+type Service struct {
+	repo       Repository
+	vehicles   VehicleService
+	events     EventService
+	entities   EntityService
+	evidence   EvidenceValidator
+	transactor Transactor
+}
+
+// NewService creates a new certification request service
+func NewService(repo Repository, vehicles VehicleService, events EventService, entities EntityService, evidence EvidenceValidator, transactor Transactor) *Service {
+	return &Service{
+		repo:       repo,
+		vehicles:   vehicles,
+		events:     events,
+		entities:   entities,
+		evidence:   evidence,
+		transactor: transactor,
+	}
+}
+
+// Submit asks a certifier entity to verify one of the owner's own events of the vehicle
+func (s *Service) Submit(ctx context.Context, vehicle vehicles.Vehicle, eventID uuid.UUID, params SubmitParams) (*Request, error) {
+	evt, _, err := s.events.GetWithRevisions(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if evt.VehicleID != vehicle.ID {
+		return nil, event.ErrEventNotFound
+	}
+	if evt.EntityID != nil || evt.RevisesEventID != nil || evt.RecordedByPlatform() {
+		return nil, ErrNotOwnerEvent
+	}
+
+	ent, err := s.entities.GetByID(ctx, params.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	if ent.Type != entity.TypeCertifier {
+		return nil, ErrNotCertifier
+	}
+
+	if params.EvidenceSessionID != nil {
+		if _, err := s.evidence.ValidateSessionForEvent(ctx, *params.EvidenceSessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.repo.ListByEvent(ctx, evt.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range existing {
+		if r.EntityID == ent.ID && r.Status == StatusPending {
+			return nil, ErrRequestAlreadyPending
+		}
+	}
+
+	var message *string
+	if params.Message != nil {
+		if trimmed := strings.TrimSpace(*params.Message); trimmed != "" {
+			message = &trimmed
+		}
+	}
+
+	return s.repo.Create(ctx, CreateRequestParams{
+		EventID:           evt.ID,
+		VehicleID:         vehicle.ID,
+		EntityID:          ent.ID,
+		RequestedBy:       params.RequestedBy,
+		EvidenceSessionID: params.EvidenceSessionID,
+		Message:           message,
+	})
+}
+
+// Endorse accepts a pending request on behalf of the entity. A certified event carrying the
+// effective content of the original submission, the metadata added by the reviewer and the
+// evidence images is recorded and anchored in the same transaction. Only the owner's metadata
+// fields known to the schema of the event type are carried over.
+func (s *Service) Endorse(ctx context.Context, entityID, requestID, decidedBy uuid.UUID, metadata map[string]interface{}) (*Request, error) {
+	r, err := s.getForEntity(ctx, entityID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	var endorsed *Request
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		endorsed, err = s.repo.Decide(ctx, r.ID, StatusEndorsed, decidedBy, nil)
+		if err != nil {
+			return err
+		}
+
+		vehicle, err := s.vehicles.GetByID(ctx, r.VehicleID)
+		if err != nil {
+			return err
+		}
+		if vehicle.OwnerID == nil || *vehicle.OwnerID != r.RequestedBy {
+			return ErrOwnerChanged
+		}
+
+		original, _, err := s.events.GetWithRevisions(ctx, r.EventID)
+		if err != nil {
+			return err
+		}
+
+		certifiedMetadata := certifiableMetadata(original.Type, original.Metadata)
+		maps.Copy(certifiedMetadata, metadata)
+		certifiedMetadata[event.MetadataCertifiesEventID] = original.ID.String()
+		certifiedMetadata[event.MetadataCertificationRequestID] = r.ID.String()
+
+		certified, err := s.events.RecordCertified(ctx, *vehicle, event.CreateEventParams{
+			VehicleID:      vehicle.ID,
+			EntityID:       &r.EntityID,
+			Type:           original.Type,
+			Title:          original.Title,
+			Description:    original.Description,
+			Date:           &original.Date,
+			Location:       original.Location,
+			Metadata:       certifiedMetadata,
+			ImageSessionID: r.EvidenceSessionID,
+		})
+		if err != nil {
+			return fmt.Errorf("create certified event: %w", err)
+		}
+
+		if err := s.repo.SetCertifiedEvent(ctx, r.ID, certified.ID); err != nil {
+			return fmt.Errorf("set certified event: %w", err)
+		}
+		endorsed.CertifiedEventID = &certified.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return endorsed, nil
+}
+
+// Decline rejects a pending request on behalf of the entity, optionally explaining why
+func (s *Service) Decline(ctx context.Context, entityID, requestID, decidedBy uuid.UUID, reason *string) (*Request, error) {
+	r, err := s.getForEntity(ctx, entityID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason != nil {
+		trimmed := strings.TrimSpace(*reason)
+		reason = nil
+		if trimmed != "" {
+			reason = &trimmed
+		}
+	}
+
+	return s.repo.Decide(ctx, r.ID, StatusDeclined, decidedBy, reason)
+}
+
+// ListByEntity retrieves the entity's review queue, oldest first. An empty status lists every request.
+func (s *Service) ListByEntity(ctx context.Context, entityID uuid.UUID, status string) ([]Request, error) {
+	return s.repo.ListByEntity(ctx, entityID, status)
+}
+
+// ListByEvent retrieves the certification requests made for an event, newest first
+func (s *Service) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]Request, error) {
+	return s.repo.ListByEvent(ctx, eventID)
+}
+
+// certifiableMetadata copies the metadata of an owner event that the certified event can carry.
+// Owner metadata is free-form, so fields outside the schema of the event type are dropped rather
+// than failing the validation of the certified event.
+func certifiableMetadata(eventType event.EventType, metadata map[string]interface{}) map[string]interface{} {
+	schema, ok := event.MetadataSchemaFor(eventType)
+	if !ok {
+		certified := maps.Clone(metadata)
+		if certified == nil {
+			certified = map[string]interface{}{}
+		}
+		return certified
+	}
+
+	certified := make(map[string]interface{}, len(schema.Fields))
+	for _, field := range schema.Fields {
+		if value, ok := metadata[field.Name]; ok {
+			certified[field.Name] = value
+		}
+	}
+	return certified
+}
+
+func (s *Service) getForEntity(ctx context.Context, entityID, requestID uuid.UUID) (*Request, error) {
+	r, err := s.repo.GetByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if r.EntityID != entityID {
+		return nil, ErrRequestNotFound
+	}
+	return r, nil
+}
@@ -0,0 +1,578 @@
+package certification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_images"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockRepo struct {
+	requests       map[uuid.UUID]*Request
+	eventByRequest map[uuid.UUID]uuid.UUID
+}
+
+func newMockRepo(requests ...*Request) *mockRepo {
+	m := &mockRepo{requests: map[uuid.UUID]*Request{}, eventByRequest: map[uuid.UUID]uuid.UUID{}}
+	for _, r := range requests {
+		m.requests[r.ID] = r
+	}
+	return m
+}
+
+func (m *mockRepo) Create(ctx context.Context, params CreateRequestParams) (*Request, error) {
+	r := &Request{
+		ID:                uuid.New(),
+		EventID:           params.EventID,
+		VehicleID:         params.VehicleID,
+		EntityID:          params.EntityID,
+		RequestedBy:       params.RequestedBy,
+		EvidenceSessionID: params.EvidenceSessionID,
+		Message:           params.Message,
+		Status:            StatusPending,
+	}
+	m.requests[r.ID] = r
+	return r, nil
+}
+func (m *mockRepo) GetByID(ctx context.Context, id uuid.UUID) (*Request, error) {
+	if r, ok := m.requests[id]; ok {
+		return r, nil
+	}
+	return nil, ErrRequestNotFound
+}
+func (m *mockRepo) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]Request, error) {
+	var result []Request
+	for _, r := range m.requests {
+		if r.EventID == eventID {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+func (m *mockRepo) ListByEntity(ctx context.Context, entityID uuid.UUID, status string) ([]Request, error) {
+	return nil, nil
+}
+func (m *mockRepo) Decide(ctx context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*Request, error) {
+	r := m.requests[id]
+	if r.Status != StatusPending {
+		return nil, ErrRequestNotPending
+	}
+	now := time.Now().UTC()
+	r.Status = status
+	r.DecidedBy = &decidedBy
+	r.DeclineReason = declineReason
+	r.DecidedAt = &now
+	return r, nil
+}
+func (m *mockRepo) SetCertifiedEvent(ctx context.Context, id, eventID uuid.UUID) error {
+	m.eventByRequest[id] = eventID
+	return nil
+}
+
+type mockVehicleService struct {
+	vehicle *vehicles.Vehicle
+}
+
+func (m *mockVehicleService) GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
+	copy := *m.vehicle
+	return &copy, nil
+}
+
+type mockEventService struct {
+	events  map[uuid.UUID]*event.Event
+	created []event.CreateEventParams
+}
+
+func (m *mockEventService) GetWithRevisions(ctx context.Context, id uuid.UUID) (*event.Event, []event.Event, error) {
+	if e, ok := m.events[id]; ok {
+		copy := *e
+		return &copy, nil, nil
+	}
+	return nil, nil, event.ErrEventNotFound
+}
+func (m *mockEventService) RecordCertified(ctx context.Context, vehicle vehicles.Vehicle, params event.CreateEventParams) (*event.Event, error) {
+	m.created = append(m.created, params)
+	return &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, EntityID: params.EntityID, Type: params.Type}, nil
+}
+
+type mockEntityService struct {
+	entities map[uuid.UUID]*entity.Entity
+}
+
+func (m *mockEntityService) GetByID(ctx context.Context, id uuid.UUID) (*entity.Entity, error) {
+	if e, ok := m.entities[id]; ok {
+		return e, nil
+	}
+	return nil, entity.ErrEntityNotFound
+}
+
+type mockEvidence struct {
+	err error
+}
+
+func (m *mockEvidence) ValidateSessionForEvent(ctx context.Context, sessionID uuid.UUID) ([]string, error) {
+	return []string{"evidence-cid"}, m.err
+}
+
+type mockTransactor struct{}
+
+func (m *mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type mockValidityRepo struct {
+	certs   map[uuid.UUID]*Certification
+	revoked []uuid.UUID
+}
+
+func newMockValidityRepo(certs ...*Certification) *mockValidityRepo {
+	m := &mockValidityRepo{certs: map[uuid.UUID]*Certification{}}
+	for _, c := range certs {
+		m.certs[c.EventID] = c
+	}
+	return m
+}
+
+func (m *mockValidityRepo) Upsert(ctx context.Context, c Certification) (*Certification, error) {
+	if existing, ok := m.certs[c.EventID]; ok {
+		if existing.Status == ValidityRevoked {
+			c.Status = ValidityRevoked
+		}
+		if !equalDates(existing.ValidUntil, c.ValidUntil) {
+			existing.LastReminderDays = nil
+		}
+		c.ID = existing.ID
+		c.LastReminderDays = existing.LastReminderDays
+	} else {
+		c.ID = uuid.New()
+	}
+	m.certs[c.EventID] = &c
+	return &c, nil
+}
+func (m *mockValidityRepo) Revoke(ctx context.Context, eventID uuid.UUID) error {
+	m.revoked = append(m.revoked, eventID)
+	if c, ok := m.certs[eventID]; ok {
+		c.Status = ValidityRevoked
+	}
+	return nil
+}
+func (m *mockValidityRepo) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Certification, error) {
+	var result []Certification
+	for _, c := range m.certs {
+		if c.VehicleID == vehicleID {
+			result = append(result, *c)
+		}
+	}
+	return result, nil
+}
+func (m *mockValidityRepo) Expire(ctx context.Context, today time.Time) ([]Certification, error) {
+	var result []Certification
+	for _, c := range m.certs {
+		if c.Status == ValidityActive && c.ValidUntil != nil && c.ValidUntil.Before(today) {
+			c.Status = ValidityExpired
+			result = append(result, *c)
+		}
+	}
+	return result, nil
+}
+func (m *mockValidityRepo) ClaimReminders(ctx context.Context, today time.Time, leadDays int) ([]Certification, error) {
+	var result []Certification
+	for _, c := range m.certs {
+		if c.Status != ValidityActive || c.ValidUntil == nil || c.ValidUntil.After(today.AddDate(0, 0, leadDays)) {
+			continue
+		}
+		if c.LastReminderDays != nil && *c.LastReminderDays <= leadDays {
+			continue
+		}
+		c.LastReminderDays = &leadDays
+		result = append(result, *c)
+	}
+	return result, nil
+}
+
+func equalDates(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+type mockUsers struct {
+	emails map[uuid.UUID]string
+}
+
+func (m *mockUsers) GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error) {
+	return m.emails[userID], nil
+}
+
+type sentReminder struct {
+	to       string
+	daysLeft int
+}
+
+type mockReminderMailer struct {
+	sent []sentReminder
+}
+
+func (m *mockReminderMailer) SendCertificationExpiryReminder(ctx context.Context, to string, vehicleID uuid.UUID, vehicle invitation.VehicleInfo, entityName string, certificateNumber *string, validUntil time.Time, daysLeft int) error {
+	m.sent = append(m.sent, sentReminder{to: to, daysLeft: daysLeft})
+	return nil
+}
+
+// --- Fixtures ---
+
+type fixture struct {
+	svc       *Service
+	repo      *mockRepo
+	events    *mockEventService
+	evidence  *mockEvidence
+	vehicle   *vehicles.Vehicle
+	ownerID   uuid.UUID
+	ownerEvt  *event.Event
+	certifier *entity.Entity
+	partner   *entity.Entity
+}
+
+func newFixture(requests ...*Request) *fixture {
+	ownerID := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &ownerID}
+	location := "Goodwood"
+	ownerEvt := &event.Event{
+		ID:        uuid.New(),
+		VehicleID: vehicle.ID,
+		Type:      event.TypeRestoration,
+		Title:     "Engine rebuild",
+		Date:      time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Location:  &location,
+		Metadata:  map[string]interface{}{"workshop": "Garage Lda"},
+	}
+	certifier := &entity.Entity{ID: uuid.New(), Type: entity.TypeCertifier}
+	partner := &entity.Entity{ID: uuid.New(), Type: entity.TypePartner}
+
+	f := &fixture{
+		repo:      newMockRepo(requests...),
+		events:    &mockEventService{events: map[uuid.UUID]*event.Event{ownerEvt.ID: ownerEvt}},
+		evidence:  &mockEvidence{},
+		vehicle:   vehicle,
+		ownerID:   ownerID,
+		ownerEvt:  ownerEvt,
+		certifier: certifier,
+		partner:   partner,
+	}
+	entities := &mockEntityService{entities: map[uuid.UUID]*entity.Entity{certifier.ID: certifier, partner.ID: partner}}
+	f.svc = NewService(f.repo, &mockVehicleService{vehicle: vehicle}, f.events, entities, f.evidence, &mockTransactor{})
+	return f
+}
+
+func (f *fixture) submit(t *testing.T, evidenceSessionID *uuid.UUID) *Request {
+	t.Helper()
+	r, err := f.svc.Submit(context.Background(), *f.vehicle, f.ownerEvt.ID, SubmitParams{
+		EntityID:          f.certifier.ID,
+		RequestedBy:       f.ownerID,
+		EvidenceSessionID: evidenceSessionID,
+	})
+	require.NoError(t, err)
+	return r
+}
+
+// --- Tests ---
+
+func TestService_Submit(t *testing.T) {
+	f := newFixture()
+	sessionID := uuid.New()
+	message := "  Invoices attached  "
+
+	r, err := f.svc.Submit(context.Background(), *f.vehicle, f.ownerEvt.ID, SubmitParams{
+		EntityID:          f.certifier.ID,
+		RequestedBy:       f.ownerID,
+		EvidenceSessionID: &sessionID,
+		Message:           &message,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, r.Status)
+	assert.Equal(t, f.ownerEvt.ID, r.EventID)
+	assert.Equal(t, f.certifier.ID, r.EntityID)
+	assert.Equal(t, &sessionID, r.EvidenceSessionID)
+	assert.Equal(t, "Invoices attached", *r.Message)
+}
+
+func TestService_Submit_AlreadyPending(t *testing.T) {
+	f := newFixture()
+	f.submit(t, nil)
+
+	_, err := f.svc.Submit(context.Background(), *f.vehicle, f.ownerEvt.ID, SubmitParams{
+		EntityID:    f.certifier.ID,
+		RequestedBy: f.ownerID,
+	})
+	assert.ErrorIs(t, err, ErrRequestAlreadyPending)
+}
+
+func TestService_Submit_Rejected(t *testing.T) {
+	t.Run("certified event", func(t *testing.T) {
+		f := newFixture()
+		f.ownerEvt.EntityID = &f.certifier.ID
+
+		_, err := f.svc.Submit(context.Background(), *f.vehicle, f.ownerEvt.ID, SubmitParams{EntityID: f.certifier.ID})
+		assert.ErrorIs(t, err, ErrNotOwnerEvent)
+	})
+
+	t.Run("event of another vehicle", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.svc.Submit(context.Background(), vehicles.Vehicle{ID: uuid.New()}, f.ownerEvt.ID, SubmitParams{EntityID: f.certifier.ID})
+		assert.ErrorIs(t, err, event.ErrEventNotFound)
+	})
+
+	t.Run("partner entity", func(t *testing.T) {
+		f := newFixture()
+
+		_, err := f.svc.Submit(context.Background(), *f.vehicle, f.ownerEvt.ID, SubmitParams{EntityID: f.partner.ID})
+		assert.ErrorIs(t, err, ErrNotCertifier)
+	})
+
+	t.Run("unconfirmed evidence", func(t *testing.T) {
+		f := newFixture()
+		f.evidence.err = event_images.ErrImageNotConfirmed
+		sessionID := uuid.New()
+
+		_, err := f.svc.Submit(context.Background(), *f.vehicle, f.ownerEvt.ID, SubmitParams{
+			EntityID:          f.certifier.ID,
+			EvidenceSessionID: &sessionID,
+		})
+		assert.ErrorIs(t, err, event_images.ErrImageNotConfirmed)
+	})
+}
+
+func TestService_Endorse_CreatesCertifiedEvent(t *testing.T) {
+	f := newFixture()
+	sessionID := uuid.New()
+	r := f.submit(t, &sessionID)
+	reviewer := uuid.New()
+
+	endorsed, err := f.svc.Endorse(context.Background(), f.certifier.ID, r.ID, reviewer, map[string]interface{}{"inspector": "J. Silva"})
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusEndorsed, endorsed.Status)
+	assert.Equal(t, &reviewer, endorsed.DecidedBy)
+	require.NotNil(t, endorsed.CertifiedEventID)
+	assert.Equal(t, *endorsed.CertifiedEventID, f.repo.eventByRequest[r.ID])
+
+	require.Len(t, f.events.created, 1)
+	params := f.events.created[0]
+	assert.Equal(t, &f.certifier.ID, params.EntityID)
+	assert.Equal(t, f.ownerEvt.Type, params.Type)
+	assert.Equal(t, f.ownerEvt.Title, params.Title)
+	assert.Equal(t, f.ownerEvt.Date, *params.Date)
+	assert.Equal(t, f.ownerEvt.Location, params.Location)
+	assert.Equal(t, &sessionID, params.ImageSessionID)
+	assert.Equal(t, f.ownerEvt.ID.String(), params.Metadata["certifiesEventId"])
+	assert.Equal(t, r.ID.String(), params.Metadata["certificationRequestId"])
+	assert.Equal(t, "Garage Lda", params.Metadata["workshop"])
+	assert.Equal(t, "J. Silva", params.Metadata["inspector"])
+	assert.NotContains(t, f.ownerEvt.Metadata, "certifiesEventId")
+
+	_, err = f.svc.Endorse(context.Background(), f.certifier.ID, r.ID, reviewer, nil)
+	assert.ErrorIs(t, err, ErrRequestNotPending)
+}
+
+func TestService_Endorse_DropsFieldsOutsideTheSchema(t *testing.T) {
+	f := newFixture()
+	f.ownerEvt.Type = event.TypeCarShow
+	f.ownerEvt.Metadata = map[string]interface{}{"category": "Pre-war", "tableNumber": 12}
+	r := f.submit(t, nil)
+
+	_, err := f.svc.Endorse(context.Background(), f.certifier.ID, r.ID, uuid.New(), map[string]interface{}{"certificateNumber": "CS-2024-017"})
+
+	require.NoError(t, err)
+	require.Len(t, f.events.created, 1)
+	params := f.events.created[0]
+	assert.Equal(t, "Pre-war", params.Metadata["category"])
+	assert.Equal(t, "CS-2024-017", params.Metadata["certificateNumber"])
+	assert.NotContains(t, params.Metadata, "tableNumber")
+}
+
+func TestService_Endorse_OtherEntity(t *testing.T) {
+	f := newFixture()
+	r := f.submit(t, nil)
+
+	_, err := f.svc.Endorse(context.Background(), f.partner.ID, r.ID, uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrRequestNotFound)
+	assert.Empty(t, f.events.created)
+}
+
+func TestService_Endorse_OwnerChanged(t *testing.T) {
+	f := newFixture()
+	r := f.submit(t, nil)
+	newOwner := uuid.New()
+	f.vehicle.OwnerID = &newOwner
+
+	_, err := f.svc.Endorse(context.Background(), f.certifier.ID, r.ID, uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrOwnerChanged)
+	assert.Empty(t, f.events.created)
+}
+
+func TestService_Decline(t *testing.T) {
+	f := newFixture()
+	r := f.submit(t, nil)
+	reason := "  Not enough evidence "
+
+	declined, err := f.svc.Decline(context.Background(), f.certifier.ID, r.ID, uuid.New(), &reason)
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusDeclined, declined.Status)
+	assert.Equal(t, "Not enough evidence", *declined.DeclineReason)
+	assert.Nil(t, declined.CertifiedEventID)
+	assert.Empty(t, f.events.created)
+
+	_, err = f.svc.Endorse(context.Background(), f.certifier.ID, r.ID, uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrRequestNotPending)
+
+	// A declined request does not block asking again
+	f.submit(t, nil)
+}
+
+func TestCertification_StatusOn(t *testing.T) {
+	validUntil := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	c := Certification{Status: ValidityActive, ValidUntil: &validUntil}
+
+	assert.Equal(t, ValidityActive, c.StatusOn(time.Date(2025, 6, 30, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, ValidityExpired, c.StatusOn(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)))
+
+	c.ValidUntil = nil
+	assert.Equal(t, ValidityActive, c.StatusOn(time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	c.Status = ValidityRevoked
+	assert.Equal(t, ValidityRevoked, c.StatusOn(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestTracker_Track(t *testing.T) {
+	repo := newMockValidityRepo()
+	tracker := NewTracker(repo)
+	tracker.now = func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	entityID := uuid.New()
+	original := event.Event{
+		ID:        uuid.New(),
+		VehicleID: uuid.New(),
+		EntityID:  &entityID,
+		Type:      event.TypeCertification,
+		Kind:      event.KindOriginal,
+		Date:      time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC),
+		Metadata:  map[string]interface{}{"certificateNumber": "FIVA-123", "validityEndDate": "2025-03-14"},
+	}
+	require.NoError(t, tracker.Track(ctx, original))
+
+	c := repo.certs[original.ID]
+	require.NotNil(t, c)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), c.ValidFrom)
+	require.NotNil(t, c.ValidUntil)
+	assert.Equal(t, time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), *c.ValidUntil)
+	assert.Equal(t, "FIVA-123", *c.CertificateNumber)
+	assert.Equal(t, ValidityActive, c.Status)
+
+	// An amendment moves the window of the certification it revises
+	amendment := original
+	amendment.ID = uuid.New()
+	amendment.Kind = event.KindAmendment
+	amendment.RevisesEventID = &original.ID
+	amendment.Metadata = map[string]interface{}{"certificateNumber": "FIVA-123", "validityEndDate": "2024-12-31"}
+	require.NoError(t, tracker.Track(ctx, amendment))
+
+	require.Len(t, repo.certs, 1)
+	assert.Equal(t, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), *repo.certs[original.ID].ValidUntil)
+	assert.Equal(t, ValidityExpired, repo.certs[original.ID].Status)
+
+	revocation := original
+	revocation.ID = uuid.New()
+	revocation.Kind = event.KindRevocation
+	revocation.RevisesEventID = &original.ID
+	require.NoError(t, tracker.Track(ctx, revocation))
+	assert.Equal(t, []uuid.UUID{original.ID}, repo.revoked)
+	assert.Equal(t, ValidityRevoked, repo.certs[original.ID].Status)
+}
+
+func TestTracker_Track_IgnoresOtherEvents(t *testing.T) {
+	repo := newMockValidityRepo()
+	tracker := NewTracker(repo)
+	entityID := uuid.New()
+
+	// Owner events carry no entity, so they certify nothing
+	require.NoError(t, tracker.Track(context.Background(), event.Event{ID: uuid.New(), Type: event.TypeCertification}))
+	require.NoError(t, tracker.Track(context.Background(), event.Event{ID: uuid.New(), EntityID: &entityID, Type: event.TypeMaintenance}))
+
+	assert.Empty(t, repo.certs)
+}
+
+func TestTracker_Track_UnparsableEndDate(t *testing.T) {
+	repo := newMockValidityRepo()
+	tracker := NewTracker(repo)
+	entityID := uuid.New()
+	evt := event.Event{
+		ID:       uuid.New(),
+		EntityID: &entityID,
+		Type:     event.TypeCertification,
+		Date:     time.Now(),
+		Metadata: map[string]interface{}{"validityEndDate": "end of next year"},
+	}
+
+	require.NoError(t, tracker.Track(context.Background(), evt))
+	assert.Nil(t, repo.certs[evt.ID].ValidUntil)
+}
+
+func TestExpiryJob_Run(t *testing.T) {
+	now := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	day := func(offset int) *time.Time {
+		d := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, offset)
+		return &d
+	}
+
+	ownerID := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &ownerID, Make: "Porsche", Model: "911", Year: 1973}
+	certifier := &entity.Entity{ID: uuid.New(), Name: "ACP", ContactEmail: "certs@acp.pt"}
+
+	ended := &Certification{EventID: uuid.New(), VehicleID: vehicle.ID, EntityID: certifier.ID, Status: ValidityActive, ValidUntil: day(-1)}
+	endingSoon := &Certification{EventID: uuid.New(), VehicleID: vehicle.ID, EntityID: certifier.ID, Status: ValidityActive, ValidUntil: day(5)}
+	endingLater := &Certification{EventID: uuid.New(), VehicleID: vehicle.ID, EntityID: certifier.ID, Status: ValidityActive, ValidUntil: day(90)}
+	repo := newMockValidityRepo(ended, endingSoon, endingLater)
+
+	mailer := &mockReminderMailer{}
+	job := NewExpiryJob(repo, &mockVehicleService{vehicle: vehicle},
+		&mockEntityService{entities: map[uuid.UUID]*entity.Entity{certifier.ID: certifier}},
+		&mockUsers{emails: map[uuid.UUID]string{ownerID: "owner@example.com"}},
+		mailer, ExpiryJobConfig{ReminderLeadDays: []int{30, 7, 1}})
+
+	expired, reminded := job.Run(context.Background(), now)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, 1, reminded)
+	assert.Equal(t, ValidityExpired, ended.Status)
+
+	// The closest due lead time is claimed, so the 30-day reminder is not sent as well
+	require.NotNil(t, endingSoon.LastReminderDays)
+	assert.Equal(t, 7, *endingSoon.LastReminderDays)
+	assert.ElementsMatch(t, []sentReminder{
+		{to: "certs@acp.pt", daysLeft: 5},
+		{to: "owner@example.com", daysLeft: 5},
+	}, mailer.sent)
+
+	// Reminders are sent once per lead time
+	expired, reminded = job.Run(context.Background(), now.Add(time.Hour))
+	assert.Zero(t, expired)
+	assert.Zero(t, reminded)
+
+	expired, reminded = job.Run(context.Background(), now.AddDate(0, 0, 4))
+	assert.Zero(t, expired)
+	assert.Equal(t, 1, reminded)
+	assert.Equal(t, 1, *endingSoon.LastReminderDays)
+}
@@ -0,0 +1,82 @@
+package certification
+
+import (
+	"context"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/google/uuid"
+)
+
+// ValidityRepository defines the data access interface for certification validity windows
+type ValidityRepository interface {
+	// Upsert stores the window of the certification recorded by c.EventID. Revoked certifications
+	// stay revoked, and moving the end of the window makes its reminders due again.
+	Upsert(ctx context.Context, c Certification) (*Certification, error)
+	Revoke(ctx context.Context, eventID uuid.UUID) error
+	ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Certification, error)
+	// Expire moves active certifications whose window ended before today to expired
+	Expire(ctx context.Context, today time.Time) ([]Certification, error)
+	// ClaimReminders marks the active certifications ending within leadDays of today that have not
+	// had a reminder at that lead time or closer, and returns them
+	ClaimReminders(ctx context.Context, today time.Time, leadDays int) ([]Certification, error)
+}
+
+// Tracker keeps the validity windows of certifications in step with the events that record them
+type Tracker struct {
+	repo ValidityRepository
+	now  func() time.Time
+}
+
+// NewTracker creates a new certification validity tracker
+func NewTracker(repo ValidityRepository) *Tracker {
+	return &Tracker{repo: repo, now: time.Now}
+}
+
+// Track updates the certification recorded by an entity's certification event, or by the original
+// event an amendment or revocation revises. Other events are ignored.
+func (t *Tracker) Track(ctx context.Context, evt event.Event) error {
+	if evt.Type != event.TypeCertification || evt.EntityID == nil {
+		return nil
+	}
+
+	eventID := evt.ID
+	if evt.RevisesEventID != nil {
+		eventID = *evt.RevisesEventID
+	}
+	if evt.Kind == event.KindRevocation {
+		return t.repo.Revoke(ctx, eventID)
+	}
+
+	validFrom, validUntil := ValidityOf(evt)
+	c := Certification{
+		EventID:    eventID,
+		VehicleID:  evt.VehicleID,
+		EntityID:   *evt.EntityID,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+	if number, ok := evt.Metadata["certificateNumber"].(string); ok && number != "" {
+		c.CertificateNumber = &number
+	}
+	c.Status = c.StatusOn(t.now())
+
+	_, err := t.repo.Upsert(ctx, c)
+	return err
+}
+
+// ListByVehicle retrieves the certifications of a vehicle with their status as of today, most
+// recent first
+func (t *Tracker) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Certification, error) {
+	certs, err := t.repo.ListByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Certifications that ended since the expiry job last ran are reported as expired already
+	today := t.now()
+	for i := range certs {
+		certs[i].Status = certs[i].StatusOn(today)
+	}
+	return certs, nil
+}
@@ -0,0 +1,62 @@
+package certification
+
+import (
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/google/uuid"
+)
+
+const (
+	ValidityActive  = "active"
+	ValidityExpired = "expired"
+	ValidityRevoked = "revoked"
+)
+
+// Certification tracks the validity window of a certification event issued by an entity. It is
+// valid from the event date through ValidUntil, or indefinitely when ValidUntil is nil.
+type Certification struct {
+	ID                uuid.UUID  `json:"id"`
+	EventID           uuid.UUID  `json:"eventId"`
+	VehicleID         uuid.UUID  `json:"vehicleId"`
+	EntityID          uuid.UUID  `json:"entityId"`
+	CertificateNumber *string    `json:"certificateNumber,omitempty"`
+	ValidFrom         time.Time  `json:"validFrom"`
+	ValidUntil        *time.Time `json:"validUntil,omitempty"`
+	Status            string     `json:"status"`
+	// LastReminderDays is the shortest reminder lead time already sent for the current window
+	LastReminderDays *int      `json:"lastReminderDays,omitempty"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// StatusOn returns the status of the certification on the given day
+func (c Certification) StatusOn(day time.Time) string {
+	if c.Status == ValidityRevoked {
+		return ValidityRevoked
+	}
+	if c.ValidUntil != nil && c.ValidUntil.Before(dateOf(day)) {
+		return ValidityExpired
+	}
+	return ValidityActive
+}
+
+// ValidityOf parses the validity window of a certification event. The window starts on the event
+// date and ends on the validityEndDate of its metadata, if it has a valid one.
+func ValidityOf(evt event.Event) (time.Time, *time.Time) {
+	validFrom := dateOf(evt.Date)
+
+	end, ok := evt.Metadata["validityEndDate"].(string)
+	if !ok {
+		return validFrom, nil
+	}
+	validUntil, err := time.Parse(time.DateOnly, end)
+	if err != nil {
+		return validFrom, nil
+	}
+	return validFrom, &validUntil
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,71 @@
+package custody
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWalletNotLinked   = errors.New("no wallet linked to the account")
+	ErrChallengeNotFound = errors.New("wallet challenge not found or expired")
+	ErrInvalidSignature  = errors.New("challenge signature does not match the wallet")
+	ErrWalletInUse       = errors.New("wallet is already linked to another account")
+	ErrAssetNotCreated   = errors.New("vehicle has no asset on chain yet")
+	ErrNotOptedIn        = errors.New("wallet has not opted in to the vehicle asset")
+	ErrNoOwner           = errors.New("vehicle has no owner")
+)
+
+const (
+	// StatusPlatform means the asset is held by the platform account
+	StatusPlatform = "platform"
+	// StatusOwner means the asset is held by the owner's linked wallet
+	StatusOwner   = "owner"
+	StatusPending = "pending"
+	StatusFailed  = "failed"
+
+	// SubjectSync is the outbox subject of custody transfers, handled by the anchor worker
+	SubjectSync = "anchor.custody"
+
+	challengeExpiry = 10 * time.Minute
+)
+
+// Wallet is an Algorand address an owner proved control of by signing a challenge
+type Wallet struct {
+	UserID   uuid.UUID `json:"userId"`
+	Address  string    `json:"address"`
+	LinkedAt time.Time `json:"linkedAt"`
+}
+
+// Challenge is a one-time message the owner signs with the wallet being linked
+type Challenge struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"userId"`
+	Address   string    `json:"address"`
+	Message   string    `json:"message"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Custody records who holds a vehicle's asset. HolderAddress is nil while the platform holds it.
+type Custody struct {
+	VehicleID     uuid.UUID `json:"vehicleId"`
+	HolderAddress *string   `json:"holderAddress,omitempty"`
+	Status        string    `json:"status"`
+	TxID          *string   `json:"txId,omitempty"`
+	Error         *string   `json:"error,omitempty"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// CreateChallengeParams represents parameters for storing a wallet challenge
+type CreateChallengeParams struct {
+	UserID    uuid.UUID
+	Address   string
+	Message   string
+	ExpiresAt time.Time
+}
+
+// SyncJob asks the worker to move the vehicle's asset to whoever should hold it
+type SyncJob struct {
+	VehicleID uuid.UUID `json:"vehicleId"`
+}
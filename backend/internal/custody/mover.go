@@ -0,0 +1,147 @@
+package custody
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	"github.com/google/uuid"
+)
+
+// VehicleRepository loads the vehicles whose assets are moved
+type VehicleRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+}
+
+// Mover performs queued custody transfers on chain. It runs in the anchor worker.
+type Mover struct {
+	repo     Repository
+	vehicles VehicleRepository
+	chain    Chain
+}
+
+// NewMover creates a new custody mover
+func NewMover(repo Repository, vehicles VehicleRepository, chain Chain) *Mover {
+	return &Mover{
+		repo:     repo,
+		vehicles: vehicles,
+		chain:    chain,
+	}
+}
+
+// Sync moves the vehicle's asset to its owner's linked wallet when that wallet has opted in, and
+// to the platform account otherwise. The chain decides who currently holds the asset, so a
+// redelivered job that already moved it only records the outcome.
+func (m *Mover) Sync(ctx context.Context, vehicleID uuid.UUID) error {
+	vehicle, err := m.vehicles.GetByID(ctx, vehicleID)
+	if err != nil {
+		return err
+	}
+
+	if vehicle.BlockchainAssetID == nil {
+		// Nothing to move yet; genesis creates the asset in the platform account
+		_, err := m.repo.SetHolder(ctx, vehicleID, nil, StatusPlatform, nil)
+		return err
+	}
+	if vehicle.LifecycleStatus == vehicles.LifecycleScrapped {
+		// The asset was returned to the platform account and destroyed when the vehicle was scrapped
+		_, err := m.repo.SetHolder(ctx, vehicleID, nil, StatusPlatform, nil)
+		return err
+	}
+	assetID, err := vehicleAssetID(vehicle)
+	if err != nil {
+		return err
+	}
+
+	platform := m.chain.Address()
+	target, err := m.targetHolder(ctx, vehicle, assetID)
+	if err != nil {
+		return err
+	}
+
+	current, err := m.currentHolder(ctx, vehicleID, assetID)
+	if err != nil {
+		return err
+	}
+
+	var txID *string
+	if current != target {
+		var id string
+		if current == platform {
+			id, err = m.chain.TransferAsset(ctx, assetID, target, 1, anchorer.CustodyNote())
+		} else {
+			id, err = m.chain.ClawbackAsset(ctx, assetID, current, target, 1, anchorer.CustodyNote())
+		}
+		if err != nil {
+			return fmt.Errorf("move asset %d to %s: %w", assetID, target, err)
+		}
+		txID = &id
+	}
+
+	if target == platform {
+		_, err = m.repo.SetHolder(ctx, vehicleID, nil, StatusPlatform, txID)
+	} else {
+		_, err = m.repo.SetHolder(ctx, vehicleID, &target, StatusOwner, txID)
+	}
+	return err
+}
+
+// Fail records that the custody transfer of the vehicle was given up on
+func (m *Mover) Fail(ctx context.Context, vehicleID uuid.UUID, cause error) error {
+	return m.repo.SetFailed(ctx, vehicleID, cause.Error())
+}
+
+// targetHolder returns the owner's linked wallet when it can receive the asset, and the platform
+// account otherwise
+func (m *Mover) targetHolder(ctx context.Context, vehicle *vehicles.Vehicle, assetID uint64) (string, error) {
+	platform := m.chain.Address()
+	if vehicle.OwnerID == nil {
+		return platform, nil
+	}
+
+	wallet, err := m.repo.GetWallet(ctx, *vehicle.OwnerID)
+	if err != nil {
+		if errors.Is(err, ErrWalletNotLinked) {
+			return platform, nil
+		}
+		return "", err
+	}
+
+	holding, err := m.chain.AssetHolding(ctx, wallet.Address, assetID)
+	if err != nil {
+		return "", fmt.Errorf("check wallet opt-in: %w", err)
+	}
+	if !holding.OptedIn {
+		return platform, nil
+	}
+	return wallet.Address, nil
+}
+
+// currentHolder returns the account holding the asset, checking the recorded holder first and
+// asking the indexer only when the record is stale
+func (m *Mover) currentHolder(ctx context.Context, vehicleID uuid.UUID, assetID uint64) (string, error) {
+	recorded := m.chain.Address()
+	c, err := m.repo.GetCustody(ctx, vehicleID)
+	if err != nil {
+		return "", err
+	}
+	if c != nil && c.HolderAddress != nil {
+		recorded = *c.HolderAddress
+	}
+
+	holding, err := m.chain.AssetHolding(ctx, recorded, assetID)
+	if err != nil {
+		return "", fmt.Errorf("check holding of %s: %w", recorded, err)
+	}
+	if holding.Amount > 0 {
+		return recorded, nil
+	}
+
+	holder, err := m.chain.AssetHolder(ctx, assetID)
+	if err != nil {
+		return "", fmt.Errorf("find holder of asset %d: %w", assetID, err)
+	}
+	return holder, nil
+}
@@ -0,0 +1,231 @@
+package custody
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for wallets and vehicle custody
+type Repository interface {
+	CreateChallenge(ctx context.Context, params CreateChallengeParams) (*Challenge, error)
+	// GetActiveChallenge returns the newest unused, unexpired challenge for the address, or
+	// ErrChallengeNotFound
+	GetActiveChallenge(ctx context.Context, userID uuid.UUID, address string) (*Challenge, error)
+	// UseChallenge returns ErrChallengeNotFound when the challenge was already used
+	UseChallenge(ctx context.Context, id uuid.UUID) error
+
+	// UpsertWallet returns ErrWalletInUse when the address is linked to another account
+	UpsertWallet(ctx context.Context, userID uuid.UUID, address string) (*Wallet, error)
+	GetWallet(ctx context.Context, userID uuid.UUID) (*Wallet, error)
+	DeleteWallet(ctx context.Context, userID uuid.UUID) error
+
+	// GetCustody returns nil without error when the vehicle has no custody record
+	GetCustody(ctx context.Context, vehicleID uuid.UUID) (*Custody, error)
+	MarkPending(ctx context.Context, vehicleID uuid.UUID) (*Custody, error)
+	// SetHolder records the holder of the asset; a nil txID keeps the previous transaction
+	SetHolder(ctx context.Context, vehicleID uuid.UUID, holder *string, status string, txID *string) (*Custody, error)
+	SetFailed(ctx context.Context, vehicleID uuid.UUID, reason string) error
+}
+
+// Chain is the set of ledger operations used to move vehicle assets
+type Chain interface {
+	Address() string
+	AssetHolding(ctx context.Context, address string, assetID uint64) (*algorand.AssetHolding, error)
+	AssetHolder(ctx context.Context, assetID uint64) (string, error)
+	TransferAsset(ctx context.Context, assetID uint64, recipient string, amount uint64, note []byte) (string, error)
+	ClawbackAsset(ctx context.Context, assetID uint64, holder, recipient string, amount uint64, note []byte) (string, error)
+}
+
+// HoldingReader checks wallet holdings. The service only reads the chain; transfers are made by
+// the Mover in the worker.
+type HoldingReader interface {
+	AssetHolding(ctx context.Context, address string, assetID uint64) (*algorand.AssetHolding, error)
+}
+
+// Transactor runs a function inside a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service links owner wallets and queues the transfers that keep each vehicle's asset in the
+// wallet of its owner
+type Service struct {
+	repo       Repository
+	chain      HoldingReader
+	publisher  queue.Publisher
+	transactor Transactor
+}
+
+// NewService creates a new custody service. Custody transfers are published within the
+// transaction that requests them, so publisher is expected to be the outbox.
+func NewService(repo Repository, chain HoldingReader, publisher queue.Publisher, transactor Transactor) *Service {
+	return &Service{
+		repo:       repo,
+		chain:      chain,
+		publisher:  publisher,
+		transactor: transactor,
+	}
+}
+
+// CreateChallenge issues the message the user signs with the wallet to link it
+func (s *Service) CreateChallenge(ctx context.Context, userID uuid.UUID, address string) (*Challenge, error) {
+	if err := algorand.ValidateAddress(address); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate challenge nonce: %w", err)
+	}
+
+	expiresAt := time.Now().Add(challengeExpiry).UTC()
+	message := fmt.Sprintf("ClassicsChain wallet link\naddress: %s\nnonce: %s\nexpires: %s",
+		address, hex.EncodeToString(nonce), expiresAt.Format(time.RFC3339))
+
+	return s.repo.CreateChallenge(ctx, CreateChallengeParams{
+		UserID:    userID,
+		Address:   address,
+		Message:   message,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// LinkWallet links the address to the user once they signed the latest challenge for it with the
+// wallet. A previously linked wallet is replaced; assets already delivered to it stay there until
+// the next custody transfer of the vehicle.
+func (s *Service) LinkWallet(ctx context.Context, userID uuid.UUID, address, signature string) (*Wallet, error) {
+	challenge, err := s.repo.GetActiveChallenge(ctx, userID, address)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || !algorand.VerifySignedBytes(address, []byte(challenge.Message), sig) {
+		return nil, ErrInvalidSignature
+	}
+
+	var wallet *Wallet
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.UseChallenge(ctx, challenge.ID); err != nil {
+			return err
+		}
+		var err error
+		wallet, err = s.repo.UpsertWallet(ctx, userID, address)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet, nil
+}
+
+// GetWallet retrieves the user's linked wallet
+func (s *Service) GetWallet(ctx context.Context, userID uuid.UUID) (*Wallet, error) {
+	return s.repo.GetWallet(ctx, userID)
+}
+
+// UnlinkWallet removes the user's linked wallet
+func (s *Service) UnlinkWallet(ctx context.Context, userID uuid.UUID) error {
+	return s.repo.DeleteWallet(ctx, userID)
+}
+
+// GetCustody retrieves who holds the vehicle's asset. Vehicles without a record are held by the
+// platform.
+func (s *Service) GetCustody(ctx context.Context, vehicleID uuid.UUID) (*Custody, error) {
+	c, err := s.repo.GetCustody(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return &Custody{VehicleID: vehicleID, Status: StatusPlatform}, nil
+	}
+	return c, nil
+}
+
+// RequestDelivery queues the transfer of the vehicle's asset to its owner's linked wallet, which
+// must have opted in to the asset. Only active vehicles are delivered.
+func (s *Service) RequestDelivery(ctx context.Context, vehicle *vehicles.Vehicle) (*Custody, error) {
+	if vehicle.OwnerID == nil {
+		return nil, ErrNoOwner
+	}
+	if !vehicle.IsActive() {
+		return nil, vehicles.ErrVehicleNotActive
+	}
+	assetID, err := vehicleAssetID(vehicle)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, *vehicle.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	holding, err := s.chain.AssetHolding(ctx, wallet.Address, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("check wallet opt-in: %w", err)
+	}
+	if !holding.OptedIn {
+		return nil, ErrNotOptedIn
+	}
+
+	var pending *Custody
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pending, err = s.queueSync(ctx, vehicle.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pending, nil
+}
+
+// OwnershipChanged queues the transfer of the vehicle's asset to its new owner's wallet, or back
+// to the platform when the new owner has no wallet that can receive it. It is meant to be called
+// within the transaction that changes the owner.
+func (s *Service) OwnershipChanged(ctx context.Context, vehicleID uuid.UUID) error {
+	_, err := s.queueSync(ctx, vehicleID)
+	return err
+}
+
+func (s *Service) queueSync(ctx context.Context, vehicleID uuid.UUID) (*Custody, error) {
+	pending, err := s.repo.MarkPending(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobData, err := json.Marshal(SyncJob{VehicleID: vehicleID})
+	if err != nil {
+		return nil, fmt.Errorf("marshal custody job: %w", err)
+	}
+	if err := s.publisher.Publish(ctx, SubjectSync, jobData); err != nil {
+		return nil, fmt.Errorf("publish custody job: %w", err)
+	}
+
+	return pending, nil
+}
+
+func vehicleAssetID(vehicle *vehicles.Vehicle) (uint64, error) {
+	if vehicle.BlockchainAssetID == nil {
+		return 0, ErrAssetNotCreated
+	}
+	assetID, err := strconv.ParseUint(*vehicle.BlockchainAssetID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse asset ID %q: %w", *vehicle.BlockchainAssetID, err)
+	}
+	return assetID, nil
+}
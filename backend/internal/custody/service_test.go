@@ -0,0 +1,378 @@
+package custody
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger/simulated"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockRepo struct {
+	challenges []*Challenge
+	used       map[uuid.UUID]bool
+	wallets    map[uuid.UUID]Wallet
+	custody    map[uuid.UUID]Custody
+}
+
+func newMockRepo() *mockRepo {
+	return &mockRepo{
+		used:    map[uuid.UUID]bool{},
+		wallets: map[uuid.UUID]Wallet{},
+		custody: map[uuid.UUID]Custody{},
+	}
+}
+
+func (m *mockRepo) CreateChallenge(_ context.Context, params CreateChallengeParams) (*Challenge, error) {
+	c := &Challenge{ID: uuid.New(), UserID: params.UserID, Address: params.Address, Message: params.Message, ExpiresAt: params.ExpiresAt}
+	m.challenges = append(m.challenges, c)
+	copied := *c
+	return &copied, nil
+}
+
+func (m *mockRepo) GetActiveChallenge(_ context.Context, userID uuid.UUID, address string) (*Challenge, error) {
+	for i := len(m.challenges) - 1; i >= 0; i-- {
+		c := m.challenges[i]
+		if c.UserID == userID && c.Address == address && !m.used[c.ID] && time.Now().Before(c.ExpiresAt) {
+			copied := *c
+			return &copied, nil
+		}
+	}
+	return nil, ErrChallengeNotFound
+}
+
+func (m *mockRepo) UseChallenge(_ context.Context, id uuid.UUID) error {
+	if m.used[id] {
+		return ErrChallengeNotFound
+	}
+	m.used[id] = true
+	return nil
+}
+
+func (m *mockRepo) UpsertWallet(_ context.Context, userID uuid.UUID, address string) (*Wallet, error) {
+	for id, w := range m.wallets {
+		if id != userID && w.Address == address {
+			return nil, ErrWalletInUse
+		}
+	}
+	w := Wallet{UserID: userID, Address: address, LinkedAt: time.Now()}
+	m.wallets[userID] = w
+	return &w, nil
+}
+
+func (m *mockRepo) GetWallet(_ context.Context, userID uuid.UUID) (*Wallet, error) {
+	w, ok := m.wallets[userID]
+	if !ok {
+		return nil, ErrWalletNotLinked
+	}
+	return &w, nil
+}
+
+func (m *mockRepo) DeleteWallet(_ context.Context, userID uuid.UUID) error {
+	if _, ok := m.wallets[userID]; !ok {
+		return ErrWalletNotLinked
+	}
+	delete(m.wallets, userID)
+	return nil
+}
+
+func (m *mockRepo) GetCustody(_ context.Context, vehicleID uuid.UUID) (*Custody, error) {
+	c, ok := m.custody[vehicleID]
+	if !ok {
+		return nil, nil
+	}
+	return &c, nil
+}
+
+func (m *mockRepo) MarkPending(_ context.Context, vehicleID uuid.UUID) (*Custody, error) {
+	c := m.custody[vehicleID]
+	c.VehicleID = vehicleID
+	c.Status = StatusPending
+	c.Error = nil
+	m.custody[vehicleID] = c
+	return &c, nil
+}
+
+func (m *mockRepo) SetHolder(_ context.Context, vehicleID uuid.UUID, holder *string, status string, txID *string) (*Custody, error) {
+	c := m.custody[vehicleID]
+	c.VehicleID = vehicleID
+	c.HolderAddress = holder
+	c.Status = status
+	if txID != nil {
+		c.TxID = txID
+	}
+	c.Error = nil
+	m.custody[vehicleID] = c
+	return &c, nil
+}
+
+func (m *mockRepo) SetFailed(_ context.Context, vehicleID uuid.UUID, reason string) error {
+	c := m.custody[vehicleID]
+	c.Status = StatusFailed
+	c.Error = &reason
+	m.custody[vehicleID] = c
+	return nil
+}
+
+type mockVehicles struct {
+	vehicles map[uuid.UUID]*vehicles.Vehicle
+}
+
+func (m *mockVehicles) GetByID(_ context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
+	v, ok := m.vehicles[id]
+	if !ok {
+		return nil, vehicles.ErrVehicleNotFound
+	}
+	copied := *v
+	return &copied, nil
+}
+
+type mockPublisher struct {
+	subjects []string
+	jobs     []SyncJob
+}
+
+func (m *mockPublisher) Publish(_ context.Context, subject string, data []byte) error {
+	var job SyncJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return err
+	}
+	m.subjects = append(m.subjects, subject)
+	m.jobs = append(m.jobs, job)
+	return nil
+}
+
+func (m *mockPublisher) Close() error { return nil }
+
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// --- Helpers ---
+
+type fixture struct {
+	repo      *mockRepo
+	vehicles  *mockVehicles
+	publisher *mockPublisher
+	ledger    *simulated.Ledger
+	svc       *Service
+	mover     *Mover
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ledger, err := simulated.New(simulated.Config{})
+	require.NoError(t, err)
+
+	f := &fixture{
+		repo:      newMockRepo(),
+		vehicles:  &mockVehicles{vehicles: map[uuid.UUID]*vehicles.Vehicle{}},
+		publisher: &mockPublisher{},
+		ledger:    ledger,
+	}
+	f.svc = NewService(f.repo, ledger, f.publisher, mockTransactor{})
+	f.mover = NewMover(f.repo, f.vehicles, ledger)
+	return f
+}
+
+// addVehicle creates an owned vehicle whose asset is held by the platform
+func (f *fixture) addVehicle(t *testing.T, ownerID uuid.UUID) (*vehicles.Vehicle, uint64) {
+	t.Helper()
+	ctx := context.Background()
+	stxn, err := f.ledger.SignAssetCreation(ctx, algorand.AssetParams{AssetName: "CC_vehicle", UnitName: "CCV", Total: 1})
+	require.NoError(t, err)
+	created, err := f.ledger.SubmitAssetCreation(ctx, stxn)
+	require.NoError(t, err)
+
+	assetID := strconv.FormatUint(created.AssetID, 10)
+	v := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &ownerID, BlockchainAssetID: &assetID}
+	f.vehicles.vehicles[v.ID] = v
+	return v, created.AssetID
+}
+
+// linkWallet links a wallet derived from name to the user by signing a challenge
+func (f *fixture) linkWallet(t *testing.T, userID uuid.UUID, name string) string {
+	t.Helper()
+	address, key := testWallet(name)
+	challenge, err := f.svc.CreateChallenge(context.Background(), userID, address)
+	require.NoError(t, err)
+	_, err = f.svc.LinkWallet(context.Background(), userID, address, sign(t, key, challenge.Message))
+	require.NoError(t, err)
+	return address
+}
+
+func (f *fixture) holder(t *testing.T, assetID uint64) string {
+	t.Helper()
+	holder, err := f.ledger.AssetHolder(context.Background(), assetID)
+	require.NoError(t, err)
+	return holder
+}
+
+func testWallet(name string) (string, ed25519.PrivateKey) {
+	seed := sha256.Sum256([]byte(name))
+	key := ed25519.NewKeyFromSeed(seed[:])
+	return types.Address(key.Public().(ed25519.PublicKey)).String(), key
+}
+
+func sign(t *testing.T, key ed25519.PrivateKey, message string) string {
+	t.Helper()
+	sig, err := crypto.SignBytes(key, []byte(message))
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+// --- Tests ---
+
+func TestService_LinkWallet(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	address, key := testWallet("owner")
+
+	challenge, err := f.svc.CreateChallenge(ctx, userID, address)
+	require.NoError(t, err)
+	assert.Contains(t, challenge.Message, address)
+
+	_, otherKey := testWallet("other")
+	_, err = f.svc.LinkWallet(ctx, userID, address, sign(t, otherKey, challenge.Message))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	wallet, err := f.svc.LinkWallet(ctx, userID, address, sign(t, key, challenge.Message))
+	require.NoError(t, err)
+	assert.Equal(t, address, wallet.Address)
+
+	// Challenges are used once
+	_, err = f.svc.LinkWallet(ctx, userID, address, sign(t, key, challenge.Message))
+	assert.ErrorIs(t, err, ErrChallengeNotFound)
+}
+
+func TestService_CreateChallenge_InvalidAddress(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.svc.CreateChallenge(context.Background(), uuid.New(), "not-an-address")
+	assert.ErrorIs(t, err, algorand.ErrInvalidAddress)
+}
+
+func TestService_RequestDelivery_MovesAssetToOwnerWallet(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	ownerID := uuid.New()
+	vehicle, assetID := f.addVehicle(t, ownerID)
+
+	_, err := f.svc.RequestDelivery(ctx, vehicle)
+	assert.ErrorIs(t, err, ErrWalletNotLinked)
+
+	wallet := f.linkWallet(t, ownerID, "owner")
+	_, err = f.svc.RequestDelivery(ctx, vehicle)
+	assert.ErrorIs(t, err, ErrNotOptedIn)
+
+	require.NoError(t, f.ledger.OptIn(ctx, wallet, assetID))
+	pending, err := f.svc.RequestDelivery(ctx, vehicle)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, pending.Status)
+	require.Len(t, f.publisher.jobs, 1)
+	assert.Equal(t, SubjectSync, f.publisher.subjects[0])
+	assert.Equal(t, vehicle.ID, f.publisher.jobs[0].VehicleID)
+
+	require.NoError(t, f.mover.Sync(ctx, vehicle.ID))
+	assert.Equal(t, wallet, f.holder(t, assetID))
+
+	custody, err := f.svc.GetCustody(ctx, vehicle.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusOwner, custody.Status)
+	assert.Equal(t, wallet, *custody.HolderAddress)
+	require.NotNil(t, custody.TxID)
+
+	// A redelivered job finds the asset already in place
+	txID := *custody.TxID
+	require.NoError(t, f.mover.Sync(ctx, vehicle.ID))
+	custody, err = f.svc.GetCustody(ctx, vehicle.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txID, *custody.TxID)
+}
+
+func TestService_RequestDelivery_Preconditions(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.svc.RequestDelivery(context.Background(), &vehicles.Vehicle{ID: uuid.New()})
+	assert.ErrorIs(t, err, ErrNoOwner)
+
+	ownerID := uuid.New()
+	_, err = f.svc.RequestDelivery(context.Background(), &vehicles.Vehicle{ID: uuid.New(), OwnerID: &ownerID})
+	assert.ErrorIs(t, err, ErrAssetNotCreated)
+
+	_, err = f.svc.RequestDelivery(context.Background(), &vehicles.Vehicle{ID: uuid.New(), OwnerID: &ownerID, LifecycleStatus: vehicles.LifecycleStolen})
+	assert.ErrorIs(t, err, vehicles.ErrVehicleNotActive)
+}
+
+func TestMover_OwnershipChange_ClawsBackToNewOwner(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	sellerID, buyerID := uuid.New(), uuid.New()
+	vehicle, assetID := f.addVehicle(t, sellerID)
+
+	seller := f.linkWallet(t, sellerID, "seller")
+	require.NoError(t, f.ledger.OptIn(ctx, seller, assetID))
+	require.NoError(t, f.mover.Sync(ctx, vehicle.ID))
+	assert.Equal(t, seller, f.holder(t, assetID))
+
+	buyer := f.linkWallet(t, buyerID, "buyer")
+	require.NoError(t, f.ledger.OptIn(ctx, buyer, assetID))
+	f.vehicles.vehicles[vehicle.ID].OwnerID = &buyerID
+
+	require.NoError(t, f.svc.OwnershipChanged(ctx, vehicle.ID))
+	require.NoError(t, f.mover.Sync(ctx, vehicle.ID))
+	assert.Equal(t, buyer, f.holder(t, assetID))
+
+	custody, err := f.svc.GetCustody(ctx, vehicle.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusOwner, custody.Status)
+	assert.Equal(t, buyer, *custody.HolderAddress)
+}
+
+func TestMover_OwnershipChange_ReturnsToPlatformWithoutWallet(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	sellerID, buyerID := uuid.New(), uuid.New()
+	vehicle, assetID := f.addVehicle(t, sellerID)
+
+	seller := f.linkWallet(t, sellerID, "seller")
+	require.NoError(t, f.ledger.OptIn(ctx, seller, assetID))
+	require.NoError(t, f.mover.Sync(ctx, vehicle.ID))
+
+	f.vehicles.vehicles[vehicle.ID].OwnerID = &buyerID
+	require.NoError(t, f.svc.OwnershipChanged(ctx, vehicle.ID))
+	require.NoError(t, f.mover.Sync(ctx, vehicle.ID))
+	assert.Equal(t, f.ledger.Address(), f.holder(t, assetID))
+
+	custody, err := f.svc.GetCustody(ctx, vehicle.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPlatform, custody.Status)
+	assert.Nil(t, custody.HolderAddress)
+}
+
+func TestService_GetCustody_DefaultsToPlatform(t *testing.T) {
+	f := newFixture(t)
+	vehicleID := uuid.New()
+
+	custody, err := f.svc.GetCustody(context.Background(), vehicleID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPlatform, custody.Status)
+	assert.Nil(t, custody.HolderAddress)
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +25,14 @@ type Entity struct {
 	LogoObjectKey *string    `json:"logoObjectKey,omitempty"`
 	CreatedAt     time.Time  `json:"createdAt"`
 	UpdatedAt     time.Time  `json:"updatedAt"`
+	// OwnerApprovalEventTypes lists the event types the entity issues only with the vehicle owner's approval
+	OwnerApprovalEventTypes []string `json:"ownerApprovalEventTypes"`
+}
+
+// RequiresOwnerApproval reports whether events of the given type issued by the entity need the
+// vehicle owner's approval before they become part of the vehicle's record
+func (e Entity) RequiresOwnerApproval(eventType string) bool {
+	return slices.Contains(e.OwnerApprovalEventTypes, eventType)
 }
 
 // EntityType represents the type of entity
@@ -61,4 +70,6 @@ type UpdateEntityParams struct {
 	ContactEmail *string
 	Website      *string
 	Address      *Address
+	// OwnerApprovalEventTypes replaces the event types that need the vehicle owner's approval
+	OwnerApprovalEventTypes *[]string
 }
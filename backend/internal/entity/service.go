@@ -143,6 +143,9 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, params UpdateEntityP
 	if params.Address != nil {
 		entity.Address = params.Address
 	}
+	if params.OwnerApprovalEventTypes != nil {
+		entity.OwnerApprovalEventTypes = *params.OwnerApprovalEventTypes
+	}
 	entity.UpdatedAt = time.Now()
 
 	if err := s.repo.Update(ctx, entity); err != nil {
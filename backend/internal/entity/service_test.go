@@ -485,6 +485,29 @@ func TestService_Update_AllFields(t *testing.T) {
 	assert.Equal(t, "Lisbon", result.Address.City)
 }
 
+func TestService_Update_OwnerApprovalEventTypes(t *testing.T) {
+	entity := &Entity{ID: uuid.New(), Name: "Club", OwnerApprovalEventTypes: []string{"rally"}}
+
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Entity, error) {
+			copy := *entity
+			return &copy, nil
+		},
+	}
+	svc := newService(repo, nil, nil, nil)
+
+	result, err := svc.Update(context.Background(), entity.ID, UpdateEntityParams{
+		OwnerApprovalEventTypes: &[]string{"car_show", "classic_meet"},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.RequiresOwnerApproval("car_show"))
+	assert.False(t, result.RequiresOwnerApproval("rally"))
+
+	result, err = svc.Update(context.Background(), entity.ID, UpdateEntityParams{Name: ptr("Renamed")})
+	require.NoError(t, err)
+	assert.True(t, result.RequiresOwnerApproval("rally")) // unchanged
+}
+
 func TestService_Update_NotFound(t *testing.T) {
 	svc := newService(nil, nil, nil, nil)
 	_, err := svc.Update(context.Background(), uuid.New(), UpdateEntityParams{})
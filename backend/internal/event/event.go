@@ -2,13 +2,27 @@ package event
 
 import (
 	"errors"
+	"strings"
 	"time"
 
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
 	"github.com/google/uuid"
 )
 
 var (
-	ErrEventNotFound = errors.New("event not found")
+	ErrEventNotFound        = errors.New("event not found")
+	ErrAnchorNotRequeueable = errors.New("event anchoring is not pending or failed")
+	ErrEventImmutable       = errors.New("event is anchored and can only be amended")
+	ErrNotOriginalEvent     = errors.New("amendments and revocations cannot be revised")
+	ErrEventRevoked         = errors.New("event has been revoked")
+	ErrEventNotCertified    = errors.New("only certified events can be revoked")
+	ErrReasonRequired       = errors.New("a reason is required")
+	ErrEventNotProposed     = errors.New("event is not awaiting the owner's approval")
+	ErrEventNotOnRecord     = errors.New("event is not part of the vehicle's record")
+	ErrUnknownEventType     = errors.New("unknown event type")
+	ErrEventNotSignable     = errors.New("only entity events that entered the vehicle's chain can be signed")
+	ErrEventAlreadySigned   = errors.New("event is already signed")
+	ErrReservedEventType    = errors.New("lifecycle changes and ownership transfers are only recorded by the platform")
 )
 
 // Event represents a vehicle history event in the system
@@ -26,12 +40,64 @@ type Event struct {
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
 	BlockchainTxID *string                `json:"blockchainTxId,omitempty"`
 	BlockchainStatus string               `json:"blockchainStatus"`
+	BlockchainError  *string              `json:"blockchainError,omitempty"`
+	BlockchainStatusAt time.Time          `json:"blockchainStatusAt"`
 	CID            *string                `json:"cid,omitempty"`
 	CIDSourceJSON  *string                `json:"cidSourceJson,omitempty"`
 	CIDSourceCBOR  *string                `json:"cidSourceCbor,omitempty"`
+	PreviousCID    *string                `json:"previousCid,omitempty"`
+	// MerkleProof is set when the event was anchored as part of a Merkle root instead of by its own transaction
+	MerkleProof    *merkle.Proof          `json:"merkleProof,omitempty"`
 	CreatedAt      time.Time              `json:"createdAt"`
+	// Kind tells original events apart from the amendments and revocations recorded against them.
+	// Revisions point at the original through RevisesEventID and carry the reason given for them.
+	Kind           Kind                   `json:"kind"`
+	RevisesEventID *uuid.UUID             `json:"revisesEventId,omitempty"`
+	Reason         *string                `json:"reason,omitempty"`
+	// AmendedAt, RevokedAt and RevocationReason are set on the effective view of an original event
+	AmendedAt        *time.Time           `json:"amendedAt,omitempty"`
+	RevokedAt        *time.Time           `json:"revokedAt,omitempty"`
+	RevocationReason *string              `json:"revocationReason,omitempty"`
+	// ApprovalStatus tracks the owner's consent to an entity event that required it
+	ApprovalStatus    ApprovalStatus `json:"approvalStatus"`
+	ApprovalDecidedAt *time.Time     `json:"approvalDecidedAt,omitempty"`
+	// Signature is the issuing entity's Ed25519 signature over the CID, made with the key SigningKeyID
+	SigningKeyID *uuid.UUID `json:"signingKeyId,omitempty"`
+	Signature    *string    `json:"signature,omitempty"`
+	SignedAt     *time.Time `json:"signedAt,omitempty"`
 }
 
+// OnRecord reports whether the event is part of the vehicle's history: it either did not need the
+// owner's approval or the owner accepted it
+func (e Event) OnRecord() bool {
+	return e.ApprovalStatus != ApprovalProposed && e.ApprovalStatus != ApprovalRejected
+}
+
+// RecordedByPlatform reports whether the platform recorded the event itself, on its own or on an
+// entity's request. Such events are public and anchored even when they have no issuing entity.
+func (e Event) RecordedByPlatform() bool {
+	return e.Type.IsReserved()
+}
+
+// ApprovalStatus represents the owner's decision on a proposed event
+type ApprovalStatus string
+
+const (
+	ApprovalNotRequired ApprovalStatus = "not_required"
+	ApprovalProposed    ApprovalStatus = "proposed"
+	ApprovalAccepted    ApprovalStatus = "accepted"
+	ApprovalRejected    ApprovalStatus = "rejected"
+)
+
+// Kind distinguishes original events from their revisions
+type Kind string
+
+const (
+	KindOriginal   Kind = "original"
+	KindAmendment  Kind = "amendment"
+	KindRevocation Kind = "revocation"
+)
+
 // EventType represents the type of vehicle history event
 type EventType string
 
@@ -52,8 +118,63 @@ const (
 	TypeOwnershipTransfer EventType = "ownership_transfer"
 	TypeRestoration       EventType = "restoration"
 	TypeModification      EventType = "modification"
+	TypeLifecycleChange   EventType = "lifecycle_change"
 )
 
+var builtInTypes = map[EventType]bool{
+	TypeCertification:     true,
+	TypeCarShow:           true,
+	TypeClassicMeet:       true,
+	TypeRally:             true,
+	TypeVintageRacing:     true,
+	TypeAuction:           true,
+	TypeWorkshop:          true,
+	TypeClubCompetition:   true,
+	TypeRoadTrip:          true,
+	TypeFestival:          true,
+	TypeRaceParticipation: true,
+	TypeShowParticipation: true,
+	TypeMaintenance:       true,
+	TypeOwnershipTransfer: true,
+	TypeRestoration:       true,
+	TypeModification:      true,
+	TypeLifecycleChange:   true,
+}
+
+// IsReserved reports whether events of the type are only recorded by the platform itself, so
+// they can neither be created through the API nor revised
+func (t EventType) IsReserved() bool {
+	return t == TypeLifecycleChange || t == TypeOwnershipTransfer
+}
+
+// customTypeSeparator separates the issuing entity from the key in the name of a custom event type
+const customTypeSeparator = ":"
+
+// IsBuiltIn reports whether the type is one of the event types known to every entity
+func (t EventType) IsBuiltIn() bool {
+	return builtInTypes[t]
+}
+
+// CustomEventType returns the name of an event type registered by an entity. Custom types are
+// namespaced by the entity so that keys chosen by different entities never collide.
+func CustomEventType(entityID uuid.UUID, key string) EventType {
+	return EventType(entityID.String() + customTypeSeparator + key)
+}
+
+// CustomTypeOwner splits the name of a custom event type into the entity that registered it and
+// its key
+func (t EventType) CustomTypeOwner() (uuid.UUID, string, bool) {
+	prefix, key, found := strings.Cut(string(t), customTypeSeparator)
+	if !found || key == "" {
+		return uuid.Nil, "", false
+	}
+	entityID, err := uuid.Parse(prefix)
+	if err != nil {
+		return uuid.Nil, "", false
+	}
+	return entityID, key, true
+}
+
 // CreateEventParams represents parameters for creating a new event
 // If Date is nil, it will be set to the current time (NOW() UTC) by the service
 type CreateEventParams struct {
@@ -67,6 +188,20 @@ type CreateEventParams struct {
 	Location       *string
 	Metadata       map[string]interface{}
 	ImageSessionID *uuid.UUID
+	// RequiresOwnerApproval creates the event as a proposal that is only anchored once the vehicle
+	// owner accepts it
+	RequiresOwnerApproval bool
+}
+
+// AmendEventParams represents the corrections made to an event. Fields left nil keep their
+// current effective value.
+type AmendEventParams struct {
+	Title       *string
+	Description *string
+	Date        *time.Time
+	Location    *string
+	Metadata    map[string]interface{}
+	Reason      *string
 }
 
 // UpdateEventParams represents parameters for updating an existing event
@@ -1,11 +1,24 @@
 package event
 
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+var ErrInvalidMetadata = errors.New("invalid event metadata")
+
 // CertificationMetadata contains vehicle certification-specific metadata
 type CertificationMetadata struct {
-	CertificateNumber         string   `json:"certificateNumber"`
-	ConditionAssessment       string   `json:"conditionAssessment"`
-	ValidityEndDate           *string  `json:"validityEndDate,omitempty"`
-	DocumentationReferences   []string `json:"documentationReferences,omitempty"`
+	CertificateNumber       string   `json:"certificateNumber"`
+	ConditionAssessment     string   `json:"conditionAssessment"`
+	ValidityEndDate         *string  `json:"validityEndDate,omitempty" format:"date"`
+	DocumentationReferences []string `json:"documentationReferences,omitempty"`
 }
 
 // CarShowMetadata contains car show & concours d'elegance event metadata
@@ -52,32 +65,320 @@ type AuctionMetadata struct {
 
 // WorkshopMetadata contains restoration workshop & seminar event metadata
 type WorkshopMetadata struct {
-	CertificateNumber       string `json:"certificateNumber"`
-	WorkshopTopic           string `json:"workshopTopic"`
-	InstructorName          string `json:"instructorName,omitempty"`
-	CompletionAcknowledgment bool  `json:"completionAcknowledgment"`
+	CertificateNumber        string `json:"certificateNumber"`
+	WorkshopTopic            string `json:"workshopTopic"`
+	InstructorName           string `json:"instructorName,omitempty"`
+	CompletionAcknowledgment bool   `json:"completionAcknowledgment"`
 }
 
 // ClubCompetitionMetadata contains club competition & awards event metadata
 type ClubCompetitionMetadata struct {
-	CertificateNumber string `json:"certificateNumber"`
+	CertificateNumber   string `json:"certificateNumber"`
 	CompetitionCategory string `json:"competitionCategory"`
-	AwardTitle        string `json:"awardTitle"`
-	ClubChapter       string `json:"clubChapter,omitempty"`
+	AwardTitle          string `json:"awardTitle"`
+	ClubChapter         string `json:"clubChapter,omitempty"`
 }
 
 // RoadTripMetadata contains road trip & heritage drive event metadata
 type RoadTripMetadata struct {
-	CertificateNumber string   `json:"certificateNumber"`
-	RouteName         string   `json:"routeName"`
-	TotalDistance     *int     `json:"totalDistance,omitempty"`
+	CertificateNumber    string   `json:"certificateNumber"`
+	RouteName            string   `json:"routeName"`
+	TotalDistance        *int     `json:"totalDistance,omitempty"`
 	CheckpointsCompleted []string `json:"checkpointsCompleted,omitempty"`
-	DateRange         *string  `json:"dateRange,omitempty"`
+	DateRange            *string  `json:"dateRange,omitempty"`
 }
 
 // FestivalMetadata contains classic car festival event metadata
 type FestivalMetadata struct {
-	CertificateNumber string   `json:"certificateNumber"`
-	FestivalTheme     string   `json:"festivalTheme"`
+	CertificateNumber        string   `json:"certificateNumber"`
+	FestivalTheme            string   `json:"festivalTheme"`
 	ActivitiesParticipatedIn []string `json:"activitiesParticipatedIn,omitempty"`
 }
+
+// LifecycleChangeMetadata contains the lifecycle statuses a vehicle moved between
+type LifecycleChangeMetadata struct {
+	FromStatus string `json:"fromStatus"`
+	ToStatus   string `json:"toStatus"`
+}
+
+// Reference fields link an event to the record it was recorded from. Only the platform sets them,
+// each on the events recorded from its own kind of record, so metadata given through the API may
+// not carry them.
+const (
+	MetadataTransferID             = "transferId"
+	MetadataCertifiesEventID       = "certifiesEventId"
+	MetadataCertificationRequestID = "certificationRequestId"
+	MetadataLifecycleRequestID     = "lifecycleRequestId"
+)
+
+var (
+	// transferReferenceFields are set on ownership_transfer events
+	transferReferenceFields = []string{MetadataTransferID}
+	// lifecycleReferenceFields are set on lifecycle_change events
+	lifecycleReferenceFields = []string{MetadataLifecycleRequestID}
+	// certificationReferenceFields are set on certified events, which keep the type of the owner
+	// event they certify
+	certificationReferenceFields = []string{MetadataCertifiesEventID, MetadataCertificationRequestID}
+
+	referenceFields = slices.Concat(transferReferenceFields, lifecycleReferenceFields, certificationReferenceFields)
+)
+
+// metadataTypes maps event types to the struct describing their metadata. Types without an entry
+// accept free-form metadata.
+var metadataTypes = map[EventType]any{
+	TypeCertification:   CertificationMetadata{},
+	TypeCarShow:         CarShowMetadata{},
+	TypeClassicMeet:     ClassicMeetMetadata{},
+	TypeRally:           RallyMetadata{},
+	TypeVintageRacing:   VintageRacingMetadata{},
+	TypeAuction:         AuctionMetadata{},
+	TypeWorkshop:        WorkshopMetadata{},
+	TypeClubCompetition: ClubCompetitionMetadata{},
+	TypeRoadTrip:        RoadTripMetadata{},
+	TypeFestival:        FestivalMetadata{},
+	TypeLifecycleChange: LifecycleChangeMetadata{},
+}
+
+var metadataSchemas = buildMetadataSchemas()
+
+// MetadataFieldType is the kind of value a metadata field holds
+type MetadataFieldType string
+
+const (
+	FieldString     MetadataFieldType = "string"
+	FieldInteger    MetadataFieldType = "integer"
+	FieldBoolean    MetadataFieldType = "boolean"
+	FieldStringList MetadataFieldType = "string_array"
+	// FieldDate holds a calendar date formatted as YYYY-MM-DD
+	FieldDate MetadataFieldType = "date"
+)
+
+// MetadataField describes a single field of an event type's metadata
+type MetadataField struct {
+	Name     string            `json:"name"`
+	Type     MetadataFieldType `json:"type"`
+	Required bool              `json:"required"`
+}
+
+// MetadataSchema lists the metadata fields accepted for an event type
+type MetadataSchema struct {
+	EventType EventType       `json:"eventType"`
+	Fields    []MetadataField `json:"fields"`
+}
+
+// MetadataFieldError reports why a single metadata field was rejected
+type MetadataFieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// MetadataValidationError lists every field that does not match the schema of the event type
+type MetadataValidationError struct {
+	EventType EventType
+	Fields    []MetadataFieldError
+}
+
+func (e *MetadataValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return fmt.Sprintf("invalid %s metadata: %s", e.EventType, strings.Join(msgs, "; "))
+}
+
+func (e *MetadataValidationError) Unwrap() error {
+	return ErrInvalidMetadata
+}
+
+// MetadataSchemas returns the metadata schemas of every event type that has one, ordered by event type
+func MetadataSchemas() []MetadataSchema {
+	schemas := make([]MetadataSchema, 0, len(metadataSchemas))
+	for _, schema := range metadataSchemas {
+		schemas = append(schemas, schema)
+	}
+	slices.SortFunc(schemas, func(a, b MetadataSchema) int {
+		return strings.Compare(string(a.EventType), string(b.EventType))
+	})
+	return schemas
+}
+
+// MetadataSchemaFor returns the metadata schema of an event type
+func MetadataSchemaFor(eventType EventType) (MetadataSchema, bool) {
+	schema, ok := metadataSchemas[eventType]
+	return schema, ok
+}
+
+// ValidateMetadata checks metadata against the schema of its event type. Unknown fields, missing
+// required fields and values of the wrong type are reported together in a *MetadataValidationError.
+// Optional fields may be left empty; event types without a schema accept any metadata. Reference
+// fields are not part of any schema.
+func ValidateMetadata(eventType EventType, metadata map[string]interface{}) error {
+	schema, ok := metadataSchemas[eventType]
+	if !ok {
+		return nil
+	}
+
+	var fieldErrs []MetadataFieldError
+	known := make(map[string]bool, len(schema.Fields))
+	for _, field := range schema.Fields {
+		known[field.Name] = true
+
+		value, present := metadata[field.Name]
+		if !present || value == nil || value == "" {
+			if field.Required {
+				fieldErrs = append(fieldErrs, MetadataFieldError{Field: field.Name, Message: "is required"})
+			}
+			continue
+		}
+		if msg := checkFieldValue(field.Type, value); msg != "" {
+			fieldErrs = append(fieldErrs, MetadataFieldError{Field: field.Name, Message: msg})
+		}
+	}
+
+	var unknown []string
+	for name := range metadata {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	for _, name := range unknown {
+		fieldErrs = append(fieldErrs, MetadataFieldError{Field: name, Message: "is not a known field"})
+	}
+
+	if len(fieldErrs) > 0 {
+		return &MetadataValidationError{EventType: eventType, Fields: fieldErrs}
+	}
+	return nil
+}
+
+// checkReferenceFields rejects the reference fields in metadata other than the allowed ones
+func checkReferenceFields(eventType EventType, metadata map[string]interface{}, allowed []string) error {
+	var fieldErrs []MetadataFieldError
+	for _, name := range referenceFields {
+		if _, present := metadata[name]; present && !slices.Contains(allowed, name) {
+			fieldErrs = append(fieldErrs, MetadataFieldError{Field: name, Message: "is only set by the platform"})
+		}
+	}
+	if len(fieldErrs) > 0 {
+		return &MetadataValidationError{EventType: eventType, Fields: fieldErrs}
+	}
+	return nil
+}
+
+// withoutReferenceFields returns the metadata without its reference fields, which are checked
+// apart from the schema of the event type
+func withoutReferenceFields(metadata map[string]interface{}) map[string]interface{} {
+	if metadata == nil {
+		return nil
+	}
+	stripped := maps.Clone(metadata)
+	for _, name := range referenceFields {
+		delete(stripped, name)
+	}
+	return stripped
+}
+
+// withReferenceFields returns metadata with the reference fields of the original event carried
+// over, so revisions keep linking to the record the original was recorded from
+func withReferenceFields(metadata, original map[string]interface{}) map[string]interface{} {
+	carried := maps.Clone(metadata)
+	for _, name := range referenceFields {
+		if value, ok := original[name]; ok {
+			if carried == nil {
+				carried = map[string]interface{}{}
+			}
+			carried[name] = value
+		}
+	}
+	return carried
+}
+
+func checkFieldValue(fieldType MetadataFieldType, value interface{}) string {
+	switch fieldType {
+	case FieldString:
+		if _, ok := value.(string); !ok {
+			return "must be a string"
+		}
+	case FieldDate:
+		s, ok := value.(string)
+		if !ok {
+			return "must be a date (YYYY-MM-DD)"
+		}
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			return "must be a date (YYYY-MM-DD)"
+		}
+	case FieldBoolean:
+		if _, ok := value.(bool); !ok {
+			return "must be a boolean"
+		}
+	case FieldInteger:
+		if !isInteger(value) {
+			return "must be an integer"
+		}
+	case FieldStringList:
+		switch list := value.(type) {
+		case []string:
+		case []interface{}:
+			for _, item := range list {
+				if _, ok := item.(string); !ok {
+					return "must be a list of strings"
+				}
+			}
+		default:
+			return "must be a list of strings"
+		}
+	}
+	return ""
+}
+
+// isInteger accepts both Go integers and the float64 values JSON numbers decode into
+func isInteger(value interface{}) bool {
+	switch v := value.(type) {
+	case int, int32, int64:
+		return true
+	case float64:
+		return v == math.Trunc(v) && !math.IsInf(v, 0)
+	}
+	return false
+}
+
+func buildMetadataSchemas() map[EventType]MetadataSchema {
+	schemas := make(map[EventType]MetadataSchema, len(metadataTypes))
+	for eventType, metadata := range metadataTypes {
+		t := reflect.TypeOf(metadata)
+		fields := make([]MetadataField, 0, t.NumField())
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			name, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+			fields = append(fields, MetadataField{
+				Name:     name,
+				Type:     metadataFieldType(sf),
+				Required: !strings.Contains(opts, "omitempty"),
+			})
+		}
+		schemas[eventType] = MetadataSchema{EventType: eventType, Fields: fields}
+	}
+	return schemas
+}
+
+func metadataFieldType(sf reflect.StructField) MetadataFieldType {
+	if sf.Tag.Get("format") == "date" {
+		return FieldDate
+	}
+
+	t := sf.Type
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return FieldBoolean
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return FieldInteger
+	case reflect.Slice:
+		return FieldStringList
+	default:
+		return FieldString
+	}
+}
@@ -0,0 +1,39 @@
+package event
+
+import "github.com/google/uuid"
+
+// ApplyRevisions returns the effective view of an original event given its revisions, which must
+// be ordered oldest first. Each amendment carries the complete corrected content, so the latest
+// one wins; a revocation marks the event as revoked without changing its content.
+func ApplyRevisions(original Event, revisions []Event) Event {
+	effective := original
+	for _, r := range revisions {
+		switch r.Kind {
+		case KindAmendment:
+			effective.Title = r.Title
+			effective.Description = r.Description
+			effective.Date = r.Date
+			effective.Location = r.Location
+			effective.Metadata = r.Metadata
+			amendedAt := r.CreatedAt
+			effective.AmendedAt = &amendedAt
+		case KindRevocation:
+			revokedAt := r.CreatedAt
+			effective.RevokedAt = &revokedAt
+			effective.RevocationReason = r.Reason
+		}
+	}
+	return effective
+}
+
+// groupRevisions indexes revisions by the event they revise, keeping their order
+func groupRevisions(revisions []Event) map[uuid.UUID][]Event {
+	grouped := make(map[uuid.UUID][]Event)
+	for _, r := range revisions {
+		if r.RevisesEventID == nil {
+			continue
+		}
+		grouped[*r.RevisesEventID] = append(grouped[*r.RevisesEventID], r)
+	}
+	return grouped
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
 	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
@@ -15,17 +18,36 @@ import (
 const (
 	SubjectEventAnchor = "anchor.event"
 
-	StatusNone    = "none"
-	StatusPending = "pending"
+	StatusNone     = "none"
+	StatusPending  = "pending"
+	StatusAnchored = "anchored"
+	StatusFailed   = "failed"
 )
 
 // Repository defines the data access interface for events
 type Repository interface {
 	GetByVehicle(ctx context.Context, vehicleID uuid.UUID, limit, offset int) ([]Event, int, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*Event, error)
+	ListRevisions(ctx context.Context, eventID uuid.UUID) ([]Event, error)
+	ListRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Event, error)
 	Create(ctx context.Context, event Event) (*Event, error)
 	Update(ctx context.Context, event Event) error
+	// UpdateDetails and Delete return ErrEventImmutable when the event was submitted for anchoring
+	UpdateDetails(ctx context.Context, event Event) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// DecideProposal returns ErrEventNotProposed when the event is no longer awaiting the owner's
+	// approval
+	DecideProposal(ctx context.Context, id uuid.UUID, status ApprovalStatus, decidedAt time.Time) error
+	ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Event, int, error)
+	LockChainHead(ctx context.Context, vehicleID uuid.UUID) (*string, error)
+	SetGenesisCID(ctx context.Context, vehicleID uuid.UUID, cid, sourceJSON, sourceCBOR string) error
+	SetChainHead(ctx context.Context, vehicleID uuid.UUID, cid string) error
+	SetSignature(ctx context.Context, eventID, keyID uuid.UUID, signature string) error
+}
+
+// Transactor runs a function inside a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type CIDGenerator interface {
@@ -35,6 +57,54 @@ type CIDGenerator interface {
 type EventImageService interface {
 	ValidateSessionForEvent(ctx context.Context, sessionID uuid.UUID) ([]string, error)
 	AttachToEvent(ctx context.Context, sessionID, eventID uuid.UUID) error
+	ListCIDsByEvent(ctx context.Context, eventID uuid.UUID) ([]string, error)
+}
+
+// UserDirectory resolves the contact email of a user
+type UserDirectory interface {
+	GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// ProposalMailer notifies vehicle owners of events awaiting their approval
+type ProposalMailer interface {
+	SendEventProposal(ctx context.Context, to string, vehicleID, eventID uuid.UUID, vehicle invitation.VehicleInfo, eventTitle string) error
+}
+
+// CustomTypeRegistry resolves the event types registered by entities
+type CustomTypeRegistry interface {
+	// Lookup returns ErrUnknownEventType when no entity registered the type
+	Lookup(ctx context.Context, eventType EventType) (*CustomTypeInfo, error)
+	// ValidateMetadata returns a *MetadataValidationError when the metadata does not match the
+	// schema of the type
+	ValidateMetadata(ctx context.Context, eventType EventType, metadata map[string]interface{}) error
+}
+
+// CertificationTracker keeps the validity of certifications up to date as the events recording
+// them enter the vehicle's record
+type CertificationTracker interface {
+	Track(ctx context.Context, evt Event) error
+}
+
+// Signer signs event CIDs with the keys of the issuing entity
+type Signer interface {
+	// Sign signs the CID with the entity's managed key. It returns nil when the entity has no
+	// managed key, leaving the event to be signed client-side.
+	Sign(ctx context.Context, entityID uuid.UUID, cid string) (*Signature, error)
+	// Verify checks a client-side signature of the CID made with one of the entity's active keys
+	Verify(ctx context.Context, entityID, keyID uuid.UUID, cid, signature string) error
+}
+
+// Signature is an entity's signature over an event CID
+type Signature struct {
+	KeyID uuid.UUID
+	Value string
+}
+
+// CustomTypeInfo describes a registered custom event type
+type CustomTypeInfo struct {
+	// DefinitionCID is the CID of the type's key and metadata schema, recorded with its events
+	DefinitionCID string
+	Retired       bool
 }
 
 type EventAnchorJob struct {
@@ -50,6 +120,7 @@ type EventAnchorJob struct {
 // Mirrors anchorer.EventRecord but avoids the import cycle.
 type eventCIDRecord struct {
 	ID          uuid.UUID              `json:"id"`
+	PreviousCID *string                `json:"previousCid,omitempty"`
 	EntityID    *uuid.UUID             `json:"entityId,omitempty"`
 	Type        *string                `json:"type,omitempty"`
 	Title       *string                `json:"title,omitempty"`
@@ -59,12 +130,20 @@ type eventCIDRecord struct {
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 	ImageCIDs   []string               `json:"imageCids,omitempty"`
 	CreatedAt   time.Time              `json:"createdAt,omitempty"`
+	// Kind, RevisesEventID and Reason are only set for amendments and revocations, so the CIDs of
+	// original events are unchanged
+	Kind           *string    `json:"kind,omitempty"`
+	RevisesEventID *uuid.UUID `json:"revisesEventId,omitempty"`
+	Reason         *string    `json:"reason,omitempty"`
+	// TypeDefinitionCID is only set for custom event types, pinning the schema their metadata follows
+	TypeDefinitionCID *string `json:"typeDefinitionCid,omitempty"`
 }
 
-func newEventCIDRecord(e *Event, imageCIDs []string) eventCIDRecord {
+func newEventCIDRecord(e *Event, imageCIDs []string, typeDefinitionCID *string) eventCIDRecord {
 	eventType := string(e.Type)
-	return eventCIDRecord{
+	record := eventCIDRecord{
 		ID:          e.ID,
+		PreviousCID: e.PreviousCID,
 		EntityID:    e.EntityID,
 		Type:        &eventType,
 		Title:       &e.Title,
@@ -74,22 +153,39 @@ func newEventCIDRecord(e *Event, imageCIDs []string) eventCIDRecord {
 		Metadata:    e.Metadata,
 		ImageCIDs:   imageCIDs,
 		CreatedAt:   e.CreatedAt,
+
+		TypeDefinitionCID: typeDefinitionCID,
+	}
+	if e.Kind != "" && e.Kind != KindOriginal {
+		kind := string(e.Kind)
+		record.Kind = &kind
+		record.RevisesEventID = e.RevisesEventID
+		record.Reason = e.Reason
 	}
+	return record
 }
 
 // Service handles business logic for event management
 type Service struct {
 	repo              Repository
 	publisher         queue.Publisher
+	transactor        Transactor
 	cidGenerator      CIDGenerator
 	eventImageService EventImageService
+	users             UserDirectory
+	proposalMailer    ProposalMailer
+	customTypes       CustomTypeRegistry
+	certifications    CertificationTracker
+	signer            Signer
 }
 
-// NewService creates a new event service with all dependencies
-func NewService(repo Repository, publisher queue.Publisher, cidGenerator CIDGenerator) *Service {
+// NewService creates a new event service with all dependencies. Anchor jobs are published
+// within the transaction that creates the event, so publisher is expected to be the outbox.
+func NewService(repo Repository, publisher queue.Publisher, transactor Transactor, cidGenerator CIDGenerator) *Service {
 	return &Service{
 		repo:         repo,
 		publisher:    publisher,
+		transactor:   transactor,
 		cidGenerator: cidGenerator,
 	}
 }
@@ -99,9 +195,46 @@ func (s *Service) SetEventImageService(eis EventImageService) {
 	s.eventImageService = eis
 }
 
-// GetByVehicle retrieves events for a specific vehicle
+// SetProposalNotifier sets the dependencies used to email owners about proposed events (optional)
+func (s *Service) SetProposalNotifier(users UserDirectory, mailer ProposalMailer) {
+	s.users = users
+	s.proposalMailer = mailer
+}
+
+// SetCustomTypeRegistry sets the registry of entity-defined event types (optional). Without it only
+// built-in event types are accepted.
+func (s *Service) SetCustomTypeRegistry(registry CustomTypeRegistry) {
+	s.customTypes = registry
+}
+
+// SetCertificationTracker sets the tracker of certification validity windows (optional)
+func (s *Service) SetCertificationTracker(tracker CertificationTracker) {
+	s.certifications = tracker
+}
+
+// SetSigner sets the signer of entity events (optional). Without it events are only signed
+// client-side, and client signatures cannot be checked.
+func (s *Service) SetSigner(signer Signer) {
+	s.signer = signer
+}
+
+// GetByVehicle retrieves the effective view of the events of a specific vehicle
 func (s *Service) GetByVehicle(ctx context.Context, vehicleID uuid.UUID, limit, offset int) ([]Event, int, error) {
-	return s.repo.GetByVehicle(ctx, vehicleID, limit, offset)
+	events, total, err := s.repo.GetByVehicle(ctx, vehicleID, limit, offset)
+	if err != nil || len(events) == 0 {
+		return events, total, err
+	}
+
+	revisions, err := s.repo.ListRevisionsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, 0, err
+	}
+	byEvent := groupRevisions(revisions)
+	for i := range events {
+		events[i] = ApplyRevisions(events[i], byEvent[events[i].ID])
+	}
+
+	return events, total, nil
 }
 
 // GetByID retrieves an event by its ID
@@ -109,8 +242,85 @@ func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*Event, error) {
 	return s.repo.GetByID(ctx, id)
 }
 
-// Create creates a new event and optionally enqueues it for blockchain anchoring
+// GetWithRevisions retrieves the effective view of an event together with its amendment trail,
+// oldest first. Revisions themselves are returned as stored, with an empty trail.
+func (s *Service) GetWithRevisions(ctx context.Context, id uuid.UUID) (*Event, []Event, error) {
+	evt, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if evt.RevisesEventID != nil {
+		return evt, []Event{}, nil
+	}
+
+	revisions, err := s.repo.ListRevisions(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	effective := ApplyRevisions(*evt, revisions)
+	return &effective, revisions, nil
+}
+
+// Create creates a new event and optionally enqueues it for blockchain anchoring. The type must be
+// built in or a custom type registered by the issuing entity, and the metadata of entity events is
+// validated against the schema of their type. Reserved types are rejected.
 func (s *Service) Create(ctx context.Context, vehicle vehicles.Vehicle, params CreateEventParams) (*Event, error) {
+	if params.Type.IsReserved() {
+		return nil, ErrReservedEventType
+	}
+	return s.createOriginal(ctx, vehicle, params, nil)
+}
+
+// RecordCertified creates the anchored event an entity records when it endorses an owner event.
+// It is the only way events linking to the certification request they came from are created.
+func (s *Service) RecordCertified(ctx context.Context, vehicle vehicles.Vehicle, params CreateEventParams) (*Event, error) {
+	if params.EntityID == nil {
+		return nil, fmt.Errorf("certified events need an issuing entity")
+	}
+	if params.Type.IsReserved() {
+		return nil, ErrReservedEventType
+	}
+	params.ShouldAnchor = true
+	params.RequiresOwnerApproval = false
+	return s.createOriginal(ctx, vehicle, params, certificationReferenceFields)
+}
+
+// RecordLifecycleChange creates the anchored lifecycle_change event of an approved lifecycle
+// request. It is the only way events of that reserved type enter a vehicle's record.
+func (s *Service) RecordLifecycleChange(ctx context.Context, vehicle vehicles.Vehicle, params CreateEventParams) (*Event, error) {
+	return s.recordPlatformEvent(ctx, vehicle, TypeLifecycleChange, params, lifecycleReferenceFields)
+}
+
+// RecordOwnershipTransfer creates the anchored ownership_transfer event of an accepted transfer.
+// It is the only way events of that reserved type enter a vehicle's record.
+func (s *Service) RecordOwnershipTransfer(ctx context.Context, vehicle vehicles.Vehicle, params CreateEventParams) (*Event, error) {
+	return s.recordPlatformEvent(ctx, vehicle, TypeOwnershipTransfer, params, transferReferenceFields)
+}
+
+// recordPlatformEvent creates an anchored event of a reserved type, which needs no owner approval
+func (s *Service) recordPlatformEvent(ctx context.Context, vehicle vehicles.Vehicle, eventType EventType, params CreateEventParams, references []string) (*Event, error) {
+	params.Type = eventType
+	params.ShouldAnchor = true
+	params.RequiresOwnerApproval = false
+	return s.createOriginal(ctx, vehicle, params, references)
+}
+
+// createOriginal creates an original event whose metadata may carry the given reference fields
+func (s *Service) createOriginal(ctx context.Context, vehicle vehicles.Vehicle, params CreateEventParams, references []string) (*Event, error) {
+	if !params.Type.IsBuiltIn() {
+		info, err := s.lookupCustomType(ctx, params.EntityID, params.Type)
+		if err != nil {
+			return nil, err
+		}
+		if info.Retired {
+			return nil, ErrUnknownEventType
+		}
+	}
+	if err := s.validateMetadata(ctx, params.EntityID, params.Type, params.Metadata, references); err != nil {
+		return nil, err
+	}
+
 	var imageCIDs []string
 
 	if params.ImageSessionID != nil && s.eventImageService != nil {
@@ -135,62 +345,492 @@ func (s *Service) Create(ctx context.Context, vehicle vehicles.Vehicle, params C
 		Date:             eventDate,
 		Location:         params.Location,
 		Metadata:         params.Metadata,
+		Kind:             KindOriginal,
+		ApprovalStatus:   ApprovalNotRequired,
 		BlockchainStatus: StatusNone,
 	}
 
-	created, err := s.repo.Create(ctx, evt)
+	if params.RequiresOwnerApproval {
+		evt.ApprovalStatus = ApprovalProposed
+		created, err := s.create(ctx, vehicle, evt, params.ImageSessionID, imageCIDs, false)
+		if err != nil {
+			return nil, err
+		}
+		s.notifyProposal(ctx, vehicle, created)
+		return created, nil
+	}
+
+	return s.create(ctx, vehicle, evt, params.ImageSessionID, imageCIDs, params.ShouldAnchor)
+}
+
+// Accept records the owner's approval of a proposed event and submits it for anchoring
+func (s *Service) Accept(ctx context.Context, vehicle vehicles.Vehicle, eventID uuid.UUID) (*Event, error) {
+	if _, err := s.getProposal(ctx, vehicle, eventID); err != nil {
+		return nil, err
+	}
+
+	var imageCIDs []string
+	if s.eventImageService != nil {
+		var err error
+		imageCIDs, err = s.eventImageService.ListCIDsByEvent(ctx, eventID)
+		if err != nil {
+			return nil, fmt.Errorf("list event image CIDs: %w", err)
+		}
+	}
+
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// The decision locks the event, so it is re-read to anchor what was actually accepted
+		if err := s.repo.DecideProposal(ctx, eventID, ApprovalAccepted, time.Now().UTC()); err != nil {
+			return err
+		}
+		evt, err := s.repo.GetByID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.anchor(ctx, vehicle, evt, imageCIDs); err != nil {
+			return err
+		}
+		return s.track(ctx, *evt)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if params.ImageSessionID != nil && s.eventImageService != nil {
-		if err := s.eventImageService.AttachToEvent(ctx, *params.ImageSessionID, created.ID); err != nil {
-			return nil, fmt.Errorf("failed to attach images to event: %w", err)
+	return s.repo.GetByID(ctx, eventID)
+}
+
+// Reject records the owner's refusal of a proposed event. The event is kept but never anchored and
+// is not part of the vehicle's record.
+func (s *Service) Reject(ctx context.Context, vehicle vehicles.Vehicle, eventID uuid.UUID) (*Event, error) {
+	if _, err := s.getProposal(ctx, vehicle, eventID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DecideProposal(ctx, eventID, ApprovalRejected, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, eventID)
+}
+
+// getProposal loads an event of the vehicle that is awaiting the owner's approval
+func (s *Service) getProposal(ctx context.Context, vehicle vehicles.Vehicle, eventID uuid.UUID) (*Event, error) {
+	evt, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if evt.VehicleID != vehicle.ID {
+		return nil, ErrEventNotFound
+	}
+	if evt.ApprovalStatus != ApprovalProposed {
+		return nil, ErrEventNotProposed
+	}
+	return evt, nil
+}
+
+// notifyProposal emails the vehicle owner about a proposed event. The proposal is already stored
+// and listed for the owner, so a failed email is only logged.
+func (s *Service) notifyProposal(ctx context.Context, vehicle vehicles.Vehicle, evt *Event) {
+	if s.users == nil || s.proposalMailer == nil || vehicle.OwnerID == nil {
+		return
+	}
+
+	email, err := s.users.GetUserEmail(ctx, *vehicle.OwnerID)
+	if err != nil {
+		log.Printf("WARN: failed to look up owner of vehicle %s for event proposal: %v", vehicle.ID, err)
+		return
+	}
+
+	info := invitation.VehicleInfo{
+		Make:  vehicle.Make,
+		Model: vehicle.Model,
+		Year:  vehicle.Year,
+	}
+	if vehicle.LicensePlate != nil {
+		info.LicensePlate = *vehicle.LicensePlate
+	}
+	if err := s.proposalMailer.SendEventProposal(ctx, email, vehicle.ID, evt.ID, info, evt.Title); err != nil {
+		log.Printf("WARN: failed to send event proposal email for event %s: %v", evt.ID, err)
+	}
+}
+
+// Amend records a correction of an original event. The amendment holds the complete corrected
+// content and is anchored in the vehicle's chain whenever the original was, leaving the original
+// record untouched.
+func (s *Service) Amend(ctx context.Context, vehicle vehicles.Vehicle, eventID uuid.UUID, params AmendEventParams) (*Event, error) {
+	original, effective, err := s.getRevisable(ctx, vehicle, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	amendment := Event{
+		VehicleID:        original.VehicleID,
+		EntityID:         original.EntityID,
+		Type:             original.Type,
+		Title:            effective.Title,
+		Description:      effective.Description,
+		Date:             effective.Date,
+		Location:         effective.Location,
+		Metadata:         effective.Metadata,
+		Kind:             KindAmendment,
+		RevisesEventID:   &original.ID,
+		Reason:           params.Reason,
+		BlockchainStatus: StatusNone,
+	}
+	if params.Title != nil {
+		amendment.Title = *params.Title
+	}
+	if params.Description != nil {
+		amendment.Description = params.Description
+	}
+	if params.Date != nil {
+		amendment.Date = *params.Date
+	}
+	if params.Location != nil {
+		amendment.Location = params.Location
+	}
+	if params.Metadata != nil {
+		if err := s.validateMetadata(ctx, original.EntityID, original.Type, params.Metadata, nil); err != nil {
+			return nil, err
 		}
+		amendment.Metadata = withReferenceFields(params.Metadata, original.Metadata)
 	}
 
-	if params.ShouldAnchor {
-		record := newEventCIDRecord(created, imageCIDs)
-		cidData, err := s.cidGenerator.GenerateCID(record)
+	return s.create(ctx, vehicle, amendment, nil, nil, original.CID != nil)
+}
+
+// Revoke withdraws a certified event. The revocation and its reason are recorded as a new event
+// that is anchored in the vehicle's chain whenever the original was.
+func (s *Service) Revoke(ctx context.Context, vehicle vehicles.Vehicle, eventID uuid.UUID, reason string) (*Event, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	original, effective, err := s.getRevisable(ctx, vehicle, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if original.EntityID == nil {
+		return nil, ErrEventNotCertified
+	}
+
+	revocation := Event{
+		VehicleID:        original.VehicleID,
+		EntityID:         original.EntityID,
+		Type:             original.Type,
+		Title:            effective.Title,
+		Date:             time.Now().UTC(),
+		Kind:             KindRevocation,
+		RevisesEventID:   &original.ID,
+		Reason:           &reason,
+		BlockchainStatus: StatusNone,
+	}
+
+	return s.create(ctx, vehicle, revocation, nil, nil, original.CID != nil)
+}
+
+// getRevisable loads an original event of the vehicle that has not been revoked, along with its
+// effective view
+func (s *Service) getRevisable(ctx context.Context, vehicle vehicles.Vehicle, eventID uuid.UUID) (*Event, Event, error) {
+	original, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, Event{}, err
+	}
+	if original.VehicleID != vehicle.ID {
+		return nil, Event{}, ErrEventNotFound
+	}
+	if original.RevisesEventID != nil {
+		return nil, Event{}, ErrNotOriginalEvent
+	}
+	if original.Type.IsReserved() {
+		return nil, Event{}, ErrReservedEventType
+	}
+	if !original.OnRecord() {
+		return nil, Event{}, ErrEventNotOnRecord
+	}
+
+	revisions, err := s.repo.ListRevisions(ctx, eventID)
+	if err != nil {
+		return nil, Event{}, err
+	}
+	effective := ApplyRevisions(*original, revisions)
+	if effective.RevokedAt != nil {
+		return nil, Event{}, ErrEventRevoked
+	}
+
+	return original, effective, nil
+}
+
+// lookupCustomType resolves a custom event type, which only the entity that registered it may use
+func (s *Service) lookupCustomType(ctx context.Context, entityID *uuid.UUID, eventType EventType) (*CustomTypeInfo, error) {
+	owner, _, ok := eventType.CustomTypeOwner()
+	if !ok || entityID == nil || owner != *entityID || s.customTypes == nil {
+		return nil, ErrUnknownEventType
+	}
+	return s.customTypes.Lookup(ctx, eventType)
+}
+
+// validateMetadata checks the metadata of entity events against the schema of their type. Owner
+// events accept free-form metadata. Reference fields other than the given ones are rejected from
+// both.
+func (s *Service) validateMetadata(ctx context.Context, entityID *uuid.UUID, eventType EventType, metadata map[string]interface{}, references []string) error {
+	if err := checkReferenceFields(eventType, metadata, references); err != nil {
+		return err
+	}
+	if entityID == nil {
+		return nil
+	}
+	metadata = withoutReferenceFields(metadata)
+	if eventType.IsBuiltIn() {
+		return ValidateMetadata(eventType, metadata)
+	}
+	if s.customTypes == nil {
+		return ErrUnknownEventType
+	}
+	return s.customTypes.ValidateMetadata(ctx, eventType, metadata)
+}
+
+// create stores an event and, when shouldAnchor is set, links it into the vehicle's chain and
+// enqueues it for blockchain anchoring. The record of a scrapped vehicle is closed, as its asset
+// no longer exists.
+func (s *Service) create(ctx context.Context, vehicle vehicles.Vehicle, evt Event, imageSessionID *uuid.UUID, imageCIDs []string, shouldAnchor bool) (*Event, error) {
+	if vehicle.LifecycleStatus == vehicles.LifecycleScrapped {
+		return nil, vehicles.ErrVehicleScrapped
+	}
+
+	var created *Event
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.repo.Create(ctx, evt)
 		if err != nil {
-			return nil, fmt.Errorf("generate event CID: %w", err)
+			return err
 		}
 
-		created.CID = &cidData.CID
-		created.CIDSourceJSON = &cidData.SourceJSON
-		created.CIDSourceCBOR = &cidData.SourceCBOR
-		created.BlockchainStatus = StatusPending
+		if imageSessionID != nil && s.eventImageService != nil {
+			if err := s.eventImageService.AttachToEvent(ctx, *imageSessionID, created.ID); err != nil {
+				return fmt.Errorf("failed to attach images to event: %w", err)
+			}
+		}
+
+		if created.OnRecord() {
+			if err := s.track(ctx, *created); err != nil {
+				return err
+			}
+		}
 
-		if err := s.repo.Update(ctx, *created); err != nil {
-			return nil, fmt.Errorf("update event with CID: %w", err)
+		if !shouldAnchor {
+			return nil
 		}
+		return s.anchor(ctx, vehicle, created, imageCIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.GetByID(ctx, created.ID)
+	return result, err
+}
+
+// track passes an event that is part of the vehicle's record to the certification tracker
+func (s *Service) track(ctx context.Context, evt Event) error {
+	if s.certifications == nil {
+		return nil
+	}
+	if err := s.certifications.Track(ctx, evt); err != nil {
+		return fmt.Errorf("track certification: %w", err)
+	}
+	return nil
+}
+
+// anchor links a stored event into the vehicle's chain and enqueues it for blockchain anchoring.
+// It must run inside a transaction.
+func (s *Service) anchor(ctx context.Context, vehicle vehicles.Vehicle, evt *Event, imageCIDs []string) error {
+	// Link the event to the latest record of the vehicle; the lock keeps concurrent events from
+	// linking to the same record
+	previousCID, err := s.repo.LockChainHead(ctx, evt.VehicleID)
+	if err != nil {
+		return fmt.Errorf("lock chain head: %w", err)
+	}
+	if previousCID == nil {
+		// The vehicle was never submitted for anchoring, so its genesis CID is fixed now for the
+		// event to link to. Genesis anchors the stored CID later.
+		genesis, err := s.cidGenerator.GenerateCID(vehicles.GenesisCIDRecord(vehicle))
+		if err != nil {
+			return fmt.Errorf("generate vehicle genesis CID: %w", err)
+		}
+		if err := s.repo.SetGenesisCID(ctx, evt.VehicleID, genesis.CID, genesis.SourceJSON, genesis.SourceCBOR); err != nil {
+			return fmt.Errorf("set vehicle genesis CID: %w", err)
+		}
+		previousCID = &genesis.CID
+	}
+	evt.PreviousCID = previousCID
+
+	var typeDefinitionCID *string
+	if _, _, custom := evt.Type.CustomTypeOwner(); custom {
+		info, err := s.lookupCustomType(ctx, evt.EntityID, evt.Type)
+		if err != nil {
+			return fmt.Errorf("resolve custom event type: %w", err)
+		}
+		typeDefinitionCID = &info.DefinitionCID
+	}
+
+	record := newEventCIDRecord(evt, imageCIDs, typeDefinitionCID)
+	cidData, err := s.cidGenerator.GenerateCID(record)
+	if err != nil {
+		return fmt.Errorf("generate event CID: %w", err)
+	}
+
+	evt.CID = &cidData.CID
+	evt.CIDSourceJSON = &cidData.SourceJSON
+	evt.CIDSourceCBOR = &cidData.SourceCBOR
+	evt.BlockchainStatus = StatusPending
+
+	if err := s.repo.Update(ctx, *evt); err != nil {
+		return fmt.Errorf("update event with CID: %w", err)
+	}
+	if err := s.repo.SetChainHead(ctx, evt.VehicleID, cidData.CID); err != nil {
+		return fmt.Errorf("set chain head: %w", err)
+	}
+	if err := s.sign(ctx, evt); err != nil {
+		return err
+	}
+
+	jobData, err := json.Marshal(EventAnchorJob{
+		VehicleID:     vehicle.ID,
+		EventID:       evt.ID,
+		CID:           cidData.CID,
+		CIDSourceJSON: cidData.SourceJSON,
+		CIDSourceCBOR: cidData.SourceCBOR,
+		ImageCIDs:     imageCIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal anchor job: %w", err)
+	}
+	if err := s.publisher.Publish(ctx, SubjectEventAnchor, jobData); err != nil {
+		return fmt.Errorf("enqueue anchor job: %w", err)
+	}
+	return nil
+}
+
+// sign signs the CID of an entity event with the entity's managed key, if it has one
+func (s *Service) sign(ctx context.Context, evt *Event) error {
+	if s.signer == nil || evt.EntityID == nil {
+		return nil
+	}
+
+	sig, err := s.signer.Sign(ctx, *evt.EntityID, *evt.CID)
+	if err != nil {
+		return fmt.Errorf("sign event: %w", err)
+	}
+	if sig == nil {
+		return nil
+	}
+	if err := s.repo.SetSignature(ctx, evt.ID, sig.KeyID, sig.Value); err != nil {
+		return fmt.Errorf("store event signature: %w", err)
+	}
+	evt.SigningKeyID = &sig.KeyID
+	evt.Signature = &sig.Value
+	return nil
+}
+
+// AttachSignature stores a signature over the event's CID made client-side by the issuing entity.
+// The CID is only known once the event entered the vehicle's chain, so clients sign the CID
+// returned when the event was created.
+func (s *Service) AttachSignature(ctx context.Context, eventID, entityID, keyID uuid.UUID, signature string) (*Event, error) {
+	evt, err := s.repo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if evt.EntityID == nil || *evt.EntityID != entityID {
+		return nil, ErrEventNotFound
+	}
+	if evt.CID == nil {
+		return nil, ErrEventNotSignable
+	}
+	if evt.Signature != nil {
+		return nil, ErrEventAlreadySigned
+	}
+	if s.signer == nil {
+		return nil, fmt.Errorf("event signing is not configured")
+	}
 
+	if err := s.signer.Verify(ctx, entityID, keyID, *evt.CID, signature); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetSignature(ctx, evt.ID, keyID, signature); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByID(ctx, evt.ID)
+}
+
+// ListByBlockchainStatus retrieves events whose anchoring has been in the given status for longer than olderThan
+func (s *Service) ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Event, int, error) {
+	return s.repo.ListByBlockchainStatus(ctx, status, olderThan, limit, offset)
+}
+
+// RequeueAnchor publishes a new anchor job for an event whose anchoring is pending or failed.
+// The job is rebuilt from the CID record stored when the event was created.
+func (s *Service) RequeueAnchor(ctx context.Context, id uuid.UUID) (*Event, error) {
+	evt, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if evt.BlockchainStatus != StatusPending && evt.BlockchainStatus != StatusFailed {
+		return nil, ErrAnchorNotRequeueable
+	}
+	if evt.CID == nil || evt.CIDSourceJSON == nil || evt.CIDSourceCBOR == nil {
+		return nil, ErrAnchorNotRequeueable
+	}
+
+	var record eventCIDRecord
+	if err := json.Unmarshal([]byte(*evt.CIDSourceJSON), &record); err != nil {
+		return nil, fmt.Errorf("decode event CID record: %w", err)
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
 		jobData, err := json.Marshal(EventAnchorJob{
-			VehicleID:     vehicle.ID,
-			EventID:       created.ID,
-			CID:           cidData.CID,
-			CIDSourceJSON: cidData.SourceJSON,
-			CIDSourceCBOR: cidData.SourceCBOR,
-			ImageCIDs:     imageCIDs,
+			VehicleID:     evt.VehicleID,
+			EventID:       evt.ID,
+			CID:           *evt.CID,
+			CIDSourceJSON: *evt.CIDSourceJSON,
+			CIDSourceCBOR: *evt.CIDSourceCBOR,
+			ImageCIDs:     record.ImageCIDs,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("marshal anchor job: %w", err)
+			return fmt.Errorf("marshal anchor job: %w", err)
 		}
 		if err := s.publisher.Publish(ctx, SubjectEventAnchor, jobData); err != nil {
-			return nil, fmt.Errorf("enqueue anchor job: %w", err)
+			return fmt.Errorf("enqueue anchor job: %w", err)
+		}
+
+		evt.BlockchainStatus = StatusPending
+		evt.BlockchainError = nil
+		evt.BlockchainStatusAt = time.Now()
+		if err := s.repo.Update(ctx, *evt); err != nil {
+			return fmt.Errorf("update blockchain status: %w", err)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	result, err := s.repo.GetByID(ctx, created.ID)
-	return result, err
+	return evt, nil
 }
 
-// Update updates an existing event
+// Update updates an event that has not been submitted for anchoring. Anchored events are
+// immutable and must be amended instead.
 func (s *Service) Update(ctx context.Context, id uuid.UUID, params UpdateEventParams) (*Event, error) {
 	evt, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if evt.CID != nil {
+		return nil, ErrEventImmutable
+	}
 
 	if params.Title != nil {
 		evt.Title = *params.Title
@@ -205,17 +845,34 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, params UpdateEventPa
 		evt.Location = params.Location
 	}
 	if params.Metadata != nil {
-		evt.Metadata = params.Metadata
+		if err := s.validateMetadata(ctx, evt.EntityID, evt.Type, params.Metadata, nil); err != nil {
+			return nil, err
+		}
+		evt.Metadata = withReferenceFields(params.Metadata, evt.Metadata)
 	}
 
-	if err := s.repo.Update(ctx, *evt); err != nil {
+	// The repository re-checks the CID, in case the event was anchored since it was read
+	if err := s.repo.UpdateDetails(ctx, *evt); err != nil {
 		return nil, err
 	}
+	if evt.OnRecord() {
+		if err := s.track(ctx, *evt); err != nil {
+			return nil, err
+		}
+	}
 
 	return evt, nil
 }
 
-// Delete deletes an event
+// Delete deletes an event that has not been submitted for anchoring
 func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	evt, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if evt.CID != nil {
+		return ErrEventImmutable
+	}
+
 	return s.repo.Delete(ctx, id)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
 	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
 	"github.com/google/uuid"
@@ -19,6 +20,11 @@ type mockRepo struct {
 	getByIDFunc func(ctx context.Context, id uuid.UUID) (*Event, error)
 	createFunc  func(ctx context.Context, event Event) (*Event, error)
 	updateFunc  func(ctx context.Context, event Event) error
+	decideFunc  func(ctx context.Context, id uuid.UUID, status ApprovalStatus, decidedAt time.Time) error
+	chainHead   *string
+	genesisCID  *string
+	revisions   []Event
+	signatures  map[uuid.UUID]Signature
 }
 
 func (m *mockRepo) GetByVehicle(ctx context.Context, vehicleID uuid.UUID, limit, offset int) ([]Event, int, error) {
@@ -30,6 +36,18 @@ func (m *mockRepo) GetByID(ctx context.Context, id uuid.UUID) (*Event, error) {
 	}
 	return nil, ErrEventNotFound
 }
+func (m *mockRepo) ListRevisions(ctx context.Context, eventID uuid.UUID) ([]Event, error) {
+	var revisions []Event
+	for _, r := range m.revisions {
+		if r.RevisesEventID != nil && *r.RevisesEventID == eventID {
+			revisions = append(revisions, r)
+		}
+	}
+	return revisions, nil
+}
+func (m *mockRepo) ListRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Event, error) {
+	return m.revisions, nil
+}
 func (m *mockRepo) Create(ctx context.Context, event Event) (*Event, error) {
 	if m.createFunc != nil {
 		return m.createFunc(ctx, event)
@@ -43,7 +61,38 @@ func (m *mockRepo) Update(ctx context.Context, event Event) error {
 	}
 	return nil
 }
+func (m *mockRepo) UpdateDetails(ctx context.Context, event Event) error {
+	return m.Update(ctx, event)
+}
 func (m *mockRepo) Delete(ctx context.Context, id uuid.UUID) error { return nil }
+func (m *mockRepo) DecideProposal(ctx context.Context, id uuid.UUID, status ApprovalStatus, decidedAt time.Time) error {
+	if m.decideFunc != nil {
+		return m.decideFunc(ctx, id, status, decidedAt)
+	}
+	return nil
+}
+func (m *mockRepo) ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Event, int, error) {
+	return nil, 0, nil
+}
+func (m *mockRepo) LockChainHead(ctx context.Context, vehicleID uuid.UUID) (*string, error) {
+	return m.chainHead, nil
+}
+func (m *mockRepo) SetGenesisCID(ctx context.Context, vehicleID uuid.UUID, cid, sourceJSON, sourceCBOR string) error {
+	m.genesisCID = &cid
+	m.chainHead = &cid
+	return nil
+}
+func (m *mockRepo) SetChainHead(ctx context.Context, vehicleID uuid.UUID, cid string) error {
+	m.chainHead = &cid
+	return nil
+}
+func (m *mockRepo) SetSignature(ctx context.Context, eventID, keyID uuid.UUID, signature string) error {
+	if m.signatures == nil {
+		m.signatures = map[uuid.UUID]Signature{}
+	}
+	m.signatures[eventID] = Signature{KeyID: keyID, Value: signature}
+	return nil
+}
 
 type mockPublisher struct {
 	publishFunc func(ctx context.Context, subject string, data []byte) error
@@ -59,6 +108,15 @@ func (m *mockPublisher) Publish(ctx context.Context, subject string, data []byte
 }
 func (m *mockPublisher) Close() error { return nil }
 
+type mockTransactor struct {
+	calls int
+}
+
+func (m *mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	return fn(ctx)
+}
+
 type mockCIDGen struct{}
 
 func (m *mockCIDGen) GenerateCID(data interface{}) (*cidpkg.CID, error) {
@@ -68,6 +126,7 @@ func (m *mockCIDGen) GenerateCID(data interface{}) (*cidpkg.CID, error) {
 type mockImageService struct {
 	validateFunc func(ctx context.Context, sessionID uuid.UUID) ([]string, error)
 	attachFunc   func(ctx context.Context, sessionID, eventID uuid.UUID) error
+	eventCIDs    []string
 }
 
 func (m *mockImageService) ValidateSessionForEvent(ctx context.Context, sessionID uuid.UUID) ([]string, error) {
@@ -76,6 +135,9 @@ func (m *mockImageService) ValidateSessionForEvent(ctx context.Context, sessionI
 	}
 	return nil, nil
 }
+func (m *mockImageService) ListCIDsByEvent(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	return m.eventCIDs, nil
+}
 func (m *mockImageService) AttachToEvent(ctx context.Context, sessionID, eventID uuid.UUID) error {
 	if m.attachFunc != nil {
 		return m.attachFunc(ctx, sessionID, eventID)
@@ -83,6 +145,21 @@ func (m *mockImageService) AttachToEvent(ctx context.Context, sessionID, eventID
 	return nil
 }
 
+type mockUsers struct{}
+
+func (m *mockUsers) GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error) {
+	return "owner@test.com", nil
+}
+
+type mockProposalMailer struct {
+	sentTo []string
+}
+
+func (m *mockProposalMailer) SendEventProposal(ctx context.Context, to string, vehicleID, eventID uuid.UUID, vehicle invitation.VehicleInfo, eventTitle string) error {
+	m.sentTo = append(m.sentTo, to)
+	return nil
+}
+
 // --- Tests ---
 
 func ptr[T any](v T) *T { return &v }
@@ -98,7 +175,7 @@ func TestService_Create_WithoutImages(t *testing.T) {
 			return &Event{ID: id, Title: "Car Show", Type: TypeCarShow}, nil
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	vehicle := vehicles.Vehicle{ID: uuid.New(), Make: "Porsche", Model: "911"}
 	result, err := svc.Create(context.Background(), vehicle, CreateEventParams{
@@ -125,7 +202,7 @@ func TestService_Create_DateDefaultsToNow(t *testing.T) {
 			return &createdEvent, nil
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Create(context.Background(), vehicles.Vehicle{}, CreateEventParams{
 		Title: "Test",
@@ -151,7 +228,7 @@ func TestService_Create_WithExplicitDate(t *testing.T) {
 			return &createdEvent, nil
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Create(context.Background(), vehicles.Vehicle{}, CreateEventParams{
 		Title: "Test",
@@ -186,7 +263,7 @@ func TestService_Create_WithImagesAndAnchoring(t *testing.T) {
 		},
 	}
 
-	svc := NewService(repo, pub, &mockCIDGen{})
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
 	svc.SetEventImageService(imgSvc)
 
 	sessionID := uuid.New()
@@ -203,8 +280,34 @@ func TestService_Create_WithImagesAndAnchoring(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+func TestService_Create_LinksToChainHead(t *testing.T) {
+	var updated []Event
+	repo := &mockRepo{
+		chainHead: ptr("bafygenesis"),
+		updateFunc: func(_ context.Context, e Event) error {
+			updated = append(updated, e)
+			return nil
+		},
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+			return &Event{ID: id}, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Create(context.Background(), vehicles.Vehicle{}, CreateEventParams{
+		Title:        "Inspection",
+		Type:         TypeCertification,
+		ShouldAnchor: true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, updated, 1)
+	assert.Equal(t, "bafygenesis", *updated[0].PreviousCID)
+	assert.Equal(t, "mock-cid", *repo.chainHead)
+}
+
 func TestService_Create_ImageValidationError(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 	svc.SetEventImageService(&mockImageService{
 		validateFunc: func(_ context.Context, _ uuid.UUID) ([]string, error) {
 			return nil, errors.New("unconfirmed images")
@@ -235,7 +338,7 @@ func TestService_Update_PartialFields(t *testing.T) {
 			return &copy, nil
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	newDate := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
 	result, err := svc.Update(context.Background(), original.ID, UpdateEventParams{
@@ -250,7 +353,7 @@ func TestService_Update_PartialFields(t *testing.T) {
 }
 
 func TestService_Update_NotFound(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Update(context.Background(), uuid.New(), UpdateEventParams{})
 	assert.ErrorIs(t, err, ErrEventNotFound)
@@ -273,7 +376,7 @@ func TestService_Update_AllFields(t *testing.T) {
 			return nil
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	meta := map[string]interface{}{"key": "value"}
 	newDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -302,7 +405,7 @@ func TestService_Update_RepoError(t *testing.T) {
 			return errors.New("db error")
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Update(context.Background(), uuid.New(), UpdateEventParams{Title: ptr("X")})
 	assert.Error(t, err)
@@ -314,7 +417,7 @@ func TestService_GetByVehicle(t *testing.T) {
 
 	repo := &mockRepo{}
 	// Override the default by using a full mock repo with GetByVehicle
-	svc := NewService(&getByVehicleRepo{events: expected, total: 1}, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(&getByVehicleRepo{events: expected, total: 1}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, total, err := svc.GetByVehicle(context.Background(), vehicleID, 10, 0)
 	require.NoError(t, err)
@@ -333,7 +436,7 @@ func TestService_GetByID(t *testing.T) {
 			return expected, nil
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, err := svc.GetByID(context.Background(), eventID)
 	require.NoError(t, err)
@@ -343,12 +446,17 @@ func TestService_GetByID(t *testing.T) {
 func TestService_Delete(t *testing.T) {
 	var deletedID uuid.UUID
 	repo := &deleteRepo{
+		mockRepo: mockRepo{
+			getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+				return &Event{ID: id, BlockchainStatus: StatusNone}, nil
+			},
+		},
 		deleteFunc: func(_ context.Context, id uuid.UUID) error {
 			deletedID = id
 			return nil
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	eventID := uuid.New()
 	err := svc.Delete(context.Background(), eventID)
@@ -362,7 +470,7 @@ func TestService_Create_RepoError(t *testing.T) {
 			return nil, errors.New("db error")
 		},
 	}
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Create(context.Background(), vehicles.Vehicle{}, CreateEventParams{
 		Title: "Test", Type: TypeMaintenance,
@@ -385,7 +493,7 @@ func TestService_Create_PublishError(t *testing.T) {
 			return errors.New("nats error")
 		},
 	}
-	svc := NewService(repo, pub, &mockCIDGen{})
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Create(context.Background(), vehicles.Vehicle{}, CreateEventParams{
 		Title: "Test", Type: TypeCertification, ShouldAnchor: true,
@@ -410,7 +518,7 @@ func TestService_Create_AttachError(t *testing.T) {
 		},
 	}
 
-	svc := NewService(repo, &mockPublisher{}, &mockCIDGen{})
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 	svc.SetEventImageService(imgSvc)
 
 	sessionID := uuid.New()
@@ -444,3 +552,974 @@ func (r *deleteRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+func TestService_RequeueAnchor_Failed(t *testing.T) {
+	id := uuid.New()
+	sourceJSON := `{"id":"` + id.String() + `","imageCids":["img1"]}`
+	var updated Event
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Event, error) {
+			return &Event{
+				ID:               id,
+				VehicleID:        uuid.New(),
+				BlockchainStatus: StatusFailed,
+				BlockchainError:  ptr("algod unavailable"),
+				CID:              ptr("bafy"),
+				CIDSourceJSON:    &sourceJSON,
+				CIDSourceCBOR:    ptr("AA=="),
+			}, nil
+		},
+		updateFunc: func(_ context.Context, e Event) error {
+			updated = e
+			return nil
+		},
+	}
+	pub := &mockPublisher{}
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+
+	result, err := svc.RequeueAnchor(context.Background(), id)
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, result.BlockchainStatus)
+	assert.Nil(t, updated.BlockchainError)
+	require.Len(t, pub.published, 1)
+	assert.Contains(t, string(pub.published[0]), `"imageCids":["img1"]`)
+}
+
+func TestService_RequeueAnchor_AlreadyAnchored(t *testing.T) {
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+			return &Event{ID: id, BlockchainStatus: StatusAnchored, CID: ptr("bafy")}, nil
+		},
+	}
+	pub := &mockPublisher{}
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.RequeueAnchor(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, ErrAnchorNotRequeueable)
+	assert.Empty(t, pub.published)
+}
+
+func anchoredEvent(entityID *uuid.UUID) *Event {
+	return &Event{
+		ID:               uuid.New(),
+		VehicleID:        uuid.New(),
+		EntityID:         entityID,
+		Type:             TypeCertification,
+		Title:            "Original",
+		Location:         ptr("Lisbon"),
+		Date:             time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Kind:             KindOriginal,
+		CID:              ptr("original-cid"),
+		BlockchainStatus: StatusAnchored,
+	}
+}
+
+// revisionRepo serves a fixed original event and stores created revisions
+func revisionRepo(original *Event) *mockRepo {
+	repo := &mockRepo{}
+	repo.createFunc = func(_ context.Context, e Event) (*Event, error) {
+		e.ID = uuid.New()
+		e.CreatedAt = time.Now()
+		repo.revisions = append(repo.revisions, e)
+		return &e, nil
+	}
+	repo.updateFunc = func(_ context.Context, e Event) error {
+		for i, r := range repo.revisions {
+			if r.ID == e.ID {
+				repo.revisions[i] = e
+			}
+		}
+		return nil
+	}
+	repo.getByIDFunc = func(_ context.Context, id uuid.UUID) (*Event, error) {
+		if id == original.ID {
+			copy := *original
+			return &copy, nil
+		}
+		for _, r := range repo.revisions {
+			if r.ID == id {
+				return &r, nil
+			}
+		}
+		return nil, ErrEventNotFound
+	}
+	return repo
+}
+
+func TestService_Update_AnchoredEventIsImmutable(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Event, error) {
+			return original, nil
+		},
+		updateFunc: func(_ context.Context, _ Event) error {
+			t.Fatal("anchored event must not be updated")
+			return nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Update(context.Background(), original.ID, UpdateEventParams{Title: ptr("Changed")})
+	assert.ErrorIs(t, err, ErrEventImmutable)
+}
+
+func TestService_Delete_AnchoredEventIsImmutable(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	repo := &deleteRepo{
+		mockRepo: mockRepo{
+			getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Event, error) {
+				return original, nil
+			},
+		},
+		deleteFunc: func(_ context.Context, _ uuid.UUID) error {
+			t.Fatal("anchored event must not be deleted")
+			return nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	err := svc.Delete(context.Background(), original.ID)
+	assert.ErrorIs(t, err, ErrEventImmutable)
+}
+
+func TestService_Update_AnchoredSinceRead(t *testing.T) {
+	// The event was read before it was submitted for anchoring; the repository refuses the edit
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+			return &Event{ID: id, EntityID: ptr(uuid.New())}, nil
+		},
+		updateFunc: func(_ context.Context, _ Event) error {
+			return ErrEventImmutable
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Update(context.Background(), uuid.New(), UpdateEventParams{Title: ptr("Changed")})
+	assert.ErrorIs(t, err, ErrEventImmutable)
+}
+
+func TestService_Delete_AnchoredSinceRead(t *testing.T) {
+	repo := &deleteRepo{
+		mockRepo: mockRepo{
+			getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+				return &Event{ID: id, BlockchainStatus: StatusNone}, nil
+			},
+		},
+		deleteFunc: func(_ context.Context, _ uuid.UUID) error {
+			return ErrEventImmutable
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	err := svc.Delete(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, ErrEventImmutable)
+}
+
+func TestService_Amend_RecordsAnchoredAmendment(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	repo := revisionRepo(original)
+	repo.chainHead = ptr("head-cid")
+	pub := &mockPublisher{}
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+
+	vehicle := vehicles.Vehicle{ID: original.VehicleID}
+	amendment, err := svc.Amend(context.Background(), vehicle, original.ID, AmendEventParams{
+		Title:  ptr("Corrected"),
+		Reason: ptr("Typo in title"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, KindAmendment, amendment.Kind)
+	assert.Equal(t, original.ID, *amendment.RevisesEventID)
+	assert.Equal(t, "Corrected", amendment.Title)
+	assert.Equal(t, original.Location, amendment.Location) // carried over
+	assert.Equal(t, original.EntityID, amendment.EntityID)
+	assert.Equal(t, ptr("head-cid"), amendment.PreviousCID)
+	assert.Len(t, pub.published, 1)
+
+	effective, trail, err := svc.GetWithRevisions(context.Background(), original.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Corrected", effective.Title)
+	assert.NotNil(t, effective.AmendedAt)
+	assert.Equal(t, ptr("original-cid"), effective.CID)
+	assert.Len(t, trail, 1)
+}
+
+func TestService_LifecycleChangesAreReserved(t *testing.T) {
+	original := anchoredEvent(nil)
+	original.Type = TypeLifecycleChange
+	repo := revisionRepo(original)
+	pub := &mockPublisher{}
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: original.VehicleID}
+
+	_, err := svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		Type:      TypeLifecycleChange,
+		Title:     "Reported stolen",
+	})
+	assert.ErrorIs(t, err, ErrReservedEventType)
+
+	_, err = svc.Amend(context.Background(), vehicle, original.ID, AmendEventParams{Title: ptr("Never stolen")})
+	assert.ErrorIs(t, err, ErrReservedEventType)
+
+	recorded, err := svc.RecordLifecycleChange(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		Title:     "Reported stolen",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TypeLifecycleChange, recorded.Type)
+	assert.Len(t, pub.published, 1)
+}
+
+func TestService_OwnershipTransferIsReserved(t *testing.T) {
+	pub := &mockPublisher{}
+	repo := proposalRepo()
+	repo.chainHead = ptr("head-cid")
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: uuid.New(), OwnerID: ptr(uuid.New())}
+
+	_, err := svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		EntityID:  ptr(uuid.New()),
+		Type:      TypeOwnershipTransfer,
+		Title:     "Ownership transferred",
+		Metadata:  map[string]interface{}{MetadataTransferID: uuid.New().String()},
+	})
+	assert.ErrorIs(t, err, ErrReservedEventType)
+
+	recorded, err := svc.RecordOwnershipTransfer(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		Title:     "Ownership transferred",
+		Metadata:  map[string]interface{}{MetadataTransferID: uuid.New().String()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TypeOwnershipTransfer, recorded.Type)
+	assert.Nil(t, recorded.EntityID)
+	assert.True(t, recorded.RecordedByPlatform())
+	assert.Len(t, pub.published, 1)
+}
+
+type mockTracker struct {
+	tracked []Event
+}
+
+func (m *mockTracker) Track(ctx context.Context, evt Event) error {
+	m.tracked = append(m.tracked, evt)
+	return nil
+}
+
+func TestService_Amend_TracksCertification(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	tracker := &mockTracker{}
+	svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	svc.SetCertificationTracker(tracker)
+
+	vehicle := vehicles.Vehicle{ID: original.VehicleID}
+	amendment, err := svc.Amend(context.Background(), vehicle, original.ID, AmendEventParams{
+		Metadata: map[string]interface{}{"certificateNumber": "C-1", "conditionAssessment": "Concours", "validityEndDate": "2026-01-01"},
+		Reason:   ptr("Renewed"),
+	})
+	require.NoError(t, err)
+	_, err = svc.Revoke(context.Background(), vehicle, original.ID, "Issued in error")
+	require.NoError(t, err)
+
+	require.Len(t, tracker.tracked, 2)
+	assert.Equal(t, amendment.ID, tracker.tracked[0].ID)
+	assert.Equal(t, KindRevocation, tracker.tracked[1].Kind)
+	assert.Equal(t, original.ID, *tracker.tracked[1].RevisesEventID)
+}
+
+func TestService_Amend_UnanchoredOriginalIsNotAnchored(t *testing.T) {
+	original := anchoredEvent(nil)
+	original.CID = nil
+	original.BlockchainStatus = StatusNone
+	pub := &mockPublisher{}
+	svc := NewService(revisionRepo(original), pub, &mockTransactor{}, &mockCIDGen{})
+
+	amendment, err := svc.Amend(context.Background(), vehicles.Vehicle{ID: original.VehicleID}, original.ID, AmendEventParams{Title: ptr("Corrected")})
+
+	require.NoError(t, err)
+	assert.Nil(t, amendment.CID)
+	assert.Empty(t, pub.published)
+}
+
+func TestService_Amend_OtherVehicle(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Amend(context.Background(), vehicles.Vehicle{ID: uuid.New()}, original.ID, AmendEventParams{Title: ptr("Corrected")})
+	assert.ErrorIs(t, err, ErrEventNotFound)
+}
+
+func TestService_Amend_RevisionCannotBeAmended(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	repo := revisionRepo(original)
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: original.VehicleID}
+
+	amendment, err := svc.Amend(context.Background(), vehicle, original.ID, AmendEventParams{Title: ptr("Corrected")})
+	require.NoError(t, err)
+
+	_, err = svc.Amend(context.Background(), vehicle, amendment.ID, AmendEventParams{Title: ptr("Again")})
+	assert.ErrorIs(t, err, ErrNotOriginalEvent)
+}
+
+func TestService_Revoke_RecordsReason(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	repo := revisionRepo(original)
+	pub := &mockPublisher{}
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: original.VehicleID}
+
+	revocation, err := svc.Revoke(context.Background(), vehicle, original.ID, "  Issued in error ")
+
+	require.NoError(t, err)
+	assert.Equal(t, KindRevocation, revocation.Kind)
+	assert.Equal(t, ptr("Issued in error"), revocation.Reason)
+	assert.NotNil(t, revocation.CID)
+	assert.Len(t, pub.published, 1)
+
+	_, err = svc.Revoke(context.Background(), vehicle, original.ID, "Again")
+	assert.ErrorIs(t, err, ErrEventRevoked)
+	_, err = svc.Amend(context.Background(), vehicle, original.ID, AmendEventParams{Title: ptr("Corrected")})
+	assert.ErrorIs(t, err, ErrEventRevoked)
+}
+
+func TestService_Revoke_OwnerEvent(t *testing.T) {
+	original := anchoredEvent(nil)
+	svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Revoke(context.Background(), vehicles.Vehicle{ID: original.VehicleID}, original.ID, "Not mine")
+	assert.ErrorIs(t, err, ErrEventNotCertified)
+}
+
+func TestService_Revoke_ReasonRequired(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Revoke(context.Background(), vehicles.Vehicle{ID: original.VehicleID}, original.ID, " ")
+	assert.ErrorIs(t, err, ErrReasonRequired)
+}
+
+func TestService_GetByVehicle_AppliesRevisions(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	amendedAt := time.Now()
+	repo := &getByVehicleRepo{events: []Event{*original}, total: 1}
+	repo.revisions = []Event{
+		{ID: uuid.New(), Kind: KindAmendment, RevisesEventID: &original.ID, Title: "Corrected", Date: original.Date, CreatedAt: amendedAt},
+		{ID: uuid.New(), Kind: KindRevocation, RevisesEventID: &original.ID, Title: "Corrected", Reason: ptr("Issued in error"), CreatedAt: amendedAt.Add(time.Minute)},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	result, _, err := svc.GetByVehicle(context.Background(), original.VehicleID, 10, 0)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, original.ID, result[0].ID)
+	assert.Equal(t, "Corrected", result[0].Title)
+	assert.Nil(t, result[0].Location)
+	assert.Equal(t, amendedAt, *result[0].AmendedAt)
+	assert.Equal(t, ptr("Issued in error"), result[0].RevocationReason)
+	assert.NotNil(t, result[0].RevokedAt)
+}
+
+// proposalRepo stores created events and applies updates to them
+func proposalRepo() *mockRepo {
+	events := map[uuid.UUID]Event{}
+	return &mockRepo{
+		createFunc: func(_ context.Context, e Event) (*Event, error) {
+			e.ID = uuid.New()
+			events[e.ID] = e
+			return &e, nil
+		},
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+			e, ok := events[id]
+			if !ok {
+				return nil, ErrEventNotFound
+			}
+			return &e, nil
+		},
+		updateFunc: func(_ context.Context, e Event) error {
+			events[e.ID] = e
+			return nil
+		},
+		decideFunc: func(_ context.Context, id uuid.UUID, status ApprovalStatus, decidedAt time.Time) error {
+			e := events[id]
+			if e.ApprovalStatus != ApprovalProposed {
+				return ErrEventNotProposed
+			}
+			e.ApprovalStatus = status
+			e.ApprovalDecidedAt = &decidedAt
+			events[id] = e
+			return nil
+		},
+	}
+}
+
+func proposeEvent(t *testing.T, svc *Service, vehicle vehicles.Vehicle) *Event {
+	t.Helper()
+	proposed, err := svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID:             vehicle.ID,
+		EntityID:              ptr(uuid.New()),
+		Type:                  TypeCarShow,
+		Title:                 "Concours",
+		Metadata:              map[string]interface{}{"certificateNumber": "CS-1", "category": "Pre-war"},
+		ShouldAnchor:          true,
+		RequiresOwnerApproval: true,
+	})
+	require.NoError(t, err)
+	return proposed
+}
+
+func TestService_Create_ProposalIsNotAnchored(t *testing.T) {
+	pub := &mockPublisher{}
+	mailer := &mockProposalMailer{}
+	svc := NewService(proposalRepo(), pub, &mockTransactor{}, &mockCIDGen{})
+	svc.SetProposalNotifier(&mockUsers{}, mailer)
+
+	vehicle := vehicles.Vehicle{ID: uuid.New(), OwnerID: ptr(uuid.New())}
+	proposed := proposeEvent(t, svc, vehicle)
+
+	assert.Equal(t, ApprovalProposed, proposed.ApprovalStatus)
+	assert.False(t, proposed.OnRecord())
+	assert.Nil(t, proposed.CID)
+	assert.Empty(t, pub.published)
+	assert.Equal(t, []string{"owner@test.com"}, mailer.sentTo)
+}
+
+func TestService_Accept_AnchorsProposal(t *testing.T) {
+	pub := &mockPublisher{}
+	svc := NewService(proposalRepo(), pub, &mockTransactor{}, &mockCIDGen{})
+	svc.SetEventImageService(&mockImageService{eventCIDs: []string{"img1"}})
+
+	vehicle := vehicles.Vehicle{ID: uuid.New(), OwnerID: ptr(uuid.New())}
+	proposed := proposeEvent(t, svc, vehicle)
+
+	accepted, err := svc.Accept(context.Background(), vehicle, proposed.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalAccepted, accepted.ApprovalStatus)
+	assert.NotNil(t, accepted.ApprovalDecidedAt)
+	assert.True(t, accepted.OnRecord())
+	assert.Equal(t, ptr("mock-cid"), accepted.CID)
+	assert.Equal(t, StatusPending, accepted.BlockchainStatus)
+	require.Len(t, pub.published, 1)
+	assert.Contains(t, string(pub.published[0]), "img1")
+
+	_, err = svc.Accept(context.Background(), vehicle, proposed.ID)
+	assert.ErrorIs(t, err, ErrEventNotProposed)
+}
+
+func TestService_Reject_KeepsProposalOffRecord(t *testing.T) {
+	pub := &mockPublisher{}
+	svc := NewService(proposalRepo(), pub, &mockTransactor{}, &mockCIDGen{})
+
+	vehicle := vehicles.Vehicle{ID: uuid.New(), OwnerID: ptr(uuid.New())}
+	proposed := proposeEvent(t, svc, vehicle)
+
+	rejected, err := svc.Reject(context.Background(), vehicle, proposed.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalRejected, rejected.ApprovalStatus)
+	assert.False(t, rejected.OnRecord())
+	assert.Nil(t, rejected.CID)
+	assert.Empty(t, pub.published)
+
+	_, err = svc.Accept(context.Background(), vehicle, proposed.ID)
+	assert.ErrorIs(t, err, ErrEventNotProposed)
+}
+
+func TestService_Accept_ConcurrentDecision(t *testing.T) {
+	pub := &mockPublisher{}
+	repo := proposalRepo()
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+
+	vehicle := vehicles.Vehicle{ID: uuid.New(), OwnerID: ptr(uuid.New())}
+	proposed := proposeEvent(t, svc, vehicle)
+	_, err := svc.Reject(context.Background(), vehicle, proposed.ID)
+	require.NoError(t, err)
+
+	// The second request read the event while it was still proposed
+	getByID := repo.getByIDFunc
+	stale := true
+	repo.getByIDFunc = func(ctx context.Context, id uuid.UUID) (*Event, error) {
+		if stale {
+			stale = false
+			e := *proposed
+			return &e, nil
+		}
+		return getByID(ctx, id)
+	}
+
+	_, err = svc.Accept(context.Background(), vehicle, proposed.ID)
+
+	assert.ErrorIs(t, err, ErrEventNotProposed)
+	assert.Empty(t, pub.published)
+	rejected, err := svc.GetByID(context.Background(), proposed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalRejected, rejected.ApprovalStatus)
+	assert.Nil(t, rejected.CID)
+}
+
+func TestService_Accept_OtherVehicle(t *testing.T) {
+	svc := NewService(proposalRepo(), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	proposed := proposeEvent(t, svc, vehicles.Vehicle{ID: uuid.New()})
+
+	_, err := svc.Accept(context.Background(), vehicles.Vehicle{ID: uuid.New()}, proposed.ID)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+}
+
+func TestService_Amend_ProposalIsNotOnRecord(t *testing.T) {
+	svc := NewService(proposalRepo(), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: uuid.New()}
+	proposed := proposeEvent(t, svc, vehicle)
+
+	_, err := svc.Amend(context.Background(), vehicle, proposed.ID, AmendEventParams{Title: ptr("Corrected")})
+	assert.ErrorIs(t, err, ErrEventNotOnRecord)
+}
+
+func TestValidateMetadata(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType EventType
+		metadata  map[string]interface{}
+		wantErrs  []MetadataFieldError
+	}{
+		{
+			name:      "valid with optional fields left empty",
+			eventType: TypeRally,
+			metadata:  map[string]interface{}{"certificateNumber": "R-1", "route": "Lisbon-Porto", "coDriver": "", "distanceDriven": float64(320)},
+		},
+		{
+			name:      "reference fields are not part of any schema",
+			eventType: TypeCertification,
+			metadata:  map[string]interface{}{"certificateNumber": "C-1", "conditionAssessment": "Good", MetadataCertifiesEventID: uuid.NewString()},
+			wantErrs: []MetadataFieldError{
+				{Field: MetadataCertifiesEventID, Message: "is not a known field"},
+			},
+		},
+		{
+			name:      "type without a schema accepts anything",
+			eventType: TypeMaintenance,
+			metadata:  map[string]interface{}{"anything": []interface{}{1, "two"}},
+		},
+		{
+			name:      "missing required fields",
+			eventType: TypeClubCompetition,
+			metadata:  map[string]interface{}{"certificateNumber": "CC-1", "awardTitle": ""},
+			wantErrs: []MetadataFieldError{
+				{Field: "competitionCategory", Message: "is required"},
+				{Field: "awardTitle", Message: "is required"},
+			},
+		},
+		{
+			name:      "required boolean may be false",
+			eventType: TypeWorkshop,
+			metadata:  map[string]interface{}{"certificateNumber": "W-1", "workshopTopic": "Carburettors", "completionAcknowledgment": false},
+		},
+		{
+			name:      "wrong types and unknown fields",
+			eventType: TypeRoadTrip,
+			metadata: map[string]interface{}{
+				"certificateNumber":    "RT-1",
+				"routeName":            "N2",
+				"totalDistance":        738.5,
+				"checkpointsCompleted": []interface{}{"Chaves", 42},
+				"mood":                 "great",
+			},
+			wantErrs: []MetadataFieldError{
+				{Field: "totalDistance", Message: "must be an integer"},
+				{Field: "checkpointsCompleted", Message: "must be a list of strings"},
+				{Field: "mood", Message: "is not a known field"},
+			},
+		},
+		{
+			name:      "malformed date",
+			eventType: TypeCertification,
+			metadata:  map[string]interface{}{"certificateNumber": "C-2", "conditionAssessment": "Good", "validityEndDate": "31/12/2030"},
+			wantErrs: []MetadataFieldError{
+				{Field: "validityEndDate", Message: "must be a date (YYYY-MM-DD)"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMetadata(tt.eventType, tt.metadata)
+			if tt.wantErrs == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, ErrInvalidMetadata)
+			var validationErr *MetadataValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantErrs, validationErr.Fields)
+		})
+	}
+}
+
+func TestService_ReferenceFieldsOnlyOnTheirRecords(t *testing.T) {
+	pub := &mockPublisher{}
+	repo := proposalRepo()
+	repo.chainHead = ptr("head-cid")
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: uuid.New(), OwnerID: ptr(uuid.New())}
+	entityID := uuid.New()
+	var validationErr *MetadataValidationError
+
+	// Neither owners nor entities may set reference fields themselves
+	_, err := svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		Type:      TypeMaintenance,
+		Title:     "Oil change",
+		Metadata:  map[string]interface{}{MetadataLifecycleRequestID: uuid.NewString()},
+	})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, MetadataLifecycleRequestID, validationErr.Fields[0].Field)
+
+	_, err = svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		EntityID:  &entityID,
+		Type:      TypeMaintenance,
+		Title:     "Oil change",
+		Metadata:  map[string]interface{}{MetadataCertifiesEventID: uuid.NewString()},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+
+	// Each reference field is only accepted on the events of its own record
+	_, err = svc.RecordLifecycleChange(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		Title:     "Reported stolen",
+		Metadata:  map[string]interface{}{MetadataTransferID: uuid.NewString()},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+
+	certified, err := svc.RecordCertified(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		EntityID:  &entityID,
+		Type:      TypeMaintenance,
+		Title:     "Oil change",
+		Metadata: map[string]interface{}{
+			MetadataCertifiesEventID:       uuid.NewString(),
+			MetadataCertificationRequestID: uuid.NewString(),
+		},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, certified.CID)
+	assert.Len(t, pub.published, 1)
+
+	_, err = svc.RecordCertified(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		EntityID:  &entityID,
+		Type:      TypeMaintenance,
+		Title:     "Oil change",
+		Metadata:  map[string]interface{}{MetadataTransferID: uuid.NewString()},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+}
+
+func TestService_Amend_KeepsReferenceFields(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	requestID := uuid.NewString()
+	original.Metadata = map[string]interface{}{"certificateNumber": "C-1", MetadataCertificationRequestID: requestID}
+	svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: original.VehicleID}
+
+	amendment, err := svc.Amend(context.Background(), vehicle, original.ID, AmendEventParams{
+		Metadata: map[string]interface{}{"certificateNumber": "C-2", "conditionAssessment": "Good"},
+		Reason:   ptr("Corrected"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, requestID, amendment.Metadata[MetadataCertificationRequestID])
+	assert.Equal(t, "C-2", amendment.Metadata["certificateNumber"])
+
+	_, err = svc.Amend(context.Background(), vehicle, original.ID, AmendEventParams{
+		Metadata: map[string]interface{}{"certificateNumber": "C-3", "conditionAssessment": "Good", MetadataCertificationRequestID: uuid.NewString()},
+		Reason:   ptr("Relinked"),
+	})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+}
+
+func TestMetadataSchemas(t *testing.T) {
+	schemas := MetadataSchemas()
+	require.Len(t, schemas, len(metadataTypes))
+	assert.Equal(t, TypeAuction, schemas[0].EventType)
+
+	schema, ok := MetadataSchemaFor(TypeCertification)
+	require.True(t, ok)
+	assert.Equal(t, []MetadataField{
+		{Name: "certificateNumber", Type: FieldString, Required: true},
+		{Name: "conditionAssessment", Type: FieldString, Required: true},
+		{Name: "validityEndDate", Type: FieldDate},
+		{Name: "documentationReferences", Type: FieldStringList},
+	}, schema.Fields)
+
+	_, ok = MetadataSchemaFor(TypeMaintenance)
+	assert.False(t, ok)
+}
+
+func TestService_Create_ValidatesEntityMetadata(t *testing.T) {
+	created := false
+	repo := &mockRepo{
+		createFunc: func(_ context.Context, e Event) (*Event, error) {
+			created = true
+			e.ID = uuid.New()
+			return &e, nil
+		},
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+			return &Event{ID: id}, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	vehicle := vehicles.Vehicle{ID: uuid.New()}
+
+	_, err := svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		EntityID:  ptr(uuid.New()),
+		Type:      TypeAuction,
+		Title:     "Spring sale",
+		Metadata:  map[string]interface{}{"lotNumber": "12"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+	assert.False(t, created)
+
+	// Owner events carry no typed metadata and are not validated
+	_, err = svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		Type:      TypeAuction,
+		Title:     "Spring sale",
+	})
+	require.NoError(t, err)
+	assert.True(t, created)
+}
+
+func TestService_Amend_ValidatesEntityMetadata(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	original.Type = TypeFestival
+	svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Amend(context.Background(), vehicles.Vehicle{ID: original.VehicleID}, original.ID, AmendEventParams{
+		Metadata: map[string]interface{}{"certificateNumber": "F-1"},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+}
+
+type mockCustomTypes struct {
+	types map[EventType]CustomTypeInfo
+}
+
+func (m *mockCustomTypes) Lookup(_ context.Context, eventType EventType) (*CustomTypeInfo, error) {
+	info, ok := m.types[eventType]
+	if !ok {
+		return nil, ErrUnknownEventType
+	}
+	return &info, nil
+}
+
+func (m *mockCustomTypes) ValidateMetadata(_ context.Context, eventType EventType, metadata map[string]interface{}) error {
+	if _, ok := metadata["inspector"]; !ok {
+		return &MetadataValidationError{EventType: eventType, Fields: []MetadataFieldError{{Field: "inspector", Message: "is required"}}}
+	}
+	return nil
+}
+
+type recordingCIDGen struct {
+	records []interface{}
+}
+
+func (m *recordingCIDGen) GenerateCID(data interface{}) (*cidpkg.CID, error) {
+	m.records = append(m.records, data)
+	return &cidpkg.CID{CID: "mock-cid", SourceJSON: "{}", SourceCBOR: "AA=="}, nil
+}
+
+func TestEventType_CustomTypeOwner(t *testing.T) {
+	entityID := uuid.New()
+	eventType := CustomEventType(entityID, "paint_inspection")
+
+	owner, key, ok := eventType.CustomTypeOwner()
+	require.True(t, ok)
+	assert.Equal(t, entityID, owner)
+	assert.Equal(t, "paint_inspection", key)
+	assert.False(t, eventType.IsBuiltIn())
+
+	for _, notCustom := range []EventType{TypeCarShow, "bogus", "not-a-uuid:key", EventType(entityID.String() + ":")} {
+		_, _, ok := notCustom.CustomTypeOwner()
+		assert.False(t, ok, notCustom)
+	}
+	assert.True(t, TypeOwnershipTransfer.IsBuiltIn())
+}
+
+func TestService_Create_CustomTypeRecordedInCID(t *testing.T) {
+	entityID := uuid.New()
+	eventType := CustomEventType(entityID, "paint_inspection")
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Event, error) {
+			return &Event{ID: id}, nil
+		},
+		chainHead: ptr("bafygenesis"),
+	}
+	cidGen := &recordingCIDGen{}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, cidGen)
+	svc.SetCustomTypeRegistry(&mockCustomTypes{types: map[EventType]CustomTypeInfo{
+		eventType: {DefinitionCID: "bafydefinition"},
+	}})
+	vehicle := vehicles.Vehicle{ID: uuid.New()}
+
+	_, err := svc.Create(context.Background(), vehicle, CreateEventParams{
+		VehicleID: vehicle.ID,
+		EntityID:  &entityID,
+		Type:      eventType,
+		Title:     "Paint inspection",
+		Metadata:  map[string]interface{}{},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+
+	_, err = svc.Create(context.Background(), vehicle, CreateEventParams{
+		ShouldAnchor: true,
+		VehicleID:    vehicle.ID,
+		EntityID:     &entityID,
+		Type:         eventType,
+		Title:        "Paint inspection",
+		Metadata:     map[string]interface{}{"inspector": "J. Silva"},
+	})
+	require.NoError(t, err)
+	require.Len(t, cidGen.records, 1)
+	record := cidGen.records[0].(eventCIDRecord)
+	assert.Equal(t, string(eventType), *record.Type)
+	require.NotNil(t, record.TypeDefinitionCID)
+	assert.Equal(t, "bafydefinition", *record.TypeDefinitionCID)
+}
+
+func TestService_Create_RejectsUnknownEventTypes(t *testing.T) {
+	entityID := uuid.New()
+	otherEntityID := uuid.New()
+	registered := CustomEventType(entityID, "paint_inspection")
+	retired := CustomEventType(entityID, "old_inspection")
+	foreign := CustomEventType(otherEntityID, "paint_inspection")
+
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	svc.SetCustomTypeRegistry(&mockCustomTypes{types: map[EventType]CustomTypeInfo{
+		registered: {DefinitionCID: "bafyregistered"},
+		retired:    {DefinitionCID: "bafyretired", Retired: true},
+		foreign:    {DefinitionCID: "bafyforeign"},
+	}})
+	vehicle := vehicles.Vehicle{ID: uuid.New()}
+	metadata := map[string]interface{}{"inspector": "J. Silva"}
+
+	tests := []struct {
+		name     string
+		entityID *uuid.UUID
+		typ      EventType
+	}{
+		{"unknown type", &entityID, "bogus"},
+		{"unregistered custom type", &entityID, CustomEventType(entityID, "unregistered")},
+		{"retired custom type", &entityID, retired},
+		{"another entity's type", &entityID, foreign},
+		{"owner event with custom type", nil, registered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Create(context.Background(), vehicle, CreateEventParams{
+				VehicleID: vehicle.ID,
+				EntityID:  tt.entityID,
+				Type:      tt.typ,
+				Title:     "Inspection",
+				Metadata:  metadata,
+			})
+			assert.ErrorIs(t, err, ErrUnknownEventType)
+		})
+	}
+}
+
+type mockSigner struct {
+	keyID     uuid.UUID
+	verifyErr error
+	signed    []string
+}
+
+func (m *mockSigner) Sign(_ context.Context, _ uuid.UUID, cid string) (*Signature, error) {
+	m.signed = append(m.signed, cid)
+	return &Signature{KeyID: m.keyID, Value: "sig:" + cid}, nil
+}
+
+func (m *mockSigner) Verify(_ context.Context, _, _ uuid.UUID, _, _ string) error {
+	return m.verifyErr
+}
+
+func TestService_Amend_SignsWithManagedKey(t *testing.T) {
+	original := anchoredEvent(ptr(uuid.New()))
+	repo := revisionRepo(original)
+	signer := &mockSigner{keyID: uuid.New()}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+	svc.SetSigner(signer)
+
+	amendment, err := svc.Amend(context.Background(), vehicles.Vehicle{ID: original.VehicleID}, original.ID, AmendEventParams{Title: ptr("Corrected")})
+
+	require.NoError(t, err)
+	require.Len(t, signer.signed, 1)
+	assert.Equal(t, *amendment.CID, signer.signed[0])
+	assert.Equal(t, Signature{KeyID: signer.keyID, Value: "sig:" + *amendment.CID}, repo.signatures[amendment.ID])
+}
+
+func TestService_AttachSignature(t *testing.T) {
+	entityID := uuid.New()
+	keyID := uuid.New()
+
+	t.Run("stores verified signature", func(t *testing.T) {
+		original := anchoredEvent(&entityID)
+		repo := revisionRepo(original)
+		svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+		svc.SetSigner(&mockSigner{})
+
+		_, err := svc.AttachSignature(context.Background(), original.ID, entityID, keyID, "c2ln")
+
+		require.NoError(t, err)
+		assert.Equal(t, Signature{KeyID: keyID, Value: "c2ln"}, repo.signatures[original.ID])
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		original := anchoredEvent(&entityID)
+		repo := revisionRepo(original)
+		svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+		verifyErr := errors.New("bad signature")
+		svc.SetSigner(&mockSigner{verifyErr: verifyErr})
+
+		_, err := svc.AttachSignature(context.Background(), original.ID, entityID, keyID, "c2ln")
+
+		assert.ErrorIs(t, err, verifyErr)
+		assert.Empty(t, repo.signatures)
+	})
+
+	t.Run("other entity's event", func(t *testing.T) {
+		original := anchoredEvent(ptr(uuid.New()))
+		svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+		svc.SetSigner(&mockSigner{})
+
+		_, err := svc.AttachSignature(context.Background(), original.ID, entityID, keyID, "c2ln")
+		assert.ErrorIs(t, err, ErrEventNotFound)
+	})
+
+	t.Run("event without CID", func(t *testing.T) {
+		original := anchoredEvent(&entityID)
+		original.CID = nil
+		svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+		svc.SetSigner(&mockSigner{})
+
+		_, err := svc.AttachSignature(context.Background(), original.ID, entityID, keyID, "c2ln")
+		assert.ErrorIs(t, err, ErrEventNotSignable)
+	})
+
+	t.Run("already signed", func(t *testing.T) {
+		original := anchoredEvent(&entityID)
+		original.Signature = ptr("c2ln")
+		svc := NewService(revisionRepo(original), &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+		svc.SetSigner(&mockSigner{})
+
+		_, err := svc.AttachSignature(context.Background(), original.ID, entityID, keyID, "c2ln")
+		assert.ErrorIs(t, err, ErrEventAlreadySigned)
+	})
+}
@@ -113,6 +113,22 @@ func (s *Service) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]EventIm
 	return s.repo.ListByEvent(ctx, eventID)
 }
 
+// ListCIDsByEvent returns the CIDs of the confirmed images attached to an event
+func (s *Service) ListCIDsByEvent(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	images, err := s.repo.ListByEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cids []string
+	for _, img := range images {
+		if img.CID != nil {
+			cids = append(cids, *img.CID)
+		}
+	}
+	return cids, nil
+}
+
 func (s *Service) AttachToEvent(ctx context.Context, sessionID, eventID uuid.UUID) error {
 	return s.repo.AttachToEvent(ctx, sessionID, eventID)
 }
@@ -0,0 +1,74 @@
+package event_types
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEventTypeNotFound   = errors.New("event type not found")
+	ErrEventTypeExists     = errors.New("entity already registered an event type with this key")
+	ErrInvalidKey          = errors.New("key must start with a lowercase letter and contain only lowercase letters, digits and underscores (at most 50 characters)")
+	ErrBuiltInKey          = errors.New("key is reserved for a built-in event type")
+	ErrDisplayNameRequired = errors.New("display name is required")
+	ErrInvalidSchema       = errors.New("invalid metadata schema")
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// CustomType is an event type registered by an entity for its own events. The key and metadata
+// schema cannot change once registered; the display name and icon can.
+type CustomType struct {
+	ID       uuid.UUID `json:"id"`
+	EntityID uuid.UUID `json:"entityId"`
+	// EntityName is only set when listing the public catalogue
+	EntityName     *string                `json:"entityName,omitempty"`
+	Key            string                 `json:"key"`
+	DisplayName    string                 `json:"displayName"`
+	Icon           *string                `json:"icon,omitempty"`
+	MetadataSchema map[string]interface{} `json:"metadataSchema"`
+	DefinitionCID  string                 `json:"definitionCid"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+	RetiredAt      *time.Time             `json:"retiredAt,omitempty"`
+}
+
+// Name returns the namespaced event type that events of this type are recorded with
+func (t CustomType) Name() event.EventType {
+	return event.CustomEventType(t.EntityID, t.Key)
+}
+
+// Definition is the part of a custom type hashed into its definition CID
+type Definition struct {
+	EntityID       uuid.UUID              `json:"entityId"`
+	Key            string                 `json:"key"`
+	MetadataSchema map[string]interface{} `json:"metadataSchema"`
+}
+
+// RegisterParams represents parameters for registering a new custom event type
+type RegisterParams struct {
+	Key            string
+	DisplayName    string
+	Icon           *string
+	MetadataSchema map[string]interface{}
+}
+
+// CreateParams represents parameters for storing a new custom event type
+type CreateParams struct {
+	EntityID       uuid.UUID
+	Key            string
+	DisplayName    string
+	Icon           *string
+	MetadataSchema map[string]interface{}
+	DefinitionCID  string
+}
+
+// UpdateParams represents parameters for updating the presentation of a custom event type
+type UpdateParams struct {
+	DisplayName *string
+	Icon        *string
+}
@@ -0,0 +1,106 @@
+package event_types
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// compileSchema parses a metadata schema, written as an OpenAPI 3 schema object (the JSON Schema
+// subset used by the rest of the API). Metadata is always an object, so the schema must describe one.
+func compileSchema(ctx context.Context, raw map[string]interface{}) (*openapi3.Schema, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("%w: schema is required", ErrInvalidSchema)
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	var schema openapi3.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	if err := schema.Validate(ctx, openapi3.EnableSchemaFormatValidation()); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSchema, err)
+	}
+	if schema.Type == nil || !schema.Type.Is(openapi3.TypeObject) {
+		return nil, fmt.Errorf("%w: schema must describe an object", ErrInvalidSchema)
+	}
+	return &schema, nil
+}
+
+// validateAgainst checks metadata against a compiled schema, reporting every rejected field in a
+// *event.MetadataValidationError
+func validateAgainst(schema *openapi3.Schema, eventType event.EventType, metadata map[string]interface{}) error {
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+
+	// Round-trip through JSON so values are checked the way clients sent them
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("encode metadata: %w", err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("decode metadata: %w", err)
+	}
+
+	err = schema.VisitJSON(value, openapi3.MultiErrors(), openapi3.EnableFormatValidation())
+	if err == nil {
+		return nil
+	}
+
+	var fields []event.MetadataFieldError
+	for _, schemaErr := range flattenSchemaErrors(err) {
+		fields = append(fields, event.MetadataFieldError{
+			Field:   schemaErrorField(schemaErr),
+			Message: schemaErr.Reason,
+		})
+	}
+	if len(fields) == 0 {
+		fields = append(fields, event.MetadataFieldError{Message: err.Error()})
+	}
+	slices.SortStableFunc(fields, func(a, b event.MetadataFieldError) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+	return &event.MetadataValidationError{EventType: eventType, Fields: fields}
+}
+
+func flattenSchemaErrors(err error) []*openapi3.SchemaError {
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		var result []*openapi3.SchemaError
+		for _, e := range multi {
+			result = append(result, flattenSchemaErrors(e)...)
+		}
+		return result
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return []*openapi3.SchemaError{schemaErr}
+	}
+	return nil
+}
+
+// schemaErrorField returns the dotted path of the rejected field. Unsupported properties are
+// reported against the enclosing object, so their name is taken from the reason.
+func schemaErrorField(err *openapi3.SchemaError) string {
+	path := err.JSONPointer()
+	if err.SchemaField == "properties" {
+		if _, rest, found := strings.Cut(err.Reason, `"`); found {
+			if name, _, found := strings.Cut(rest, `"`); found {
+				path = append(path, name)
+			}
+		}
+	}
+	return strings.Join(path, ".")
+}
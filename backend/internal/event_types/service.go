@@ -0,0 +1,189 @@
+package event_types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for custom event types
+type Repository interface {
+	Create(ctx context.Context, params CreateParams) (*CustomType, error)
+	Get(ctx context.Context, entityID uuid.UUID, key string) (*CustomType, error)
+	ListByEntity(ctx context.Context, entityID uuid.UUID) ([]CustomType, error)
+	// ListActive returns the types of every entity that have not been retired
+	ListActive(ctx context.Context) ([]CustomType, error)
+	Update(ctx context.Context, entityID uuid.UUID, key, displayName string, icon *string) (*CustomType, error)
+	// Retire returns ErrEventTypeNotFound when the type does not exist or was already retired
+	Retire(ctx context.Context, entityID uuid.UUID, key string) (*CustomType, error)
+}
+
+// EntityService handles entity operations
+type EntityService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Entity, error)
+}
+
+type CIDGenerator interface {
+	GenerateCID(data interface{}) (*cidpkg.CID, error)
+}
+
+// Service handles business logic for the event types entities define for their own events
+type Service struct {
+	repo         Repository
+	entities     EntityService
+	cidGenerator CIDGenerator
+}
+
+// NewService creates a new custom event type service
+func NewService(repo Repository, entities EntityService, cidGenerator CIDGenerator) *Service {
+	return &Service{
+		repo:         repo,
+		entities:     entities,
+		cidGenerator: cidGenerator,
+	}
+}
+
+// Register defines a new event type for the entity. The schema is checked before it is stored and
+// the CID of the definition is computed so it can be recorded with the type's events.
+func (s *Service) Register(ctx context.Context, entityID uuid.UUID, params RegisterParams) (*CustomType, error) {
+	if !keyPattern.MatchString(params.Key) {
+		return nil, ErrInvalidKey
+	}
+	if event.EventType(params.Key).IsBuiltIn() {
+		return nil, ErrBuiltInKey
+	}
+	displayName := strings.TrimSpace(params.DisplayName)
+	if displayName == "" {
+		return nil, ErrDisplayNameRequired
+	}
+	if _, err := compileSchema(ctx, params.MetadataSchema); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.entities.GetByID(ctx, entityID); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.Get(ctx, entityID, params.Key); err == nil {
+		return nil, ErrEventTypeExists
+	} else if !errors.Is(err, ErrEventTypeNotFound) {
+		return nil, err
+	}
+
+	cidData, err := s.cidGenerator.GenerateCID(Definition{
+		EntityID:       entityID,
+		Key:            params.Key,
+		MetadataSchema: params.MetadataSchema,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate definition CID: %w", err)
+	}
+
+	return s.repo.Create(ctx, CreateParams{
+		EntityID:       entityID,
+		Key:            params.Key,
+		DisplayName:    displayName,
+		Icon:           trimmedOrNil(params.Icon),
+		MetadataSchema: params.MetadataSchema,
+		DefinitionCID:  cidData.CID,
+	})
+}
+
+// Update changes how a custom event type is presented. Its key and schema are fixed.
+func (s *Service) Update(ctx context.Context, entityID uuid.UUID, key string, params UpdateParams) (*CustomType, error) {
+	existing, err := s.repo.Get(ctx, entityID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := existing.DisplayName
+	if params.DisplayName != nil {
+		displayName = strings.TrimSpace(*params.DisplayName)
+		if displayName == "" {
+			return nil, ErrDisplayNameRequired
+		}
+	}
+	icon := existing.Icon
+	if params.Icon != nil {
+		icon = trimmedOrNil(params.Icon)
+	}
+
+	return s.repo.Update(ctx, entityID, key, displayName, icon)
+}
+
+// Retire stops the entity from issuing new events of the type. Events already recorded with it
+// keep resolving their definition.
+func (s *Service) Retire(ctx context.Context, entityID uuid.UUID, key string) (*CustomType, error) {
+	return s.repo.Retire(ctx, entityID, key)
+}
+
+// Get retrieves one of the entity's custom event types by its key
+func (s *Service) Get(ctx context.Context, entityID uuid.UUID, key string) (*CustomType, error) {
+	return s.repo.Get(ctx, entityID, key)
+}
+
+// ListByEntity retrieves every event type the entity registered, including retired ones
+func (s *Service) ListByEntity(ctx context.Context, entityID uuid.UUID) ([]CustomType, error) {
+	return s.repo.ListByEntity(ctx, entityID)
+}
+
+// Catalogue retrieves the custom event types in use across all entities
+func (s *Service) Catalogue(ctx context.Context) ([]CustomType, error) {
+	return s.repo.ListActive(ctx)
+}
+
+// Lookup resolves a namespaced custom event type for the event service
+func (s *Service) Lookup(ctx context.Context, eventType event.EventType) (*event.CustomTypeInfo, error) {
+	t, err := s.resolve(ctx, eventType)
+	if err != nil {
+		return nil, err
+	}
+	return &event.CustomTypeInfo{
+		DefinitionCID: t.DefinitionCID,
+		Retired:       t.RetiredAt != nil,
+	}, nil
+}
+
+// ValidateMetadata checks event metadata against the schema of a namespaced custom event type
+func (s *Service) ValidateMetadata(ctx context.Context, eventType event.EventType, metadata map[string]interface{}) error {
+	t, err := s.resolve(ctx, eventType)
+	if err != nil {
+		return err
+	}
+	schema, err := compileSchema(ctx, t.MetadataSchema)
+	if err != nil {
+		return fmt.Errorf("compile schema of %s: %w", eventType, err)
+	}
+	return validateAgainst(schema, eventType, metadata)
+}
+
+func (s *Service) resolve(ctx context.Context, eventType event.EventType) (*CustomType, error) {
+	entityID, key, ok := eventType.CustomTypeOwner()
+	if !ok {
+		return nil, event.ErrUnknownEventType
+	}
+	t, err := s.repo.Get(ctx, entityID, key)
+	if err != nil {
+		if errors.Is(err, ErrEventTypeNotFound) {
+			return nil, event.ErrUnknownEventType
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
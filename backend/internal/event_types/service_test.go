@@ -0,0 +1,272 @@
+package event_types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type typeKey struct {
+	entityID uuid.UUID
+	key      string
+}
+
+type mockRepo struct {
+	types map[typeKey]*CustomType
+}
+
+func newMockRepo() *mockRepo {
+	return &mockRepo{types: map[typeKey]*CustomType{}}
+}
+
+func (m *mockRepo) Create(_ context.Context, params CreateParams) (*CustomType, error) {
+	t := &CustomType{
+		ID:             uuid.New(),
+		EntityID:       params.EntityID,
+		Key:            params.Key,
+		DisplayName:    params.DisplayName,
+		Icon:           params.Icon,
+		MetadataSchema: params.MetadataSchema,
+		DefinitionCID:  params.DefinitionCID,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	m.types[typeKey{params.EntityID, params.Key}] = t
+	return t, nil
+}
+
+func (m *mockRepo) Get(_ context.Context, entityID uuid.UUID, key string) (*CustomType, error) {
+	t, ok := m.types[typeKey{entityID, key}]
+	if !ok {
+		return nil, ErrEventTypeNotFound
+	}
+	copied := *t
+	return &copied, nil
+}
+
+func (m *mockRepo) ListByEntity(_ context.Context, entityID uuid.UUID) ([]CustomType, error) {
+	var result []CustomType
+	for k, t := range m.types {
+		if k.entityID == entityID {
+			result = append(result, *t)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepo) ListActive(_ context.Context) ([]CustomType, error) {
+	var result []CustomType
+	for _, t := range m.types {
+		if t.RetiredAt == nil {
+			result = append(result, *t)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepo) Update(_ context.Context, entityID uuid.UUID, key, displayName string, icon *string) (*CustomType, error) {
+	t, ok := m.types[typeKey{entityID, key}]
+	if !ok {
+		return nil, ErrEventTypeNotFound
+	}
+	t.DisplayName = displayName
+	t.Icon = icon
+	copied := *t
+	return &copied, nil
+}
+
+func (m *mockRepo) Retire(_ context.Context, entityID uuid.UUID, key string) (*CustomType, error) {
+	t, ok := m.types[typeKey{entityID, key}]
+	if !ok || t.RetiredAt != nil {
+		return nil, ErrEventTypeNotFound
+	}
+	now := time.Now()
+	t.RetiredAt = &now
+	copied := *t
+	return &copied, nil
+}
+
+type mockEntities struct {
+	known map[uuid.UUID]bool
+}
+
+func (m *mockEntities) GetByID(_ context.Context, id uuid.UUID) (*entity.Entity, error) {
+	if !m.known[id] {
+		return nil, entity.ErrEntityNotFound
+	}
+	return &entity.Entity{ID: id}, nil
+}
+
+type mockCIDGen struct {
+	data []interface{}
+}
+
+func (m *mockCIDGen) GenerateCID(data interface{}) (*cidpkg.CID, error) {
+	m.data = append(m.data, data)
+	return &cidpkg.CID{CID: "bafydefinition"}, nil
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func inspectionSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"inspector":   map[string]interface{}{"type": "string"},
+			"paintDepth":  map[string]interface{}{"type": "integer", "minimum": 0},
+			"inspectedOn": map[string]interface{}{"type": "string", "format": "date"},
+		},
+		"required":             []interface{}{"inspector"},
+		"additionalProperties": false,
+	}
+}
+
+func newTestService(t *testing.T) (*Service, *mockCIDGen, uuid.UUID) {
+	t.Helper()
+	entityID := uuid.New()
+	cidGen := &mockCIDGen{}
+	svc := NewService(newMockRepo(), &mockEntities{known: map[uuid.UUID]bool{entityID: true}}, cidGen)
+	return svc, cidGen, entityID
+}
+
+func register(t *testing.T, svc *Service, entityID uuid.UUID) *CustomType {
+	t.Helper()
+	created, err := svc.Register(context.Background(), entityID, RegisterParams{
+		Key:            "paint_inspection",
+		DisplayName:    "  Paint inspection ",
+		Icon:           ptr("paint-brush"),
+		MetadataSchema: inspectionSchema(),
+	})
+	require.NoError(t, err)
+	return created
+}
+
+// --- Tests ---
+
+func TestService_Register(t *testing.T) {
+	svc, cidGen, entityID := newTestService(t)
+
+	created := register(t, svc, entityID)
+
+	assert.Equal(t, "Paint inspection", created.DisplayName)
+	assert.Equal(t, "bafydefinition", created.DefinitionCID)
+	assert.Equal(t, event.CustomEventType(entityID, "paint_inspection"), created.Name())
+	require.Len(t, cidGen.data, 1)
+	assert.Equal(t, Definition{EntityID: entityID, Key: "paint_inspection", MetadataSchema: inspectionSchema()}, cidGen.data[0])
+
+	_, err := svc.Register(context.Background(), entityID, RegisterParams{
+		Key:            "paint_inspection",
+		DisplayName:    "Another",
+		MetadataSchema: inspectionSchema(),
+	})
+	assert.ErrorIs(t, err, ErrEventTypeExists)
+}
+
+func TestService_Register_Rejected(t *testing.T) {
+	svc, _, entityID := newTestService(t)
+
+	tests := []struct {
+		name     string
+		entityID uuid.UUID
+		params   RegisterParams
+		wantErr  error
+	}{
+		{"invalid key", entityID, RegisterParams{Key: "Paint Inspection", DisplayName: "Paint", MetadataSchema: inspectionSchema()}, ErrInvalidKey},
+		{"built-in key", entityID, RegisterParams{Key: "car_show", DisplayName: "Car show", MetadataSchema: inspectionSchema()}, ErrBuiltInKey},
+		{"blank display name", entityID, RegisterParams{Key: "paint", DisplayName: "  ", MetadataSchema: inspectionSchema()}, ErrDisplayNameRequired},
+		{"missing schema", entityID, RegisterParams{Key: "paint", DisplayName: "Paint"}, ErrInvalidSchema},
+		{"non-object schema", entityID, RegisterParams{Key: "paint", DisplayName: "Paint", MetadataSchema: map[string]interface{}{"type": "string"}}, ErrInvalidSchema},
+		{"malformed schema", entityID, RegisterParams{Key: "paint", DisplayName: "Paint", MetadataSchema: map[string]interface{}{"type": "object", "required": "inspector"}}, ErrInvalidSchema},
+		{"unknown entity", uuid.New(), RegisterParams{Key: "paint", DisplayName: "Paint", MetadataSchema: inspectionSchema()}, entity.ErrEntityNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Register(context.Background(), tt.entityID, tt.params)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ValidateMetadata(t *testing.T) {
+	svc, _, entityID := newTestService(t)
+	created := register(t, svc, entityID)
+
+	err := svc.ValidateMetadata(context.Background(), created.Name(), map[string]interface{}{
+		"inspector":   "J. Silva",
+		"paintDepth":  float64(120),
+		"inspectedOn": "2026-03-01",
+	})
+	require.NoError(t, err)
+
+	err = svc.ValidateMetadata(context.Background(), created.Name(), map[string]interface{}{
+		"paintDepth":  "thick",
+		"inspectedOn": "yesterday",
+		"colour":      "red",
+	})
+	require.ErrorIs(t, err, event.ErrInvalidMetadata)
+	var validationErr *event.MetadataValidationError
+	require.True(t, errors.As(err, &validationErr))
+	fields := make([]string, len(validationErr.Fields))
+	for i, f := range validationErr.Fields {
+		fields[i] = f.Field
+	}
+	assert.Equal(t, []string{"colour", "inspectedOn", "inspector", "paintDepth"}, fields)
+
+	err = svc.ValidateMetadata(context.Background(), event.CustomEventType(entityID, "unregistered"), nil)
+	assert.ErrorIs(t, err, event.ErrUnknownEventType)
+}
+
+func TestService_Lookup(t *testing.T) {
+	svc, _, entityID := newTestService(t)
+	created := register(t, svc, entityID)
+
+	info, err := svc.Lookup(context.Background(), created.Name())
+	require.NoError(t, err)
+	assert.Equal(t, "bafydefinition", info.DefinitionCID)
+	assert.False(t, info.Retired)
+
+	_, err = svc.Retire(context.Background(), entityID, created.Key)
+	require.NoError(t, err)
+
+	// Retired types keep resolving for the events already recorded with them
+	info, err = svc.Lookup(context.Background(), created.Name())
+	require.NoError(t, err)
+	assert.True(t, info.Retired)
+
+	catalogue, err := svc.Catalogue(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, catalogue)
+
+	_, err = svc.Retire(context.Background(), entityID, created.Key)
+	assert.ErrorIs(t, err, ErrEventTypeNotFound)
+
+	_, err = svc.Lookup(context.Background(), event.TypeCarShow)
+	assert.ErrorIs(t, err, event.ErrUnknownEventType)
+}
+
+func TestService_Update(t *testing.T) {
+	svc, _, entityID := newTestService(t)
+	created := register(t, svc, entityID)
+
+	updated, err := svc.Update(context.Background(), entityID, created.Key, UpdateParams{Icon: ptr(" ")})
+	require.NoError(t, err)
+	assert.Equal(t, "Paint inspection", updated.DisplayName)
+	assert.Nil(t, updated.Icon)
+	assert.Equal(t, created.DefinitionCID, updated.DefinitionCID)
+
+	_, err = svc.Update(context.Background(), entityID, created.Key, UpdateParams{DisplayName: ptr("")})
+	assert.ErrorIs(t, err, ErrDisplayNameRequired)
+
+	_, err = svc.Update(context.Background(), entityID, "missing", UpdateParams{DisplayName: ptr("Missing")})
+	assert.ErrorIs(t, err, ErrEventTypeNotFound)
+}
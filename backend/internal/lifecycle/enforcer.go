@@ -0,0 +1,190 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/custody"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	"github.com/google/uuid"
+)
+
+// VehicleRepository loads the vehicles whose assets are acted on
+type VehicleRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+}
+
+// EventRepository loads the lifecycle_change events the asset actions refer to
+type EventRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error)
+}
+
+// CustodyRepository reads and records who holds a vehicle's asset
+type CustodyRepository interface {
+	GetCustody(ctx context.Context, vehicleID uuid.UUID) (*custody.Custody, error)
+	SetHolder(ctx context.Context, vehicleID uuid.UUID, holder *string, status string, txID *string) (*custody.Custody, error)
+}
+
+// Chain is the set of ledger operations used to freeze and destroy vehicle assets
+type Chain interface {
+	Address() string
+	AssetHolding(ctx context.Context, address string, assetID uint64) (*algorand.AssetHolding, error)
+	AssetHolder(ctx context.Context, assetID uint64) (string, error)
+	ClawbackAsset(ctx context.Context, assetID uint64, holder, recipient string, amount uint64, note []byte) (string, error)
+	FreezeAsset(ctx context.Context, assetID uint64, holder string, frozen bool, note []byte) (string, error)
+	DestroyAsset(ctx context.Context, assetID uint64, note []byte) (string, error)
+}
+
+// Enforcer performs the asset actions of approved lifecycle requests on chain. It runs in the
+// anchor worker.
+type Enforcer struct {
+	repo     Repository
+	vehicles VehicleRepository
+	events   EventRepository
+	custody  CustodyRepository
+	chain    Chain
+}
+
+// NewEnforcer creates a new lifecycle enforcer
+func NewEnforcer(repo Repository, vehicles VehicleRepository, events EventRepository, custody CustodyRepository, chain Chain) *Enforcer {
+	return &Enforcer{
+		repo:     repo,
+		vehicles: vehicles,
+		events:   events,
+		custody:  custody,
+		chain:    chain,
+	}
+}
+
+// Enforce freezes, unfreezes or destroys the vehicle's asset as the request's transition
+// requires. The chain is checked before acting, so a redelivered job that already acted only
+// records the outcome. Assets are only destroyed once the lifecycle event is anchored, as the
+// anchor is written against the asset.
+func (e *Enforcer) Enforce(ctx context.Context, requestID uuid.UUID) error {
+	r, err := e.repo.GetByID(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	if r.AssetStatus == nil || *r.AssetStatus == AssetStatusDone || r.EventID == nil {
+		return nil
+	}
+
+	vehicle, err := e.vehicles.GetByID(ctx, r.VehicleID)
+	if err != nil {
+		return err
+	}
+	if vehicle.BlockchainAssetID == nil {
+		if vehicle.BlockchainStatus == vehicles.StatusPending {
+			return ErrAssetNotCreated
+		}
+		// Genesis gave up, so there is no asset to act on
+		return e.repo.SetAssetStatus(ctx, r.ID, AssetStatusDone, nil, nil)
+	}
+	assetID, err := strconv.ParseUint(*vehicle.BlockchainAssetID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse asset ID %q: %w", *vehicle.BlockchainAssetID, err)
+	}
+
+	evt, err := e.events.GetByID(ctx, *r.EventID)
+	if err != nil {
+		return err
+	}
+	var note []byte
+	if evt.CID != nil {
+		note = anchorer.LifecycleNote(*evt.CID)
+	}
+
+	c, err := e.custody.GetCustody(ctx, vehicle.ID)
+	if err != nil {
+		return err
+	}
+	if c != nil && c.Status == custody.StatusPending {
+		return ErrCustodyPending
+	}
+
+	var txID *string
+	switch assetAction(r.FromStatus, r.ToStatus) {
+	case actionFreeze:
+		txID, err = e.setFrozen(ctx, c, assetID, true, note)
+	case actionUnfreeze:
+		txID, err = e.setFrozen(ctx, c, assetID, false, note)
+	case actionDestroy:
+		if evt.BlockchainStatus != event.StatusAnchored {
+			return ErrEventNotAnchored
+		}
+		txID, err = e.destroy(ctx, vehicle.ID, assetID, note)
+	}
+	if err != nil {
+		return err
+	}
+
+	return e.repo.SetAssetStatus(ctx, r.ID, AssetStatusDone, txID, nil)
+}
+
+// Fail records that the asset action of the request was given up on
+func (e *Enforcer) Fail(ctx context.Context, requestID uuid.UUID, cause error) error {
+	reason := cause.Error()
+	return e.repo.SetAssetStatus(ctx, requestID, AssetStatusFailed, nil, &reason)
+}
+
+// setFrozen freezes or unfreezes the asset in the owner's wallet. An asset held by the platform
+// account is left alone: it only leaves the platform through a custody transfer, which is not
+// made for vehicles that are not active.
+func (e *Enforcer) setFrozen(ctx context.Context, c *custody.Custody, assetID uint64, frozen bool, note []byte) (*string, error) {
+	if c == nil || c.HolderAddress == nil {
+		return nil, nil
+	}
+	holder := *c.HolderAddress
+
+	holding, err := e.chain.AssetHolding(ctx, holder, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("check holding of %s: %w", holder, err)
+	}
+	if !holding.OptedIn || holding.Frozen == frozen {
+		return nil, nil
+	}
+
+	txID, err := e.chain.FreezeAsset(ctx, assetID, holder, frozen, note)
+	if err != nil {
+		return nil, fmt.Errorf("set frozen=%t on asset %d of %s: %w", frozen, assetID, holder, err)
+	}
+	return &txID, nil
+}
+
+// destroy claws the asset back to the platform account, which must hold the whole supply for the
+// asset to be destroyed, and then destroys it
+func (e *Enforcer) destroy(ctx context.Context, vehicleID uuid.UUID, assetID uint64, note []byte) (*string, error) {
+	platform := e.chain.Address()
+	holding, err := e.chain.AssetHolding(ctx, platform, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("check holding of %s: %w", platform, err)
+	}
+	if !holding.OptedIn {
+		// The creator's holding goes away with the asset, so it was already destroyed
+		return nil, nil
+	}
+
+	if holding.Amount == 0 {
+		holder, err := e.chain.AssetHolder(ctx, assetID)
+		if err != nil {
+			return nil, fmt.Errorf("find holder of asset %d: %w", assetID, err)
+		}
+		clawbackTxID, err := e.chain.ClawbackAsset(ctx, assetID, holder, platform, 1, note)
+		if err != nil {
+			return nil, fmt.Errorf("claw back asset %d from %s: %w", assetID, holder, err)
+		}
+		if _, err := e.custody.SetHolder(ctx, vehicleID, nil, custody.StatusPlatform, &clawbackTxID); err != nil {
+			return nil, err
+		}
+	}
+
+	txID, err := e.chain.DestroyAsset(ctx, assetID, note)
+	if err != nil {
+		return nil, fmt.Errorf("destroy asset %d: %w", assetID, err)
+	}
+	return &txID, nil
+}
@@ -0,0 +1,126 @@
+package lifecycle
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRequestNotFound       = errors.New("lifecycle request not found")
+	ErrRequestNotPending     = errors.New("lifecycle request has already been decided")
+	ErrRequestAlreadyPending = errors.New("vehicle already has a pending lifecycle request")
+	ErrInvalidTransition     = errors.New("vehicle cannot move to the requested lifecycle status")
+	ErrNotCertifier          = errors.New("entity is not a certifier")
+	ErrReasonRequired        = errors.New("a reason is required")
+	ErrEventNotAnchored      = errors.New("lifecycle event is not anchored yet")
+	ErrAssetNotCreated       = errors.New("vehicle asset is still being created")
+	ErrCustodyPending        = errors.New("a custody transfer of the vehicle asset is in progress")
+)
+
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+
+	// AssetStatusPending means the freeze or destroy of the vehicle's asset is queued
+	AssetStatusPending = "pending"
+	AssetStatusDone    = "done"
+	AssetStatusFailed  = "failed"
+
+	// SubjectEnforce is the outbox subject of asset actions, handled by the anchor worker
+	SubjectEnforce = "anchor.lifecycle"
+)
+
+// transitions lists the statuses each lifecycle status can move to. Scrapped is final: the asset
+// is destroyed and the record closed.
+var transitions = map[string][]string{
+	vehicles.LifecycleActive:   {vehicles.LifecycleStolen, vehicles.LifecycleScrapped, vehicles.LifecycleExported, vehicles.LifecycleArchived},
+	vehicles.LifecycleStolen:   {vehicles.LifecycleActive, vehicles.LifecycleScrapped},
+	vehicles.LifecycleExported: {vehicles.LifecycleActive, vehicles.LifecycleArchived},
+	vehicles.LifecycleArchived: {vehicles.LifecycleActive},
+}
+
+// CanTransition reports whether a vehicle in the from status may move to the to status
+func CanTransition(from, to string) bool {
+	if from == "" {
+		from = vehicles.LifecycleActive
+	}
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Asset actions the platform performs on a vehicle's asset when its lifecycle status changes
+const (
+	actionNone     = ""
+	actionFreeze   = "freeze"
+	actionUnfreeze = "unfreeze"
+	actionDestroy  = "destroy"
+)
+
+// assetAction returns what the transition requires of the vehicle's asset. A stolen vehicle's
+// asset is frozen in the owner's wallet so it cannot be passed on, and a scrapped vehicle's asset
+// is clawed back and destroyed.
+func assetAction(from, to string) string {
+	switch {
+	case to == vehicles.LifecycleScrapped:
+		return actionDestroy
+	case to == vehicles.LifecycleStolen:
+		return actionFreeze
+	case from == vehicles.LifecycleStolen:
+		return actionUnfreeze
+	}
+	return actionNone
+}
+
+// Request asks for a vehicle to move to another lifecycle status. Requests are made by the owner
+// and decided by an admin, or by the certifier entity they were addressed to. Approving one
+// records an anchored lifecycle_change event and queues the action it requires on the asset.
+type Request struct {
+	ID            uuid.UUID  `json:"id"`
+	VehicleID     uuid.UUID  `json:"vehicleId"`
+	FromStatus    string     `json:"fromStatus"`
+	ToStatus      string     `json:"toStatus"`
+	Reason        string     `json:"reason"`
+	RequestedBy   uuid.UUID  `json:"requestedBy"`
+	EntityID      *uuid.UUID `json:"entityId,omitempty"`
+	Status        string     `json:"status"`
+	DecidedBy     *uuid.UUID `json:"decidedBy,omitempty"`
+	DeclineReason *string    `json:"declineReason,omitempty"`
+	EventID       *uuid.UUID `json:"eventId,omitempty"`
+	AssetStatus   *string    `json:"assetStatus,omitempty"`
+	AssetTxID     *string    `json:"assetTxId,omitempty"`
+	AssetError    *string    `json:"assetError,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	DecidedAt     *time.Time `json:"decidedAt,omitempty"`
+}
+
+// CreateRequestParams represents parameters for creating a new lifecycle request
+type CreateRequestParams struct {
+	VehicleID   uuid.UUID
+	FromStatus  string
+	ToStatus    string
+	Reason      string
+	RequestedBy uuid.UUID
+	EntityID    *uuid.UUID
+}
+
+// SubmitParams represents a request to move a vehicle to another lifecycle status. Without an
+// EntityID the request is decided by an admin.
+type SubmitParams struct {
+	ToStatus    string
+	Reason      string
+	RequestedBy uuid.UUID
+	EntityID    *uuid.UUID
+}
+
+// EnforceJob asks the worker to perform the asset action of an approved request
+type EnforceJob struct {
+	RequestID uuid.UUID `json:"requestId"`
+}
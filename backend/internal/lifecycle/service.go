@@ -0,0 +1,322 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for lifecycle requests
+type Repository interface {
+	// Create returns ErrRequestAlreadyPending when the vehicle already has a pending request
+	Create(ctx context.Context, params CreateRequestParams) (*Request, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Request, error)
+	ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Request, error)
+	// List returns the requests addressed to the entity, or every request if entityID is nil, in
+	// the given status, or all of them if status is empty
+	List(ctx context.Context, entityID *uuid.UUID, status string) ([]Request, error)
+	// ListByAssetStatus returns requests whose asset action has been in the status since before
+	// olderThan, oldest first
+	ListByAssetStatus(ctx context.Context, assetStatus string, olderThan time.Duration, limit int) ([]Request, error)
+	// Decide returns ErrRequestNotPending when the request has already been decided
+	Decide(ctx context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*Request, error)
+	SetEvent(ctx context.Context, id, eventID uuid.UUID, assetStatus *string) error
+	SetAssetStatus(ctx context.Context, id uuid.UUID, assetStatus string, txID, assetError *string) error
+}
+
+// VehicleService handles vehicle operations
+type VehicleService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+	SetLifecycleStatus(ctx context.Context, id uuid.UUID, from, to string) error
+}
+
+// EventService records the lifecycle_change events
+type EventService interface {
+	RecordLifecycleChange(ctx context.Context, vehicle vehicles.Vehicle, params event.CreateEventParams) (*event.Event, error)
+}
+
+// EntityService handles entity operations
+type EntityService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Entity, error)
+}
+
+// Transactor runs a function inside a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service handles business logic for vehicle lifecycle requests
+type Service struct {
+	repo       Repository
+	vehicles   VehicleService
+	events     EventService
+	entities   EntityService
+	publisher  queue.Publisher
+	transactor Transactor
+}
+
+// NewService creates a new lifecycle service. Asset actions are published within the
+// transaction that approves them, so publisher is expected to be the outbox.
+func NewService(repo Repository, vehicles VehicleService, events EventService, entities EntityService, publisher queue.Publisher, transactor Transactor) *Service {
+	return &Service{
+		repo:       repo,
+		vehicles:   vehicles,
+		events:     events,
+		entities:   entities,
+		publisher:  publisher,
+		transactor: transactor,
+	}
+}
+
+// Submit asks for the vehicle to move to another lifecycle status. The request is addressed to
+// the certifier entity in params, or to the admins when there is none.
+func (s *Service) Submit(ctx context.Context, vehicle vehicles.Vehicle, params SubmitParams) (*Request, error) {
+	from := vehicle.LifecycleStatus
+	if from == "" {
+		from = vehicles.LifecycleActive
+	}
+	if !CanTransition(from, params.ToStatus) {
+		return nil, ErrInvalidTransition
+	}
+
+	reason := strings.TrimSpace(params.Reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	if params.EntityID != nil {
+		ent, err := s.entities.GetByID(ctx, *params.EntityID)
+		if err != nil {
+			return nil, err
+		}
+		if ent.Type != entity.TypeCertifier {
+			return nil, ErrNotCertifier
+		}
+	}
+
+	return s.repo.Create(ctx, CreateRequestParams{
+		VehicleID:   vehicle.ID,
+		FromStatus:  from,
+		ToStatus:    params.ToStatus,
+		Reason:      reason,
+		RequestedBy: params.RequestedBy,
+		EntityID:    params.EntityID,
+	})
+}
+
+// Approve accepts a pending request on behalf of the entity it was addressed to, or of an admin
+// when entityID is nil. The vehicle's status is changed and the anchored lifecycle_change event
+// recorded in the same transaction, which also queues the freeze or destroy of the asset the
+// transition requires.
+func (s *Service) Approve(ctx context.Context, entityID *uuid.UUID, requestID, decidedBy uuid.UUID) (*Request, error) {
+	r, err := s.getForDecider(ctx, entityID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	var approved *Request
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		approved, err = s.apply(ctx, *r, decidedBy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return approved, nil
+}
+
+// Record makes a request on behalf of an admin and approves it at once. It is used by flows that
+// have their own review, such as stolen vehicle reports.
+func (s *Service) Record(ctx context.Context, vehicle vehicles.Vehicle, params SubmitParams, decidedBy uuid.UUID) (*Request, error) {
+	params.EntityID = nil
+
+	var approved *Request
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		r, err := s.Submit(ctx, vehicle, params)
+		if err != nil {
+			return err
+		}
+		approved, err = s.apply(ctx, *r, decidedBy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return approved, nil
+}
+
+// apply approves a pending request. It must run inside a transaction.
+func (s *Service) apply(ctx context.Context, r Request, decidedBy uuid.UUID) (*Request, error) {
+	approved, err := s.repo.Decide(ctx, r.ID, StatusApproved, decidedBy, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicle, err := s.vehicles.GetByID(ctx, r.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The event is public and permanently anchored, so the requester's reason stays on the request
+	description := lifecycleEventDescription(r.FromStatus, r.ToStatus)
+	evt, err := s.events.RecordLifecycleChange(ctx, *vehicle, event.CreateEventParams{
+		VehicleID:   vehicle.ID,
+		EntityID:    r.EntityID,
+		Title:       lifecycleEventTitle(r.ToStatus),
+		Description: &description,
+		Metadata: map[string]interface{}{
+			"fromStatus":                     r.FromStatus,
+			"toStatus":                       r.ToStatus,
+			event.MetadataLifecycleRequestID: r.ID.String(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record lifecycle event: %w", err)
+	}
+
+	// Fails when the vehicle moved since the request was made, rolling the approval back
+	if err := s.vehicles.SetLifecycleStatus(ctx, vehicle.ID, r.FromStatus, r.ToStatus); err != nil {
+		return nil, err
+	}
+
+	var assetStatus *string
+	if needsAssetAction(*vehicle, r.FromStatus, r.ToStatus) {
+		status := AssetStatusPending
+		assetStatus = &status
+		if err := s.queueEnforce(ctx, r.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.SetEvent(ctx, r.ID, evt.ID, assetStatus); err != nil {
+		return nil, fmt.Errorf("set lifecycle event: %w", err)
+	}
+	approved.EventID = &evt.ID
+	approved.AssetStatus = assetStatus
+	return approved, nil
+}
+
+// Reject declines a pending request on behalf of the entity it was addressed to, or of an admin
+// when entityID is nil, optionally explaining why
+func (s *Service) Reject(ctx context.Context, entityID *uuid.UUID, requestID, decidedBy uuid.UUID, reason *string) (*Request, error) {
+	r, err := s.getForDecider(ctx, entityID, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason != nil {
+		trimmed := strings.TrimSpace(*reason)
+		reason = nil
+		if trimmed != "" {
+			reason = &trimmed
+		}
+	}
+
+	return s.repo.Decide(ctx, r.ID, StatusRejected, decidedBy, reason)
+}
+
+// ListByVehicle retrieves the lifecycle requests made for a vehicle, newest first
+func (s *Service) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Request, error) {
+	return s.repo.ListByVehicle(ctx, vehicleID)
+}
+
+// List retrieves the review queue of an entity, or of the admins when entityID is nil, oldest
+// first. Admins see every request. An empty status lists every request.
+func (s *Service) List(ctx context.Context, entityID *uuid.UUID, status string) ([]Request, error) {
+	return s.repo.List(ctx, entityID, status)
+}
+
+// RequeueStalledAssetActions publishes the asset actions again for approved requests whose action
+// has been pending for longer than olderThan, typically because the lifecycle event took longer
+// to anchor than the worker kept retrying. It returns how many were requeued.
+func (s *Service) RequeueStalledAssetActions(ctx context.Context, olderThan time.Duration, limit int) (int, error) {
+	stalled, err := s.repo.ListByAssetStatus(ctx, AssetStatusPending, olderThan, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	requeued := 0
+	for _, r := range stalled {
+		if err := s.queueEnforce(ctx, r.ID); err != nil {
+			return requeued, err
+		}
+		requeued++
+	}
+	return requeued, nil
+}
+
+func (s *Service) queueEnforce(ctx context.Context, requestID uuid.UUID) error {
+	jobData, err := json.Marshal(EnforceJob{RequestID: requestID})
+	if err != nil {
+		return fmt.Errorf("marshal lifecycle job: %w", err)
+	}
+	if err := s.publisher.Publish(ctx, SubjectEnforce, jobData); err != nil {
+		return fmt.Errorf("publish lifecycle job: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) getForDecider(ctx context.Context, entityID *uuid.UUID, requestID uuid.UUID) (*Request, error) {
+	r, err := s.repo.GetByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if entityID != nil && (r.EntityID == nil || *r.EntityID != *entityID) {
+		return nil, ErrRequestNotFound
+	}
+	if r.Status != StatusPending {
+		return nil, ErrRequestNotPending
+	}
+	return r, nil
+}
+
+// needsAssetAction reports whether the transition requires the platform to act on the vehicle's
+// asset. Vehicles whose genesis is still in flight are included, as their asset will exist.
+func needsAssetAction(vehicle vehicles.Vehicle, from, to string) bool {
+	if assetAction(from, to) == actionNone {
+		return false
+	}
+	return vehicle.BlockchainAssetID != nil || vehicle.BlockchainStatus == vehicles.StatusPending
+}
+
+func lifecycleEventTitle(status string) string {
+	switch status {
+	case vehicles.LifecycleStolen:
+		return "Reported stolen"
+	case vehicles.LifecycleScrapped:
+		return "Scrapped"
+	case vehicles.LifecycleExported:
+		return "Exported"
+	case vehicles.LifecycleArchived:
+		return "Archived"
+	}
+	return "Returned to active"
+}
+
+func lifecycleEventDescription(from, to string) string {
+	switch to {
+	case vehicles.LifecycleStolen:
+		return "The vehicle was reported stolen."
+	case vehicles.LifecycleScrapped:
+		return "The vehicle was scrapped and permanently taken off the road."
+	case vehicles.LifecycleExported:
+		return "The vehicle was exported."
+	case vehicles.LifecycleArchived:
+		return "The vehicle's record was archived."
+	}
+	if from == vehicles.LifecycleStolen {
+		return "The vehicle was recovered after being reported stolen."
+	}
+	return "The vehicle was returned to active use."
+}
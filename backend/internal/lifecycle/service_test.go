@@ -0,0 +1,529 @@
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/custody"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger/simulated"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockRepo struct {
+	requests map[uuid.UUID]*Request
+}
+
+func (m *mockRepo) Create(_ context.Context, params CreateRequestParams) (*Request, error) {
+	for _, r := range m.requests {
+		if r.VehicleID == params.VehicleID && r.Status == StatusPending {
+			return nil, ErrRequestAlreadyPending
+		}
+	}
+	r := &Request{
+		ID:          uuid.New(),
+		VehicleID:   params.VehicleID,
+		FromStatus:  params.FromStatus,
+		ToStatus:    params.ToStatus,
+		Reason:      params.Reason,
+		RequestedBy: params.RequestedBy,
+		EntityID:    params.EntityID,
+		Status:      StatusPending,
+		CreatedAt:   time.Now(),
+	}
+	m.requests[r.ID] = r
+	copied := *r
+	return &copied, nil
+}
+
+func (m *mockRepo) GetByID(_ context.Context, id uuid.UUID) (*Request, error) {
+	r, ok := m.requests[id]
+	if !ok {
+		return nil, ErrRequestNotFound
+	}
+	copied := *r
+	return &copied, nil
+}
+
+func (m *mockRepo) ListByVehicle(_ context.Context, vehicleID uuid.UUID) ([]Request, error) {
+	var result []Request
+	for _, r := range m.requests {
+		if r.VehicleID == vehicleID {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepo) List(_ context.Context, entityID *uuid.UUID, status string) ([]Request, error) {
+	var result []Request
+	for _, r := range m.requests {
+		if entityID != nil && (r.EntityID == nil || *r.EntityID != *entityID) {
+			continue
+		}
+		if status != "" && r.Status != status {
+			continue
+		}
+		result = append(result, *r)
+	}
+	return result, nil
+}
+
+func (m *mockRepo) ListByAssetStatus(_ context.Context, assetStatus string, _ time.Duration, _ int) ([]Request, error) {
+	var result []Request
+	for _, r := range m.requests {
+		if r.AssetStatus != nil && *r.AssetStatus == assetStatus {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepo) Decide(_ context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*Request, error) {
+	r, ok := m.requests[id]
+	if !ok || r.Status != StatusPending {
+		return nil, ErrRequestNotPending
+	}
+	now := time.Now()
+	r.Status = status
+	r.DecidedBy = &decidedBy
+	r.DeclineReason = declineReason
+	r.DecidedAt = &now
+	copied := *r
+	return &copied, nil
+}
+
+func (m *mockRepo) SetEvent(_ context.Context, id, eventID uuid.UUID, assetStatus *string) error {
+	r := m.requests[id]
+	r.EventID = &eventID
+	r.AssetStatus = assetStatus
+	return nil
+}
+
+func (m *mockRepo) SetAssetStatus(_ context.Context, id uuid.UUID, assetStatus string, txID, assetError *string) error {
+	r := m.requests[id]
+	r.AssetStatus = &assetStatus
+	if txID != nil {
+		r.AssetTxID = txID
+	}
+	r.AssetError = assetError
+	return nil
+}
+
+type mockVehicles struct {
+	vehicles map[uuid.UUID]*vehicles.Vehicle
+}
+
+func (m *mockVehicles) GetByID(_ context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
+	v, ok := m.vehicles[id]
+	if !ok {
+		return nil, vehicles.ErrVehicleNotFound
+	}
+	copied := *v
+	return &copied, nil
+}
+
+func (m *mockVehicles) SetLifecycleStatus(_ context.Context, id uuid.UUID, from, to string) error {
+	v := m.vehicles[id]
+	if v.LifecycleStatus != from {
+		return vehicles.ErrLifecycleStatusChanged
+	}
+	v.LifecycleStatus = to
+	return nil
+}
+
+type mockEvents struct {
+	events map[uuid.UUID]*event.Event
+}
+
+func (m *mockEvents) RecordLifecycleChange(_ context.Context, vehicle vehicles.Vehicle, params event.CreateEventParams) (*event.Event, error) {
+	cid := "bafy-" + uuid.NewString()
+	evt := &event.Event{
+		ID:               uuid.New(),
+		VehicleID:        vehicle.ID,
+		EntityID:         params.EntityID,
+		Type:             event.TypeLifecycleChange,
+		Title:            params.Title,
+		Description:      params.Description,
+		Metadata:         params.Metadata,
+		CID:              &cid,
+		BlockchainStatus: event.StatusPending,
+	}
+	m.events[evt.ID] = evt
+	copied := *evt
+	return &copied, nil
+}
+
+func (m *mockEvents) GetByID(_ context.Context, id uuid.UUID) (*event.Event, error) {
+	evt, ok := m.events[id]
+	if !ok {
+		return nil, event.ErrEventNotFound
+	}
+	copied := *evt
+	return &copied, nil
+}
+
+type mockEntities struct {
+	entities map[uuid.UUID]*entity.Entity
+}
+
+func (m *mockEntities) GetByID(_ context.Context, id uuid.UUID) (*entity.Entity, error) {
+	e, ok := m.entities[id]
+	if !ok {
+		return nil, entity.ErrEntityNotFound
+	}
+	return e, nil
+}
+
+type mockCustody struct {
+	custody map[uuid.UUID]custody.Custody
+}
+
+func (m *mockCustody) GetCustody(_ context.Context, vehicleID uuid.UUID) (*custody.Custody, error) {
+	c, ok := m.custody[vehicleID]
+	if !ok {
+		return nil, nil
+	}
+	return &c, nil
+}
+
+func (m *mockCustody) SetHolder(_ context.Context, vehicleID uuid.UUID, holder *string, status string, txID *string) (*custody.Custody, error) {
+	c := custody.Custody{VehicleID: vehicleID, HolderAddress: holder, Status: status, TxID: txID}
+	m.custody[vehicleID] = c
+	return &c, nil
+}
+
+type mockPublisher struct {
+	subjects []string
+	jobs     []EnforceJob
+}
+
+func (m *mockPublisher) Publish(_ context.Context, subject string, data []byte) error {
+	var job EnforceJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return err
+	}
+	m.subjects = append(m.subjects, subject)
+	m.jobs = append(m.jobs, job)
+	return nil
+}
+
+func (m *mockPublisher) Close() error { return nil }
+
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// --- Helpers ---
+
+type fixture struct {
+	repo      *mockRepo
+	vehicles  *mockVehicles
+	events    *mockEvents
+	entities  *mockEntities
+	custody   *mockCustody
+	publisher *mockPublisher
+	ledger    *simulated.Ledger
+	svc       *Service
+	enforcer  *Enforcer
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ledger, err := simulated.New(simulated.Config{})
+	require.NoError(t, err)
+
+	f := &fixture{
+		repo:      &mockRepo{requests: map[uuid.UUID]*Request{}},
+		vehicles:  &mockVehicles{vehicles: map[uuid.UUID]*vehicles.Vehicle{}},
+		events:    &mockEvents{events: map[uuid.UUID]*event.Event{}},
+		entities:  &mockEntities{entities: map[uuid.UUID]*entity.Entity{}},
+		custody:   &mockCustody{custody: map[uuid.UUID]custody.Custody{}},
+		publisher: &mockPublisher{},
+		ledger:    ledger,
+	}
+	f.svc = NewService(f.repo, f.vehicles, f.events, f.entities, f.publisher, mockTransactor{})
+	f.enforcer = NewEnforcer(f.repo, f.vehicles, f.events, f.custody, ledger)
+	return f
+}
+
+// addVehicle creates an active vehicle whose asset is held by the platform
+func (f *fixture) addVehicle(t *testing.T) (*vehicles.Vehicle, uint64) {
+	t.Helper()
+	ctx := context.Background()
+	stxn, err := f.ledger.SignAssetCreation(ctx, algorand.AssetParams{AssetName: "CC_vehicle", UnitName: "CCV", Total: 1})
+	require.NoError(t, err)
+	created, err := f.ledger.SubmitAssetCreation(ctx, stxn)
+	require.NoError(t, err)
+
+	assetID := strconv.FormatUint(created.AssetID, 10)
+	ownerID := uuid.New()
+	v := &vehicles.Vehicle{
+		ID:                uuid.New(),
+		OwnerID:           &ownerID,
+		BlockchainAssetID: &assetID,
+		BlockchainStatus:  vehicles.StatusAnchored,
+		LifecycleStatus:   vehicles.LifecycleActive,
+	}
+	f.vehicles.vehicles[v.ID] = v
+	return v, created.AssetID
+}
+
+// deliver moves the vehicle's asset to a wallet of the owner, as the custody worker would
+func (f *fixture) deliver(t *testing.T, vehicleID uuid.UUID, assetID uint64) string {
+	t.Helper()
+	ctx := context.Background()
+	wallet := crypto.GenerateAccount().Address.String()
+	require.NoError(t, f.ledger.OptIn(ctx, wallet, assetID))
+	txID, err := f.ledger.TransferAsset(ctx, assetID, wallet, 1, nil)
+	require.NoError(t, err)
+	_, err = f.custody.SetHolder(ctx, vehicleID, &wallet, custody.StatusOwner, &txID)
+	require.NoError(t, err)
+	return wallet
+}
+
+func (f *fixture) transition(t *testing.T, vehicle *vehicles.Vehicle, to string) *Request {
+	t.Helper()
+	ctx := context.Background()
+	current, err := f.vehicles.GetByID(ctx, vehicle.ID)
+	require.NoError(t, err)
+	r, err := f.svc.Submit(ctx, *current, SubmitParams{ToStatus: to, Reason: "reported by owner", RequestedBy: *vehicle.OwnerID})
+	require.NoError(t, err)
+	approved, err := f.svc.Approve(ctx, nil, r.ID, uuid.New())
+	require.NoError(t, err)
+	return approved
+}
+
+func (f *fixture) holding(t *testing.T, address string, assetID uint64) *algorand.AssetHolding {
+	t.Helper()
+	holding, err := f.ledger.AssetHolding(context.Background(), address, assetID)
+	require.NoError(t, err)
+	return holding
+}
+
+// --- Tests ---
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{vehicles.LifecycleActive, vehicles.LifecycleStolen, true},
+		{"", vehicles.LifecycleScrapped, true},
+		{vehicles.LifecycleStolen, vehicles.LifecycleActive, true},
+		{vehicles.LifecycleStolen, vehicles.LifecycleExported, false},
+		{vehicles.LifecycleExported, vehicles.LifecycleArchived, true},
+		{vehicles.LifecycleArchived, vehicles.LifecycleScrapped, false},
+		{vehicles.LifecycleScrapped, vehicles.LifecycleActive, false},
+		{vehicles.LifecycleActive, vehicles.LifecycleActive, false},
+		{vehicles.LifecycleActive, "merged", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.allowed, CanTransition(tt.from, tt.to), "%s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestService_Submit_Validates(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	vehicle, _ := f.addVehicle(t)
+	requester := *vehicle.OwnerID
+
+	_, err := f.svc.Submit(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleActive, Reason: "found", RequestedBy: requester})
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+
+	_, err = f.svc.Submit(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleStolen, Reason: "   ", RequestedBy: requester})
+	assert.ErrorIs(t, err, ErrReasonRequired)
+
+	partnerID := uuid.New()
+	f.entities.entities[partnerID] = &entity.Entity{ID: partnerID, Type: entity.TypePartner}
+	_, err = f.svc.Submit(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleStolen, Reason: "stolen", RequestedBy: requester, EntityID: &partnerID})
+	assert.ErrorIs(t, err, ErrNotCertifier)
+
+	r, err := f.svc.Submit(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleStolen, Reason: " stolen from garage ", RequestedBy: requester})
+	require.NoError(t, err)
+	assert.Equal(t, vehicles.LifecycleActive, r.FromStatus)
+	assert.Equal(t, "stolen from garage", r.Reason)
+
+	_, err = f.svc.Submit(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleScrapped, Reason: "scrapped", RequestedBy: requester})
+	assert.ErrorIs(t, err, ErrRequestAlreadyPending)
+}
+
+func TestService_Decide_ScopedToEntity(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	vehicle, _ := f.addVehicle(t)
+
+	certifierID, otherID := uuid.New(), uuid.New()
+	f.entities.entities[certifierID] = &entity.Entity{ID: certifierID, Type: entity.TypeCertifier}
+	r, err := f.svc.Submit(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleExported, Reason: "sold abroad", RequestedBy: *vehicle.OwnerID, EntityID: &certifierID})
+	require.NoError(t, err)
+
+	_, err = f.svc.Approve(ctx, &otherID, r.ID, uuid.New())
+	assert.ErrorIs(t, err, ErrRequestNotFound)
+
+	reason := "  no export papers "
+	rejected, err := f.svc.Reject(ctx, &certifierID, r.ID, uuid.New(), &reason)
+	require.NoError(t, err)
+	assert.Equal(t, StatusRejected, rejected.Status)
+	assert.Equal(t, "no export papers", *rejected.DeclineReason)
+
+	_, err = f.svc.Approve(ctx, nil, r.ID, uuid.New())
+	assert.ErrorIs(t, err, ErrRequestNotPending)
+	assert.Equal(t, vehicles.LifecycleActive, f.vehicles.vehicles[vehicle.ID].LifecycleStatus)
+}
+
+func TestService_Approve_RecordsEventWithoutAssetAction(t *testing.T) {
+	f := newFixture(t)
+	vehicle, _ := f.addVehicle(t)
+
+	approved := f.transition(t, vehicle, vehicles.LifecycleExported)
+
+	assert.Equal(t, StatusApproved, approved.Status)
+	assert.Nil(t, approved.AssetStatus)
+	assert.Empty(t, f.publisher.jobs)
+	assert.Equal(t, vehicles.LifecycleExported, f.vehicles.vehicles[vehicle.ID].LifecycleStatus)
+
+	require.NotNil(t, approved.EventID)
+	evt := f.events.events[*approved.EventID]
+	assert.Equal(t, event.TypeLifecycleChange, evt.Type)
+	assert.Equal(t, vehicles.LifecycleActive, evt.Metadata["fromStatus"])
+	assert.Equal(t, vehicles.LifecycleExported, evt.Metadata["toStatus"])
+	assert.Equal(t, approved.ID.String(), evt.Metadata[event.MetadataLifecycleRequestID])
+	// The requester's reason is kept on the request, off the public event
+	require.NotNil(t, evt.Description)
+	assert.Equal(t, "The vehicle was exported.", *evt.Description)
+	assert.Equal(t, "reported by owner", approved.Reason)
+}
+
+func TestService_Approve_VehicleMovedSinceRequest(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	vehicle, _ := f.addVehicle(t)
+
+	r, err := f.svc.Submit(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleArchived, Reason: "museum piece", RequestedBy: *vehicle.OwnerID})
+	require.NoError(t, err)
+	f.vehicles.vehicles[vehicle.ID].LifecycleStatus = vehicles.LifecycleStolen
+
+	_, err = f.svc.Approve(ctx, nil, r.ID, uuid.New())
+	assert.ErrorIs(t, err, vehicles.ErrLifecycleStatusChanged)
+}
+
+func TestService_Record_ApprovesOnBehalfOfAdmin(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	vehicle, _ := f.addVehicle(t)
+	entityID := uuid.New()
+
+	recorded, err := f.svc.Record(ctx, *vehicle, SubmitParams{
+		ToStatus:    vehicles.LifecycleArchived,
+		Reason:      "museum piece",
+		RequestedBy: *vehicle.OwnerID,
+		EntityID:    &entityID,
+	}, uuid.New())
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusApproved, recorded.Status)
+	assert.Nil(t, recorded.EntityID)
+	require.NotNil(t, recorded.EventID)
+	assert.Equal(t, vehicles.LifecycleArchived, f.vehicles.vehicles[vehicle.ID].LifecycleStatus)
+
+	_, err = f.svc.Record(ctx, *vehicle, SubmitParams{ToStatus: vehicles.LifecycleScrapped, Reason: "crushed", RequestedBy: *vehicle.OwnerID}, uuid.New())
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+}
+
+func TestEnforcer_StolenFreezesOwnerWallet(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	vehicle, assetID := f.addVehicle(t)
+	wallet := f.deliver(t, vehicle.ID, assetID)
+
+	stolen := f.transition(t, vehicle, vehicles.LifecycleStolen)
+	require.NotNil(t, stolen.AssetStatus)
+	assert.Equal(t, AssetStatusPending, *stolen.AssetStatus)
+	require.Len(t, f.publisher.jobs, 1)
+	assert.Equal(t, SubjectEnforce, f.publisher.subjects[0])
+	assert.Equal(t, stolen.ID, f.publisher.jobs[0].RequestID)
+
+	require.NoError(t, f.enforcer.Enforce(ctx, stolen.ID))
+	assert.True(t, f.holding(t, wallet, assetID).Frozen)
+	r := f.repo.requests[stolen.ID]
+	assert.Equal(t, AssetStatusDone, *r.AssetStatus)
+	require.NotNil(t, r.AssetTxID)
+
+	// A redelivered job leaves the recorded outcome alone
+	require.NoError(t, f.enforcer.Enforce(ctx, stolen.ID))
+
+	recovered := f.transition(t, vehicle, vehicles.LifecycleActive)
+	require.NoError(t, f.enforcer.Enforce(ctx, recovered.ID))
+	assert.False(t, f.holding(t, wallet, assetID).Frozen)
+	assert.Equal(t, wallet, *f.custody.custody[vehicle.ID].HolderAddress)
+}
+
+func TestEnforcer_ScrappedDestroysAssetOnceAnchored(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	vehicle, assetID := f.addVehicle(t)
+	f.deliver(t, vehicle.ID, assetID)
+
+	scrapped := f.transition(t, vehicle, vehicles.LifecycleScrapped)
+
+	err := f.enforcer.Enforce(ctx, scrapped.ID)
+	assert.ErrorIs(t, err, ErrEventNotAnchored)
+
+	f.events.events[*scrapped.EventID].BlockchainStatus = event.StatusAnchored
+	require.NoError(t, f.enforcer.Enforce(ctx, scrapped.ID))
+
+	assert.False(t, f.holding(t, f.ledger.Address(), assetID).OptedIn)
+	c := f.custody.custody[vehicle.ID]
+	assert.Equal(t, custody.StatusPlatform, c.Status)
+	assert.Nil(t, c.HolderAddress)
+	assert.Equal(t, AssetStatusDone, *f.repo.requests[scrapped.ID].AssetStatus)
+
+	// Scrapped is final
+	_, err = f.svc.Submit(ctx, *f.vehicles.vehicles[vehicle.ID], SubmitParams{ToStatus: vehicles.LifecycleActive, Reason: "mistake", RequestedBy: *vehicle.OwnerID})
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+}
+
+func TestEnforcer_WaitsForPendingCustodyTransfer(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	vehicle, _ := f.addVehicle(t)
+	f.custody.custody[vehicle.ID] = custody.Custody{VehicleID: vehicle.ID, Status: custody.StatusPending}
+
+	stolen := f.transition(t, vehicle, vehicles.LifecycleStolen)
+
+	err := f.enforcer.Enforce(ctx, stolen.ID)
+	assert.ErrorIs(t, err, ErrCustodyPending)
+
+	require.NoError(t, f.enforcer.Fail(ctx, stolen.ID, err))
+	r := f.repo.requests[stolen.ID]
+	assert.Equal(t, AssetStatusFailed, *r.AssetStatus)
+	assert.Equal(t, ErrCustodyPending.Error(), *r.AssetError)
+}
+
+func TestService_RequeueStalledAssetActions(t *testing.T) {
+	f := newFixture(t)
+	vehicle, assetID := f.addVehicle(t)
+	f.deliver(t, vehicle.ID, assetID)
+	scrapped := f.transition(t, vehicle, vehicles.LifecycleScrapped)
+
+	n, err := f.svc.RequeueStalledAssetActions(context.Background(), time.Minute, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Len(t, f.publisher.jobs, 2)
+	assert.Equal(t, scrapped.ID, f.publisher.jobs[1].RequestID)
+}
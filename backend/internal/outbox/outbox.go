@@ -0,0 +1,13 @@
+package outbox
+
+import "time"
+
+// Message is a queue message stored in the outbox until it has been published
+type Message struct {
+	ID        int64
+	Subject   string
+	Payload   []byte
+	Attempts  int
+	LastError *string
+	CreatedAt time.Time
+}
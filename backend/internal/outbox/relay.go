@@ -0,0 +1,149 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
+)
+
+const (
+	DefaultPollInterval = time.Second
+	DefaultBatchSize    = 100
+	DefaultRetention    = 7 * 24 * time.Hour
+
+	cleanupInterval = time.Hour
+
+	// A message the queue rejected is retried after retryDelay, doubling with every failed
+	// attempt up to maxRetryDelay
+	retryDelay    = time.Second
+	maxRetryDelay = 5 * time.Minute
+)
+
+// Repository defines the data access interface for the outbox
+type Repository interface {
+	// ClaimPending locks the claimed messages until the transaction ends; messages claimed by
+	// another relay are skipped
+	ClaimPending(ctx context.Context, limit int) ([]Message, error)
+	MarkPublished(ctx context.Context, id int64) error
+	// MarkFailed records a failed attempt and holds the message back until retryAt
+	MarkFailed(ctx context.Context, id int64, lastError string, retryAt time.Time) error
+	DeletePublishedBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// Transactor runs a function within a database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Relay drains the outbox into the message queue. Messages are marked published only
+// after the queue has accepted them, so delivery is at-least-once: a crash between the
+// two steps publishes the message again on the next poll. Each batch is claimed in its own
+// transaction, so several relays can drain the outbox without publishing the same message.
+type Relay struct {
+	repo         Repository
+	publisher    queue.Publisher
+	transactor   Transactor
+	pollInterval time.Duration
+	batchSize    int
+	retention    time.Duration
+}
+
+// NewRelay creates a new outbox relay
+func NewRelay(repo Repository, publisher queue.Publisher, transactor Transactor, pollInterval time.Duration, batchSize int) *Relay {
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	return &Relay{
+		repo:         repo,
+		publisher:    publisher,
+		transactor:   transactor,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		retention:    DefaultRetention,
+	}
+}
+
+// Start polls the outbox until the context is cancelled
+func (r *Relay) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	lastCleanup := time.Now()
+	log.Println("Outbox relay started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if _, err := r.Drain(ctx); err != nil {
+			log.Printf("outbox relay: %v", err)
+		}
+
+		if time.Since(lastCleanup) >= cleanupInterval {
+			lastCleanup = time.Now()
+			if n, err := r.repo.DeletePublishedBefore(ctx, time.Now().Add(-r.retention)); err != nil {
+				log.Printf("outbox relay: cleanup failed: %v", err)
+			} else if n > 0 {
+				log.Printf("outbox relay: removed %d published messages", n)
+			}
+		}
+	}
+}
+
+// Drain publishes the messages that are due in insertion order until none are left. A
+// message the queue rejects is held back with a backoff while the rest of the batch is
+// published. It returns the number of messages published.
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		var claimed, batchPublished int
+		err := r.transactor.WithinTx(ctx, func(ctx context.Context) error {
+			msgs, err := r.repo.ClaimPending(ctx, r.batchSize)
+			if err != nil {
+				return err
+			}
+			claimed = len(msgs)
+
+			for _, msg := range msgs {
+				if err := r.publisher.Publish(ctx, msg.Subject, msg.Payload); err != nil {
+					retryAt := time.Now().Add(retryBackoff(msg.Attempts + 1))
+					log.Printf("outbox relay: failed to publish message %d, retrying at %s: %v", msg.ID, retryAt.Format(time.RFC3339), err)
+					if err := r.repo.MarkFailed(ctx, msg.ID, err.Error(), retryAt); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := r.repo.MarkPublished(ctx, msg.ID); err != nil {
+					return err
+				}
+				batchPublished++
+			}
+			return nil
+		})
+		if err != nil {
+			return published, err
+		}
+		published += batchPublished
+
+		if claimed < r.batchSize {
+			return published, nil
+		}
+	}
+}
+
+// retryBackoff returns how long a message is held back after its given number of failed attempts
+func retryBackoff(attempts int) time.Duration {
+	delay := retryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockRepo struct {
+	pending   []Message
+	published []int64
+	failed    map[int64]string
+	retryAt   map[int64]time.Time
+}
+
+func (m *mockRepo) ClaimPending(_ context.Context, limit int) ([]Message, error) {
+	var result []Message
+	for _, msg := range m.pending {
+		if m.isPublished(msg.ID) || m.retryAt[msg.ID].After(time.Now()) {
+			continue
+		}
+		result = append(result, msg)
+		if len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepo) MarkPublished(_ context.Context, id int64) error {
+	m.published = append(m.published, id)
+	return nil
+}
+
+func (m *mockRepo) MarkFailed(_ context.Context, id int64, lastError string, retryAt time.Time) error {
+	if m.failed == nil {
+		m.failed = map[int64]string{}
+		m.retryAt = map[int64]time.Time{}
+	}
+	m.failed[id] = lastError
+	m.retryAt[id] = retryAt
+	return nil
+}
+
+func (m *mockRepo) DeletePublishedBefore(_ context.Context, _ time.Time) (int, error) {
+	return 0, nil
+}
+
+func (m *mockRepo) isPublished(id int64) bool {
+	for _, p := range m.published {
+		if p == id {
+			return true
+		}
+	}
+	return false
+}
+
+type mockPublisher struct {
+	subjects    []string
+	failSubject string
+	err         error
+}
+
+func (m *mockPublisher) Publish(_ context.Context, subject string, _ []byte) error {
+	if m.err != nil && subject == m.failSubject {
+		return m.err
+	}
+	m.subjects = append(m.subjects, subject)
+	return nil
+}
+
+func (m *mockPublisher) Close() error { return nil }
+
+type mockTransactor struct {
+	calls int
+}
+
+func (m *mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	return fn(ctx)
+}
+
+// --- Tests ---
+
+func TestRelay_Drain_PublishesInOrder(t *testing.T) {
+	repo := &mockRepo{pending: []Message{
+		{ID: 1, Subject: "anchor.vehicle"},
+		{ID: 2, Subject: "anchor.event"},
+		{ID: 3, Subject: "anchor.event"},
+	}}
+	pub := &mockPublisher{}
+	tx := &mockTransactor{}
+	relay := NewRelay(repo, pub, tx, time.Second, 2)
+
+	n, err := relay.Drain(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"anchor.vehicle", "anchor.event", "anchor.event"}, pub.subjects)
+	assert.Equal(t, []int64{1, 2, 3}, repo.published)
+	assert.Equal(t, 2, tx.calls, "each batch is claimed in its own transaction")
+}
+
+func TestRelay_Drain_ContinuesAfterPublishError(t *testing.T) {
+	repo := &mockRepo{pending: []Message{
+		{ID: 1, Subject: "anchor.vehicle"},
+		{ID: 2, Subject: "anchor.event"},
+		{ID: 3, Subject: "anchor.vehicle_update"},
+		{ID: 4, Subject: "anchor.vehicle_update", Attempts: 3},
+	}}
+	pub := &mockPublisher{failSubject: "anchor.vehicle_update", err: errors.New("nats unavailable")}
+	relay := NewRelay(repo, pub, &mockTransactor{}, time.Second, 2)
+
+	before := time.Now()
+	n, err := relay.Drain(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []int64{1, 2}, repo.published)
+	assert.Equal(t, "nats unavailable", repo.failed[3])
+	assert.WithinDuration(t, before.Add(retryDelay), repo.retryAt[3], time.Second)
+	assert.WithinDuration(t, before.Add(8*retryDelay), repo.retryAt[4], time.Second)
+
+	n, err = relay.Drain(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, n, "failed messages are held back until they are due")
+}
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, retryDelay, retryBackoff(1))
+	assert.Equal(t, 2*retryDelay, retryBackoff(2))
+	assert.Equal(t, 16*retryDelay, retryBackoff(5))
+	assert.Equal(t, maxRetryDelay, retryBackoff(100))
+}
+
+func TestRelay_Drain_Empty(t *testing.T) {
+	relay := NewRelay(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, 0, 0)
+
+	n, err := relay.Drain(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
@@ -0,0 +1,71 @@
+// Package recovery rebuilds the anchor state of vehicles, versions and events from the chain, for
+// when the anchor columns in the database are lost or damaged.
+package recovery
+
+import (
+	"github.com/google/uuid"
+)
+
+// RecordType identifies which kind of record a discrepancy refers to
+type RecordType string
+
+const (
+	RecordTypeVehicle        RecordType = "vehicle"
+	RecordTypeVehicleVersion RecordType = "vehicle_version"
+	RecordTypeEvent          RecordType = "event"
+)
+
+// Kind classifies a difference between the database and the chain
+type Kind string
+
+const (
+	// KindMissing is a value the chain has and the database lost. These are repaired.
+	KindMissing Kind = "missing"
+	// KindMismatch is a stored value that differs from the chain
+	KindMismatch Kind = "mismatch"
+	// KindMissingOnChain is an anchor recorded in the database that the chain does not have
+	KindMissingOnChain Kind = "missing_on_chain"
+	// KindUnknownVehicle is an asset whose vehicle does not exist in the database
+	KindUnknownVehicle Kind = "unknown_vehicle"
+	// KindUnmatchedAnchor is an on-chain anchor whose CID matches no record of the vehicle
+	KindUnmatchedAnchor Kind = "unmatched_anchor"
+	// KindDuplicateAsset is a second asset created for the same vehicle
+	KindDuplicateAsset Kind = "duplicate_asset"
+	// KindInvalidAnchor is an asset name or transaction note that cannot be decoded
+	KindInvalidAnchor Kind = "invalid_anchor"
+)
+
+// Columns repaired from the chain
+const (
+	FieldBlockchainAssetID = "blockchain_asset_id"
+	FieldCID               = "cid"
+	FieldBlockchainTxID    = "blockchain_tx_id"
+	FieldGenesisTxID       = "genesis_tx_id"
+)
+
+// Discrepancy is a difference between the database and the chain
+type Discrepancy struct {
+	Kind       Kind       `json:"kind"`
+	RecordType RecordType `json:"recordType,omitempty"`
+	RecordID   *uuid.UUID `json:"recordId,omitempty"`
+	VehicleID  *uuid.UUID `json:"vehicleId,omitempty"`
+	AssetID    *uint64    `json:"assetId,omitempty"`
+	TxID       *string    `json:"txId,omitempty"`
+	// Field is the database column that differs, with its stored and on-chain values
+	Field   string  `json:"field,omitempty"`
+	Stored  *string `json:"stored,omitempty"`
+	OnChain *string `json:"onChain,omitempty"`
+	Reason  string  `json:"reason"`
+	// Repaired reports whether the database was updated from the chain
+	Repaired bool `json:"repaired"`
+}
+
+// Report is the outcome of a scan of the chain
+type Report struct {
+	// Repair reports whether missing values were written back to the database
+	Repair              bool          `json:"repair"`
+	AssetsScanned       int           `json:"assetsScanned"`
+	TransactionsScanned int           `json:"transactionsScanned"`
+	VehiclesMatched     int           `json:"vehiclesMatched"`
+	Discrepancies       []Discrepancy `json:"discrepancies"`
+}
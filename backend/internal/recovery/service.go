@@ -0,0 +1,459 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	"github.com/google/uuid"
+)
+
+const pageSize = 100
+
+// Chain defines the chain scans needed to rebuild anchor state
+type Chain interface {
+	// Address is the platform account that sends every anchoring transaction
+	Address() string
+	CreatedAssets(ctx context.Context, namePrefix string) ([]algorand.CreatedAsset, error)
+	AssetTransactions(ctx context.Context, assetID uint64) ([]algorand.Transaction, error)
+}
+
+// VehicleRepository defines the vehicle data access needed for recovery
+type VehicleRepository interface {
+	GetAll(ctx context.Context, limit, offset int, ownerID *uuid.UUID) ([]vehicles.Vehicle, int, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+	Update(ctx context.Context, vehicle *vehicles.Vehicle) error
+	ListVersions(ctx context.Context, vehicleID uuid.UUID) ([]vehicles.Version, error)
+	UpdateVersion(ctx context.Context, version *vehicles.Version) error
+}
+
+// EventRepository defines the event data access needed for recovery
+type EventRepository interface {
+	GetByVehicle(ctx context.Context, vehicleID uuid.UUID, limit, offset int) ([]event.Event, int, error)
+	ListRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]event.Event, error)
+	Update(ctx context.Context, evt event.Event) error
+}
+
+// Service rebuilds anchor state by scanning the vehicle assets created by the platform account
+type Service struct {
+	chain       Chain
+	vehicleRepo VehicleRepository
+	eventRepo   EventRepository
+}
+
+func NewService(chain Chain, vehicleRepo VehicleRepository, eventRepo EventRepository) *Service {
+	return &Service{chain: chain, vehicleRepo: vehicleRepo, eventRepo: eventRepo}
+}
+
+// anchoredRecord is a version or event of a vehicle that can be matched to an on-chain anchor by CID
+type anchoredRecord struct {
+	recordType RecordType
+	id         uuid.UUID
+	txID       *string
+	version    *vehicles.Version
+	event      *event.Event
+}
+
+// Scan reconciles the database with the chain. Every vehicle asset is decoded back to its vehicle,
+// its creation note to the genesis CID, and its self-transfer notes to the versions and events
+// they anchor. With repair set, values the database lost are restored from the chain; values
+// that conflict with the chain are only reported, as either side may be the damaged one.
+//
+// Events anchored under a Merkle root are anchored by a payment rather than a transaction of
+// the vehicle's asset, so they are neither restored nor reported.
+func (s *Service) Scan(ctx context.Context, repair bool) (*Report, error) {
+	assets, err := s.chain.CreatedAssets(ctx, anchorer.AlgorandVehicleAssetNamePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("list vehicle assets: %w", err)
+	}
+
+	report := &Report{Repair: repair, AssetsScanned: len(assets), Discrepancies: []Discrepancy{}}
+
+	// Assets are ordered by ID, so the first asset of a vehicle is the one genesis adopts
+	assetOf := make(map[uuid.UUID]uint64, len(assets))
+	for _, asset := range assets {
+		vehicleID, err := anchorer.VehicleIDFromAssetName(asset.Name)
+		if err != nil {
+			report.add(Discrepancy{
+				Kind:    KindInvalidAnchor,
+				AssetID: &asset.ID,
+				Reason:  "asset name does not encode a vehicle ID: " + err.Error(),
+			})
+			continue
+		}
+
+		if canonical, ok := assetOf[vehicleID]; ok {
+			report.add(Discrepancy{
+				Kind:       KindDuplicateAsset,
+				RecordType: RecordTypeVehicle,
+				RecordID:   &vehicleID,
+				VehicleID:  &vehicleID,
+				AssetID:    &asset.ID,
+				Reason:     fmt.Sprintf("vehicle already has asset %d", canonical),
+			})
+			continue
+		}
+		assetOf[vehicleID] = asset.ID
+
+		if err := s.scanVehicle(ctx, report, vehicleID, asset.ID, repair); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.scanStoredAssets(ctx, report, assetOf); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// scanVehicle reconciles a vehicle and its records with the transactions of its asset
+func (s *Service) scanVehicle(ctx context.Context, report *Report, vehicleID uuid.UUID, assetID uint64, repair bool) error {
+	vehicle, err := s.vehicleRepo.GetByID(ctx, vehicleID)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			report.add(Discrepancy{
+				Kind:      KindUnknownVehicle,
+				VehicleID: &vehicleID,
+				AssetID:   &assetID,
+				Reason:    "asset was created for a vehicle that does not exist",
+			})
+			return nil
+		}
+		return fmt.Errorf("get vehicle %s: %w", vehicleID, err)
+	}
+	report.VehiclesMatched++
+
+	txns, err := s.chain.AssetTransactions(ctx, assetID)
+	if err != nil {
+		return fmt.Errorf("list transactions of asset %d: %w", assetID, err)
+	}
+	report.TransactionsScanned += len(txns)
+
+	var creation *algorand.Transaction
+	var transfers []algorand.Transaction
+	for i, txn := range txns {
+		if txn.Sender != s.chain.Address() {
+			continue
+		}
+		switch txn.Type {
+		case "acfg":
+			if creation == nil && txn.AssetID == assetID {
+				creation = &txns[i]
+			}
+		case "axfer":
+			transfers = append(transfers, txn)
+		}
+	}
+
+	if err := s.scanGenesis(ctx, report, vehicle, assetID, creation, repair); err != nil {
+		return err
+	}
+	return s.scanRecords(ctx, report, vehicle, assetID, transfers, repair)
+}
+
+// scanGenesis reconciles the vehicle's asset ID and genesis CID with the asset creation
+func (s *Service) scanGenesis(ctx context.Context, report *Report, vehicle *vehicles.Vehicle, assetID uint64, creation *algorand.Transaction, repair bool) error {
+	base := Discrepancy{
+		RecordType: RecordTypeVehicle,
+		RecordID:   &vehicle.ID,
+		VehicleID:  &vehicle.ID,
+		AssetID:    &assetID,
+	}
+	var found []Discrepancy
+
+	onChainAssetID := strconv.FormatUint(assetID, 10)
+	switch {
+	case vehicle.BlockchainAssetID == nil:
+		vehicle.BlockchainAssetID = &onChainAssetID
+		found = append(found, base.missing(FieldBlockchainAssetID, onChainAssetID, "asset ID restored from the chain"))
+	case *vehicle.BlockchainAssetID != onChainAssetID:
+		found = append(found, base.mismatch(FieldBlockchainAssetID, *vehicle.BlockchainAssetID, onChainAssetID, "stored asset is not the vehicle's oldest asset on chain"))
+	}
+
+	if creation == nil {
+		found = append(found, base.with(Discrepancy{
+			Kind:   KindMissingOnChain,
+			Reason: "asset has no creation transaction from the platform account",
+		}))
+	} else {
+		base.TxID = &creation.ID
+		note, err := anchorer.ParseNote(creation.Note)
+		switch {
+		case err != nil:
+			found = append(found, base.with(Discrepancy{
+				Kind:   KindInvalidAnchor,
+				Reason: "genesis note cannot be decoded: " + err.Error(),
+			}))
+		case vehicle.CID == nil:
+			vehicle.CID = &note.CID
+			found = append(found, base.missing(FieldCID, note.CID, "genesis CID restored from the chain; the hashed source record cannot be recovered"))
+		case *vehicle.CID != note.CID:
+			found = append(found, base.mismatch(FieldCID, *vehicle.CID, note.CID, "stored genesis CID differs from the anchored one"))
+		}
+
+		// Vehicles minted before genesis transactions were tracked have none stored
+		if vehicle.GenesisTxID != nil && *vehicle.GenesisTxID != creation.ID {
+			found = append(found, base.mismatch(FieldGenesisTxID, *vehicle.GenesisTxID, creation.ID, "stored genesis transaction did not create the asset"))
+		}
+	}
+
+	if repair && hasMissing(found) {
+		vehicle.BlockchainStatus = vehicles.StatusAnchored
+		vehicle.BlockchainError = nil
+		if err := s.vehicleRepo.Update(ctx, vehicle); err != nil {
+			return fmt.Errorf("repair vehicle %s: %w", vehicle.ID, err)
+		}
+		markRepaired(found)
+	}
+
+	report.add(found...)
+	return nil
+}
+
+// scanRecords matches the notes of the asset's self-transfers to the vehicle's versions and events
+func (s *Service) scanRecords(ctx context.Context, report *Report, vehicle *vehicles.Vehicle, assetID uint64, transfers []algorand.Transaction, repair bool) error {
+	records, err := s.loadRecords(ctx, vehicle.ID)
+	if err != nil {
+		return err
+	}
+
+	byCID := make(map[string]*anchoredRecord, len(records))
+	for _, rec := range records {
+		if cid := rec.cid(); cid != nil {
+			byCID[*cid] = rec
+		}
+	}
+
+	onChain := make(map[string]bool, len(transfers))
+	for _, txn := range transfers {
+		onChain[txn.ID] = true
+	}
+
+	for _, txn := range transfers {
+		base := Discrepancy{VehicleID: &vehicle.ID, AssetID: &assetID, TxID: &txn.ID}
+
+		note, err := anchorer.ParseNote(txn.Note)
+		if err != nil {
+			report.add(base.with(Discrepancy{
+				Kind:   KindInvalidAnchor,
+				Reason: "transfer note cannot be decoded: " + err.Error(),
+			}))
+			continue
+		}
+		if note.Type == anchorer.NoteTypeCustody {
+			continue
+		}
+
+		rec, ok := byCID[note.CID]
+		if !ok {
+			report.add(base.with(Discrepancy{
+				Kind:    KindUnmatchedAnchor,
+				Field:   FieldCID,
+				OnChain: &note.CID,
+				Reason:  fmt.Sprintf("no version or event of the vehicle has the anchored CID (note type %s)", note.Type),
+			}))
+			continue
+		}
+		base.RecordType = rec.recordType
+		base.RecordID = &rec.id
+
+		switch {
+		case rec.txID == nil:
+			d := base.missing(FieldBlockchainTxID, txn.ID, "anchoring transaction restored from the chain")
+			if repair {
+				if err := s.repairRecord(ctx, rec, txn.ID); err != nil {
+					return err
+				}
+				d.Repaired = true
+			}
+			report.add(d)
+		case *rec.txID == txn.ID:
+		case !onChain[*rec.txID]:
+			// The stored transaction is not on chain, so this one is the record's only anchor
+			d := base.mismatch(FieldBlockchainTxID, *rec.txID, txn.ID, "stored transaction is not on chain")
+			if repair {
+				if err := s.repairRecord(ctx, rec, txn.ID); err != nil {
+					return err
+				}
+				d.Repaired = true
+			}
+			report.add(d)
+		default:
+			report.add(base.mismatch(FieldBlockchainTxID, *rec.txID, txn.ID, "record is anchored by more than one transaction"))
+		}
+	}
+
+	for _, rec := range records {
+		if rec.txID == nil || onChain[*rec.txID] || rec.merkle() {
+			continue
+		}
+		report.add(Discrepancy{
+			Kind:       KindMissingOnChain,
+			RecordType: rec.recordType,
+			RecordID:   &rec.id,
+			VehicleID:  &vehicle.ID,
+			AssetID:    &assetID,
+			TxID:       rec.txID,
+			Reason:     "stored transaction is not a transfer of the vehicle's asset",
+		})
+	}
+
+	return nil
+}
+
+// loadRecords loads the versions and events of the vehicle, including amendments and revocations
+func (s *Service) loadRecords(ctx context.Context, vehicleID uuid.UUID) ([]*anchoredRecord, error) {
+	var records []*anchoredRecord
+
+	versions, err := s.vehicleRepo.ListVersions(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("list versions of vehicle %s: %w", vehicleID, err)
+	}
+	for i := range versions {
+		v := &versions[i]
+		records = append(records, &anchoredRecord{recordType: RecordTypeVehicleVersion, id: v.ID, txID: v.BlockchainTxID, version: v})
+	}
+
+	var events []event.Event
+	for offset := 0; ; offset += pageSize {
+		page, total, err := s.eventRepo.GetByVehicle(ctx, vehicleID, pageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list events of vehicle %s: %w", vehicleID, err)
+		}
+		events = append(events, page...)
+
+		if offset+pageSize >= total {
+			break
+		}
+	}
+
+	revisions, err := s.eventRepo.ListRevisionsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("list event revisions of vehicle %s: %w", vehicleID, err)
+	}
+	events = append(events, revisions...)
+
+	for i := range events {
+		e := &events[i]
+		records = append(records, &anchoredRecord{recordType: RecordTypeEvent, id: e.ID, txID: e.BlockchainTxID, event: e})
+	}
+
+	return records, nil
+}
+
+// repairRecord stores txID as the record's anchoring transaction
+func (s *Service) repairRecord(ctx context.Context, rec *anchoredRecord, txID string) error {
+	rec.txID = &txID
+
+	if rec.version != nil {
+		rec.version.BlockchainTxID = &txID
+		rec.version.BlockchainStatus = vehicles.StatusAnchored
+		rec.version.BlockchainError = nil
+		if err := s.vehicleRepo.UpdateVersion(ctx, rec.version); err != nil {
+			return fmt.Errorf("repair vehicle version %s: %w", rec.id, err)
+		}
+		return nil
+	}
+
+	rec.event.BlockchainTxID = &txID
+	rec.event.BlockchainStatus = event.StatusAnchored
+	rec.event.BlockchainError = nil
+	rec.event.MerkleProof = nil
+	if err := s.eventRepo.Update(ctx, *rec.event); err != nil {
+		return fmt.Errorf("repair event %s: %w", rec.id, err)
+	}
+	return nil
+}
+
+// scanStoredAssets reports vehicles whose stored asset the chain scan did not find
+func (s *Service) scanStoredAssets(ctx context.Context, report *Report, assetOf map[uuid.UUID]uint64) error {
+	for offset := 0; ; offset += pageSize {
+		page, total, err := s.vehicleRepo.GetAll(ctx, pageSize, offset, nil)
+		if err != nil {
+			return fmt.Errorf("list vehicles: %w", err)
+		}
+
+		for _, v := range page {
+			if v.BlockchainAssetID == nil {
+				continue
+			}
+			if _, ok := assetOf[v.ID]; ok {
+				continue
+			}
+			report.add(Discrepancy{
+				Kind:       KindMissingOnChain,
+				RecordType: RecordTypeVehicle,
+				RecordID:   &v.ID,
+				VehicleID:  &v.ID,
+				Field:      FieldBlockchainAssetID,
+				Stored:     v.BlockchainAssetID,
+				Reason:     "no asset on chain is named after the vehicle",
+			})
+		}
+
+		if offset+pageSize >= total {
+			break
+		}
+	}
+	return nil
+}
+
+func (r *anchoredRecord) cid() *string {
+	if r.version != nil {
+		return &r.version.CID
+	}
+	return r.event.CID
+}
+
+// merkle reports whether the record is anchored under a Merkle root, whose transaction is a
+// payment rather than a transfer of the vehicle's asset
+func (r *anchoredRecord) merkle() bool {
+	return r.event != nil && r.event.MerkleProof != nil
+}
+
+func (r *Report) add(d ...Discrepancy) {
+	r.Discrepancies = append(r.Discrepancies, d...)
+}
+
+// with fills the record fields of d from the base discrepancy
+func (base Discrepancy) with(d Discrepancy) Discrepancy {
+	d.RecordType = base.RecordType
+	d.RecordID = base.RecordID
+	d.VehicleID = base.VehicleID
+	d.AssetID = base.AssetID
+	if d.TxID == nil {
+		d.TxID = base.TxID
+	}
+	return d
+}
+
+func (base Discrepancy) missing(field, onChain, reason string) Discrepancy {
+	return base.with(Discrepancy{Kind: KindMissing, Field: field, OnChain: &onChain, Reason: reason})
+}
+
+func (base Discrepancy) mismatch(field, stored, onChain, reason string) Discrepancy {
+	return base.with(Discrepancy{Kind: KindMismatch, Field: field, Stored: &stored, OnChain: &onChain, Reason: reason})
+}
+
+func hasMissing(found []Discrepancy) bool {
+	for _, d := range found {
+		if d.Kind == KindMissing {
+			return true
+		}
+	}
+	return false
+}
+
+func markRepaired(found []Discrepancy) {
+	for i := range found {
+		if found[i].Kind == KindMissing {
+			found[i].Repaired = true
+		}
+	}
+}
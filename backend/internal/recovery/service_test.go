@@ -0,0 +1,330 @@
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger/simulated"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockVehicleRepo struct {
+	vehicles map[uuid.UUID]*vehicles.Vehicle
+	versions map[uuid.UUID][]vehicles.Version
+	updated  int
+}
+
+func newMockVehicleRepo() *mockVehicleRepo {
+	return &mockVehicleRepo{vehicles: map[uuid.UUID]*vehicles.Vehicle{}, versions: map[uuid.UUID][]vehicles.Version{}}
+}
+
+func (m *mockVehicleRepo) GetAll(ctx context.Context, limit, offset int, ownerID *uuid.UUID) ([]vehicles.Vehicle, int, error) {
+	var result []vehicles.Vehicle
+	for _, v := range m.vehicles {
+		result = append(result, *v)
+	}
+	return result, len(result), nil
+}
+
+func (m *mockVehicleRepo) GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
+	v, ok := m.vehicles[id]
+	if !ok {
+		return nil, vehicles.ErrVehicleNotFound
+	}
+	copied := *v
+	return &copied, nil
+}
+
+func (m *mockVehicleRepo) Update(ctx context.Context, vehicle *vehicles.Vehicle) error {
+	copied := *vehicle
+	m.vehicles[vehicle.ID] = &copied
+	m.updated++
+	return nil
+}
+
+func (m *mockVehicleRepo) ListVersions(ctx context.Context, vehicleID uuid.UUID) ([]vehicles.Version, error) {
+	return append([]vehicles.Version(nil), m.versions[vehicleID]...), nil
+}
+
+func (m *mockVehicleRepo) UpdateVersion(ctx context.Context, version *vehicles.Version) error {
+	versions := m.versions[version.VehicleID]
+	for i := range versions {
+		if versions[i].ID == version.ID {
+			versions[i] = *version
+		}
+	}
+	return nil
+}
+
+type mockEventRepo struct {
+	events []event.Event
+}
+
+func (m *mockEventRepo) GetByVehicle(ctx context.Context, vehicleID uuid.UUID, limit, offset int) ([]event.Event, int, error) {
+	var result []event.Event
+	for _, e := range m.events {
+		if e.VehicleID == vehicleID {
+			result = append(result, e)
+		}
+	}
+	return result, len(result), nil
+}
+
+func (m *mockEventRepo) ListRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]event.Event, error) {
+	return nil, nil
+}
+
+func (m *mockEventRepo) Update(ctx context.Context, evt event.Event) error {
+	for i := range m.events {
+		if m.events[i].ID == evt.ID {
+			m.events[i] = evt
+		}
+	}
+	return nil
+}
+
+// --- Helpers ---
+
+func newLedger(t *testing.T) *simulated.Ledger {
+	t.Helper()
+	l, err := simulated.New(simulated.Config{})
+	require.NoError(t, err)
+	return l
+}
+
+// mint creates the vehicle's asset with a genesis note like the anchorer does
+func mint(t *testing.T, l *simulated.Ledger, vehicleID uuid.UUID, cid string) uint64 {
+	t.Helper()
+	ctx := context.Background()
+	stxn, err := l.SignAssetCreation(ctx, algorand.AssetParams{
+		AssetName: anchorer.AlgorandVehicleAssetNamePrefix + anchorer.UUIDToBase64(vehicleID),
+		UnitName:  "CCV",
+		Total:     1,
+		Note:      []byte("type=genesis|cid=" + cid),
+	})
+	require.NoError(t, err)
+	created, err := l.SubmitAssetCreation(ctx, stxn)
+	require.NoError(t, err)
+	return created.AssetID
+}
+
+// anchor self-transfers the asset with a note anchoring cid
+func anchor(t *testing.T, l *simulated.Ledger, assetID uint64, noteType, cid string) string {
+	t.Helper()
+	txns, err := l.SelfTransferAssets(context.Background(), []algorand.SelfTransfer{{
+		AssetID: assetID,
+		Note:    []byte(fmt.Sprintf("type=%s|cid=%s", noteType, cid)),
+	}})
+	require.NoError(t, err)
+	return txns[0].ID
+}
+
+func kinds(report *Report) []Kind {
+	result := make([]Kind, len(report.Discrepancies))
+	for i, d := range report.Discrepancies {
+		result[i] = d.Kind
+	}
+	return result
+}
+
+// --- Tests ---
+
+func TestScan_MatchingDatabaseHasNoDiscrepancies(t *testing.T) {
+	l := newLedger(t)
+	vehicleRepo := newMockVehicleRepo()
+	eventRepo := &mockEventRepo{}
+
+	vehicleID := uuid.New()
+	assetID := mint(t, l, vehicleID, "bafy-genesis")
+	txID := anchor(t, l, assetID, "new_event", "bafy-event")
+
+	assetIDStr := strconv.FormatUint(assetID, 10)
+	vehicleRepo.vehicles[vehicleID] = &vehicles.Vehicle{ID: vehicleID, BlockchainAssetID: &assetIDStr, CID: ptr("bafy-genesis")}
+	eventRepo.events = []event.Event{{ID: uuid.New(), VehicleID: vehicleID, CID: ptr("bafy-event"), BlockchainTxID: &txID}}
+
+	report, err := NewService(l, vehicleRepo, eventRepo).Scan(context.Background(), true)
+	require.NoError(t, err)
+
+	assert.Empty(t, report.Discrepancies)
+	assert.Equal(t, 1, report.AssetsScanned)
+	assert.Equal(t, 1, report.VehiclesMatched)
+	assert.Equal(t, 2, report.TransactionsScanned)
+	assert.Zero(t, vehicleRepo.updated)
+}
+
+func TestScan_RepairsLostAnchorColumns(t *testing.T) {
+	l := newLedger(t)
+	vehicleRepo := newMockVehicleRepo()
+	eventRepo := &mockEventRepo{}
+
+	vehicleID, versionID, eventID := uuid.New(), uuid.New(), uuid.New()
+	assetID := mint(t, l, vehicleID, "bafy-genesis")
+	versionTxID := anchor(t, l, assetID, "vehicle_update", "bafy-version")
+	eventTxID := anchor(t, l, assetID, "new_event", "bafy-event")
+
+	vehicleRepo.vehicles[vehicleID] = &vehicles.Vehicle{ID: vehicleID, BlockchainStatus: "failed"}
+	vehicleRepo.versions[vehicleID] = []vehicles.Version{{ID: versionID, VehicleID: vehicleID, CID: "bafy-version"}}
+	eventRepo.events = []event.Event{{ID: eventID, VehicleID: vehicleID, CID: ptr("bafy-event")}}
+
+	report, err := NewService(l, vehicleRepo, eventRepo).Scan(context.Background(), true)
+	require.NoError(t, err)
+
+	require.Len(t, report.Discrepancies, 4)
+	for _, d := range report.Discrepancies {
+		assert.Equal(t, KindMissing, d.Kind)
+		assert.True(t, d.Repaired, d.Field)
+	}
+
+	vehicle := vehicleRepo.vehicles[vehicleID]
+	require.NotNil(t, vehicle.BlockchainAssetID)
+	assert.Equal(t, strconv.FormatUint(assetID, 10), *vehicle.BlockchainAssetID)
+	assert.Equal(t, "bafy-genesis", *vehicle.CID)
+	assert.Equal(t, vehicles.StatusAnchored, vehicle.BlockchainStatus)
+
+	version := vehicleRepo.versions[vehicleID][0]
+	require.NotNil(t, version.BlockchainTxID)
+	assert.Equal(t, versionTxID, *version.BlockchainTxID)
+	assert.Equal(t, vehicles.StatusAnchored, version.BlockchainStatus)
+
+	evt := eventRepo.events[0]
+	require.NotNil(t, evt.BlockchainTxID)
+	assert.Equal(t, eventTxID, *evt.BlockchainTxID)
+	assert.Equal(t, event.StatusAnchored, evt.BlockchainStatus)
+}
+
+func TestScan_ReportOnlyDoesNotRepair(t *testing.T) {
+	l := newLedger(t)
+	vehicleRepo := newMockVehicleRepo()
+
+	vehicleID := uuid.New()
+	mint(t, l, vehicleID, "bafy-genesis")
+	vehicleRepo.vehicles[vehicleID] = &vehicles.Vehicle{ID: vehicleID}
+
+	report, err := NewService(l, vehicleRepo, &mockEventRepo{}).Scan(context.Background(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Kind{KindMissing, KindMissing}, kinds(report))
+	assert.False(t, report.Discrepancies[0].Repaired)
+	assert.Zero(t, vehicleRepo.updated)
+	assert.Nil(t, vehicleRepo.vehicles[vehicleID].BlockchainAssetID)
+}
+
+func TestScan_ReportsConflictsWithoutOverwriting(t *testing.T) {
+	l := newLedger(t)
+	vehicleRepo := newMockVehicleRepo()
+	eventRepo := &mockEventRepo{}
+
+	vehicleID := uuid.New()
+	assetID := mint(t, l, vehicleID, "bafy-genesis")
+	firstTxID := anchor(t, l, assetID, "new_event", "bafy-event")
+	anchor(t, l, assetID, "new_event", "bafy-event")
+
+	assetIDStr := strconv.FormatUint(assetID, 10)
+	vehicleRepo.vehicles[vehicleID] = &vehicles.Vehicle{ID: vehicleID, BlockchainAssetID: &assetIDStr, CID: ptr("bafy-tampered")}
+	eventRepo.events = []event.Event{{ID: uuid.New(), VehicleID: vehicleID, CID: ptr("bafy-event"), BlockchainTxID: &firstTxID}}
+
+	report, err := NewService(l, vehicleRepo, eventRepo).Scan(context.Background(), true)
+	require.NoError(t, err)
+
+	require.Equal(t, []Kind{KindMismatch, KindMismatch}, kinds(report))
+	assert.Equal(t, FieldCID, report.Discrepancies[0].Field)
+	assert.Equal(t, "bafy-genesis", *report.Discrepancies[0].OnChain)
+	assert.Equal(t, FieldBlockchainTxID, report.Discrepancies[1].Field)
+	assert.False(t, report.Discrepancies[1].Repaired)
+
+	assert.Equal(t, "bafy-tampered", *vehicleRepo.vehicles[vehicleID].CID)
+	assert.Equal(t, firstTxID, *eventRepo.events[0].BlockchainTxID)
+}
+
+func TestScan_ReplacesStoredTransactionMissingOnChain(t *testing.T) {
+	l := newLedger(t)
+	vehicleRepo := newMockVehicleRepo()
+	eventRepo := &mockEventRepo{}
+
+	vehicleID := uuid.New()
+	assetID := mint(t, l, vehicleID, "bafy-genesis")
+	txID := anchor(t, l, assetID, "new_event", "bafy-event")
+
+	assetIDStr := strconv.FormatUint(assetID, 10)
+	vehicleRepo.vehicles[vehicleID] = &vehicles.Vehicle{ID: vehicleID, BlockchainAssetID: &assetIDStr, CID: ptr("bafy-genesis")}
+	eventRepo.events = []event.Event{{ID: uuid.New(), VehicleID: vehicleID, CID: ptr("bafy-event"), BlockchainTxID: ptr("LOSTTX")}}
+
+	report, err := NewService(l, vehicleRepo, eventRepo).Scan(context.Background(), true)
+	require.NoError(t, err)
+
+	require.Equal(t, []Kind{KindMismatch}, kinds(report))
+	assert.True(t, report.Discrepancies[0].Repaired)
+	assert.Equal(t, txID, *eventRepo.events[0].BlockchainTxID)
+}
+
+func TestScan_ReportsUnknownAndUnmatchedRecords(t *testing.T) {
+	l := newLedger(t)
+	vehicleRepo := newMockVehicleRepo()
+	eventRepo := &mockEventRepo{}
+
+	unknownID := uuid.New()
+	mint(t, l, unknownID, "bafy-unknown")
+
+	vehicleID := uuid.New()
+	assetID := mint(t, l, vehicleID, "bafy-genesis")
+	anchor(t, l, assetID, "new_event", "bafy-deleted")
+	duplicateID := mint(t, l, vehicleID, "bafy-genesis")
+
+	assetIDStr := strconv.FormatUint(assetID, 10)
+	vehicleRepo.vehicles[vehicleID] = &vehicles.Vehicle{ID: vehicleID, BlockchainAssetID: &assetIDStr, CID: ptr("bafy-genesis")}
+
+	offChainID := uuid.New()
+	vehicleRepo.vehicles[offChainID] = &vehicles.Vehicle{ID: offChainID, BlockchainAssetID: ptr("999999")}
+
+	report, err := NewService(l, vehicleRepo, eventRepo).Scan(context.Background(), true)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []Kind{KindUnknownVehicle, KindUnmatchedAnchor, KindDuplicateAsset, KindMissingOnChain}, kinds(report))
+	for _, d := range report.Discrepancies {
+		switch d.Kind {
+		case KindUnknownVehicle:
+			assert.Equal(t, unknownID, *d.VehicleID)
+		case KindUnmatchedAnchor:
+			assert.Equal(t, "bafy-deleted", *d.OnChain)
+		case KindDuplicateAsset:
+			assert.Equal(t, duplicateID, *d.AssetID)
+		case KindMissingOnChain:
+			assert.Equal(t, offChainID, *d.RecordID)
+		}
+	}
+}
+
+func TestScan_ReportsStoredAnchorMissingOnChainButSkipsMerkleEvents(t *testing.T) {
+	l := newLedger(t)
+	vehicleRepo := newMockVehicleRepo()
+
+	vehicleID := uuid.New()
+	assetID := mint(t, l, vehicleID, "bafy-genesis")
+
+	assetIDStr := strconv.FormatUint(assetID, 10)
+	vehicleRepo.vehicles[vehicleID] = &vehicles.Vehicle{ID: vehicleID, BlockchainAssetID: &assetIDStr, CID: ptr("bafy-genesis")}
+	missing := event.Event{ID: uuid.New(), VehicleID: vehicleID, CID: ptr("bafy-a"), BlockchainTxID: ptr("GONE")}
+	rooted := event.Event{ID: uuid.New(), VehicleID: vehicleID, CID: ptr("bafy-b"), BlockchainTxID: ptr("ROOTTX"), MerkleProof: &merkle.Proof{}}
+	eventRepo := &mockEventRepo{events: []event.Event{missing, rooted}}
+
+	report, err := NewService(l, vehicleRepo, eventRepo).Scan(context.Background(), true)
+	require.NoError(t, err)
+
+	require.Equal(t, []Kind{KindMissingOnChain}, kinds(report))
+	assert.Equal(t, missing.ID, *report.Discrepancies[0].RecordID)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,54 @@
+package signing_keys
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrKeyNotFound         = errors.New("signing key not found")
+	ErrKeyRevoked          = errors.New("signing key has been revoked")
+	ErrKeyExists           = errors.New("public key is already registered")
+	ErrLabelRequired       = errors.New("label is required")
+	ErrManagedKeysDisabled = errors.New("managed signing keys are not configured")
+)
+
+const AlgorithmEd25519 = "ed25519"
+
+// Key is an Ed25519 key an entity signs the CIDs of its events with. Managed keys are generated by
+// the platform, which signs with them when the entity's events enter the vehicle's chain; the
+// private key of other keys is held by the entity, which signs client-side.
+type Key struct {
+	ID        uuid.UUID `json:"id"`
+	EntityID  uuid.UUID `json:"entityId"`
+	Label     string    `json:"label"`
+	Algorithm string    `json:"algorithm"`
+	// PublicKey is the base64-encoded 32-byte Ed25519 public key
+	PublicKey string     `json:"publicKey"`
+	Managed   bool       `json:"managed"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// ActiveAt reports whether the key could sign at the given time
+func (k Key) ActiveAt(t time.Time) bool {
+	return !t.Before(k.CreatedAt) && (k.RevokedAt == nil || t.Before(*k.RevokedAt))
+}
+
+// RegisterParams represents parameters for adding a signing key to an entity. Without a public
+// key a managed key is generated.
+type RegisterParams struct {
+	Label     string
+	PublicKey *string
+}
+
+// CreateParams represents parameters for storing a new signing key
+type CreateParams struct {
+	EntityID  uuid.UUID
+	Label     string
+	PublicKey string
+	// SealedPrivateKey is only set for managed keys
+	SealedPrivateKey *string
+}
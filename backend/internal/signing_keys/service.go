@@ -0,0 +1,147 @@
+package signing_keys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/signing"
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for signing keys
+type Repository interface {
+	Create(ctx context.Context, params CreateParams) (*Key, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Key, error)
+	GetByPublicKey(ctx context.Context, publicKey string) (*Key, error)
+	// ListByEntity returns the entity's keys, newest first, including revoked ones
+	ListByEntity(ctx context.Context, entityID uuid.UUID) ([]Key, error)
+	// GetActiveManaged returns the entity's newest managed key that is not revoked, with its
+	// sealed private key, or ErrKeyNotFound
+	GetActiveManaged(ctx context.Context, entityID uuid.UUID) (*Key, string, error)
+	// Revoke returns ErrKeyNotFound when the key does not exist or was already revoked
+	Revoke(ctx context.Context, entityID, id uuid.UUID) (*Key, error)
+}
+
+// EntityService handles entity operations
+type EntityService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Entity, error)
+}
+
+// Service manages the keys entities sign their events with and signs on behalf of entities with
+// managed keys
+type Service struct {
+	repo     Repository
+	entities EntityService
+	sealer   *signing.Sealer
+}
+
+// NewService creates a new signing key service. Without a sealer only keys held by the entities
+// themselves can be registered.
+func NewService(repo Repository, entities EntityService, sealer *signing.Sealer) *Service {
+	return &Service{
+		repo:     repo,
+		entities: entities,
+		sealer:   sealer,
+	}
+}
+
+// Register adds a key to the entity. A given public key is registered as held by the entity;
+// otherwise a managed key is generated and its private key stored sealed.
+func (s *Service) Register(ctx context.Context, entityID uuid.UUID, params RegisterParams) (*Key, error) {
+	label := strings.TrimSpace(params.Label)
+	if label == "" {
+		return nil, ErrLabelRequired
+	}
+	if _, err := s.entities.GetByID(ctx, entityID); err != nil {
+		return nil, err
+	}
+
+	create := CreateParams{EntityID: entityID, Label: label}
+	if params.PublicKey != nil {
+		publicKey, err := signing.ParsePublicKey(*params.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		create.PublicKey = signing.EncodePublicKey(publicKey)
+
+		if _, err := s.repo.GetByPublicKey(ctx, create.PublicKey); err == nil {
+			return nil, ErrKeyExists
+		} else if !errors.Is(err, ErrKeyNotFound) {
+			return nil, err
+		}
+	} else {
+		if s.sealer == nil {
+			return nil, ErrManagedKeysDisabled
+		}
+		publicKey, privateKey, err := signing.GenerateKey()
+		if err != nil {
+			return nil, fmt.Errorf("generate signing key: %w", err)
+		}
+		sealed, err := s.sealer.SealPrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("seal signing key: %w", err)
+		}
+		create.PublicKey = signing.EncodePublicKey(publicKey)
+		create.SealedPrivateKey = &sealed
+	}
+
+	return s.repo.Create(ctx, create)
+}
+
+// ListByEntity retrieves the entity's keys, newest first, including revoked ones
+func (s *Service) ListByEntity(ctx context.Context, entityID uuid.UUID) ([]Key, error) {
+	return s.repo.ListByEntity(ctx, entityID)
+}
+
+// GetByID retrieves a key. Keys are public, so anyone can check a signature against them.
+func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*Key, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Revoke stops the key from signing new events. Signatures made before remain verifiable.
+func (s *Service) Revoke(ctx context.Context, entityID, id uuid.UUID) (*Key, error) {
+	return s.repo.Revoke(ctx, entityID, id)
+}
+
+// Sign signs the CID with the entity's newest managed key. It returns nil when the entity has no
+// active managed key or managed keys are not configured.
+func (s *Service) Sign(ctx context.Context, entityID uuid.UUID, cid string) (*event.Signature, error) {
+	if s.sealer == nil {
+		return nil, nil
+	}
+
+	key, sealed, err := s.repo.GetActiveManaged(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	privateKey, err := s.sealer.OpenPrivateKey(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("open signing key %s: %w", key.ID, err)
+	}
+
+	return &event.Signature{KeyID: key.ID, Value: signing.SignCID(privateKey, cid)}, nil
+}
+
+// Verify checks a signature of the CID made with one of the entity's active keys
+func (s *Service) Verify(ctx context.Context, entityID, keyID uuid.UUID, cid, signature string) error {
+	key, err := s.repo.GetByID(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	if key.EntityID != entityID {
+		return ErrKeyNotFound
+	}
+	if !key.ActiveAt(time.Now()) {
+		return ErrKeyRevoked
+	}
+	return signing.VerifyCID(key.PublicKey, cid, signature)
+}
@@ -0,0 +1,209 @@
+package signing_keys
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/signing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type storedKey struct {
+	key    Key
+	sealed *string
+}
+
+type mockRepo struct {
+	keys []*storedKey
+}
+
+func (m *mockRepo) Create(_ context.Context, params CreateParams) (*Key, error) {
+	stored := &storedKey{
+		key: Key{
+			ID:        uuid.New(),
+			EntityID:  params.EntityID,
+			Label:     params.Label,
+			Algorithm: AlgorithmEd25519,
+			PublicKey: params.PublicKey,
+			Managed:   params.SealedPrivateKey != nil,
+			CreatedAt: time.Now().Add(-time.Second),
+		},
+		sealed: params.SealedPrivateKey,
+	}
+	m.keys = append(m.keys, stored)
+	copied := stored.key
+	return &copied, nil
+}
+
+func (m *mockRepo) find(match func(k *storedKey) bool) (*Key, error) {
+	for _, k := range m.keys {
+		if match(k) {
+			copied := k.key
+			return &copied, nil
+		}
+	}
+	return nil, ErrKeyNotFound
+}
+
+func (m *mockRepo) GetByID(_ context.Context, id uuid.UUID) (*Key, error) {
+	return m.find(func(k *storedKey) bool { return k.key.ID == id })
+}
+
+func (m *mockRepo) GetByPublicKey(_ context.Context, publicKey string) (*Key, error) {
+	return m.find(func(k *storedKey) bool { return k.key.PublicKey == publicKey })
+}
+
+func (m *mockRepo) ListByEntity(_ context.Context, entityID uuid.UUID) ([]Key, error) {
+	var result []Key
+	for i := len(m.keys) - 1; i >= 0; i-- {
+		if m.keys[i].key.EntityID == entityID {
+			result = append(result, m.keys[i].key)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRepo) GetActiveManaged(_ context.Context, entityID uuid.UUID) (*Key, string, error) {
+	for i := len(m.keys) - 1; i >= 0; i-- {
+		k := m.keys[i]
+		if k.key.EntityID == entityID && k.sealed != nil && k.key.RevokedAt == nil {
+			copied := k.key
+			return &copied, *k.sealed, nil
+		}
+	}
+	return nil, "", ErrKeyNotFound
+}
+
+func (m *mockRepo) Revoke(_ context.Context, entityID, id uuid.UUID) (*Key, error) {
+	for _, k := range m.keys {
+		if k.key.ID == id && k.key.EntityID == entityID && k.key.RevokedAt == nil {
+			now := time.Now()
+			k.key.RevokedAt = &now
+			copied := k.key
+			return &copied, nil
+		}
+	}
+	return nil, ErrKeyNotFound
+}
+
+type mockEntities struct {
+	known map[uuid.UUID]bool
+}
+
+func (m *mockEntities) GetByID(_ context.Context, id uuid.UUID) (*entity.Entity, error) {
+	if !m.known[id] {
+		return nil, entity.ErrEntityNotFound
+	}
+	return &entity.Entity{ID: id}, nil
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func newTestService(t *testing.T, withSealer bool) (*Service, uuid.UUID) {
+	t.Helper()
+	var sealer *signing.Sealer
+	if withSealer {
+		var err error
+		sealer, err = signing.NewSealer(bytes.Repeat([]byte{1}, 32))
+		require.NoError(t, err)
+	}
+	entityID := uuid.New()
+	svc := NewService(&mockRepo{}, &mockEntities{known: map[uuid.UUID]bool{entityID: true}}, sealer)
+	return svc, entityID
+}
+
+// --- Tests ---
+
+func TestService_Register_ManagedKeySigns(t *testing.T) {
+	svc, entityID := newTestService(t, true)
+
+	key, err := svc.Register(context.Background(), entityID, RegisterParams{Label: " Workshop "})
+	require.NoError(t, err)
+	assert.True(t, key.Managed)
+	assert.Equal(t, "Workshop", key.Label)
+
+	sig, err := svc.Sign(context.Background(), entityID, "bafyevent")
+	require.NoError(t, err)
+	require.NotNil(t, sig)
+	assert.Equal(t, key.ID, sig.KeyID)
+	assert.NoError(t, signing.VerifyCID(key.PublicKey, "bafyevent", sig.Value))
+	assert.NoError(t, svc.Verify(context.Background(), entityID, key.ID, "bafyevent", sig.Value))
+}
+
+func TestService_Register_ManagedKeyRequiresSealer(t *testing.T) {
+	svc, entityID := newTestService(t, false)
+
+	_, err := svc.Register(context.Background(), entityID, RegisterParams{Label: "Workshop"})
+	assert.ErrorIs(t, err, ErrManagedKeysDisabled)
+
+	sig, err := svc.Sign(context.Background(), entityID, "bafyevent")
+	require.NoError(t, err)
+	assert.Nil(t, sig)
+}
+
+func TestService_Register_EntityHeldKey(t *testing.T) {
+	svc, entityID := newTestService(t, true)
+	pub, priv, err := signing.GenerateKey()
+	require.NoError(t, err)
+	publicKey := signing.EncodePublicKey(pub)
+
+	key, err := svc.Register(context.Background(), entityID, RegisterParams{Label: "Client", PublicKey: &publicKey})
+	require.NoError(t, err)
+	assert.False(t, key.Managed)
+	assert.Equal(t, publicKey, key.PublicKey)
+
+	// Only managed keys are used to sign on the entity's behalf
+	sig, err := svc.Sign(context.Background(), entityID, "bafyevent")
+	require.NoError(t, err)
+	assert.Nil(t, sig)
+
+	assert.NoError(t, svc.Verify(context.Background(), entityID, key.ID, "bafyevent", signing.SignCID(priv, "bafyevent")))
+
+	_, err = svc.Register(context.Background(), entityID, RegisterParams{Label: "Again", PublicKey: &publicKey})
+	assert.ErrorIs(t, err, ErrKeyExists)
+}
+
+func TestService_Register_Validation(t *testing.T) {
+	svc, entityID := newTestService(t, true)
+
+	_, err := svc.Register(context.Background(), entityID, RegisterParams{Label: "  "})
+	assert.ErrorIs(t, err, ErrLabelRequired)
+
+	_, err = svc.Register(context.Background(), entityID, RegisterParams{Label: "Bad", PublicKey: ptr("AAAA")})
+	assert.ErrorIs(t, err, signing.ErrInvalidPublicKey)
+
+	_, err = svc.Register(context.Background(), uuid.New(), RegisterParams{Label: "Unknown"})
+	assert.ErrorIs(t, err, entity.ErrEntityNotFound)
+}
+
+func TestService_Verify_RejectsRevokedAndForeignKeys(t *testing.T) {
+	svc, entityID := newTestService(t, true)
+	key, err := svc.Register(context.Background(), entityID, RegisterParams{Label: "Workshop"})
+	require.NoError(t, err)
+	sig, err := svc.Sign(context.Background(), entityID, "bafyevent")
+	require.NoError(t, err)
+
+	err = svc.Verify(context.Background(), uuid.New(), key.ID, "bafyevent", sig.Value)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	err = svc.Verify(context.Background(), entityID, key.ID, "bafyother", sig.Value)
+	assert.ErrorIs(t, err, signing.ErrInvalidSignature)
+
+	_, err = svc.Revoke(context.Background(), entityID, key.ID)
+	require.NoError(t, err)
+
+	err = svc.Verify(context.Background(), entityID, key.ID, "bafyevent", sig.Value)
+	assert.ErrorIs(t, err, ErrKeyRevoked)
+
+	// A revoked managed key is no longer used to sign
+	sig, err = svc.Sign(context.Background(), entityID, "bafynext")
+	require.NoError(t, err)
+	assert.Nil(t, sig)
+}
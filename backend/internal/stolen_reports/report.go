@@ -0,0 +1,92 @@
+package stolen_reports
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReportNotFound      = errors.New("stolen vehicle report not found")
+	ErrReportNotPending    = errors.New("stolen vehicle report has already been decided")
+	ErrReportNotApproved   = errors.New("stolen vehicle report is not approved")
+	ErrReportAlreadyOpen   = errors.New("vehicle already has an open stolen vehicle report")
+	ErrInvalidKind         = errors.New("report kind must be stolen or missing")
+	ErrDescriptionRequired = errors.New("a description of what happened is required")
+	ErrIncidentInFuture    = errors.New("incident date cannot be in the future")
+	ErrIdentifierRequired  = errors.New("a chassis number or license plate is required")
+)
+
+const (
+	KindStolen  = "stolen"
+	KindMissing = "missing"
+
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+	// StatusResolved means the vehicle was recovered after the report was approved
+	StatusResolved = "resolved"
+)
+
+// Alert statuses returned by public lookups
+const (
+	AlertNone    = "none"
+	AlertStolen  = "stolen"
+	AlertMissing = "missing"
+)
+
+// Report is an owner's report that their vehicle was stolen or went missing. Approving it flags
+// the vehicle as stolen through a lifecycle request and alerts the entities that issued events on
+// it; resolving it once the vehicle is recovered returns it to active.
+type Report struct {
+	ID                 uuid.UUID  `json:"id"`
+	VehicleID          uuid.UUID  `json:"vehicleId"`
+	Kind               string     `json:"kind"`
+	PoliceReference    *string    `json:"policeReference,omitempty"`
+	PoliceAuthority    *string    `json:"policeAuthority,omitempty"`
+	IncidentDate       *time.Time `json:"incidentDate,omitempty"`
+	Location           *string    `json:"location,omitempty"`
+	Description        string     `json:"description"`
+	ReportedBy         uuid.UUID  `json:"reportedBy"`
+	Status             string     `json:"status"`
+	DecidedBy          *uuid.UUID `json:"decidedBy,omitempty"`
+	DeclineReason      *string    `json:"declineReason,omitempty"`
+	LifecycleRequestID *uuid.UUID `json:"lifecycleRequestId,omitempty"`
+	ResolvedBy         *uuid.UUID `json:"resolvedBy,omitempty"`
+	ResolutionNote     *string    `json:"resolutionNote,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	DecidedAt          *time.Time `json:"decidedAt,omitempty"`
+	ResolvedAt         *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// CreateReportParams represents parameters for creating a new report
+type CreateReportParams struct {
+	VehicleID       uuid.UUID
+	Kind            string
+	PoliceReference *string
+	PoliceAuthority *string
+	IncidentDate    *time.Time
+	Location        *string
+	Description     string
+	ReportedBy      uuid.UUID
+}
+
+// FileParams represents an owner's report about their vehicle. The police reference and
+// authority identify the report made to the police, when there is one.
+type FileParams struct {
+	Kind            string
+	PoliceReference *string
+	PoliceAuthority *string
+	IncidentDate    *time.Time
+	Location        *string
+	Description     string
+	ReportedBy      uuid.UUID
+}
+
+// Alert is what a public lookup reveals about a vehicle: whether it is flagged and since when.
+// Vehicles that are not registered are reported as not flagged.
+type Alert struct {
+	Status string     `json:"status"`
+	Since  *time.Time `json:"since,omitempty"`
+}
@@ -0,0 +1,331 @@
+package stolen_reports
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+// Repository defines the data access interface for stolen vehicle reports
+type Repository interface {
+	// Create returns ErrReportAlreadyOpen when the vehicle already has a pending or approved report
+	Create(ctx context.Context, params CreateReportParams) (*Report, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Report, error)
+	// GetApprovedByVehicle returns ErrReportNotFound when the vehicle has no approved report
+	GetApprovedByVehicle(ctx context.Context, vehicleID uuid.UUID) (*Report, error)
+	ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Report, error)
+	// List returns the reports in the given status, or all of them if status is empty
+	List(ctx context.Context, status string) ([]Report, error)
+	// Decide returns ErrReportNotPending when the report has already been decided
+	Decide(ctx context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*Report, error)
+	SetLifecycleRequest(ctx context.Context, id, lifecycleRequestID uuid.UUID) error
+	// Resolve returns ErrReportNotApproved when the report is not approved
+	Resolve(ctx context.Context, id, resolvedBy uuid.UUID, note *string) (*Report, error)
+	// ListIssuingEntities returns the entities that issued events on the vehicle
+	ListIssuingEntities(ctx context.Context, vehicleID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// VehicleService handles vehicle operations
+type VehicleService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+	GetByChassisNumber(ctx context.Context, chassisNumber string) (*vehicles.Vehicle, error)
+	GetByLicensePlate(ctx context.Context, licensePlate string) (*vehicles.Vehicle, error)
+}
+
+// LifecycleService moves vehicles in and out of the stolen lifecycle status
+type LifecycleService interface {
+	Record(ctx context.Context, vehicle vehicles.Vehicle, params lifecycle.SubmitParams, decidedBy uuid.UUID) (*lifecycle.Request, error)
+}
+
+// EntityService handles entity operations
+type EntityService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Entity, error)
+}
+
+// AlertMailer notifies entities of vehicles reported stolen or missing
+type AlertMailer interface {
+	SendStolenVehicleAlert(ctx context.Context, to string, vehicleID uuid.UUID, vehicle invitation.VehicleInfo, entityName, kind string, policeReference *string) error
+}
+
+// Transactor runs a function inside a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service handles business logic for stolen vehicle reports
+type Service struct {
+	repo       Repository
+	vehicles   VehicleService
+	lifecycle  LifecycleService
+	entities   EntityService
+	mailer     AlertMailer
+	transactor Transactor
+	now        func() time.Time
+}
+
+// NewService creates a new stolen vehicle report service
+func NewService(repo Repository, vehicles VehicleService, lifecycle LifecycleService, entities EntityService, mailer AlertMailer, transactor Transactor) *Service {
+	return &Service{
+		repo:       repo,
+		vehicles:   vehicles,
+		lifecycle:  lifecycle,
+		entities:   entities,
+		mailer:     mailer,
+		transactor: transactor,
+		now:        time.Now,
+	}
+}
+
+// File records an owner's report that their vehicle was stolen or went missing. The vehicle is
+// only flagged once an admin approves the report.
+func (s *Service) File(ctx context.Context, vehicle vehicles.Vehicle, params FileParams) (*Report, error) {
+	if params.Kind != KindStolen && params.Kind != KindMissing {
+		return nil, ErrInvalidKind
+	}
+	if !vehicle.IsActive() {
+		return nil, vehicles.ErrVehicleNotActive
+	}
+
+	description := strings.TrimSpace(params.Description)
+	if description == "" {
+		return nil, ErrDescriptionRequired
+	}
+	if params.IncidentDate != nil && params.IncidentDate.After(s.now()) {
+		return nil, ErrIncidentInFuture
+	}
+
+	return s.repo.Create(ctx, CreateReportParams{
+		VehicleID:       vehicle.ID,
+		Kind:            params.Kind,
+		PoliceReference: trimmed(params.PoliceReference),
+		PoliceAuthority: trimmed(params.PoliceAuthority),
+		IncidentDate:    params.IncidentDate,
+		Location:        trimmed(params.Location),
+		Description:     description,
+		ReportedBy:      params.ReportedBy,
+	})
+}
+
+// Approve accepts a pending report. The vehicle is moved to the stolen lifecycle status in the
+// same transaction, which records the anchored lifecycle_change event and queues the freeze of its
+// asset. The entities that issued events on the vehicle are alerted once it is flagged.
+func (s *Service) Approve(ctx context.Context, reportID, decidedBy uuid.UUID) (*Report, error) {
+	r, err := s.getPending(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	var approved *Report
+	var vehicle *vehicles.Vehicle
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		approved, err = s.repo.Decide(ctx, r.ID, StatusApproved, decidedBy, nil)
+		if err != nil {
+			return err
+		}
+
+		vehicle, err = s.vehicles.GetByID(ctx, r.VehicleID)
+		if err != nil {
+			return err
+		}
+
+		lr, err := s.lifecycle.Record(ctx, *vehicle, lifecycle.SubmitParams{
+			ToStatus:    vehicles.LifecycleStolen,
+			Reason:      approvalReason(r.Kind),
+			RequestedBy: r.ReportedBy,
+		}, decidedBy)
+		if err != nil {
+			if errors.Is(err, lifecycle.ErrInvalidTransition) {
+				return vehicles.ErrVehicleNotActive
+			}
+			return err
+		}
+
+		if err := s.repo.SetLifecycleRequest(ctx, r.ID, lr.ID); err != nil {
+			return err
+		}
+		approved.LifecycleRequestID = &lr.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.alertEntities(ctx, *vehicle, *approved)
+	return approved, nil
+}
+
+// Reject declines a pending report, optionally explaining why
+func (s *Service) Reject(ctx context.Context, reportID, decidedBy uuid.UUID, reason *string) (*Report, error) {
+	r, err := s.getPending(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Decide(ctx, r.ID, StatusRejected, decidedBy, trimmed(reason))
+}
+
+// Resolve closes an approved report once the vehicle is recovered, returning it to active. A
+// vehicle that already left the stolen status, for example through a lifecycle request, is left
+// where it is.
+func (s *Service) Resolve(ctx context.Context, reportID, resolvedBy uuid.UUID, note *string) (*Report, error) {
+	if _, err := s.repo.GetByID(ctx, reportID); err != nil {
+		return nil, err
+	}
+	note = trimmed(note)
+
+	var resolved *Report
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		resolved, err = s.repo.Resolve(ctx, reportID, resolvedBy, note)
+		if err != nil {
+			return err
+		}
+
+		vehicle, err := s.vehicles.GetByID(ctx, resolved.VehicleID)
+		if err != nil {
+			return err
+		}
+		if vehicle.LifecycleStatus != vehicles.LifecycleStolen {
+			return nil
+		}
+
+		// The note stays on the report, as the lifecycle_change event is public
+		_, err = s.lifecycle.Record(ctx, *vehicle, lifecycle.SubmitParams{
+			ToStatus:    vehicles.LifecycleActive,
+			Reason:      "Vehicle recovered",
+			RequestedBy: resolvedBy,
+		}, resolvedBy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
+
+// ListByVehicle retrieves the reports filed for a vehicle, newest first
+func (s *Service) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Report, error) {
+	return s.repo.ListByVehicle(ctx, vehicleID)
+}
+
+// List retrieves the reports in a status, oldest first. An empty status lists every report.
+func (s *Service) List(ctx context.Context, status string) ([]Report, error) {
+	return s.repo.List(ctx, status)
+}
+
+// Lookup reports whether the vehicle with the chassis number or license plate is flagged as
+// stolen or missing. Nothing else about the vehicle is revealed, and unknown identifiers are
+// reported as not flagged so lookups cannot be used to find out which vehicles are registered.
+func (s *Service) Lookup(ctx context.Context, chassisNumber, licensePlate string) (*Alert, error) {
+	chassisNumber = strings.TrimSpace(chassisNumber)
+	licensePlate = strings.TrimSpace(licensePlate)
+	if chassisNumber == "" && licensePlate == "" {
+		return nil, ErrIdentifierRequired
+	}
+
+	if chassisNumber != "" {
+		vehicle, err := s.vehicles.GetByChassisNumber(ctx, chassisNumber)
+		if err != nil && !errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return nil, err
+		}
+		if vehicle != nil && vehicle.LifecycleStatus == vehicles.LifecycleStolen {
+			return s.alertFor(ctx, *vehicle)
+		}
+	}
+	if licensePlate != "" {
+		vehicle, err := s.vehicles.GetByLicensePlate(ctx, licensePlate)
+		if err != nil && !errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return nil, err
+		}
+		if vehicle != nil && vehicle.LifecycleStatus == vehicles.LifecycleStolen {
+			return s.alertFor(ctx, *vehicle)
+		}
+	}
+	return &Alert{Status: AlertNone}, nil
+}
+
+// alertFor returns the alert of a vehicle flagged as stolen
+func (s *Service) alertFor(ctx context.Context, vehicle vehicles.Vehicle) (*Alert, error) {
+	// Vehicles flagged through a lifecycle request rather than a report are reported as stolen
+	status := AlertStolen
+	r, err := s.repo.GetApprovedByVehicle(ctx, vehicle.ID)
+	if err != nil && !errors.Is(err, ErrReportNotFound) {
+		return nil, err
+	}
+	if r != nil && r.Kind == KindMissing {
+		status = AlertMissing
+	}
+
+	since := vehicle.LifecycleStatusAt
+	return &Alert{Status: status, Since: &since}, nil
+}
+
+// alertEntities emails the entities that issued events on the vehicle. Failures are logged, as
+// the report has already been approved.
+func (s *Service) alertEntities(ctx context.Context, vehicle vehicles.Vehicle, r Report) {
+	entityIDs, err := s.repo.ListIssuingEntities(ctx, vehicle.ID)
+	if err != nil {
+		log.Printf("stolen reports: list entities of vehicle %s: %v", vehicle.ID, err)
+		return
+	}
+
+	info := invitation.VehicleInfo{Make: vehicle.Make, Model: vehicle.Model, Year: vehicle.Year}
+	if vehicle.LicensePlate != nil {
+		info.LicensePlate = *vehicle.LicensePlate
+	}
+
+	for _, entityID := range entityIDs {
+		ent, err := s.entities.GetByID(ctx, entityID)
+		if err != nil {
+			log.Printf("stolen reports: load entity %s: %v", entityID, err)
+			continue
+		}
+		if ent.ContactEmail == "" {
+			continue
+		}
+		if err := s.mailer.SendStolenVehicleAlert(ctx, ent.ContactEmail, vehicle.ID, info, ent.Name, r.Kind, r.PoliceReference); err != nil {
+			log.Printf("stolen reports: alert entity %s of report %s: %v", entityID, r.ID, err)
+		}
+	}
+}
+
+func (s *Service) getPending(ctx context.Context, reportID uuid.UUID) (*Report, error) {
+	r, err := s.repo.GetByID(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != StatusPending {
+		return nil, ErrReportNotPending
+	}
+	return r, nil
+}
+
+// approvalReason is the description of the lifecycle_change event, which is public, so it does
+// not repeat the owner's account or the police reference
+func approvalReason(kind string) string {
+	if kind == KindMissing {
+		return "Missing vehicle report approved"
+	}
+	return "Stolen vehicle report approved"
+}
+
+func trimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	if t == "" {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,429 @@
+package stolen_reports
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockRepo struct {
+	reports  map[uuid.UUID]*Report
+	entities map[uuid.UUID][]uuid.UUID
+}
+
+func (m *mockRepo) Create(_ context.Context, params CreateReportParams) (*Report, error) {
+	for _, r := range m.reports {
+		if r.VehicleID == params.VehicleID && (r.Status == StatusPending || r.Status == StatusApproved) {
+			return nil, ErrReportAlreadyOpen
+		}
+	}
+	r := &Report{
+		ID:              uuid.New(),
+		VehicleID:       params.VehicleID,
+		Kind:            params.Kind,
+		PoliceReference: params.PoliceReference,
+		PoliceAuthority: params.PoliceAuthority,
+		IncidentDate:    params.IncidentDate,
+		Location:        params.Location,
+		Description:     params.Description,
+		ReportedBy:      params.ReportedBy,
+		Status:          StatusPending,
+		CreatedAt:       time.Now(),
+	}
+	m.reports[r.ID] = r
+	return r, nil
+}
+func (m *mockRepo) GetByID(_ context.Context, id uuid.UUID) (*Report, error) {
+	if r, ok := m.reports[id]; ok {
+		return r, nil
+	}
+	return nil, ErrReportNotFound
+}
+func (m *mockRepo) GetApprovedByVehicle(_ context.Context, vehicleID uuid.UUID) (*Report, error) {
+	for _, r := range m.reports {
+		if r.VehicleID == vehicleID && r.Status == StatusApproved {
+			return r, nil
+		}
+	}
+	return nil, ErrReportNotFound
+}
+func (m *mockRepo) ListByVehicle(_ context.Context, vehicleID uuid.UUID) ([]Report, error) {
+	var result []Report
+	for _, r := range m.reports {
+		if r.VehicleID == vehicleID {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+func (m *mockRepo) List(_ context.Context, status string) ([]Report, error) {
+	var result []Report
+	for _, r := range m.reports {
+		if status == "" || r.Status == status {
+			result = append(result, *r)
+		}
+	}
+	return result, nil
+}
+func (m *mockRepo) Decide(_ context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*Report, error) {
+	r := m.reports[id]
+	if r.Status != StatusPending {
+		return nil, ErrReportNotPending
+	}
+	now := time.Now()
+	r.Status = status
+	r.DecidedBy = &decidedBy
+	r.DeclineReason = declineReason
+	r.DecidedAt = &now
+	copied := *r
+	return &copied, nil
+}
+func (m *mockRepo) SetLifecycleRequest(_ context.Context, id, lifecycleRequestID uuid.UUID) error {
+	m.reports[id].LifecycleRequestID = &lifecycleRequestID
+	return nil
+}
+func (m *mockRepo) Resolve(_ context.Context, id, resolvedBy uuid.UUID, note *string) (*Report, error) {
+	r, ok := m.reports[id]
+	if !ok || r.Status != StatusApproved {
+		return nil, ErrReportNotApproved
+	}
+	now := time.Now()
+	r.Status = StatusResolved
+	r.ResolvedBy = &resolvedBy
+	r.ResolutionNote = note
+	r.ResolvedAt = &now
+	copied := *r
+	return &copied, nil
+}
+func (m *mockRepo) ListIssuingEntities(_ context.Context, vehicleID uuid.UUID) ([]uuid.UUID, error) {
+	return m.entities[vehicleID], nil
+}
+
+type mockVehicles struct {
+	vehicles map[uuid.UUID]*vehicles.Vehicle
+}
+
+func (m *mockVehicles) GetByID(_ context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
+	if v, ok := m.vehicles[id]; ok {
+		copied := *v
+		return &copied, nil
+	}
+	return nil, vehicles.ErrVehicleNotFound
+}
+func (m *mockVehicles) GetByChassisNumber(_ context.Context, chassisNumber string) (*vehicles.Vehicle, error) {
+	for _, v := range m.vehicles {
+		if v.ChassisNumber != nil && *v.ChassisNumber == chassisNumber {
+			copied := *v
+			return &copied, nil
+		}
+	}
+	return nil, vehicles.ErrVehicleNotFound
+}
+func (m *mockVehicles) GetByLicensePlate(_ context.Context, licensePlate string) (*vehicles.Vehicle, error) {
+	for _, v := range m.vehicles {
+		if v.LicensePlate != nil && *v.LicensePlate == licensePlate {
+			copied := *v
+			return &copied, nil
+		}
+	}
+	return nil, vehicles.ErrVehicleNotFound
+}
+
+type recordedTransition struct {
+	vehicleID uuid.UUID
+	params    lifecycle.SubmitParams
+	decidedBy uuid.UUID
+}
+
+// mockLifecycle applies transitions directly to the vehicles, checking them like the lifecycle
+// service does
+type mockLifecycle struct {
+	vehicles    *mockVehicles
+	transitions []recordedTransition
+}
+
+func (m *mockLifecycle) Record(_ context.Context, vehicle vehicles.Vehicle, params lifecycle.SubmitParams, decidedBy uuid.UUID) (*lifecycle.Request, error) {
+	if !lifecycle.CanTransition(vehicle.LifecycleStatus, params.ToStatus) {
+		return nil, lifecycle.ErrInvalidTransition
+	}
+	v := m.vehicles.vehicles[vehicle.ID]
+	v.LifecycleStatus = params.ToStatus
+	v.LifecycleStatusAt = time.Now()
+	m.transitions = append(m.transitions, recordedTransition{vehicleID: vehicle.ID, params: params, decidedBy: decidedBy})
+	return &lifecycle.Request{ID: uuid.New(), VehicleID: vehicle.ID, ToStatus: params.ToStatus, Status: lifecycle.StatusApproved}, nil
+}
+
+type mockEntities struct {
+	entities map[uuid.UUID]*entity.Entity
+}
+
+func (m *mockEntities) GetByID(_ context.Context, id uuid.UUID) (*entity.Entity, error) {
+	if e, ok := m.entities[id]; ok {
+		return e, nil
+	}
+	return nil, entity.ErrEntityNotFound
+}
+
+type sentAlert struct {
+	to              string
+	entityName      string
+	kind            string
+	policeReference *string
+}
+
+type mockMailer struct {
+	sent []sentAlert
+}
+
+func (m *mockMailer) SendStolenVehicleAlert(_ context.Context, to string, _ uuid.UUID, _ invitation.VehicleInfo, entityName, kind string, policeReference *string) error {
+	m.sent = append(m.sent, sentAlert{to: to, entityName: entityName, kind: kind, policeReference: policeReference})
+	return nil
+}
+
+type mockTransactor struct{}
+
+func (mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// --- Fixture ---
+
+type fixture struct {
+	svc       *Service
+	repo      *mockRepo
+	vehicles  *mockVehicles
+	lifecycle *mockLifecycle
+	entities  *mockEntities
+	mailer    *mockMailer
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		repo:     &mockRepo{reports: map[uuid.UUID]*Report{}, entities: map[uuid.UUID][]uuid.UUID{}},
+		vehicles: &mockVehicles{vehicles: map[uuid.UUID]*vehicles.Vehicle{}},
+		entities: &mockEntities{entities: map[uuid.UUID]*entity.Entity{}},
+		mailer:   &mockMailer{},
+	}
+	f.lifecycle = &mockLifecycle{vehicles: f.vehicles}
+	f.svc = NewService(f.repo, f.vehicles, f.lifecycle, f.entities, f.mailer, mockTransactor{})
+	return f
+}
+
+func (f *fixture) addVehicle(chassisNumber, licensePlate string) *vehicles.Vehicle {
+	ownerID := uuid.New()
+	v := &vehicles.Vehicle{
+		ID:              uuid.New(),
+		Make:            "Porsche",
+		Model:           "911",
+		Year:            1973,
+		ChassisNumber:   &chassisNumber,
+		LicensePlate:    &licensePlate,
+		OwnerID:         &ownerID,
+		LifecycleStatus: vehicles.LifecycleActive,
+	}
+	f.vehicles.vehicles[v.ID] = v
+	return v
+}
+
+func (f *fixture) file(t *testing.T, vehicle *vehicles.Vehicle, kind string) *Report {
+	t.Helper()
+	r, err := f.svc.File(context.Background(), *vehicle, FileParams{
+		Kind:        kind,
+		Description: "Taken from the garage overnight",
+		ReportedBy:  *vehicle.OwnerID,
+	})
+	require.NoError(t, err)
+	return r
+}
+
+func ptr(s string) *string { return &s }
+
+// --- Tests ---
+
+func TestService_File_Validates(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	vehicle := f.addVehicle("WP0ZZZ91ZJS100001", "AA-00-01")
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	_, err := f.svc.File(ctx, *vehicle, FileParams{Kind: "lost", Description: "gone", ReportedBy: *vehicle.OwnerID})
+	assert.ErrorIs(t, err, ErrInvalidKind)
+
+	_, err = f.svc.File(ctx, *vehicle, FileParams{Kind: KindStolen, Description: "  ", ReportedBy: *vehicle.OwnerID})
+	assert.ErrorIs(t, err, ErrDescriptionRequired)
+
+	_, err = f.svc.File(ctx, *vehicle, FileParams{Kind: KindStolen, Description: "gone", IncidentDate: &tomorrow, ReportedBy: *vehicle.OwnerID})
+	assert.ErrorIs(t, err, ErrIncidentInFuture)
+
+	scrapped := *vehicle
+	scrapped.LifecycleStatus = vehicles.LifecycleScrapped
+	_, err = f.svc.File(ctx, scrapped, FileParams{Kind: KindStolen, Description: "gone", ReportedBy: *vehicle.OwnerID})
+	assert.ErrorIs(t, err, vehicles.ErrVehicleNotActive)
+
+	r, err := f.svc.File(ctx, *vehicle, FileParams{
+		Kind:            KindStolen,
+		Description:     " gone ",
+		PoliceReference: ptr(" NUIPC 123/26 "),
+		PoliceAuthority: ptr(""),
+		ReportedBy:      *vehicle.OwnerID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, r.Status)
+	assert.Equal(t, "gone", r.Description)
+	assert.Equal(t, "NUIPC 123/26", *r.PoliceReference)
+	assert.Nil(t, r.PoliceAuthority)
+
+	_, err = f.svc.File(ctx, *vehicle, FileParams{Kind: KindMissing, Description: "gone again", ReportedBy: *vehicle.OwnerID})
+	assert.ErrorIs(t, err, ErrReportAlreadyOpen)
+}
+
+func TestService_Approve_FlagsVehicleAndAlertsEntities(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	vehicle := f.addVehicle("WP0ZZZ91ZJS100002", "AA-00-02")
+	restorer := &entity.Entity{ID: uuid.New(), Name: "Restorer", ContactEmail: "shop@example.com"}
+	silent := &entity.Entity{ID: uuid.New(), Name: "No Contact"}
+	f.entities.entities[restorer.ID] = restorer
+	f.entities.entities[silent.ID] = silent
+	f.repo.entities[vehicle.ID] = []uuid.UUID{restorer.ID, silent.ID}
+
+	r, err := f.svc.File(ctx, *vehicle, FileParams{
+		Kind:            KindStolen,
+		Description:     "Taken from the garage overnight",
+		PoliceReference: ptr("NUIPC 123/26"),
+		ReportedBy:      *vehicle.OwnerID,
+	})
+	require.NoError(t, err)
+	adminID := uuid.New()
+
+	approved, err := f.svc.Approve(ctx, r.ID, adminID)
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusApproved, approved.Status)
+	require.NotNil(t, approved.LifecycleRequestID)
+	assert.Equal(t, vehicles.LifecycleStolen, f.vehicles.vehicles[vehicle.ID].LifecycleStatus)
+
+	require.Len(t, f.lifecycle.transitions, 1)
+	transition := f.lifecycle.transitions[0]
+	assert.Equal(t, *vehicle.OwnerID, transition.params.RequestedBy)
+	assert.Equal(t, adminID, transition.decidedBy)
+	assert.NotContains(t, transition.params.Reason, "NUIPC", "the lifecycle event is public")
+
+	require.Len(t, f.mailer.sent, 1)
+	assert.Equal(t, "shop@example.com", f.mailer.sent[0].to)
+	assert.Equal(t, KindStolen, f.mailer.sent[0].kind)
+	assert.Equal(t, "NUIPC 123/26", *f.mailer.sent[0].policeReference)
+
+	_, err = f.svc.Approve(ctx, r.ID, adminID)
+	assert.ErrorIs(t, err, ErrReportNotPending)
+}
+
+func TestService_Approve_VehicleNoLongerActive(t *testing.T) {
+	f := newFixture()
+	vehicle := f.addVehicle("WP0ZZZ91ZJS100003", "AA-00-03")
+	r := f.file(t, vehicle, KindMissing)
+	f.vehicles.vehicles[vehicle.ID].LifecycleStatus = vehicles.LifecycleScrapped
+
+	_, err := f.svc.Approve(context.Background(), r.ID, uuid.New())
+	assert.ErrorIs(t, err, vehicles.ErrVehicleNotActive)
+	assert.Empty(t, f.mailer.sent)
+}
+
+func TestService_Resolve_ReturnsVehicleToActive(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	vehicle := f.addVehicle("WP0ZZZ91ZJS100004", "AA-00-04")
+	r := f.file(t, vehicle, KindStolen)
+
+	_, err := f.svc.Resolve(ctx, r.ID, uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrReportNotApproved)
+
+	_, err = f.svc.Approve(ctx, r.ID, uuid.New())
+	require.NoError(t, err)
+
+	resolved, err := f.svc.Resolve(ctx, r.ID, uuid.New(), ptr("Found by the police in Porto"))
+	require.NoError(t, err)
+	assert.Equal(t, StatusResolved, resolved.Status)
+	assert.Equal(t, vehicles.LifecycleActive, f.vehicles.vehicles[vehicle.ID].LifecycleStatus)
+	require.Len(t, f.lifecycle.transitions, 2)
+	assert.Equal(t, "Vehicle recovered", f.lifecycle.transitions[1].params.Reason)
+
+	_, err = f.svc.Resolve(ctx, uuid.New(), uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrReportNotFound)
+}
+
+func TestService_Resolve_VehicleAlreadyMovedOn(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	vehicle := f.addVehicle("WP0ZZZ91ZJS100005", "AA-00-05")
+	r := f.file(t, vehicle, KindStolen)
+	_, err := f.svc.Approve(ctx, r.ID, uuid.New())
+	require.NoError(t, err)
+	f.vehicles.vehicles[vehicle.ID].LifecycleStatus = vehicles.LifecycleScrapped
+
+	resolved, err := f.svc.Resolve(ctx, r.ID, uuid.New(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, StatusResolved, resolved.Status)
+	assert.Len(t, f.lifecycle.transitions, 1)
+	assert.Equal(t, vehicles.LifecycleScrapped, f.vehicles.vehicles[vehicle.ID].LifecycleStatus)
+}
+
+func TestService_Lookup(t *testing.T) {
+	f := newFixture()
+	ctx := context.Background()
+	stolen := f.addVehicle("WP0ZZZ91ZJS100006", "AA-00-06")
+	missing := f.addVehicle("WP0ZZZ91ZJS100007", "AA-00-07")
+	f.addVehicle("WP0ZZZ91ZJS100008", "AA-00-08")
+	pending := f.addVehicle("WP0ZZZ91ZJS100009", "AA-00-09")
+
+	_, err := f.svc.Approve(ctx, f.file(t, stolen, KindStolen).ID, uuid.New())
+	require.NoError(t, err)
+	_, err = f.svc.Approve(ctx, f.file(t, missing, KindMissing).ID, uuid.New())
+	require.NoError(t, err)
+	f.file(t, pending, KindStolen)
+
+	_, err = f.svc.Lookup(ctx, " ", "")
+	assert.ErrorIs(t, err, ErrIdentifierRequired)
+
+	tests := []struct {
+		name          string
+		chassisNumber string
+		licensePlate  string
+		want          string
+	}{
+		{"stolen by chassis", "WP0ZZZ91ZJS100006", "", AlertStolen},
+		{"missing by plate", "", " AA-00-07 ", AlertMissing},
+		{"active vehicle", "WP0ZZZ91ZJS100008", "", AlertNone},
+		{"pending report", "WP0ZZZ91ZJS100009", "", AlertNone},
+		{"unknown vehicle", "UNKNOWN", "", AlertNone},
+		{"plate flagged when chassis is not", "WP0ZZZ91ZJS100008", "AA-00-06", AlertStolen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert, err := f.svc.Lookup(ctx, tt.chassisNumber, tt.licensePlate)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, alert.Status)
+			if tt.want == AlertNone {
+				assert.Nil(t, alert.Since)
+			} else {
+				assert.NotNil(t, alert.Since)
+			}
+		})
+	}
+
+	// Vehicles flagged through a lifecycle request have no report and are reported as stolen
+	f.vehicles.vehicles[pending.ID].LifecycleStatus = vehicles.LifecycleStolen
+	alert, err := f.svc.Lookup(ctx, "", "AA-00-09")
+	require.NoError(t, err)
+	assert.Equal(t, AlertStolen, alert.Status)
+}
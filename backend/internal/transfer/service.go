@@ -0,0 +1,243 @@
+package transfer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+const tokenExpiry = 7 * 24 * time.Hour
+
+// Repository defines the data access interface for ownership transfers
+type Repository interface {
+	Create(ctx context.Context, params CreateTransferParams) (*Transfer, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Transfer, error)
+	GetByToken(ctx context.Context, token string) (*Transfer, error)
+	ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Transfer, error)
+	// Accept and Cancel return ErrTransferNotPending when the transfer is no longer pending
+	Accept(ctx context.Context, id, toOwnerID uuid.UUID) (*Transfer, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*Transfer, error)
+	CancelPending(ctx context.Context, vehicleID uuid.UUID) error
+	SetEvent(ctx context.Context, id, eventID uuid.UUID) error
+}
+
+// VehicleService handles vehicle operations
+type VehicleService interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+	TransferOwnership(ctx context.Context, vehicleID uuid.UUID, params vehicles.TransferOwnershipParams) (*vehicles.Vehicle, error)
+}
+
+// EventService handles event operations
+type EventService interface {
+	RecordOwnershipTransfer(ctx context.Context, vehicle vehicles.Vehicle, params event.CreateEventParams) (*event.Event, error)
+}
+
+// CustodyNotifier moves the vehicle's asset to the wallet of its new owner
+type CustodyNotifier interface {
+	// OwnershipChanged queues the custody transfer within the transaction that changes the owner
+	OwnershipChanged(ctx context.Context, vehicleID uuid.UUID) error
+}
+
+// Transactor runs a function inside a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service handles business logic for ownership transfers
+type Service struct {
+	repo       Repository
+	vehicles   VehicleService
+	events     EventService
+	transactor Transactor
+	mailer     Mailer
+	custody    CustodyNotifier
+}
+
+// NewService creates a new ownership transfer service
+func NewService(repo Repository, vehicles VehicleService, events EventService, transactor Transactor, mailer Mailer) *Service {
+	return &Service{
+		repo:       repo,
+		vehicles:   vehicles,
+		events:     events,
+		transactor: transactor,
+		mailer:     mailer,
+	}
+}
+
+// SetCustodyNotifier sets the notifier that moves vehicle assets on ownership change (optional).
+// Without it assets stay where they are.
+func (s *Service) SetCustodyNotifier(custody CustodyNotifier) {
+	s.custody = custody
+}
+
+// generateTransferToken generates a secure random token
+func generateTransferToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(bytes), nil
+}
+
+// Initiate starts a transfer of the vehicle from its current owner to the holder of toEmail and
+// emails them the acceptance link. A pending transfer of the same vehicle is cancelled.
+func (s *Service) Initiate(ctx context.Context, vehicle *vehicles.Vehicle, toEmail string) (*Transfer, error) {
+	if vehicle.OwnerID == nil {
+		return nil, ErrVehicleHasNoOwner
+	}
+	if !vehicle.IsActive() {
+		return nil, vehicles.ErrVehicleNotActive
+	}
+
+	token, err := generateTransferToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate token: %w", err)
+	}
+
+	var created *Transfer
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.CancelPending(ctx, vehicle.ID); err != nil {
+			return fmt.Errorf("cancel pending transfers: %w", err)
+		}
+
+		created, err = s.repo.Create(ctx, CreateTransferParams{
+			VehicleID:      vehicle.ID,
+			FromOwnerID:    *vehicle.OwnerID,
+			ToEmail:        toEmail,
+			Token:          token,
+			TokenExpiresAt: time.Now().Add(tokenExpiry),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info := invitation.VehicleInfo{
+		Make:  vehicle.Make,
+		Model: vehicle.Model,
+		Year:  vehicle.Year,
+	}
+	if vehicle.LicensePlate != nil {
+		info.LicensePlate = *vehicle.LicensePlate
+	}
+	if err := s.mailer.SendOwnershipTransfer(ctx, toEmail, token, info); err != nil {
+		return nil, fmt.Errorf("send transfer email: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetByToken retrieves a pending transfer by its token
+func (s *Service) GetByToken(ctx context.Context, token string) (*Transfer, error) {
+	t, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != StatusPending {
+		return nil, ErrTransferNotPending
+	}
+	if time.Now().After(t.TokenExpiresAt) {
+		return nil, ErrTransferExpired
+	}
+	return t, nil
+}
+
+// Accept completes a transfer on behalf of the recipient, who must be signed in with the email
+// address the transfer was sent to. The vehicle changes owner and an ownership_transfer event is
+// recorded and anchored in the same transaction.
+func (s *Service) Accept(ctx context.Context, token, email string, userID uuid.UUID) (*Transfer, error) {
+	t, err := s.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(t.ToEmail, email) {
+		return nil, ErrRecipientMismatch
+	}
+	if t.FromOwnerID == userID {
+		return nil, ErrSelfTransfer
+	}
+
+	var accepted *Transfer
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		accepted, err = s.repo.Accept(ctx, t.ID, userID)
+		if err != nil {
+			return err
+		}
+
+		vehicle, err := s.vehicles.GetByID(ctx, t.VehicleID)
+		if err != nil {
+			return err
+		}
+		if vehicle.OwnerID == nil || *vehicle.OwnerID != t.FromOwnerID {
+			return ErrOwnerChanged
+		}
+
+		transferDate := time.Now().UTC()
+		if accepted.CompletedAt != nil {
+			transferDate = *accepted.CompletedAt
+		}
+
+		vehicle, err = s.vehicles.TransferOwnership(ctx, vehicle.ID, vehicles.TransferOwnershipParams{
+			NewOwnerID:   userID,
+			TransferDate: transferDate,
+		})
+		if err != nil {
+			return fmt.Errorf("transfer ownership: %w", err)
+		}
+
+		if s.custody != nil {
+			if err := s.custody.OwnershipChanged(ctx, vehicle.ID); err != nil {
+				return fmt.Errorf("queue custody transfer: %w", err)
+			}
+		}
+
+		// The event carries no owner identities, as it is shown on the public passport
+		evt, err := s.events.RecordOwnershipTransfer(ctx, *vehicle, event.CreateEventParams{
+			VehicleID: vehicle.ID,
+			Title:     "Ownership transferred",
+			Date:      &transferDate,
+			Metadata:  map[string]interface{}{event.MetadataTransferID: t.ID.String()},
+		})
+		if err != nil {
+			return fmt.Errorf("create transfer event: %w", err)
+		}
+
+		if err := s.repo.SetEvent(ctx, t.ID, evt.ID); err != nil {
+			return fmt.Errorf("set transfer event: %w", err)
+		}
+		accepted.EventID = &evt.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accepted, nil
+}
+
+// Cancel cancels a pending transfer of the vehicle
+func (s *Service) Cancel(ctx context.Context, vehicleID, transferID uuid.UUID) (*Transfer, error) {
+	t, err := s.repo.GetByID(ctx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if t.VehicleID != vehicleID {
+		return nil, ErrTransferNotFound
+	}
+	return s.repo.Cancel(ctx, t.ID)
+}
+
+// ListByVehicle retrieves the transfers of a vehicle, newest first
+func (s *Service) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Transfer, error) {
+	return s.repo.ListByVehicle(ctx, vehicleID)
+}
@@ -0,0 +1,272 @@
+package transfer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockRepo struct {
+	transfers       map[uuid.UUID]*Transfer
+	cancelledFor    []uuid.UUID
+	eventByTransfer map[uuid.UUID]uuid.UUID
+}
+
+func newMockRepo(transfers ...*Transfer) *mockRepo {
+	m := &mockRepo{transfers: map[uuid.UUID]*Transfer{}, eventByTransfer: map[uuid.UUID]uuid.UUID{}}
+	for _, t := range transfers {
+		m.transfers[t.ID] = t
+	}
+	return m
+}
+
+func (m *mockRepo) Create(ctx context.Context, params CreateTransferParams) (*Transfer, error) {
+	t := &Transfer{
+		ID:             uuid.New(),
+		VehicleID:      params.VehicleID,
+		FromOwnerID:    params.FromOwnerID,
+		ToEmail:        params.ToEmail,
+		Token:          params.Token,
+		TokenExpiresAt: params.TokenExpiresAt,
+		Status:         StatusPending,
+	}
+	m.transfers[t.ID] = t
+	return t, nil
+}
+func (m *mockRepo) GetByID(ctx context.Context, id uuid.UUID) (*Transfer, error) {
+	if t, ok := m.transfers[id]; ok {
+		return t, nil
+	}
+	return nil, ErrTransferNotFound
+}
+func (m *mockRepo) GetByToken(ctx context.Context, token string) (*Transfer, error) {
+	for _, t := range m.transfers {
+		if t.Token == token {
+			return t, nil
+		}
+	}
+	return nil, ErrTransferNotFound
+}
+func (m *mockRepo) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Transfer, error) {
+	return nil, nil
+}
+func (m *mockRepo) Accept(ctx context.Context, id, toOwnerID uuid.UUID) (*Transfer, error) {
+	t := m.transfers[id]
+	if t.Status != StatusPending {
+		return nil, ErrTransferNotPending
+	}
+	now := time.Now().UTC()
+	t.Status = StatusAccepted
+	t.ToOwnerID = &toOwnerID
+	t.CompletedAt = &now
+	return t, nil
+}
+func (m *mockRepo) Cancel(ctx context.Context, id uuid.UUID) (*Transfer, error) {
+	t := m.transfers[id]
+	if t.Status != StatusPending {
+		return nil, ErrTransferNotPending
+	}
+	t.Status = StatusCancelled
+	return t, nil
+}
+func (m *mockRepo) CancelPending(ctx context.Context, vehicleID uuid.UUID) error {
+	m.cancelledFor = append(m.cancelledFor, vehicleID)
+	return nil
+}
+func (m *mockRepo) SetEvent(ctx context.Context, id, eventID uuid.UUID) error {
+	m.eventByTransfer[id] = eventID
+	return nil
+}
+
+type mockVehicleService struct {
+	vehicle     *vehicles.Vehicle
+	transferred []vehicles.TransferOwnershipParams
+}
+
+func (m *mockVehicleService) GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
+	copy := *m.vehicle
+	return &copy, nil
+}
+func (m *mockVehicleService) TransferOwnership(ctx context.Context, vehicleID uuid.UUID, params vehicles.TransferOwnershipParams) (*vehicles.Vehicle, error) {
+	m.transferred = append(m.transferred, params)
+	m.vehicle.OwnerID = &params.NewOwnerID
+	copy := *m.vehicle
+	return &copy, nil
+}
+
+type mockEventService struct {
+	transfers []event.CreateEventParams
+}
+
+func (m *mockEventService) RecordOwnershipTransfer(ctx context.Context, vehicle vehicles.Vehicle, params event.CreateEventParams) (*event.Event, error) {
+	m.transfers = append(m.transfers, params)
+	return &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Type: event.TypeOwnershipTransfer}, nil
+}
+
+type mockTransactor struct{}
+
+func (m *mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type mockMailer struct {
+	sentTo []string
+}
+
+func (m *mockMailer) SendOwnershipTransfer(ctx context.Context, to, token string, vehicle invitation.VehicleInfo) error {
+	m.sentTo = append(m.sentTo, to)
+	return nil
+}
+
+type mockCustody struct {
+	changed []uuid.UUID
+}
+
+func (m *mockCustody) OwnershipChanged(ctx context.Context, vehicleID uuid.UUID) error {
+	m.changed = append(m.changed, vehicleID)
+	return nil
+}
+
+func pendingTransfer(vehicleID, fromOwnerID uuid.UUID) *Transfer {
+	return &Transfer{
+		ID:             uuid.New(),
+		VehicleID:      vehicleID,
+		FromOwnerID:    fromOwnerID,
+		ToEmail:        "buyer@test.com",
+		Token:          "token",
+		TokenExpiresAt: time.Now().Add(time.Hour),
+		Status:         StatusPending,
+	}
+}
+
+// --- Tests ---
+
+func TestService_Initiate_CancelsPendingAndSendsEmail(t *testing.T) {
+	owner := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &owner, Make: "Porsche", Model: "911"}
+	repo := newMockRepo()
+	mailer := &mockMailer{}
+	svc := NewService(repo, &mockVehicleService{vehicle: vehicle}, &mockEventService{}, &mockTransactor{}, mailer)
+
+	created, err := svc.Initiate(context.Background(), vehicle, "buyer@test.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, owner, created.FromOwnerID)
+	assert.Equal(t, StatusPending, created.Status)
+	assert.NotEmpty(t, created.Token)
+	assert.Equal(t, []uuid.UUID{vehicle.ID}, repo.cancelledFor)
+	assert.Equal(t, []string{"buyer@test.com"}, mailer.sentTo)
+}
+
+func TestService_Initiate_VehicleWithoutOwner(t *testing.T) {
+	svc := NewService(newMockRepo(), &mockVehicleService{}, &mockEventService{}, &mockTransactor{}, &mockMailer{})
+
+	_, err := svc.Initiate(context.Background(), &vehicles.Vehicle{ID: uuid.New()}, "buyer@test.com")
+	assert.ErrorIs(t, err, ErrVehicleHasNoOwner)
+}
+
+func TestService_Initiate_StolenVehicle(t *testing.T) {
+	owner := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &owner, LifecycleStatus: vehicles.LifecycleStolen}
+	repo := newMockRepo()
+	mailer := &mockMailer{}
+	svc := NewService(repo, &mockVehicleService{vehicle: vehicle}, &mockEventService{}, &mockTransactor{}, mailer)
+
+	_, err := svc.Initiate(context.Background(), vehicle, "buyer@test.com")
+	assert.ErrorIs(t, err, vehicles.ErrVehicleNotActive)
+	assert.Empty(t, mailer.sentTo)
+}
+
+func TestService_Accept_TransfersOwnershipAndRecordsEvent(t *testing.T) {
+	owner := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &owner}
+	pending := pendingTransfer(vehicle.ID, owner)
+	repo := newMockRepo(pending)
+	vehicleSvc := &mockVehicleService{vehicle: vehicle}
+	eventSvc := &mockEventService{}
+	svc := NewService(repo, vehicleSvc, eventSvc, &mockTransactor{}, &mockMailer{})
+
+	buyer := uuid.New()
+	accepted, err := svc.Accept(context.Background(), "token", "Buyer@Test.com", buyer)
+
+	require.NoError(t, err)
+	assert.Equal(t, StatusAccepted, accepted.Status)
+	require.Len(t, vehicleSvc.transferred, 1)
+	assert.Equal(t, buyer, vehicleSvc.transferred[0].NewOwnerID)
+	assert.Equal(t, *accepted.CompletedAt, vehicleSvc.transferred[0].TransferDate)
+
+	require.Len(t, eventSvc.transfers, 1)
+	assert.Nil(t, eventSvc.transfers[0].EntityID)
+	assert.Equal(t, pending.ID.String(), eventSvc.transfers[0].Metadata[event.MetadataTransferID])
+	require.NotNil(t, accepted.EventID)
+	assert.Equal(t, *accepted.EventID, repo.eventByTransfer[pending.ID])
+}
+
+func TestService_Accept_QueuesCustodyTransfer(t *testing.T) {
+	owner := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &owner}
+	repo := newMockRepo(pendingTransfer(vehicle.ID, owner))
+	custody := &mockCustody{}
+	svc := NewService(repo, &mockVehicleService{vehicle: vehicle}, &mockEventService{}, &mockTransactor{}, &mockMailer{})
+	svc.SetCustodyNotifier(custody)
+
+	_, err := svc.Accept(context.Background(), "token", "buyer@test.com", uuid.New())
+
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{vehicle.ID}, custody.changed)
+}
+
+func TestService_Accept_RecipientMismatch(t *testing.T) {
+	owner := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &owner}
+	repo := newMockRepo(pendingTransfer(vehicle.ID, owner))
+	vehicleSvc := &mockVehicleService{vehicle: vehicle}
+	svc := NewService(repo, vehicleSvc, &mockEventService{}, &mockTransactor{}, &mockMailer{})
+
+	_, err := svc.Accept(context.Background(), "token", "someone@else.com", uuid.New())
+
+	assert.ErrorIs(t, err, ErrRecipientMismatch)
+	assert.Empty(t, vehicleSvc.transferred)
+}
+
+func TestService_Accept_Expired(t *testing.T) {
+	owner := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &owner}
+	expired := pendingTransfer(vehicle.ID, owner)
+	expired.TokenExpiresAt = time.Now().Add(-time.Minute)
+	svc := NewService(newMockRepo(expired), &mockVehicleService{vehicle: vehicle}, &mockEventService{}, &mockTransactor{}, &mockMailer{})
+
+	_, err := svc.Accept(context.Background(), "token", "buyer@test.com", uuid.New())
+	assert.ErrorIs(t, err, ErrTransferExpired)
+}
+
+func TestService_Accept_OwnerChangedSinceInitiation(t *testing.T) {
+	owner := uuid.New()
+	otherOwner := uuid.New()
+	vehicle := &vehicles.Vehicle{ID: uuid.New(), OwnerID: &otherOwner}
+	repo := newMockRepo(pendingTransfer(vehicle.ID, owner))
+	vehicleSvc := &mockVehicleService{vehicle: vehicle}
+	svc := NewService(repo, vehicleSvc, &mockEventService{}, &mockTransactor{}, &mockMailer{})
+
+	_, err := svc.Accept(context.Background(), "token", "buyer@test.com", uuid.New())
+
+	assert.ErrorIs(t, err, ErrOwnerChanged)
+	assert.Empty(t, vehicleSvc.transferred)
+}
+
+func TestService_Cancel_OtherVehicle(t *testing.T) {
+	pending := pendingTransfer(uuid.New(), uuid.New())
+	svc := NewService(newMockRepo(pending), &mockVehicleService{}, &mockEventService{}, &mockTransactor{}, &mockMailer{})
+
+	_, err := svc.Cancel(context.Background(), uuid.New(), pending.ID)
+	assert.ErrorIs(t, err, ErrTransferNotFound)
+}
@@ -0,0 +1,56 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTransferNotFound   = errors.New("ownership transfer not found")
+	ErrTransferExpired    = errors.New("ownership transfer has expired")
+	ErrTransferNotPending = errors.New("ownership transfer is no longer pending")
+	ErrVehicleHasNoOwner  = errors.New("vehicle has no owner to transfer from")
+	ErrRecipientMismatch  = errors.New("ownership transfer was sent to a different email address")
+	ErrSelfTransfer       = errors.New("vehicle cannot be transferred to its current owner")
+	ErrOwnerChanged       = errors.New("vehicle owner changed after the transfer was initiated")
+)
+
+const (
+	StatusPending   = "pending"
+	StatusAccepted  = "accepted"
+	StatusCancelled = "cancelled"
+)
+
+// Transfer is a handover of a vehicle from its current owner to the holder of an email address.
+// The recipient accepts it through the emailed token, which makes them the owner.
+type Transfer struct {
+	ID             uuid.UUID  `json:"id"`
+	VehicleID      uuid.UUID  `json:"vehicleId"`
+	FromOwnerID    uuid.UUID  `json:"fromOwnerId"`
+	ToEmail        string     `json:"toEmail"`
+	ToOwnerID      *uuid.UUID `json:"toOwnerId,omitempty"`
+	Token          string     `json:"-"`
+	TokenExpiresAt time.Time  `json:"tokenExpiresAt"`
+	Status         string     `json:"status"`
+	EventID        *uuid.UUID `json:"eventId,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	CompletedAt    *time.Time `json:"completedAt,omitempty"`
+}
+
+// CreateTransferParams represents parameters for creating a new ownership transfer
+type CreateTransferParams struct {
+	VehicleID      uuid.UUID
+	FromOwnerID    uuid.UUID
+	ToEmail        string
+	Token          string
+	TokenExpiresAt time.Time
+}
+
+// Mailer defines the interface for sending ownership transfer emails
+type Mailer interface {
+	SendOwnershipTransfer(ctx context.Context, to, token string, vehicle invitation.VehicleInfo) error
+}
@@ -88,6 +88,23 @@ func (s *Service) GetUserByEmail(ctx context.Context, email string) (*uuid.UUID,
 	return &userID, nil
 }
 
+// GetUserEmail retrieves the email address of a user
+func (s *Service) GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error) {
+	if s.kratos == nil {
+		return "", fmt.Errorf("kratos client not configured")
+	}
+
+	user, err := s.kratos.GetUser(ctx, userID.String())
+	if err != nil {
+		return "", fmt.Errorf("get user from kratos: %w", err)
+	}
+	if user == nil || user.Email == "" {
+		return "", ErrUserNotFound
+	}
+
+	return user.Email, nil
+}
+
 // GetUserEntityMemberships retrieves all entities a user belongs to
 func (s *Service) GetUserEntityMemberships(ctx context.Context, userID uuid.UUID) ([]EntityMembership, error) {
 	return s.repo.GetUserEntityMemberships(ctx, userID)
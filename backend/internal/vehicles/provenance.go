@@ -0,0 +1,47 @@
+package vehicles
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProvenancePeriod is an ownership period with the owner's identity replaced by their position in
+// the chain of custody. An owner who owned the vehicle more than once keeps the same number.
+type ProvenancePeriod struct {
+	OwnerNumber int        `json:"ownerNumber"`
+	Source      string     `json:"source"`
+	StartDate   time.Time  `json:"startDate"`
+	EndDate     *time.Time `json:"endDate,omitempty"`
+}
+
+// Provenance pseudonymises ownership periods, which must be ordered oldest first. Periods of
+// deleted accounts cannot be matched to each other, so each is numbered as a separate owner.
+func Provenance(owners []Owner) []ProvenancePeriod {
+	numbers := make(map[uuid.UUID]int, len(owners))
+	next := 1
+
+	periods := make([]ProvenancePeriod, len(owners))
+	for i, o := range owners {
+		number := next
+		if o.OwnerID != nil {
+			if n, ok := numbers[*o.OwnerID]; ok {
+				number = n
+			} else {
+				numbers[*o.OwnerID] = number
+			}
+		}
+		if number == next {
+			next++
+		}
+
+		periods[i] = ProvenancePeriod{
+			OwnerNumber: number,
+			Source:      o.Source,
+			StartDate:   o.StartDate,
+			EndDate:     o.EndDate,
+		}
+	}
+
+	return periods
+}
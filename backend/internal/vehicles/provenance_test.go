@@ -0,0 +1,53 @@
+package vehicles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func year(y int) time.Time {
+	return time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestProvenance_NumbersOwnersInOrder(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	end2011, end2019 := year(2011), year(2019)
+
+	periods := Provenance([]Owner{
+		{OwnerID: &first, Source: OwnerSourceRegistration, StartDate: year(2005), EndDate: &end2011},
+		{OwnerID: &second, Source: OwnerSourceTransfer, StartDate: year(2011), EndDate: &end2019},
+		{OwnerID: &first, Source: OwnerSourceTransfer, StartDate: year(2019)},
+	})
+
+	require.Len(t, periods, 3)
+	assert.Equal(t, 1, periods[0].OwnerNumber)
+	assert.Equal(t, 2, periods[1].OwnerNumber)
+	assert.Equal(t, year(2011), periods[1].StartDate)
+	assert.Equal(t, &end2019, periods[1].EndDate)
+	// Buying the vehicle back keeps the owner's number
+	assert.Equal(t, 1, periods[2].OwnerNumber)
+	assert.Nil(t, periods[2].EndDate)
+}
+
+func TestProvenance_DeletedOwnersAreNumberedSeparately(t *testing.T) {
+	current := uuid.New()
+
+	periods := Provenance([]Owner{
+		{StartDate: year(1990)},
+		{StartDate: year(2000)},
+		{OwnerID: &current, StartDate: year(2010)},
+	})
+
+	require.Len(t, periods, 3)
+	assert.Equal(t, 1, periods[0].OwnerNumber)
+	assert.Equal(t, 2, periods[1].OwnerNumber)
+	assert.Equal(t, 3, periods[2].OwnerNumber)
+}
+
+func TestProvenance_Empty(t *testing.T) {
+	assert.Empty(t, Provenance(nil))
+}
@@ -5,17 +5,22 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
 	"github.com/google/uuid"
 )
 
 const (
 	SubjectVehicleGenesis = "anchor.vehicle"
+	SubjectVehicleUpdate  = "anchor.vehicle_update"
 
-	StatusNone    = "none"
-	StatusPending = "pending"
+	StatusNone     = "none"
+	StatusPending  = "pending"
+	StatusAnchored = "anchored"
+	StatusFailed   = "failed"
 )
 
 // Repository defines the data access interface for vehicles
@@ -29,21 +34,98 @@ type Repository interface {
 	Create(ctx context.Context, vehicle *Vehicle) (*Vehicle, error)
 	Update(ctx context.Context, vehicle *Vehicle) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// SetLifecycleStatus returns ErrLifecycleStatusChanged when the vehicle is no longer in the from status
+	SetLifecycleStatus(ctx context.Context, id uuid.UUID, from, to string) error
+	ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Vehicle, int, error)
+	CreateVersion(ctx context.Context, version Version) (*Version, error)
+	GetVersion(ctx context.Context, id uuid.UUID) (*Version, error)
+	ListVersions(ctx context.Context, vehicleID uuid.UUID) ([]Version, error)
+	UpdateVersion(ctx context.Context, version *Version) error
+	ListVersionsByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Version, int, error)
+	LockChainHead(ctx context.Context, vehicleID uuid.UUID) (*string, error)
+	SetChainHead(ctx context.Context, vehicleID uuid.UUID, cid string) error
+	CreateOwner(ctx context.Context, owner Owner) (*Owner, error)
+	EndOwnership(ctx context.Context, vehicleID uuid.UUID, endDate time.Time) error
+	ListOwners(ctx context.Context, vehicleID uuid.UUID) ([]Owner, error)
+}
+
+// Transactor runs a function inside a single database transaction
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type CIDGenerator interface {
+	GenerateCID(data interface{}) (*cidpkg.CID, error)
 }
 
 type VehicleGenesisJob struct {
 	VehicleID uuid.UUID `json:"vehicleId"`
 }
 
+type VehicleUpdateJob struct {
+	VehicleID uuid.UUID `json:"vehicleId"`
+	VersionID uuid.UUID `json:"versionId"`
+}
+
+// vehicleCIDRecord is the data structure hashed to produce the vehicle CID.
+// Mirrors anchorer.VehicleRecord but avoids the import cycle.
+type vehicleCIDRecord struct {
+	ID                 uuid.UUID  `json:"id"`
+	PreviousCID        *string    `json:"previousCid,omitempty"`
+	LicensePlate       *string    `json:"licensePlate,omitempty"`
+	ChassisNumber      *string    `json:"chassisNumber,omitempty"`
+	Make               *string    `json:"make,omitempty"`
+	Model              *string    `json:"model,omitempty"`
+	Year               *int       `json:"year,omitempty"`
+	Color              *string    `json:"color,omitempty"`
+	EngineNumber       *string    `json:"engineNumber,omitempty"`
+	TransmissionNumber *string    `json:"transmissionNumber,omitempty"`
+	BodyType           *string    `json:"bodyType,omitempty"`
+	DriveType          *string    `json:"driveType,omitempty"`
+	GearType           *string    `json:"gearType,omitempty"`
+	SuspensionType     *string    `json:"suspensionType,omitempty"`
+	OwnerID            *uuid.UUID `json:"ownerId,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt,omitempty"`
+}
+
+func newVehicleCIDRecord(v *Vehicle, previousCID *string) vehicleCIDRecord {
+	return vehicleCIDRecord{
+		ID:                 v.ID,
+		PreviousCID:        previousCID,
+		LicensePlate:       v.LicensePlate,
+		ChassisNumber:      v.ChassisNumber,
+		Make:               &v.Make,
+		Model:              &v.Model,
+		Year:               &v.Year,
+		Color:              v.Color,
+		EngineNumber:       v.EngineNumber,
+		TransmissionNumber: v.TransmissionNumber,
+		BodyType:           v.BodyType,
+		DriveType:          v.DriveType,
+		GearType:           v.GearType,
+		SuspensionType:     v.SuspensionType,
+		OwnerID:            v.OwnerID,
+		CreatedAt:          v.CreatedAt,
+	}
+}
+
+// GenesisCIDRecord returns the data hashed to produce the genesis CID of the vehicle
+func GenesisCIDRecord(v Vehicle) interface{} {
+	return newVehicleCIDRecord(&v, nil)
+}
+
 // Service handles business logic for vehicle management
 type Service struct {
-	repo      Repository
-	publisher queue.Publisher
+	repo         Repository
+	publisher    queue.Publisher
+	transactor   Transactor
+	cidGenerator CIDGenerator
 }
 
-// NewService creates a new vehicle service
-func NewService(repo Repository, publisher queue.Publisher) *Service {
-	return &Service{repo, publisher}
+// NewService creates a new vehicle service. Anchor jobs are published within the
+// transaction that creates or updates the vehicle, so publisher is expected to be the outbox.
+func NewService(repo Repository, publisher queue.Publisher, transactor Transactor, cidGenerator CIDGenerator) *Service {
+	return &Service{repo, publisher, transactor, cidGenerator}
 }
 
 // GetAll retrieves paginated vehicles with optional owner filter
@@ -61,6 +143,16 @@ func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*Vehicle, error) {
 	return s.repo.GetByID(ctx, id)
 }
 
+// GetByChassisNumber retrieves a vehicle by its chassis number
+func (s *Service) GetByChassisNumber(ctx context.Context, chassisNumber string) (*Vehicle, error) {
+	return s.repo.GetByChassisNumber(ctx, chassisNumber)
+}
+
+// GetByLicensePlate retrieves a vehicle by its license plate
+func (s *Service) GetByLicensePlate(ctx context.Context, licensePlate string) (*Vehicle, error) {
+	return s.repo.GetByLicensePlate(ctx, licensePlate)
+}
+
 // GetByOwnerID retrieves all vehicles owned by a specific owner
 func (s *Service) GetByOwnerID(ctx context.Context, ownerID uuid.UUID, limit, offset int) ([]Vehicle, int, error) {
 	return s.repo.GetByOwnerID(ctx, ownerID, limit, offset)
@@ -90,34 +182,107 @@ func (s *Service) Create(ctx context.Context, params CreateVehicleParams) (*Vehi
 		BlockchainStatus:   StatusNone,
 	}
 
-	created, err := s.repo.Create(ctx, vehicle)
-	if err != nil {
-		return nil, err
-	}
+	var created *Vehicle
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.repo.Create(ctx, vehicle)
+		if err != nil {
+			return err
+		}
+
+		if created.OwnerID != nil {
+			if err := s.startOwnership(ctx, created.ID, *created.OwnerID, OwnerSourceRegistration, created.CreatedAt); err != nil {
+				return err
+			}
+		}
+
+		if !params.ShouldAnchor {
+			return nil
+		}
+
+		// The genesis CID is fixed now so later versions and events can link to it before it is anchored
+		cidData, err := s.cidGenerator.GenerateCID(newVehicleCIDRecord(created, nil))
+		if err != nil {
+			return fmt.Errorf("generate vehicle CID: %w", err)
+		}
+		created.CID = &cidData.CID
+		created.CIDSourceJSON = &cidData.SourceJSON
+		created.CIDSourceCBOR = &cidData.SourceCBOR
+		created.ChainHeadCID = &cidData.CID
 
-	if params.ShouldAnchor {
 		jobData, err := json.Marshal(VehicleGenesisJob{VehicleID: created.ID})
 		if err != nil {
-			return nil, fmt.Errorf("marshal anchor job: %w", err)
+			return fmt.Errorf("marshal anchor job: %w", err)
 		}
 		if err := s.publisher.Publish(ctx, SubjectVehicleGenesis, jobData); err != nil {
-			return nil, fmt.Errorf("enqueue anchor job: %w", err)
+			return fmt.Errorf("enqueue anchor job: %w", err)
 		}
 		created.BlockchainStatus = StatusPending
 		if err := s.repo.Update(ctx, created); err != nil {
-			return nil, fmt.Errorf("update blockchain status: %w", err)
+			return fmt.Errorf("update blockchain status: %w", err)
 		}
+		if err := s.repo.SetChainHead(ctx, created.ID, cidData.CID); err != nil {
+			return fmt.Errorf("set chain head: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return created, nil
 }
 
-// Update updates an existing vehicle
+// ListByBlockchainStatus retrieves vehicles whose anchoring has been in the given status for longer than olderThan
+func (s *Service) ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Vehicle, int, error) {
+	return s.repo.ListByBlockchainStatus(ctx, status, olderThan, limit, offset)
+}
+
+// RequeueAnchor publishes a new genesis job for a vehicle whose anchoring is pending or failed
+func (s *Service) RequeueAnchor(ctx context.Context, id uuid.UUID) (*Vehicle, error) {
+	vehicle, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if vehicle.BlockchainStatus != StatusPending && vehicle.BlockchainStatus != StatusFailed {
+		return nil, ErrAnchorNotRequeueable
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		jobData, err := json.Marshal(VehicleGenesisJob{VehicleID: vehicle.ID})
+		if err != nil {
+			return fmt.Errorf("marshal anchor job: %w", err)
+		}
+		if err := s.publisher.Publish(ctx, SubjectVehicleGenesis, jobData); err != nil {
+			return fmt.Errorf("enqueue anchor job: %w", err)
+		}
+
+		vehicle.BlockchainStatus = StatusPending
+		vehicle.BlockchainError = nil
+		vehicle.BlockchainStatusAt = time.Now()
+		if err := s.repo.Update(ctx, vehicle); err != nil {
+			return fmt.Errorf("update blockchain status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vehicle, nil
+}
+
+// Update updates an existing vehicle. Scrapped vehicles cannot be updated, as their asset no
+// longer exists to anchor new versions on.
 func (s *Service) Update(ctx context.Context, id uuid.UUID, params UpdateVehicleParams) (*Vehicle, error) {
 	vehicle, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if vehicle.LifecycleStatus == LifecycleScrapped {
+		return nil, ErrVehicleScrapped
+	}
+	before := *vehicle
 
 	if params.LicensePlate != nil {
 		vehicle.LicensePlate = params.LicensePlate
@@ -173,18 +338,43 @@ func (s *Service) Update(ctx context.Context, id uuid.UUID, params UpdateVehicle
 
 	vehicle.UpdatedAt = time.Now()
 
-	if err := s.repo.Update(ctx, vehicle); err != nil {
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, vehicle); err != nil {
+			return err
+		}
+		if ownerChanged(before.OwnerID, vehicle.OwnerID) {
+			if err := s.startOwnership(ctx, vehicle.ID, *vehicle.OwnerID, OwnerSourceReassignment, vehicle.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		return s.recordVersion(ctx, before, vehicle)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return vehicle, nil
 }
 
-// Delete deletes a vehicle
+// Delete deletes a vehicle. Vehicles with an asset on chain are kept, so the asset is never
+// orphaned; they are retired by moving them to another lifecycle status instead.
 func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	vehicle, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if vehicle.BlockchainAssetID != nil && *vehicle.BlockchainAssetID != "" {
+		return ErrVehicleHasAsset
+	}
 	return s.repo.Delete(ctx, id)
 }
 
+// SetLifecycleStatus moves the vehicle from one lifecycle status to another. It fails with
+// ErrLifecycleStatusChanged when the vehicle is no longer in the from status.
+func (s *Service) SetLifecycleStatus(ctx context.Context, id uuid.UUID, from, to string) error {
+	return s.repo.SetLifecycleStatus(ctx, id, from, to)
+}
+
 // FindOrCreateVehicle searches for a vehicle by chassis number (priority) or license plate
 // If not found, creates a new unclaimed vehicle with minimal information
 func (s *Service) FindOrCreateVehicle(ctx context.Context, chassisNumber, licensePlate *string) (*Vehicle, error) {
@@ -240,8 +430,181 @@ func (s *Service) AssignOwnership(ctx context.Context, vehicleID uuid.UUID, owne
 		return nil
 	}
 
+	before := *vehicle
 	vehicle.OwnerID = &ownerID
 	vehicle.UpdatedAt = time.Now()
 
-	return s.repo.Update(ctx, vehicle)
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, vehicle); err != nil {
+			return err
+		}
+		if ownerChanged(before.OwnerID, vehicle.OwnerID) {
+			if err := s.startOwnership(ctx, vehicle.ID, ownerID, OwnerSourceInvitation, vehicle.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		return s.recordVersion(ctx, before, vehicle)
+	})
+}
+
+// TransferOwnership hands a vehicle over to a new owner. The current ownership period ends and
+// the new owner's period starts at the transfer date, which defaults to now. Only active vehicles
+// can be transferred.
+func (s *Service) TransferOwnership(ctx context.Context, vehicleID uuid.UUID, params TransferOwnershipParams) (*Vehicle, error) {
+	vehicle, err := s.repo.GetByID(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	if !vehicle.IsActive() {
+		return nil, ErrVehicleNotActive
+	}
+
+	transferDate := params.TransferDate
+	if transferDate.IsZero() {
+		transferDate = time.Now()
+	}
+
+	before := *vehicle
+	vehicle.OwnerID = &params.NewOwnerID
+	vehicle.UpdatedAt = time.Now()
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, vehicle); err != nil {
+			return err
+		}
+		if err := s.recordVersion(ctx, before, vehicle); err != nil {
+			return err
+		}
+		return s.startOwnership(ctx, vehicle.ID, params.NewOwnerID, OwnerSourceTransfer, transferDate)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vehicle, nil
+}
+
+// ListOwners retrieves the ownership periods of a vehicle, oldest first
+func (s *Service) ListOwners(ctx context.Context, vehicleID uuid.UUID) ([]Owner, error) {
+	return s.repo.ListOwners(ctx, vehicleID)
+}
+
+// GetProvenance retrieves the pseudonymised chain of custody of a vehicle, oldest first
+func (s *Service) GetProvenance(ctx context.Context, vehicleID uuid.UUID) ([]ProvenancePeriod, error) {
+	owners, err := s.repo.ListOwners(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	return Provenance(owners), nil
+}
+
+// startOwnership ends the open ownership period of the vehicle and opens one for the new owner
+func (s *Service) startOwnership(ctx context.Context, vehicleID, ownerID uuid.UUID, source string, startDate time.Time) error {
+	if err := s.repo.EndOwnership(ctx, vehicleID, startDate); err != nil {
+		return fmt.Errorf("end ownership: %w", err)
+	}
+	owner := Owner{VehicleID: vehicleID, OwnerID: &ownerID, Source: source, StartDate: startDate}
+	if _, err := s.repo.CreateOwner(ctx, owner); err != nil {
+		return fmt.Errorf("start ownership: %w", err)
+	}
+	return nil
+}
+
+// ownerChanged reports whether the vehicle was handed to a different owner
+func ownerChanged(before, after *uuid.UUID) bool {
+	return after != nil && (before == nil || *before != *after)
+}
+
+// ListVersions retrieves the revisions of a vehicle record made after genesis, oldest first
+func (s *Service) ListVersions(ctx context.Context, vehicleID uuid.UUID) ([]Version, error) {
+	return s.repo.ListVersions(ctx, vehicleID)
+}
+
+// ListVersionsByBlockchainStatus retrieves versions whose anchoring has been in the given status for longer than olderThan
+func (s *Service) ListVersionsByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Version, int, error) {
+	return s.repo.ListVersionsByBlockchainStatus(ctx, status, olderThan, limit, offset)
+}
+
+// RequeueVersionAnchor publishes a new vehicle update job for a version whose anchoring is pending or failed
+func (s *Service) RequeueVersionAnchor(ctx context.Context, id uuid.UUID) (*Version, error) {
+	version, err := s.repo.GetVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version.BlockchainStatus != StatusPending && version.BlockchainStatus != StatusFailed {
+		return nil, ErrAnchorNotRequeueable
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.publishVersion(ctx, version); err != nil {
+			return err
+		}
+
+		version.BlockchainStatus = StatusPending
+		version.BlockchainError = nil
+		version.BlockchainStatusAt = time.Now()
+		if err := s.repo.UpdateVersion(ctx, version); err != nil {
+			return fmt.Errorf("update blockchain status: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// recordVersion creates and enqueues a new version of the vehicle record when a field covered by
+// the CID changed. The version links to the head of the vehicle's chain and becomes the new head.
+// Vehicles without a genesis CID are skipped, as their genesis captures the current state.
+func (s *Service) recordVersion(ctx context.Context, before Vehicle, after *Vehicle) error {
+	if after.CID == nil {
+		return nil
+	}
+	if reflect.DeepEqual(newVehicleCIDRecord(&before, nil), newVehicleCIDRecord(after, nil)) {
+		return nil
+	}
+
+	previousCID := *after.CID
+	head, err := s.repo.LockChainHead(ctx, after.ID)
+	if err != nil {
+		return fmt.Errorf("lock chain head: %w", err)
+	}
+	if head != nil {
+		previousCID = *head
+	}
+
+	cidData, err := s.cidGenerator.GenerateCID(newVehicleCIDRecord(after, &previousCID))
+	if err != nil {
+		return fmt.Errorf("generate vehicle CID: %w", err)
+	}
+
+	version, err := s.repo.CreateVersion(ctx, Version{
+		VehicleID:        after.ID,
+		CID:              cidData.CID,
+		PreviousCID:      previousCID,
+		CIDSourceJSON:    cidData.SourceJSON,
+		CIDSourceCBOR:    cidData.SourceCBOR,
+		BlockchainStatus: StatusPending,
+	})
+	if err != nil {
+		return fmt.Errorf("create vehicle version: %w", err)
+	}
+	if err := s.repo.SetChainHead(ctx, after.ID, cidData.CID); err != nil {
+		return fmt.Errorf("set chain head: %w", err)
+	}
+
+	return s.publishVersion(ctx, version)
+}
+
+func (s *Service) publishVersion(ctx context.Context, version *Version) error {
+	jobData, err := json.Marshal(VehicleUpdateJob{VehicleID: version.VehicleID, VersionID: version.ID})
+	if err != nil {
+		return fmt.Errorf("marshal anchor job: %w", err)
+	}
+	if err := s.publisher.Publish(ctx, SubjectVehicleUpdate, jobData); err != nil {
+		return fmt.Errorf("enqueue anchor job: %w", err)
+	}
+	return nil
 }
@@ -3,8 +3,11 @@ package vehicles
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +21,10 @@ type mockRepo struct {
 	getByLicensePlateFunc func(ctx context.Context, licensePlate string) (*Vehicle, error)
 	createFunc            func(ctx context.Context, vehicle *Vehicle) (*Vehicle, error)
 	updateFunc            func(ctx context.Context, vehicle *Vehicle) error
+	versions              []Version
+	chainHead             *string
+	owners                []Owner
+	deleted               []uuid.UUID
 }
 
 func (m *mockRepo) GetAll(ctx context.Context, limit, offset int, ownerID *uuid.UUID) ([]Vehicle, int, error) {
@@ -61,8 +68,72 @@ func (m *mockRepo) Update(ctx context.Context, vehicle *Vehicle) error {
 	return nil
 }
 func (m *mockRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	m.deleted = append(m.deleted, id)
 	return nil
 }
+func (m *mockRepo) SetLifecycleStatus(ctx context.Context, id uuid.UUID, from, to string) error {
+	return nil
+}
+func (m *mockRepo) ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Vehicle, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockRepo) CreateVersion(ctx context.Context, version Version) (*Version, error) {
+	version.ID = uuid.New()
+	version.Version = len(m.versions) + 2
+	m.versions = append(m.versions, version)
+	return &version, nil
+}
+func (m *mockRepo) GetVersion(ctx context.Context, id uuid.UUID) (*Version, error) {
+	for _, v := range m.versions {
+		if v.ID == id {
+			return &v, nil
+		}
+	}
+	return nil, ErrVersionNotFound
+}
+func (m *mockRepo) ListVersions(ctx context.Context, vehicleID uuid.UUID) ([]Version, error) {
+	return m.versions, nil
+}
+func (m *mockRepo) UpdateVersion(ctx context.Context, version *Version) error {
+	return nil
+}
+func (m *mockRepo) LockChainHead(ctx context.Context, vehicleID uuid.UUID) (*string, error) {
+	return m.chainHead, nil
+}
+func (m *mockRepo) SetChainHead(ctx context.Context, vehicleID uuid.UUID, cid string) error {
+	m.chainHead = &cid
+	return nil
+}
+func (m *mockRepo) ListVersionsByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]Version, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockRepo) CreateOwner(ctx context.Context, owner Owner) (*Owner, error) {
+	owner.ID = uuid.New()
+	m.owners = append(m.owners, owner)
+	return &owner, nil
+}
+func (m *mockRepo) EndOwnership(ctx context.Context, vehicleID uuid.UUID, endDate time.Time) error {
+	for i := range m.owners {
+		if m.owners[i].VehicleID == vehicleID && m.owners[i].EndDate == nil {
+			m.owners[i].EndDate = &endDate
+		}
+	}
+	return nil
+}
+func (m *mockRepo) ListOwners(ctx context.Context, vehicleID uuid.UUID) ([]Owner, error) {
+	return m.owners, nil
+}
+
+type mockCIDGen struct {
+	records []interface{}
+}
+
+func (m *mockCIDGen) GenerateCID(data interface{}) (*cidpkg.CID, error) {
+	m.records = append(m.records, data)
+	return &cidpkg.CID{CID: fmt.Sprintf("mock-cid-%d", len(m.records)), SourceJSON: "{}", SourceCBOR: "AA=="}, nil
+}
 
 type mockPublisher struct {
 	publishFunc func(ctx context.Context, subject string, data []byte) error
@@ -78,6 +149,15 @@ func (m *mockPublisher) Publish(ctx context.Context, subject string, data []byte
 }
 func (m *mockPublisher) Close() error { return nil }
 
+type mockTransactor struct {
+	calls int
+}
+
+func (m *mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	return fn(ctx)
+}
+
 // --- Tests ---
 
 func ptr[T any](v T) *T { return &v }
@@ -88,7 +168,7 @@ func TestService_FindOrCreateVehicle_ChassisPriority(t *testing.T) {
 		getByChassisNumberFunc: func(_ context.Context, _ string) (*Vehicle, error) {
 			return existing, nil
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	chassis := "WBA12345"
 	plate := "AA-00-BB"
@@ -107,7 +187,7 @@ func TestService_FindOrCreateVehicle_PlateFallback(t *testing.T) {
 		getByLicensePlateFunc: func(_ context.Context, _ string) (*Vehicle, error) {
 			return existing, nil
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	chassis := "NONEXIST"
 	plate := "AA-00-BB"
@@ -118,7 +198,7 @@ func TestService_FindOrCreateVehicle_PlateFallback(t *testing.T) {
 }
 
 func TestService_FindOrCreateVehicle_CreatesNew(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	chassis := "NEW123"
 	plate := "NEW-PLATE"
@@ -132,7 +212,7 @@ func TestService_FindOrCreateVehicle_CreatesNew(t *testing.T) {
 }
 
 func TestService_FindOrCreateVehicle_NilInputsCreatesNew(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, err := svc.FindOrCreateVehicle(context.Background(), nil, nil)
 
@@ -141,7 +221,7 @@ func TestService_FindOrCreateVehicle_NilInputsCreatesNew(t *testing.T) {
 }
 
 func TestService_Create_WithoutAnchoring(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	params := CreateVehicleParams{
 		Make:  "BMW",
@@ -158,7 +238,8 @@ func TestService_Create_WithoutAnchoring(t *testing.T) {
 
 func TestService_Create_WithAnchoring(t *testing.T) {
 	pub := &mockPublisher{}
-	svc := NewService(&mockRepo{}, pub)
+	tx := &mockTransactor{}
+	svc := NewService(&mockRepo{}, pub, tx, &mockCIDGen{})
 
 	params := CreateVehicleParams{
 		Make:         "Alfa Romeo",
@@ -171,6 +252,18 @@ func TestService_Create_WithAnchoring(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, pub.published, 1)
 	assert.Equal(t, StatusPending, result.BlockchainStatus)
+	assert.Equal(t, 1, tx.calls, "insert and anchor job must share a transaction")
+}
+
+func TestService_Create_FixesGenesisCIDAsChainHead(t *testing.T) {
+	repo := &mockRepo{}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	result, err := svc.Create(context.Background(), CreateVehicleParams{ShouldAnchor: true, Make: "Lancia", Model: "Fulvia", Year: 1968})
+
+	require.NoError(t, err)
+	require.NotNil(t, result.CID)
+	assert.Equal(t, *result.CID, *repo.chainHead)
 }
 
 func TestService_Create_AnchoringPublishError(t *testing.T) {
@@ -179,7 +272,7 @@ func TestService_Create_AnchoringPublishError(t *testing.T) {
 			return errors.New("nats error")
 		},
 	}
-	svc := NewService(&mockRepo{}, pub)
+	svc := NewService(&mockRepo{}, pub, &mockTransactor{}, &mockCIDGen{})
 
 	params := CreateVehicleParams{ShouldAnchor: true, Make: "Fiat", Model: "500", Year: 1965}
 	_, err := svc.Create(context.Background(), params)
@@ -202,7 +295,7 @@ func TestService_Update_PartialFields(t *testing.T) {
 			copy := *original
 			return &copy, nil
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, err := svc.Update(context.Background(), original.ID, UpdateVehicleParams{
 		Color: ptr("Blue"),
@@ -216,7 +309,7 @@ func TestService_Update_PartialFields(t *testing.T) {
 }
 
 func TestService_Update_NotFound(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Update(context.Background(), uuid.New(), UpdateVehicleParams{})
 
@@ -235,7 +328,7 @@ func TestService_AssignOwnership_Success(t *testing.T) {
 			updatedOwner = v.OwnerID
 			return nil
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	ownerID := uuid.New()
 	err := svc.AssignOwnership(context.Background(), vehicle.ID, ownerID)
@@ -249,7 +342,7 @@ func TestService_AssignOwnership_NilVehicle(t *testing.T) {
 		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
 			return nil, nil
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	err := svc.AssignOwnership(context.Background(), uuid.New(), uuid.New())
 	assert.NoError(t, err)
@@ -260,14 +353,120 @@ func TestService_AssignOwnership_GetError(t *testing.T) {
 		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
 			return nil, errors.New("db error")
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	err := svc.AssignOwnership(context.Background(), uuid.New(), uuid.New())
 	assert.Error(t, err)
 }
 
+func TestService_TransferOwnership_RecordsOwnershipPeriods(t *testing.T) {
+	previousOwner := uuid.New()
+	vehicle := &Vehicle{ID: uuid.New(), OwnerID: &previousOwner, CID: ptr("bafygenesis")}
+	start := time.Date(2011, 3, 1, 0, 0, 0, 0, time.UTC)
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *vehicle
+			return &copy, nil
+		},
+		owners: []Owner{{VehicleID: vehicle.ID, OwnerID: &previousOwner, StartDate: start}},
+	}
+	pub := &mockPublisher{}
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+
+	newOwner := uuid.New()
+	transferDate := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	result, err := svc.TransferOwnership(context.Background(), vehicle.ID, TransferOwnershipParams{
+		NewOwnerID:   newOwner,
+		TransferDate: transferDate,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &newOwner, result.OwnerID)
+	require.Len(t, repo.owners, 2)
+	assert.Equal(t, &transferDate, repo.owners[0].EndDate)
+	assert.Equal(t, &newOwner, repo.owners[1].OwnerID)
+	assert.Equal(t, OwnerSourceTransfer, repo.owners[1].Source)
+	assert.Equal(t, transferDate, repo.owners[1].StartDate)
+	assert.Nil(t, repo.owners[1].EndDate)
+	// The owner is part of the anchored vehicle record, so the transfer creates a new version
+	assert.Len(t, repo.versions, 1)
+	assert.Len(t, pub.published, 1)
+}
+
+func TestService_Create_WithOwnerStartsOwnership(t *testing.T) {
+	repo := &mockRepo{}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	ownerID := uuid.New()
+	result, err := svc.Create(context.Background(), CreateVehicleParams{Make: "BMW", Model: "2002", OwnerID: &ownerID})
+
+	require.NoError(t, err)
+	require.Len(t, repo.owners, 1)
+	assert.Equal(t, result.ID, repo.owners[0].VehicleID)
+	assert.Equal(t, &ownerID, repo.owners[0].OwnerID)
+	assert.Equal(t, OwnerSourceRegistration, repo.owners[0].Source)
+}
+
+func TestService_Update_OwnerReassignmentStartsOwnership(t *testing.T) {
+	previousOwner := uuid.New()
+	vehicle := &Vehicle{ID: uuid.New(), OwnerID: &previousOwner}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *vehicle
+			return &copy, nil
+		},
+		owners: []Owner{{VehicleID: vehicle.ID, OwnerID: &previousOwner, Source: OwnerSourceRegistration}},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	newOwner := uuid.New()
+	_, err := svc.Update(context.Background(), vehicle.ID, UpdateVehicleParams{OwnerID: &newOwner})
+
+	require.NoError(t, err)
+	require.Len(t, repo.owners, 2)
+	assert.NotNil(t, repo.owners[0].EndDate)
+	assert.Equal(t, &newOwner, repo.owners[1].OwnerID)
+	assert.Equal(t, OwnerSourceReassignment, repo.owners[1].Source)
+}
+
+func TestService_Update_SameOwnerKeepsOwnership(t *testing.T) {
+	ownerID := uuid.New()
+	vehicle := &Vehicle{ID: uuid.New(), OwnerID: &ownerID}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *vehicle
+			return &copy, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	sameOwner := ownerID
+	_, err := svc.Update(context.Background(), vehicle.ID, UpdateVehicleParams{OwnerID: &sameOwner})
+
+	require.NoError(t, err)
+	assert.Empty(t, repo.owners)
+}
+
+func TestService_AssignOwnership_StartsOwnership(t *testing.T) {
+	vehicle := &Vehicle{ID: uuid.New()}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			return vehicle, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	ownerID := uuid.New()
+	err := svc.AssignOwnership(context.Background(), vehicle.ID, ownerID)
+
+	require.NoError(t, err)
+	require.Len(t, repo.owners, 1)
+	assert.Equal(t, &ownerID, repo.owners[0].OwnerID)
+	assert.Equal(t, OwnerSourceInvitation, repo.owners[0].Source)
+}
+
 func TestService_GetAll(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, total, err := svc.GetAll(context.Background(), 10, 0, nil)
 	require.NoError(t, err)
@@ -276,7 +475,7 @@ func TestService_GetAll(t *testing.T) {
 }
 
 func TestService_GetAllWithStats(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, total, err := svc.GetAllWithStats(context.Background(), 10, 0, nil)
 	require.NoError(t, err)
@@ -292,7 +491,7 @@ func TestService_GetByID(t *testing.T) {
 		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
 			return expected, nil
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, err := svc.GetByID(context.Background(), vehicleID)
 	require.NoError(t, err)
@@ -300,7 +499,7 @@ func TestService_GetByID(t *testing.T) {
 }
 
 func TestService_GetByOwnerID(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, total, err := svc.GetByOwnerID(context.Background(), uuid.New(), 10, 0)
 	require.NoError(t, err)
@@ -309,10 +508,57 @@ func TestService_GetByOwnerID(t *testing.T) {
 }
 
 func TestService_Delete(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Vehicle, error) {
+			return &Vehicle{ID: id}, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
-	err := svc.Delete(context.Background(), uuid.New())
+	id := uuid.New()
+	err := svc.Delete(context.Background(), id)
 	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{id}, repo.deleted)
+}
+
+func TestService_Delete_KeepsVehicleWithAsset(t *testing.T) {
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Vehicle, error) {
+			return &Vehicle{ID: id, BlockchainAssetID: ptr("1001")}, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	err := svc.Delete(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, ErrVehicleHasAsset)
+	assert.Empty(t, repo.deleted)
+}
+
+func TestService_TransferOwnership_RequiresActiveVehicle(t *testing.T) {
+	owner := uuid.New()
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Vehicle, error) {
+			return &Vehicle{ID: id, OwnerID: &owner, LifecycleStatus: LifecycleStolen}, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.TransferOwnership(context.Background(), uuid.New(), TransferOwnershipParams{NewOwnerID: uuid.New()})
+	assert.ErrorIs(t, err, ErrVehicleNotActive)
+	assert.Empty(t, repo.owners)
+}
+
+func TestService_Update_RejectsScrappedVehicle(t *testing.T) {
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Vehicle, error) {
+			return &Vehicle{ID: id, Make: "Porsche", LifecycleStatus: LifecycleScrapped}, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Update(context.Background(), uuid.New(), UpdateVehicleParams{Make: ptr("Ferrari")})
+	assert.ErrorIs(t, err, ErrVehicleScrapped)
+	assert.Empty(t, repo.versions)
 }
 
 func TestService_Update_AllFields(t *testing.T) {
@@ -333,7 +579,7 @@ func TestService_Update_AllFields(t *testing.T) {
 			updated = v
 			return nil
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	result, err := svc.Update(context.Background(), original.ID, UpdateVehicleParams{
 		LicensePlate:       ptr("AA-00-BB"),
@@ -383,7 +629,7 @@ func TestService_Update_RepoError(t *testing.T) {
 		updateFunc: func(_ context.Context, _ *Vehicle) error {
 			return errors.New("db error")
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Update(context.Background(), uuid.New(), UpdateVehicleParams{Make: ptr("X")})
 	assert.Error(t, err)
@@ -394,7 +640,7 @@ func TestService_Create_RepoError(t *testing.T) {
 		createFunc: func(_ context.Context, _ *Vehicle) (*Vehicle, error) {
 			return nil, errors.New("db error")
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.Create(context.Background(), CreateVehicleParams{Make: "X", Model: "Y"})
 	assert.Error(t, err)
@@ -405,7 +651,7 @@ func TestService_FindOrCreateVehicle_ChassisRepoError(t *testing.T) {
 		getByChassisNumberFunc: func(_ context.Context, _ string) (*Vehicle, error) {
 			return nil, errors.New("db error")
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	chassis := "WBA123"
 	_, err := svc.FindOrCreateVehicle(context.Background(), &chassis, nil)
@@ -417,7 +663,7 @@ func TestService_FindOrCreateVehicle_PlateRepoError(t *testing.T) {
 		getByLicensePlateFunc: func(_ context.Context, _ string) (*Vehicle, error) {
 			return nil, errors.New("db error")
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	plate := "AA-00-BB"
 	_, err := svc.FindOrCreateVehicle(context.Background(), nil, &plate)
@@ -429,17 +675,139 @@ func TestService_FindOrCreateVehicle_CreateError(t *testing.T) {
 		createFunc: func(_ context.Context, _ *Vehicle) (*Vehicle, error) {
 			return nil, errors.New("create error")
 		},
-	}, &mockPublisher{})
+	}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	_, err := svc.FindOrCreateVehicle(context.Background(), nil, nil)
 	assert.Error(t, err)
 }
 
 func TestService_FindOrCreateVehicle_EmptyStrings(t *testing.T) {
-	svc := NewService(&mockRepo{}, &mockPublisher{})
+	svc := NewService(&mockRepo{}, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
 
 	empty := ""
 	result, err := svc.FindOrCreateVehicle(context.Background(), &empty, &empty)
 	require.NoError(t, err)
 	assert.Equal(t, "Unknown", result.Make)
 }
+
+func TestService_RequeueAnchor_Pending(t *testing.T) {
+	stuckSince := time.Now().Add(-time.Hour)
+	pub := &mockPublisher{}
+	svc := NewService(&mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Vehicle, error) {
+			return &Vehicle{ID: id, BlockchainStatus: StatusPending, BlockchainStatusAt: stuckSince}, nil
+		},
+	}, pub, &mockTransactor{}, &mockCIDGen{})
+
+	result, err := svc.RequeueAnchor(context.Background(), uuid.New())
+
+	require.NoError(t, err)
+	assert.Len(t, pub.published, 1)
+	assert.Equal(t, StatusPending, result.BlockchainStatus)
+	assert.True(t, result.BlockchainStatusAt.After(stuckSince))
+}
+
+func TestService_RequeueAnchor_NotRequeueable(t *testing.T) {
+	pub := &mockPublisher{}
+	svc := NewService(&mockRepo{
+		getByIDFunc: func(_ context.Context, id uuid.UUID) (*Vehicle, error) {
+			return &Vehicle{ID: id, BlockchainStatus: StatusAnchored}, nil
+		},
+	}, pub, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.RequeueAnchor(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, ErrAnchorNotRequeueable)
+	assert.Empty(t, pub.published)
+}
+
+func TestService_Update_MaterialChangeCreatesVersion(t *testing.T) {
+	original := &Vehicle{ID: uuid.New(), Make: "Porsche", Model: "911", Year: 1970, CID: ptr("genesis-cid")}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *original
+			return &copy, nil
+		},
+	}
+	pub := &mockPublisher{}
+	tx := &mockTransactor{}
+	svc := NewService(repo, pub, tx, &mockCIDGen{})
+
+	_, err := svc.Update(context.Background(), original.ID, UpdateVehicleParams{EngineNumber: ptr("E-9000")})
+
+	require.NoError(t, err)
+	require.Len(t, repo.versions, 1)
+	assert.Equal(t, "genesis-cid", repo.versions[0].PreviousCID)
+	assert.Equal(t, StatusPending, repo.versions[0].BlockchainStatus)
+	assert.Len(t, pub.published, 1)
+	assert.Equal(t, 1, tx.calls, "update and anchor job must share a transaction")
+}
+
+func TestService_Update_VersionLinksToChainHead(t *testing.T) {
+	original := &Vehicle{ID: uuid.New(), Make: "Porsche", Model: "911", Year: 1970, CID: ptr("genesis-cid")}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *original
+			return &copy, nil
+		},
+		chainHead: ptr("event-cid"),
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Update(context.Background(), original.ID, UpdateVehicleParams{Color: ptr("Green")})
+
+	require.NoError(t, err)
+	require.Len(t, repo.versions, 1)
+	assert.Equal(t, "event-cid", repo.versions[0].PreviousCID)
+	assert.Equal(t, repo.versions[0].CID, *repo.chainHead)
+}
+
+func TestService_Update_NonMaterialChangeSkipsVersion(t *testing.T) {
+	original := &Vehicle{ID: uuid.New(), Make: "Porsche", Model: "911", Year: 1970, CID: ptr("genesis-cid")}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *original
+			return &copy, nil
+		},
+	}
+	pub := &mockPublisher{}
+	svc := NewService(repo, pub, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Update(context.Background(), original.ID, UpdateVehicleParams{EnginePowerHp: ptr(210), Make: ptr("Porsche")})
+
+	require.NoError(t, err)
+	assert.Empty(t, repo.versions)
+	assert.Empty(t, pub.published)
+}
+
+func TestService_Update_BeforeGenesisSkipsVersion(t *testing.T) {
+	original := &Vehicle{ID: uuid.New(), Make: "Porsche", Model: "911", Year: 1970}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *original
+			return &copy, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	_, err := svc.Update(context.Background(), original.ID, UpdateVehicleParams{ChassisNumber: ptr("WP0ZZZ91")})
+
+	require.NoError(t, err)
+	assert.Empty(t, repo.versions)
+}
+
+func TestService_AssignOwnership_CreatesVersion(t *testing.T) {
+	original := &Vehicle{ID: uuid.New(), Make: "Porsche", Model: "911", Year: 1970, CID: ptr("genesis-cid")}
+	repo := &mockRepo{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*Vehicle, error) {
+			copy := *original
+			return &copy, nil
+		},
+	}
+	svc := NewService(repo, &mockPublisher{}, &mockTransactor{}, &mockCIDGen{})
+
+	err := svc.AssignOwnership(context.Background(), original.ID, uuid.New())
+
+	require.NoError(t, err)
+	assert.Len(t, repo.versions, 1)
+}
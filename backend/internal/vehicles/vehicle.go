@@ -12,6 +12,24 @@ var (
 	ErrDuplicateChassisNo = errors.New("vehicle with this chassis no already exists")
 	ErrVehicleNotFound    = errors.New("vehicle not found")
 	ErrInvalidVehicleData = errors.New("invalid vehicle data")
+
+	ErrAnchorNotRequeueable = errors.New("vehicle anchoring is not pending or failed")
+	ErrVersionNotFound      = errors.New("vehicle version not found")
+
+	ErrVehicleNotActive       = errors.New("vehicle is not active")
+	ErrVehicleScrapped        = errors.New("vehicle has been scrapped")
+	ErrVehicleHasAsset        = errors.New("vehicle has an on-chain asset, retire it through its lifecycle instead")
+	ErrLifecycleStatusChanged = errors.New("vehicle lifecycle status changed")
+)
+
+// Lifecycle statuses of a vehicle. Only active vehicles can change owner or be delivered to a
+// wallet; scrapped is final, as the vehicle's asset is destroyed.
+const (
+	LifecycleActive   = "active"
+	LifecycleStolen   = "stolen"
+	LifecycleScrapped = "scrapped"
+	LifecycleExported = "exported"
+	LifecycleArchived = "archived"
 )
 
 // Vehicle represents a classic vehicle in the system
@@ -36,17 +54,59 @@ type Vehicle struct {
 	EnginePowerHp      *int       `json:"enginePowerHp,omitempty"`
 	BlockchainAssetID  *string    `json:"blockchainAssetId,omitempty"`
 	BlockchainStatus   string     `json:"blockchainStatus"`
+	BlockchainError    *string    `json:"blockchainError,omitempty"`
+	BlockchainStatusAt time.Time  `json:"blockchainStatusAt"`
+	GenesisTxID        *string    `json:"genesisTxId,omitempty"`
+	GenesisLastValid   *uint64    `json:"-"`
 	CID                *string    `json:"cid,omitempty"`
 	CIDSourceJSON      *string    `json:"cidSourceJson,omitempty"`
 	CIDSourceCBOR      *string    `json:"cidSourceCbor,omitempty"`
+	// ChainHeadCID is the CID of the latest record in the vehicle's chain of versions and events
+	ChainHeadCID       *string    `json:"chainHeadCid,omitempty"`
+	LifecycleStatus    string     `json:"lifecycleStatus"`
+	LifecycleStatusAt  time.Time  `json:"lifecycleStatusAt"`
 	CreatedAt          time.Time  `json:"createdAt"`
 	UpdatedAt          time.Time  `json:"updatedAt"`
 }
 
-// Owner represents a previous or current owner of a vehicle
+// IsActive reports whether the vehicle is in use and can change hands. A vehicle that has not
+// been stored yet has no lifecycle status and counts as active.
+func (v Vehicle) IsActive() bool {
+	return v.LifecycleStatus == "" || v.LifecycleStatus == LifecycleActive
+}
+
+// Version is a revision of the vehicle record made after genesis. It is anchored on the
+// vehicle's asset with a vehicle_update note and links to the previous record in the vehicle's chain.
+type Version struct {
+	ID                 uuid.UUID `json:"id"`
+	VehicleID          uuid.UUID `json:"vehicleId"`
+	Version            int       `json:"version"`
+	CID                string    `json:"cid"`
+	PreviousCID        string    `json:"previousCid"`
+	CIDSourceJSON      string    `json:"cidSourceJson"`
+	CIDSourceCBOR      string    `json:"cidSourceCbor"`
+	BlockchainTxID     *string   `json:"blockchainTxId,omitempty"`
+	BlockchainStatus   string    `json:"blockchainStatus"`
+	BlockchainError    *string   `json:"blockchainError,omitempty"`
+	BlockchainStatusAt time.Time `json:"blockchainStatusAt"`
+	CreatedAt          time.Time `json:"createdAt"`
+}
+
+// How an ownership period started
+const (
+	OwnerSourceRegistration = "registration"
+	OwnerSourceInvitation   = "invitation"
+	OwnerSourceTransfer     = "transfer"
+	OwnerSourceReassignment = "reassignment"
+)
+
+// Owner represents an ownership period of a vehicle. The current owner's period has no end date.
+// OwnerID is nil when the owner's account was deleted after the period was recorded.
 type Owner struct {
-	OwnerID   uuid.UUID  `json:"ownerId"`
-	OwnerName string     `json:"ownerName"`
+	ID        uuid.UUID  `json:"id"`
+	VehicleID uuid.UUID  `json:"vehicleId"`
+	OwnerID   *uuid.UUID `json:"ownerId,omitempty"`
+	Source    string     `json:"source"`
 	StartDate time.Time  `json:"startDate"`
 	EndDate   *time.Time `json:"endDate,omitempty"`
 }
@@ -0,0 +1,258 @@
+package verification
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+	"github.com/google/uuid"
+)
+
+// Bundle is a self-contained export of a vehicle passport that can be verified
+// without access to the platform database or API
+type Bundle struct {
+	Vehicle BundleVehicle `json:"vehicle"`
+	Events  []BundleEvent `json:"events"`
+}
+
+// BundleRecord is an anchored record together with the DAG-CBOR block it was hashed from
+type BundleRecord struct {
+	ID     uuid.UUID       `json:"id"`
+	CID    string          `json:"cid"`
+	Record json.RawMessage `json:"record"`
+	CBOR   string          `json:"cbor"`
+	TxID   string          `json:"txId,omitempty"`
+}
+
+// BundleVehicle is the vehicle record of a bundle and the Algorand asset it was minted as
+type BundleVehicle struct {
+	BundleRecord
+	AssetID string `json:"assetId"`
+}
+
+// BundleEvent is a certified event record of a bundle and its images. Events anchored under a
+// Merkle root carry their inclusion proof; the transaction dump must then include the root
+// transaction, which is a payment from the platform account rather than an asset transfer.
+type BundleEvent struct {
+	BundleRecord
+	Images      []BundleImage    `json:"images,omitempty"`
+	MerkleProof *merkle.Proof    `json:"merkleProof,omitempty"`
+	Signature   *BundleSignature `json:"signature,omitempty"`
+}
+
+// BundleSignature is the issuing entity's signature over an event CID, with the public key it
+// was made with. The key can be matched to the entity through the platform's public key registry.
+type BundleSignature struct {
+	KeyID     uuid.UUID  `json:"keyId"`
+	EntityID  *uuid.UUID `json:"entityId,omitempty"`
+	PublicKey string     `json:"publicKey"`
+	Signature string     `json:"signature"`
+}
+
+// BundleImage references an event image by CID and, optionally, by a file inside the bundle
+type BundleImage struct {
+	CID  string `json:"cid"`
+	File string `json:"file,omitempty"`
+}
+
+// VerifyBundle verifies every record of a bundle against a dump of on-chain transactions.
+// Image files are read from files; images without a file are only checked against the
+// event record. When platformAddress is set, anchors sent from any other address are
+// reported as mismatches.
+func VerifyBundle(bundle *Bundle, files fs.FS, txns []algorand.Transaction, platformAddress string) *VehicleReport {
+	byID := make(map[string]*algorand.Transaction, len(txns))
+	for i := range txns {
+		byID[txns[i].ID] = &txns[i]
+	}
+
+	report := &VehicleReport{
+		Vehicle:  *verifyBundleVehicle(&bundle.Vehicle, txns, byID, platformAddress),
+		Versions: []Result{},
+		Events:   make([]Result, 0, len(bundle.Events)),
+	}
+
+	for i := range bundle.Events {
+		report.Events = append(report.Events, *verifyBundleEvent(&bundle.Events[i], bundle.Vehicle.AssetID, files, byID, platformAddress))
+	}
+
+	return report
+}
+
+func verifyBundleVehicle(vehicle *BundleVehicle, txns []algorand.Transaction, byID map[string]*algorand.Transaction, platformAddress string) *Result {
+	result := newBundleResult(RecordTypeVehicle, &vehicle.BundleRecord)
+
+	if vehicle.CID == "" || vehicle.CBOR == "" || vehicle.AssetID == "" {
+		result.Verdict = VerdictNotAnchored
+		return result
+	}
+
+	assetID, err := strconv.ParseUint(vehicle.AssetID, 10, 64)
+	if err != nil {
+		return mismatch(result, "asset id is not a valid Algorand asset id")
+	}
+	result.AssetID = &assetID
+
+	if reason := checkBundleRecord(&vehicle.BundleRecord); reason != "" {
+		return mismatch(result, reason)
+	}
+
+	var txn *algorand.Transaction
+	if vehicle.TxID != "" {
+		txn = byID[vehicle.TxID]
+	} else {
+		txn = findAssetCreation(txns, assetID)
+	}
+	if txn == nil {
+		result.Verdict = VerdictMissingOnChain
+		return result
+	}
+	if txn.AssetID != assetID {
+		return mismatch(result, "transaction does not reference the vehicle asset")
+	}
+
+	return compareAnchor(result, vehicle.CID, vehicle.CBOR, anchorer.NoteTypeGenesis, txn, platformAddress)
+}
+
+func verifyBundleEvent(evt *BundleEvent, vehicleAssetID string, files fs.FS, byID map[string]*algorand.Transaction, platformAddress string) *Result {
+	result := newBundleResult(RecordTypeEvent, &evt.BundleRecord)
+	if evt.Signature != nil && evt.CID != "" {
+		result.Signature = checkSignature(&SignatureResult{
+			KeyID:     evt.Signature.KeyID,
+			EntityID:  evt.Signature.EntityID,
+			PublicKey: &evt.Signature.PublicKey,
+			Signature: evt.Signature.Signature,
+		}, evt.CID)
+	}
+
+	if evt.CID == "" || evt.CBOR == "" || evt.TxID == "" {
+		result.Verdict = VerdictNotAnchored
+		return result
+	}
+
+	if reason := checkBundleRecord(&evt.BundleRecord); reason != "" {
+		return mismatch(result, reason)
+	}
+	if reason := checkBundleImages(evt, files); reason != "" {
+		return mismatch(result, reason)
+	}
+
+	txn, ok := byID[evt.TxID]
+	if !ok {
+		result.Verdict = VerdictMissingOnChain
+		return result
+	}
+
+	if evt.MerkleProof != nil {
+		return compareMerkleAnchor(result, evt.CID, evt.CBOR, evt.MerkleProof, txn, platformAddress)
+	}
+
+	result.AssetID = &txn.AssetID
+
+	if vehicleAssetID != strconv.FormatUint(txn.AssetID, 10) {
+		return mismatch(result, "transaction does not reference the vehicle asset")
+	}
+
+	var record struct {
+		Kind event.Kind `json:"kind"`
+	}
+	if err := json.Unmarshal(evt.Record, &record); err != nil {
+		return mismatch(result, "record is not valid JSON")
+	}
+
+	return compareAnchor(result, evt.CID, evt.CBOR, eventNoteType(record.Kind), txn, platformAddress)
+}
+
+func newBundleResult(recordType RecordType, record *BundleRecord) *Result {
+	result := &Result{
+		RecordType: recordType,
+		RecordID:   record.ID,
+	}
+	if record.CID != "" {
+		result.StoredCID = &record.CID
+	}
+	if record.TxID != "" {
+		result.TxID = &record.TxID
+	}
+	return result
+}
+
+// checkBundleRecord re-encodes the readable record and checks that it produces both the
+// claimed CID and the exact DAG-CBOR block shipped with it. It returns a mismatch reason,
+// or an empty string when the record is consistent.
+func checkBundleRecord(record *BundleRecord) string {
+	var data interface{}
+	if err := json.Unmarshal(record.Record, &data); err != nil {
+		return "record is not valid JSON"
+	}
+
+	generated, err := cidpkg.GenerateCID(data)
+	if err != nil {
+		return "record could not be encoded: " + err.Error()
+	}
+	if generated.CID != record.CID {
+		return "record does not hash to the claimed CID"
+	}
+	if generated.SourceCBOR != record.CBOR {
+		return "DAG-CBOR block does not match the record"
+	}
+	return ""
+}
+
+// checkBundleImages checks that every bundled image is referenced by the event record
+// and, when the image file is included, that its content hashes to the image CID.
+func checkBundleImages(evt *BundleEvent, files fs.FS) string {
+	var record struct {
+		ImageCIDs []string `json:"imageCids"`
+	}
+	if err := json.Unmarshal(evt.Record, &record); err != nil {
+		return "record is not valid JSON"
+	}
+
+	for _, img := range evt.Images {
+		if !slices.Contains(record.ImageCIDs, img.CID) {
+			return fmt.Sprintf("image %s is not referenced by the event record", img.CID)
+		}
+		if img.File == "" {
+			continue
+		}
+		if files == nil {
+			return fmt.Sprintf("image file %s could not be read", img.File)
+		}
+
+		content, err := fs.ReadFile(files, img.File)
+		if err != nil {
+			return fmt.Sprintf("image file %s could not be read", img.File)
+		}
+		fileCID, err := cidpkg.GenerateFileCID(content)
+		if err != nil {
+			return fmt.Sprintf("image file %s could not be hashed", img.File)
+		}
+		if fileCID != img.CID {
+			return fmt.Sprintf("image file %s does not match CID %s", img.File, img.CID)
+		}
+	}
+	return ""
+}
+
+// findAssetCreation returns the earliest asset configuration transaction for the asset,
+// which is the transaction that created it.
+func findAssetCreation(txns []algorand.Transaction, assetID uint64) *algorand.Transaction {
+	var creation *algorand.Transaction
+	for i := range txns {
+		txn := &txns[i]
+		if txn.Type != "acfg" || txn.AssetID != assetID {
+			continue
+		}
+		if creation == nil || txn.ConfirmedRound < creation.ConfirmedRound {
+			creation = txn
+		}
+	}
+	return creation
+}
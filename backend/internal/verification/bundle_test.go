@@ -0,0 +1,167 @@
+package verification
+
+import (
+	"encoding/json"
+	"testing"
+	"testing/fstest"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/signing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Helpers ---
+
+func bundleRecord(t *testing.T, data map[string]interface{}, txID string) BundleRecord {
+	t.Helper()
+	generated, err := cidpkg.GenerateCID(data)
+	require.NoError(t, err)
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	return BundleRecord{
+		ID:     uuid.MustParse(data["id"].(string)),
+		CID:    generated.CID,
+		Record: raw,
+		CBOR:   generated.SourceCBOR,
+		TxID:   txID,
+	}
+}
+
+func testBundle(t *testing.T) (*Bundle, fstest.MapFS, []algorand.Transaction) {
+	t.Helper()
+	image := []byte("jpeg bytes")
+	imageCID, err := cidpkg.GenerateFileCID(image)
+	require.NoError(t, err)
+
+	vehicle := bundleRecord(t, map[string]interface{}{"id": uuid.NewString(), "make": "Porsche"}, "")
+	evt := bundleRecord(t, map[string]interface{}{
+		"id":        uuid.NewString(),
+		"title":     "Concours",
+		"imageCids": []string{imageCID},
+	}, "EVENT-TX")
+
+	bundle := &Bundle{
+		Vehicle: BundleVehicle{BundleRecord: vehicle, AssetID: "1001"},
+		Events: []BundleEvent{{
+			BundleRecord: evt,
+			Images:       []BundleImage{{CID: imageCID, File: "images/1.jpg"}},
+		}},
+	}
+	files := fstest.MapFS{"images/1.jpg": {Data: image}}
+	txns := []algorand.Transaction{
+		{ID: "GENESIS-TX", Type: "acfg", Sender: platformAddress, AssetID: 1001, ConfirmedRound: 10, Note: []byte("type=genesis|cid=" + vehicle.CID)},
+		{ID: "EVENT-TX", Type: "axfer", Sender: platformAddress, AssetID: 1001, ConfirmedRound: 20, Note: []byte("type=new_event|cid=" + evt.CID)},
+	}
+	return bundle, files, txns
+}
+
+// --- Tests ---
+
+func TestVerifyBundle_Match(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+
+	assert.Equal(t, VerdictMatch, report.Vehicle.Verdict)
+	require.Len(t, report.Events, 1)
+	assert.Equal(t, VerdictMatch, report.Events[0].Verdict)
+	assert.Equal(t, "GENESIS-TX", *report.Vehicle.TxID)
+}
+
+func TestVerifyBundle_EditedRecord(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+	bundle.Events[0].Record = json.RawMessage(`{"id":"` + bundle.Events[0].ID.String() + `","title":"Edited"}`)
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+
+	assert.Equal(t, VerdictMismatch, report.Events[0].Verdict)
+	assert.Contains(t, *report.Events[0].Reason, "claimed CID")
+}
+
+func TestVerifyBundle_ImageContentDiffers(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+	files["images/1.jpg"] = &fstest.MapFile{Data: []byte("other bytes")}
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+
+	assert.Equal(t, VerdictMismatch, report.Events[0].Verdict)
+	assert.Contains(t, *report.Events[0].Reason, "does not match CID")
+}
+
+func TestVerifyBundle_ImageNotInRecord(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+	bundle.Events[0].Images = append(bundle.Events[0].Images, BundleImage{CID: "bafkunknown"})
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+
+	assert.Equal(t, VerdictMismatch, report.Events[0].Verdict)
+}
+
+func TestVerifyBundle_MissingTransaction(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+
+	report := VerifyBundle(bundle, files, txns[:1], platformAddress)
+
+	assert.Equal(t, VerdictMatch, report.Vehicle.Verdict)
+	assert.Equal(t, VerdictMissingOnChain, report.Events[0].Verdict)
+}
+
+func TestVerifyBundle_NoteCIDDiffers(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+	txns[1].Note = []byte("type=new_event|cid=bafyother")
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+
+	assert.Equal(t, VerdictMismatch, report.Events[0].Verdict)
+}
+
+func TestVerifyBundle_UnanchoredEvent(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+	bundle.Events[0].TxID = ""
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+
+	assert.Equal(t, VerdictNotAnchored, report.Events[0].Verdict)
+}
+
+func TestVerifyBundle_MerkleAnchoredEvent(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+	evt := &bundle.Events[0]
+	tree := merkle.Build([]string{evt.CID, "bafyother"})
+	proof := tree.Proof(0)
+	evt.MerkleProof = &proof
+	evt.TxID = "ROOT-TX"
+	txns = append(txns, algorand.Transaction{
+		ID: "ROOT-TX", Type: "pay", Sender: platformAddress, ConfirmedRound: 30, Note: []byte("type=merkle_root|root=" + tree.Root()),
+	})
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+
+	require.Len(t, report.Events, 1)
+	assert.Equal(t, VerdictMatch, report.Events[0].Verdict)
+	assert.Equal(t, tree.Root(), *report.Events[0].MerkleRoot)
+}
+
+func TestVerifyBundle_EventSignature(t *testing.T) {
+	bundle, files, txns := testBundle(t)
+	pub, priv, err := signing.GenerateKey()
+	require.NoError(t, err)
+	bundle.Events[0].Signature = &BundleSignature{
+		KeyID:     uuid.New(),
+		PublicKey: signing.EncodePublicKey(pub),
+		Signature: signing.SignCID(priv, bundle.Events[0].CID),
+	}
+
+	report := VerifyBundle(bundle, files, txns, platformAddress)
+	require.NotNil(t, report.Events[0].Signature)
+	assert.True(t, report.Events[0].Signature.Valid)
+
+	bundle.Events[0].Signature.Signature = signing.SignCID(priv, "bafyother")
+	report = VerifyBundle(bundle, files, txns, platformAddress)
+	assert.False(t, report.Events[0].Signature.Valid)
+}
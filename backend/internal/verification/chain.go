@@ -0,0 +1,238 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/google/uuid"
+)
+
+// chainRecord is a record of the vehicle's chain as loaded for the walk
+type chainRecord struct {
+	link       ChainLink
+	sourceJSON *string
+	sourceCBOR *string
+	genesis    bool
+}
+
+// VerifyChain walks the vehicle's hash-linked chain from its head back to genesis. Every record
+// must hash to its stored CID, link to an existing record, and be reachable from the head, so
+// deleted or reordered records show up as issues.
+func (s *Service) VerifyChain(ctx context.Context, vehicleID uuid.UUID) (*ChainReport, error) {
+	vehicle, err := s.vehicleRepo.GetByID(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ChainReport{
+		VehicleID:  vehicle.ID,
+		GenesisCID: vehicle.CID,
+		HeadCID:    vehicle.ChainHeadCID,
+		Links:      []ChainLink{},
+		Issues:     []ChainIssue{},
+	}
+	if report.HeadCID == nil {
+		report.HeadCID = vehicle.CID
+	}
+
+	if vehicle.CID == nil {
+		report.Issues = append(report.Issues, ChainIssue{
+			RecordType: RecordTypeVehicle,
+			RecordID:   &vehicle.ID,
+			Reason:     "vehicle has no genesis record",
+		})
+		return report, nil
+	}
+
+	records, err := s.loadChainRecords(ctx, vehicle)
+	if err != nil {
+		return nil, err
+	}
+
+	byCID := make(map[string]*chainRecord, len(records))
+	for _, rec := range records {
+		if reason := checkChainRecord(rec); reason != "" {
+			report.Issues = append(report.Issues, chainIssue(rec, reason))
+		}
+		if _, ok := byCID[rec.link.CID]; ok {
+			report.Issues = append(report.Issues, chainIssue(rec, "another record has the same CID"))
+			continue
+		}
+		byCID[rec.link.CID] = rec
+	}
+
+	visited := make(map[string]bool, len(records))
+	cid := *report.HeadCID
+	for {
+		rec, ok := byCID[cid]
+		if !ok {
+			report.Issues = append(report.Issues, ChainIssue{
+				CID:    &cid,
+				Reason: "chain links to a record that does not exist",
+			})
+			break
+		}
+		if visited[cid] {
+			report.Issues = append(report.Issues, chainIssue(rec, "chain links back to a later record"))
+			break
+		}
+		visited[cid] = true
+		report.Links = append(report.Links, rec.link)
+
+		if rec.genesis {
+			break
+		}
+		if rec.link.PreviousCID == nil {
+			report.Issues = append(report.Issues, chainIssue(rec, "record does not link to a previous record"))
+			break
+		}
+		cid = *rec.link.PreviousCID
+	}
+	slices.Reverse(report.Links)
+
+	for _, rec := range records {
+		if !visited[rec.link.CID] {
+			report.Issues = append(report.Issues, chainIssue(rec, "record is not reachable from the chain head"))
+		}
+	}
+
+	report.Valid = len(report.Issues) == 0
+	return report, nil
+}
+
+// loadChainRecords loads the genesis record, the vehicle versions and every event with a CID
+func (s *Service) loadChainRecords(ctx context.Context, vehicle *vehicles.Vehicle) ([]*chainRecord, error) {
+	records := []*chainRecord{{
+		link: ChainLink{
+			RecordType:       RecordTypeVehicle,
+			RecordID:         vehicle.ID,
+			CID:              *vehicle.CID,
+			BlockchainStatus: vehicle.BlockchainStatus,
+			CreatedAt:        vehicle.CreatedAt,
+		},
+		sourceJSON: vehicle.CIDSourceJSON,
+		sourceCBOR: vehicle.CIDSourceCBOR,
+		genesis:    true,
+	}}
+
+	versions, err := s.vehicleRepo.ListVersions(ctx, vehicle.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list vehicle versions: %w", err)
+	}
+	for _, v := range versions {
+		records = append(records, &chainRecord{
+			link: ChainLink{
+				RecordType:       RecordTypeVehicleVersion,
+				RecordID:         v.ID,
+				CID:              v.CID,
+				PreviousCID:      &v.PreviousCID,
+				BlockchainStatus: v.BlockchainStatus,
+				CreatedAt:        v.CreatedAt,
+			},
+			sourceJSON: &v.CIDSourceJSON,
+			sourceCBOR: &v.CIDSourceCBOR,
+		})
+	}
+
+	for offset := 0; ; offset += eventPageSize {
+		events, total, err := s.eventRepo.GetByVehicle(ctx, vehicle.ID, eventPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list vehicle events: %w", err)
+		}
+		records = appendEventRecords(records, events)
+
+		if offset+eventPageSize >= total {
+			break
+		}
+	}
+
+	revisions, err := s.eventRepo.ListRevisionsByVehicle(ctx, vehicle.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list vehicle event revisions: %w", err)
+	}
+	records = appendEventRecords(records, revisions)
+
+	return records, nil
+}
+
+// appendEventRecords adds the events that were submitted for anchoring to the chain records
+func appendEventRecords(records []*chainRecord, events []event.Event) []*chainRecord {
+	for _, evt := range events {
+		// Events that were never submitted for anchoring are not part of the chain
+		if evt.CID == nil {
+			continue
+		}
+		records = append(records, &chainRecord{
+			link: ChainLink{
+				RecordType:       RecordTypeEvent,
+				RecordID:         evt.ID,
+				CID:              *evt.CID,
+				PreviousCID:      evt.PreviousCID,
+				BlockchainStatus: evt.BlockchainStatus,
+				CreatedAt:        evt.CreatedAt,
+			},
+			sourceJSON: evt.CIDSourceJSON,
+			sourceCBOR: evt.CIDSourceCBOR,
+		})
+	}
+	return records
+}
+
+// checkChainRecord checks that the record hashes to its CID, that its JSON source is the one
+// derived from the hashed block, and that the link stored with the record is the one covered by
+// the hash
+func checkChainRecord(rec *chainRecord) string {
+	if rec.sourceCBOR == nil || rec.sourceJSON == nil {
+		return "record has no stored source"
+	}
+
+	computed, err := cidpkg.CIDFromSourceCBOR(*rec.sourceCBOR)
+	if err != nil {
+		return "stored source could not be re-encoded: " + err.Error()
+	}
+	if computed != rec.link.CID {
+		return "recomputed CID does not match the stored CID"
+	}
+
+	// The link is read from the hashed block; the stored JSON must be the block's JSON form
+	sourceJSON, err := cidpkg.SourceJSONFromCBOR(*rec.sourceCBOR)
+	if err != nil {
+		return "stored source could not be decoded: " + err.Error()
+	}
+	if sourceJSON != *rec.sourceJSON {
+		return "stored JSON source does not match the hashed record"
+	}
+
+	var source struct {
+		PreviousCID *string `json:"previousCid"`
+	}
+	if err := json.Unmarshal([]byte(sourceJSON), &source); err != nil {
+		return "stored source is not valid JSON"
+	}
+	if !equalCIDs(source.PreviousCID, rec.link.PreviousCID) {
+		return "stored link does not match the hashed record"
+	}
+
+	return ""
+}
+
+func chainIssue(rec *chainRecord, reason string) ChainIssue {
+	return ChainIssue{
+		RecordType: rec.link.RecordType,
+		RecordID:   &rec.link.RecordID,
+		CID:        &rec.link.CID,
+		Reason:     reason,
+	}
+}
+
+func equalCIDs(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
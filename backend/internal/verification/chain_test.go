@@ -0,0 +1,292 @@
+package verification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Helpers ---
+
+func linkedCID(t *testing.T, id uuid.UUID, previousCID *string) *cidpkg.CID {
+	t.Helper()
+	record := map[string]interface{}{"id": id.String()}
+	if previousCID != nil {
+		record["previousCid"] = *previousCID
+	}
+	cidData, err := cidpkg.GenerateCID(record)
+	require.NoError(t, err)
+	return cidData
+}
+
+func genesisVehicle(t *testing.T) *vehicles.Vehicle {
+	t.Helper()
+	id := uuid.New()
+	cidData := linkedCID(t, id, nil)
+	return &vehicles.Vehicle{
+		ID:            id,
+		CID:           &cidData.CID,
+		CIDSourceJSON: &cidData.SourceJSON,
+		CIDSourceCBOR: &cidData.SourceCBOR,
+		ChainHeadCID:  &cidData.CID,
+	}
+}
+
+func linkedEvent(t *testing.T, vehicle *vehicles.Vehicle, previousCID string) event.Event {
+	t.Helper()
+	id := uuid.New()
+	cidData := linkedCID(t, id, &previousCID)
+	vehicle.ChainHeadCID = &cidData.CID
+	return event.Event{
+		ID:            id,
+		VehicleID:     vehicle.ID,
+		EntityID:      ptr(uuid.New()),
+		PreviousCID:   &previousCID,
+		CID:           &cidData.CID,
+		CIDSourceJSON: &cidData.SourceJSON,
+		CIDSourceCBOR: &cidData.SourceCBOR,
+	}
+}
+
+// chainStore keeps a vehicle and its events in memory so the event service can append to the
+// chain that VerifyChain walks
+type chainStore struct {
+	vehicle *vehicles.Vehicle
+	events  []event.Event
+}
+
+func (m *chainStore) GetByVehicle(_ context.Context, _ uuid.UUID, _, _ int) ([]event.Event, int, error) {
+	return m.events, len(m.events), nil
+}
+
+func (m *chainStore) GetByID(_ context.Context, id uuid.UUID) (*event.Event, error) {
+	for _, e := range m.events {
+		if e.ID == id {
+			return &e, nil
+		}
+	}
+	return nil, event.ErrEventNotFound
+}
+
+func (m *chainStore) ListRevisions(_ context.Context, _ uuid.UUID) ([]event.Event, error) {
+	return nil, nil
+}
+
+func (m *chainStore) ListRevisionsByVehicle(_ context.Context, _ uuid.UUID) ([]event.Event, error) {
+	return nil, nil
+}
+
+func (m *chainStore) Create(_ context.Context, evt event.Event) (*event.Event, error) {
+	evt.ID = uuid.New()
+	evt.CreatedAt = time.Now()
+	m.events = append(m.events, evt)
+	return &evt, nil
+}
+
+func (m *chainStore) Update(_ context.Context, evt event.Event) error {
+	for i := range m.events {
+		if m.events[i].ID == evt.ID {
+			m.events[i] = evt
+		}
+	}
+	return nil
+}
+
+func (m *chainStore) UpdateDetails(ctx context.Context, evt event.Event) error {
+	return m.Update(ctx, evt)
+}
+
+func (m *chainStore) Delete(_ context.Context, _ uuid.UUID) error { return nil }
+
+func (m *chainStore) DecideProposal(_ context.Context, _ uuid.UUID, _ event.ApprovalStatus, _ time.Time) error {
+	return nil
+}
+
+func (m *chainStore) ListByBlockchainStatus(_ context.Context, _ string, _ time.Duration, _, _ int) ([]event.Event, int, error) {
+	return nil, 0, nil
+}
+
+func (m *chainStore) LockChainHead(_ context.Context, _ uuid.UUID) (*string, error) {
+	if m.vehicle.ChainHeadCID != nil {
+		return m.vehicle.ChainHeadCID, nil
+	}
+	return m.vehicle.CID, nil
+}
+
+func (m *chainStore) SetGenesisCID(_ context.Context, _ uuid.UUID, cid, sourceJSON, sourceCBOR string) error {
+	if m.vehicle.CID != nil {
+		return nil
+	}
+	m.vehicle.CID = &cid
+	m.vehicle.CIDSourceJSON = &sourceJSON
+	m.vehicle.CIDSourceCBOR = &sourceCBOR
+	if m.vehicle.ChainHeadCID == nil {
+		m.vehicle.ChainHeadCID = &cid
+	}
+	return nil
+}
+
+func (m *chainStore) SetChainHead(_ context.Context, _ uuid.UUID, cid string) error {
+	m.vehicle.ChainHeadCID = &cid
+	return nil
+}
+
+func (m *chainStore) SetSignature(_ context.Context, _, _ uuid.UUID, _ string) error { return nil }
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(_ context.Context, _ string, _ []byte) error { return nil }
+func (nopPublisher) Close() error                                        { return nil }
+
+type nopTransactor struct{}
+
+func (nopTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// --- Tests ---
+
+func TestService_VerifyChain_Valid(t *testing.T) {
+	vehicle := genesisVehicle(t)
+	versionID := uuid.New()
+	versionCID := linkedCID(t, versionID, vehicle.CID)
+	version := vehicles.Version{
+		ID:            versionID,
+		VehicleID:     vehicle.ID,
+		CID:           versionCID.CID,
+		PreviousCID:   *vehicle.CID,
+		CIDSourceJSON: versionCID.SourceJSON,
+		CIDSourceCBOR: versionCID.SourceCBOR,
+	}
+	vehicle.ChainHeadCID = &version.CID
+	evt := linkedEvent(t, vehicle, version.CID)
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle, versions: []vehicles.Version{version}},
+		&mockEventRepo{events: []event.Event{evt}}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyChain(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.True(t, report.Valid)
+	assert.Empty(t, report.Issues)
+	require.Len(t, report.Links, 3)
+	assert.Equal(t, RecordTypeVehicle, report.Links[0].RecordType)
+	assert.Equal(t, RecordTypeVehicleVersion, report.Links[1].RecordType)
+	assert.Equal(t, evt.ID, report.Links[2].RecordID)
+}
+
+func TestService_VerifyChain_DeletedRecord(t *testing.T) {
+	vehicle := genesisVehicle(t)
+	first := linkedEvent(t, vehicle, *vehicle.CID)
+	second := linkedEvent(t, vehicle, *first.CID)
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle},
+		&mockEventRepo{events: []event.Event{second}}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyChain(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.False(t, report.Valid)
+	require.NotEmpty(t, report.Issues)
+	assert.Equal(t, first.CID, report.Issues[0].CID)
+	assert.Equal(t, "chain links to a record that does not exist", report.Issues[0].Reason)
+}
+
+func TestService_VerifyChain_UnlinkedRecord(t *testing.T) {
+	vehicle := genesisVehicle(t)
+	first := linkedEvent(t, vehicle, *vehicle.CID)
+	// Links to genesis, skipping the first event, while the head still points at the first event
+	head := vehicle.ChainHeadCID
+	second := linkedEvent(t, vehicle, *vehicle.CID)
+	vehicle.ChainHeadCID = head
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle},
+		&mockEventRepo{events: []event.Event{first, second}}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyChain(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.False(t, report.Valid)
+	assert.Len(t, report.Links, 2)
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, second.ID, *report.Issues[0].RecordID)
+	assert.Equal(t, "record is not reachable from the chain head", report.Issues[0].Reason)
+}
+
+func TestService_VerifyChain_TamperedLink(t *testing.T) {
+	vehicle := genesisVehicle(t)
+	evt := linkedEvent(t, vehicle, *vehicle.CID)
+	evt.PreviousCID = ptr("bafyother")
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle},
+		&mockEventRepo{events: []event.Event{evt}}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyChain(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.False(t, report.Valid)
+	assert.Equal(t, "stored link does not match the hashed record", report.Issues[0].Reason)
+}
+
+func TestService_VerifyChain_TamperedLinkAndJSON(t *testing.T) {
+	vehicle := genesisVehicle(t)
+	evt := linkedEvent(t, vehicle, *vehicle.CID)
+	// Rewriting the JSON source alongside the link leaves the hashed block untouched
+	forged := linkedCID(t, evt.ID, ptr("bafyother"))
+	evt.PreviousCID = ptr("bafyother")
+	evt.CIDSourceJSON = &forged.SourceJSON
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle},
+		&mockEventRepo{events: []event.Event{evt}}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyChain(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.False(t, report.Valid)
+	assert.Equal(t, "stored JSON source does not match the hashed record", report.Issues[0].Reason)
+}
+
+func TestService_VerifyChain_OwnerVehicleFirstEvent(t *testing.T) {
+	// Owner-registered vehicles are not submitted for anchoring, so they have no genesis CID
+	// until their first anchored event
+	ownerID := uuid.New()
+	vehicle := &vehicles.Vehicle{
+		ID:        uuid.New(),
+		Make:      "Porsche",
+		Model:     "911",
+		Year:      1973,
+		OwnerID:   &ownerID,
+		CreatedAt: time.Now(),
+	}
+	store := &chainStore{vehicle: vehicle}
+	events := event.NewService(store, nopPublisher{}, nopTransactor{}, cidpkg.NewCIDGenerator())
+
+	evt, err := events.Create(context.Background(), *vehicle, event.CreateEventParams{
+		ShouldAnchor: true,
+		VehicleID:    vehicle.ID,
+		Type:         event.TypeMaintenance,
+		Title:        "Oil change",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, vehicle.CID)
+	assert.Equal(t, vehicle.CID, evt.PreviousCID)
+	assert.Equal(t, evt.CID, vehicle.ChainHeadCID)
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle},
+		&mockEventRepo{events: store.events}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyChain(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.True(t, report.Valid, report.Issues)
+	require.Len(t, report.Links, 2)
+	assert.Equal(t, RecordTypeVehicle, report.Links[0].RecordType)
+	assert.Equal(t, evt.ID, report.Links[1].RecordID)
+}
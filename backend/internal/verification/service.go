@@ -0,0 +1,418 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/signing_keys"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/signing"
+	"github.com/google/uuid"
+)
+
+const eventPageSize = 100
+
+// Ledger defines the on-chain lookups needed to verify anchors
+type Ledger interface {
+	LookupTransaction(ctx context.Context, txID string) (*algorand.Transaction, error)
+	LookupAssetCreation(ctx context.Context, assetID uint64) (*algorand.Transaction, error)
+}
+
+// VehicleRepository defines the vehicle data access needed for verification
+type VehicleRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error)
+	ListVersions(ctx context.Context, vehicleID uuid.UUID) ([]vehicles.Version, error)
+}
+
+// EventRepository defines the event data access needed for verification
+type EventRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error)
+	GetByVehicle(ctx context.Context, vehicleID uuid.UUID, limit, offset int) ([]event.Event, int, error)
+	ListRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]event.Event, error)
+}
+
+// KeyResolver resolves the keys entities sign their events with
+type KeyResolver interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*signing_keys.Key, error)
+}
+
+// Service recomputes record CIDs and checks them against the notes anchored on-chain
+type Service struct {
+	vehicleRepo     VehicleRepository
+	eventRepo       EventRepository
+	ledger          Ledger
+	platformAddress string
+	keys            KeyResolver
+}
+
+// NewService creates a new verification service. When platformAddress is set,
+// anchors sent from any other address are reported as mismatches.
+func NewService(vehicleRepo VehicleRepository, eventRepo EventRepository, ledger Ledger, platformAddress string) *Service {
+	return &Service{
+		vehicleRepo:     vehicleRepo,
+		eventRepo:       eventRepo,
+		ledger:          ledger,
+		platformAddress: platformAddress,
+	}
+}
+
+// SetKeyResolver sets the resolver of event signing keys (optional). Without it event signatures
+// are reported as unverifiable.
+func (s *Service) SetKeyResolver(keys KeyResolver) {
+	s.keys = keys
+}
+
+// VerifyVehicle verifies the vehicle genesis anchor, every anchored version of the vehicle record
+// and every certified event of the vehicle, including amendments and revocations
+func (s *Service) VerifyVehicle(ctx context.Context, vehicleID uuid.UUID) (*VehicleReport, error) {
+	vehicle, err := s.vehicleRepo.GetByID(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicleResult, err := s.verifyVehicle(ctx, vehicle)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VehicleReport{
+		Vehicle:  *vehicleResult,
+		Versions: []Result{},
+		Events:   []Result{},
+	}
+
+	versions, err := s.vehicleRepo.ListVersions(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("list vehicle versions: %w", err)
+	}
+	for _, version := range versions {
+		versionResult, err := s.verifyVersion(ctx, vehicle, &version)
+		if err != nil {
+			return nil, err
+		}
+		report.Versions = append(report.Versions, *versionResult)
+	}
+
+	for offset := 0; ; offset += eventPageSize {
+		events, total, err := s.eventRepo.GetByVehicle(ctx, vehicleID, eventPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list vehicle events: %w", err)
+		}
+
+		for _, evt := range events {
+			// Proposals are only anchored once accepted
+			if !isPublic(evt) || !evt.OnRecord() {
+				continue
+			}
+			eventResult, err := s.verifyEvent(ctx, vehicle, &evt)
+			if err != nil {
+				return nil, err
+			}
+			report.Events = append(report.Events, *eventResult)
+		}
+
+		if offset+eventPageSize >= total {
+			break
+		}
+	}
+
+	// Amendments and revocations of certified events are anchored like the events themselves
+	revisions, err := s.eventRepo.ListRevisionsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, fmt.Errorf("list vehicle event revisions: %w", err)
+	}
+	for _, rev := range revisions {
+		if !isPublic(rev) {
+			continue
+		}
+		eventResult, err := s.verifyEvent(ctx, vehicle, &rev)
+		if err != nil {
+			return nil, err
+		}
+		report.Events = append(report.Events, *eventResult)
+	}
+
+	return report, nil
+}
+
+// VerifyEvent verifies the anchor of a single certified or platform-recorded event.
+// Owner events are not public and are reported as not found.
+func (s *Service) VerifyEvent(ctx context.Context, eventID uuid.UUID) (*Result, error) {
+	evt, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPublic(*evt) {
+		return nil, event.ErrEventNotFound
+	}
+
+	vehicle, err := s.vehicleRepo.GetByID(ctx, evt.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.verifyEvent(ctx, vehicle, evt)
+}
+
+// isPublic reports whether the event is part of the public record: events issued by an entity and
+// events the platform recorded itself. Owner events are private and never anchored. Platform
+// events have no issuer to check, so only their CID and anchor are verified.
+func isPublic(evt event.Event) bool {
+	return evt.EntityID != nil || evt.RecordedByPlatform()
+}
+
+func (s *Service) verifyVehicle(ctx context.Context, vehicle *vehicles.Vehicle) (*Result, error) {
+	result := &Result{
+		RecordType: RecordTypeVehicle,
+		RecordID:   vehicle.ID,
+		StoredCID:  vehicle.CID,
+	}
+
+	if vehicle.CID == nil || vehicle.CIDSourceCBOR == nil || vehicle.BlockchainAssetID == nil {
+		result.Verdict = VerdictNotAnchored
+		return result, nil
+	}
+
+	assetID, err := strconv.ParseUint(*vehicle.BlockchainAssetID, 10, 64)
+	if err != nil {
+		return mismatch(result, "stored asset id is not a valid Algorand asset id"), nil
+	}
+	result.AssetID = &assetID
+
+	txn, err := s.ledger.LookupAssetCreation(ctx, assetID)
+	if err != nil {
+		if errors.Is(err, algorand.ErrTransactionNotFound) {
+			result.Verdict = VerdictMissingOnChain
+			return result, nil
+		}
+		return nil, fmt.Errorf("lookup asset creation: %w", err)
+	}
+
+	return compareAnchor(result, *vehicle.CID, *vehicle.CIDSourceCBOR, anchorer.NoteTypeGenesis, txn, s.platformAddress), nil
+}
+
+func (s *Service) verifyVersion(ctx context.Context, vehicle *vehicles.Vehicle, version *vehicles.Version) (*Result, error) {
+	result := &Result{
+		RecordType: RecordTypeVehicleVersion,
+		RecordID:   version.ID,
+		StoredCID:  &version.CID,
+		TxID:       version.BlockchainTxID,
+	}
+
+	if version.BlockchainTxID == nil {
+		result.Verdict = VerdictNotAnchored
+		return result, nil
+	}
+
+	txn, err := s.ledger.LookupTransaction(ctx, *version.BlockchainTxID)
+	if err != nil {
+		if errors.Is(err, algorand.ErrTransactionNotFound) {
+			result.Verdict = VerdictMissingOnChain
+			return result, nil
+		}
+		return nil, fmt.Errorf("lookup version transaction: %w", err)
+	}
+
+	result.AssetID = &txn.AssetID
+
+	if vehicle.BlockchainAssetID == nil || *vehicle.BlockchainAssetID != strconv.FormatUint(txn.AssetID, 10) {
+		return mismatch(result, "transaction does not reference the vehicle asset"), nil
+	}
+
+	return compareAnchor(result, version.CID, version.CIDSourceCBOR, anchorer.NoteTypeVehicleUpdate, txn, s.platformAddress), nil
+}
+
+func (s *Service) verifyEvent(ctx context.Context, vehicle *vehicles.Vehicle, evt *event.Event) (*Result, error) {
+	result := &Result{
+		RecordType: RecordTypeEvent,
+		RecordID:   evt.ID,
+		StoredCID:  evt.CID,
+		TxID:       evt.BlockchainTxID,
+	}
+
+	signature, err := s.verifySignature(ctx, evt)
+	if err != nil {
+		return nil, err
+	}
+	result.Signature = signature
+
+	if evt.CID == nil || evt.CIDSourceCBOR == nil || evt.BlockchainTxID == nil {
+		result.Verdict = VerdictNotAnchored
+		return result, nil
+	}
+
+	txn, err := s.ledger.LookupTransaction(ctx, *evt.BlockchainTxID)
+	if err != nil {
+		if errors.Is(err, algorand.ErrTransactionNotFound) {
+			result.Verdict = VerdictMissingOnChain
+			return result, nil
+		}
+		return nil, fmt.Errorf("lookup event transaction: %w", err)
+	}
+
+	// The root transaction of a Merkle anchor is not written on the vehicle's asset
+	if evt.MerkleProof != nil {
+		return compareMerkleAnchor(result, *evt.CID, *evt.CIDSourceCBOR, evt.MerkleProof, txn, s.platformAddress), nil
+	}
+
+	result.AssetID = &txn.AssetID
+
+	if vehicle.BlockchainAssetID == nil || *vehicle.BlockchainAssetID != strconv.FormatUint(txn.AssetID, 10) {
+		return mismatch(result, "transaction does not reference the vehicle asset"), nil
+	}
+
+	return compareAnchor(result, *evt.CID, *evt.CIDSourceCBOR, eventNoteType(evt.Kind), txn, s.platformAddress), nil
+}
+
+// eventNoteType returns the note type an event of the kind is anchored with on the vehicle's asset
+func eventNoteType(kind event.Kind) string {
+	switch kind {
+	case event.KindAmendment:
+		return anchorer.NoteTypeEventAmendment
+	case event.KindRevocation:
+		return anchorer.NoteTypeEventRevocation
+	}
+	return anchorer.NoteTypeNewEvent
+}
+
+// verifySignature checks the issuing entity's signature over the event's stored CID against the
+// registered key it was made with
+func (s *Service) verifySignature(ctx context.Context, evt *event.Event) (*SignatureResult, error) {
+	if evt.Signature == nil || evt.SigningKeyID == nil || evt.CID == nil {
+		return nil, nil
+	}
+
+	result := &SignatureResult{
+		KeyID:     *evt.SigningKeyID,
+		Signature: *evt.Signature,
+		SignedAt:  evt.SignedAt,
+	}
+	if s.keys == nil {
+		return invalidSignature(result, "signing keys cannot be resolved"), nil
+	}
+
+	key, err := s.keys.GetByID(ctx, *evt.SigningKeyID)
+	if err != nil {
+		if errors.Is(err, signing_keys.ErrKeyNotFound) {
+			return invalidSignature(result, "signing key is not registered"), nil
+		}
+		return nil, fmt.Errorf("get signing key: %w", err)
+	}
+	result.EntityID = &key.EntityID
+	result.PublicKey = &key.PublicKey
+	result.KeyRevokedAt = key.RevokedAt
+
+	switch {
+	case evt.EntityID == nil || key.EntityID != *evt.EntityID:
+		return invalidSignature(result, "signing key does not belong to the issuing entity"), nil
+	case evt.SignedAt != nil && !key.ActiveAt(*evt.SignedAt):
+		return invalidSignature(result, "signing key was not active when the event was signed"), nil
+	}
+
+	return checkSignature(result, *evt.CID), nil
+}
+
+// checkSignature checks the signature over the CID with the public key of the result
+func checkSignature(result *SignatureResult, cid string) *SignatureResult {
+	if result.PublicKey == nil {
+		return invalidSignature(result, "public key is missing")
+	}
+	if err := signing.VerifyCID(*result.PublicKey, cid, result.Signature); err != nil {
+		return invalidSignature(result, err.Error())
+	}
+	result.Valid = true
+	return result
+}
+
+func invalidSignature(result *SignatureResult, reason string) *SignatureResult {
+	result.Valid = false
+	result.Reason = &reason
+	return result
+}
+
+// compareAnchor recomputes the CID from the stored DAG-CBOR and checks it against both
+// the stored CID and the CID written in the transaction note, which must be of the given
+// note type. When platformAddress is set, transactions sent from any other address are
+// reported as mismatches.
+func compareAnchor(result *Result, storedCID, sourceCBOR, noteType string, txn *algorand.Transaction, platformAddress string) *Result {
+	result.TxID = &txn.ID
+	result.ConfirmedRound = &txn.ConfirmedRound
+	result.ConfirmedAt = &txn.RoundTime
+
+	note, err := anchorer.ParseNote(txn.Note)
+	if err == nil {
+		result.OnChainCID = &note.CID
+	}
+
+	computed, err := cidpkg.CIDFromSourceCBOR(sourceCBOR)
+	if err != nil {
+		return mismatch(result, "stored source could not be re-encoded: "+err.Error())
+	}
+	result.ComputedCID = &computed
+
+	switch {
+	case computed != storedCID:
+		return mismatch(result, "recomputed CID does not match the stored CID")
+	case platformAddress != "" && txn.Sender != platformAddress:
+		return mismatch(result, "transaction was not sent by the platform wallet")
+	case result.OnChainCID == nil:
+		return mismatch(result, "transaction note is not an anchor note")
+	case note.Type != noteType:
+		return mismatch(result, fmt.Sprintf("transaction note is a %s note, not a %s note", note.Type, noteType))
+	case *result.OnChainCID != computed:
+		return mismatch(result, "on-chain CID does not match the recomputed CID")
+	}
+
+	result.Verdict = VerdictMatch
+	return result
+}
+
+// compareMerkleAnchor recomputes the CID from the stored DAG-CBOR and checks that the inclusion
+// proof leads from it to the root written in the transaction note. When platformAddress is set,
+// transactions sent from any other address are reported as mismatches.
+func compareMerkleAnchor(result *Result, storedCID, sourceCBOR string, proof *merkle.Proof, txn *algorand.Transaction, platformAddress string) *Result {
+	result.TxID = &txn.ID
+	result.ConfirmedRound = &txn.ConfirmedRound
+	result.ConfirmedAt = &txn.RoundTime
+	result.MerkleProof = proof
+
+	note, err := anchorer.ParseNote(txn.Note)
+	if err == nil && note.Type == anchorer.NoteTypeMerkleRoot {
+		result.MerkleRoot = &note.Root
+	}
+
+	computed, err := cidpkg.CIDFromSourceCBOR(sourceCBOR)
+	if err != nil {
+		return mismatch(result, "stored source could not be re-encoded: "+err.Error())
+	}
+	result.ComputedCID = &computed
+
+	switch {
+	case computed != storedCID:
+		return mismatch(result, "recomputed CID does not match the stored CID")
+	case platformAddress != "" && txn.Sender != platformAddress:
+		return mismatch(result, "transaction was not sent by the platform wallet")
+	case result.MerkleRoot == nil:
+		return mismatch(result, "transaction note is not a Merkle root note")
+	case *result.MerkleRoot != proof.Root:
+		return mismatch(result, "on-chain Merkle root does not match the proof root")
+	case !merkle.Verify(computed, *proof):
+		return mismatch(result, "Merkle proof does not lead from the recomputed CID to the root")
+	}
+
+	result.Verdict = VerdictMatch
+	return result
+}
+
+func mismatch(result *Result, reason string) *Result {
+	result.Verdict = VerdictMismatch
+	result.Reason = &reason
+	return result
+}
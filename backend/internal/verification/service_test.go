@@ -0,0 +1,604 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/signing_keys"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/signing"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockVehicleRepo struct {
+	vehicle  *vehicles.Vehicle
+	versions []vehicles.Version
+}
+
+func (m *mockVehicleRepo) GetByID(_ context.Context, _ uuid.UUID) (*vehicles.Vehicle, error) {
+	if m.vehicle == nil {
+		return nil, vehicles.ErrVehicleNotFound
+	}
+	return m.vehicle, nil
+}
+
+func (m *mockVehicleRepo) ListVersions(_ context.Context, _ uuid.UUID) ([]vehicles.Version, error) {
+	return m.versions, nil
+}
+
+type mockEventRepo struct {
+	events    []event.Event
+	revisions []event.Event
+}
+
+func (m *mockEventRepo) GetByID(_ context.Context, id uuid.UUID) (*event.Event, error) {
+	for _, e := range m.events {
+		if e.ID == id {
+			return &e, nil
+		}
+	}
+	return nil, event.ErrEventNotFound
+}
+
+func (m *mockEventRepo) GetByVehicle(_ context.Context, _ uuid.UUID, _, _ int) ([]event.Event, int, error) {
+	return m.events, len(m.events), nil
+}
+
+func (m *mockEventRepo) ListRevisionsByVehicle(_ context.Context, _ uuid.UUID) ([]event.Event, error) {
+	return m.revisions, nil
+}
+
+type mockLedger struct {
+	transactions map[string]*algorand.Transaction
+	creations    map[uint64]*algorand.Transaction
+	err          error
+}
+
+func (m *mockLedger) LookupTransaction(_ context.Context, txID string) (*algorand.Transaction, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if txn, ok := m.transactions[txID]; ok {
+		return txn, nil
+	}
+	return nil, algorand.ErrTransactionNotFound
+}
+
+func (m *mockLedger) LookupAssetCreation(_ context.Context, assetID uint64) (*algorand.Transaction, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if txn, ok := m.creations[assetID]; ok {
+		return txn, nil
+	}
+	return nil, algorand.ErrTransactionNotFound
+}
+
+type mockKeyResolver struct {
+	keys map[uuid.UUID]*signing_keys.Key
+}
+
+func (m *mockKeyResolver) GetByID(_ context.Context, id uuid.UUID) (*signing_keys.Key, error) {
+	if key, ok := m.keys[id]; ok {
+		return key, nil
+	}
+	return nil, signing_keys.ErrKeyNotFound
+}
+
+// --- Helpers ---
+
+const platformAddress = "PLATFORM"
+
+func ptr[T any](v T) *T { return &v }
+
+func anchoredVehicle(t *testing.T) (*vehicles.Vehicle, *algorand.Transaction) {
+	t.Helper()
+	id := uuid.New()
+	cidData, err := cidpkg.GenerateCID(map[string]interface{}{"id": id.String(), "make": "Porsche"})
+	require.NoError(t, err)
+
+	vehicle := &vehicles.Vehicle{
+		ID:                id,
+		BlockchainAssetID: ptr("1001"),
+		CID:               &cidData.CID,
+		CIDSourceCBOR:     &cidData.SourceCBOR,
+	}
+	txn := &algorand.Transaction{
+		ID:      "GENESIS-TX",
+		Sender:  platformAddress,
+		AssetID: 1001,
+		Note:    []byte("type=genesis|cid=" + cidData.CID),
+	}
+	return vehicle, txn
+}
+
+func anchoredEvent(t *testing.T, vehicleID uuid.UUID, txID string) (event.Event, *algorand.Transaction) {
+	t.Helper()
+	id := uuid.New()
+	cidData, err := cidpkg.GenerateCID(map[string]interface{}{"id": id.String(), "title": "Concours"})
+	require.NoError(t, err)
+
+	evt := event.Event{
+		ID:             id,
+		VehicleID:      vehicleID,
+		EntityID:       ptr(uuid.New()),
+		CID:            &cidData.CID,
+		CIDSourceCBOR:  &cidData.SourceCBOR,
+		BlockchainTxID: &txID,
+	}
+	txn := &algorand.Transaction{
+		ID:      txID,
+		Sender:  platformAddress,
+		AssetID: 1001,
+		Note:    []byte("type=new_event|cid=" + cidData.CID),
+	}
+	return evt, txn
+}
+
+// merkleAnchoredEvent anchors the event under a Merkle root shared with other CIDs
+func merkleAnchoredEvent(t *testing.T, vehicleID uuid.UUID, txID string) (event.Event, *algorand.Transaction) {
+	t.Helper()
+	evt, _ := anchoredEvent(t, vehicleID, txID)
+
+	tree := merkle.Build([]string{"bafyother0", *evt.CID, "bafyother1"})
+	proof := tree.Proof(1)
+	evt.MerkleProof = &proof
+
+	txn := &algorand.Transaction{
+		ID:     txID,
+		Type:   "pay",
+		Sender: platformAddress,
+		Note:   []byte("type=merkle_root|root=" + tree.Root()),
+	}
+	return evt, txn
+}
+
+// --- Tests ---
+
+func TestService_VerifyVehicle_Match(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	evt, evtTxn := anchoredEvent(t, vehicle.ID, "EVENT-TX")
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+		creations:    map[uint64]*algorand.Transaction{1001: genesis},
+		transactions: map[string]*algorand.Transaction{"EVENT-TX": evtTxn},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMatch, report.Vehicle.Verdict)
+	assert.Equal(t, *vehicle.CID, *report.Vehicle.ComputedCID)
+	require.Len(t, report.Events, 1)
+	assert.Equal(t, VerdictMatch, report.Events[0].Verdict)
+}
+
+func TestService_VerifyVehicle_IncludesRevisions(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	evt, evtTxn := anchoredEvent(t, vehicle.ID, "EVENT-TX")
+	revocation, revocationTxn := anchoredEvent(t, vehicle.ID, "REVOCATION-TX")
+	revocation.Kind = event.KindRevocation
+	revocation.RevisesEventID = &evt.ID
+	revocationTxn.Note = []byte("type=event_revocation|cid=" + *revocation.CID)
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}, revisions: []event.Event{revocation}}, &mockLedger{
+		creations:    map[uint64]*algorand.Transaction{1001: genesis},
+		transactions: map[string]*algorand.Transaction{"EVENT-TX": evtTxn, "REVOCATION-TX": revocationTxn},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	require.Len(t, report.Events, 2)
+	assert.Equal(t, revocation.ID, report.Events[1].RecordID)
+	assert.Equal(t, VerdictMatch, report.Events[1].Verdict)
+}
+
+func anchoredVersion(t *testing.T, vehicleID uuid.UUID, txID string) (vehicles.Version, *algorand.Transaction) {
+	t.Helper()
+	id := uuid.New()
+	cidData, err := cidpkg.GenerateCID(map[string]interface{}{"id": vehicleID.String(), "make": "Porsche", "color": "Silver"})
+	require.NoError(t, err)
+
+	version := vehicles.Version{
+		ID:             id,
+		VehicleID:      vehicleID,
+		Version:        2,
+		CID:            cidData.CID,
+		CIDSourceCBOR:  cidData.SourceCBOR,
+		BlockchainTxID: &txID,
+	}
+	txn := &algorand.Transaction{
+		ID:      txID,
+		Sender:  platformAddress,
+		AssetID: 1001,
+		Note:    []byte("type=vehicle_update|cid=" + cidData.CID),
+	}
+	return version, txn
+}
+
+func TestService_VerifyVehicle_IncludesVersions(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	version, versionTxn := anchoredVersion(t, vehicle.ID, "VERSION-TX")
+	tampered, tamperedTxn := anchoredVersion(t, vehicle.ID, "TAMPERED-TX")
+	tampered.CIDSourceCBOR = *vehicle.CIDSourceCBOR
+	pending := vehicles.Version{ID: uuid.New(), VehicleID: vehicle.ID, Version: 4, CID: version.CID, CIDSourceCBOR: version.CIDSourceCBOR}
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle, versions: []vehicles.Version{version, tampered, pending}}, &mockEventRepo{}, &mockLedger{
+		creations:    map[uint64]*algorand.Transaction{1001: genesis},
+		transactions: map[string]*algorand.Transaction{"VERSION-TX": versionTxn, "TAMPERED-TX": tamperedTxn},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	require.Len(t, report.Versions, 3)
+	assert.Equal(t, RecordTypeVehicleVersion, report.Versions[0].RecordType)
+	assert.Equal(t, version.ID, report.Versions[0].RecordID)
+	assert.Equal(t, VerdictMatch, report.Versions[0].Verdict)
+	assert.Equal(t, VerdictMismatch, report.Versions[1].Verdict)
+	assert.Equal(t, "recomputed CID does not match the stored CID", *report.Versions[1].Reason)
+	assert.Equal(t, VerdictNotAnchored, report.Versions[2].Verdict)
+}
+
+func TestService_VerifyVehicle_VersionOnAnotherAsset(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	version, versionTxn := anchoredVersion(t, vehicle.ID, "VERSION-TX")
+	versionTxn.AssetID = 2002
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle, versions: []vehicles.Version{version}}, &mockEventRepo{}, &mockLedger{
+		creations:    map[uint64]*algorand.Transaction{1001: genesis},
+		transactions: map[string]*algorand.Transaction{"VERSION-TX": versionTxn},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	require.Len(t, report.Versions, 1)
+	assert.Equal(t, VerdictMismatch, report.Versions[0].Verdict)
+	assert.Equal(t, "transaction does not reference the vehicle asset", *report.Versions[0].Reason)
+}
+
+func TestService_VerifyEvent_WrongNoteType(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	evt, evtTxn := anchoredEvent(t, vehicle.ID, "EVENT-TX")
+	evtTxn.Note = []byte("type=vehicle_update|cid=" + *evt.CID)
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"EVENT-TX": evtTxn},
+	}, platformAddress)
+
+	result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, result.Verdict)
+	assert.Equal(t, "transaction note is a vehicle_update note, not a new_event note", *result.Reason)
+}
+
+func TestService_VerifyVehicle_NotAnchored(t *testing.T) {
+	vehicle := &vehicles.Vehicle{ID: uuid.New()}
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictNotAnchored, report.Vehicle.Verdict)
+	assert.Empty(t, report.Events)
+}
+
+func TestService_VerifyVehicle_MissingOnChain(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{}, &mockLedger{}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMissingOnChain, report.Vehicle.Verdict)
+}
+
+func TestService_VerifyVehicle_TamperedSource(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	other, err := cidpkg.GenerateCID(map[string]interface{}{"id": vehicle.ID.String(), "make": "Ferrari"})
+	require.NoError(t, err)
+	vehicle.CIDSourceCBOR = &other.SourceCBOR
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{}, &mockLedger{
+		creations: map[uint64]*algorand.Transaction{1001: genesis},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, report.Vehicle.Verdict)
+	assert.Contains(t, *report.Vehicle.Reason, "stored CID")
+}
+
+func TestService_VerifyVehicle_OnChainCIDDiffers(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	genesis.Note = []byte("type=genesis|cid=bafyother")
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{}, &mockLedger{
+		creations: map[uint64]*algorand.Transaction{1001: genesis},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, report.Vehicle.Verdict)
+	assert.Equal(t, "bafyother", *report.Vehicle.OnChainCID)
+}
+
+func TestService_VerifyVehicle_ForeignSender(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	genesis.Sender = "SOMEONE-ELSE"
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{}, &mockLedger{
+		creations: map[uint64]*algorand.Transaction{1001: genesis},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, report.Vehicle.Verdict)
+}
+
+func TestService_VerifyVehicle_SkipsOwnerEvents(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	ownerEvent := event.Event{ID: uuid.New(), VehicleID: vehicle.ID}
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{ownerEvent}}, &mockLedger{
+		creations: map[uint64]*algorand.Transaction{1001: genesis},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	require.NoError(t, err)
+	assert.Empty(t, report.Events)
+}
+
+func TestService_VerifyVehicle_IncludesOwnershipTransfers(t *testing.T) {
+	vehicle, genesis := anchoredVehicle(t)
+	transfer, transferTxn := anchoredEvent(t, vehicle.ID, "TRANSFER-TX")
+	transfer.EntityID = nil
+	transfer.Type = event.TypeOwnershipTransfer
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{transfer}}, &mockLedger{
+		creations:    map[uint64]*algorand.Transaction{1001: genesis},
+		transactions: map[string]*algorand.Transaction{"TRANSFER-TX": transferTxn},
+	}, platformAddress)
+
+	report, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+	require.NoError(t, err)
+	require.Len(t, report.Events, 1)
+	assert.Equal(t, VerdictMatch, report.Events[0].Verdict)
+
+	result, err := svc.VerifyEvent(context.Background(), transfer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMatch, result.Verdict)
+	assert.Nil(t, result.Signature)
+}
+
+func TestService_VerifyEvent_LifecycleChange(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	change, changeTxn := anchoredEvent(t, vehicle.ID, "LIFECYCLE-TX")
+	change.EntityID = nil
+	change.Type = event.TypeLifecycleChange
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{change}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"LIFECYCLE-TX": changeTxn},
+	}, platformAddress)
+
+	result, err := svc.VerifyEvent(context.Background(), change.ID)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMatch, result.Verdict)
+
+	// The anchor is still checked against the hashed record
+	tampered := *changeTxn
+	tampered.Note = []byte("type=new_event|cid=bafyother")
+	svc = NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{change}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"LIFECYCLE-TX": &tampered},
+	}, platformAddress)
+
+	result, err = svc.VerifyEvent(context.Background(), change.ID)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, result.Verdict)
+}
+
+func TestService_VerifyVehicle_LedgerError(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{}, &mockLedger{err: errors.New("indexer down")}, platformAddress)
+
+	_, err := svc.VerifyVehicle(context.Background(), vehicle.ID)
+
+	assert.Error(t, err)
+}
+
+func TestService_VerifyVehicle_NotFound(t *testing.T) {
+	svc := NewService(&mockVehicleRepo{}, &mockEventRepo{}, &mockLedger{}, platformAddress)
+
+	_, err := svc.VerifyVehicle(context.Background(), uuid.New())
+
+	assert.ErrorIs(t, err, vehicles.ErrVehicleNotFound)
+}
+
+func TestService_VerifyEvent_WrongAsset(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	evt, evtTxn := anchoredEvent(t, vehicle.ID, "EVENT-TX")
+	evtTxn.AssetID = 2002
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"EVENT-TX": evtTxn},
+	}, platformAddress)
+
+	result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, result.Verdict)
+}
+
+func TestService_VerifyEvent_PendingIsNotAnchored(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	evt, _ := anchoredEvent(t, vehicle.ID, "EVENT-TX")
+	evt.BlockchainTxID = nil
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{}, platformAddress)
+
+	result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictNotAnchored, result.Verdict)
+}
+
+func TestService_VerifyEvent_OwnerEventHidden(t *testing.T) {
+	ownerEvent := event.Event{ID: uuid.New(), VehicleID: uuid.New()}
+	svc := NewService(&mockVehicleRepo{}, &mockEventRepo{events: []event.Event{ownerEvent}}, &mockLedger{}, platformAddress)
+
+	_, err := svc.VerifyEvent(context.Background(), ownerEvent.ID)
+
+	assert.ErrorIs(t, err, event.ErrEventNotFound)
+}
+
+func TestService_VerifyEvent_MerkleProofMatch(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	evt, rootTxn := merkleAnchoredEvent(t, vehicle.ID, "ROOT-TX")
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"ROOT-TX": rootTxn},
+	}, platformAddress)
+
+	result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMatch, result.Verdict)
+	assert.Equal(t, evt.MerkleProof.Root, *result.MerkleRoot)
+	assert.NotNil(t, result.MerkleProof)
+}
+
+func TestService_VerifyEvent_MerkleProofForOtherCID(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	evt, rootTxn := merkleAnchoredEvent(t, vehicle.ID, "ROOT-TX")
+	otherProof := merkle.Build([]string{"bafyother0", *evt.CID, "bafyother1"}).Proof(0)
+	evt.MerkleProof = &otherProof
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"ROOT-TX": rootTxn},
+	}, platformAddress)
+
+	result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, result.Verdict)
+}
+
+func TestService_VerifyEvent_MerkleRootDiffers(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	evt, rootTxn := merkleAnchoredEvent(t, vehicle.ID, "ROOT-TX")
+	rootTxn.Note = []byte("type=merkle_root|root=" + merkle.Build([]string{"bafyother"}).Root())
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"ROOT-TX": rootTxn},
+	}, platformAddress)
+
+	result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, VerdictMismatch, result.Verdict)
+	assert.Equal(t, "on-chain Merkle root does not match the proof root", *result.Reason)
+}
+
+// signedEvent signs the event's CID with a new key of its entity registered at registeredAt
+func signedEvent(t *testing.T, evt *event.Event, registeredAt time.Time) *signing_keys.Key {
+	t.Helper()
+	pub, priv, err := signing.GenerateKey()
+	require.NoError(t, err)
+
+	key := &signing_keys.Key{
+		ID:        uuid.New(),
+		EntityID:  *evt.EntityID,
+		PublicKey: signing.EncodePublicKey(pub),
+		CreatedAt: registeredAt,
+	}
+	evt.SigningKeyID = &key.ID
+	evt.Signature = ptr(signing.SignCID(priv, *evt.CID))
+	evt.SignedAt = ptr(registeredAt.Add(time.Hour))
+	return key
+}
+
+func TestService_VerifyEvent_Signature(t *testing.T) {
+	registeredAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		modify func(evt *event.Event, key *signing_keys.Key)
+		valid  bool
+	}{
+		{"valid", func(*event.Event, *signing_keys.Key) {}, true},
+		{"key revoked after signing", func(_ *event.Event, key *signing_keys.Key) {
+			key.RevokedAt = ptr(registeredAt.Add(2 * time.Hour))
+		}, true},
+		{"key revoked before signing", func(_ *event.Event, key *signing_keys.Key) {
+			key.RevokedAt = ptr(registeredAt.Add(time.Minute))
+		}, false},
+		{"key of another entity", func(_ *event.Event, key *signing_keys.Key) {
+			key.EntityID = uuid.New()
+		}, false},
+		{"signature of another CID", func(evt *event.Event, _ *signing_keys.Key) {
+			evt.Signature = ptr(base64Signature(t, "bafyother"))
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vehicle, _ := anchoredVehicle(t)
+			evt, evtTxn := anchoredEvent(t, vehicle.ID, "EVENT-TX")
+			key := signedEvent(t, &evt, registeredAt)
+			tt.modify(&evt, key)
+
+			svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+				transactions: map[string]*algorand.Transaction{"EVENT-TX": evtTxn},
+			}, platformAddress)
+			svc.SetKeyResolver(&mockKeyResolver{keys: map[uuid.UUID]*signing_keys.Key{key.ID: key}})
+
+			result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+			require.NoError(t, err)
+			assert.Equal(t, VerdictMatch, result.Verdict)
+			require.NotNil(t, result.Signature)
+			assert.Equal(t, tt.valid, result.Signature.Valid)
+			assert.Equal(t, key.PublicKey, *result.Signature.PublicKey)
+		})
+	}
+}
+
+func TestService_VerifyEvent_SignatureWithUnknownKey(t *testing.T) {
+	vehicle, _ := anchoredVehicle(t)
+	evt, evtTxn := anchoredEvent(t, vehicle.ID, "EVENT-TX")
+	signedEvent(t, &evt, time.Now())
+
+	svc := NewService(&mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{events: []event.Event{evt}}, &mockLedger{
+		transactions: map[string]*algorand.Transaction{"EVENT-TX": evtTxn},
+	}, platformAddress)
+	svc.SetKeyResolver(&mockKeyResolver{})
+
+	result, err := svc.VerifyEvent(context.Background(), evt.ID)
+
+	require.NoError(t, err)
+	require.NotNil(t, result.Signature)
+	assert.False(t, result.Signature.Valid)
+}
+
+func base64Signature(t *testing.T, cid string) string {
+	t.Helper()
+	_, priv, err := signing.GenerateKey()
+	require.NoError(t, err)
+	return signing.SignCID(priv, cid)
+}
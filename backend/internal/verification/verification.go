@@ -0,0 +1,98 @@
+package verification
+
+import (
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+	"github.com/google/uuid"
+)
+
+// Verdict is the outcome of checking a stored record against its on-chain anchor
+type Verdict string
+
+const (
+	VerdictMatch          Verdict = "match"
+	VerdictMismatch       Verdict = "mismatch"
+	VerdictMissingOnChain Verdict = "missing_on_chain"
+	VerdictNotAnchored    Verdict = "not_anchored"
+)
+
+// RecordType identifies which kind of record a verification result refers to
+type RecordType string
+
+const (
+	RecordTypeVehicle        RecordType = "vehicle"
+	RecordTypeVehicleVersion RecordType = "vehicle_version"
+	RecordTypeEvent          RecordType = "event"
+)
+
+// Result is the verification outcome for a single anchored record
+type Result struct {
+	RecordType     RecordType `json:"recordType"`
+	RecordID       uuid.UUID  `json:"recordId"`
+	Verdict        Verdict    `json:"verdict"`
+	Reason         *string    `json:"reason,omitempty"`
+	StoredCID      *string    `json:"storedCid,omitempty"`
+	ComputedCID    *string    `json:"computedCid,omitempty"`
+	OnChainCID     *string    `json:"onChainCid,omitempty"`
+	TxID           *string    `json:"txId,omitempty"`
+	AssetID        *uint64    `json:"assetId,omitempty"`
+	ConfirmedRound *uint64    `json:"confirmedRound,omitempty"`
+	ConfirmedAt    *time.Time `json:"confirmedAt,omitempty"`
+	// MerkleRoot and MerkleProof are set for records anchored under a Merkle root
+	MerkleRoot  *string       `json:"merkleRoot,omitempty"`
+	MerkleProof *merkle.Proof `json:"merkleProof,omitempty"`
+	// Signature is set for events signed by their issuing entity
+	Signature *SignatureResult `json:"signature,omitempty"`
+}
+
+// SignatureResult is the outcome of checking the issuing entity's signature over a record's CID.
+// The signed message is the stored CID, so a valid signature over a record whose anchor matches
+// proves the entity attested the anchored record.
+type SignatureResult struct {
+	Valid     bool       `json:"valid"`
+	Reason    *string    `json:"reason,omitempty"`
+	KeyID     uuid.UUID  `json:"keyId"`
+	EntityID  *uuid.UUID `json:"entityId,omitempty"`
+	PublicKey *string    `json:"publicKey,omitempty"`
+	Signature string     `json:"signature"`
+	SignedAt  *time.Time `json:"signedAt,omitempty"`
+	// KeyRevokedAt is set when the key has since been revoked; signatures made before remain valid
+	KeyRevokedAt *time.Time `json:"keyRevokedAt,omitempty"`
+}
+
+// VehicleReport holds the verification results for a vehicle, the versions of its record and its
+// certified events
+type VehicleReport struct {
+	Vehicle  Result   `json:"vehicle"`
+	Versions []Result `json:"versions"`
+	Events   []Result `json:"events"`
+}
+
+// ChainLink is a record in a vehicle's hash-linked chain
+type ChainLink struct {
+	RecordType       RecordType `json:"recordType"`
+	RecordID         uuid.UUID  `json:"recordId"`
+	CID              string     `json:"cid"`
+	PreviousCID      *string    `json:"previousCid,omitempty"`
+	BlockchainStatus string     `json:"blockchainStatus"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+// ChainIssue describes a break in a vehicle's chain
+type ChainIssue struct {
+	RecordType RecordType `json:"recordType,omitempty"`
+	RecordID   *uuid.UUID `json:"recordId,omitempty"`
+	CID        *string    `json:"cid,omitempty"`
+	Reason     string     `json:"reason"`
+}
+
+// ChainReport is the result of walking a vehicle's chain. Links are ordered from genesis to head.
+type ChainReport struct {
+	VehicleID  uuid.UUID    `json:"vehicleId"`
+	GenesisCID *string      `json:"genesisCid,omitempty"`
+	HeadCID    *string      `json:"headCid,omitempty"`
+	Valid      bool         `json:"valid"`
+	Links      []ChainLink  `json:"links"`
+	Issues     []ChainIssue `json:"issues"`
+}
@@ -0,0 +1,15 @@
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    subject TEXT NOT NULL,
+    payload BYTEA NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_outbox_unpublished ON outbox(id) WHERE published_at IS NULL;
+
+---- create above / drop below ----
+
+DROP TABLE outbox;
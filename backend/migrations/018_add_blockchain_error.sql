@@ -0,0 +1,18 @@
+ALTER TABLE vehicles ADD COLUMN blockchain_error TEXT NULL;
+ALTER TABLE vehicles ADD COLUMN blockchain_status_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE events ADD COLUMN blockchain_error TEXT NULL;
+ALTER TABLE events ADD COLUMN blockchain_status_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX idx_vehicles_blockchain_status ON vehicles(blockchain_status, blockchain_status_at)
+    WHERE blockchain_status IN ('pending', 'failed');
+CREATE INDEX idx_events_blockchain_status ON events(blockchain_status, blockchain_status_at)
+    WHERE blockchain_status IN ('pending', 'failed');
+
+---- create above / drop below ----
+
+DROP INDEX idx_events_blockchain_status;
+DROP INDEX idx_vehicles_blockchain_status;
+ALTER TABLE events DROP COLUMN blockchain_status_at;
+ALTER TABLE events DROP COLUMN blockchain_error;
+ALTER TABLE vehicles DROP COLUMN blockchain_status_at;
+ALTER TABLE vehicles DROP COLUMN blockchain_error;
@@ -0,0 +1,7 @@
+ALTER TABLE vehicles ADD COLUMN genesis_tx_id TEXT NULL;
+ALTER TABLE vehicles ADD COLUMN genesis_last_valid_round BIGINT NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE vehicles DROP COLUMN genesis_last_valid_round;
+ALTER TABLE vehicles DROP COLUMN genesis_tx_id;
@@ -0,0 +1,24 @@
+-- Revisions of a vehicle record after genesis. Each version is anchored on the vehicle's asset
+-- with a vehicle_update note and links to the CID it supersedes.
+CREATE TABLE vehicle_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    cid TEXT NOT NULL,
+    previous_cid TEXT NOT NULL,
+    cid_source_json TEXT NOT NULL,
+    cid_source_cbor_b64 TEXT NOT NULL,
+    blockchain_tx_id TEXT NULL,
+    blockchain_status TEXT NOT NULL DEFAULT 'pending',
+    blockchain_error TEXT NULL,
+    blockchain_status_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (vehicle_id, version)
+);
+
+CREATE INDEX idx_vehicle_versions_blockchain_status ON vehicle_versions(blockchain_status, blockchain_status_at)
+    WHERE blockchain_status IN ('pending', 'failed');
+
+---- create above / drop below ----
+
+DROP TABLE vehicle_versions;
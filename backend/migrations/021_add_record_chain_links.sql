@@ -0,0 +1,16 @@
+-- Records of a vehicle form a hash-linked chain: genesis, then vehicle versions and events, each
+-- linking to the CID of the record before it. chain_head_cid is the CID of the latest record.
+ALTER TABLE vehicles ADD COLUMN chain_head_cid TEXT NULL;
+ALTER TABLE events ADD COLUMN previous_cid TEXT NULL;
+
+UPDATE vehicles v
+SET chain_head_cid = COALESCE(
+    (SELECT vv.cid FROM vehicle_versions vv WHERE vv.vehicle_id = v.id ORDER BY vv.version DESC LIMIT 1),
+    v.cid
+)
+WHERE v.cid IS NOT NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE events DROP COLUMN previous_cid;
+ALTER TABLE vehicles DROP COLUMN chain_head_cid;
@@ -0,0 +1,7 @@
+-- Events anchored in Merkle mode share a single on-chain root. merkle_proof holds the inclusion
+-- proof from the event CID to the root written by the transaction in blockchain_tx_id.
+ALTER TABLE events ADD COLUMN merkle_proof JSONB NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE events DROP COLUMN merkle_proof;
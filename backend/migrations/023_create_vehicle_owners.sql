@@ -0,0 +1,22 @@
+-- Ownership periods of a vehicle. The period of the current owner has no end date.
+CREATE TABLE vehicle_owners (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    owner_id UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_vehicle_owners_vehicle_id ON vehicle_owners(vehicle_id, start_date);
+CREATE UNIQUE INDEX idx_vehicle_owners_current ON vehicle_owners(vehicle_id) WHERE end_date IS NULL;
+
+-- The start of existing ownerships is unknown, so they are backdated to when the vehicle was registered
+INSERT INTO vehicle_owners (vehicle_id, owner_id, start_date)
+SELECT id, owner_id, created_at
+FROM vehicles
+WHERE owner_id IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP TABLE vehicle_owners;
@@ -0,0 +1,22 @@
+-- Transfers of a vehicle from its current owner to the holder of an email address, who accepts
+-- through the emailed token
+CREATE TABLE vehicle_ownership_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    from_owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_email TEXT NOT NULL,
+    to_owner_id UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    token TEXT NOT NULL UNIQUE,
+    token_expires_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'cancelled')),
+    event_id UUID NULL REFERENCES events(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_vehicle_ownership_transfers_vehicle_id ON vehicle_ownership_transfers(vehicle_id, created_at);
+CREATE UNIQUE INDEX idx_vehicle_ownership_transfers_pending ON vehicle_ownership_transfers(vehicle_id) WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP TABLE vehicle_ownership_transfers;
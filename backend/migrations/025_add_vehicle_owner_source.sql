@@ -0,0 +1,16 @@
+-- How an ownership period started: the vehicle was registered with an owner, an invitation was
+-- claimed, an ownership transfer was accepted, or the owner was reassigned directly.
+ALTER TABLE vehicle_owners ADD COLUMN source TEXT NOT NULL DEFAULT 'registration'
+    CHECK (source IN ('registration', 'invitation', 'transfer', 'reassignment'));
+
+UPDATE vehicle_owners vo
+SET source = 'transfer'
+FROM vehicle_ownership_transfers t
+WHERE t.vehicle_id = vo.vehicle_id
+  AND t.status = 'accepted'
+  AND t.to_owner_id = vo.owner_id
+  AND t.completed_at = vo.start_date;
+
+---- create above / drop below ----
+
+ALTER TABLE vehicle_owners DROP COLUMN source;
@@ -0,0 +1,20 @@
+-- Anchored events are immutable. A correction is stored as an amendment and a withdrawn
+-- certification as a revocation; both are events of their own in the vehicle's chain that point
+-- at the original event they revise.
+ALTER TABLE events ADD COLUMN kind TEXT NOT NULL DEFAULT 'original'
+    CHECK (kind IN ('original', 'amendment', 'revocation'));
+ALTER TABLE events ADD COLUMN revises_event_id UUID NULL REFERENCES events(id) ON DELETE CASCADE;
+ALTER TABLE events ADD COLUMN reason TEXT NULL;
+
+ALTER TABLE events ADD CONSTRAINT events_revision_target
+    CHECK ((kind = 'original') = (revises_event_id IS NULL));
+
+CREATE INDEX idx_events_revises_event_id ON events(revises_event_id) WHERE revises_event_id IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP INDEX idx_events_revises_event_id;
+ALTER TABLE events DROP CONSTRAINT events_revision_target;
+ALTER TABLE events DROP COLUMN reason;
+ALTER TABLE events DROP COLUMN revises_event_id;
+ALTER TABLE events DROP COLUMN kind;
@@ -0,0 +1,16 @@
+-- Entities can require the vehicle owner's approval before events of the listed types become part
+-- of the vehicle's record. Such events start out proposed and are only anchored once accepted.
+ALTER TABLE entities ADD COLUMN owner_approval_event_types TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE events ADD COLUMN approval_status TEXT NOT NULL DEFAULT 'not_required'
+    CHECK (approval_status IN ('not_required', 'proposed', 'accepted', 'rejected'));
+ALTER TABLE events ADD COLUMN approval_decided_at TIMESTAMPTZ NULL;
+
+CREATE INDEX idx_events_proposed ON events(vehicle_id) WHERE approval_status = 'proposed';
+
+---- create above / drop below ----
+
+DROP INDEX idx_events_proposed;
+ALTER TABLE events DROP COLUMN approval_decided_at;
+ALTER TABLE events DROP COLUMN approval_status;
+ALTER TABLE entities DROP COLUMN owner_approval_event_types;
@@ -0,0 +1,25 @@
+-- Requests from vehicle owners for a certifier entity to verify one of their own events. An
+-- endorsed request links to the certified event the entity recorded for it.
+CREATE TABLE event_certification_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+    requested_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    evidence_session_id UUID NULL,
+    message TEXT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'endorsed', 'declined')),
+    decided_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    decline_reason TEXT NULL,
+    certified_event_id UUID NULL REFERENCES events(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_event_certification_requests_event_id ON event_certification_requests(event_id, created_at);
+CREATE INDEX idx_event_certification_requests_entity_id ON event_certification_requests(entity_id, status, created_at);
+CREATE UNIQUE INDEX idx_event_certification_requests_pending ON event_certification_requests(event_id, entity_id) WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP TABLE event_certification_requests;
@@ -0,0 +1,23 @@
+-- Event types defined by entities for their own events. Events refer to them by the namespaced
+-- name "<entity_id>:<key>". The key and metadata schema are fixed once registered, as the CID of
+-- the definition is recorded with every event of the type; retired types keep resolving for the
+-- events already issued with them.
+CREATE TABLE entity_event_types (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    icon TEXT NULL,
+    metadata_schema JSONB NOT NULL,
+    definition_cid TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    retired_at TIMESTAMPTZ NULL,
+    UNIQUE (entity_id, key)
+);
+
+CREATE INDEX idx_entity_event_types_active ON entity_event_types(entity_id) WHERE retired_at IS NULL;
+
+---- create above / drop below ----
+
+DROP TABLE entity_event_types;
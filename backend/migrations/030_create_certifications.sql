@@ -0,0 +1,56 @@
+-- Validity windows of the certification events issued by entities. A certification is valid from
+-- its event date until its validity end date (indefinitely when it has none); amendments move the
+-- window and revocations end it. last_reminder_days records the shortest reminder lead time
+-- already sent, so each reminder goes out once per window.
+CREATE TABLE certifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL UNIQUE REFERENCES events(id) ON DELETE CASCADE,
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+    certificate_number TEXT NULL,
+    valid_from DATE NOT NULL,
+    valid_until DATE NULL,
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'expired', 'revoked')),
+    last_reminder_days INT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_certifications_vehicle_id ON certifications(vehicle_id);
+CREATE INDEX idx_certifications_active_until ON certifications(valid_until) WHERE status = 'active';
+
+-- Track the certifications already on record, using the content of their latest amendment
+INSERT INTO certifications (event_id, vehicle_id, entity_id, certificate_number, valid_from, valid_until, status)
+SELECT
+    e.id,
+    e.vehicle_id,
+    e.entity_id,
+    effective.metadata->>'certificateNumber',
+    effective.event_date,
+    CASE WHEN effective.metadata->>'validityEndDate' ~ '^\d{4}-\d{2}-\d{2}$'
+        THEN (effective.metadata->>'validityEndDate')::date END,
+    CASE
+        WHEN EXISTS (SELECT 1 FROM events r WHERE r.revises_event_id = e.id AND r.kind = 'revocation') THEN 'revoked'
+        WHEN effective.metadata->>'validityEndDate' ~ '^\d{4}-\d{2}-\d{2}$'
+            AND (effective.metadata->>'validityEndDate')::date < CURRENT_DATE THEN 'expired'
+        ELSE 'active'
+    END
+FROM events e
+CROSS JOIN LATERAL (
+    SELECT COALESCE(a.metadata, e.metadata) AS metadata, COALESCE(a.event_date, e.event_date) AS event_date
+    FROM (SELECT 1) one
+    LEFT JOIN LATERAL (
+        SELECT metadata, event_date FROM events
+        WHERE revises_event_id = e.id AND kind = 'amendment'
+        ORDER BY created_at DESC
+        LIMIT 1
+    ) a ON TRUE
+) effective
+WHERE e.event_type = 'certification'
+  AND e.kind = 'original'
+  AND e.entity_id IS NOT NULL
+  AND e.approval_status IN ('not_required', 'accepted');
+
+---- create above / drop below ----
+
+DROP TABLE certifications;
@@ -0,0 +1,29 @@
+-- Every transaction submitted to anchor a record, including the ones that failed, with the
+-- outcome reported by the ledger. Records anchored under a Merkle root share its transaction, so
+-- fees are reconciled per distinct tx_id.
+CREATE TABLE anchors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    record_type TEXT NOT NULL CHECK (record_type IN ('vehicle', 'vehicle_version', 'event')),
+    event_id UUID NULL REFERENCES events(id) ON DELETE CASCADE,
+    vehicle_version_id UUID NULL REFERENCES vehicle_versions(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('confirmed', 'failed')),
+    error TEXT NULL,
+    network TEXT NOT NULL,
+    sender TEXT NOT NULL,
+    tx_id TEXT NULL,
+    tx_type TEXT NULL,
+    asset_id BIGINT NULL,
+    confirmed_round BIGINT NULL,
+    round_time TIMESTAMPTZ NULL,
+    fee_microalgos BIGINT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_anchors_vehicle_id ON anchors(vehicle_id, created_at DESC);
+CREATE INDEX idx_anchors_event_id ON anchors(event_id) WHERE event_id IS NOT NULL;
+CREATE INDEX idx_anchors_tx_id ON anchors(tx_id) WHERE tx_id IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP TABLE anchors;
@@ -0,0 +1,31 @@
+-- Ed25519 keys entities sign the CIDs of their events with. Managed keys are generated by the
+-- platform and their private key is stored sealed with the server's secret; other keys are held by
+-- the entity, which signs client-side. Keys are revoked rather than deleted, so signatures made
+-- before the revocation can still be checked.
+CREATE TABLE entity_signing_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_id UUID NOT NULL REFERENCES entities(id) ON DELETE CASCADE,
+    label TEXT NOT NULL,
+    algorithm TEXT NOT NULL DEFAULT 'ed25519' CHECK (algorithm IN ('ed25519')),
+    public_key TEXT NOT NULL UNIQUE,
+    sealed_private_key TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_entity_signing_keys_entity ON entity_signing_keys(entity_id);
+
+-- The signature is over the event's CID, made with one of the issuing entity's keys
+ALTER TABLE events
+    ADD COLUMN signing_key_id UUID NULL REFERENCES entity_signing_keys(id),
+    ADD COLUMN signature TEXT NULL,
+    ADD COLUMN signed_at TIMESTAMPTZ NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE events
+    DROP COLUMN signed_at,
+    DROP COLUMN signature,
+    DROP COLUMN signing_key_id;
+
+DROP TABLE entity_signing_keys;
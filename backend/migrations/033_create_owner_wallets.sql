@@ -0,0 +1,37 @@
+-- Algorand addresses owners linked to their account by signing a challenge. Vehicle assets are
+-- delivered to the linked wallet, so an address can only be linked to one account.
+CREATE TABLE user_wallets (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    address TEXT NOT NULL UNIQUE,
+    linked_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Messages issued for an owner to sign with the wallet being linked. Each is used once.
+CREATE TABLE wallet_challenges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    address TEXT NOT NULL,
+    message TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_wallet_challenges_user ON wallet_challenges(user_id, address, created_at DESC);
+
+-- Who holds each vehicle's asset. Vehicles without a row, or with a NULL holder, are held by the
+-- platform account. A pending row has a custody transfer queued for the worker.
+CREATE TABLE vehicle_custody (
+    vehicle_id UUID PRIMARY KEY REFERENCES vehicles(id) ON DELETE CASCADE,
+    holder_address TEXT NULL,
+    status TEXT NOT NULL CHECK (status IN ('platform', 'owner', 'pending', 'failed')),
+    tx_id TEXT NULL,
+    error TEXT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+---- create above / drop below ----
+
+DROP TABLE vehicle_custody;
+DROP TABLE wallet_challenges;
+DROP TABLE user_wallets;
@@ -0,0 +1,35 @@
+ALTER TABLE vehicles ADD COLUMN lifecycle_status TEXT NOT NULL DEFAULT 'active'
+    CHECK (lifecycle_status IN ('active', 'stolen', 'scrapped', 'exported', 'archived'));
+ALTER TABLE vehicles ADD COLUMN lifecycle_status_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Requests to move a vehicle to another lifecycle status. They are decided by an admin, or by the
+-- certifier entity they were addressed to. An approved request links to the anchored
+-- lifecycle_change event, and tracks the freeze or destroy it required on the vehicle's asset.
+CREATE TABLE vehicle_lifecycle_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    requested_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    entity_id UUID NULL REFERENCES entities(id) ON DELETE SET NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    decided_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    decline_reason TEXT NULL,
+    event_id UUID NULL REFERENCES events(id) ON DELETE SET NULL,
+    asset_status TEXT NULL CHECK (asset_status IN ('pending', 'done', 'failed')),
+    asset_tx_id TEXT NULL,
+    asset_error TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_vehicle_lifecycle_requests_vehicle_id ON vehicle_lifecycle_requests(vehicle_id, created_at);
+CREATE INDEX idx_vehicle_lifecycle_requests_status ON vehicle_lifecycle_requests(status, created_at);
+CREATE UNIQUE INDEX idx_vehicle_lifecycle_requests_pending ON vehicle_lifecycle_requests(vehicle_id) WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP TABLE vehicle_lifecycle_requests;
+ALTER TABLE vehicles DROP COLUMN lifecycle_status_at;
+ALTER TABLE vehicles DROP COLUMN lifecycle_status;
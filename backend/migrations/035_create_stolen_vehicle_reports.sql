@@ -0,0 +1,31 @@
+-- Reports of stolen or missing vehicles, filed by owners and decided by admins. Approving a report
+-- moves the vehicle to the stolen lifecycle status through the lifecycle request it links to, and
+-- resolving it once the vehicle is recovered moves it back.
+CREATE TABLE stolen_vehicle_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    vehicle_id UUID NOT NULL REFERENCES vehicles(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('stolen', 'missing')),
+    police_reference TEXT NULL,
+    police_authority TEXT NULL,
+    incident_date DATE NULL,
+    location TEXT NULL,
+    description TEXT NOT NULL,
+    reported_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'resolved')),
+    decided_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    decline_reason TEXT NULL,
+    lifecycle_request_id UUID NULL REFERENCES vehicle_lifecycle_requests(id) ON DELETE SET NULL,
+    resolved_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    resolution_note TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMPTZ NULL,
+    resolved_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX idx_stolen_vehicle_reports_vehicle_id ON stolen_vehicle_reports(vehicle_id, created_at);
+CREATE INDEX idx_stolen_vehicle_reports_status ON stolen_vehicle_reports(status, created_at);
+CREATE UNIQUE INDEX idx_stolen_vehicle_reports_open ON stolen_vehicle_reports(vehicle_id) WHERE status IN ('pending', 'approved');
+
+---- create above / drop below ----
+
+DROP TABLE stolen_vehicle_reports;
@@ -0,0 +1,12 @@
+-- Messages the queue rejected are retried with a backoff, so one failing message does not hold
+-- back the rest of the outbox
+ALTER TABLE outbox ADD COLUMN next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+DROP INDEX idx_outbox_unpublished;
+CREATE INDEX idx_outbox_unpublished ON outbox(next_attempt_at, id) WHERE published_at IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX idx_outbox_unpublished;
+CREATE INDEX idx_outbox_unpublished ON outbox(id) WHERE published_at IS NULL;
+ALTER TABLE outbox DROP COLUMN next_attempt_at;
@@ -2,6 +2,7 @@ package algorand
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
@@ -22,11 +23,32 @@ type AssetParams struct {
 	Note          []byte
 }
 
+// assetCreationValidRounds bounds how long a signed asset creation can still be confirmed.
+// Kept short so an unconfirmed genesis can be retried safely within minutes.
+const assetCreationValidRounds = 100
+
 func (c *Client) CreateAsset(ctx context.Context, params AssetParams) (uint64, string, error) {
+	stxn, err := c.SignAssetCreation(ctx, params)
+	if err != nil {
+		return 0, "", err
+	}
+
+	confirmed, err := c.SubmitAssetCreation(ctx, stxn)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return confirmed.AssetID, stxn.ID, nil
+}
+
+// SignAssetCreation builds and signs an asset creation transaction without submitting it,
+// so the transaction ID can be recorded before the asset exists.
+func (c *Client) SignAssetCreation(ctx context.Context, params AssetParams) (*SignedTransaction, error) {
 	txParams, err := c.SuggestedParams(ctx)
 	if err != nil {
-		return 0, "", fmt.Errorf("get transaction params: %w", err)
+		return nil, fmt.Errorf("get transaction params: %w", err)
 	}
+	txParams.LastRoundValid = txParams.FirstRoundValid + assetCreationValidRounds
 
 	manager := params.Manager
 	if manager == "" {
@@ -62,20 +84,60 @@ func (c *Client) CreateAsset(ctx context.Context, params AssetParams) (uint64, s
 		"",
 	)
 	if err != nil {
-		return 0, "", fmt.Errorf("create asset transaction: %w", err)
+		return nil, fmt.Errorf("create asset transaction: %w", err)
 	}
 
-	txID, err := c.SendTransaction(ctx, txn)
+	return c.SignTransaction(txn)
+}
+
+// SubmitAssetCreation submits a signed asset creation and returns it once confirmed, with the ID
+// of the new asset
+func (c *Client) SubmitAssetCreation(ctx context.Context, stxn *SignedTransaction) (*Transaction, error) {
+	info, err := c.SubmitTransaction(ctx, stxn)
 	if err != nil {
-		return 0, "", fmt.Errorf("send asset creation: %w", err)
+		return nil, &SubmitError{TxIDs: []string{stxn.ID}, Err: fmt.Errorf("send asset creation: %w", err)}
 	}
 
-	pendingTxn, _, err := c.algod.PendingTransactionInformation(txID).Do(ctx)
-	if err != nil {
-		return 0, "", fmt.Errorf("get pending transaction: %w", err)
+	confirmed := confirmedTransaction(stxn.ID, info.Transaction.Txn, info.ConfirmedRound, c.roundTime(ctx, info.ConfirmedRound))
+	confirmed.AssetID = info.AssetIndex
+	return &confirmed, nil
+}
+
+// AssetCreatedBy resolves the asset created by a previously signed asset creation transaction.
+// It returns ErrTransactionPending while the transaction may still be confirmed and
+// ErrTransactionExpired once it can no longer be.
+func (c *Client) AssetCreatedBy(ctx context.Context, txID string, lastValid uint64) (uint64, error) {
+	info, _, err := c.algod.PendingTransactionInformation(txID).Do(ctx)
+	if err == nil {
+		if info.ConfirmedRound > 0 {
+			return info.AssetIndex, nil
+		}
+		if info.PoolError != "" {
+			return 0, fmt.Errorf("%w: %s", ErrTransactionExpired, info.PoolError)
+		}
+		return 0, ErrTransactionPending
+	}
+	if !isNotFound(err) {
+		return 0, fmt.Errorf("get pending transaction %s: %w", txID, err)
+	}
+
+	// algod only remembers recent transactions, older ones are resolved through the indexer
+	txn, err := c.LookupTransaction(ctx, txID)
+	if err == nil {
+		return txn.AssetID, nil
+	}
+	if !errors.Is(err, ErrTransactionNotFound) {
+		return 0, err
 	}
 
-	return pendingTxn.AssetIndex, txID, nil
+	status, err := c.algod.Status().Do(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get node status: %w", err)
+	}
+	if status.LastRound > lastValid {
+		return 0, ErrTransactionExpired
+	}
+	return 0, ErrTransactionPending
 }
 
 func (c *Client) TransferAsset(ctx context.Context, assetID uint64, recipient string, amount uint64, note []byte) (string, error) {
@@ -102,14 +164,183 @@ func (c *Client) TransferAsset(ctx context.Context, assetID uint64, recipient st
 		return "", fmt.Errorf("create transfer transaction: %w", err)
 	}
 
-	txID, err := c.SendTransaction(ctx, txn)
+	confirmed, err := c.SendTransaction(ctx, txn)
 	if err != nil {
 		return "", fmt.Errorf("send transfer: %w", err)
 	}
 
-	return txID, nil
+	return confirmed.ID, nil
+}
+
+// ClawbackAsset moves units of the asset from holder to recipient using the platform account's
+// clawback authority. The holder's consent is not needed; the recipient must have opted in.
+func (c *Client) ClawbackAsset(ctx context.Context, assetID uint64, holder, recipient string, amount uint64, note []byte) (string, error) {
+	txParams, err := c.SuggestedParams(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get transaction params: %w", err)
+	}
+
+	if err := ValidateAddress(holder); err != nil {
+		return "", fmt.Errorf("invalid holder: %w", err)
+	}
+	if err := ValidateAddress(recipient); err != nil {
+		return "", fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	txn, err := transaction.MakeAssetRevocationTxn(
+		c.account.Address.String(),
+		holder,
+		amount,
+		recipient,
+		note,
+		txParams,
+		assetID,
+	)
+	if err != nil {
+		return "", fmt.Errorf("create clawback transaction: %w", err)
+	}
+
+	confirmed, err := c.SendTransaction(ctx, txn)
+	if err != nil {
+		return "", fmt.Errorf("send clawback: %w", err)
+	}
+
+	return confirmed.ID, nil
+}
+
+// FreezeAsset freezes or unfreezes the holder's units of the asset using the platform account's
+// freeze authority. Frozen units cannot be sent until they are unfrozen, although the clawback
+// authority can still revoke them.
+func (c *Client) FreezeAsset(ctx context.Context, assetID uint64, holder string, frozen bool, note []byte) (string, error) {
+	txParams, err := c.SuggestedParams(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get transaction params: %w", err)
+	}
+
+	if err := ValidateAddress(holder); err != nil {
+		return "", fmt.Errorf("invalid holder: %w", err)
+	}
+
+	txn, err := transaction.MakeAssetFreezeTxn(
+		c.account.Address.String(),
+		note,
+		txParams,
+		assetID,
+		holder,
+		frozen,
+	)
+	if err != nil {
+		return "", fmt.Errorf("create freeze transaction: %w", err)
+	}
+
+	confirmed, err := c.SendTransaction(ctx, txn)
+	if err != nil {
+		return "", fmt.Errorf("send freeze: %w", err)
+	}
+
+	return confirmed.ID, nil
+}
+
+// DestroyAsset destroys the asset using the platform account's manager authority. The whole
+// supply must be back in the platform account.
+func (c *Client) DestroyAsset(ctx context.Context, assetID uint64, note []byte) (string, error) {
+	txParams, err := c.SuggestedParams(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get transaction params: %w", err)
+	}
+
+	txn, err := transaction.MakeAssetDestroyTxn(c.account.Address.String(), note, txParams, assetID)
+	if err != nil {
+		return "", fmt.Errorf("create destroy transaction: %w", err)
+	}
+
+	confirmed, err := c.SendTransaction(ctx, txn)
+	if err != nil {
+		return "", fmt.Errorf("send destroy: %w", err)
+	}
+
+	return confirmed.ID, nil
+}
+
+// AssetHolding is an account's holding of an asset
+type AssetHolding struct {
+	// OptedIn reports whether the account can receive the asset
+	OptedIn bool
+	Amount  uint64
+	Frozen  bool
+}
+
+// AssetHolding returns the account's holding of the asset. Accounts that have not opted in are
+// reported with OptedIn false rather than an error.
+func (c *Client) AssetHolding(ctx context.Context, address string, assetID uint64) (*AssetHolding, error) {
+	resp, err := c.algod.AccountAssetInformation(address, assetID).Do(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return &AssetHolding{}, nil
+		}
+		return nil, fmt.Errorf("get holding of asset %d by %s: %w", assetID, address, err)
+	}
+
+	return &AssetHolding{
+		OptedIn: true,
+		Amount:  resp.AssetHolding.Amount,
+		Frozen:  resp.AssetHolding.IsFrozen,
+	}, nil
 }
 
 func (c *Client) SelfTransferAsset(ctx context.Context, assetID uint64, note []byte) (string, error) {
 	return c.TransferAsset(ctx, assetID, c.account.Address.String(), 0, note)
 }
+
+// SelfTransfer is a zero-amount transfer of an asset back to the platform account, carrying a note
+type SelfTransfer struct {
+	AssetID uint64
+	Note    []byte
+}
+
+// SelfTransferAssets sends up to MaxGroupSize self-transfers as a single atomic group.
+// The confirmed transactions are returned in the order of transfers.
+func (c *Client) SelfTransferAssets(ctx context.Context, transfers []SelfTransfer) ([]Transaction, error) {
+	txParams, err := c.SuggestedParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get transaction params: %w", err)
+	}
+
+	sender := c.account.Address.String()
+	txns := make([]types.Transaction, len(transfers))
+	for i, t := range transfers {
+		txns[i], err = transaction.MakeAssetTransferTxn(sender, sender, 0, t.Note, txParams, "", t.AssetID)
+		if err != nil {
+			return nil, fmt.Errorf("create transfer transaction: %w", err)
+		}
+	}
+
+	confirmed, err := c.SendTransactionGroup(ctx, txns)
+	if err != nil {
+		return nil, fmt.Errorf("send transfers: %w", err)
+	}
+
+	return confirmed, nil
+}
+
+// SelfPayment sends a zero-amount payment from the platform account to itself carrying the note.
+// It anchors data that does not belong to a single asset.
+func (c *Client) SelfPayment(ctx context.Context, note []byte) (*Transaction, error) {
+	txParams, err := c.SuggestedParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get transaction params: %w", err)
+	}
+
+	sender := c.account.Address.String()
+	txn, err := transaction.MakePaymentTxn(sender, sender, 0, note, "", txParams)
+	if err != nil {
+		return nil, fmt.Errorf("create payment transaction: %w", err)
+	}
+
+	confirmed, err := c.SendTransaction(ctx, txn)
+	if err != nil {
+		return nil, fmt.Errorf("send payment: %w", err)
+	}
+
+	return confirmed, nil
+}
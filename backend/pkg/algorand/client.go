@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/ed25519"
 	"fmt"
+	"time"
 
 	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
 	"github.com/algorand/go-algorand-sdk/v2/client/v2/indexer"
 	"github.com/algorand/go-algorand-sdk/v2/crypto"
 	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
@@ -77,23 +79,148 @@ func (c *Client) SuggestedParams(ctx context.Context) (types.SuggestedParams, er
 	return params, nil
 }
 
-func (c *Client) SendTransaction(ctx context.Context, txn types.Transaction) (string, error) {
-	_, signedTxn, err := crypto.SignTransaction(c.account.PrivateKey, txn)
+// SignedTransaction is a transaction signed by the platform account but not necessarily submitted.
+// Its ID is known before submission and it can only be confirmed up to LastValid.
+type SignedTransaction struct {
+	ID        string
+	LastValid uint64
+	Bytes     []byte
+}
+
+func (c *Client) SignTransaction(txn types.Transaction) (*SignedTransaction, error) {
+	txID, signedTxn, err := crypto.SignTransaction(c.account.PrivateKey, txn)
+	if err != nil {
+		return nil, fmt.Errorf("sign transaction: %w", err)
+	}
+
+	return &SignedTransaction{ID: txID, LastValid: uint64(txn.LastValid), Bytes: signedTxn}, nil
+}
+
+// SubmitTransaction sends a signed transaction and waits for it to be confirmed
+func (c *Client) SubmitTransaction(ctx context.Context, stxn *SignedTransaction) (models.PendingTransactionInfoResponse, error) {
+	txID, err := c.algod.SendRawTransaction(stxn.Bytes).Do(ctx)
+	if err != nil {
+		return models.PendingTransactionInfoResponse{}, fmt.Errorf("send transaction: %w", err)
+	}
+
+	info, err := transaction.WaitForConfirmation(c.algod, txID, 4, ctx)
+	if err != nil {
+		return models.PendingTransactionInfoResponse{}, fmt.Errorf("wait for confirmation: %w", err)
+	}
+
+	return info, nil
+}
+
+// SendTransaction signs and submits a transaction and returns it once confirmed
+func (c *Client) SendTransaction(ctx context.Context, txn types.Transaction) (*Transaction, error) {
+	stxn, err := c.SignTransaction(txn)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := c.SubmitTransaction(ctx, stxn)
 	if err != nil {
-		return "", fmt.Errorf("sign transaction: %w", err)
+		return nil, &SubmitError{TxIDs: []string{stxn.ID}, Err: err}
+	}
+
+	confirmed := confirmedTransaction(stxn.ID, txn, info.ConfirmedRound, c.roundTime(ctx, info.ConfirmedRound))
+	return &confirmed, nil
+}
+
+// MaxGroupSize is the largest number of transactions Algorand accepts in an atomic group
+const MaxGroupSize = 16
+
+// SendTransactionGroup submits the transactions as one atomic group and waits for it to be
+// confirmed: either every transaction is confirmed in the same round or none is.
+// The confirmed transactions are returned in the order of txns.
+func (c *Client) SendTransactionGroup(ctx context.Context, txns []types.Transaction) ([]Transaction, error) {
+	if len(txns) > MaxGroupSize {
+		return nil, fmt.Errorf("transaction group of %d exceeds the maximum of %d", len(txns), MaxGroupSize)
+	}
+	if len(txns) == 1 {
+		confirmed, err := c.SendTransaction(ctx, txns[0])
+		if err != nil {
+			return nil, err
+		}
+		return []Transaction{*confirmed}, nil
 	}
 
-	txID, err := c.algod.SendRawTransaction(signedTxn).Do(ctx)
+	groupID, err := crypto.ComputeGroupID(txns)
 	if err != nil {
-		return "", fmt.Errorf("send transaction: %w", err)
+		return nil, fmt.Errorf("compute group id: %w", err)
+	}
+
+	txIDs := make([]string, len(txns))
+	var group []byte
+	for i := range txns {
+		txns[i].Group = groupID
+		stxn, err := c.SignTransaction(txns[i])
+		if err != nil {
+			return nil, err
+		}
+		txIDs[i] = stxn.ID
+		group = append(group, stxn.Bytes...)
 	}
 
-	_, err = transaction.WaitForConfirmation(c.algod, txID, 4, ctx)
+	if _, err := c.algod.SendRawTransaction(group).Do(ctx); err != nil {
+		return nil, &SubmitError{TxIDs: txIDs, Err: fmt.Errorf("send transaction group: %w", err)}
+	}
+
+	// The group is confirmed atomically, so waiting for one transaction is enough
+	info, err := transaction.WaitForConfirmation(c.algod, txIDs[0], 4, ctx)
 	if err != nil {
-		return "", fmt.Errorf("wait for confirmation: %w", err)
+		return nil, &SubmitError{TxIDs: txIDs, Err: fmt.Errorf("wait for confirmation: %w", err)}
+	}
+
+	roundTime := c.roundTime(ctx, info.ConfirmedRound)
+	confirmed := make([]Transaction, len(txns))
+	for i, txn := range txns {
+		confirmed[i] = confirmedTransaction(txIDs[i], txn, info.ConfirmedRound, roundTime)
+	}
+	return confirmed, nil
+}
+
+// SubmitError is returned when signed transactions were sent but could not be confirmed. They
+// may still be confirmed later, so their IDs are kept for reconciliation.
+type SubmitError struct {
+	TxIDs []string
+	Err   error
+}
+
+func (e *SubmitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SubmitError) Unwrap() error {
+	return e.Err
+}
+
+// confirmedTransaction describes a transaction confirmed in round as the indexer would
+func confirmedTransaction(txID string, txn types.Transaction, round uint64, roundTime time.Time) Transaction {
+	assetID := uint64(txn.XferAsset)
+	if txn.Type == types.AssetConfigTx {
+		assetID = uint64(txn.ConfigAsset)
+	}
+
+	return Transaction{
+		ID:             txID,
+		Type:           string(txn.Type),
+		Sender:         txn.Sender.String(),
+		AssetID:        assetID,
+		Note:           txn.Note,
+		Fee:            uint64(txn.Fee),
+		ConfirmedRound: round,
+		RoundTime:      roundTime,
 	}
+}
 
-	return txID, nil
+// roundTime returns the timestamp of a round's block, or the zero time if it cannot be fetched
+func (c *Client) roundTime(ctx context.Context, round uint64) time.Time {
+	block, err := c.algod.Block(round).HeaderOnly(true).Do(ctx)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(block.TimeStamp, 0).UTC()
 }
 
 func (c *Client) IsOnline(ctx context.Context) bool {
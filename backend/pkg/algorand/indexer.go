@@ -0,0 +1,249 @@
+package algorand
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/indexer"
+)
+
+var (
+	ErrIndexerNotConfigured = errors.New("algorand indexer not configured")
+	ErrTransactionNotFound  = errors.New("transaction not found")
+	ErrTransactionPending   = errors.New("transaction not yet confirmed")
+	ErrTransactionExpired   = errors.New("transaction expired without being confirmed")
+	ErrAssetNotFound        = errors.New("asset not found")
+)
+
+// Transaction is the subset of a confirmed transaction needed to record and verify anchors
+type Transaction struct {
+	ID      string
+	Type    string
+	Sender  string
+	AssetID uint64
+	Note    []byte
+	// Fee is the fee paid, in microAlgos
+	Fee            uint64
+	ConfirmedRound uint64
+	RoundTime      time.Time
+}
+
+// LookupTransaction fetches a confirmed transaction by its ID from the indexer.
+func (c *Client) LookupTransaction(ctx context.Context, txID string) (*Transaction, error) {
+	return lookupTransaction(ctx, c.indexer, txID)
+}
+
+// LookupAssetCreation fetches the asset configuration transaction that created the asset.
+func (c *Client) LookupAssetCreation(ctx context.Context, assetID uint64) (*Transaction, error) {
+	return lookupAssetCreation(ctx, c.indexer, assetID)
+}
+
+func lookupTransaction(ctx context.Context, idx *indexer.Client, txID string) (*Transaction, error) {
+	if idx == nil {
+		return nil, ErrIndexerNotConfigured
+	}
+
+	resp, err := idx.LookupTransaction(txID).Do(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("lookup transaction %s: %w", txID, err)
+	}
+
+	return toTransaction(resp.Transaction), nil
+}
+
+func lookupAssetCreation(ctx context.Context, idx *indexer.Client, assetID uint64) (*Transaction, error) {
+	if idx == nil {
+		return nil, ErrIndexerNotConfigured
+	}
+
+	resp, err := idx.LookupAssetTransactions(assetID).TxType("acfg").Do(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("lookup asset %d transactions: %w", assetID, err)
+	}
+
+	for _, txn := range resp.Transactions {
+		if txn.CreatedAssetIndex == assetID {
+			return toTransaction(txn), nil
+		}
+	}
+
+	return nil, ErrTransactionNotFound
+}
+
+// FindAssetByName returns the oldest non-deleted asset with exactly the given name
+// created by the platform account.
+func (c *Client) FindAssetByName(ctx context.Context, name string) (uint64, error) {
+	if c.indexer == nil {
+		return 0, ErrIndexerNotConfigured
+	}
+
+	resp, err := c.indexer.SearchForAssets().Creator(c.Address()).Name(name).Do(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return 0, ErrAssetNotFound
+		}
+		return 0, fmt.Errorf("search assets named %s: %w", name, err)
+	}
+
+	var found uint64
+	for _, asset := range resp.Assets {
+		if asset.Deleted || asset.Params.Name != name {
+			continue
+		}
+		if found == 0 || asset.Index < found {
+			found = asset.Index
+		}
+	}
+	if found == 0 {
+		return 0, ErrAssetNotFound
+	}
+
+	return found, nil
+}
+
+// CreatedAsset is an asset created by the platform account
+type CreatedAsset struct {
+	ID   uint64
+	Name string
+	// Deleted is set once the asset was destroyed, as for scrapped vehicles
+	Deleted bool
+}
+
+// CreatedAssets returns the assets created by the platform account whose name starts with
+// namePrefix, including destroyed ones, ordered by asset ID.
+func (c *Client) CreatedAssets(ctx context.Context, namePrefix string) ([]CreatedAsset, error) {
+	if c.indexer == nil {
+		return nil, ErrIndexerNotConfigured
+	}
+
+	var assets []CreatedAsset
+	next := ""
+	for {
+		resp, err := c.indexer.SearchForAssets().Creator(c.Address()).IncludeAll(true).NextToken(next).Do(ctx)
+		if err != nil {
+			if isNotFound(err) {
+				break
+			}
+			return nil, fmt.Errorf("search assets created by %s: %w", c.Address(), err)
+		}
+
+		for _, asset := range resp.Assets {
+			if !strings.HasPrefix(asset.Params.Name, namePrefix) {
+				continue
+			}
+			assets = append(assets, CreatedAsset{ID: asset.Index, Name: asset.Params.Name, Deleted: asset.Deleted})
+		}
+
+		if resp.NextToken == "" || len(resp.Assets) == 0 {
+			break
+		}
+		next = resp.NextToken
+	}
+
+	slices.SortFunc(assets, func(a, b CreatedAsset) int { return cmp.Compare(a.ID, b.ID) })
+	return assets, nil
+}
+
+// AssetTransactions returns every confirmed transaction of the asset in confirmation order.
+func (c *Client) AssetTransactions(ctx context.Context, assetID uint64) ([]Transaction, error) {
+	if c.indexer == nil {
+		return nil, ErrIndexerNotConfigured
+	}
+
+	var txns []Transaction
+	next := ""
+	for {
+		resp, err := c.indexer.LookupAssetTransactions(assetID).NextToken(next).Do(ctx)
+		if err != nil {
+			if isNotFound(err) {
+				return nil, ErrAssetNotFound
+			}
+			return nil, fmt.Errorf("lookup asset %d transactions: %w", assetID, err)
+		}
+
+		for _, txn := range resp.Transactions {
+			txns = append(txns, *toTransaction(txn))
+		}
+
+		if resp.NextToken == "" || len(resp.Transactions) == 0 {
+			break
+		}
+		next = resp.NextToken
+	}
+
+	return txns, nil
+}
+
+// AssetHolder returns the account holding units of the asset. Vehicle assets have a single unit,
+// so there is at most one holder.
+func (c *Client) AssetHolder(ctx context.Context, assetID uint64) (string, error) {
+	if c.indexer == nil {
+		return "", ErrIndexerNotConfigured
+	}
+
+	resp, err := c.indexer.LookupAssetBalances(assetID).CurrencyGreaterThan(0).Limit(1).Do(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return "", ErrAssetNotFound
+		}
+		return "", fmt.Errorf("lookup balances of asset %d: %w", assetID, err)
+	}
+	if len(resp.Balances) == 0 {
+		return "", ErrAssetNotFound
+	}
+	return resp.Balances[0].Address, nil
+}
+
+func toTransaction(txn models.Transaction) *Transaction {
+	assetID := txn.AssetTransferTransaction.AssetId
+	if txn.CreatedAssetIndex != 0 {
+		assetID = txn.CreatedAssetIndex
+	} else if txn.Type == "acfg" {
+		assetID = txn.AssetConfigTransaction.AssetId
+	}
+
+	return &Transaction{
+		ID:             txn.Id,
+		Type:           txn.Type,
+		Sender:         txn.Sender,
+		AssetID:        assetID,
+		Note:           txn.Note,
+		Fee:            txn.Fee,
+		ConfirmedRound: txn.ConfirmedRound,
+		RoundTime:      time.Unix(int64(txn.RoundTime), 0).UTC(),
+	}
+}
+
+// isNotFound reports whether the indexer answered with HTTP 404. The SDK's
+// common.NotFound is a plain error alias, so it cannot be matched by type.
+func isNotFound(err error) bool {
+	return strings.Contains(err.Error(), "HTTP 404")
+}
+
+// DecodeTransactions parses a JSON transaction dump as returned by the indexer
+// transaction search endpoints (for example /v2/assets/{id}/transactions).
+func DecodeTransactions(data []byte) ([]Transaction, error) {
+	var resp models.TransactionsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("decode transaction dump: %w", err)
+	}
+
+	txns := make([]Transaction, len(resp.Transactions))
+	for i, txn := range resp.Transactions {
+		txns[i] = *toTransaction(txn)
+	}
+	return txns, nil
+}
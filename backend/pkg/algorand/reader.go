@@ -0,0 +1,78 @@
+package algorand
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/indexer"
+)
+
+// Reader is a read-only view of the chain from the indexer. It holds no key and needs no algod
+// node, so processes that only verify anchors and check holdings never see the wallet mnemonic.
+type Reader struct {
+	indexer *indexer.Client
+	address string
+}
+
+type ReaderConfig struct {
+	IndexerURL string
+	// Address is the platform account that sends every anchoring transaction
+	Address string
+}
+
+func NewReader(cfg ReaderConfig) (*Reader, error) {
+	if cfg.IndexerURL == "" {
+		return nil, fmt.Errorf("indexer URL is required")
+	}
+	if err := ValidateAddress(cfg.Address); err != nil {
+		return nil, fmt.Errorf("platform address: %w", err)
+	}
+
+	indexerClient, err := indexer.MakeClient(cfg.IndexerURL, "")
+	if err != nil {
+		return nil, fmt.Errorf("create indexer client: %w", err)
+	}
+
+	return &Reader{
+		indexer: indexerClient,
+		address: cfg.Address,
+	}, nil
+}
+
+// Address returns the configured platform account
+func (r *Reader) Address() string {
+	return r.address
+}
+
+// LookupTransaction fetches a confirmed transaction by its ID from the indexer.
+func (r *Reader) LookupTransaction(ctx context.Context, txID string) (*Transaction, error) {
+	return lookupTransaction(ctx, r.indexer, txID)
+}
+
+// LookupAssetCreation fetches the asset configuration transaction that created the asset.
+func (r *Reader) LookupAssetCreation(ctx context.Context, assetID uint64) (*Transaction, error) {
+	return lookupAssetCreation(ctx, r.indexer, assetID)
+}
+
+// AssetHolding returns the account's holding of the asset as last seen by the indexer. Accounts
+// that have not opted in are reported with OptedIn false rather than an error.
+func (r *Reader) AssetHolding(ctx context.Context, address string, assetID uint64) (*AssetHolding, error) {
+	resp, err := r.indexer.LookupAccountAssets(address).AssetID(assetID).Do(ctx)
+	if err != nil {
+		if isNotFound(err) {
+			return &AssetHolding{}, nil
+		}
+		return nil, fmt.Errorf("lookup holding of asset %d by %s: %w", assetID, address, err)
+	}
+
+	for _, holding := range resp.Assets {
+		if holding.AssetId == assetID && !holding.Deleted {
+			return &AssetHolding{
+				OptedIn: true,
+				Amount:  holding.Amount,
+				Frozen:  holding.IsFrozen,
+			}, nil
+		}
+	}
+	return &AssetHolding{}, nil
+}
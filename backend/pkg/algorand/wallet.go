@@ -0,0 +1,29 @@
+package algorand
+
+import (
+	"crypto/ed25519"
+	"errors"
+
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+var ErrInvalidAddress = errors.New("invalid algorand address")
+
+// ValidateAddress checks the address and its checksum
+func ValidateAddress(address string) error {
+	if _, err := types.DecodeAddress(address); err != nil {
+		return ErrInvalidAddress
+	}
+	return nil
+}
+
+// VerifySignedBytes checks a signature of arbitrary bytes made by the address's account, as
+// produced by wallets that sign data with the "MX" domain prefix (algosdk signBytes)
+func VerifySignedBytes(address string, message, signature []byte) bool {
+	addr, err := types.DecodeAddress(address)
+	if err != nil {
+		return false
+	}
+	return crypto.VerifyBytes(ed25519.PublicKey(addr[:]), message, signature)
+}
@@ -3,11 +3,13 @@ package anchorer
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchors"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
@@ -15,69 +17,199 @@ import (
 	"github.com/google/uuid"
 )
 
-const (
-	AlgorandVehicleAssetNamePrefix = "CC_"
-	AssetMetadataBaseURL           = "https://vehicle.classicschain.com" // TODO make configurable
-)
+const AlgorandVehicleAssetNamePrefix = "CC_"
 
 type vehicleUpdateType string
 
 const (
-	vehicleUpdateTypeGenesis       vehicleUpdateType = "genesis"
-	vehicleUpdateTypeNewEvent      vehicleUpdateType = "new_event"
-	vehicleUpdateTypeVehicleUpdate vehicleUpdateType = "vehicle_update"
+	vehicleUpdateTypeGenesis         vehicleUpdateType = "genesis"
+	vehicleUpdateTypeNewEvent        vehicleUpdateType = "new_event"
+	vehicleUpdateTypeVehicleUpdate   vehicleUpdateType = "vehicle_update"
+	vehicleUpdateTypeEventAmendment  vehicleUpdateType = "event_amendment"
+	vehicleUpdateTypeEventRevocation vehicleUpdateType = "event_revocation"
+)
+
+// Note types of the anchors of vehicle records and events, so their anchors can be told apart
+// when verified
+const (
+	NoteTypeGenesis         = string(vehicleUpdateTypeGenesis)
+	NoteTypeNewEvent        = string(vehicleUpdateTypeNewEvent)
+	NoteTypeVehicleUpdate   = string(vehicleUpdateTypeVehicleUpdate)
+	NoteTypeEventAmendment  = string(vehicleUpdateTypeEventAmendment)
+	NoteTypeEventRevocation = string(vehicleUpdateTypeEventRevocation)
 )
 
+// ErrGenesisInProgress is returned while another attempt's asset creation for the vehicle
+// may still be confirmed. The caller should retry later instead of minting a second asset.
+var ErrGenesisInProgress = errors.New("vehicle genesis already in progress")
+
 type AssetManager interface {
-	CreateAsset(ctx context.Context, params algorand.AssetParams) (uint64, string, error)
-	SelfTransferAsset(ctx context.Context, assetID uint64, note []byte) (string, error)
+	// Address is the account that sends the anchoring transactions
+	Address() string
+	SignAssetCreation(ctx context.Context, params algorand.AssetParams) (*algorand.SignedTransaction, error)
+	SubmitAssetCreation(ctx context.Context, stxn *algorand.SignedTransaction) (*algorand.Transaction, error)
+	AssetCreatedBy(ctx context.Context, txID string, lastValid uint64) (uint64, error)
+	FindAssetByName(ctx context.Context, name string) (uint64, error)
+	SelfTransferAssets(ctx context.Context, transfers []algorand.SelfTransfer) ([]algorand.Transaction, error)
+	SelfPayment(ctx context.Context, note []byte) (*algorand.Transaction, error)
 }
 
 type VehicleRepository interface {
 	Update(ctx context.Context, vehicle *vehicles.Vehicle) error
+	ClaimGenesis(ctx context.Context, vehicleID uuid.UUID, previousTxID *string, txID string, lastValid uint64, cid, cidSourceJSON, cidSourceCBOR string) (bool, error)
+	UpdateVersion(ctx context.Context, version *vehicles.Version) error
 }
 
 type EventRepository interface {
 	Update(ctx context.Context, evt event.Event) error
 }
 
+// AnchorRepository records the outcome of every submitted anchoring transaction
+type AnchorRepository interface {
+	Create(ctx context.Context, anchor anchors.Anchor) (*anchors.Anchor, error)
+}
+
 type Anchorer struct {
 	ac          AssetManager
 	vehicleRepo VehicleRepository
 	eventRepo   EventRepository
+	anchorRepo  AnchorRepository
+	network     string
+	// metadataBaseURL is the base of the URL of vehicle assets, where their metadata is served
+	metadataBaseURL string
 }
 
 func New(ac AssetManager, vehicleRepo VehicleRepository, eventRepo EventRepository) *Anchorer {
-	return &Anchorer{ac, vehicleRepo, eventRepo}
+	return &Anchorer{ac: ac, vehicleRepo: vehicleRepo, eventRepo: eventRepo, metadataBaseURL: DefaultAssetMetadataBaseURL}
+}
+
+// SetAssetMetadataBaseURL sets the base of the URL of the vehicle assets created from now on. The
+// vehicle ID is appended to it, so it should point at the public asset metadata endpoint.
+func (a *Anchorer) SetAssetMetadataBaseURL(baseURL string) {
+	a.metadataBaseURL = baseURL
+}
+
+// SetAnchorRepository records the outcome of every transaction submitted from now on, tagged
+// with the network the ledger is connected to
+func (a *Anchorer) SetAnchorRepository(repo AnchorRepository, network string) {
+	a.anchorRepo = repo
+	a.network = network
 }
 
 // VehicleGenesis generates a deterministic CID for the vehicle and anchors it on the blockchain.
-// The CID is stored in the Algorand asset's note field for verification purposes.
+// The CID is stored in the Algorand asset's note field for verification purposes, as part of the
+// asset's ARC-69 metadata.
+//
+// Genesis is idempotent: the signed creation transaction is recorded on the vehicle before it is
+// submitted, and a retry resolves that transaction, or an existing asset with the vehicle's name,
+// instead of minting a second asset.
 func (a *Anchorer) VehicleGenesis(ctx context.Context, vehicle vehicles.Vehicle) (*string, error) {
-	cidData, err := cidpkg.GenerateCID(vehicleToVehicleRecord(vehicle))
+	if vehicle.BlockchainAssetID != nil {
+		return vehicle.BlockchainAssetID, nil
+	}
+
+	if vehicle.GenesisTxID != nil {
+		var lastValid uint64
+		if vehicle.GenesisLastValid != nil {
+			lastValid = *vehicle.GenesisLastValid
+		}
+
+		assetID, err := a.ac.AssetCreatedBy(ctx, *vehicle.GenesisTxID, lastValid)
+		switch {
+		case err == nil:
+			log.Printf("resolved algorand asset %d for vehicle %s from transaction %s", assetID, vehicle.ID, *vehicle.GenesisTxID)
+			return a.completeGenesis(ctx, vehicle, assetID)
+		case errors.Is(err, algorand.ErrTransactionPending):
+			return nil, fmt.Errorf("%w: transaction %s not yet confirmed", ErrGenesisInProgress, *vehicle.GenesisTxID)
+		case errors.Is(err, algorand.ErrTransactionExpired):
+			// The genesis CID is kept, as later records in the vehicle's chain link to it
+			log.Printf("genesis transaction %s for vehicle %s expired, submitting a new one", *vehicle.GenesisTxID, vehicle.ID)
+		default:
+			return nil, fmt.Errorf("anchorer genesis failed to resolve transaction %s: %w", *vehicle.GenesisTxID, err)
+		}
+	}
+
+	assetName := AlgorandVehicleAssetNamePrefix + UUIDToBase64(vehicle.ID)
+
+	// Covers assets whose creation was never recorded, e.g. minted before transactions were tracked
+	assetID, err := a.ac.FindAssetByName(ctx, assetName)
+	if err == nil {
+		log.Printf("found existing algorand asset %d for vehicle %s", assetID, vehicle.ID)
+		return a.completeGenesis(ctx, vehicle, assetID)
+	}
+	if !errors.Is(err, algorand.ErrAssetNotFound) {
+		return nil, fmt.Errorf("anchorer genesis failed to look up existing asset: %w", err)
+	}
+
+	cidData, err := vehicleGenesisCID(vehicle)
 	if err != nil {
-		return nil, fmt.Errorf("anchorer genesis failed to generate cid: %w", err)
+		return nil, err
 	}
 
-	assetID, txnID, err := a.ac.CreateAsset(ctx, algorand.AssetParams{
-		AssetName: AlgorandVehicleAssetNamePrefix + UUIDToBase64(vehicle.ID),
+	metadata, err := GenesisAssetMetadata(cidData.CID, cidData.SourceJSON)
+	if err != nil {
+		return nil, fmt.Errorf("anchorer genesis failed to build asset metadata: %w", err)
+	}
+	note, err := metadata.Note()
+	if err != nil {
+		return nil, fmt.Errorf("anchorer genesis failed to encode asset metadata: %w", err)
+	}
+
+	stxn, err := a.ac.SignAssetCreation(ctx, algorand.AssetParams{
+		AssetName: assetName,
 		UnitName:  "CCV",
-		URL:       fmt.Sprintf("%s/%s", AssetMetadataBaseURL, vehicle.ID.String()),
+		URL:       AssetURL(a.metadataBaseURL, vehicle.ID),
 		Total:     1,
-		Note:      []byte(vehicleUpdateNote(vehicleUpdateTypeNewEvent, cidData.CID)),
+		Note:      note,
 	})
 	if err != nil {
+		return nil, fmt.Errorf("anchorer genesis failed to sign algorand asset creation: %w", err)
+	}
+
+	claimed, err := a.vehicleRepo.ClaimGenesis(ctx, vehicle.ID, vehicle.GenesisTxID, stxn.ID, stxn.LastValid, cidData.CID, cidData.SourceJSON, cidData.SourceCBOR)
+	if err != nil {
+		return nil, fmt.Errorf("anchorer genesis failed to record transaction: %w", err)
+	}
+	if !claimed {
+		return nil, fmt.Errorf("%w: claimed by another attempt", ErrGenesisInProgress)
+	}
+
+	created, err := a.ac.SubmitAssetCreation(ctx, stxn)
+	if err != nil {
+		a.recordFailed(ctx, genesisTarget(vehicle), &stxn.ID, err)
 		return nil, fmt.Errorf("anchorer genesis failed to create algorand asset: %w", err)
 	}
+	a.recordConfirmed(ctx, genesisTarget(vehicle), *created)
+	assetID = created.AssetID
 
-	log.Printf("created algorand asset %d (CID: %s) on transaction %s", assetID, cidData.CID, txnID)
+	log.Printf("created algorand asset %d (CID: %s) on transaction %s", assetID, cidData.CID, stxn.ID)
 
-	vehicle.BlockchainAssetID = ptr(fmt.Sprintf("%d", assetID))
+	vehicle.GenesisTxID = &stxn.ID
 	vehicle.CID = &cidData.CID
 	vehicle.CIDSourceJSON = &cidData.SourceJSON
 	vehicle.CIDSourceCBOR = &cidData.SourceCBOR
 
-	err = a.vehicleRepo.Update(ctx, &vehicle)
+	return a.completeGenesis(ctx, vehicle, assetID)
+}
+
+// completeGenesis stores the asset ID on the vehicle. The CID recorded with the genesis claim is
+// kept; it is only computed here for assets adopted without a recorded claim.
+func (a *Anchorer) completeGenesis(ctx context.Context, vehicle vehicles.Vehicle, assetID uint64) (*string, error) {
+	if vehicle.CID == nil {
+		cidData, err := cidpkg.GenerateCID(vehicleToVehicleRecord(vehicle))
+		if err != nil {
+			return nil, fmt.Errorf("anchorer genesis failed to generate cid: %w", err)
+		}
+		vehicle.CID = &cidData.CID
+		vehicle.CIDSourceJSON = &cidData.SourceJSON
+		vehicle.CIDSourceCBOR = &cidData.SourceCBOR
+	}
+
+	vehicle.BlockchainAssetID = ptr(fmt.Sprintf("%d", assetID))
+	vehicle.BlockchainStatus = vehicles.StatusAnchored
+	vehicle.BlockchainError = nil
+
+	err := a.vehicleRepo.Update(ctx, &vehicle)
 	if err != nil {
 		return nil, fmt.Errorf("anchorer genesis failed to update vehicle: %w", err)
 	}
@@ -85,58 +217,126 @@ func (a *Anchorer) VehicleGenesis(ctx context.Context, vehicle vehicles.Vehicle)
 	return vehicle.BlockchainAssetID, nil
 }
 
-// AnchorEvent generates a new CID for the event (including optional image CIDs)
-// and anchors the updated CID on the blockchain via a self-transfer transaction.
+// AnchorEvent anchors the event's CID on the vehicle's asset via a self-transfer transaction.
 func (a *Anchorer) AnchorEvent(ctx context.Context, vehicle vehicles.Vehicle, event event.Event, imageCIDs []string) error {
-	var vehicleAssetID uint64
-	var err error
+	return a.AnchorBatch(ctx, []Anchor{{Vehicle: vehicle, Event: &event, ImageCIDs: imageCIDs}})[0]
+}
 
-	if vehicle.BlockchainAssetID != nil {
-		vehicleAssetID, err = strconv.ParseUint(*vehicle.BlockchainAssetID, 10, 64)
-		if err != nil {
-			return fmt.Errorf("parse asset id: %w", err)
-		}
-	} else {
-		assetId, err := a.VehicleGenesis(ctx, vehicle)
-		if err != nil {
-			return fmt.Errorf("anchorer event update failed to perform vehicle genesis: %w", err)
-		}
+// AnchorVehicleUpdate anchors a new version of the vehicle record on the vehicle's asset via a
+// self-transfer with a vehicle_update note. The version CID is computed when the version is created.
+func (a *Anchorer) AnchorVehicleUpdate(ctx context.Context, vehicle vehicles.Vehicle, version vehicles.Version) error {
+	return a.AnchorBatch(ctx, []Anchor{{Vehicle: vehicle, Version: &version}})[0]
+}
 
-		vehicleAssetID, err = strconv.ParseUint(*assetId, 10, 64)
+// vehicleAssetID returns the vehicle's asset, performing genesis first if it has none yet.
+// Genesis is idempotent, so this cannot mint a second asset when a genesis job is also queued.
+func (a *Anchorer) vehicleAssetID(ctx context.Context, vehicle vehicles.Vehicle) (uint64, error) {
+	assetID := vehicle.BlockchainAssetID
+	if assetID == nil {
+		var err error
+		assetID, err = a.VehicleGenesis(ctx, vehicle)
 		if err != nil {
-			return fmt.Errorf("parse asset id: %w", err)
+			return 0, fmt.Errorf("anchorer failed to perform vehicle genesis: %w", err)
 		}
 	}
 
-	cidData, err := cidpkg.GenerateCID(eventToEventRecord(event, imageCIDs))
+	parsed, err := strconv.ParseUint(*assetID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("anchorer event update failed to generate cid: %w", err)
+		return 0, fmt.Errorf("parse asset id: %w", err)
 	}
+	return parsed, nil
+}
 
-	txnId, err := a.ac.SelfTransferAsset(ctx, vehicleAssetID, []byte(vehicleUpdateNote(vehicleUpdateTypeNewEvent, cidData.CID)))
-	if err != nil {
-		return fmt.Errorf("anchorer event update failed to transfer algorand asset: %w", err)
+// vehicleGenesisCID returns the genesis CID computed when the vehicle was created. Vehicles
+// created before genesis CIDs were fixed at creation get one computed from their current state.
+func vehicleGenesisCID(vehicle vehicles.Vehicle) (*cidpkg.CID, error) {
+	if cidData := storedCID(vehicle.CID, vehicle.CIDSourceJSON, vehicle.CIDSourceCBOR); cidData != nil {
+		return cidData, nil
 	}
 
-	log.Printf("updated algorand asset %d (CID: %s) on transaction %s", vehicleAssetID, cidData.CID, txnId)
-
-	event.BlockchainTxID = &txnId
-	event.CID = &cidData.CID
-	event.CIDSourceJSON = &cidData.SourceJSON
-	event.CIDSourceCBOR = &cidData.SourceCBOR
-
-	err = a.eventRepo.Update(ctx, event)
+	cidData, err := cidpkg.GenerateCID(vehicleToVehicleRecord(vehicle))
 	if err != nil {
-		return fmt.Errorf("anchorer genesis failed to update vehicle: %w", err)
+		return nil, fmt.Errorf("anchorer genesis failed to generate cid: %w", err)
 	}
+	return cidData, nil
+}
 
-	return nil
+func storedCID(cid, sourceJSON, sourceCBOR *string) *cidpkg.CID {
+	if cid == nil || sourceJSON == nil || sourceCBOR == nil {
+		return nil
+	}
+	return &cidpkg.CID{CID: *cid, SourceJSON: *sourceJSON, SourceCBOR: *sourceCBOR}
 }
 
 func vehicleUpdateNote(updateType vehicleUpdateType, cid string) string {
 	return fmt.Sprintf("type=%s|cid=%s", updateType, cid)
 }
 
+// Note is the decoded form of a note written on an anchoring transaction.
+// Merkle root notes carry Root instead of CID.
+type Note struct {
+	Type string
+	CID  string
+	Root string
+}
+
+// NoteTypeCustody is the note type of a transfer moving a vehicle's asset between the platform
+// account and an owner's wallet. It anchors no record.
+const NoteTypeCustody = "custody"
+
+// CustodyNote is the note written on custody transfers
+func CustodyNote() []byte {
+	return []byte("type=" + NoteTypeCustody)
+}
+
+// NoteTypeLifecycle is the note type of the freeze, unfreeze or destruction of a vehicle's asset
+// that a lifecycle change required. Its CID is the lifecycle_change event recording the change.
+const NoteTypeLifecycle = "lifecycle"
+
+// LifecycleNote is the note written on the asset operations made for a lifecycle change
+func LifecycleNote(eventCID string) []byte {
+	return []byte(fmt.Sprintf("type=%s|cid=%s", NoteTypeLifecycle, eventCID))
+}
+
+// ParseNote decodes a "type=...|cid=..." or "type=merkle_root|root=..." note as written by the
+// anchorer, a custody note, or the ARC-69 metadata note of a vehicle asset's creation
+func ParseNote(note []byte) (Note, error) {
+	if len(note) > 0 && note[0] == '{' {
+		return parseARC69Note(note)
+	}
+
+	var parsed Note
+	for _, field := range strings.Split(string(note), "|") {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return Note{}, fmt.Errorf("malformed note field %q", field)
+		}
+		switch key {
+		case "type":
+			parsed.Type = value
+		case "cid":
+			parsed.CID = value
+		case "root":
+			parsed.Root = value
+		}
+	}
+
+	if parsed.Type == NoteTypeCustody {
+		return parsed, nil
+	}
+	if parsed.Type == NoteTypeMerkleRoot {
+		if parsed.Root == "" {
+			return Note{}, fmt.Errorf("merkle root note is missing root")
+		}
+		return parsed, nil
+	}
+	if parsed.Type == "" || parsed.CID == "" {
+		return Note{}, fmt.Errorf("note is missing type or cid")
+	}
+
+	return parsed, nil
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
@@ -145,3 +345,21 @@ func UUIDToBase64(u uuid.UUID) string {
 	encoded := base64.URLEncoding.EncodeToString(u[:])
 	return strings.TrimRight(encoded, "=")
 }
+
+// Base64ToUUID is the inverse of UUIDToBase64
+func Base64ToUUID(s string) (uuid.UUID, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("decode %q: %w", s, err)
+	}
+	return uuid.FromBytes(decoded)
+}
+
+// VehicleIDFromAssetName decodes the vehicle ID from the name of a vehicle's asset
+func VehicleIDFromAssetName(name string) (uuid.UUID, error) {
+	encoded, ok := strings.CutPrefix(name, AlgorandVehicleAssetNamePrefix)
+	if !ok {
+		return uuid.Nil, fmt.Errorf("asset name %q does not start with %s", name, AlgorandVehicleAssetNamePrefix)
+	}
+	return Base64ToUUID(encoded)
+}
@@ -0,0 +1,687 @@
+package anchorer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchors"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type mockAssetManager struct {
+	nextAssetID uint64
+	signed      []*algorand.SignedTransaction
+	submitted   []string
+	submitErr   error
+
+	// confirmed maps submitted transaction IDs to the asset they created
+	confirmed   map[string]uint64
+	createdByFn func(txID string) (uint64, error)
+	byName      map[string]uint64
+	notes       []string
+	urls        []string
+	groups      [][]algorand.SelfTransfer
+	transferErr error
+	payments    []string
+	paymentErr  error
+}
+
+func newMockAssetManager() *mockAssetManager {
+	return &mockAssetManager{nextAssetID: 1000, confirmed: map[string]uint64{}, byName: map[string]uint64{}}
+}
+
+const mockSender = "PLATFORMADDRESS"
+
+// mockTxn returns a transaction as confirmed by the mock ledger
+func mockTxn(id, txType string, assetID uint64) algorand.Transaction {
+	return algorand.Transaction{
+		ID:             id,
+		Type:           txType,
+		Sender:         mockSender,
+		AssetID:        assetID,
+		Fee:            1000,
+		ConfirmedRound: 52000000,
+		RoundTime:      time.Date(2025, 3, 2, 14, 22, 0, 0, time.UTC),
+	}
+}
+
+func (m *mockAssetManager) Address() string {
+	return mockSender
+}
+
+func (m *mockAssetManager) SignAssetCreation(_ context.Context, params algorand.AssetParams) (*algorand.SignedTransaction, error) {
+	stxn := &algorand.SignedTransaction{ID: fmt.Sprintf("%s-TX%d", params.AssetName, len(m.signed)), LastValid: 100}
+	m.notes = append(m.notes, string(params.Note))
+	m.urls = append(m.urls, params.URL)
+	m.signed = append(m.signed, stxn)
+	return stxn, nil
+}
+
+func (m *mockAssetManager) SubmitAssetCreation(_ context.Context, stxn *algorand.SignedTransaction) (*algorand.Transaction, error) {
+	if m.submitErr != nil {
+		return nil, m.submitErr
+	}
+	m.nextAssetID++
+	m.submitted = append(m.submitted, stxn.ID)
+	m.confirmed[stxn.ID] = m.nextAssetID
+	txn := mockTxn(stxn.ID, "acfg", m.nextAssetID)
+	return &txn, nil
+}
+
+func (m *mockAssetManager) AssetCreatedBy(_ context.Context, txID string, _ uint64) (uint64, error) {
+	if m.createdByFn != nil {
+		return m.createdByFn(txID)
+	}
+	if id, ok := m.confirmed[txID]; ok {
+		return id, nil
+	}
+	return 0, algorand.ErrTransactionExpired
+}
+
+func (m *mockAssetManager) FindAssetByName(_ context.Context, name string) (uint64, error) {
+	if id, ok := m.byName[name]; ok {
+		return id, nil
+	}
+	return 0, algorand.ErrAssetNotFound
+}
+
+func (m *mockAssetManager) SelfTransferAssets(_ context.Context, transfers []algorand.SelfTransfer) ([]algorand.Transaction, error) {
+	if m.transferErr != nil {
+		return nil, m.transferErr
+	}
+	txns := make([]algorand.Transaction, len(transfers))
+	for i, t := range transfers {
+		m.notes = append(m.notes, string(t.Note))
+		txns[i] = mockTxn(fmt.Sprintf("TX-%d-%d", len(m.groups), i), "axfer", t.AssetID)
+	}
+	m.groups = append(m.groups, transfers)
+	return txns, nil
+}
+
+func (m *mockAssetManager) SelfPayment(_ context.Context, note []byte) (*algorand.Transaction, error) {
+	if m.paymentErr != nil {
+		return nil, m.paymentErr
+	}
+	m.payments = append(m.payments, string(note))
+	txn := mockTxn(fmt.Sprintf("PAY-%d", len(m.payments)-1), "pay", 0)
+	return &txn, nil
+}
+
+type mockVehicleRepo struct {
+	vehicle   vehicles.Vehicle
+	updateErr error
+	claimLost bool
+	versions  []vehicles.Version
+}
+
+func (m *mockVehicleRepo) Update(_ context.Context, vehicle *vehicles.Vehicle) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	m.vehicle = *vehicle
+	return nil
+}
+
+func (m *mockVehicleRepo) ClaimGenesis(_ context.Context, _ uuid.UUID, previousTxID *string, txID string, lastValid uint64, cid, cidSourceJSON, cidSourceCBOR string) (bool, error) {
+	if m.claimLost || m.vehicle.BlockchainAssetID != nil {
+		return false, nil
+	}
+	current := m.vehicle.GenesisTxID
+	if (current == nil) != (previousTxID == nil) || (current != nil && *current != *previousTxID) {
+		return false, nil
+	}
+	m.vehicle.GenesisTxID = &txID
+	m.vehicle.GenesisLastValid = &lastValid
+	m.vehicle.CID = &cid
+	m.vehicle.CIDSourceJSON = &cidSourceJSON
+	m.vehicle.CIDSourceCBOR = &cidSourceCBOR
+	return true, nil
+}
+
+func (m *mockVehicleRepo) UpdateVersion(_ context.Context, version *vehicles.Version) error {
+	m.versions = append(m.versions, *version)
+	return nil
+}
+
+type mockEventRepo struct {
+	updated []event.Event
+}
+
+func (m *mockEventRepo) Update(_ context.Context, evt event.Event) error {
+	m.updated = append(m.updated, evt)
+	return nil
+}
+
+type mockAnchorRepo struct {
+	created []anchors.Anchor
+}
+
+func (m *mockAnchorRepo) Create(_ context.Context, anchor anchors.Anchor) (*anchors.Anchor, error) {
+	m.created = append(m.created, anchor)
+	return &anchor, nil
+}
+
+func newTestVehicle() vehicles.Vehicle {
+	return vehicles.Vehicle{ID: uuid.New(), Make: "Porsche", Model: "911", Year: 1973, BlockchainStatus: vehicles.StatusPending}
+}
+
+// --- Tests ---
+
+func TestVehicleGenesis_CreatesAsset(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	repo := &mockVehicleRepo{vehicle: vehicle}
+	a := New(ac, repo, &mockEventRepo{})
+
+	assetID, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	require.NoError(t, err)
+	assert.Equal(t, "1001", *assetID)
+	assert.Len(t, ac.submitted, 1)
+	assert.Equal(t, ac.signed[0].ID, *repo.vehicle.GenesisTxID)
+	assert.Equal(t, vehicles.StatusAnchored, repo.vehicle.BlockchainStatus)
+	assert.Equal(t, DefaultAssetMetadataBaseURL+"/"+vehicle.ID.String(), ac.urls[0])
+
+	var metadata AssetMetadata
+	require.NoError(t, json.Unmarshal([]byte(ac.notes[0]), &metadata))
+	assert.Equal(t, ARC69Standard, metadata.Standard)
+	assert.Equal(t, "1973 Porsche 911", metadata.Description)
+	assert.Equal(t, AssetMetadataProperties{Type: "genesis", CID: *repo.vehicle.CID, Make: "Porsche", Model: "911", Year: 1973}, metadata.Properties)
+}
+
+func TestVehicleGenesis_ConfiguredMetadataBaseURL(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+	a.SetAssetMetadataBaseURL("https://api.example.com/v1/public/asset-metadata/")
+
+	_, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1/public/asset-metadata/"+vehicle.ID.String(), ac.urls[0])
+}
+
+func TestVehicleGenesis_RetryAfterFailedUpdateReusesAsset(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	repo := &mockVehicleRepo{vehicle: vehicle, updateErr: errors.New("connection reset")}
+	a := New(ac, repo, &mockEventRepo{})
+
+	_, err := a.VehicleGenesis(context.Background(), vehicle)
+	require.Error(t, err)
+
+	// Redelivery loads the vehicle with the recorded genesis transaction
+	repo.updateErr = nil
+	assetID, err := a.VehicleGenesis(context.Background(), repo.vehicle)
+
+	require.NoError(t, err)
+	assert.Equal(t, "1001", *assetID)
+	assert.Len(t, ac.signed, 1)
+	assert.Len(t, ac.submitted, 1)
+}
+
+func TestVehicleGenesis_PendingTransaction(t *testing.T) {
+	ac := newMockAssetManager()
+	ac.createdByFn = func(string) (uint64, error) { return 0, algorand.ErrTransactionPending }
+	vehicle := newTestVehicle()
+	vehicle.GenesisTxID = ptr("PENDING-TX")
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+
+	_, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	assert.ErrorIs(t, err, ErrGenesisInProgress)
+	assert.Empty(t, ac.signed)
+}
+
+func TestVehicleGenesis_ExpiredTransactionIsReplaced(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	vehicle.GenesisTxID = ptr("EXPIRED-TX")
+	vehicle.CID = ptr("bafygenesis")
+	vehicle.CIDSourceJSON = ptr("{}")
+	vehicle.CIDSourceCBOR = ptr("oA==")
+	repo := &mockVehicleRepo{vehicle: vehicle}
+	a := New(ac, repo, &mockEventRepo{})
+
+	_, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	require.NoError(t, err)
+	assert.Len(t, ac.submitted, 1)
+	// Later records link to the genesis CID, so the replacement anchors the same one
+	assert.Equal(t, "bafygenesis", *repo.vehicle.CID)
+	note, err := ParseNote([]byte(ac.notes[0]))
+	require.NoError(t, err)
+	assert.Equal(t, Note{Type: "genesis", CID: "bafygenesis"}, note)
+}
+
+func TestVehicleGenesis_AdoptsExistingAssetByName(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	ac.byName[AlgorandVehicleAssetNamePrefix+UUIDToBase64(vehicle.ID)] = 555
+	repo := &mockVehicleRepo{vehicle: vehicle}
+	a := New(ac, repo, &mockEventRepo{})
+
+	assetID, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	require.NoError(t, err)
+	assert.Equal(t, "555", *assetID)
+	assert.Empty(t, ac.signed)
+	assert.NotNil(t, repo.vehicle.CID)
+}
+
+func TestVehicleGenesis_ClaimLost(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle, claimLost: true}, &mockEventRepo{})
+
+	_, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	assert.ErrorIs(t, err, ErrGenesisInProgress)
+	assert.Empty(t, ac.submitted)
+}
+
+func TestVehicleGenesis_AlreadyAnchored(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+
+	assetID, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	require.NoError(t, err)
+	assert.Equal(t, "42", *assetID)
+	assert.Empty(t, ac.signed)
+}
+
+func TestAnchorEvent_DoesNotMintWhileGenesisInProgress(t *testing.T) {
+	ac := newMockAssetManager()
+	ac.createdByFn = func(string) (uint64, error) { return 0, algorand.ErrTransactionPending }
+	vehicle := newTestVehicle()
+	vehicle.GenesisTxID = ptr("PENDING-TX")
+	eventRepo := &mockEventRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, eventRepo)
+
+	err := a.AnchorEvent(context.Background(), vehicle, event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Service"}, nil)
+
+	assert.ErrorIs(t, err, ErrGenesisInProgress)
+	assert.Empty(t, ac.signed)
+	assert.Empty(t, eventRepo.updated)
+}
+
+func TestAnchorVehicleUpdate_WritesVehicleUpdateNote(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	repo := &mockVehicleRepo{vehicle: vehicle}
+	a := New(ac, repo, &mockEventRepo{})
+	version := vehicles.Version{ID: uuid.New(), VehicleID: vehicle.ID, Version: 2, CID: "bafyversion2", PreviousCID: "bafygenesis"}
+
+	err := a.AnchorVehicleUpdate(context.Background(), vehicle, version)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"type=vehicle_update|cid=bafyversion2"}, ac.notes)
+	require.Len(t, repo.versions, 1)
+	assert.Equal(t, "TX-0-0", *repo.versions[0].BlockchainTxID)
+}
+
+func TestAnchorEvent_AnchorsLinkedCID(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	eventRepo := &mockEventRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, eventRepo)
+	evt := event.Event{
+		ID:            uuid.New(),
+		VehicleID:     vehicle.ID,
+		Title:         "Service",
+		PreviousCID:   ptr("bafygenesis"),
+		CID:           ptr("bafyevent"),
+		CIDSourceJSON: ptr("{}"),
+		CIDSourceCBOR: ptr("oA=="),
+	}
+
+	err := a.AnchorEvent(context.Background(), vehicle, evt, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"type=new_event|cid=bafyevent"}, ac.notes)
+	require.Len(t, eventRepo.updated, 1)
+	assert.Equal(t, "bafyevent", *eventRepo.updated[0].CID)
+}
+
+func TestAnchorBatch_SplitsIntoAtomicGroups(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	eventRepo := &mockEventRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, eventRepo)
+
+	anchors := make([]Anchor, algorand.MaxGroupSize+4)
+	for i := range anchors {
+		cid := fmt.Sprintf("bafyevent%d", i)
+		anchors[i] = Anchor{Vehicle: vehicle, Event: &event.Event{
+			ID: uuid.New(), VehicleID: vehicle.ID, CID: &cid, CIDSourceJSON: ptr("{}"), CIDSourceCBOR: ptr("oA=="),
+		}}
+	}
+
+	errs := a.AnchorBatch(context.Background(), anchors)
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Len(t, ac.groups, 2)
+	assert.Len(t, ac.groups[0], algorand.MaxGroupSize)
+	assert.Len(t, ac.groups[1], 4)
+	require.Len(t, eventRepo.updated, len(anchors))
+	assert.Equal(t, "TX-1-3", *eventRepo.updated[len(anchors)-1].BlockchainTxID)
+}
+
+func TestAnchorBatch_GroupFailureFailsEveryRecord(t *testing.T) {
+	ac := newMockAssetManager()
+	ac.transferErr = errors.New("overspend")
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	version := vehicles.Version{ID: uuid.New(), VehicleID: vehicle.ID, CID: "bafyversion"}
+	eventRepo := &mockEventRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, eventRepo)
+
+	errs := a.AnchorBatch(context.Background(), []Anchor{
+		{Vehicle: vehicle, Version: &version},
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Rally"}},
+	})
+
+	require.Len(t, errs, 2)
+	assert.ErrorContains(t, errs[0], "overspend")
+	assert.ErrorContains(t, errs[1], "overspend")
+	assert.Empty(t, eventRepo.updated)
+}
+
+func TestAnchorBatch_GenesisErrorOnlyFailsItsVehicle(t *testing.T) {
+	ac := newMockAssetManager()
+	ac.createdByFn = func(string) (uint64, error) { return 0, algorand.ErrTransactionPending }
+	pending := newTestVehicle()
+	pending.GenesisTxID = ptr("PENDING-TX")
+	anchored := newTestVehicle()
+	anchored.BlockchainAssetID = ptr("42")
+	a := New(ac, &mockVehicleRepo{vehicle: anchored}, &mockEventRepo{})
+
+	errs := a.AnchorBatch(context.Background(), []Anchor{
+		{Vehicle: pending, Event: &event.Event{ID: uuid.New(), VehicleID: pending.ID, Title: "Rally"}},
+		{Vehicle: anchored, Event: &event.Event{ID: uuid.New(), VehicleID: anchored.ID, Title: "Rally"}},
+	})
+
+	assert.ErrorIs(t, errs[0], ErrGenesisInProgress)
+	assert.NoError(t, errs[1])
+	require.Len(t, ac.groups, 1)
+	assert.Len(t, ac.groups[0], 1)
+}
+
+func TestAnchorMerkleBatch_AnchorsRootWithProofs(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	eventRepo := &mockEventRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, eventRepo)
+
+	anchors := make([]Anchor, 5)
+	for i := range anchors {
+		cid := fmt.Sprintf("bafyevent%d", i)
+		anchors[i] = Anchor{Vehicle: vehicle, Event: &event.Event{
+			ID: uuid.New(), VehicleID: vehicle.ID, CID: &cid, CIDSourceJSON: ptr("{}"), CIDSourceCBOR: ptr("oA=="),
+		}}
+	}
+
+	errs := a.AnchorMerkleBatch(context.Background(), anchors)
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	// No genesis is needed, as the root is not written on the vehicle's asset
+	assert.Empty(t, ac.signed)
+	assert.Empty(t, ac.groups)
+	require.Len(t, ac.payments, 1)
+
+	note, err := ParseNote([]byte(ac.payments[0]))
+	require.NoError(t, err)
+	assert.Equal(t, NoteTypeMerkleRoot, note.Type)
+
+	require.Len(t, eventRepo.updated, len(anchors))
+	for _, evt := range eventRepo.updated {
+		assert.Equal(t, "PAY-0", *evt.BlockchainTxID)
+		require.NotNil(t, evt.MerkleProof)
+		assert.Equal(t, note.Root, evt.MerkleProof.Root)
+		assert.True(t, merkle.Verify(*evt.CID, *evt.MerkleProof))
+	}
+}
+
+func TestAnchorMerkleBatch_AnchorsVersionsDirectly(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	repo := &mockVehicleRepo{vehicle: vehicle}
+	eventRepo := &mockEventRepo{}
+	a := New(ac, repo, eventRepo)
+	version := vehicles.Version{ID: uuid.New(), VehicleID: vehicle.ID, CID: "bafyversion"}
+
+	errs := a.AnchorMerkleBatch(context.Background(), []Anchor{
+		{Vehicle: vehicle, Version: &version},
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Rally"}},
+	})
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	assert.Equal(t, []string{"type=vehicle_update|cid=bafyversion"}, ac.notes)
+	require.Len(t, repo.versions, 1)
+	assert.Len(t, ac.payments, 1)
+	require.Len(t, eventRepo.updated, 1)
+	assert.NotNil(t, eventRepo.updated[0].MerkleProof)
+}
+
+func TestAnchorMerkleBatch_RootFailureFailsEveryEvent(t *testing.T) {
+	ac := newMockAssetManager()
+	ac.paymentErr = errors.New("overspend")
+	vehicle := newTestVehicle()
+	eventRepo := &mockEventRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, eventRepo)
+
+	errs := a.AnchorMerkleBatch(context.Background(), []Anchor{
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Rally"}},
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Show"}},
+	})
+
+	assert.ErrorContains(t, errs[0], "overspend")
+	assert.ErrorContains(t, errs[1], "overspend")
+	assert.Empty(t, eventRepo.updated)
+}
+
+func TestVehicleGenesis_RecordsAnchor(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	anchorRepo := &mockAnchorRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+	a.SetAnchorRepository(anchorRepo, "testnet")
+
+	_, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	require.NoError(t, err)
+	require.Len(t, anchorRepo.created, 1)
+	genesis := anchorRepo.created[0]
+	assert.Equal(t, vehicle.ID, genesis.VehicleID)
+	assert.Equal(t, anchors.RecordTypeVehicle, genesis.RecordType)
+	assert.Equal(t, anchors.StatusConfirmed, genesis.Status)
+	assert.Equal(t, "testnet", genesis.Network)
+	assert.Equal(t, mockSender, genesis.Sender)
+	assert.Equal(t, ac.signed[0].ID, *genesis.TxID)
+	assert.Equal(t, uint64(1001), *genesis.AssetID)
+	assert.Equal(t, uint64(52000000), *genesis.ConfirmedRound)
+	assert.Equal(t, uint64(1000), *genesis.FeeMicroAlgos)
+	assert.Equal(t, time.Date(2025, 3, 2, 14, 22, 0, 0, time.UTC), *genesis.RoundTime)
+}
+
+func TestVehicleGenesis_RecordsFailedSubmission(t *testing.T) {
+	ac := newMockAssetManager()
+	ac.submitErr = errors.New("overspend")
+	vehicle := newTestVehicle()
+	anchorRepo := &mockAnchorRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+	a.SetAnchorRepository(anchorRepo, "testnet")
+
+	_, err := a.VehicleGenesis(context.Background(), vehicle)
+
+	require.Error(t, err)
+	require.Len(t, anchorRepo.created, 1)
+	failed := anchorRepo.created[0]
+	assert.Equal(t, anchors.StatusFailed, failed.Status)
+	assert.Equal(t, ac.signed[0].ID, *failed.TxID)
+	assert.Equal(t, "overspend", *failed.Error)
+	assert.Equal(t, mockSender, failed.Sender)
+	assert.Nil(t, failed.ConfirmedRound)
+}
+
+func TestAnchorBatch_RecordsOutcomePerRecord(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	anchorRepo := &mockAnchorRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+	a.SetAnchorRepository(anchorRepo, "mainnet")
+	version := vehicles.Version{ID: uuid.New(), VehicleID: vehicle.ID, CID: "bafyversion"}
+	evt := event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Rally"}
+
+	errs := a.AnchorBatch(context.Background(), []Anchor{
+		{Vehicle: vehicle, Version: &version},
+		{Vehicle: vehicle, Event: &evt},
+	})
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.Len(t, anchorRepo.created, 2)
+	assert.Equal(t, anchors.RecordTypeVehicleVersion, anchorRepo.created[0].RecordType)
+	assert.Equal(t, version.ID, *anchorRepo.created[0].VehicleVersionID)
+	assert.Equal(t, "TX-0-0", *anchorRepo.created[0].TxID)
+	assert.Equal(t, anchors.RecordTypeEvent, anchorRepo.created[1].RecordType)
+	assert.Equal(t, evt.ID, *anchorRepo.created[1].EventID)
+	assert.Equal(t, "TX-0-1", *anchorRepo.created[1].TxID)
+	assert.Equal(t, uint64(42), *anchorRepo.created[1].AssetID)
+	assert.Equal(t, "mainnet", anchorRepo.created[1].Network)
+}
+
+func TestAnchorBatch_RecordsSentTransactionsOfFailedGroup(t *testing.T) {
+	ac := newMockAssetManager()
+	ac.transferErr = &algorand.SubmitError{TxIDs: []string{"SENT-0", "SENT-1"}, Err: errors.New("wait for confirmation: timeout")}
+	vehicle := newTestVehicle()
+	vehicle.BlockchainAssetID = ptr("42")
+	anchorRepo := &mockAnchorRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+	a.SetAnchorRepository(anchorRepo, "testnet")
+
+	a.AnchorBatch(context.Background(), []Anchor{
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Rally"}},
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Show"}},
+	})
+
+	require.Len(t, anchorRepo.created, 2)
+	for i, failed := range anchorRepo.created {
+		assert.Equal(t, anchors.StatusFailed, failed.Status)
+		assert.Equal(t, fmt.Sprintf("SENT-%d", i), *failed.TxID)
+		assert.Contains(t, *failed.Error, "timeout")
+	}
+}
+
+func TestAnchorMerkleBatch_RecordsSharedRootTransaction(t *testing.T) {
+	ac := newMockAssetManager()
+	vehicle := newTestVehicle()
+	anchorRepo := &mockAnchorRepo{}
+	a := New(ac, &mockVehicleRepo{vehicle: vehicle}, &mockEventRepo{})
+	a.SetAnchorRepository(anchorRepo, "testnet")
+
+	a.AnchorMerkleBatch(context.Background(), []Anchor{
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Rally"}},
+		{Vehicle: vehicle, Event: &event.Event{ID: uuid.New(), VehicleID: vehicle.ID, Title: "Show"}},
+	})
+
+	require.Len(t, anchorRepo.created, 2)
+	for _, anchor := range anchorRepo.created {
+		assert.Equal(t, anchors.StatusConfirmed, anchor.Status)
+		assert.Equal(t, "PAY-0", *anchor.TxID)
+		assert.Equal(t, "pay", *anchor.TxType)
+		assert.Nil(t, anchor.AssetID)
+	}
+}
+
+func TestParseNote_MerkleRoot(t *testing.T) {
+	note, err := ParseNote([]byte("type=merkle_root|root=abcd"))
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", note.Root)
+
+	_, err = ParseNote([]byte("type=merkle_root"))
+	assert.Error(t, err)
+}
+
+func TestParseNote_ARC69(t *testing.T) {
+	metadata, err := GenesisAssetMetadata("bafygenesis", `{"id":"x","make":"Jaguar","model":"E-Type","year":1961}`)
+	require.NoError(t, err)
+	encoded, err := metadata.Note()
+	require.NoError(t, err)
+
+	note, err := ParseNote(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, Note{Type: "genesis", CID: "bafygenesis"}, note)
+
+	// Notes written before ARC-69 metadata remain readable
+	note, err = ParseNote([]byte("type=genesis|cid=bafygenesis"))
+	require.NoError(t, err)
+	assert.Equal(t, Note{Type: "genesis", CID: "bafygenesis"}, note)
+
+	_, err = ParseNote([]byte(`{"standard":"arc3","properties":{"type":"genesis","cid":"bafy"}}`))
+	assert.Error(t, err)
+}
+
+func TestGenesisAssetMetadata_FitsInNote(t *testing.T) {
+	long := strings.Repeat("x", maxNoteSize)
+	metadata, err := GenesisAssetMetadata("bafygenesis", `{"make":"`+long+`","model":"911","year":1973}`)
+	require.NoError(t, err)
+
+	encoded, err := metadata.Note()
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(encoded), maxNoteSize)
+	assert.Equal(t, AssetMetadataProperties{Type: "genesis", CID: "bafygenesis"}, metadata.Properties)
+}
+
+func TestParseNote_Custody(t *testing.T) {
+	note, err := ParseNote(CustodyNote())
+	require.NoError(t, err)
+	assert.Equal(t, NoteTypeCustody, note.Type)
+	assert.Empty(t, note.CID)
+}
+
+func TestParseNote_Lifecycle(t *testing.T) {
+	note, err := ParseNote(LifecycleNote("bafyevent"))
+	require.NoError(t, err)
+	assert.Equal(t, Note{Type: NoteTypeLifecycle, CID: "bafyevent"}, note)
+}
+
+func TestVehicleIDFromAssetName_InvertsAssetName(t *testing.T) {
+	id := uuid.New()
+
+	decoded, err := VehicleIDFromAssetName(AlgorandVehicleAssetNamePrefix + UUIDToBase64(id))
+	require.NoError(t, err)
+	assert.Equal(t, id, decoded)
+
+	_, err = VehicleIDFromAssetName("XX_" + UUIDToBase64(id))
+	assert.Error(t, err)
+
+	_, err = VehicleIDFromAssetName(AlgorandVehicleAssetNamePrefix + "not-a-uuid")
+	assert.Error(t, err)
+}
@@ -0,0 +1,154 @@
+package anchorer
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/google/uuid"
+)
+
+// Anchor is an event or a vehicle version to anchor on the vehicle's asset.
+// Exactly one of Event and Version is set.
+type Anchor struct {
+	Vehicle   vehicles.Vehicle
+	Event     *event.Event
+	Version   *vehicles.Version
+	ImageCIDs []string
+}
+
+// preparedAnchor is an anchor whose asset and note are resolved, waiting to be sent in a group
+type preparedAnchor struct {
+	index   int
+	assetID uint64
+	cid     *cidpkg.CID
+}
+
+// AnchorBatch anchors the records with self-transfers sent in atomic groups of up to
+// algorand.MaxGroupSize transactions, so a batch costs one confirmation per group instead of one
+// per record. Every record of a group is confirmed, or fails, together. The returned errors are
+// per record, in the order of anchors.
+func (a *Anchorer) AnchorBatch(ctx context.Context, anchors []Anchor) []error {
+	errs := make([]error, len(anchors))
+
+	// Records of the same vehicle share its genesis
+	assetIDs := make(map[uuid.UUID]uint64)
+	var prepared []preparedAnchor
+	for i, anchor := range anchors {
+		assetID, ok := assetIDs[anchor.Vehicle.ID]
+		if !ok {
+			var err error
+			assetID, err = a.vehicleAssetID(ctx, anchor.Vehicle)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			assetIDs[anchor.Vehicle.ID] = assetID
+		}
+
+		cidData, err := anchorCID(anchor)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		prepared = append(prepared, preparedAnchor{index: i, assetID: assetID, cid: cidData})
+	}
+
+	for start := 0; start < len(prepared); start += algorand.MaxGroupSize {
+		group := prepared[start:min(start+algorand.MaxGroupSize, len(prepared))]
+
+		transfers := make([]algorand.SelfTransfer, len(group))
+		for i, p := range group {
+			transfers[i] = algorand.SelfTransfer{
+				AssetID: p.assetID,
+				Note:    []byte(vehicleUpdateNote(anchorNoteType(anchors[p.index]), p.cid.CID)),
+			}
+		}
+
+		confirmed, err := a.ac.SelfTransferAssets(ctx, transfers)
+		if err != nil {
+			txIDs := submittedTxIDs(err, len(group))
+			for i, p := range group {
+				a.recordFailed(ctx, anchorTarget(anchors[p.index]), txIDs[i], err)
+				errs[p.index] = fmt.Errorf("anchorer failed to transfer algorand asset: %w", err)
+			}
+			continue
+		}
+
+		for i, p := range group {
+			log.Printf("updated algorand asset %d (CID: %s) on transaction %s", p.assetID, p.cid.CID, confirmed[i].ID)
+			a.recordConfirmed(ctx, anchorTarget(anchors[p.index]), confirmed[i])
+			errs[p.index] = a.completeAnchor(ctx, anchors[p.index], p.cid, confirmed[i].ID)
+		}
+	}
+
+	return errs
+}
+
+// completeAnchor stores the anchoring transaction on the record
+func (a *Anchorer) completeAnchor(ctx context.Context, anchor Anchor, cidData *cidpkg.CID, txID string) error {
+	if anchor.Version != nil {
+		version := *anchor.Version
+		version.BlockchainTxID = &txID
+		if err := a.vehicleRepo.UpdateVersion(ctx, &version); err != nil {
+			return fmt.Errorf("anchorer vehicle update failed to update version: %w", err)
+		}
+		return nil
+	}
+
+	evt := *anchor.Event
+	evt.BlockchainTxID = &txID
+	evt.CID = &cidData.CID
+	evt.CIDSourceJSON = &cidData.SourceJSON
+	evt.CIDSourceCBOR = &cidData.SourceCBOR
+	// A proof from an earlier Merkle anchor no longer applies to this transaction
+	evt.MerkleProof = nil
+	if err := a.eventRepo.Update(ctx, evt); err != nil {
+		return fmt.Errorf("anchorer event update failed to update event: %w", err)
+	}
+	return nil
+}
+
+// anchorCID returns the CID to anchor for the record. Event CIDs are computed when the event is
+// created, as they are linked into the vehicle's chain; older events get one computed here.
+func anchorCID(anchor Anchor) (*cidpkg.CID, error) {
+	if anchor.Version != nil {
+		return &cidpkg.CID{
+			CID:        anchor.Version.CID,
+			SourceJSON: anchor.Version.CIDSourceJSON,
+			SourceCBOR: anchor.Version.CIDSourceCBOR,
+		}, nil
+	}
+	if anchor.Event == nil {
+		return nil, fmt.Errorf("anchor has neither an event nor a vehicle version")
+	}
+
+	evt := anchor.Event
+	if cidData := storedCID(evt.CID, evt.CIDSourceJSON, evt.CIDSourceCBOR); cidData != nil {
+		return cidData, nil
+	}
+	cidData, err := cidpkg.GenerateCID(eventToEventRecord(*evt, anchor.ImageCIDs))
+	if err != nil {
+		return nil, fmt.Errorf("anchorer event update failed to generate cid: %w", err)
+	}
+	return cidData, nil
+}
+
+func anchorNoteType(anchor Anchor) vehicleUpdateType {
+	if anchor.Version != nil {
+		return vehicleUpdateTypeVehicleUpdate
+	}
+	if anchor.Event != nil {
+		switch anchor.Event.Kind {
+		case event.KindAmendment:
+			return vehicleUpdateTypeEventAmendment
+		case event.KindRevocation:
+			return vehicleUpdateTypeEventRevocation
+		}
+	}
+	return vehicleUpdateTypeNewEvent
+}
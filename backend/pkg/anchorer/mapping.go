@@ -11,7 +11,9 @@ import (
 // VehicleRecord represents the structure of a vehicle stored in IPFS
 // Warning: handle with care! Changes to this structure may affect IPFS data integrity.
 type VehicleRecord struct {
-	ID                 uuid.UUID  `json:"id"`
+	ID uuid.UUID `json:"id"`
+	// PreviousCID links a vehicle_update version to the previous record in the vehicle's chain; empty for genesis
+	PreviousCID        *string    `json:"previousCid,omitempty"`
 	LicensePlate       *string    `json:"licensePlate,omitempty"`
 	ChassisNumber      *string    `json:"chassisNumber,omitempty"`
 	Make               *string    `json:"make,omitempty"`
@@ -28,8 +30,12 @@ type VehicleRecord struct {
 	CreatedAt          time.Time  `json:"createdAt,omitempty"`
 }
 
+// EventRecord represents the structure of an event stored in IPFS
+// Warning: handle with care! Changes to this structure may affect IPFS data integrity.
 type EventRecord struct {
-	ID          uuid.UUID              `json:"id"`
+	ID uuid.UUID `json:"id"`
+	// PreviousCID links the event to the vehicle's previous record, forming a hash-linked chain
+	PreviousCID *string                `json:"previousCid,omitempty"`
 	EntityID    *uuid.UUID             `json:"entityId,omitempty"`
 	Type        *string                `json:"type,omitempty"`
 	Title       *string                `json:"title,omitempty"`
@@ -39,6 +45,12 @@ type EventRecord struct {
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 	ImageCIDs   []string               `json:"imageCids,omitempty"`
 	CreatedAt   time.Time              `json:"createdAt,omitempty"`
+	// Kind, RevisesEventID and Reason are only set for amendments and revocations of an event
+	Kind           *string    `json:"kind,omitempty"`
+	RevisesEventID *uuid.UUID `json:"revisesEventId,omitempty"`
+	Reason         *string    `json:"reason,omitempty"`
+	// TypeDefinitionCID is only set for events of custom types registered by entities
+	TypeDefinitionCID *string `json:"typeDefinitionCid,omitempty"`
 }
 
 func vehicleToVehicleRecord(v vehicles.Vehicle) VehicleRecord {
@@ -66,8 +78,9 @@ func EventRecordFromEvent(e *event.Event, imageCIDs []string) EventRecord {
 }
 
 func eventToEventRecord(e event.Event, imageCIDs []string) EventRecord {
-	return EventRecord{
+	record := EventRecord{
 		ID:          e.ID,
+		PreviousCID: e.PreviousCID,
 		EntityID:    e.EntityID,
 		Type:        ptr(string(e.Type)),
 		Title:       &e.Title,
@@ -78,4 +91,10 @@ func eventToEventRecord(e event.Event, imageCIDs []string) EventRecord {
 		ImageCIDs:   imageCIDs,
 		CreatedAt:   e.CreatedAt,
 	}
+	if e.Kind != "" && e.Kind != event.KindOriginal {
+		record.Kind = ptr(string(e.Kind))
+		record.RevisesEventID = e.RevisesEventID
+		record.Reason = e.Reason
+	}
+	return record
 }
@@ -0,0 +1,103 @@
+package anchorer
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	cidpkg "github.com/ClassicCarsRestore/ClassicsChain/pkg/cid"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
+)
+
+// Mode selects how pending records are written to the chain
+type Mode string
+
+const (
+	// ModeDirect anchors every record with its own self-transfer on the vehicle's asset
+	ModeDirect Mode = "direct"
+	// ModeMerkle anchors the events of a batch with a single transaction carrying the root of a
+	// Merkle tree over their CIDs. Each event stores its inclusion proof.
+	ModeMerkle Mode = "merkle"
+)
+
+// NoteTypeMerkleRoot is the note type of a transaction anchoring a Merkle root
+const NoteTypeMerkleRoot = "merkle_root"
+
+// AnchorMerkleBatch anchors the events of the batch under one Merkle root, sent as a zero-amount
+// self-payment, and stores each event's inclusion proof with the root transaction. Vehicle
+// versions update the vehicle record itself and are anchored on its asset as in AnchorBatch.
+// The returned errors are per record, in the order of anchors.
+func (a *Anchorer) AnchorMerkleBatch(ctx context.Context, anchors []Anchor) []error {
+	errs := make([]error, len(anchors))
+
+	var versions []Anchor
+	var versionIndexes []int
+	var leaves []string
+	var leafIndexes []int
+	cids := make([]*cidpkg.CID, len(anchors))
+	for i, anchor := range anchors {
+		if anchor.Version != nil {
+			versions = append(versions, anchor)
+			versionIndexes = append(versionIndexes, i)
+			continue
+		}
+
+		cidData, err := anchorCID(anchor)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		cids[i] = cidData
+		leaves = append(leaves, cidData.CID)
+		leafIndexes = append(leafIndexes, i)
+	}
+
+	if len(versions) > 0 {
+		for i, err := range a.AnchorBatch(ctx, versions) {
+			errs[versionIndexes[i]] = err
+		}
+	}
+
+	tree := merkle.Build(leaves)
+	if tree == nil {
+		return errs
+	}
+
+	confirmed, err := a.ac.SelfPayment(ctx, []byte(merkleRootNote(tree.Root())))
+	if err != nil {
+		txID := submittedTxIDs(err, 1)[0]
+		for _, i := range leafIndexes {
+			a.recordFailed(ctx, anchorTarget(anchors[i]), txID, err)
+			errs[i] = fmt.Errorf("anchorer failed to send merkle root: %w", err)
+		}
+		return errs
+	}
+
+	log.Printf("anchored merkle root %s over %d events on transaction %s", tree.Root(), len(leaves), confirmed.ID)
+
+	for leaf, i := range leafIndexes {
+		proof := tree.Proof(leaf)
+		a.recordConfirmed(ctx, anchorTarget(anchors[i]), *confirmed)
+		errs[i] = a.completeMerkleAnchor(ctx, anchors[i], cids[i], confirmed.ID, &proof)
+	}
+
+	return errs
+}
+
+// completeMerkleAnchor stores the root transaction and the inclusion proof on the event
+func (a *Anchorer) completeMerkleAnchor(ctx context.Context, anchor Anchor, cidData *cidpkg.CID, txID string, proof *merkle.Proof) error {
+	evt := *anchor.Event
+	evt.BlockchainTxID = &txID
+	evt.CID = &cidData.CID
+	evt.CIDSourceJSON = &cidData.SourceJSON
+	evt.CIDSourceCBOR = &cidData.SourceCBOR
+	evt.MerkleProof = proof
+	if err := a.eventRepo.Update(ctx, evt); err != nil {
+		return fmt.Errorf("anchorer event update failed to update event: %w", err)
+	}
+	return nil
+}
+
+func merkleRootNote(root string) string {
+	return fmt.Sprintf("type=%s|root=%s", NoteTypeMerkleRoot, root)
+}
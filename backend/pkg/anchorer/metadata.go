@@ -0,0 +1,113 @@
+package anchorer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ARC69Standard identifies ARC-69 metadata, kept in the note of the asset's creation transaction
+	// so wallets and explorers can render the asset without fetching anything off chain
+	ARC69Standard = "arc69"
+
+	// DefaultAssetMetadataBaseURL is the production public asset metadata endpoint, the base of the
+	// asset URL when none is configured
+	DefaultAssetMetadataBaseURL = "https://api.classicschain.com/v1/public/asset-metadata"
+
+	// maxNoteSize is the largest note an Algorand transaction can carry
+	maxNoteSize = 1024
+)
+
+// AssetMetadata is the ARC-69 metadata of a vehicle asset. Besides describing the vehicle it
+// carries the genesis CID, so the creation note still anchors the vehicle's first record.
+type AssetMetadata struct {
+	Standard    string                  `json:"standard"`
+	Description string                  `json:"description,omitempty"`
+	Properties  AssetMetadataProperties `json:"properties"`
+}
+
+// AssetMetadataProperties are the ARC-69 properties of a vehicle asset. Only fields shown on the
+// public passport are included.
+type AssetMetadataProperties struct {
+	Type  string `json:"type"`
+	CID   string `json:"cid"`
+	Make  string `json:"make,omitempty"`
+	Model string `json:"model,omitempty"`
+	Year  int    `json:"year,omitempty"`
+}
+
+// AssetURL returns the URL of the vehicle's asset, where its metadata is served. ARC-69 suggests the
+// URL point at the asset's media, but a vehicle has no media when its asset is created and its
+// photos change over time while the URL cannot, so it points at the vehicle's metadata instead.
+func AssetURL(baseURL string, vehicleID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s", strings.TrimRight(baseURL, "/"), vehicleID.String())
+}
+
+// GenesisAssetMetadata builds the metadata of a vehicle asset from the vehicle's genesis CID and
+// the JSON of the record it was computed from. Vehicle details are left out when they would not
+// fit in a transaction note.
+func GenesisAssetMetadata(cid, sourceJSON string) (AssetMetadata, error) {
+	// Only the described fields are decoded, the rest of the record is covered by the CID
+	var record struct {
+		Make  *string `json:"make"`
+		Model *string `json:"model"`
+		Year  *int    `json:"year"`
+	}
+	if err := json.Unmarshal([]byte(sourceJSON), &record); err != nil {
+		return AssetMetadata{}, fmt.Errorf("decode genesis record: %w", err)
+	}
+
+	metadata := AssetMetadata{
+		Standard: ARC69Standard,
+		Properties: AssetMetadataProperties{
+			Type: string(vehicleUpdateTypeGenesis),
+			CID:  cid,
+		},
+	}
+	var description []string
+	if record.Year != nil && *record.Year > 0 {
+		metadata.Properties.Year = *record.Year
+		description = append(description, strconv.Itoa(*record.Year))
+	}
+	if record.Make != nil && *record.Make != "" {
+		metadata.Properties.Make = *record.Make
+		description = append(description, *record.Make)
+	}
+	if record.Model != nil && *record.Model != "" {
+		metadata.Properties.Model = *record.Model
+		description = append(description, *record.Model)
+	}
+	if len(description) > 0 {
+		metadata.Description = strings.Join(description, " ")
+	}
+
+	if note, err := metadata.Note(); err != nil || len(note) > maxNoteSize {
+		metadata.Description = ""
+		metadata.Properties = AssetMetadataProperties{Type: metadata.Properties.Type, CID: cid}
+	}
+	return metadata, nil
+}
+
+// Note encodes the metadata as an ARC-69 transaction note
+func (m AssetMetadata) Note() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// parseARC69Note decodes an ARC-69 creation note into the anchored record it carries
+func parseARC69Note(note []byte) (Note, error) {
+	var metadata AssetMetadata
+	if err := json.Unmarshal(note, &metadata); err != nil {
+		return Note{}, fmt.Errorf("malformed metadata note: %w", err)
+	}
+	if metadata.Standard != ARC69Standard {
+		return Note{}, fmt.Errorf("unsupported metadata standard %q", metadata.Standard)
+	}
+	if metadata.Properties.Type == "" || metadata.Properties.CID == "" {
+		return Note{}, fmt.Errorf("metadata note is missing type or cid")
+	}
+	return Note{Type: metadata.Properties.Type, CID: metadata.Properties.CID}, nil
+}
@@ -0,0 +1,82 @@
+package anchorer
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchors"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+)
+
+// recordConfirmed stores the outcome of a confirmed transaction anchoring the target record.
+// Failing to store it is logged only, as the record is anchored regardless.
+func (a *Anchorer) recordConfirmed(ctx context.Context, target anchors.Anchor, txn algorand.Transaction) {
+	target.Status = anchors.StatusConfirmed
+	target.TxID = &txn.ID
+	target.TxType = &txn.Type
+	target.Sender = txn.Sender
+	target.ConfirmedRound = &txn.ConfirmedRound
+	target.FeeMicroAlgos = &txn.Fee
+	if txn.AssetID != 0 {
+		target.AssetID = &txn.AssetID
+	}
+	if !txn.RoundTime.IsZero() {
+		target.RoundTime = &txn.RoundTime
+	}
+	a.record(ctx, target)
+}
+
+// recordFailed stores a transaction anchoring the target record that was not confirmed. txID is
+// the transaction that was sent, when it is known.
+func (a *Anchorer) recordFailed(ctx context.Context, target anchors.Anchor, txID *string, err error) {
+	message := err.Error()
+	target.Status = anchors.StatusFailed
+	target.Error = &message
+	target.TxID = txID
+	target.Sender = a.ac.Address()
+	a.record(ctx, target)
+}
+
+func (a *Anchorer) record(ctx context.Context, anchor anchors.Anchor) {
+	if a.anchorRepo == nil {
+		return
+	}
+	anchor.Network = a.network
+	if _, err := a.anchorRepo.Create(ctx, anchor); err != nil {
+		log.Printf("anchorer failed to record %s anchor of vehicle %s: %v", anchor.Status, anchor.VehicleID, err)
+	}
+}
+
+// genesisTarget identifies the genesis record of the vehicle
+func genesisTarget(vehicle vehicles.Vehicle) anchors.Anchor {
+	return anchors.Anchor{VehicleID: vehicle.ID, RecordType: anchors.RecordTypeVehicle}
+}
+
+// anchorTarget identifies the record of an anchor
+func anchorTarget(anchor Anchor) anchors.Anchor {
+	target := anchors.Anchor{VehicleID: anchor.Vehicle.ID}
+	if anchor.Version != nil {
+		target.RecordType = anchors.RecordTypeVehicleVersion
+		target.VehicleVersionID = &anchor.Version.ID
+		return target
+	}
+	target.RecordType = anchors.RecordTypeEvent
+	target.EventID = &anchor.Event.ID
+	return target
+}
+
+// submittedTxIDs returns the IDs of the n transactions that were sent before err, or nil IDs when
+// the submission failed before they were sent
+func submittedTxIDs(err error, n int) []*string {
+	txIDs := make([]*string, n)
+
+	var submitErr *algorand.SubmitError
+	if errors.As(err, &submitErr) && len(submitErr.TxIDs) == n {
+		for i := range submitErr.TxIDs {
+			txIDs[i] = &submitErr.TxIDs[i]
+		}
+	}
+	return txIDs
+}
@@ -67,6 +67,55 @@ func GenerateFileCID(content []byte) (string, error) {
 	return ipfscid.NewCidV1(ipfscid.Raw, hash).String(), nil
 }
 
+// CIDFromSourceCBOR recomputes the CID of a base64-encoded DAG-CBOR block, as stored
+// in CID.SourceCBOR. The block is decoded and re-encoded before hashing so that bytes
+// which are not in canonical DAG-CBOR form are rejected instead of silently hashed.
+func CIDFromSourceCBOR(sourceCBOR string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(sourceCBOR)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode base64 source: %w", err)
+	}
+
+	node, err := ipldDecode(raw)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode DAG-CBOR: %w", err)
+	}
+
+	var cborBuf bytes.Buffer
+	if err := dagcbor.Encode(node, &cborBuf); err != nil {
+		return "", fmt.Errorf("failed to re-encode as DAG-CBOR: %w", err)
+	}
+	if !bytes.Equal(cborBuf.Bytes(), raw) {
+		return "", fmt.Errorf("source is not canonical DAG-CBOR")
+	}
+
+	hash, err := mh.Sum(cborBuf.Bytes(), mh.SHA2_256, -1)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash: %w", err)
+	}
+	return ipfscid.NewCidV1(ipfscid.DagCBOR, hash).String(), nil
+}
+
+// SourceJSONFromCBOR derives the JSON form of a base64-encoded DAG-CBOR block the same way
+// GenerateCID derives CID.SourceJSON, so a stored JSON source can be checked against its block.
+func SourceJSONFromCBOR(sourceCBOR string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(sourceCBOR)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode base64 source: %w", err)
+	}
+
+	node, err := ipldDecode(raw)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode DAG-CBOR: %w", err)
+	}
+
+	jsonBytes, err := ipldNodeToJSON(node)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize IPLD node to JSON: %w", err)
+	}
+	return string(jsonBytes), nil
+}
+
 // CIDGenerator provides methods to generate CIDs for structured data and file content
 type CIDGenerator struct{}
 
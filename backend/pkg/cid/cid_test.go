@@ -440,6 +440,54 @@ func TestGenerateCID_SourceJSONKeyOrderMatchesCBOR(t *testing.T) {
 	}
 }
 
+func TestCIDFromSourceCBOR_MatchesGeneratedCID(t *testing.T) {
+	record := testVehicleRecord{
+		ID:            uuid.New(),
+		ChassisNumber: ptr("WBA12345"),
+		Make:          ptr("BMW"),
+		Model:         ptr("2002"),
+		Year:          ptr(1972),
+		CreatedAt:     time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+	}
+
+	generated, err := GenerateCID(record)
+	require.NoError(t, err)
+
+	recomputed, err := CIDFromSourceCBOR(generated.SourceCBOR)
+	require.NoError(t, err)
+	assert.Equal(t, generated.CID, recomputed)
+}
+
+func TestCIDFromSourceCBOR_InvalidBase64(t *testing.T) {
+	_, err := CIDFromSourceCBOR("not base64!")
+	assert.Error(t, err)
+}
+
+func TestSourceJSONFromCBOR_MatchesGeneratedJSON(t *testing.T) {
+	record := testVehicleRecord{
+		ID:        uuid.New(),
+		Make:      ptr("BMW"),
+		Model:     ptr("2002"),
+		Year:      ptr(1972),
+		CreatedAt: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+	}
+
+	generated, err := GenerateCID(record)
+	require.NoError(t, err)
+
+	sourceJSON, err := SourceJSONFromCBOR(generated.SourceCBOR)
+	require.NoError(t, err)
+	assert.Equal(t, generated.SourceJSON, sourceJSON)
+}
+
+func TestCIDFromSourceCBOR_NonCanonical(t *testing.T) {
+	// {"bb": 1, "a": 2} — canonical DAG-CBOR orders shorter keys first
+	raw := []byte{0xa2, 0x62, 'b', 'b', 0x01, 0x61, 'a', 0x02}
+
+	_, err := CIDFromSourceCBOR(base64.StdEncoding.EncodeToString(raw))
+	assert.Error(t, err)
+}
+
 func extractJSONKeyOrder(t *testing.T, raw string) []string {
 	t.Helper()
 	dec := json.NewDecoder(bytes.NewReader([]byte(raw)))
@@ -0,0 +1,331 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchors"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+const requeueAllBatchSize = 100
+
+// GetFailedAnchors lists vehicles or events whose anchoring failed
+func (a apiServer) GetFailedAnchors(ctx context.Context, request GetFailedAnchorsRequestObject) (GetFailedAnchorsResponseObject, error) {
+	if err := a.authorizer.Authorize(ctx, ResourceAnchors, ActionRead); err != nil {
+		return GetFailedAnchors403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	limit := 20
+	offset := 0
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if request.Params.Page != nil {
+		offset = (*request.Params.Page - 1) * limit
+	}
+
+	var anchors []FailedAnchor
+	var total int
+	switch request.Params.RecordType {
+	case GetFailedAnchorsParamsRecordTypeVehicle:
+		vehicleList, count, err := a.vehicleService.ListByBlockchainStatus(ctx, vehicles.StatusFailed, 0, limit, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list failed vehicle anchors: %w", err)
+		}
+		anchors = make([]FailedAnchor, len(vehicleList))
+		for i, v := range vehicleList {
+			anchors[i] = vehicleToHTTPFailedAnchor(v)
+		}
+		total = count
+	default:
+		events, count, err := a.eventService.ListByBlockchainStatus(ctx, event.StatusFailed, 0, limit, offset)
+		if err != nil {
+			return nil, fmt.Errorf("list failed event anchors: %w", err)
+		}
+		anchors = make([]FailedAnchor, len(events))
+		for i, e := range events {
+			anchors[i] = eventToHTTPFailedAnchor(e)
+		}
+		total = count
+	}
+
+	totalPages := (total + limit - 1) / limit
+	page := (offset / limit) + 1
+
+	return GetFailedAnchors200JSONResponse{
+		Data: anchors,
+		Meta: PaginationMeta{
+			Page:       page,
+			Limit:      limit,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+// RequeueAnchor publishes the anchor job again for a single vehicle or event
+func (a apiServer) RequeueAnchor(ctx context.Context, request RequeueAnchorRequestObject) (RequeueAnchorResponseObject, error) {
+	if err := a.authorizer.Authorize(ctx, ResourceAnchors, ActionUpdate); err != nil {
+		return RequeueAnchor403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	var anchor FailedAnchor
+	switch request.RecordType {
+	case RequeueAnchorParamsRecordTypeVehicle:
+		v, err := a.vehicleService.RequeueAnchor(ctx, request.RecordId)
+		if err != nil {
+			if errors.Is(err, vehicles.ErrVehicleNotFound) {
+				return RequeueAnchor404JSONResponse{
+					NotFoundJSONResponse: NotFoundJSONResponse{
+						Error: "Vehicle not found",
+					},
+				}, nil
+			}
+			if errors.Is(err, vehicles.ErrAnchorNotRequeueable) {
+				return RequeueAnchor409JSONResponse{
+					Error: err.Error(),
+					Code:  "not_requeueable",
+				}, nil
+			}
+			return nil, err
+		}
+		anchor = vehicleToHTTPFailedAnchor(*v)
+	default:
+		e, err := a.eventService.RequeueAnchor(ctx, request.RecordId)
+		if err != nil {
+			if errors.Is(err, event.ErrEventNotFound) {
+				return RequeueAnchor404JSONResponse{
+					NotFoundJSONResponse: NotFoundJSONResponse{
+						Error: "Event not found",
+					},
+				}, nil
+			}
+			if errors.Is(err, event.ErrAnchorNotRequeueable) {
+				return RequeueAnchor409JSONResponse{
+					Error: err.Error(),
+					Code:  "not_requeueable",
+				}, nil
+			}
+			return nil, err
+		}
+		anchor = eventToHTTPFailedAnchor(*e)
+	}
+
+	return RequeueAnchor200JSONResponse(anchor), nil
+}
+
+// RequeueAnchors publishes the anchor job again for several records, or for every failed record
+func (a apiServer) RequeueAnchors(ctx context.Context, request RequeueAnchorsRequestObject) (RequeueAnchorsResponseObject, error) {
+	if err := a.authorizer.Authorize(ctx, ResourceAnchors, ActionUpdate); err != nil {
+		return RequeueAnchors403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	if request.Body == nil {
+		return RequeueAnchors400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	requeue := func(id uuid.UUID) error {
+		_, err := a.eventService.RequeueAnchor(ctx, id)
+		return err
+	}
+	if request.Body.RecordType == RequeueAnchorsRequestRecordTypeVehicle {
+		requeue = func(id uuid.UUID) error {
+			_, err := a.vehicleService.RequeueAnchor(ctx, id)
+			return err
+		}
+	}
+
+	var ids []uuid.UUID
+	if request.Body.Ids != nil {
+		ids = *request.Body.Ids
+	} else {
+		var err error
+		ids, err = a.listFailedAnchorIDs(ctx, request.Body.RecordType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	requeued := 0
+	skipped := []uuid.UUID{}
+	for _, id := range ids {
+		err := requeue(id)
+		switch {
+		case err == nil:
+			requeued++
+		case errors.Is(err, vehicles.ErrVehicleNotFound), errors.Is(err, vehicles.ErrAnchorNotRequeueable),
+			errors.Is(err, event.ErrEventNotFound), errors.Is(err, event.ErrAnchorNotRequeueable):
+			skipped = append(skipped, id)
+		default:
+			return nil, fmt.Errorf("requeue anchor %s: %w", id, err)
+		}
+	}
+
+	return RequeueAnchors200JSONResponse{
+		Requeued: requeued,
+		Skipped:  skipped,
+	}, nil
+}
+
+// listFailedAnchorIDs collects the IDs of every failed record of the given type
+func (a apiServer) listFailedAnchorIDs(ctx context.Context, recordType RequeueAnchorsRequestRecordType) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for offset := 0; ; offset += requeueAllBatchSize {
+		var batch []uuid.UUID
+		if recordType == RequeueAnchorsRequestRecordTypeVehicle {
+			vehicleList, _, err := a.vehicleService.ListByBlockchainStatus(ctx, vehicles.StatusFailed, 0, requeueAllBatchSize, offset)
+			if err != nil {
+				return nil, fmt.Errorf("list failed vehicle anchors: %w", err)
+			}
+			for _, v := range vehicleList {
+				batch = append(batch, v.ID)
+			}
+		} else {
+			events, _, err := a.eventService.ListByBlockchainStatus(ctx, event.StatusFailed, 0, requeueAllBatchSize, offset)
+			if err != nil {
+				return nil, fmt.Errorf("list failed event anchors: %w", err)
+			}
+			for _, e := range events {
+				batch = append(batch, e.ID)
+			}
+		}
+
+		ids = append(ids, batch...)
+		if len(batch) < requeueAllBatchSize {
+			return ids, nil
+		}
+	}
+}
+
+func vehicleToHTTPFailedAnchor(v vehicles.Vehicle) FailedAnchor {
+	return FailedAnchor{
+		RecordType:         FailedAnchorRecordTypeVehicle,
+		RecordId:           v.ID,
+		VehicleId:          v.ID,
+		Label:              fmt.Sprintf("%d %s %s", v.Year, v.Make, v.Model),
+		BlockchainStatus:   FailedAnchorBlockchainStatus(v.BlockchainStatus),
+		BlockchainError:    v.BlockchainError,
+		BlockchainStatusAt: v.BlockchainStatusAt,
+	}
+}
+
+func eventToHTTPFailedAnchor(e event.Event) FailedAnchor {
+	return FailedAnchor{
+		RecordType:         FailedAnchorRecordTypeEvent,
+		RecordId:           e.ID,
+		VehicleId:          e.VehicleID,
+		Label:              e.Title,
+		BlockchainStatus:   FailedAnchorBlockchainStatus(e.BlockchainStatus),
+		BlockchainError:    e.BlockchainError,
+		BlockchainStatusAt: e.BlockchainStatusAt,
+	}
+}
+
+// GetVehicleAnchors lists every transaction submitted to anchor the vehicle's records
+func (a apiServer) GetVehicleAnchors(ctx context.Context, request GetVehicleAnchorsRequestObject) (GetVehicleAnchorsResponseObject, error) {
+	_, err := a.checkVehicleAccess(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, ErrVehicleNotFound) {
+			return GetVehicleAnchors404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrAuthenticationRequired) {
+			return GetVehicleAnchors401JSONResponse{
+				UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrForbiddenVehicleAccess) {
+			return GetVehicleAnchors403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	list, err := a.anchorService.ListByVehicle(ctx, request.VehicleId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]AnchorTransaction, len(list))
+	for i, anchor := range list {
+		result[i] = domainAnchorToHTTP(anchor)
+	}
+	return GetVehicleAnchors200JSONResponse(result), nil
+}
+
+// vehicleAnchors returns the current anchor of each record of the vehicle. Anchor details only
+// supplement the records, so they are left out when they cannot be loaded.
+func (a apiServer) vehicleAnchors(ctx context.Context, vehicleID uuid.UUID) anchors.Summary {
+	list, err := a.anchorService.ListByVehicle(ctx, vehicleID)
+	if err != nil {
+		log.Printf("failed to load anchors of vehicle %s: %v", vehicleID, err)
+	}
+	return anchors.Summarize(list)
+}
+
+// httpAnchorOf returns the anchor of the record with the given ID, if it has one
+func httpAnchorOf(byRecord map[uuid.UUID]anchors.Anchor, id uuid.UUID) *AnchorTransaction {
+	anchor, ok := byRecord[id]
+	if !ok {
+		return nil
+	}
+	result := domainAnchorToHTTP(anchor)
+	return &result
+}
+
+func domainAnchorToHTTP(anchor anchors.Anchor) AnchorTransaction {
+	return AnchorTransaction{
+		Id:               anchor.ID,
+		RecordType:       AnchorTransactionRecordType(anchor.RecordType),
+		EventId:          anchor.EventID,
+		VehicleVersionId: anchor.VehicleVersionID,
+		Status:           AnchorTransactionStatus(anchor.Status),
+		Error:            anchor.Error,
+		Network:          anchor.Network,
+		Sender:           anchor.Sender,
+		TxId:             anchor.TxID,
+		TxType:           anchor.TxType,
+		AssetId:          uint64PtrToInt64Ptr(anchor.AssetID),
+		ConfirmedRound:   uint64PtrToInt64Ptr(anchor.ConfirmedRound),
+		RoundTime:        anchor.RoundTime,
+		FeeMicroAlgos:    uint64PtrToInt64Ptr(anchor.FeeMicroAlgos),
+		CreatedAt:        anchor.CreatedAt,
+	}
+}
+
+func uint64PtrToInt64Ptr(u *uint64) *int64 {
+	if u == nil {
+		return nil
+	}
+	v := int64(*u)
+	return &v
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/anchorer"
+)
+
+func (a apiServer) GetVehicleAssetMetadata(ctx context.Context, request GetVehicleAssetMetadataRequestObject) (GetVehicleAssetMetadataResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return GetVehicleAssetMetadata404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	// The metadata is written with the asset, so vehicles still awaiting genesis have none
+	if vehicle.BlockchainAssetID == nil || vehicle.CID == nil || vehicle.CIDSourceJSON == nil {
+		return GetVehicleAssetMetadata404JSONResponse{
+			NotFoundJSONResponse: NotFoundJSONResponse{
+				Error: "Vehicle asset not created yet",
+			},
+		}, nil
+	}
+
+	metadata, err := anchorer.GenesisAssetMetadata(*vehicle.CID, *vehicle.CIDSourceJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetVehicleAssetMetadata200JSONResponse(domainAssetMetadataToHTTP(metadata)), nil
+}
+
+func domainAssetMetadataToHTTP(m anchorer.AssetMetadata) AssetMetadata {
+	result := AssetMetadata{
+		Standard: AssetMetadataStandard(m.Standard),
+		Properties: AssetMetadataProperties{
+			Type: m.Properties.Type,
+			Cid:  m.Properties.CID,
+		},
+	}
+	if m.Description != "" {
+		result.Description = &m.Description
+	}
+	if m.Properties.Make != "" {
+		result.Properties.Make = &m.Properties.Make
+	}
+	if m.Properties.Model != "" {
+		result.Properties.Model = &m.Properties.Model
+	}
+	if m.Properties.Year != 0 {
+		result.Properties.Year = &m.Properties.Year
+	}
+	return result
+}
@@ -16,14 +16,21 @@ const (
 	ResourceEntities    = "entities"
 	ResourceCertifiers  = "certifiers"
 	ResourcePartners    = "partners"
+	ResourceAnchors     = "anchors"
+	ResourceLifecycle   = "lifecycle_requests"
+	// ResourceStolenReports covers the review of stolen vehicle reports
+	ResourceStolenReports = "stolen_reports"
+	// ResourceStolenVehicles covers the integration lookup of flagged vehicles
+	ResourceStolenVehicles = "stolen_vehicles"
 )
 
 // Authorization action names
 const (
-	ActionCreate = "create"
-	ActionRead   = "read"
-	ActionUpdate = "update"
-	ActionDelete = "delete"
+	ActionCreate   = "create"
+	ActionRead     = "read"
+	ActionUpdate   = "update"
+	ActionDelete   = "delete"
+	ActionTransfer = "transfer"
 )
 
 // Entity role names
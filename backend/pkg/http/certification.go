@@ -0,0 +1,333 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/certification"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_images"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+// authorizeCertificationRequester checks that the current user owns the vehicle of the owner event
+func (a apiServer) authorizeCertificationRequester(ctx context.Context, evt *event.Event) (*vehicles.Vehicle, error) {
+	if _, ok := auth.GetIdentityID(ctx); !ok {
+		return nil, ErrAuthenticationRequired
+	}
+	if err := a.authorizer.Authorize(ctx, ResourceOwnerEvents, ActionCreate); err != nil {
+		return nil, ErrForbiddenVehicleAccess
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, evt.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+	if !isVehicleOwner(ctx, vehicle) {
+		return nil, ErrForbiddenVehicleAccess
+	}
+	return vehicle, nil
+}
+
+// authorizeCertificationReviewer checks that the current user may certify events on behalf of the entity
+func (a apiServer) authorizeCertificationReviewer(ctx context.Context, entityID uuid.UUID) error {
+	if err := a.authorizer.Authorize(ctx, ResourceEvents, ActionCreate); err != nil {
+		return err
+	}
+	return a.authorizer.AuthorizeEntityMembership(ctx, entityID, "")
+}
+
+func (a apiServer) GetEventCertificationRequests(ctx context.Context, request GetEventCertificationRequestsRequestObject) (GetEventCertificationRequestsResponseObject, error) {
+	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) {
+			return GetEventCertificationRequests404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if _, err := a.authorizeCertificationRequester(ctx, evt); err != nil {
+		if errors.Is(err, ErrAuthenticationRequired) {
+			return GetEventCertificationRequests401JSONResponse{
+				UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrForbiddenVehicleAccess) {
+			return GetEventCertificationRequests403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: "Forbidden: only the vehicle owner can view this event's certification requests",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	requests, err := a.certificationService.ListByEvent(ctx, evt.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequests := make([]CertificationRequest, len(requests))
+	for i, r := range requests {
+		httpRequests[i] = domainCertificationRequestToHTTP(r)
+	}
+
+	return GetEventCertificationRequests200JSONResponse(httpRequests), nil
+}
+
+func (a apiServer) RequestEventCertification(ctx context.Context, request RequestEventCertificationRequestObject) (RequestEventCertificationResponseObject, error) {
+	if request.Body == nil {
+		return RequestEventCertification400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) {
+			return RequestEventCertification404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	vehicle, err := a.authorizeCertificationRequester(ctx, evt)
+	if err != nil {
+		if errors.Is(err, ErrAuthenticationRequired) {
+			return RequestEventCertification401JSONResponse{
+				UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrForbiddenVehicleAccess) {
+			return RequestEventCertification403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: "Forbidden: only the vehicle owner can request certification of this event",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	identityID, _ := auth.GetIdentityID(ctx)
+	created, err := a.certificationService.Submit(ctx, *vehicle, evt.ID, certification.SubmitParams{
+		EntityID:          request.Body.EntityId,
+		RequestedBy:       identityID,
+		EvidenceSessionID: request.Body.EvidenceSessionId,
+		Message:           request.Body.Message,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrEntityNotFound):
+			return RequestEventCertification404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Entity not found",
+				},
+			}, nil
+		case errors.Is(err, certification.ErrNotOwnerEvent),
+			errors.Is(err, certification.ErrNotCertifier),
+			errors.Is(err, event_images.ErrSessionHasNoImages),
+			errors.Is(err, event_images.ErrImageNotConfirmed),
+			errors.Is(err, event_images.ErrMaxImagesExceeded):
+			return RequestEventCertification400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, certification.ErrRequestAlreadyPending):
+			return RequestEventCertification409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RequestEventCertification201JSONResponse(domainCertificationRequestToHTTP(*created)), nil
+}
+
+func (a apiServer) GetEntityCertificationRequests(ctx context.Context, request GetEntityCertificationRequestsRequestObject) (GetEntityCertificationRequestsResponseObject, error) {
+	if err := a.authorizer.AuthorizeEntityMembership(ctx, request.EntityId, ""); err != nil {
+		return GetEntityCertificationRequests403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to review its certification requests",
+			},
+		}, nil
+	}
+
+	status := ""
+	if request.Params.Status != nil {
+		status = string(*request.Params.Status)
+	}
+
+	requests, err := a.certificationService.ListByEntity(ctx, request.EntityId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reviewers see the effective submission together with the evidence provided for it
+	httpRequests := make([]CertificationRequest, len(requests))
+	for i, r := range requests {
+		httpRequests[i] = domainCertificationRequestToHTTP(r)
+
+		evt, _, err := a.eventService.GetWithRevisions(ctx, r.EventID)
+		if err != nil {
+			return nil, err
+		}
+		images, _ := a.eventImageService.ListByEvent(ctx, evt.ID)
+		httpEvent := domainToHTTPEvent(*evt, images)
+		httpRequests[i].Event = &httpEvent
+
+		if r.EvidenceSessionID != nil {
+			evidence, _ := a.eventImageService.ListBySession(ctx, *r.EvidenceSessionID)
+			httpRequests[i].EvidenceImages = domainEventImagesToHTTP(evidence)
+		}
+	}
+
+	return GetEntityCertificationRequests200JSONResponse(httpRequests), nil
+}
+
+func (a apiServer) EndorseCertificationRequest(ctx context.Context, request EndorseCertificationRequestRequestObject) (EndorseCertificationRequestResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return EndorseCertificationRequest401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+
+	if err := a.authorizeCertificationReviewer(ctx, request.EntityId); err != nil {
+		return EndorseCertificationRequest403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to endorse its certification requests",
+			},
+		}, nil
+	}
+
+	var metadata map[string]interface{}
+	if request.Body != nil {
+		metadata = getMetadataValue(request.Body.Metadata)
+	}
+
+	endorsed, err := a.certificationService.Endorse(ctx, request.EntityId, request.RequestId, identityID, metadata)
+	if err != nil {
+		if resp, ok := metadataValidationResponse(err); ok {
+			return EndorseCertificationRequest400JSONResponse{BadRequestJSONResponse: resp}, nil
+		}
+		switch {
+		case errors.Is(err, certification.ErrRequestNotFound):
+			return EndorseCertificationRequest404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Certification request not found",
+				},
+			}, nil
+		case errors.Is(err, certification.ErrRequestNotPending), errors.Is(err, certification.ErrOwnerChanged):
+			return EndorseCertificationRequest409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return EndorseCertificationRequest200JSONResponse(domainCertificationRequestToHTTP(*endorsed)), nil
+}
+
+func (a apiServer) DeclineCertificationRequest(ctx context.Context, request DeclineCertificationRequestRequestObject) (DeclineCertificationRequestResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return DeclineCertificationRequest401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+
+	if err := a.authorizeCertificationReviewer(ctx, request.EntityId); err != nil {
+		return DeclineCertificationRequest403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to decline its certification requests",
+			},
+		}, nil
+	}
+
+	var reason *string
+	if request.Body != nil {
+		reason = request.Body.Reason
+	}
+
+	declined, err := a.certificationService.Decline(ctx, request.EntityId, request.RequestId, identityID, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, certification.ErrRequestNotFound):
+			return DeclineCertificationRequest404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Certification request not found",
+				},
+			}, nil
+		case errors.Is(err, certification.ErrRequestNotPending):
+			return DeclineCertificationRequest409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return DeclineCertificationRequest200JSONResponse(domainCertificationRequestToHTTP(*declined)), nil
+}
+
+func domainCertificationRequestToHTTP(r certification.Request) CertificationRequest {
+	return CertificationRequest{
+		Id:               r.ID,
+		EventId:          r.EventID,
+		VehicleId:        r.VehicleID,
+		EntityId:         r.EntityID,
+		Message:          r.Message,
+		Status:           CertificationRequestStatus(r.Status),
+		DeclineReason:    r.DeclineReason,
+		CertifiedEventId: r.CertifiedEventID,
+		CreatedAt:        r.CreatedAt,
+		DecidedAt:        r.DecidedAt,
+	}
+}
+
+// vehicleCertifications returns the certifications of a vehicle with their current status
+func (a apiServer) vehicleCertifications(ctx context.Context, vehicleID uuid.UUID) (*[]CertificationValidity, error) {
+	certs, err := a.certificationTracker.ListByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]CertificationValidity, len(certs))
+	for i, c := range certs {
+		result[i] = CertificationValidity{
+			EventId:           c.EventID,
+			EntityId:          c.EntityID,
+			CertificateNumber: c.CertificateNumber,
+			ValidFrom:         c.ValidFrom,
+			ValidUntil:        c.ValidUntil,
+			Status:            CertificationValidityStatus(c.Status),
+		}
+	}
+	return &result, nil
+}
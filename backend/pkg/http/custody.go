@@ -0,0 +1,229 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/custody"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+)
+
+func (a apiServer) CreateWalletChallenge(ctx context.Context, request CreateWalletChallengeRequestObject) (CreateWalletChallengeResponseObject, error) {
+	userID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return CreateWalletChallenge401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "No user identity found",
+			},
+		}, nil
+	}
+	if request.Body == nil {
+		return CreateWalletChallenge400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	challenge, err := a.custodyService.CreateChallenge(ctx, userID, request.Body.Address)
+	if err != nil {
+		if errors.Is(err, algorand.ErrInvalidAddress) {
+			return CreateWalletChallenge400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return CreateWalletChallenge201JSONResponse{
+		Address:   challenge.Address,
+		Message:   challenge.Message,
+		ExpiresAt: challenge.ExpiresAt,
+	}, nil
+}
+
+func (a apiServer) GetMyWallet(ctx context.Context, request GetMyWalletRequestObject) (GetMyWalletResponseObject, error) {
+	userID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return GetMyWallet401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "No user identity found",
+			},
+		}, nil
+	}
+
+	wallet, err := a.custodyService.GetWallet(ctx, userID)
+	if err != nil {
+		if errors.Is(err, custody.ErrWalletNotLinked) {
+			return GetMyWallet404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "No wallet linked",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return GetMyWallet200JSONResponse(domainWalletToHTTP(*wallet)), nil
+}
+
+func (a apiServer) LinkMyWallet(ctx context.Context, request LinkMyWalletRequestObject) (LinkMyWalletResponseObject, error) {
+	userID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return LinkMyWallet401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "No user identity found",
+			},
+		}, nil
+	}
+	if request.Body == nil {
+		return LinkMyWallet400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	wallet, err := a.custodyService.LinkWallet(ctx, userID, request.Body.Address, request.Body.Signature)
+	if err != nil {
+		switch {
+		case errors.Is(err, custody.ErrChallengeNotFound),
+			errors.Is(err, custody.ErrInvalidSignature):
+			return LinkMyWallet400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, custody.ErrWalletInUse):
+			return LinkMyWallet409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return LinkMyWallet200JSONResponse(domainWalletToHTTP(*wallet)), nil
+}
+
+func (a apiServer) UnlinkMyWallet(ctx context.Context, request UnlinkMyWalletRequestObject) (UnlinkMyWalletResponseObject, error) {
+	userID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return UnlinkMyWallet401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "No user identity found",
+			},
+		}, nil
+	}
+
+	if err := a.custodyService.UnlinkWallet(ctx, userID); err != nil {
+		if errors.Is(err, custody.ErrWalletNotLinked) {
+			return UnlinkMyWallet404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "No wallet linked",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return UnlinkMyWallet204Response{}, nil
+}
+
+func (a apiServer) GetVehicleCustody(ctx context.Context, request GetVehicleCustodyRequestObject) (GetVehicleCustodyResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return GetVehicleCustody404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !isVehicleOwner(ctx, vehicle) {
+		if err := a.authorizer.Authorize(ctx, ResourceVehicles, ActionRead); err != nil {
+			return GetVehicleCustody403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: "Forbidden: You don't have permission to access this vehicle's custody",
+				},
+			}, nil
+		}
+	}
+
+	c, err := a.custodyService.GetCustody(ctx, vehicle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetVehicleCustody200JSONResponse(domainCustodyToHTTP(*c)), nil
+}
+
+func (a apiServer) RequestVehicleAssetDelivery(ctx context.Context, request RequestVehicleAssetDeliveryRequestObject) (RequestVehicleAssetDeliveryResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return RequestVehicleAssetDelivery404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !isVehicleOwner(ctx, vehicle) {
+		return RequestVehicleAssetDelivery403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: only the vehicle owner can receive its asset",
+			},
+		}, nil
+	}
+
+	pending, err := a.custodyService.RequestDelivery(ctx, vehicle)
+	if err != nil {
+		switch {
+		case errors.Is(err, custody.ErrWalletNotLinked),
+			errors.Is(err, custody.ErrNotOptedIn),
+			errors.Is(err, custody.ErrAssetNotCreated),
+			errors.Is(err, custody.ErrNoOwner),
+			errors.Is(err, vehicles.ErrVehicleNotActive):
+			return RequestVehicleAssetDelivery409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RequestVehicleAssetDelivery202JSONResponse(domainCustodyToHTTP(*pending)), nil
+}
+
+func domainWalletToHTTP(w custody.Wallet) Wallet {
+	return Wallet{
+		Address:  w.Address,
+		LinkedAt: w.LinkedAt,
+	}
+}
+
+func domainCustodyToHTTP(c custody.Custody) VehicleCustody {
+	result := VehicleCustody{
+		VehicleId:     c.VehicleID,
+		Status:        VehicleCustodyStatus(c.Status),
+		HolderAddress: c.HolderAddress,
+		TxId:          c.TxID,
+		Error:         c.Error,
+	}
+	if !c.UpdatedAt.IsZero() {
+		result.UpdatedAt = &c.UpdatedAt
+	}
+	return result
+}
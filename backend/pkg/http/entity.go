@@ -271,11 +271,12 @@ func (a apiServer) UpdateEntity(ctx context.Context, request UpdateEntityRequest
 	}
 
 	params := entity.UpdateEntityParams{
-		Name:         request.Body.Name,
-		Description:  request.Body.Description,
-		ContactEmail: contactEmail,
-		Website:      request.Body.Website,
-		Address:      httpToDomainAddress(request.Body.Address),
+		Name:                    request.Body.Name,
+		Description:             request.Body.Description,
+		ContactEmail:            contactEmail,
+		Website:                 request.Body.Website,
+		Address:                 httpToDomainAddress(request.Body.Address),
+		OwnerApprovalEventTypes: request.Body.OwnerApprovalEventTypes,
 	}
 
 	updatedEntity, err := a.entityService.Update(ctx, request.EntityId, params)
@@ -402,15 +403,16 @@ func (a apiServer) DeleteEntityLogo(ctx context.Context, request DeleteEntityLog
 // domainToHTTPEntity converts a domain entity to HTTP entity
 func domainToHTTPEntity(domainEntity entity.Entity) Entity {
 	return Entity{
-		Id:            domainEntity.ID,
-		Name:          domainEntity.Name,
-		Type:          EntityType(domainEntity.Type),
-		Description:   domainEntity.Description,
-		ContactEmail:  openapi_types.Email(domainEntity.ContactEmail),
-		Website:       domainEntity.Website,
-		Address:       domainToHTTPAddress(domainEntity.Address),
-		CertifiedBy:   domainEntity.CertifiedBy,
-		LogoObjectKey: domainEntity.LogoObjectKey,
+		Id:                      domainEntity.ID,
+		Name:                    domainEntity.Name,
+		Type:                    EntityType(domainEntity.Type),
+		Description:             domainEntity.Description,
+		ContactEmail:            openapi_types.Email(domainEntity.ContactEmail),
+		Website:                 domainEntity.Website,
+		Address:                 domainToHTTPAddress(domainEntity.Address),
+		CertifiedBy:             domainEntity.CertifiedBy,
+		LogoObjectKey:           domainEntity.LogoObjectKey,
+		OwnerApprovalEventTypes: &domainEntity.OwnerApprovalEventTypes,
 	}
 }
 
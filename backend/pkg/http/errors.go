@@ -1,6 +1,36 @@
 package http
 
+import (
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+)
+
 const (
 	ErrDuplicateChassisNoCode = "DUPLICATE_CHASSIS_NO"
 	ErrDuplicateChassisNoMsg  = "A vehicle with this chassis no already exists"
+
+	ErrInvalidMetadataCode = "INVALID_METADATA"
 )
+
+// metadataValidationResponse turns an event metadata validation error into a bad request
+// response listing the rejected fields
+func metadataValidationResponse(err error) (BadRequestJSONResponse, bool) {
+	var validationErr *event.MetadataValidationError
+	if !errors.As(err, &validationErr) {
+		return BadRequestJSONResponse{}, false
+	}
+
+	fields := make([]FieldError, len(validationErr.Fields))
+	for i, f := range validationErr.Fields {
+		fields[i] = FieldError{
+			Field:   "metadata." + f.Field,
+			Message: f.Message,
+		}
+	}
+	return BadRequestJSONResponse{
+		Error:  validationErr.Error(),
+		Code:   ErrInvalidMetadataCode,
+		Fields: &fields,
+	}, true
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_images"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -51,10 +53,12 @@ func (a apiServer) GetVehicleEvents(ctx context.Context, request GetVehicleEvent
 		return nil, err
 	}
 
+	eventAnchors := a.vehicleAnchors(ctx, request.VehicleId).Events
 	httpEvents := make([]Event, len(events))
 	for i, evt := range events {
 		images, _ := a.eventImageService.ListByEvent(ctx, evt.ID)
 		httpEvents[i] = domainToHTTPEvent(evt, images)
+		httpEvents[i].Anchor = httpAnchorOf(eventAnchors, evt.ID)
 	}
 
 	totalPages := (total + limit - 1) / limit
@@ -100,21 +104,50 @@ func (a apiServer) CreateEvent(ctx context.Context, request CreateEventRequestOb
 		}, nil
 	}
 
+	// Entities may require the owner's consent before their events become part of the record;
+	// owners recording events through their own entity consent implicitly
+	requiresApproval := false
+	if request.Body.EntityId != nil && vehicle.OwnerID != nil && !isVehicleOwner(ctx, vehicle) {
+		ent, err := a.entityService.GetByID(ctx, *request.Body.EntityId)
+		if err != nil {
+			if errors.Is(err, entity.ErrEntityNotFound) {
+				return CreateEvent404JSONResponse{
+					NotFoundJSONResponse: NotFoundJSONResponse{
+						Error: "entity not found",
+					},
+				}, nil
+			}
+			return nil, err
+		}
+		requiresApproval = ent.RequiresOwnerApproval(string(request.Body.Type))
+	}
+
 	params := event.CreateEventParams{
-		VehicleID:      request.Body.VehicleId,
-		EntityID:       request.Body.EntityId,
-		Type:           event.EventType(request.Body.Type),
-		Title:          request.Body.Title,
-		Description:    request.Body.Description,
-		Date:           request.Body.Date,
-		Location:       request.Body.Location,
-		Metadata:       getMetadataValue(request.Body.Metadata),
-		ShouldAnchor:   true,
-		ImageSessionID: request.Body.ImageSessionId,
+		VehicleID:             request.Body.VehicleId,
+		EntityID:              request.Body.EntityId,
+		Type:                  event.EventType(request.Body.Type),
+		Title:                 request.Body.Title,
+		Description:           request.Body.Description,
+		Date:                  request.Body.Date,
+		Location:              request.Body.Location,
+		Metadata:              getMetadataValue(request.Body.Metadata),
+		ShouldAnchor:          true,
+		ImageSessionID:        request.Body.ImageSessionId,
+		RequiresOwnerApproval: requiresApproval,
 	}
 
 	createdEvent, err := a.eventService.Create(ctx, *vehicle, params)
 	if err != nil {
+		if resp, ok := metadataValidationResponse(err); ok {
+			return CreateEvent400JSONResponse{BadRequestJSONResponse: resp}, nil
+		}
+		if errors.Is(err, event.ErrUnknownEventType) || errors.Is(err, event.ErrReservedEventType) || errors.Is(err, vehicles.ErrVehicleScrapped) {
+			return CreateEvent400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -184,6 +217,13 @@ func (a apiServer) CreateOwnerEvent(ctx context.Context, request CreateOwnerEven
 
 	createdEvent, err := a.eventService.Create(ctx, *vehicle, params)
 	if err != nil {
+		if errors.Is(err, event.ErrUnknownEventType) || errors.Is(err, event.ErrReservedEventType) || errors.Is(err, vehicles.ErrVehicleScrapped) {
+			return CreateOwnerEvent400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -193,7 +233,7 @@ func (a apiServer) CreateOwnerEvent(ctx context.Context, request CreateOwnerEven
 }
 
 func (a apiServer) GetEvent(ctx context.Context, request GetEventRequestObject) (GetEventResponseObject, error) {
-	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	evt, revisions, err := a.eventService.GetWithRevisions(ctx, request.EventId)
 	if err != nil {
 		if errors.Is(err, event.ErrEventNotFound) {
 			return GetEvent404JSONResponse{
@@ -206,28 +246,299 @@ func (a apiServer) GetEvent(ctx context.Context, request GetEventRequestObject)
 	}
 
 	images, _ := a.eventImageService.ListByEvent(ctx, evt.ID)
+	eventAnchors := a.vehicleAnchors(ctx, evt.VehicleID).Events
 	httpEvent := domainToHTTPEvent(*evt, images)
+	httpEvent.Anchor = httpAnchorOf(eventAnchors, evt.ID)
+
+	httpRevisions := make([]Event, len(revisions))
+	for i, rev := range revisions {
+		httpRevisions[i] = domainToHTTPEvent(rev, nil)
+		httpRevisions[i].Anchor = httpAnchorOf(eventAnchors, rev.ID)
+	}
+	httpEvent.Revisions = &httpRevisions
+
 	return GetEvent200JSONResponse(httpEvent), nil
 }
 
-func domainToHTTPEvent(domainEvent event.Event, images []event_images.EventImage) Event {
-	var httpImages *[]EventImage
-	if len(images) > 0 {
-		imgs := make([]EventImage, len(images))
-		for i, img := range images {
-			imgs[i] = EventImage{
-				Id:              img.ID,
-				EventId:         img.EventID,
-				UploadSessionId: img.UploadSessionID,
-				ObjectKey:       img.ObjectKey,
-				Cid:             img.CID,
-				CreatedAt:       img.CreatedAt,
-			}
+// authorizeEventRevision checks that the current user may amend or revoke the event: a member of
+// the issuing entity for certified events, the vehicle owner for owner events
+func (a apiServer) authorizeEventRevision(ctx context.Context, evt *event.Event, vehicle *vehicles.Vehicle) error {
+	if evt.EntityID != nil {
+		if err := a.authorizer.Authorize(ctx, ResourceEvents, ActionCreate); err != nil {
+			return err
+		}
+		return a.authorizer.AuthorizeEntityMembership(ctx, *evt.EntityID, "")
+	}
+
+	if err := a.authorizer.Authorize(ctx, ResourceOwnerEvents, ActionCreate); err != nil {
+		return err
+	}
+	if !isVehicleOwner(ctx, vehicle) {
+		return ErrForbiddenVehicleAccess
+	}
+	return nil
+}
+
+func (a apiServer) AmendEvent(ctx context.Context, request AmendEventRequestObject) (AmendEventResponseObject, error) {
+	if request.Body == nil {
+		return AmendEvent400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) {
+			return AmendEvent404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, evt.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.authorizeEventRevision(ctx, evt, vehicle); err != nil {
+		return AmendEvent403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	amendment, err := a.eventService.Amend(ctx, *vehicle, evt.ID, event.AmendEventParams{
+		Title:       request.Body.Title,
+		Description: request.Body.Description,
+		Date:        request.Body.Date,
+		Location:    request.Body.Location,
+		Metadata:    getMetadataValue(request.Body.Metadata),
+		Reason:      request.Body.Reason,
+	})
+	if err != nil {
+		if resp, ok := metadataValidationResponse(err); ok {
+			return AmendEvent400JSONResponse{BadRequestJSONResponse: resp}, nil
+		}
+		if errors.Is(err, event.ErrNotOriginalEvent) || errors.Is(err, event.ErrEventRevoked) || errors.Is(err, event.ErrEventNotOnRecord) || errors.Is(err, event.ErrReservedEventType) || errors.Is(err, vehicles.ErrVehicleScrapped) {
+			return AmendEvent409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return AmendEvent201JSONResponse(domainToHTTPEvent(*amendment, nil)), nil
+}
+
+func (a apiServer) RevokeEvent(ctx context.Context, request RevokeEventRequestObject) (RevokeEventResponseObject, error) {
+	if request.Body == nil {
+		return RevokeEvent400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) {
+			return RevokeEvent404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, evt.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.authorizeEventRevision(ctx, evt, vehicle); err != nil {
+		return RevokeEvent403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	revocation, err := a.eventService.Revoke(ctx, *vehicle, evt.ID, request.Body.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, event.ErrReasonRequired):
+			return RevokeEvent400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, event.ErrNotOriginalEvent), errors.Is(err, event.ErrEventRevoked), errors.Is(err, event.ErrEventNotCertified), errors.Is(err, event.ErrEventNotOnRecord), errors.Is(err, event.ErrReservedEventType), errors.Is(err, vehicles.ErrVehicleScrapped):
+			return RevokeEvent409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RevokeEvent201JSONResponse(domainToHTTPEvent(*revocation, nil)), nil
+}
+
+// authorizeProposalDecision checks that the current user owns the vehicle the proposed event is for
+func (a apiServer) authorizeProposalDecision(ctx context.Context, evt *event.Event) (*vehicles.Vehicle, error) {
+	if _, ok := auth.GetIdentityID(ctx); !ok {
+		return nil, ErrAuthenticationRequired
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, evt.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+	if !isVehicleOwner(ctx, vehicle) {
+		return nil, ErrForbiddenVehicleAccess
+	}
+	return vehicle, nil
+}
+
+func (a apiServer) AcceptEvent(ctx context.Context, request AcceptEventRequestObject) (AcceptEventResponseObject, error) {
+	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) {
+			return AcceptEvent404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	vehicle, err := a.authorizeProposalDecision(ctx, evt)
+	if err != nil {
+		if errors.Is(err, ErrAuthenticationRequired) {
+			return AcceptEvent401JSONResponse{
+				UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrForbiddenVehicleAccess) {
+			return AcceptEvent403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: "Forbidden: only the vehicle owner can accept this event",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	accepted, err := a.eventService.Accept(ctx, *vehicle, evt.ID)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotProposed) {
+			return AcceptEvent409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	images, _ := a.eventImageService.ListByEvent(ctx, accepted.ID)
+	return AcceptEvent200JSONResponse(domainToHTTPEvent(*accepted, images)), nil
+}
+
+func (a apiServer) RejectEvent(ctx context.Context, request RejectEventRequestObject) (RejectEventResponseObject, error) {
+	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) {
+			return RejectEvent404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	vehicle, err := a.authorizeProposalDecision(ctx, evt)
+	if err != nil {
+		if errors.Is(err, ErrAuthenticationRequired) {
+			return RejectEvent401JSONResponse{
+				UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrForbiddenVehicleAccess) {
+			return RejectEvent403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: "Forbidden: only the vehicle owner can reject this event",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	rejected, err := a.eventService.Reject(ctx, *vehicle, evt.ID)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotProposed) {
+			return RejectEvent409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	images, _ := a.eventImageService.ListByEvent(ctx, rejected.ID)
+	return RejectEvent200JSONResponse(domainToHTTPEvent(*rejected, images)), nil
+}
+
+func domainEventImagesToHTTP(images []event_images.EventImage) *[]EventImage {
+	if len(images) == 0 {
+		return nil
+	}
+
+	imgs := make([]EventImage, len(images))
+	for i, img := range images {
+		imgs[i] = EventImage{
+			Id:              img.ID,
+			EventId:         img.EventID,
+			UploadSessionId: img.UploadSessionID,
+			ObjectKey:       img.ObjectKey,
+			Cid:             img.CID,
+			CreatedAt:       img.CreatedAt,
 		}
-		httpImages = &imgs
 	}
+	return &imgs
+}
+
+func domainToHTTPEvent(domainEvent event.Event, images []event_images.EventImage) Event {
+	httpImages := domainEventImagesToHTTP(images)
 
 	blockchainStatus := EventBlockchainStatus(domainEvent.BlockchainStatus)
+	var kind *EventKind
+	if domainEvent.Kind != "" {
+		k := EventKind(domainEvent.Kind)
+		kind = &k
+	}
+	var approvalStatus *EventApprovalStatus
+	if domainEvent.ApprovalStatus != "" {
+		status := EventApprovalStatus(domainEvent.ApprovalStatus)
+		approvalStatus = &status
+	}
 	return Event{
 		BlockchainTxId:      domainEvent.BlockchainTxID,
 		BlockchainStatus:    &blockchainStatus,
@@ -247,9 +558,42 @@ func domainToHTTPEvent(domainEvent event.Event, images []event_images.EventImage
 		Title:               domainEvent.Title,
 		Type:                EventType(domainEvent.Type),
 		VehicleId:           domainEvent.VehicleID,
+		Kind:                kind,
+		RevisesEventId:      domainEvent.RevisesEventID,
+		Reason:              domainEvent.Reason,
+		AmendedAt:           domainEvent.AmendedAt,
+		RevokedAt:           domainEvent.RevokedAt,
+		RevocationReason:    domainEvent.RevocationReason,
+		ApprovalStatus:      approvalStatus,
+		ApprovalDecidedAt:   domainEvent.ApprovalDecidedAt,
+		SigningKeyId:        domainEvent.SigningKeyID,
+		Signature:           domainEvent.Signature,
+		SignedAt:            domainEvent.SignedAt,
 	}
 }
 
+func (a apiServer) GetEventMetadataSchemas(ctx context.Context, _ GetEventMetadataSchemasRequestObject) (GetEventMetadataSchemasResponseObject, error) {
+	schemas := event.MetadataSchemas()
+
+	httpSchemas := make([]EventMetadataSchema, len(schemas))
+	for i, schema := range schemas {
+		fields := make([]EventMetadataField, len(schema.Fields))
+		for j, f := range schema.Fields {
+			fields[j] = EventMetadataField{
+				Name:     f.Name,
+				Type:     EventMetadataFieldType(f.Type),
+				Required: f.Required,
+			}
+		}
+		httpSchemas[i] = EventMetadataSchema{
+			EventType: EventType(schema.EventType),
+			Fields:    fields,
+		}
+	}
+
+	return GetEventMetadataSchemas200JSONResponse(httpSchemas), nil
+}
+
 func getMetadataValue(metadata *map[string]interface{}) map[string]interface{} {
 	if metadata == nil {
 		return nil
@@ -0,0 +1,187 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_types"
+	"github.com/google/uuid"
+)
+
+// authorizeEventTypeManager checks that the current user may manage the entity's event types
+func (a apiServer) authorizeEventTypeManager(ctx context.Context, entityID uuid.UUID) error {
+	if err := a.authorizer.Authorize(ctx, ResourceEntities, ActionUpdate); err != nil {
+		return err
+	}
+	return a.authorizer.AuthorizeEntityMembership(ctx, entityID, EntityRoleAdmin)
+}
+
+func (a apiServer) GetEntityEventTypes(ctx context.Context, request GetEntityEventTypesRequestObject) (GetEntityEventTypesResponseObject, error) {
+	if err := a.authorizer.AuthorizeEntityMembership(ctx, request.EntityId, ""); err != nil {
+		return GetEntityEventTypes403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to view its event types",
+			},
+		}, nil
+	}
+
+	types, err := a.eventTypeService.ListByEntity(ctx, request.EntityId)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetEntityEventTypes200JSONResponse(domainCustomEventTypesToHTTP(types)), nil
+}
+
+func (a apiServer) RegisterEntityEventType(ctx context.Context, request RegisterEntityEventTypeRequestObject) (RegisterEntityEventTypeResponseObject, error) {
+	if request.Body == nil {
+		return RegisterEntityEventType400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	if err := a.authorizeEventTypeManager(ctx, request.EntityId); err != nil {
+		return RegisterEntityEventType403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be an admin of the entity to register event types",
+			},
+		}, nil
+	}
+
+	created, err := a.eventTypeService.Register(ctx, request.EntityId, event_types.RegisterParams{
+		Key:            request.Body.Key,
+		DisplayName:    request.Body.DisplayName,
+		Icon:           request.Body.Icon,
+		MetadataSchema: request.Body.MetadataSchema,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrEntityNotFound):
+			return RegisterEntityEventType404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Entity not found",
+				},
+			}, nil
+		case errors.Is(err, event_types.ErrInvalidKey),
+			errors.Is(err, event_types.ErrBuiltInKey),
+			errors.Is(err, event_types.ErrDisplayNameRequired),
+			errors.Is(err, event_types.ErrInvalidSchema):
+			return RegisterEntityEventType400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, event_types.ErrEventTypeExists):
+			return RegisterEntityEventType409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RegisterEntityEventType201JSONResponse(domainCustomEventTypeToHTTP(*created)), nil
+}
+
+func (a apiServer) UpdateEntityEventType(ctx context.Context, request UpdateEntityEventTypeRequestObject) (UpdateEntityEventTypeResponseObject, error) {
+	if request.Body == nil {
+		return UpdateEntityEventType400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	if err := a.authorizeEventTypeManager(ctx, request.EntityId); err != nil {
+		return UpdateEntityEventType403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be an admin of the entity to update its event types",
+			},
+		}, nil
+	}
+
+	updated, err := a.eventTypeService.Update(ctx, request.EntityId, request.Key, event_types.UpdateParams{
+		DisplayName: request.Body.DisplayName,
+		Icon:        request.Body.Icon,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, event_types.ErrEventTypeNotFound):
+			return UpdateEntityEventType404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event type not found",
+				},
+			}, nil
+		case errors.Is(err, event_types.ErrDisplayNameRequired):
+			return UpdateEntityEventType400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return UpdateEntityEventType200JSONResponse(domainCustomEventTypeToHTTP(*updated)), nil
+}
+
+func (a apiServer) RetireEntityEventType(ctx context.Context, request RetireEntityEventTypeRequestObject) (RetireEntityEventTypeResponseObject, error) {
+	if err := a.authorizeEventTypeManager(ctx, request.EntityId); err != nil {
+		return RetireEntityEventType403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be an admin of the entity to retire its event types",
+			},
+		}, nil
+	}
+
+	if _, err := a.eventTypeService.Retire(ctx, request.EntityId, request.Key); err != nil {
+		if errors.Is(err, event_types.ErrEventTypeNotFound) {
+			return RetireEntityEventType404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event type not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RetireEntityEventType204Response{}, nil
+}
+
+func (a apiServer) GetPublicEventTypes(ctx context.Context, request GetPublicEventTypesRequestObject) (GetPublicEventTypesResponseObject, error) {
+	types, err := a.eventTypeService.Catalogue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetPublicEventTypes200JSONResponse(domainCustomEventTypesToHTTP(types)), nil
+}
+
+func domainCustomEventTypesToHTTP(types []event_types.CustomType) []CustomEventType {
+	result := make([]CustomEventType, len(types))
+	for i, t := range types {
+		result[i] = domainCustomEventTypeToHTTP(t)
+	}
+	return result
+}
+
+func domainCustomEventTypeToHTTP(t event_types.CustomType) CustomEventType {
+	return CustomEventType{
+		Id:             t.ID,
+		EntityId:       t.EntityID,
+		EntityName:     t.EntityName,
+		Key:            t.Key,
+		Name:           EventType(t.Name()),
+		DisplayName:    t.DisplayName,
+		Icon:           t.Icon,
+		MetadataSchema: t.MetadataSchema,
+		DefinitionCid:  t.DefinitionCID,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		RetiredAt:      t.RetiredAt,
+	}
+}
@@ -33,6 +33,75 @@ const (
 	AdminInvitationResponseInvitationTypeEntityMember AdminInvitationResponseInvitationType = "entity_member"
 )
 
+// Defines values for AnchorTransactionRecordType.
+const (
+	AnchorTransactionRecordTypeEvent          AnchorTransactionRecordType = "event"
+	AnchorTransactionRecordTypeVehicle        AnchorTransactionRecordType = "vehicle"
+	AnchorTransactionRecordTypeVehicleVersion AnchorTransactionRecordType = "vehicle_version"
+)
+
+// Defines values for AnchorTransactionStatus.
+const (
+	AnchorTransactionStatusConfirmed AnchorTransactionStatus = "confirmed"
+	AnchorTransactionStatusFailed    AnchorTransactionStatus = "failed"
+)
+
+// Defines values for AnchorVerificationRecordType.
+const (
+	AnchorVerificationRecordTypeEvent          AnchorVerificationRecordType = "event"
+	AnchorVerificationRecordTypeVehicle        AnchorVerificationRecordType = "vehicle"
+	AnchorVerificationRecordTypeVehicleVersion AnchorVerificationRecordType = "vehicle_version"
+)
+
+// Defines values for AnchorVerificationVerdict.
+const (
+	Match          AnchorVerificationVerdict = "match"
+	Mismatch       AnchorVerificationVerdict = "mismatch"
+	MissingOnChain AnchorVerificationVerdict = "missing_on_chain"
+	NotAnchored    AnchorVerificationVerdict = "not_anchored"
+)
+
+// Defines values for AssetMetadataStandard.
+const (
+	Arc69 AssetMetadataStandard = "arc69"
+)
+
+// Defines values for CertificationRequestStatus.
+const (
+	CertificationRequestStatusDeclined CertificationRequestStatus = "declined"
+	CertificationRequestStatusEndorsed CertificationRequestStatus = "endorsed"
+	CertificationRequestStatusPending  CertificationRequestStatus = "pending"
+)
+
+// Defines values for CertificationValidityStatus.
+const (
+	CertificationValidityStatusActive  CertificationValidityStatus = "active"
+	CertificationValidityStatusExpired CertificationValidityStatus = "expired"
+	CertificationValidityStatusRevoked CertificationValidityStatus = "revoked"
+)
+
+// Defines values for ChainIssueRecordType.
+const (
+	ChainIssueRecordTypeEvent          ChainIssueRecordType = "event"
+	ChainIssueRecordTypeVehicle        ChainIssueRecordType = "vehicle"
+	ChainIssueRecordTypeVehicleVersion ChainIssueRecordType = "vehicle_version"
+)
+
+// Defines values for ChainLinkBlockchainStatus.
+const (
+	ChainLinkBlockchainStatusAnchored ChainLinkBlockchainStatus = "anchored"
+	ChainLinkBlockchainStatusFailed   ChainLinkBlockchainStatus = "failed"
+	ChainLinkBlockchainStatusNone     ChainLinkBlockchainStatus = "none"
+	ChainLinkBlockchainStatusPending  ChainLinkBlockchainStatus = "pending"
+)
+
+// Defines values for ChainLinkRecordType.
+const (
+	ChainLinkRecordTypeEvent          ChainLinkRecordType = "event"
+	ChainLinkRecordTypeVehicle        ChainLinkRecordType = "vehicle"
+	ChainLinkRecordTypeVehicleVersion ChainLinkRecordType = "vehicle_version"
+)
+
 // Defines values for ClaimAdminInvitationResponseInvitationType.
 const (
 	ClaimAdminInvitationResponseInvitationTypeAdmin        ClaimAdminInvitationResponseInvitationType = "admin"
@@ -67,24 +136,42 @@ const (
 	EventBlockchainStatusPending  EventBlockchainStatus = "pending"
 )
 
-// Defines values for EventType.
+// Defines values for EventApprovalStatus.
+const (
+	EventApprovalStatusAccepted    EventApprovalStatus = "accepted"
+	EventApprovalStatusNotRequired EventApprovalStatus = "not_required"
+	EventApprovalStatusProposed    EventApprovalStatus = "proposed"
+	EventApprovalStatusRejected    EventApprovalStatus = "rejected"
+)
+
+// Defines values for EventKind.
+const (
+	Amendment  EventKind = "amendment"
+	Original   EventKind = "original"
+	Revocation EventKind = "revocation"
+)
+
+// Defines values for EventMetadataFieldType.
+const (
+	Boolean     EventMetadataFieldType = "boolean"
+	Date        EventMetadataFieldType = "date"
+	Integer     EventMetadataFieldType = "integer"
+	String      EventMetadataFieldType = "string"
+	StringArray EventMetadataFieldType = "string_array"
+)
+
+// Defines values for FailedAnchorBlockchainStatus.
+const (
+	FailedAnchorBlockchainStatusAnchored FailedAnchorBlockchainStatus = "anchored"
+	FailedAnchorBlockchainStatusFailed   FailedAnchorBlockchainStatus = "failed"
+	FailedAnchorBlockchainStatusNone     FailedAnchorBlockchainStatus = "none"
+	FailedAnchorBlockchainStatusPending  FailedAnchorBlockchainStatus = "pending"
+)
+
+// Defines values for FailedAnchorRecordType.
 const (
-	Auction           EventType = "auction"
-	CarShow           EventType = "car_show"
-	Certification     EventType = "certification"
-	ClassicMeet       EventType = "classic_meet"
-	ClubCompetition   EventType = "club_competition"
-	Festival          EventType = "festival"
-	Maintenance       EventType = "maintenance"
-	Modification      EventType = "modification"
-	OwnershipTransfer EventType = "ownership_transfer"
-	RaceParticipation EventType = "race_participation"
-	Rally             EventType = "rally"
-	Restoration       EventType = "restoration"
-	RoadTrip          EventType = "road_trip"
-	ShowParticipation EventType = "show_participation"
-	VintageRacing     EventType = "vintage_racing"
-	Workshop          EventType = "workshop"
+	FailedAnchorRecordTypeEvent   FailedAnchorRecordType = "event"
+	FailedAnchorRecordTypeVehicle FailedAnchorRecordType = "vehicle"
 )
 
 // Defines values for HealthResponseStatus.
@@ -92,6 +179,73 @@ const (
 	Healthy HealthResponseStatus = "healthy"
 )
 
+// Defines values for LifecycleAssetStatus.
+const (
+	LifecycleAssetStatusDone    LifecycleAssetStatus = "done"
+	LifecycleAssetStatusFailed  LifecycleAssetStatus = "failed"
+	LifecycleAssetStatusPending LifecycleAssetStatus = "pending"
+)
+
+// Defines values for LifecycleRequestStatus.
+const (
+	LifecycleRequestStatusApproved LifecycleRequestStatus = "approved"
+	LifecycleRequestStatusPending  LifecycleRequestStatus = "pending"
+	LifecycleRequestStatusRejected LifecycleRequestStatus = "rejected"
+)
+
+// Defines values for OwnershipSource.
+const (
+	Invitation   OwnershipSource = "invitation"
+	Reassignment OwnershipSource = "reassignment"
+	Registration OwnershipSource = "registration"
+	Transfer     OwnershipSource = "transfer"
+)
+
+// Defines values for OwnershipTransferStatus.
+const (
+	OwnershipTransferStatusAccepted  OwnershipTransferStatus = "accepted"
+	OwnershipTransferStatusCancelled OwnershipTransferStatus = "cancelled"
+	OwnershipTransferStatusPending   OwnershipTransferStatus = "pending"
+)
+
+// Defines values for PassportWarningCode.
+const (
+	PassportWarningCodeScrapped PassportWarningCode = "scrapped"
+	PassportWarningCodeStolen   PassportWarningCode = "stolen"
+)
+
+// Defines values for RequeueAnchorsRequestRecordType.
+const (
+	RequeueAnchorsRequestRecordTypeEvent   RequeueAnchorsRequestRecordType = "event"
+	RequeueAnchorsRequestRecordTypeVehicle RequeueAnchorsRequestRecordType = "vehicle"
+)
+
+// Defines values for SigningKeyAlgorithm.
+const (
+	Ed25519 SigningKeyAlgorithm = "ed25519"
+)
+
+// Defines values for StolenReportKind.
+const (
+	StolenReportKindMissing StolenReportKind = "missing"
+	StolenReportKindStolen  StolenReportKind = "stolen"
+)
+
+// Defines values for StolenReportStatus.
+const (
+	StolenReportStatusApproved StolenReportStatus = "approved"
+	StolenReportStatusPending  StolenReportStatus = "pending"
+	StolenReportStatusRejected StolenReportStatus = "rejected"
+	StolenReportStatusResolved StolenReportStatus = "resolved"
+)
+
+// Defines values for StolenVehicleAlertStatus.
+const (
+	StolenVehicleAlertStatusMissing StolenVehicleAlertStatus = "missing"
+	StolenVehicleAlertStatusNone    StolenVehicleAlertStatus = "none"
+	StolenVehicleAlertStatusStolen  StolenVehicleAlertStatus = "stolen"
+)
+
 // Defines values for UpdateEntityMemberRoleRequestRole.
 const (
 	UpdateEntityMemberRoleRequestRoleAdmin  UpdateEntityMemberRoleRequestRole = "admin"
@@ -112,6 +266,54 @@ const (
 	VehicleBlockchainStatusPending  VehicleBlockchainStatus = "pending"
 )
 
+// Defines values for VehicleCustodyStatus.
+const (
+	VehicleCustodyStatusFailed   VehicleCustodyStatus = "failed"
+	VehicleCustodyStatusOwner    VehicleCustodyStatus = "owner"
+	VehicleCustodyStatusPending  VehicleCustodyStatus = "pending"
+	VehicleCustodyStatusPlatform VehicleCustodyStatus = "platform"
+)
+
+// Defines values for VehicleLifecycleStatus.
+const (
+	VehicleLifecycleStatusActive   VehicleLifecycleStatus = "active"
+	VehicleLifecycleStatusArchived VehicleLifecycleStatus = "archived"
+	VehicleLifecycleStatusExported VehicleLifecycleStatus = "exported"
+	VehicleLifecycleStatusScrapped VehicleLifecycleStatus = "scrapped"
+	VehicleLifecycleStatusStolen   VehicleLifecycleStatus = "stolen"
+)
+
+// Defines values for VehicleVersionBlockchainStatus.
+const (
+	VehicleVersionBlockchainStatusAnchored VehicleVersionBlockchainStatus = "anchored"
+	VehicleVersionBlockchainStatusFailed   VehicleVersionBlockchainStatus = "failed"
+	VehicleVersionBlockchainStatusPending  VehicleVersionBlockchainStatus = "pending"
+)
+
+// Defines values for AnchorRecordTypeParam.
+const (
+	AnchorRecordTypeParamEvent   AnchorRecordTypeParam = "event"
+	AnchorRecordTypeParamVehicle AnchorRecordTypeParam = "vehicle"
+)
+
+// Defines values for AnchorRecordTypeQueryParam.
+const (
+	AnchorRecordTypeQueryParamEvent   AnchorRecordTypeQueryParam = "event"
+	AnchorRecordTypeQueryParamVehicle AnchorRecordTypeQueryParam = "vehicle"
+)
+
+// Defines values for GetFailedAnchorsParamsRecordType.
+const (
+	GetFailedAnchorsParamsRecordTypeEvent   GetFailedAnchorsParamsRecordType = "event"
+	GetFailedAnchorsParamsRecordTypeVehicle GetFailedAnchorsParamsRecordType = "vehicle"
+)
+
+// Defines values for RequeueAnchorParamsRecordType.
+const (
+	RequeueAnchorParamsRecordTypeEvent   RequeueAnchorParamsRecordType = "event"
+	RequeueAnchorParamsRecordTypeVehicle RequeueAnchorParamsRecordType = "vehicle"
+)
+
 // AddEntityMemberRequest defines model for AddEntityMemberRequest.
 type AddEntityMemberRequest struct {
 	// Email Email address of the user to invite
@@ -175,6 +377,204 @@ type AdminUserListResponse struct {
 	Meta PaginationMeta `json:"meta"`
 }
 
+// AmendEventRequest Corrected fields of the event. Omitted fields keep their current value.
+type AmendEventRequest struct {
+	Date        *time.Time              `json:"date,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+	Location    *string                 `json:"location,omitempty"`
+	Metadata    *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Reason Why the event is being amended
+	Reason *string `json:"reason,omitempty"`
+	Title  *string `json:"title,omitempty"`
+}
+
+// AnchorTransaction A transaction submitted to anchor a record, with the outcome reported by the ledger. On a record, this is its latest confirmed anchor, or its latest attempt when none was confirmed. Events anchored under one Merkle root share its transaction.
+type AnchorTransaction struct {
+	AssetId *int64 `json:"assetId,omitempty"`
+
+	// ConfirmedRound Round (block) the transaction was confirmed in
+	ConfirmedRound *int64    `json:"confirmedRound,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+
+	// Error Why the submission failed
+	Error   *string             `json:"error,omitempty"`
+	EventId *openapi_types.UUID `json:"eventId,omitempty"`
+
+	// FeeMicroAlgos Fee paid for the transaction, in microAlgos
+	FeeMicroAlgos *int64             `json:"feeMicroAlgos,omitempty"`
+	Id            openapi_types.UUID `json:"id"`
+
+	// Network Ledger network the transaction was sent to, e.g. mainnet, testnet or simulated
+	Network    string                      `json:"network"`
+	RecordType AnchorTransactionRecordType `json:"recordType"`
+
+	// RoundTime Timestamp of the block the transaction was confirmed in
+	RoundTime *time.Time `json:"roundTime,omitempty"`
+
+	// Sender Account that sent the transaction
+	Sender string                  `json:"sender"`
+	Status AnchorTransactionStatus `json:"status"`
+
+	// TxId Transaction ID; absent when a failed submission never got as far as sending it
+	TxId *string `json:"txId,omitempty"`
+
+	// TxType Algorand transaction type (acfg, axfer or pay)
+	TxType           *string             `json:"txType,omitempty"`
+	VehicleVersionId *openapi_types.UUID `json:"vehicleVersionId,omitempty"`
+}
+
+// AnchorTransactionRecordType defines model for AnchorTransaction.RecordType.
+type AnchorTransactionRecordType string
+
+// AnchorTransactionStatus defines model for AnchorTransaction.Status.
+type AnchorTransactionStatus string
+
+// AnchorVerification defines model for AnchorVerification.
+type AnchorVerification struct {
+	// AssetId Algorand asset ID of the vehicle
+	AssetId *string `json:"assetId,omitempty"`
+
+	// ComputedCid CID recomputed from the stored DAG-CBOR source
+	ComputedCid *string `json:"computedCid,omitempty"`
+
+	// ConfirmedAt Timestamp of the block containing the anchoring transaction
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty"`
+
+	// ConfirmedRound Round in which the anchoring transaction was confirmed
+	ConfirmedRound *int64 `json:"confirmedRound,omitempty"`
+
+	// MerkleProof Inclusion proof from a record CID to an anchored Merkle root
+	MerkleProof *MerkleProof `json:"merkleProof,omitempty"`
+
+	// MerkleRoot Merkle root found in the anchoring transaction note, for records anchored under a root
+	MerkleRoot *string `json:"merkleRoot,omitempty"`
+
+	// OnChainCid CID found in the anchoring transaction note
+	OnChainCid *string `json:"onChainCid,omitempty"`
+
+	// Reason Why the record did not match, when verdict is mismatch
+	Reason     *string                      `json:"reason,omitempty"`
+	RecordId   openapi_types.UUID           `json:"recordId"`
+	RecordType AnchorVerificationRecordType `json:"recordType"`
+
+	// Signature Check of the issuing entity's signature of the event's CID
+	Signature *EventSignatureVerification `json:"signature,omitempty"`
+
+	// StoredCid CID stored alongside the record
+	StoredCid *string `json:"storedCid,omitempty"`
+
+	// TxId Algorand transaction ID of the anchor
+	TxId *string `json:"txId,omitempty"`
+
+	// Verdict Outcome of comparing the stored record with its on-chain anchor
+	Verdict AnchorVerificationVerdict `json:"verdict"`
+}
+
+// AnchorVerificationRecordType defines model for AnchorVerification.RecordType.
+type AnchorVerificationRecordType string
+
+// AnchorVerificationVerdict Outcome of comparing the stored record with its on-chain anchor
+type AnchorVerificationVerdict string
+
+// AssetMetadata defines model for AssetMetadata.
+type AssetMetadata struct {
+	Description *string                 `json:"description,omitempty"`
+	Properties  AssetMetadataProperties `json:"properties"`
+	Standard    AssetMetadataStandard   `json:"standard"`
+}
+
+// AssetMetadataStandard defines model for AssetMetadata.Standard.
+type AssetMetadataStandard string
+
+// AssetMetadataProperties defines model for AssetMetadataProperties.
+type AssetMetadataProperties struct {
+	// Cid CID of the vehicle's genesis record
+	Cid   string  `json:"cid"`
+	Make  *string `json:"make,omitempty"`
+	Model *string `json:"model,omitempty"`
+
+	// Type Record anchored by the asset creation, always genesis
+	Type string `json:"type"`
+	Year *int   `json:"year,omitempty"`
+}
+
+// CertificationRequest defines model for CertificationRequest.
+type CertificationRequest struct {
+	// CertifiedEventId The certified event recorded when the request was endorsed
+	CertifiedEventId *openapi_types.UUID `json:"certifiedEventId"`
+	CreatedAt        time.Time           `json:"createdAt"`
+	DecidedAt        *time.Time          `json:"decidedAt"`
+	DeclineReason    *string             `json:"declineReason"`
+
+	// EntityId The certifier entity asked to verify the event
+	EntityId openapi_types.UUID `json:"entityId"`
+	Event    *Event             `json:"event,omitempty"`
+
+	// EventId The owner event submitted for certification
+	EventId        openapi_types.UUID `json:"eventId"`
+	EvidenceImages *[]EventImage      `json:"evidenceImages,omitempty"`
+	Id             openapi_types.UUID `json:"id"`
+
+	// Message Note from the owner to the entity
+	Message   *string                    `json:"message"`
+	Status    CertificationRequestStatus `json:"status"`
+	VehicleId openapi_types.UUID         `json:"vehicleId"`
+}
+
+// CertificationRequestStatus defines model for CertificationRequestStatus.
+type CertificationRequestStatus string
+
+// CertificationValidity Validity window of a certification event issued by an entity
+type CertificationValidity struct {
+	CertificateNumber *string `json:"certificateNumber,omitempty"`
+
+	// EntityId The entity that issued the certification
+	EntityId openapi_types.UUID `json:"entityId"`
+
+	// EventId The certification event
+	EventId   openapi_types.UUID          `json:"eventId"`
+	Status    CertificationValidityStatus `json:"status"`
+	ValidFrom time.Time                   `json:"validFrom"`
+
+	// ValidUntil Last day the certification is valid; absent when it does not expire
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+// CertificationValidityStatus defines model for CertificationValidityStatus.
+type CertificationValidityStatus string
+
+// ChainIssue defines model for ChainIssue.
+type ChainIssue struct {
+	Cid *string `json:"cid,omitempty"`
+
+	// Reason Why the chain is broken at this record
+	Reason     string                `json:"reason"`
+	RecordId   *openapi_types.UUID   `json:"recordId,omitempty"`
+	RecordType *ChainIssueRecordType `json:"recordType,omitempty"`
+}
+
+// ChainIssueRecordType defines model for ChainIssue.RecordType.
+type ChainIssueRecordType string
+
+// ChainLink defines model for ChainLink.
+type ChainLink struct {
+	BlockchainStatus ChainLinkBlockchainStatus `json:"blockchainStatus"`
+	Cid              string                    `json:"cid"`
+	CreatedAt        time.Time                 `json:"createdAt"`
+
+	// PreviousCid CID of the previous record in the chain; absent for genesis
+	PreviousCid *string             `json:"previousCid,omitempty"`
+	RecordId    openapi_types.UUID  `json:"recordId"`
+	RecordType  ChainLinkRecordType `json:"recordType"`
+}
+
+// ChainLinkBlockchainStatus defines model for ChainLink.BlockchainStatus.
+type ChainLinkBlockchainStatus string
+
+// ChainLinkRecordType defines model for ChainLink.RecordType.
+type ChainLinkRecordType string
+
 // ClaimAdminInvitationRequest defines model for ClaimAdminInvitationRequest.
 type ClaimAdminInvitationRequest struct {
 	// Email User's email (can be modified from invitation)
@@ -220,6 +620,16 @@ type CreateAdminUserRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// CreateCertificationRequestRequest defines model for CreateCertificationRequestRequest.
+type CreateCertificationRequestRequest struct {
+	// EntityId The certifier entity to ask
+	EntityId openapi_types.UUID `json:"entityId"`
+
+	// EvidenceSessionId Event image upload session holding the evidence images
+	EvidenceSessionId *openapi_types.UUID `json:"evidenceSessionId,omitempty"`
+	Message           *string             `json:"message,omitempty"`
+}
+
 // CreateCertifierVehicleRequest Request to create an unclaimed vehicle for certification with optional owner assignment
 type CreateCertifierVehicleRequest struct {
 	BodyType      *string `json:"bodyType,omitempty"`
@@ -291,13 +701,34 @@ type CreateEventRequest struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 
 	// Title Title of the event
-	Title string    `json:"title"`
-	Type  EventType `json:"type"`
+	Title string `json:"title"`
+
+	// Type One of the built-in event types (certification, car_show, classic_meet, rally, vintage_racing,
+	// auction, workshop, club_competition, road_trip, festival, race_participation,
+	// show_participation, maintenance, ownership_transfer, restoration, modification,
+	// lifecycle_change) or a custom event type registered by the issuing entity, named
+	// `<entityId>:<key>`. lifecycle_change and ownership_transfer events are only recorded by the
+	// platform, when a lifecycle request is approved or a transfer is accepted.
+	Type EventType `json:"type"`
 
 	// VehicleId ID of the vehicle for this event
 	VehicleId openapi_types.UUID `json:"vehicleId"`
 }
 
+// CreateLifecycleRequestRequest defines model for CreateLifecycleRequestRequest.
+type CreateLifecycleRequestRequest struct {
+	// EntityId Certifier entity asked to decide the request. Admins decide it when omitted.
+	EntityId *openapi_types.UUID `json:"entityId,omitempty"`
+
+	// Reason Why the vehicle changes status, such as the police report of a theft
+	Reason string `json:"reason"`
+
+	// ToStatus Where the vehicle is in its life. Stolen vehicles cannot change hands and their asset is
+	// frozen in the owner's wallet; scrapped vehicles are final, their record is closed and their
+	// asset destroyed.
+	ToStatus VehicleLifecycleStatus `json:"toStatus"`
+}
+
 // CreateOwnerEventRequest defines model for CreateOwnerEventRequest.
 type CreateOwnerEventRequest struct {
 	// Date Optional date when the event occurred. If omitted, defaults to current date.
@@ -313,8 +744,15 @@ type CreateOwnerEventRequest struct {
 	Location *string `json:"location,omitempty"`
 
 	// Title Title of the event
-	Title string    `json:"title"`
-	Type  EventType `json:"type"`
+	Title string `json:"title"`
+
+	// Type One of the built-in event types (certification, car_show, classic_meet, rally, vintage_racing,
+	// auction, workshop, club_competition, road_trip, festival, race_participation,
+	// show_participation, maintenance, ownership_transfer, restoration, modification,
+	// lifecycle_change) or a custom event type registered by the issuing entity, named
+	// `<entityId>:<key>`. lifecycle_change and ownership_transfer events are only recorded by the
+	// platform, when a lifecycle request is approved or a transfer is accepted.
+	Type EventType `json:"type"`
 }
 
 // CreateShareLinkRequest defines model for CreateShareLinkRequest.
@@ -357,6 +795,17 @@ type CreateShareLinkResponse struct {
 	VehicleId openapi_types.UUID `json:"vehicleId"`
 }
 
+// CreateStolenReportRequest defines model for CreateStolenReportRequest.
+type CreateStolenReportRequest struct {
+	// Description What happened. Only admins and the owner can see it.
+	Description     string              `json:"description"`
+	IncidentDate    *openapi_types.Date `json:"incidentDate,omitempty"`
+	Kind            StolenReportKind    `json:"kind"`
+	Location        *string             `json:"location,omitempty"`
+	PoliceAuthority *string             `json:"policeAuthority,omitempty"`
+	PoliceReference *string             `json:"policeReference,omitempty"`
+}
+
 // CreateVehicleRequest defines model for CreateVehicleRequest.
 type CreateVehicleRequest struct {
 	BodyType      *string `json:"bodyType,omitempty"`
@@ -385,6 +834,42 @@ type CreateVehicleRequest struct {
 	Year               int     `json:"year"`
 }
 
+// CustomEventType defines model for CustomEventType.
+type CustomEventType struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DefinitionCid CID of the type's entity, key and metadata schema, recorded with each of its anchored events
+	DefinitionCid string             `json:"definitionCid"`
+	DisplayName   string             `json:"displayName"`
+	EntityId      openapi_types.UUID `json:"entityId"`
+
+	// EntityName Name of the entity that registered the type; only set in the public catalogue
+	EntityName *string `json:"entityName,omitempty"`
+
+	// Icon Icon name or URL shown with events of the type
+	Icon *string            `json:"icon,omitempty"`
+	Id   openapi_types.UUID `json:"id"`
+	Key  string             `json:"key"`
+
+	// MetadataSchema OpenAPI 3 schema object the metadata of events of the type must match
+	MetadataSchema map[string]interface{} `json:"metadataSchema"`
+
+	// Name One of the built-in event types (certification, car_show, classic_meet, rally, vintage_racing,
+	// auction, workshop, club_competition, road_trip, festival, race_participation,
+	// show_participation, maintenance, ownership_transfer, restoration, modification,
+	// lifecycle_change) or a custom event type registered by the issuing entity, named
+	// `<entityId>:<key>`. lifecycle_change and ownership_transfer events are only recorded by the
+	// platform, when a lifecycle request is approved or a transfer is accepted.
+	Name      EventType  `json:"name"`
+	RetiredAt *time.Time `json:"retiredAt,omitempty"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// DeclineCertificationRequestRequest defines model for DeclineCertificationRequestRequest.
+type DeclineCertificationRequestRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
 // Document defines model for Document.
 type Document struct {
 	// CreatedAt When document was added
@@ -408,6 +893,12 @@ type DocumentListResponse struct {
 	Data []Document `json:"data"`
 }
 
+// EndorseCertificationRequestRequest defines model for EndorseCertificationRequestRequest.
+type EndorseCertificationRequestRequest struct {
+	// Metadata Metadata added by the reviewer, such as the certificate number. It is merged over the metadata of the original submission and validated against the schema of the event type.
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+}
+
 // Entity defines model for Entity.
 type Entity struct {
 	Address      *Address            `json:"address,omitempty"`
@@ -417,10 +908,13 @@ type Entity struct {
 	Id           openapi_types.UUID  `json:"id"`
 
 	// LogoObjectKey S3 object key for the entity logo
-	LogoObjectKey *string    `json:"logoObjectKey,omitempty"`
-	Name          string     `json:"name"`
-	Type          EntityType `json:"type"`
-	Website       *string    `json:"website,omitempty"`
+	LogoObjectKey *string `json:"logoObjectKey,omitempty"`
+	Name          string  `json:"name"`
+
+	// OwnerApprovalEventTypes Event types the entity issues only with the vehicle owner's approval
+	OwnerApprovalEventTypes *[]string  `json:"ownerApprovalEventTypes,omitempty"`
+	Type                    EntityType `json:"type"`
+	Website                 *string    `json:"website,omitempty"`
 }
 
 // EntityListResponse defines model for EntityListResponse.
@@ -466,10 +960,26 @@ type ErrorResponse struct {
 
 	// Error Error message
 	Error string `json:"error"`
+
+	// Fields Field-level validation errors, set when the request body failed validation
+	Fields *[]FieldError `json:"fields,omitempty"`
 }
 
 // Event defines model for Event.
 type Event struct {
+	// AmendedAt When the content shown was last amended (effective view of an original event)
+	AmendedAt *time.Time `json:"amendedAt,omitempty"`
+
+	// Anchor A transaction submitted to anchor a record, with the outcome reported by the ledger. On a record, this is its latest confirmed anchor, or its latest attempt when none was confirmed. Events anchored under one Merkle root share its transaction.
+	Anchor *AnchorTransaction `json:"anchor,omitempty"`
+
+	// ApprovalDecidedAt When the vehicle owner accepted or rejected the event
+	ApprovalDecidedAt *time.Time `json:"approvalDecidedAt,omitempty"`
+
+	// ApprovalStatus Owner consent for events from entities that require it. Proposed events await the owner's
+	// decision and are only anchored once accepted; rejected events are not part of the record.
+	ApprovalStatus *EventApprovalStatus `json:"approvalStatus,omitempty"`
+
 	// BlockchainStatus Status of blockchain anchoring
 	BlockchainStatus *EventBlockchainStatus `json:"blockchainStatus,omitempty"`
 
@@ -499,17 +1009,55 @@ type Event struct {
 	Id         openapi_types.UUID `json:"id"`
 
 	// Images Images attached to this event
-	Images    *[]EventImage           `json:"images,omitempty"`
-	Location  *string                 `json:"location,omitempty"`
-	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
-	Title     string                  `json:"title"`
-	Type      EventType               `json:"type"`
-	VehicleId openapi_types.UUID      `json:"vehicleId"`
+	Images *[]EventImage `json:"images,omitempty"`
+
+	// Kind Whether the record is an original event or an amendment or revocation of one
+	Kind     *EventKind              `json:"kind,omitempty"`
+	Location *string                 `json:"location,omitempty"`
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Reason Reason given for the amendment or revocation
+	Reason *string `json:"reason,omitempty"`
+
+	// RevisesEventId Event amended or revoked by this record (amendments and revocations only)
+	RevisesEventId *openapi_types.UUID `json:"revisesEventId,omitempty"`
+
+	// Revisions Amendment trail of the event, oldest first. Only returned when fetching a single event.
+	Revisions *[]Event `json:"revisions,omitempty"`
+
+	// RevocationReason Reason the event was revoked
+	RevocationReason *string `json:"revocationReason,omitempty"`
+
+	// RevokedAt When the event was revoked (effective view of an original event)
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Signature Base64-encoded Ed25519 signature of the event's CID by the issuing entity
+	Signature *string `json:"signature,omitempty"`
+
+	// SignedAt When the signature was recorded
+	SignedAt *time.Time `json:"signedAt,omitempty"`
+
+	// SigningKeyId Key the issuing entity signed the event's CID with
+	SigningKeyId *openapi_types.UUID `json:"signingKeyId,omitempty"`
+	Title        string              `json:"title"`
+
+	// Type One of the built-in event types (certification, car_show, classic_meet, rally, vintage_racing,
+	// auction, workshop, club_competition, road_trip, festival, race_participation,
+	// show_participation, maintenance, ownership_transfer, restoration, modification,
+	// lifecycle_change) or a custom event type registered by the issuing entity, named
+	// `<entityId>:<key>`. lifecycle_change and ownership_transfer events are only recorded by the
+	// platform, when a lifecycle request is approved or a transfer is accepted.
+	Type      EventType          `json:"type"`
+	VehicleId openapi_types.UUID `json:"vehicleId"`
 }
 
 // EventBlockchainStatus Status of blockchain anchoring
 type EventBlockchainStatus string
 
+// EventApprovalStatus Owner consent for events from entities that require it. Proposed events await the owner's
+// decision and are only anchored once accepted; rejected events are not part of the record.
+type EventApprovalStatus string
+
 // EventImage defines model for EventImage.
 type EventImage struct {
 	// Cid Content Identifier (CID) for the image
@@ -542,14 +1090,98 @@ type EventImageUploadSessionResponse struct {
 	SessionId openapi_types.UUID `json:"sessionId"`
 }
 
+// EventKind Whether the record is an original event or an amendment or revocation of one
+type EventKind string
+
 // EventListResponse defines model for EventListResponse.
 type EventListResponse struct {
 	Data []Event        `json:"data"`
 	Meta PaginationMeta `json:"meta"`
 }
 
-// EventType defines model for EventType.
-type EventType string
+// EventMetadataField defines model for EventMetadataField.
+type EventMetadataField struct {
+	Name     string                 `json:"name"`
+	Required bool                   `json:"required"`
+	Type     EventMetadataFieldType `json:"type"`
+}
+
+// EventMetadataFieldType defines model for EventMetadataField.Type.
+type EventMetadataFieldType string
+
+// EventMetadataSchema defines model for EventMetadataSchema.
+type EventMetadataSchema struct {
+	// EventType One of the built-in event types (certification, car_show, classic_meet, rally, vintage_racing,
+	// auction, workshop, club_competition, road_trip, festival, race_participation,
+	// show_participation, maintenance, ownership_transfer, restoration, modification,
+	// lifecycle_change) or a custom event type registered by the issuing entity, named
+	// `<entityId>:<key>`. lifecycle_change and ownership_transfer events are only recorded by the
+	// platform, when a lifecycle request is approved or a transfer is accepted.
+	EventType EventType            `json:"eventType"`
+	Fields    []EventMetadataField `json:"fields"`
+}
+
+// EventSignatureVerification Check of the issuing entity's signature of the event's CID
+type EventSignatureVerification struct {
+	EntityId *openapi_types.UUID `json:"entityId,omitempty"`
+	KeyId    openapi_types.UUID  `json:"keyId"`
+
+	// KeyRevokedAt When the key was revoked; signatures made before remain valid
+	KeyRevokedAt *time.Time `json:"keyRevokedAt,omitempty"`
+
+	// PublicKey Base64-encoded Ed25519 public key the signature was checked against
+	PublicKey *string `json:"publicKey,omitempty"`
+
+	// Reason Why the signature is not valid
+	Reason    *string    `json:"reason,omitempty"`
+	Signature string     `json:"signature"`
+	SignedAt  *time.Time `json:"signedAt,omitempty"`
+	Valid     bool       `json:"valid"`
+}
+
+// EventType One of the built-in event types (certification, car_show, classic_meet, rally, vintage_racing,
+// auction, workshop, club_competition, road_trip, festival, race_participation,
+// show_participation, maintenance, ownership_transfer, restoration, modification,
+// lifecycle_change) or a custom event type registered by the issuing entity, named
+// `<entityId>:<key>`. lifecycle_change and ownership_transfer events are only recorded by the
+// platform, when a lifecycle request is approved or a transfer is accepted.
+type EventType = string
+
+// FailedAnchor defines model for FailedAnchor.
+type FailedAnchor struct {
+	// BlockchainError Error returned by the last anchoring attempt
+	BlockchainError  *string                      `json:"blockchainError,omitempty"`
+	BlockchainStatus FailedAnchorBlockchainStatus `json:"blockchainStatus"`
+
+	// BlockchainStatusAt When the blockchain status last changed
+	BlockchainStatusAt time.Time `json:"blockchainStatusAt"`
+
+	// Label Human readable description of the record
+	Label      string                 `json:"label"`
+	RecordId   openapi_types.UUID     `json:"recordId"`
+	RecordType FailedAnchorRecordType `json:"recordType"`
+
+	// VehicleId Vehicle the record belongs to (the record itself for vehicles)
+	VehicleId openapi_types.UUID `json:"vehicleId"`
+}
+
+// FailedAnchorBlockchainStatus defines model for FailedAnchor.BlockchainStatus.
+type FailedAnchorBlockchainStatus string
+
+// FailedAnchorRecordType defines model for FailedAnchor.RecordType.
+type FailedAnchorRecordType string
+
+// FailedAnchorListResponse defines model for FailedAnchorListResponse.
+type FailedAnchorListResponse struct {
+	Data []FailedAnchor `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
 
 // GenerateDocumentUploadUrlRequest defines model for GenerateDocumentUploadUrlRequest.
 type GenerateDocumentUploadUrlRequest struct {
@@ -606,6 +1238,12 @@ type HealthResponse struct {
 // HealthResponseStatus defines model for HealthResponse.Status.
 type HealthResponseStatus string
 
+// InitiateOwnershipTransferRequest defines model for InitiateOwnershipTransferRequest.
+type InitiateOwnershipTransferRequest struct {
+	// Email Email address of the new owner
+	Email openapi_types.Email `json:"email"`
+}
+
 // InvitationValidationResponse defines model for InvitationValidationResponse.
 type InvitationValidationResponse struct {
 	// Email The email address associated with the invitation
@@ -624,6 +1262,75 @@ type InvitationVehicle struct {
 	Year         *int               `json:"year,omitempty"`
 }
 
+// LifecycleAssetStatus Progress of the freeze, unfreeze or destroy of the vehicle's asset
+type LifecycleAssetStatus string
+
+// LifecycleRequest defines model for LifecycleRequest.
+type LifecycleRequest struct {
+	AssetError *string `json:"assetError"`
+
+	// AssetStatus Progress of the freeze, unfreeze or destroy of the vehicle's asset
+	AssetStatus *LifecycleAssetStatus `json:"assetStatus,omitempty"`
+
+	// AssetTxId Transaction that froze, unfroze or destroyed the asset
+	AssetTxId     *string    `json:"assetTxId"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	DecidedAt     *time.Time `json:"decidedAt"`
+	DeclineReason *string    `json:"declineReason"`
+
+	// EntityId The certifier entity asked to decide the request; null when it is decided by an admin
+	EntityId *openapi_types.UUID `json:"entityId"`
+
+	// EventId The lifecycle_change event recorded when the request was approved
+	EventId *openapi_types.UUID `json:"eventId"`
+
+	// FromStatus Where the vehicle is in its life. Stolen vehicles cannot change hands and their asset is
+	// frozen in the owner's wallet; scrapped vehicles are final, their record is closed and their
+	// asset destroyed.
+	FromStatus VehicleLifecycleStatus `json:"fromStatus"`
+	Id         openapi_types.UUID     `json:"id"`
+	Reason     string                 `json:"reason"`
+	Status     LifecycleRequestStatus `json:"status"`
+
+	// ToStatus Where the vehicle is in its life. Stolen vehicles cannot change hands and their asset is
+	// frozen in the owner's wallet; scrapped vehicles are final, their record is closed and their
+	// asset destroyed.
+	ToStatus  VehicleLifecycleStatus `json:"toStatus"`
+	VehicleId openapi_types.UUID     `json:"vehicleId"`
+}
+
+// LifecycleRequestStatus defines model for LifecycleRequestStatus.
+type LifecycleRequestStatus string
+
+// LinkWalletRequest defines model for LinkWalletRequest.
+type LinkWalletRequest struct {
+	Address string `json:"address"`
+
+	// Signature Base64-encoded Ed25519 signature of the challenge message
+	Signature string `json:"signature"`
+}
+
+// MerkleProof Inclusion proof from a record CID to an anchored Merkle root
+type MerkleProof struct {
+	// Index Position of the record in the tree
+	Index int `json:"index"`
+
+	// Root Hex-encoded SHA-256 root of the tree
+	Root string `json:"root"`
+
+	// Steps Sibling hashes from the leaf up to the root
+	Steps []MerkleProofStep `json:"steps"`
+}
+
+// MerkleProofStep defines model for MerkleProofStep.
+type MerkleProofStep struct {
+	// Hash Hex-encoded SHA-256 sibling hash
+	Hash string `json:"hash"`
+
+	// Left Whether the sibling is the left-hand node
+	Left bool `json:"left"`
+}
+
 // OAuth2Client defines model for OAuth2Client.
 type OAuth2Client struct {
 	// ClientId OAuth2 client identifier
@@ -675,14 +1382,60 @@ type OAuth2ClientWithSecret struct {
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
-// PaginationMeta defines model for PaginationMeta.
-type PaginationMeta struct {
-	Limit      int `json:"limit"`
+// OwnershipSource How an ownership period started
+type OwnershipSource string
+
+// OwnershipTransfer defines model for OwnershipTransfer.
+type OwnershipTransfer struct {
+	// CompletedAt When the transfer was accepted or cancelled
+	CompletedAt *time.Time `json:"completedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+
+	// EventId The ownership_transfer event recorded when the transfer was accepted
+	EventId *openapi_types.UUID `json:"eventId"`
+
+	// ExpiresAt When the transfer can no longer be accepted
+	ExpiresAt time.Time               `json:"expiresAt"`
+	Id        openapi_types.UUID      `json:"id"`
+	Status    OwnershipTransferStatus `json:"status"`
+
+	// ToEmail Email address the transfer was sent to
+	ToEmail   string             `json:"toEmail"`
+	VehicleId openapi_types.UUID `json:"vehicleId"`
+}
+
+// OwnershipTransferStatus defines model for OwnershipTransfer.Status.
+type OwnershipTransferStatus string
+
+// OwnershipTransferPreview defines model for OwnershipTransferPreview.
+type OwnershipTransferPreview struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// ToEmail Email address the transfer was sent to
+	ToEmail string            `json:"toEmail"`
+	Vehicle InvitationVehicle `json:"vehicle"`
+}
+
+// PaginationMeta defines model for PaginationMeta.
+type PaginationMeta struct {
+	Limit      int `json:"limit"`
 	Page       int `json:"page"`
 	Total      int `json:"total"`
 	TotalPages int `json:"totalPages"`
 }
 
+// PassportWarning Warning to show prominently to anyone viewing the vehicle
+type PassportWarning struct {
+	Code    PassportWarningCode `json:"code"`
+	Message string              `json:"message"`
+
+	// Since When the vehicle was reported stolen or scrapped
+	Since time.Time `json:"since"`
+}
+
+// PassportWarningCode defines model for PassportWarning.Code.
+type PassportWarningCode string
+
 // Photo defines model for Photo.
 type Photo struct {
 	// CreatedAt When photo was added
@@ -703,6 +1456,19 @@ type PhotoListResponse struct {
 	Data []Photo `json:"data"`
 }
 
+// ProvenancePeriod An ownership period with the owner identified only by their position in the chain of custody, e.g. "Owner 3, 2011–2019"
+type ProvenancePeriod struct {
+	// EndDate Null for the current owner
+	EndDate *time.Time `json:"endDate"`
+
+	// OwnerNumber Position of the owner in the chain of custody, starting at 1. An owner who owned the vehicle more than once keeps the same number.
+	OwnerNumber int `json:"ownerNumber"`
+
+	// Source How an ownership period started
+	Source    OwnershipSource `json:"source"`
+	StartDate time.Time       `json:"startDate"`
+}
+
 // PublicEntity defines model for PublicEntity.
 type PublicEntity struct {
 	Address     *Address            `json:"address,omitempty"`
@@ -723,6 +1489,69 @@ type PublicEntityListResponse struct {
 	Meta PaginationMeta `json:"meta"`
 }
 
+// RegisterEventTypeRequest defines model for RegisterEventTypeRequest.
+type RegisterEventTypeRequest struct {
+	DisplayName string `json:"displayName"`
+
+	// Icon Icon name or URL shown with events of the type
+	Icon *string `json:"icon,omitempty"`
+
+	// Key Identifier of the type, unique within the entity
+	Key string `json:"key"`
+
+	// MetadataSchema OpenAPI 3 schema object (a subset of JSON Schema) describing the metadata object of events of the type
+	MetadataSchema map[string]interface{} `json:"metadataSchema"`
+}
+
+// RegisterSigningKeyRequest defines model for RegisterSigningKeyRequest.
+type RegisterSigningKeyRequest struct {
+	Label string `json:"label"`
+
+	// PublicKey Base64-encoded 32-byte Ed25519 public key held by the entity. Omit to generate a managed key.
+	PublicKey *string `json:"publicKey,omitempty"`
+}
+
+// RejectLifecycleRequestRequest defines model for RejectLifecycleRequestRequest.
+type RejectLifecycleRequestRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// RejectStolenReportRequest defines model for RejectStolenReportRequest.
+type RejectStolenReportRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// RequeueAnchorsRequest defines model for RequeueAnchorsRequest.
+type RequeueAnchorsRequest struct {
+	// Ids Records to requeue. Omit to requeue every failed record of the record type.
+	Ids        *[]openapi_types.UUID           `json:"ids,omitempty"`
+	RecordType RequeueAnchorsRequestRecordType `json:"recordType"`
+}
+
+// RequeueAnchorsRequestRecordType defines model for RequeueAnchorsRequest.RecordType.
+type RequeueAnchorsRequestRecordType string
+
+// RequeueAnchorsResponse defines model for RequeueAnchorsResponse.
+type RequeueAnchorsResponse struct {
+	// Requeued Number of anchor jobs published
+	Requeued int `json:"requeued"`
+
+	// Skipped Records that were not found or are not pending or failed
+	Skipped []openapi_types.UUID `json:"skipped"`
+}
+
+// ResolveStolenReportRequest defines model for ResolveStolenReportRequest.
+type ResolveStolenReportRequest struct {
+	// Note How the vehicle was recovered. It is kept on the report and not made public.
+	Note *string `json:"note,omitempty"`
+}
+
+// RevokeEventRequest defines model for RevokeEventRequest.
+type RevokeEventRequest struct {
+	// Reason Why the certification is revoked; written on-chain with the revocation
+	Reason string `json:"reason"`
+}
+
 // ShareLink defines model for ShareLink.
 type ShareLink struct {
 	// AccessedCount Number of times the link has been accessed
@@ -757,9 +1586,85 @@ type SharedVehicleResponse struct {
 	Documents *[]Document `json:"documents"`
 	History   *[]Event    `json:"history"`
 	Photos    *[]Photo    `json:"photos"`
-	Vehicle   Vehicle     `json:"vehicle"`
+
+	// Provenance Pseudonymised chain of custody, oldest first
+	Provenance *[]ProvenancePeriod `json:"provenance"`
+	Vehicle    Vehicle             `json:"vehicle"`
+
+	// Warning Warning to show prominently to anyone viewing the vehicle
+	Warning *PassportWarning `json:"warning,omitempty"`
+}
+
+// SignEventRequest defines model for SignEventRequest.
+type SignEventRequest struct {
+	KeyId openapi_types.UUID `json:"keyId"`
+
+	// Signature Base64-encoded Ed25519 signature of the event's CID
+	Signature string `json:"signature"`
+}
+
+// SigningKey defines model for SigningKey.
+type SigningKey struct {
+	Algorithm SigningKeyAlgorithm `json:"algorithm"`
+	CreatedAt time.Time           `json:"createdAt"`
+	EntityId  openapi_types.UUID  `json:"entityId"`
+	Id        openapi_types.UUID  `json:"id"`
+	Label     string              `json:"label"`
+
+	// Managed Whether the platform holds the private key and signs the entity's events with it
+	Managed bool `json:"managed"`
+
+	// PublicKey Base64-encoded 32-byte Ed25519 public key
+	PublicKey string     `json:"publicKey"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// SigningKeyAlgorithm defines model for SigningKey.Algorithm.
+type SigningKeyAlgorithm string
+
+// StolenReport defines model for StolenReport.
+type StolenReport struct {
+	CreatedAt     time.Time           `json:"createdAt"`
+	DecidedAt     *time.Time          `json:"decidedAt"`
+	DeclineReason *string             `json:"declineReason"`
+	Description   string              `json:"description"`
+	Id            openapi_types.UUID  `json:"id"`
+	IncidentDate  *openapi_types.Date `json:"incidentDate"`
+	Kind          StolenReportKind    `json:"kind"`
+
+	// LifecycleRequestId The lifecycle request that flagged the vehicle as stolen when the report was approved
+	LifecycleRequestId *openapi_types.UUID `json:"lifecycleRequestId"`
+	Location           *string             `json:"location"`
+
+	// PoliceAuthority Police force or station the report was made to
+	PoliceAuthority *string `json:"policeAuthority"`
+
+	// PoliceReference Reference of the report made to the police
+	PoliceReference *string    `json:"policeReference"`
+	ResolutionNote  *string    `json:"resolutionNote"`
+	ResolvedAt      *time.Time `json:"resolvedAt"`
+
+	// Status Resolved reports were approved and later closed when the vehicle was recovered
+	Status    StolenReportStatus `json:"status"`
+	VehicleId openapi_types.UUID `json:"vehicleId"`
+}
+
+// StolenReportKind defines model for StolenReportKind.
+type StolenReportKind string
+
+// StolenReportStatus Resolved reports were approved and later closed when the vehicle was recovered
+type StolenReportStatus string
+
+// StolenVehicleAlert defines model for StolenVehicleAlert.
+type StolenVehicleAlert struct {
+	// Since When the vehicle was flagged
+	Since  *time.Time               `json:"since"`
+	Status StolenVehicleAlertStatus `json:"status"`
 }
 
+// StolenVehicleAlertStatus defines model for StolenVehicleAlert.Status.
+type StolenVehicleAlertStatus string
+
 // UpdateCertifierVehicleRequest Request to update an unclaimed vehicle for certification with optional owner assignment
 type UpdateCertifierVehicleRequest struct {
 	BodyType      *string `json:"bodyType,omitempty"`
@@ -803,7 +1708,18 @@ type UpdateEntityRequest struct {
 	ContactEmail *openapi_types.Email `json:"contactEmail,omitempty"`
 	Description  *string              `json:"description,omitempty"`
 	Name         *string              `json:"name,omitempty"`
-	Website      *string              `json:"website,omitempty"`
+
+	// OwnerApprovalEventTypes Event types that are proposed to the vehicle owner and only anchored once accepted. Replaces the current list.
+	OwnerApprovalEventTypes *[]string `json:"ownerApprovalEventTypes,omitempty"`
+	Website                 *string   `json:"website,omitempty"`
+}
+
+// UpdateEventTypeRequest defines model for UpdateEventTypeRequest.
+type UpdateEventTypeRequest struct {
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Icon Icon name or URL; an empty string removes the icon
+	Icon *string `json:"icon,omitempty"`
 }
 
 // UpdateVehicleRequest defines model for UpdateVehicleRequest.
@@ -868,9 +1784,12 @@ type UserProfile struct {
 
 // Vehicle defines model for Vehicle.
 type Vehicle struct {
-	// ActiveCertificationsCount Number of active/valid certifications
+	// ActiveCertificationsCount Number of certifications that are neither expired nor revoked
 	ActiveCertificationsCount *int `json:"activeCertificationsCount,omitempty"`
 
+	// Anchor A transaction submitted to anchor a record, with the outcome reported by the ledger. On a record, this is its latest confirmed anchor, or its latest attempt when none was confirmed. Events anchored under one Merkle root share its transaction.
+	Anchor *AnchorTransaction `json:"anchor,omitempty"`
+
 	// BlockchainAssetId Algorand blockchain address for this vehicle
 	BlockchainAssetId *string `json:"blockchainAssetId,omitempty"`
 
@@ -880,6 +1799,9 @@ type Vehicle struct {
 	// BodyType Body style (e.g., Sedan, Coupe, Convertible, Wagon)
 	BodyType *string `json:"bodyType,omitempty"`
 
+	// Certifications Certifications of the vehicle with their current status, most recent first. Only included when a single vehicle is requested.
+	Certifications *[]CertificationValidity `json:"certifications,omitempty"`
+
 	// CertifiedEventsCount Number of events certified by entities
 	CertifiedEventsCount *int `json:"certifiedEventsCount,omitempty"`
 
@@ -923,8 +1845,16 @@ type Vehicle struct {
 
 	// LicensePlate License plate number
 	LicensePlate *string `json:"licensePlate,omitempty"`
-	Make         string  `json:"make"`
-	Model        string  `json:"model"`
+
+	// LifecycleStatus Where the vehicle is in its life. Stolen vehicles cannot change hands and their asset is
+	// frozen in the owner's wallet; scrapped vehicles are final, their record is closed and their
+	// asset destroyed.
+	LifecycleStatus *VehicleLifecycleStatus `json:"lifecycleStatus,omitempty"`
+
+	// LifecycleStatusAt When the vehicle entered its lifecycle status
+	LifecycleStatusAt *time.Time `json:"lifecycleStatusAt,omitempty"`
+	Make              string     `json:"make"`
+	Model             string     `json:"model"`
 
 	// OwnerEventsCount Number of events created by owners
 	OwnerEventsCount *int                `json:"ownerEventsCount,omitempty"`
@@ -941,6 +1871,39 @@ type Vehicle struct {
 // VehicleBlockchainStatus Status of blockchain anchoring
 type VehicleBlockchainStatus string
 
+// VehicleChainResponse defines model for VehicleChainResponse.
+type VehicleChainResponse struct {
+	GenesisCid *string      `json:"genesisCid,omitempty"`
+	HeadCid    *string      `json:"headCid,omitempty"`
+	Issues     []ChainIssue `json:"issues"`
+
+	// Links Records from genesis to head
+	Links []ChainLink `json:"links"`
+
+	// Valid Whether every record hashes to its CID and is linked into a single chain
+	Valid     bool               `json:"valid"`
+	VehicleId openapi_types.UUID `json:"vehicleId"`
+}
+
+// VehicleCustody defines model for VehicleCustody.
+type VehicleCustody struct {
+	Error *string `json:"error,omitempty"`
+
+	// HolderAddress Owner wallet holding the asset. Absent while the platform holds it.
+	HolderAddress *string `json:"holderAddress,omitempty"`
+
+	// Status platform and owner tell who holds the asset; pending and failed describe the latest custody transfer
+	Status VehicleCustodyStatus `json:"status"`
+
+	// TxId Latest custody transfer transaction
+	TxId      *string            `json:"txId,omitempty"`
+	UpdatedAt *time.Time         `json:"updatedAt,omitempty"`
+	VehicleId openapi_types.UUID `json:"vehicleId"`
+}
+
+// VehicleCustodyStatus platform and owner tell who holds the asset; pending and failed describe the latest custody transfer
+type VehicleCustodyStatus string
+
 // VehicleInvitationResponse defines model for VehicleInvitationResponse.
 type VehicleInvitationResponse struct {
 	// Email The email address the invitation was sent to
@@ -956,12 +1919,101 @@ type VehicleInvitationResponse struct {
 	InvitedAt *time.Time `json:"invitedAt,omitempty"`
 }
 
+// VehicleLifecycleStatus Where the vehicle is in its life. Stolen vehicles cannot change hands and their asset is
+// frozen in the owner's wallet; scrapped vehicles are final, their record is closed and their
+// asset destroyed.
+type VehicleLifecycleStatus string
+
 // VehicleListResponse defines model for VehicleListResponse.
 type VehicleListResponse struct {
 	Data []Vehicle      `json:"data"`
 	Meta PaginationMeta `json:"meta"`
 }
 
+// VehicleOwnerPeriod defines model for VehicleOwnerPeriod.
+type VehicleOwnerPeriod struct {
+	// EndDate Null for the current owner
+	EndDate *time.Time         `json:"endDate"`
+	Id      openapi_types.UUID `json:"id"`
+
+	// OwnerId Only returned to admins. Null when the owner's account was deleted.
+	OwnerId *openapi_types.UUID `json:"ownerId"`
+
+	// OwnerNumber Position of the owner in the chain of custody, starting at 1
+	OwnerNumber int `json:"ownerNumber"`
+
+	// Source How an ownership period started
+	Source    OwnershipSource `json:"source"`
+	StartDate time.Time       `json:"startDate"`
+}
+
+// VehicleVerificationResponse defines model for VehicleVerificationResponse.
+type VehicleVerificationResponse struct {
+	Events   []AnchorVerification `json:"events"`
+	Vehicle  AnchorVerification   `json:"vehicle"`
+	Versions []AnchorVerification `json:"versions"`
+}
+
+// VehicleVersion defines model for VehicleVersion.
+type VehicleVersion struct {
+	// Anchor A transaction submitted to anchor a record, with the outcome reported by the ledger. On a record, this is its latest confirmed anchor, or its latest attempt when none was confirmed. Events anchored under one Merkle root share its transaction.
+	Anchor           *AnchorTransaction             `json:"anchor,omitempty"`
+	BlockchainStatus VehicleVersionBlockchainStatus `json:"blockchainStatus"`
+
+	// BlockchainTxId Algorand transaction ID of the vehicle_update anchor
+	BlockchainTxId *string `json:"blockchainTxId,omitempty"`
+
+	// Cid CID of this version of the vehicle record
+	Cid       string             `json:"cid"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// PreviousCid CID of the previous record in the vehicle's chain, a version or an event
+	PreviousCid string `json:"previousCid"`
+
+	// Version Version number; the genesis record is version 1
+	Version int `json:"version"`
+}
+
+// VehicleVersionBlockchainStatus defines model for VehicleVersion.BlockchainStatus.
+type VehicleVersionBlockchainStatus string
+
+// Wallet defines model for Wallet.
+type Wallet struct {
+	Address  string    `json:"address"`
+	LinkedAt time.Time `json:"linkedAt"`
+}
+
+// WalletChallenge defines model for WalletChallenge.
+type WalletChallenge struct {
+	Address   string    `json:"address"`
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Message Message to sign with the wallet as arbitrary bytes (MX-prefixed, as algosdk signBytes does)
+	Message string `json:"message"`
+}
+
+// WalletChallengeRequest defines model for WalletChallengeRequest.
+type WalletChallengeRequest struct {
+	// Address Algorand address of the wallet to link
+	Address string `json:"address"`
+}
+
+// AnchorRecordIdParam defines model for AnchorRecordIdParam.
+type AnchorRecordIdParam = openapi_types.UUID
+
+// AnchorRecordTypeParam defines model for AnchorRecordTypeParam.
+type AnchorRecordTypeParam string
+
+// AnchorRecordTypeQueryParam defines model for AnchorRecordTypeQueryParam.
+type AnchorRecordTypeQueryParam string
+
+// CertificationRequestIdParam defines model for CertificationRequestIdParam.
+type CertificationRequestIdParam = openapi_types.UUID
+
+// ChassisNumberQueryParam defines model for ChassisNumberQueryParam.
+type ChassisNumberQueryParam = string
+
 // ClientIdParam defines model for ClientIdParam.
 type ClientIdParam = string
 
@@ -980,6 +2032,15 @@ type EventImageIdParam = openapi_types.UUID
 // EventImageSessionIdParam defines model for EventImageSessionIdParam.
 type EventImageSessionIdParam = openapi_types.UUID
 
+// EventTypeKeyParam defines model for EventTypeKeyParam.
+type EventTypeKeyParam = string
+
+// LicensePlateQueryParam defines model for LicensePlateQueryParam.
+type LicensePlateQueryParam = string
+
+// LifecycleRequestIdParam defines model for LifecycleRequestIdParam.
+type LifecycleRequestIdParam = openapi_types.UUID
+
 // LimitParam defines model for LimitParam.
 type LimitParam = int
 
@@ -995,6 +2056,18 @@ type ShareLinkIdParam = openapi_types.UUID
 // ShareTokenParam defines model for ShareTokenParam.
 type ShareTokenParam = string
 
+// SigningKeyIdParam defines model for SigningKeyIdParam.
+type SigningKeyIdParam = openapi_types.UUID
+
+// StolenReportIdParam defines model for StolenReportIdParam.
+type StolenReportIdParam = openapi_types.UUID
+
+// TransferIdParam defines model for TransferIdParam.
+type TransferIdParam = openapi_types.UUID
+
+// TransferTokenParam defines model for TransferTokenParam.
+type TransferTokenParam = string
+
 // UserIdParam defines model for UserIdParam.
 type UserIdParam = openapi_types.UUID
 
@@ -1013,9 +2086,42 @@ type Forbidden = ErrorResponse
 // NotFound defines model for NotFound.
 type NotFound = ErrorResponse
 
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorResponse
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = ErrorResponse
 
+// GetFailedAnchorsParams defines parameters for GetFailedAnchors.
+type GetFailedAnchorsParams struct {
+	// RecordType Kind of anchored record
+	RecordType GetFailedAnchorsParamsRecordType `form:"recordType" json:"recordType"`
+
+	// Page Page number for pagination
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Number of items per page
+	Limit *LimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetFailedAnchorsParamsRecordType defines parameters for GetFailedAnchors.
+type GetFailedAnchorsParamsRecordType string
+
+// RequeueAnchorParamsRecordType defines parameters for RequeueAnchor.
+type RequeueAnchorParamsRecordType string
+
+// GetAdminLifecycleRequestsParams defines parameters for GetAdminLifecycleRequests.
+type GetAdminLifecycleRequestsParams struct {
+	// Status Only list requests in this status
+	Status *LifecycleRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetAdminStolenReportsParams defines parameters for GetAdminStolenReports.
+type GetAdminStolenReportsParams struct {
+	// Status Only list reports in this status
+	Status *StolenReportStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // GetAdminUsersParams defines parameters for GetAdminUsers.
 type GetAdminUsersParams struct {
 	// Page Page number for pagination
@@ -1037,6 +2143,18 @@ type GetEntitiesParams struct {
 	Type *EntityType `form:"type,omitempty" json:"type,omitempty"`
 }
 
+// GetEntityCertificationRequestsParams defines parameters for GetEntityCertificationRequests.
+type GetEntityCertificationRequestsParams struct {
+	// Status Only list requests in this status
+	Status *CertificationRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetEntityLifecycleRequestsParams defines parameters for GetEntityLifecycleRequests.
+type GetEntityLifecycleRequestsParams struct {
+	// Status Only list requests in this status
+	Status *LifecycleRequestStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // ListEntityOAuth2ClientsParams defines parameters for ListEntityOAuth2Clients.
 type ListEntityOAuth2ClientsParams struct {
 	// Page Page number for pagination
@@ -1064,6 +2182,24 @@ type GetPublicEntitiesParams struct {
 	Type *EntityType `form:"type,omitempty" json:"type,omitempty"`
 }
 
+// LookupStolenVehicleParams defines parameters for LookupStolenVehicle.
+type LookupStolenVehicleParams struct {
+	// ChassisNumber Chassis number (VIN) of the vehicle. Either it or the license plate is required.
+	ChassisNumber *ChassisNumberQueryParam `form:"chassisNumber,omitempty" json:"chassisNumber,omitempty"`
+
+	// LicensePlate License plate of the vehicle
+	LicensePlate *LicensePlateQueryParam `form:"licensePlate,omitempty" json:"licensePlate,omitempty"`
+}
+
+// LookupStolenVehicleAuthenticatedParams defines parameters for LookupStolenVehicleAuthenticated.
+type LookupStolenVehicleAuthenticatedParams struct {
+	// ChassisNumber Chassis number (VIN) of the vehicle. Either it or the license plate is required.
+	ChassisNumber *ChassisNumberQueryParam `form:"chassisNumber,omitempty" json:"chassisNumber,omitempty"`
+
+	// LicensePlate License plate of the vehicle
+	LicensePlate *LicensePlateQueryParam `form:"licensePlate,omitempty" json:"licensePlate,omitempty"`
+}
+
 // GetVehiclesParams defines parameters for GetVehicles.
 type GetVehiclesParams struct {
 	// Page Page number for pagination
@@ -1088,6 +2224,18 @@ type GetVehicleEventsParams struct {
 // ClaimAdminInvitationJSONRequestBody defines body for ClaimAdminInvitation for application/json ContentType.
 type ClaimAdminInvitationJSONRequestBody = ClaimAdminInvitationRequest
 
+// RequeueAnchorsJSONRequestBody defines body for RequeueAnchors for application/json ContentType.
+type RequeueAnchorsJSONRequestBody = RequeueAnchorsRequest
+
+// RejectAdminLifecycleRequestJSONRequestBody defines body for RejectAdminLifecycleRequest for application/json ContentType.
+type RejectAdminLifecycleRequestJSONRequestBody = RejectLifecycleRequestRequest
+
+// RejectStolenReportJSONRequestBody defines body for RejectStolenReport for application/json ContentType.
+type RejectStolenReportJSONRequestBody = RejectStolenReportRequest
+
+// ResolveStolenReportJSONRequestBody defines body for ResolveStolenReport for application/json ContentType.
+type ResolveStolenReportJSONRequestBody = ResolveStolenReportRequest
+
 // CreateAdminUserJSONRequestBody defines body for CreateAdminUser for application/json ContentType.
 type CreateAdminUserJSONRequestBody = CreateAdminUserRequest
 
@@ -1103,6 +2251,21 @@ type CreateEntityJSONRequestBody = CreateEntityRequest
 // UpdateEntityJSONRequestBody defines body for UpdateEntity for application/json ContentType.
 type UpdateEntityJSONRequestBody = UpdateEntityRequest
 
+// DeclineCertificationRequestJSONRequestBody defines body for DeclineCertificationRequest for application/json ContentType.
+type DeclineCertificationRequestJSONRequestBody = DeclineCertificationRequestRequest
+
+// EndorseCertificationRequestJSONRequestBody defines body for EndorseCertificationRequest for application/json ContentType.
+type EndorseCertificationRequestJSONRequestBody = EndorseCertificationRequestRequest
+
+// RegisterEntityEventTypeJSONRequestBody defines body for RegisterEntityEventType for application/json ContentType.
+type RegisterEntityEventTypeJSONRequestBody = RegisterEventTypeRequest
+
+// UpdateEntityEventTypeJSONRequestBody defines body for UpdateEntityEventType for application/json ContentType.
+type UpdateEntityEventTypeJSONRequestBody = UpdateEventTypeRequest
+
+// RejectEntityLifecycleRequestJSONRequestBody defines body for RejectEntityLifecycleRequest for application/json ContentType.
+type RejectEntityLifecycleRequestJSONRequestBody = RejectLifecycleRequestRequest
+
 // GenerateEntityLogoUploadUrlJSONRequestBody defines body for GenerateEntityLogoUploadUrl for application/json ContentType.
 type GenerateEntityLogoUploadUrlJSONRequestBody = GenerateUploadUrlRequest
 
@@ -1115,12 +2278,33 @@ type UpdateEntityMemberRoleJSONRequestBody = UpdateEntityMemberRoleRequest
 // CreateEntityOAuth2ClientJSONRequestBody defines body for CreateEntityOAuth2Client for application/json ContentType.
 type CreateEntityOAuth2ClientJSONRequestBody = CreateEntityOAuth2ClientRequest
 
+// RegisterEntitySigningKeyJSONRequestBody defines body for RegisterEntitySigningKey for application/json ContentType.
+type RegisterEntitySigningKeyJSONRequestBody = RegisterSigningKeyRequest
+
 // GenerateEventImageUploadUrlJSONRequestBody defines body for GenerateEventImageUploadUrl for application/json ContentType.
 type GenerateEventImageUploadUrlJSONRequestBody = GenerateEventImageUploadUrlRequest
 
 // CreateEventJSONRequestBody defines body for CreateEvent for application/json ContentType.
 type CreateEventJSONRequestBody = CreateEventRequest
 
+// AmendEventJSONRequestBody defines body for AmendEvent for application/json ContentType.
+type AmendEventJSONRequestBody = AmendEventRequest
+
+// RequestEventCertificationJSONRequestBody defines body for RequestEventCertification for application/json ContentType.
+type RequestEventCertificationJSONRequestBody = CreateCertificationRequestRequest
+
+// RevokeEventJSONRequestBody defines body for RevokeEvent for application/json ContentType.
+type RevokeEventJSONRequestBody = RevokeEventRequest
+
+// SignEventJSONRequestBody defines body for SignEvent for application/json ContentType.
+type SignEventJSONRequestBody = SignEventRequest
+
+// LinkMyWalletJSONRequestBody defines body for LinkMyWallet for application/json ContentType.
+type LinkMyWalletJSONRequestBody = LinkWalletRequest
+
+// CreateWalletChallengeJSONRequestBody defines body for CreateWalletChallenge for application/json ContentType.
+type CreateWalletChallengeJSONRequestBody = WalletChallengeRequest
+
 // CreateVehicleJSONRequestBody defines body for CreateVehicle for application/json ContentType.
 type CreateVehicleJSONRequestBody = CreateVehicleRequest
 
@@ -1133,12 +2317,21 @@ type GenerateDocumentUploadUrlJSONRequestBody = GenerateDocumentUploadUrlRequest
 // CreateOwnerEventJSONRequestBody defines body for CreateOwnerEvent for application/json ContentType.
 type CreateOwnerEventJSONRequestBody = CreateOwnerEventRequest
 
+// RequestVehicleLifecycleChangeJSONRequestBody defines body for RequestVehicleLifecycleChange for application/json ContentType.
+type RequestVehicleLifecycleChangeJSONRequestBody = CreateLifecycleRequestRequest
+
 // GeneratePhotoUploadUrlJSONRequestBody defines body for GeneratePhotoUploadUrl for application/json ContentType.
 type GeneratePhotoUploadUrlJSONRequestBody = GenerateUploadUrlRequest
 
 // CreateShareLinkJSONRequestBody defines body for CreateShareLink for application/json ContentType.
 type CreateShareLinkJSONRequestBody = CreateShareLinkRequest
 
+// FileStolenReportJSONRequestBody defines body for FileStolenReport for application/json ContentType.
+type FileStolenReportJSONRequestBody = CreateStolenReportRequest
+
+// InitiateVehicleTransferJSONRequestBody defines body for InitiateVehicleTransfer for application/json ContentType.
+type InitiateVehicleTransferJSONRequestBody = InitiateOwnershipTransferRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get admin invitation details by token
@@ -1147,6 +2340,36 @@ type ServerInterface interface {
 	// Claim admin invitation and create user account
 	// (POST /admin-invitations/{token})
 	ClaimAdminInvitation(w http.ResponseWriter, r *http.Request, token string)
+	// List failed anchors
+	// (GET /admin/anchors/failed)
+	GetFailedAnchors(w http.ResponseWriter, r *http.Request, params GetFailedAnchorsParams)
+	// Requeue failed anchors in bulk
+	// (POST /admin/anchors/requeue)
+	RequeueAnchors(w http.ResponseWriter, r *http.Request)
+	// Requeue a single anchor
+	// (POST /admin/anchors/{recordType}/{recordId}/requeue)
+	RequeueAnchor(w http.ResponseWriter, r *http.Request, recordType RequeueAnchorParamsRecordType, recordId AnchorRecordIdParam)
+	// List lifecycle requests
+	// (GET /admin/lifecycle-requests)
+	GetAdminLifecycleRequests(w http.ResponseWriter, r *http.Request, params GetAdminLifecycleRequestsParams)
+	// Approve a lifecycle request
+	// (POST /admin/lifecycle-requests/{requestId}/approve)
+	ApproveAdminLifecycleRequest(w http.ResponseWriter, r *http.Request, requestId LifecycleRequestIdParam)
+	// Reject a lifecycle request
+	// (POST /admin/lifecycle-requests/{requestId}/reject)
+	RejectAdminLifecycleRequest(w http.ResponseWriter, r *http.Request, requestId LifecycleRequestIdParam)
+	// List stolen vehicle reports
+	// (GET /admin/stolen-reports)
+	GetAdminStolenReports(w http.ResponseWriter, r *http.Request, params GetAdminStolenReportsParams)
+	// Approve a stolen vehicle report
+	// (POST /admin/stolen-reports/{reportId}/approve)
+	ApproveStolenReport(w http.ResponseWriter, r *http.Request, reportId StolenReportIdParam)
+	// Reject a stolen vehicle report
+	// (POST /admin/stolen-reports/{reportId}/reject)
+	RejectStolenReport(w http.ResponseWriter, r *http.Request, reportId StolenReportIdParam)
+	// Resolve a stolen vehicle report
+	// (POST /admin/stolen-reports/{reportId}/resolve)
+	ResolveStolenReport(w http.ResponseWriter, r *http.Request, reportId StolenReportIdParam)
 	// List admin users
 	// (GET /admin/users)
 	GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams)
@@ -1177,6 +2400,36 @@ type ServerInterface interface {
 	// Update entity
 	// (PUT /entities/{entityId})
 	UpdateEntity(w http.ResponseWriter, r *http.Request, entityId EntityIdParam)
+	// List the entity's certification review queue
+	// (GET /entities/{entityId}/certification-requests)
+	GetEntityCertificationRequests(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, params GetEntityCertificationRequestsParams)
+	// Decline a certification request
+	// (POST /entities/{entityId}/certification-requests/{requestId}/decline)
+	DeclineCertificationRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId CertificationRequestIdParam)
+	// Endorse a certification request
+	// (POST /entities/{entityId}/certification-requests/{requestId}/endorse)
+	EndorseCertificationRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId CertificationRequestIdParam)
+	// List the entity's custom event types
+	// (GET /entities/{entityId}/event-types)
+	GetEntityEventTypes(w http.ResponseWriter, r *http.Request, entityId EntityIdParam)
+	// Register a custom event type
+	// (POST /entities/{entityId}/event-types)
+	RegisterEntityEventType(w http.ResponseWriter, r *http.Request, entityId EntityIdParam)
+	// Retire a custom event type
+	// (DELETE /entities/{entityId}/event-types/{key})
+	RetireEntityEventType(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, key EventTypeKeyParam)
+	// Update a custom event type
+	// (PATCH /entities/{entityId}/event-types/{key})
+	UpdateEntityEventType(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, key EventTypeKeyParam)
+	// List the entity's lifecycle review queue
+	// (GET /entities/{entityId}/lifecycle-requests)
+	GetEntityLifecycleRequests(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, params GetEntityLifecycleRequestsParams)
+	// Approve a lifecycle request
+	// (POST /entities/{entityId}/lifecycle-requests/{requestId}/approve)
+	ApproveEntityLifecycleRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId LifecycleRequestIdParam)
+	// Reject a lifecycle request
+	// (POST /entities/{entityId}/lifecycle-requests/{requestId}/reject)
+	RejectEntityLifecycleRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId LifecycleRequestIdParam)
 	// Delete entity logo
 	// (DELETE /entities/{entityId}/logo)
 	DeleteEntityLogo(w http.ResponseWriter, r *http.Request, entityId EntityIdParam)
@@ -1207,6 +2460,15 @@ type ServerInterface interface {
 	// Get OAuth2 client details
 	// (GET /entities/{entityId}/oauth2/clients/{clientId})
 	GetEntityOAuth2Client(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, clientId ClientIdParam)
+	// List the entity's signing keys
+	// (GET /entities/{entityId}/signing-keys)
+	GetEntitySigningKeys(w http.ResponseWriter, r *http.Request, entityId EntityIdParam)
+	// Register a signing key
+	// (POST /entities/{entityId}/signing-keys)
+	RegisterEntitySigningKey(w http.ResponseWriter, r *http.Request, entityId EntityIdParam)
+	// Revoke a signing key
+	// (DELETE /entities/{entityId}/signing-keys/{keyId})
+	RevokeEntitySigningKey(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, keyId SigningKeyIdParam)
 	// Create an upload session for event images
 	// (POST /event-images/upload-session)
 	CreateEventImageUploadSession(w http.ResponseWriter, r *http.Request)
@@ -1222,15 +2484,39 @@ type ServerInterface interface {
 	// Generate a pre-signed URL for uploading an event image
 	// (POST /event-images/{sessionId}/upload-url)
 	GenerateEventImageUploadUrl(w http.ResponseWriter, r *http.Request, sessionId EventImageSessionIdParam)
+	// List event metadata schemas
+	// (GET /event-metadata-schemas)
+	GetEventMetadataSchemas(w http.ResponseWriter, r *http.Request)
 	// Create a new history event
 	// (POST /events)
 	CreateEvent(w http.ResponseWriter, r *http.Request)
 	// Get event by ID
 	// (GET /events/{eventId})
 	GetEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// Accept a proposed event
+	// (POST /events/{eventId}/accept)
+	AcceptEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// Amend an event
+	// (POST /events/{eventId}/amendments)
+	AmendEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// List certification requests of an event
+	// (GET /events/{eventId}/certification-requests)
+	GetEventCertificationRequests(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// Request certification of an owner event
+	// (POST /events/{eventId}/certification-requests)
+	RequestEventCertification(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
 	// Get images for an event
 	// (GET /events/{eventId}/images)
 	GetEventImages(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// Reject a proposed event
+	// (POST /events/{eventId}/reject)
+	RejectEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// Revoke a certified event
+	// (POST /events/{eventId}/revocation)
+	RevokeEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// Attach a signature to an event
+	// (POST /events/{eventId}/signature)
+	SignEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
 	// Health check endpoint
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
@@ -1243,15 +2529,57 @@ type ServerInterface interface {
 	// Get current user profile
 	// (GET /me)
 	GetMe(w http.ResponseWriter, r *http.Request)
+	// Unlink wallet
+	// (DELETE /me/wallet)
+	UnlinkMyWallet(w http.ResponseWriter, r *http.Request)
+	// Get linked wallet
+	// (GET /me/wallet)
+	GetMyWallet(w http.ResponseWriter, r *http.Request)
+	// Link a wallet
+	// (POST /me/wallet)
+	LinkMyWallet(w http.ResponseWriter, r *http.Request)
+	// Request a wallet challenge
+	// (POST /me/wallet/challenge)
+	CreateWalletChallenge(w http.ResponseWriter, r *http.Request)
+	// Get vehicle asset metadata
+	// (GET /public/asset-metadata/{vehicleId})
+	GetVehicleAssetMetadata(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
 	// List public entities
 	// (GET /public/entities)
 	GetPublicEntities(w http.ResponseWriter, r *http.Request, params GetPublicEntitiesParams)
+	// List custom event types
+	// (GET /public/event-types)
+	GetPublicEventTypes(w http.ResponseWriter, r *http.Request)
 	// Get public vehicle passport
 	// (GET /public/passport/{vehicleId})
 	GetVehiclePassport(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Get a signing key
+	// (GET /public/signing-keys/{keyId})
+	GetSigningKey(w http.ResponseWriter, r *http.Request, keyId SigningKeyIdParam)
+	// Check whether a vehicle is flagged as stolen
+	// (GET /public/stolen-vehicles/lookup)
+	LookupStolenVehicle(w http.ResponseWriter, r *http.Request, params LookupStolenVehicleParams)
+	// Get ownership transfer details by token
+	// (GET /public/transfers/{token})
+	GetOwnershipTransferByToken(w http.ResponseWriter, r *http.Request, token TransferTokenParam)
+	// Verify a certified event against the blockchain
+	// (GET /public/verify/events/{eventId})
+	VerifyEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam)
+	// Verify a vehicle passport against the blockchain
+	// (GET /public/verify/vehicles/{vehicleId})
+	VerifyVehicle(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Walk and validate a vehicle's record chain
+	// (GET /public/verify/vehicles/{vehicleId}/chain)
+	VerifyVehicleChain(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
 	// Access shared vehicle data
 	// (GET /shared/vehicles/{token})
 	GetSharedVehicle(w http.ResponseWriter, r *http.Request, token ShareTokenParam)
+	// Check whether a vehicle is flagged as stolen (integrations)
+	// (GET /stolen-vehicles/lookup)
+	LookupStolenVehicleAuthenticated(w http.ResponseWriter, r *http.Request, params LookupStolenVehicleAuthenticatedParams)
+	// Accept an ownership transfer
+	// (POST /transfers/{token}/accept)
+	AcceptOwnershipTransfer(w http.ResponseWriter, r *http.Request, token TransferTokenParam)
 	// List vehicles
 	// (GET /vehicles)
 	GetVehicles(w http.ResponseWriter, r *http.Request, params GetVehiclesParams)
@@ -1264,6 +2592,15 @@ type ServerInterface interface {
 	// Update vehicle
 	// (PUT /vehicles/{vehicleId})
 	UpdateVehicle(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Get vehicle anchor transactions
+	// (GET /vehicles/{vehicleId}/anchors)
+	GetVehicleAnchors(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Get vehicle asset custody
+	// (GET /vehicles/{vehicleId}/custody)
+	GetVehicleCustody(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Deliver the vehicle asset to the owner's wallet
+	// (POST /vehicles/{vehicleId}/custody)
+	RequestVehicleAssetDelivery(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
 	// Get vehicle documents
 	// (GET /vehicles/{vehicleId}/documents)
 	GetVehicleDocuments(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
@@ -1282,6 +2619,15 @@ type ServerInterface interface {
 	// Create a new (owner documented) history event
 	// (POST /vehicles/{vehicleId}/events)
 	CreateOwnerEvent(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// List lifecycle requests of a vehicle
+	// (GET /vehicles/{vehicleId}/lifecycle-requests)
+	GetVehicleLifecycleRequests(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Request a lifecycle status change
+	// (POST /vehicles/{vehicleId}/lifecycle-requests)
+	RequestVehicleLifecycleChange(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Get vehicle ownership history
+	// (GET /vehicles/{vehicleId}/owners)
+	GetVehicleOwners(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
 	// Get vehicle photos
 	// (GET /vehicles/{vehicleId}/photos)
 	GetVehiclePhotos(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
@@ -1303,6 +2649,24 @@ type ServerInterface interface {
 	// Revoke a share link
 	// (DELETE /vehicles/{vehicleId}/share-links/{shareLinkId})
 	RevokeShareLink(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam, shareLinkId ShareLinkIdParam)
+	// List stolen vehicle reports of a vehicle
+	// (GET /vehicles/{vehicleId}/stolen-reports)
+	GetVehicleStolenReports(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Report a vehicle stolen or missing
+	// (POST /vehicles/{vehicleId}/stolen-reports)
+	FileStolenReport(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// List vehicle ownership transfers
+	// (GET /vehicles/{vehicleId}/transfers)
+	GetVehicleTransfers(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Transfer a vehicle to a new owner
+	// (POST /vehicles/{vehicleId}/transfers)
+	InitiateVehicleTransfer(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
+	// Cancel an ownership transfer
+	// (DELETE /vehicles/{vehicleId}/transfers/{transferId})
+	CancelVehicleTransfer(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam, transferId TransferIdParam)
+	// Get vehicle record versions
+	// (GET /vehicles/{vehicleId}/versions)
+	GetVehicleVersions(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1364,8 +2728,8 @@ func (siw *ServerInterfaceWrapper) ClaimAdminInvitation(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetAdminUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
+// GetFailedAnchors operation middleware
+func (siw *ServerInterfaceWrapper) GetFailedAnchors(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1376,7 +2740,22 @@ func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAdminUsersParams
+	var params GetFailedAnchorsParams
+
+	// ------------- Required query parameter "recordType" -------------
+
+	if paramValue := r.URL.Query().Get("recordType"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "recordType"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "recordType", r.URL.Query(), &params.RecordType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recordType", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1395,7 +2774,7 @@ func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAdminUsers(w, r, params)
+		siw.Handler.GetFailedAnchors(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1405,8 +2784,8 @@ func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateAdminUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
+// RequeueAnchors operation middleware
+func (siw *ServerInterfaceWrapper) RequeueAnchors(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1415,7 +2794,7 @@ func (siw *ServerInterfaceWrapper) CreateAdminUser(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateAdminUser(w, r)
+		siw.Handler.RequeueAnchors(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1425,8 +2804,28 @@ func (siw *ServerInterfaceWrapper) CreateAdminUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCertifierVehicle operation middleware
-func (siw *ServerInterfaceWrapper) CreateCertifierVehicle(w http.ResponseWriter, r *http.Request) {
+// RequeueAnchor operation middleware
+func (siw *ServerInterfaceWrapper) RequeueAnchor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "recordType" -------------
+	var recordType RequeueAnchorParamsRecordType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recordType", r.PathValue("recordType"), &recordType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recordType", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recordId" -------------
+	var recordId AnchorRecordIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recordId", r.PathValue("recordId"), &recordId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recordId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1435,7 +2834,7 @@ func (siw *ServerInterfaceWrapper) CreateCertifierVehicle(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCertifierVehicle(w, r)
+		siw.Handler.RequeueAnchor(w, r, recordType, recordId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1445,17 +2844,50 @@ func (siw *ServerInterfaceWrapper) CreateCertifierVehicle(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCertifierVehicle operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCertifierVehicle(w http.ResponseWriter, r *http.Request) {
+// GetAdminLifecycleRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminLifecycleRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminLifecycleRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminLifecycleRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveAdminLifecycleRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveAdminLifecycleRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId LifecycleRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "requestId", r.PathValue("requestId"), &requestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
 		return
 	}
 
@@ -1466,7 +2898,7 @@ func (siw *ServerInterfaceWrapper) UpdateCertifierVehicle(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCertifierVehicle(w, r, vehicleId)
+		siw.Handler.ApproveAdminLifecycleRequest(w, r, requestId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1476,17 +2908,17 @@ func (siw *ServerInterfaceWrapper) UpdateCertifierVehicle(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetCertifierVehicleInvitation operation middleware
-func (siw *ServerInterfaceWrapper) GetCertifierVehicleInvitation(w http.ResponseWriter, r *http.Request) {
+// RejectAdminLifecycleRequest operation middleware
+func (siw *ServerInterfaceWrapper) RejectAdminLifecycleRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "requestId" -------------
+	var requestId LifecycleRequestIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "requestId", r.PathValue("requestId"), &requestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
 		return
 	}
 
@@ -1497,7 +2929,7 @@ func (siw *ServerInterfaceWrapper) GetCertifierVehicleInvitation(w http.Response
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCertifierVehicleInvitation(w, r, vehicleId)
+		siw.Handler.RejectAdminLifecycleRequest(w, r, requestId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1507,8 +2939,8 @@ func (siw *ServerInterfaceWrapper) GetCertifierVehicleInvitation(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// GetEntities operation middleware
-func (siw *ServerInterfaceWrapper) GetEntities(w http.ResponseWriter, r *http.Request) {
+// GetAdminStolenReports operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminStolenReports(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1519,34 +2951,49 @@ func (siw *ServerInterfaceWrapper) GetEntities(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEntitiesParams
+	var params GetAdminStolenReportsParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminStolenReports(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "type" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+// ApproveStolenReport operation middleware
+func (siw *ServerInterfaceWrapper) ApproveStolenReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "reportId" -------------
+	var reportId StolenReportIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reportId", r.PathValue("reportId"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reportId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEntities(w, r, params)
+		siw.Handler.ApproveStolenReport(w, r, reportId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1556,8 +3003,19 @@ func (siw *ServerInterfaceWrapper) GetEntities(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEntity operation middleware
-func (siw *ServerInterfaceWrapper) CreateEntity(w http.ResponseWriter, r *http.Request) {
+// RejectStolenReport operation middleware
+func (siw *ServerInterfaceWrapper) RejectStolenReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "reportId" -------------
+	var reportId StolenReportIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reportId", r.PathValue("reportId"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reportId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -1566,7 +3024,7 @@ func (siw *ServerInterfaceWrapper) CreateEntity(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEntity(w, r)
+		siw.Handler.RejectStolenReport(w, r, reportId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1576,17 +3034,17 @@ func (siw *ServerInterfaceWrapper) CreateEntity(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteEntity operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEntity(w http.ResponseWriter, r *http.Request) {
+// ResolveStolenReport operation middleware
+func (siw *ServerInterfaceWrapper) ResolveStolenReport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "entityId" -------------
-	var entityId EntityIdParam
+	// ------------- Path parameter "reportId" -------------
+	var reportId StolenReportIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "reportId", r.PathValue("reportId"), &reportId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reportId", Err: err})
 		return
 	}
 
@@ -1597,7 +3055,7 @@ func (siw *ServerInterfaceWrapper) DeleteEntity(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEntity(w, r, entityId)
+		siw.Handler.ResolveStolenReport(w, r, reportId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1607,28 +3065,38 @@ func (siw *ServerInterfaceWrapper) DeleteEntity(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetEntity operation middleware
-func (siw *ServerInterfaceWrapper) GetEntity(w http.ResponseWriter, r *http.Request) {
+// GetAdminUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "entityId" -------------
-	var entityId EntityIdParam
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminUsersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEntity(w, r, entityId)
+		siw.Handler.GetAdminUsers(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1638,20 +3106,29 @@ func (siw *ServerInterfaceWrapper) GetEntity(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateEntity operation middleware
-func (siw *ServerInterfaceWrapper) UpdateEntity(w http.ResponseWriter, r *http.Request) {
+// CreateAdminUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "entityId" -------------
-	var entityId EntityIdParam
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAdminUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCertifierVehicle operation middleware
+func (siw *ServerInterfaceWrapper) CreateCertifierVehicle(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1659,7 +3136,7 @@ func (siw *ServerInterfaceWrapper) UpdateEntity(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateEntity(w, r, entityId)
+		siw.Handler.CreateCertifierVehicle(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1669,17 +3146,17 @@ func (siw *ServerInterfaceWrapper) UpdateEntity(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteEntityLogo operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEntityLogo(w http.ResponseWriter, r *http.Request) {
+// UpdateCertifierVehicle operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCertifierVehicle(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "entityId" -------------
-	var entityId EntityIdParam
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
 		return
 	}
 
@@ -1690,7 +3167,7 @@ func (siw *ServerInterfaceWrapper) DeleteEntityLogo(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEntityLogo(w, r, entityId)
+		siw.Handler.UpdateCertifierVehicle(w, r, vehicleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1700,17 +3177,17 @@ func (siw *ServerInterfaceWrapper) DeleteEntityLogo(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GenerateEntityLogoUploadUrl operation middleware
-func (siw *ServerInterfaceWrapper) GenerateEntityLogoUploadUrl(w http.ResponseWriter, r *http.Request) {
+// GetCertifierVehicleInvitation operation middleware
+func (siw *ServerInterfaceWrapper) GetCertifierVehicleInvitation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "entityId" -------------
-	var entityId EntityIdParam
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
 		return
 	}
 
@@ -1721,7 +3198,7 @@ func (siw *ServerInterfaceWrapper) GenerateEntityLogoUploadUrl(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GenerateEntityLogoUploadUrl(w, r, entityId)
+		siw.Handler.GetCertifierVehicleInvitation(w, r, vehicleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1731,20 +3208,58 @@ func (siw *ServerInterfaceWrapper) GenerateEntityLogoUploadUrl(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetEntityMembers operation middleware
-func (siw *ServerInterfaceWrapper) GetEntityMembers(w http.ResponseWriter, r *http.Request) {
+// GetEntities operation middleware
+func (siw *ServerInterfaceWrapper) GetEntities(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "entityId" -------------
-	var entityId EntityIdParam
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEntitiesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEntities(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEntity operation middleware
+func (siw *ServerInterfaceWrapper) CreateEntity(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1752,7 +3267,7 @@ func (siw *ServerInterfaceWrapper) GetEntityMembers(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEntityMembers(w, r, entityId)
+		siw.Handler.CreateEntity(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1762,8 +3277,8 @@ func (siw *ServerInterfaceWrapper) GetEntityMembers(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// AddEntityMember operation middleware
-func (siw *ServerInterfaceWrapper) AddEntityMember(w http.ResponseWriter, r *http.Request) {
+// DeleteEntity operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEntity(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1783,7 +3298,7 @@ func (siw *ServerInterfaceWrapper) AddEntityMember(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddEntityMember(w, r, entityId)
+		siw.Handler.DeleteEntity(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1793,8 +3308,8 @@ func (siw *ServerInterfaceWrapper) AddEntityMember(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// RemoveEntityMember operation middleware
-func (siw *ServerInterfaceWrapper) RemoveEntityMember(w http.ResponseWriter, r *http.Request) {
+// GetEntity operation middleware
+func (siw *ServerInterfaceWrapper) GetEntity(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1807,15 +3322,6 @@ func (siw *ServerInterfaceWrapper) RemoveEntityMember(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "userId" -------------
-	var userId UserIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1823,7 +3329,7 @@ func (siw *ServerInterfaceWrapper) RemoveEntityMember(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveEntityMember(w, r, entityId, userId)
+		siw.Handler.GetEntity(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1833,8 +3339,8 @@ func (siw *ServerInterfaceWrapper) RemoveEntityMember(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateEntityMemberRole operation middleware
-func (siw *ServerInterfaceWrapper) UpdateEntityMemberRole(w http.ResponseWriter, r *http.Request) {
+// UpdateEntity operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEntity(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1847,15 +3353,6 @@ func (siw *ServerInterfaceWrapper) UpdateEntityMemberRole(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "userId" -------------
-	var userId UserIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1863,7 +3360,7 @@ func (siw *ServerInterfaceWrapper) UpdateEntityMemberRole(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateEntityMemberRole(w, r, entityId, userId)
+		siw.Handler.UpdateEntity(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1873,8 +3370,8 @@ func (siw *ServerInterfaceWrapper) UpdateEntityMemberRole(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListEntityOAuth2Clients operation middleware
-func (siw *ServerInterfaceWrapper) ListEntityOAuth2Clients(w http.ResponseWriter, r *http.Request) {
+// GetEntityCertificationRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetEntityCertificationRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1894,26 +3391,18 @@ func (siw *ServerInterfaceWrapper) ListEntityOAuth2Clients(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListEntityOAuth2ClientsParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+	var params GetEntityCertificationRequestsParams
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "status" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEntityOAuth2Clients(w, r, entityId, params)
+		siw.Handler.GetEntityCertificationRequests(w, r, entityId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1923,8 +3412,8 @@ func (siw *ServerInterfaceWrapper) ListEntityOAuth2Clients(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEntityOAuth2Client operation middleware
-func (siw *ServerInterfaceWrapper) CreateEntityOAuth2Client(w http.ResponseWriter, r *http.Request) {
+// DeclineCertificationRequest operation middleware
+func (siw *ServerInterfaceWrapper) DeclineCertificationRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1937,6 +3426,15 @@ func (siw *ServerInterfaceWrapper) CreateEntityOAuth2Client(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Path parameter "requestId" -------------
+	var requestId CertificationRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "requestId", r.PathValue("requestId"), &requestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1944,7 +3442,7 @@ func (siw *ServerInterfaceWrapper) CreateEntityOAuth2Client(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEntityOAuth2Client(w, r, entityId)
+		siw.Handler.DeclineCertificationRequest(w, r, entityId, requestId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1954,8 +3452,8 @@ func (siw *ServerInterfaceWrapper) CreateEntityOAuth2Client(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteEntityOAuth2Client operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEntityOAuth2Client(w http.ResponseWriter, r *http.Request) {
+// EndorseCertificationRequest operation middleware
+func (siw *ServerInterfaceWrapper) EndorseCertificationRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1968,12 +3466,12 @@ func (siw *ServerInterfaceWrapper) DeleteEntityOAuth2Client(w http.ResponseWrite
 		return
 	}
 
-	// ------------- Path parameter "clientId" -------------
-	var clientId ClientIdParam
+	// ------------- Path parameter "requestId" -------------
+	var requestId CertificationRequestIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "clientId", r.PathValue("clientId"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "requestId", r.PathValue("requestId"), &requestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
 		return
 	}
 
@@ -1984,7 +3482,7 @@ func (siw *ServerInterfaceWrapper) DeleteEntityOAuth2Client(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEntityOAuth2Client(w, r, entityId, clientId)
+		siw.Handler.EndorseCertificationRequest(w, r, entityId, requestId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1994,8 +3492,8 @@ func (siw *ServerInterfaceWrapper) DeleteEntityOAuth2Client(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetEntityOAuth2Client operation middleware
-func (siw *ServerInterfaceWrapper) GetEntityOAuth2Client(w http.ResponseWriter, r *http.Request) {
+// GetEntityEventTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetEntityEventTypes(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2008,15 +3506,6 @@ func (siw *ServerInterfaceWrapper) GetEntityOAuth2Client(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "clientId" -------------
-	var clientId ClientIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "clientId", r.PathValue("clientId"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2024,7 +3513,7 @@ func (siw *ServerInterfaceWrapper) GetEntityOAuth2Client(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEntityOAuth2Client(w, r, entityId, clientId)
+		siw.Handler.GetEntityEventTypes(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2034,17 +3523,28 @@ func (siw *ServerInterfaceWrapper) GetEntityOAuth2Client(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEventImageUploadSession operation middleware
-func (siw *ServerInterfaceWrapper) CreateEventImageUploadSession(w http.ResponseWriter, r *http.Request) {
+// RegisterEntityEventType operation middleware
+func (siw *ServerInterfaceWrapper) RegisterEntityEventType(w http.ResponseWriter, r *http.Request) {
 
-	ctx := r.Context()
+	var err error
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEventImageUploadSession(w, r)
+		siw.Handler.RegisterEntityEventType(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2054,17 +3554,26 @@ func (siw *ServerInterfaceWrapper) CreateEventImageUploadSession(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteEventImage operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEventImage(w http.ResponseWriter, r *http.Request) {
+// RetireEntityEventType operation middleware
+func (siw *ServerInterfaceWrapper) RetireEntityEventType(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "imageId" -------------
-	var imageId EventImageIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "imageId", r.PathValue("imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key EventTypeKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
 		return
 	}
 
@@ -2075,7 +3584,7 @@ func (siw *ServerInterfaceWrapper) DeleteEventImage(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEventImage(w, r, imageId)
+		siw.Handler.RetireEntityEventType(w, r, entityId, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2085,17 +3594,26 @@ func (siw *ServerInterfaceWrapper) DeleteEventImage(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ConfirmEventImageUpload operation middleware
-func (siw *ServerInterfaceWrapper) ConfirmEventImageUpload(w http.ResponseWriter, r *http.Request) {
+// UpdateEntityEventType operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEntityEventType(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "imageId" -------------
-	var imageId EventImageIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "imageId", r.PathValue("imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key EventTypeKeyParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
 		return
 	}
 
@@ -2106,7 +3624,7 @@ func (siw *ServerInterfaceWrapper) ConfirmEventImageUpload(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ConfirmEventImageUpload(w, r, imageId)
+		siw.Handler.UpdateEntityEventType(w, r, entityId, key)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2116,17 +3634,17 @@ func (siw *ServerInterfaceWrapper) ConfirmEventImageUpload(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetEventImagesBySession operation middleware
-func (siw *ServerInterfaceWrapper) GetEventImagesBySession(w http.ResponseWriter, r *http.Request) {
+// GetEntityLifecycleRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetEntityLifecycleRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "sessionId" -------------
-	var sessionId EventImageSessionIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", r.PathValue("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
@@ -2136,8 +3654,19 @@ func (siw *ServerInterfaceWrapper) GetEventImagesBySession(w http.ResponseWriter
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEntityLifecycleRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEventImagesBySession(w, r, sessionId)
+		siw.Handler.GetEntityLifecycleRequests(w, r, entityId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2147,17 +3676,26 @@ func (siw *ServerInterfaceWrapper) GetEventImagesBySession(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GenerateEventImageUploadUrl operation middleware
-func (siw *ServerInterfaceWrapper) GenerateEventImageUploadUrl(w http.ResponseWriter, r *http.Request) {
+// ApproveEntityLifecycleRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveEntityLifecycleRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "sessionId" -------------
-	var sessionId EventImageSessionIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", r.PathValue("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId LifecycleRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "requestId", r.PathValue("requestId"), &requestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
 		return
 	}
 
@@ -2168,7 +3706,7 @@ func (siw *ServerInterfaceWrapper) GenerateEventImageUploadUrl(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GenerateEventImageUploadUrl(w, r, sessionId)
+		siw.Handler.ApproveEntityLifecycleRequest(w, r, entityId, requestId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2178,8 +3716,28 @@ func (siw *ServerInterfaceWrapper) GenerateEventImageUploadUrl(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// CreateEvent operation middleware
-func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Request) {
+// RejectEntityLifecycleRequest operation middleware
+func (siw *ServerInterfaceWrapper) RejectEntityLifecycleRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "requestId" -------------
+	var requestId LifecycleRequestIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "requestId", r.PathValue("requestId"), &requestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2188,7 +3746,7 @@ func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEvent(w, r)
+		siw.Handler.RejectEntityLifecycleRequest(w, r, entityId, requestId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2198,17 +3756,17 @@ func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetEvent operation middleware
-func (siw *ServerInterfaceWrapper) GetEvent(w http.ResponseWriter, r *http.Request) {
+// DeleteEntityLogo operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEntityLogo(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "eventId" -------------
-	var eventId EventIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
@@ -2219,7 +3777,7 @@ func (siw *ServerInterfaceWrapper) GetEvent(w http.ResponseWriter, r *http.Reque
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEvent(w, r, eventId)
+		siw.Handler.DeleteEntityLogo(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2229,17 +3787,17 @@ func (siw *ServerInterfaceWrapper) GetEvent(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// GetEventImages operation middleware
-func (siw *ServerInterfaceWrapper) GetEventImages(w http.ResponseWriter, r *http.Request) {
+// GenerateEntityLogoUploadUrl operation middleware
+func (siw *ServerInterfaceWrapper) GenerateEntityLogoUploadUrl(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "eventId" -------------
-	var eventId EventIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
@@ -2250,7 +3808,7 @@ func (siw *ServerInterfaceWrapper) GetEventImages(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEventImages(w, r, eventId)
+		siw.Handler.GenerateEntityLogoUploadUrl(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2260,11 +3818,28 @@ func (siw *ServerInterfaceWrapper) GetEventImages(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// GetEntityMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetEntityMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.GetEntityMembers(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2274,8 +3849,19 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ClaimInvitations operation middleware
-func (siw *ServerInterfaceWrapper) ClaimInvitations(w http.ResponseWriter, r *http.Request) {
+// AddEntityMember operation middleware
+func (siw *ServerInterfaceWrapper) AddEntityMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2284,7 +3870,7 @@ func (siw *ServerInterfaceWrapper) ClaimInvitations(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ClaimInvitations(w, r)
+		siw.Handler.AddEntityMember(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2294,31 +3880,37 @@ func (siw *ServerInterfaceWrapper) ClaimInvitations(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ValidateInvitation operation middleware
-func (siw *ServerInterfaceWrapper) ValidateInvitation(w http.ResponseWriter, r *http.Request) {
+// RemoveEntityMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveEntityMember(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ValidateInvitationParams
-
-	// ------------- Required query parameter "token" -------------
-
-	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	// ------------- Path parameter "userId" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ValidateInvitation(w, r, params)
+		siw.Handler.RemoveEntityMember(w, r, entityId, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2328,8 +3920,28 @@ func (siw *ServerInterfaceWrapper) ValidateInvitation(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetMe operation middleware
-func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
+// UpdateEntityMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEntityMemberRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2338,7 +3950,7 @@ func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request)
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMe(w, r)
+		siw.Handler.UpdateEntityMemberRole(w, r, entityId, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2348,13 +3960,28 @@ func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r)
 }
 
-// GetPublicEntities operation middleware
-func (siw *ServerInterfaceWrapper) GetPublicEntities(w http.ResponseWriter, r *http.Request) {
+// ListEntityOAuth2Clients operation middleware
+func (siw *ServerInterfaceWrapper) ListEntityOAuth2Clients(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPublicEntitiesParams
+	var params ListEntityOAuth2ClientsParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -2372,16 +3999,8 @@ func (siw *ServerInterfaceWrapper) GetPublicEntities(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Optional query parameter "type" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPublicEntities(w, r, params)
+		siw.Handler.ListEntityOAuth2Clients(w, r, entityId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2391,22 +4010,28 @@ func (siw *ServerInterfaceWrapper) GetPublicEntities(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetVehiclePassport operation middleware
-func (siw *ServerInterfaceWrapper) GetVehiclePassport(w http.ResponseWriter, r *http.Request) {
+// CreateEntityOAuth2Client operation middleware
+func (siw *ServerInterfaceWrapper) CreateEntityOAuth2Client(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVehiclePassport(w, r, vehicleId)
+		siw.Handler.CreateEntityOAuth2Client(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2416,22 +4041,37 @@ func (siw *ServerInterfaceWrapper) GetVehiclePassport(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetSharedVehicle operation middleware
-func (siw *ServerInterfaceWrapper) GetSharedVehicle(w http.ResponseWriter, r *http.Request) {
+// DeleteEntityOAuth2Client operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEntityOAuth2Client(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "token" -------------
-	var token ShareTokenParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clientId" -------------
+	var clientId ClientIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientId", r.PathValue("clientId"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSharedVehicle(w, r, token)
+		siw.Handler.DeleteEntityOAuth2Client(w, r, entityId, clientId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2441,46 +4081,37 @@ func (siw *ServerInterfaceWrapper) GetSharedVehicle(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetVehicles operation middleware
-func (siw *ServerInterfaceWrapper) GetVehicles(w http.ResponseWriter, r *http.Request) {
+// GetEntityOAuth2Client operation middleware
+func (siw *ServerInterfaceWrapper) GetEntityOAuth2Client(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetVehiclesParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "clientId" -------------
+	var clientId ClientIdParam
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "clientId", r.PathValue("clientId"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "ownerId" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "ownerId", r.URL.Query(), &params.OwnerId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVehicles(w, r, params)
+		siw.Handler.GetEntityOAuth2Client(w, r, entityId, clientId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2490,8 +4121,19 @@ func (siw *ServerInterfaceWrapper) GetVehicles(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateVehicle operation middleware
-func (siw *ServerInterfaceWrapper) CreateVehicle(w http.ResponseWriter, r *http.Request) {
+// GetEntitySigningKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetEntitySigningKeys(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2500,7 +4142,7 @@ func (siw *ServerInterfaceWrapper) CreateVehicle(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateVehicle(w, r)
+		siw.Handler.GetEntitySigningKeys(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2510,17 +4152,17 @@ func (siw *ServerInterfaceWrapper) CreateVehicle(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetVehicle operation middleware
-func (siw *ServerInterfaceWrapper) GetVehicle(w http.ResponseWriter, r *http.Request) {
+// RegisterEntitySigningKey operation middleware
+func (siw *ServerInterfaceWrapper) RegisterEntitySigningKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
 		return
 	}
 
@@ -2531,7 +4173,7 @@ func (siw *ServerInterfaceWrapper) GetVehicle(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVehicle(w, r, vehicleId)
+		siw.Handler.RegisterEntitySigningKey(w, r, entityId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2541,17 +4183,26 @@ func (siw *ServerInterfaceWrapper) GetVehicle(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateVehicle operation middleware
-func (siw *ServerInterfaceWrapper) UpdateVehicle(w http.ResponseWriter, r *http.Request) {
+// RevokeEntitySigningKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeEntitySigningKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "entityId" -------------
+	var entityId EntityIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "entityId", r.PathValue("entityId"), &entityId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId SigningKeyIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", r.PathValue("keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
 		return
 	}
 
@@ -2562,7 +4213,7 @@ func (siw *ServerInterfaceWrapper) UpdateVehicle(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateVehicle(w, r, vehicleId)
+		siw.Handler.RevokeEntitySigningKey(w, r, entityId, keyId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2572,19 +4223,8 @@ func (siw *ServerInterfaceWrapper) UpdateVehicle(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetVehicleDocuments operation middleware
-func (siw *ServerInterfaceWrapper) GetVehicleDocuments(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
-		return
-	}
+// CreateEventImageUploadSession operation middleware
+func (siw *ServerInterfaceWrapper) CreateEventImageUploadSession(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2593,7 +4233,7 @@ func (siw *ServerInterfaceWrapper) GetVehicleDocuments(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVehicleDocuments(w, r, vehicleId)
+		siw.Handler.CreateEventImageUploadSession(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2603,17 +4243,17 @@ func (siw *ServerInterfaceWrapper) GetVehicleDocuments(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GenerateDocumentUploadUrl operation middleware
-func (siw *ServerInterfaceWrapper) GenerateDocumentUploadUrl(w http.ResponseWriter, r *http.Request) {
+// DeleteEventImage operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventImage(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "imageId" -------------
+	var imageId EventImageIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", r.PathValue("imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
 		return
 	}
 
@@ -2624,7 +4264,7 @@ func (siw *ServerInterfaceWrapper) GenerateDocumentUploadUrl(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GenerateDocumentUploadUrl(w, r, vehicleId)
+		siw.Handler.DeleteEventImage(w, r, imageId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2634,26 +4274,17 @@ func (siw *ServerInterfaceWrapper) GenerateDocumentUploadUrl(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteVehicleDocument operation middleware
-func (siw *ServerInterfaceWrapper) DeleteVehicleDocument(w http.ResponseWriter, r *http.Request) {
+// ConfirmEventImageUpload operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmEventImageUpload(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "documentId" -------------
-	var documentId DocumentIdParam
+	// ------------- Path parameter "imageId" -------------
+	var imageId EventImageIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "documentId", r.PathValue("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", r.PathValue("imageId"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "imageId", Err: err})
 		return
 	}
 
@@ -2664,7 +4295,7 @@ func (siw *ServerInterfaceWrapper) DeleteVehicleDocument(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteVehicleDocument(w, r, vehicleId, documentId)
+		siw.Handler.ConfirmEventImageUpload(w, r, imageId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2674,26 +4305,17 @@ func (siw *ServerInterfaceWrapper) DeleteVehicleDocument(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ConfirmDocumentUpload operation middleware
-func (siw *ServerInterfaceWrapper) ConfirmDocumentUpload(w http.ResponseWriter, r *http.Request) {
+// GetEventImagesBySession operation middleware
+func (siw *ServerInterfaceWrapper) GetEventImagesBySession(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "documentId" -------------
-	var documentId DocumentIdParam
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId EventImageSessionIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "documentId", r.PathValue("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", r.PathValue("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
 		return
 	}
 
@@ -2704,7 +4326,7 @@ func (siw *ServerInterfaceWrapper) ConfirmDocumentUpload(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ConfirmDocumentUpload(w, r, vehicleId, documentId)
+		siw.Handler.GetEventImagesBySession(w, r, sessionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2714,17 +4336,17 @@ func (siw *ServerInterfaceWrapper) ConfirmDocumentUpload(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetVehicleEvents operation middleware
-func (siw *ServerInterfaceWrapper) GetVehicleEvents(w http.ResponseWriter, r *http.Request) {
+// GenerateEventImageUploadUrl operation middleware
+func (siw *ServerInterfaceWrapper) GenerateEventImageUploadUrl(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId EventImageSessionIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", r.PathValue("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
 		return
 	}
 
@@ -2734,27 +4356,8 @@ func (siw *ServerInterfaceWrapper) GetVehicleEvents(w http.ResponseWriter, r *ht
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetVehicleEventsParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVehicleEvents(w, r, vehicleId, params)
+		siw.Handler.GenerateEventImageUploadUrl(w, r, sessionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2764,19 +4367,8 @@ func (siw *ServerInterfaceWrapper) GetVehicleEvents(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateOwnerEvent operation middleware
-func (siw *ServerInterfaceWrapper) CreateOwnerEvent(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
-		return
-	}
+// GetEventMetadataSchemas operation middleware
+func (siw *ServerInterfaceWrapper) GetEventMetadataSchemas(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2785,7 +4377,7 @@ func (siw *ServerInterfaceWrapper) CreateOwnerEvent(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateOwnerEvent(w, r, vehicleId)
+		siw.Handler.GetEventMetadataSchemas(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2795,17 +4387,37 @@ func (siw *ServerInterfaceWrapper) CreateOwnerEvent(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetVehiclePhotos operation middleware
-func (siw *ServerInterfaceWrapper) GetVehiclePhotos(w http.ResponseWriter, r *http.Request) {
+// CreateEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEvent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEvent operation middleware
+func (siw *ServerInterfaceWrapper) GetEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
@@ -2816,7 +4428,7 @@ func (siw *ServerInterfaceWrapper) GetVehiclePhotos(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVehiclePhotos(w, r, vehicleId)
+		siw.Handler.GetEvent(w, r, eventId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2826,17 +4438,17 @@ func (siw *ServerInterfaceWrapper) GetVehiclePhotos(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GeneratePhotoUploadUrl operation middleware
-func (siw *ServerInterfaceWrapper) GeneratePhotoUploadUrl(w http.ResponseWriter, r *http.Request) {
+// AcceptEvent operation middleware
+func (siw *ServerInterfaceWrapper) AcceptEvent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
@@ -2847,7 +4459,7 @@ func (siw *ServerInterfaceWrapper) GeneratePhotoUploadUrl(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GeneratePhotoUploadUrl(w, r, vehicleId)
+		siw.Handler.AcceptEvent(w, r, eventId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2857,26 +4469,17 @@ func (siw *ServerInterfaceWrapper) GeneratePhotoUploadUrl(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteVehiclePhoto operation middleware
-func (siw *ServerInterfaceWrapper) DeleteVehiclePhoto(w http.ResponseWriter, r *http.Request) {
+// AmendEvent operation middleware
+func (siw *ServerInterfaceWrapper) AmendEvent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "photoId" -------------
-	var photoId PhotoIdParam
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "photoId", r.PathValue("photoId"), &photoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
@@ -2887,7 +4490,7 @@ func (siw *ServerInterfaceWrapper) DeleteVehiclePhoto(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteVehiclePhoto(w, r, vehicleId, photoId)
+		siw.Handler.AmendEvent(w, r, eventId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2897,26 +4500,48 @@ func (siw *ServerInterfaceWrapper) DeleteVehiclePhoto(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ConfirmPhotoUpload operation middleware
-func (siw *ServerInterfaceWrapper) ConfirmPhotoUpload(w http.ResponseWriter, r *http.Request) {
+// GetEventCertificationRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetEventCertificationRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "photoId" -------------
-	var photoId PhotoIdParam
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "photoId", r.PathValue("photoId"), &photoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventCertificationRequests(w, r, eventId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestEventCertification operation middleware
+func (siw *ServerInterfaceWrapper) RequestEventCertification(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
@@ -2927,7 +4552,7 @@ func (siw *ServerInterfaceWrapper) ConfirmPhotoUpload(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ConfirmPhotoUpload(w, r, vehicleId, photoId)
+		siw.Handler.RequestEventCertification(w, r, eventId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2937,17 +4562,17 @@ func (siw *ServerInterfaceWrapper) ConfirmPhotoUpload(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetVehicleShareLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetVehicleShareLinks(w http.ResponseWriter, r *http.Request) {
+// GetEventImages operation middleware
+func (siw *ServerInterfaceWrapper) GetEventImages(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
@@ -2958,7 +4583,7 @@ func (siw *ServerInterfaceWrapper) GetVehicleShareLinks(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVehicleShareLinks(w, r, vehicleId)
+		siw.Handler.GetEventImages(w, r, eventId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2968,17 +4593,17 @@ func (siw *ServerInterfaceWrapper) GetVehicleShareLinks(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// CreateShareLink operation middleware
-func (siw *ServerInterfaceWrapper) CreateShareLink(w http.ResponseWriter, r *http.Request) {
+// RejectEvent operation middleware
+func (siw *ServerInterfaceWrapper) RejectEvent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
@@ -2989,7 +4614,7 @@ func (siw *ServerInterfaceWrapper) CreateShareLink(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateShareLink(w, r, vehicleId)
+		siw.Handler.RejectEvent(w, r, eventId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2999,26 +4624,48 @@ func (siw *ServerInterfaceWrapper) CreateShareLink(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// RevokeShareLink operation middleware
-func (siw *ServerInterfaceWrapper) RevokeShareLink(w http.ResponseWriter, r *http.Request) {
+// RevokeEvent operation middleware
+func (siw *ServerInterfaceWrapper) RevokeEvent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "vehicleId" -------------
-	var vehicleId VehicleIdParam
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "shareLinkId" -------------
-	var shareLinkId ShareLinkIdParam
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "shareLinkId", r.PathValue("shareLinkId"), &shareLinkId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeEvent(w, r, eventId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SignEvent operation middleware
+func (siw *ServerInterfaceWrapper) SignEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shareLinkId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
 		return
 	}
 
@@ -3029,7 +4676,7 @@ func (siw *ServerInterfaceWrapper) RevokeShareLink(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RevokeShareLink(w, r, vehicleId, shareLinkId)
+		siw.Handler.SignEvent(w, r, eventId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3039,2697 +4686,8359 @@ func (siw *ServerInterfaceWrapper) RevokeShareLink(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+	handler.ServeHTTP(w, r)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// ClaimInvitations operation middleware
+func (siw *ServerInterfaceWrapper) ClaimInvitations(w http.ResponseWriter, r *http.Request) {
 
-type RequiredParamError struct {
-	ParamName string
-}
+	ctx := r.Context()
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClaimInvitations(w, r)
+	}))
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// ValidateInvitation operation middleware
+func (siw *ServerInterfaceWrapper) ValidateInvitation(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ValidateInvitationParams
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// ------------- Required query parameter "token" -------------
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
-
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
-
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
-
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
-
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateInvitation(w, r, params)
+	}))
 
-	m.HandleFunc("GET "+options.BaseURL+"/admin-invitations/{token}", wrapper.GetAdminInvitation)
-	m.HandleFunc("POST "+options.BaseURL+"/admin-invitations/{token}", wrapper.ClaimAdminInvitation)
-	m.HandleFunc("GET "+options.BaseURL+"/admin/users", wrapper.GetAdminUsers)
-	m.HandleFunc("POST "+options.BaseURL+"/admin/users", wrapper.CreateAdminUser)
-	m.HandleFunc("POST "+options.BaseURL+"/certifiers/vehicles", wrapper.CreateCertifierVehicle)
-	m.HandleFunc("PUT "+options.BaseURL+"/certifiers/vehicles/{vehicleId}", wrapper.UpdateCertifierVehicle)
-	m.HandleFunc("GET "+options.BaseURL+"/certifiers/vehicles/{vehicleId}/invitation", wrapper.GetCertifierVehicleInvitation)
-	m.HandleFunc("GET "+options.BaseURL+"/entities", wrapper.GetEntities)
-	m.HandleFunc("POST "+options.BaseURL+"/entities", wrapper.CreateEntity)
-	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}", wrapper.DeleteEntity)
-	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}", wrapper.GetEntity)
-	m.HandleFunc("PUT "+options.BaseURL+"/entities/{entityId}", wrapper.UpdateEntity)
-	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/logo", wrapper.DeleteEntityLogo)
-	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/logo/upload-url", wrapper.GenerateEntityLogoUploadUrl)
-	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/members", wrapper.GetEntityMembers)
-	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/members", wrapper.AddEntityMember)
-	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/members/{userId}", wrapper.RemoveEntityMember)
-	m.HandleFunc("PATCH "+options.BaseURL+"/entities/{entityId}/members/{userId}", wrapper.UpdateEntityMemberRole)
-	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/oauth2/clients", wrapper.ListEntityOAuth2Clients)
-	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/oauth2/clients", wrapper.CreateEntityOAuth2Client)
-	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/oauth2/clients/{clientId}", wrapper.DeleteEntityOAuth2Client)
-	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/oauth2/clients/{clientId}", wrapper.GetEntityOAuth2Client)
-	m.HandleFunc("POST "+options.BaseURL+"/event-images/upload-session", wrapper.CreateEventImageUploadSession)
-	m.HandleFunc("DELETE "+options.BaseURL+"/event-images/{imageId}", wrapper.DeleteEventImage)
-	m.HandleFunc("POST "+options.BaseURL+"/event-images/{imageId}/confirm", wrapper.ConfirmEventImageUpload)
-	m.HandleFunc("GET "+options.BaseURL+"/event-images/{sessionId}", wrapper.GetEventImagesBySession)
-	m.HandleFunc("POST "+options.BaseURL+"/event-images/{sessionId}/upload-url", wrapper.GenerateEventImageUploadUrl)
-	m.HandleFunc("POST "+options.BaseURL+"/events", wrapper.CreateEvent)
-	m.HandleFunc("GET "+options.BaseURL+"/events/{eventId}", wrapper.GetEvent)
-	m.HandleFunc("GET "+options.BaseURL+"/events/{eventId}/images", wrapper.GetEventImages)
-	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
-	m.HandleFunc("POST "+options.BaseURL+"/invitations/claim", wrapper.ClaimInvitations)
-	m.HandleFunc("GET "+options.BaseURL+"/invitations/validate", wrapper.ValidateInvitation)
-	m.HandleFunc("GET "+options.BaseURL+"/me", wrapper.GetMe)
-	m.HandleFunc("GET "+options.BaseURL+"/public/entities", wrapper.GetPublicEntities)
-	m.HandleFunc("GET "+options.BaseURL+"/public/passport/{vehicleId}", wrapper.GetVehiclePassport)
-	m.HandleFunc("GET "+options.BaseURL+"/shared/vehicles/{token}", wrapper.GetSharedVehicle)
-	m.HandleFunc("GET "+options.BaseURL+"/vehicles", wrapper.GetVehicles)
-	m.HandleFunc("POST "+options.BaseURL+"/vehicles", wrapper.CreateVehicle)
-	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}", wrapper.GetVehicle)
-	m.HandleFunc("PUT "+options.BaseURL+"/vehicles/{vehicleId}", wrapper.UpdateVehicle)
-	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/documents", wrapper.GetVehicleDocuments)
-	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/documents/upload-url", wrapper.GenerateDocumentUploadUrl)
-	m.HandleFunc("DELETE "+options.BaseURL+"/vehicles/{vehicleId}/documents/{documentId}", wrapper.DeleteVehicleDocument)
-	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/documents/{documentId}/confirm", wrapper.ConfirmDocumentUpload)
-	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/events", wrapper.GetVehicleEvents)
-	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/events", wrapper.CreateOwnerEvent)
-	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/photos", wrapper.GetVehiclePhotos)
-	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/photos/upload-url", wrapper.GeneratePhotoUploadUrl)
-	m.HandleFunc("DELETE "+options.BaseURL+"/vehicles/{vehicleId}/photos/{photoId}", wrapper.DeleteVehiclePhoto)
-	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/photos/{photoId}/confirm", wrapper.ConfirmPhotoUpload)
-	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/share-links", wrapper.GetVehicleShareLinks)
-	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/share-links", wrapper.CreateShareLink)
-	m.HandleFunc("DELETE "+options.BaseURL+"/vehicles/{vehicleId}/share-links/{shareLinkId}", wrapper.RevokeShareLink)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return m
+	handler.ServeHTTP(w, r)
 }
 
-type BadRequestJSONResponse ErrorResponse
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
 
-type ConflictJSONResponse ErrorResponse
+	ctx := r.Context()
 
-type ForbiddenJSONResponse ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type NotFoundJSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-type UnauthorizedJSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMe(w, r)
+	}))
 
-type GetAdminInvitationRequestObject struct {
-	Token string `json:"token"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetAdminInvitationResponseObject interface {
-	VisitGetAdminInvitationResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminInvitation200JSONResponse AdminInvitationResponse
+// UnlinkMyWallet operation middleware
+func (siw *ServerInterfaceWrapper) UnlinkMyWallet(w http.ResponseWriter, r *http.Request) {
 
-func (response GetAdminInvitation200JSONResponse) VisitGetAdminInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetAdminInvitation404JSONResponse struct{ NotFoundJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GetAdminInvitation404JSONResponse) VisitGetAdminInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlinkMyWallet(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetAdminInvitation409JSONResponse ErrorResponse
+	handler.ServeHTTP(w, r)
+}
 
-func (response GetAdminInvitation409JSONResponse) VisitGetAdminInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// GetMyWallet operation middleware
+func (siw *ServerInterfaceWrapper) GetMyWallet(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ClaimAdminInvitationRequestObject struct {
-	Token string `json:"token"`
-	Body  *ClaimAdminInvitationJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ClaimAdminInvitationResponseObject interface {
-	VisitClaimAdminInvitationResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type ClaimAdminInvitation200JSONResponse ClaimAdminInvitationResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyWallet(w, r)
+	}))
 
-func (response ClaimAdminInvitation200JSONResponse) VisitClaimAdminInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ClaimAdminInvitation404JSONResponse struct{ NotFoundJSONResponse }
+// LinkMyWallet operation middleware
+func (siw *ServerInterfaceWrapper) LinkMyWallet(w http.ResponseWriter, r *http.Request) {
 
-func (response ClaimAdminInvitation404JSONResponse) VisitClaimAdminInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ClaimAdminInvitation409JSONResponse ErrorResponse
+	r = r.WithContext(ctx)
 
-func (response ClaimAdminInvitation409JSONResponse) VisitClaimAdminInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LinkMyWallet(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetAdminUsersRequestObject struct {
-	Params GetAdminUsersParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminUsersResponseObject interface {
-	VisitGetAdminUsersResponse(w http.ResponseWriter) error
-}
+// CreateWalletChallenge operation middleware
+func (siw *ServerInterfaceWrapper) CreateWalletChallenge(w http.ResponseWriter, r *http.Request) {
 
-type GetAdminUsers200JSONResponse AdminUserListResponse
+	ctx := r.Context()
 
-func (response GetAdminUsers200JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetAdminUsers401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWalletChallenge(w, r)
+	}))
 
-func (response GetAdminUsers401JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetAdminUsers403JSONResponse struct{ ForbiddenJSONResponse }
-
-func (response GetAdminUsers403JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetVehicleAssetMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleAssetMetadata(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateAdminUserRequestObject struct {
-	Body *CreateAdminUserJSONRequestBody
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type CreateAdminUserResponseObject interface {
-	VisitCreateAdminUserResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type CreateAdminUser201JSONResponse AdminUser
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleAssetMetadata(w, r, vehicleId)
+	}))
 
-func (response CreateAdminUser201JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateAdminUser400JSONResponse struct{ BadRequestJSONResponse }
+// GetPublicEntities operation middleware
+func (siw *ServerInterfaceWrapper) GetPublicEntities(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateAdminUser400JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPublicEntitiesParams
 
-type CreateAdminUser401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "page" -------------
 
-func (response CreateAdminUser401JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type CreateAdminUser403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response CreateAdminUser403JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "type" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-type CreateCertifierVehicleRequestObject struct {
-	Body *CreateCertifierVehicleJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublicEntities(w, r, params)
+	}))
 
-type CreateCertifierVehicleResponseObject interface {
-	VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCertifierVehicle201JSONResponse Vehicle
+// GetPublicEventTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetPublicEventTypes(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCertifierVehicle201JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPublicEventTypes(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCertifierVehicle400JSONResponse struct{ BadRequestJSONResponse }
+// GetVehiclePassport operation middleware
+func (siw *ServerInterfaceWrapper) GetVehiclePassport(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCertifierVehicle400JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type CreateCertifierVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response CreateCertifierVehicle401JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehiclePassport(w, r, vehicleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCertifierVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+// GetSigningKey operation middleware
+func (siw *ServerInterfaceWrapper) GetSigningKey(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateCertifierVehicle403JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "keyId" -------------
+	var keyId SigningKeyIdParam
 
-type CreateCertifierVehicle409JSONResponse struct{ ConflictJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", r.PathValue("keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
 
-func (response CreateCertifierVehicle409JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSigningKey(w, r, keyId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateCertifierVehicleRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	Body      *UpdateCertifierVehicleJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateCertifierVehicleResponseObject interface {
-	VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error
-}
+// LookupStolenVehicle operation middleware
+func (siw *ServerInterfaceWrapper) LookupStolenVehicle(w http.ResponseWriter, r *http.Request) {
 
-type UpdateCertifierVehicle200JSONResponse Vehicle
+	var err error
 
-func (response UpdateCertifierVehicle200JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupStolenVehicleParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "chassisNumber" -------------
 
-type UpdateCertifierVehicle400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "chassisNumber", r.URL.Query(), &params.ChassisNumber)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chassisNumber", Err: err})
+		return
+	}
 
-func (response UpdateCertifierVehicle400JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "licensePlate" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "licensePlate", r.URL.Query(), &params.LicensePlate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "licensePlate", Err: err})
+		return
+	}
 
-type UpdateCertifierVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LookupStolenVehicle(w, r, params)
+	}))
 
-func (response UpdateCertifierVehicle401JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateCertifierVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+// GetOwnershipTransferByToken operation middleware
+func (siw *ServerInterfaceWrapper) GetOwnershipTransferByToken(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateCertifierVehicle403JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "token" -------------
+	var token TransferTokenParam
 
-type UpdateCertifierVehicle404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
 
-func (response UpdateCertifierVehicle404JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateCertifierVehicle409JSONResponse struct{ ConflictJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response UpdateCertifierVehicle409JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOwnershipTransferByToken(w, r, token)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetCertifierVehicleInvitationRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetCertifierVehicleInvitationResponseObject interface {
-	VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error
-}
+// VerifyEvent operation middleware
+func (siw *ServerInterfaceWrapper) VerifyEvent(w http.ResponseWriter, r *http.Request) {
 
-type GetCertifierVehicleInvitation200JSONResponse VehicleInvitationResponse
+	var err error
 
-func (response GetCertifierVehicleInvitation200JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "eventId" -------------
+	var eventId EventIdParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", r.PathValue("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventId", Err: err})
+		return
+	}
 
-type GetCertifierVehicleInvitation401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyEvent(w, r, eventId)
+	}))
 
-func (response GetCertifierVehicleInvitation401JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetCertifierVehicleInvitation403JSONResponse struct{ ForbiddenJSONResponse }
-
-func (response GetCertifierVehicleInvitation403JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// VerifyVehicle operation middleware
+func (siw *ServerInterfaceWrapper) VerifyVehicle(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetCertifierVehicleInvitation404JSONResponse struct{ NotFoundJSONResponse }
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-func (response GetCertifierVehicleInvitation404JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyVehicle(w, r, vehicleId)
+	}))
 
-type GetEntitiesRequestObject struct {
-	Params GetEntitiesParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetEntitiesResponseObject interface {
-	VisitGetEntitiesResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetEntities200JSONResponse EntityListResponse
+// VerifyVehicleChain operation middleware
+func (siw *ServerInterfaceWrapper) VerifyVehicleChain(w http.ResponseWriter, r *http.Request) {
 
-func (response GetEntities200JSONResponse) VisitGetEntitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type CreateEntityRequestObject struct {
-	Body *CreateEntityJSONRequestBody
-}
-
-type CreateEntityResponseObject interface {
-	VisitCreateEntityResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type CreateEntity201JSONResponse Entity
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyVehicleChain(w, r, vehicleId)
+	}))
 
-func (response CreateEntity201JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateEntity400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response CreateEntity400JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetSharedVehicle operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedVehicle(w http.ResponseWriter, r *http.Request) {
 
-type CreateEntity401JSONResponse struct{ UnauthorizedJSONResponse }
+	var err error
 
-func (response CreateEntity401JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Path parameter "token" -------------
+	var token ShareTokenParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
 
-type CreateEntity403JSONResponse struct{ ForbiddenJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedVehicle(w, r, token)
+	}))
 
-func (response CreateEntity403JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteEntityRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-}
+// LookupStolenVehicleAuthenticated operation middleware
+func (siw *ServerInterfaceWrapper) LookupStolenVehicleAuthenticated(w http.ResponseWriter, r *http.Request) {
 
-type DeleteEntityResponseObject interface {
-	VisitDeleteEntityResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type DeleteEntity204Response struct {
-}
+	ctx := r.Context()
 
-func (response DeleteEntity204Response) VisitDeleteEntityResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteEntity401JSONResponse struct{ UnauthorizedJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response DeleteEntity401JSONResponse) VisitDeleteEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupStolenVehicleAuthenticatedParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "chassisNumber" -------------
 
-type DeleteEntity403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "chassisNumber", r.URL.Query(), &params.ChassisNumber)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chassisNumber", Err: err})
+		return
+	}
 
-func (response DeleteEntity403JSONResponse) VisitDeleteEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "licensePlate" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "licensePlate", r.URL.Query(), &params.LicensePlate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "licensePlate", Err: err})
+		return
+	}
 
-type DeleteEntity404JSONResponse struct{ NotFoundJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LookupStolenVehicleAuthenticated(w, r, params)
+	}))
 
-func (response DeleteEntity404JSONResponse) VisitDeleteEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetEntityRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-}
+// AcceptOwnershipTransfer operation middleware
+func (siw *ServerInterfaceWrapper) AcceptOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
 
-type GetEntityResponseObject interface {
-	VisitGetEntityResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetEntity200JSONResponse Entity
+	// ------------- Path parameter "token" -------------
+	var token TransferTokenParam
 
-func (response GetEntity200JSONResponse) VisitGetEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetEntity404JSONResponse struct{ NotFoundJSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetEntity404JSONResponse) VisitGetEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptOwnershipTransfer(w, r, token)
+	}))
 
-type UpdateEntityRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	Body     *UpdateEntityJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateEntityResponseObject interface {
-	VisitUpdateEntityResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateEntity200JSONResponse Entity
+// GetVehicles operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicles(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateEntity200JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type UpdateEntity400JSONResponse struct{ BadRequestJSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response UpdateEntity400JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVehiclesParams
 
-type UpdateEntity401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "page" -------------
 
-func (response UpdateEntity401JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type UpdateEntity403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response UpdateEntity403JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "ownerId" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "ownerId", r.URL.Query(), &params.OwnerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownerId", Err: err})
+		return
+	}
 
-type UpdateEntity404JSONResponse struct{ NotFoundJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicles(w, r, params)
+	}))
 
-func (response UpdateEntity404JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteEntityLogoRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-}
+// CreateVehicle operation middleware
+func (siw *ServerInterfaceWrapper) CreateVehicle(w http.ResponseWriter, r *http.Request) {
 
-type DeleteEntityLogoResponseObject interface {
-	VisitDeleteEntityLogoResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type DeleteEntityLogo204Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteEntityLogo204Response) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type DeleteEntityLogo401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVehicle(w, r)
+	}))
 
-func (response DeleteEntityLogo401JSONResponse) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteEntityLogo403JSONResponse struct{ ForbiddenJSONResponse }
+// GetVehicle operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicle(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteEntityLogo403JSONResponse) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type DeleteEntityLogo404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response DeleteEntityLogo404JSONResponse) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GenerateEntityLogoUploadUrlRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	Body     *GenerateEntityLogoUploadUrlJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GenerateEntityLogoUploadUrlResponseObject interface {
-	VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GenerateEntityLogoUploadUrl200JSONResponse EntityLogoUploadUrlResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicle(w, r, vehicleId)
+	}))
 
-func (response GenerateEntityLogoUploadUrl200JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GenerateEntityLogoUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+// UpdateVehicle operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVehicle(w http.ResponseWriter, r *http.Request) {
 
-func (response GenerateEntityLogoUploadUrl400JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type GenerateEntityLogoUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response GenerateEntityLogoUploadUrl401JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GenerateEntityLogoUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GenerateEntityLogoUploadUrl403JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateVehicle(w, r, vehicleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GenerateEntityLogoUploadUrl404JSONResponse struct{ NotFoundJSONResponse }
+// GetVehicleAnchors operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleAnchors(w http.ResponseWriter, r *http.Request) {
 
-func (response GenerateEntityLogoUploadUrl404JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type GetEntityMembersRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type GetEntityMembersResponseObject interface {
-	VisitGetEntityMembersResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetEntityMembers200JSONResponse []EntityMember
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetEntityMembers200JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleAnchors(w, r, vehicleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetEntityMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetVehicleCustody operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleCustody(w http.ResponseWriter, r *http.Request) {
 
-func (response GetEntityMembers401JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type GetEntityMembers403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response GetEntityMembers403JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetEntityMembers404JSONResponse struct{ NotFoundJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GetEntityMembers404JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleCustody(w, r, vehicleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AddEntityMemberRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	Body     *AddEntityMemberJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type AddEntityMemberResponseObject interface {
-	VisitAddEntityMemberResponse(w http.ResponseWriter) error
-}
+// RequestVehicleAssetDelivery operation middleware
+func (siw *ServerInterfaceWrapper) RequestVehicleAssetDelivery(w http.ResponseWriter, r *http.Request) {
 
-type AddEntityMember201JSONResponse EntityMember
+	var err error
 
-func (response AddEntityMember201JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type AddEntityMember400JSONResponse struct{ BadRequestJSONResponse }
+	ctx := r.Context()
 
-func (response AddEntityMember400JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type AddEntityMember401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestVehicleAssetDelivery(w, r, vehicleId)
+	}))
 
-func (response AddEntityMember401JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AddEntityMember403JSONResponse struct{ ForbiddenJSONResponse }
+// GetVehicleDocuments operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleDocuments(w http.ResponseWriter, r *http.Request) {
 
-func (response AddEntityMember403JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type AddEntityMember404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response AddEntityMember404JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RemoveEntityMemberRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	UserId   UserIdParam   `json:"userId"`
-}
+	r = r.WithContext(ctx)
 
-type RemoveEntityMemberResponseObject interface {
-	VisitRemoveEntityMemberResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleDocuments(w, r, vehicleId)
+	}))
 
-type RemoveEntityMember204Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response RemoveEntityMember204Response) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type RemoveEntityMember401JSONResponse struct{ UnauthorizedJSONResponse }
+// GenerateDocumentUploadUrl operation middleware
+func (siw *ServerInterfaceWrapper) GenerateDocumentUploadUrl(w http.ResponseWriter, r *http.Request) {
 
-func (response RemoveEntityMember401JSONResponse) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type RemoveEntityMember403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response RemoveEntityMember403JSONResponse) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RemoveEntityMember404JSONResponse struct{ NotFoundJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response RemoveEntityMember404JSONResponse) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GenerateDocumentUploadUrl(w, r, vehicleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateEntityMemberRoleRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	UserId   UserIdParam   `json:"userId"`
-	Body     *UpdateEntityMemberRoleJSONRequestBody
-}
-
-type UpdateEntityMemberRoleResponseObject interface {
-	VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error
-}
-
-type UpdateEntityMemberRole200JSONResponse EntityMember
+// DeleteVehicleDocument operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVehicleDocument(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateEntityMemberRole200JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type UpdateEntityMemberRole400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response UpdateEntityMemberRole400JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "documentId" -------------
+	var documentId DocumentIdParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", r.PathValue("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
+	}
 
-type UpdateEntityMemberRole401JSONResponse struct{ UnauthorizedJSONResponse }
+	ctx := r.Context()
 
-func (response UpdateEntityMemberRole401JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type UpdateEntityMemberRole403JSONResponse struct{ ForbiddenJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVehicleDocument(w, r, vehicleId, documentId)
+	}))
 
-func (response UpdateEntityMemberRole403JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateEntityMemberRole404JSONResponse struct{ NotFoundJSONResponse }
+// ConfirmDocumentUpload operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmDocumentUpload(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateEntityMemberRole404JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type ListEntityOAuth2ClientsRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	Params   ListEntityOAuth2ClientsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type ListEntityOAuth2ClientsResponseObject interface {
-	VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "documentId" -------------
+	var documentId DocumentIdParam
 
-type ListEntityOAuth2Clients200JSONResponse OAuth2ClientListResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", r.PathValue("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
+	}
 
-func (response ListEntityOAuth2Clients200JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListEntityOAuth2Clients401JSONResponse struct{ UnauthorizedJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response ListEntityOAuth2Clients401JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmDocumentUpload(w, r, vehicleId, documentId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListEntityOAuth2Clients403JSONResponse struct{ ForbiddenJSONResponse }
+// GetVehicleEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleEvents(w http.ResponseWriter, r *http.Request) {
 
-func (response ListEntityOAuth2Clients403JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type ListEntityOAuth2Clients404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response ListEntityOAuth2Clients404JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateEntityOAuth2ClientRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	Body     *CreateEntityOAuth2ClientJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type CreateEntityOAuth2ClientResponseObject interface {
-	VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVehicleEventsParams
 
-type CreateEntityOAuth2Client201JSONResponse OAuth2ClientWithSecret
+	// ------------- Optional query parameter "page" -------------
 
-func (response CreateEntityOAuth2Client201JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type CreateEntityOAuth2Client400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response CreateEntityOAuth2Client400JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleEvents(w, r, vehicleId, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateEntityOAuth2Client401JSONResponse struct{ UnauthorizedJSONResponse }
+// CreateOwnerEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateOwnerEvent(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateEntityOAuth2Client401JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type CreateEntityOAuth2Client403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response CreateEntityOAuth2Client403JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateEntityOAuth2Client404JSONResponse struct{ NotFoundJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response CreateEntityOAuth2Client404JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOwnerEvent(w, r, vehicleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteEntityOAuth2ClientRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	ClientId ClientIdParam `json:"clientId"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteEntityOAuth2ClientResponseObject interface {
-	VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error
-}
+// GetVehicleLifecycleRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleLifecycleRequests(w http.ResponseWriter, r *http.Request) {
 
-type DeleteEntityOAuth2Client204Response struct {
-}
+	var err error
 
-func (response DeleteEntityOAuth2Client204Response) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type DeleteEntityOAuth2Client401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response DeleteEntityOAuth2Client401JSONResponse) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteEntityOAuth2Client403JSONResponse struct{ ForbiddenJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response DeleteEntityOAuth2Client403JSONResponse) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleLifecycleRequests(w, r, vehicleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteEntityOAuth2Client404JSONResponse struct{ NotFoundJSONResponse }
+// RequestVehicleLifecycleChange operation middleware
+func (siw *ServerInterfaceWrapper) RequestVehicleLifecycleChange(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteEntityOAuth2Client404JSONResponse) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetEntityOAuth2ClientRequestObject struct {
-	EntityId EntityIdParam `json:"entityId"`
-	ClientId ClientIdParam `json:"clientId"`
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type GetEntityOAuth2ClientResponseObject interface {
-	VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type GetEntityOAuth2Client200JSONResponse OAuth2Client
+	ctx := r.Context()
 
-func (response GetEntityOAuth2Client200JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetEntityOAuth2Client401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestVehicleLifecycleChange(w, r, vehicleId)
+	}))
 
-func (response GetEntityOAuth2Client401JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetEntityOAuth2Client403JSONResponse struct{ ForbiddenJSONResponse }
-
-func (response GetEntityOAuth2Client403JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetVehicleOwners operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleOwners(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetEntityOAuth2Client404JSONResponse struct{ NotFoundJSONResponse }
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-func (response GetEntityOAuth2Client404JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateEventImageUploadSessionRequestObject struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateEventImageUploadSessionResponseObject interface {
-	VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type CreateEventImageUploadSession201JSONResponse EventImageUploadSessionResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleOwners(w, r, vehicleId)
+	}))
 
-func (response CreateEventImageUploadSession201JSONResponse) VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateEventImageUploadSession401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetVehiclePhotos operation middleware
+func (siw *ServerInterfaceWrapper) GetVehiclePhotos(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateEventImageUploadSession401JSONResponse) VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type CreateEventImageUploadSession403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response CreateEventImageUploadSession403JSONResponse) VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteEventImageRequestObject struct {
-	ImageId EventImageIdParam `json:"imageId"`
-}
+	r = r.WithContext(ctx)
 
-type DeleteEventImageResponseObject interface {
-	VisitDeleteEventImageResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehiclePhotos(w, r, vehicleId)
+	}))
 
-type DeleteEventImage204Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteEventImage204Response) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteEventImage400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response DeleteEventImage400JSONResponse) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GeneratePhotoUploadUrl operation middleware
+func (siw *ServerInterfaceWrapper) GeneratePhotoUploadUrl(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type DeleteEventImage401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-func (response DeleteEventImage401JSONResponse) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type DeleteEventImage404JSONResponse struct{ NotFoundJSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteEventImage404JSONResponse) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GeneratePhotoUploadUrl(w, r, vehicleId)
+	}))
 
-type ConfirmEventImageUploadRequestObject struct {
-	ImageId EventImageIdParam `json:"imageId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ConfirmEventImageUploadResponseObject interface {
-	VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ConfirmEventImageUpload200JSONResponse EventImage
+// DeleteVehiclePhoto operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVehiclePhoto(w http.ResponseWriter, r *http.Request) {
 
-func (response ConfirmEventImageUpload200JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type ConfirmEventImageUpload400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response ConfirmEventImageUpload400JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "photoId" -------------
+	var photoId PhotoIdParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "photoId", r.PathValue("photoId"), &photoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		return
+	}
 
-type ConfirmEventImageUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+	ctx := r.Context()
 
-func (response ConfirmEventImageUpload401JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type ConfirmEventImageUpload404JSONResponse struct{ NotFoundJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVehiclePhoto(w, r, vehicleId, photoId)
+	}))
 
-func (response ConfirmEventImageUpload404JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetEventImagesBySessionRequestObject struct {
-	SessionId EventImageSessionIdParam `json:"sessionId"`
-}
+// ConfirmPhotoUpload operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmPhotoUpload(w http.ResponseWriter, r *http.Request) {
 
-type GetEventImagesBySessionResponseObject interface {
-	VisitGetEventImagesBySessionResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetEventImagesBySession200JSONResponse EventImageListResponse
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-func (response GetEventImagesBySession200JSONResponse) VisitGetEventImagesBySessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "photoId" -------------
+	var photoId PhotoIdParam
 
-type GetEventImagesBySession401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "photoId", r.PathValue("photoId"), &photoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "photoId", Err: err})
+		return
+	}
 
-func (response GetEventImagesBySession401JSONResponse) VisitGetEventImagesBySessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GenerateEventImageUploadUrlRequestObject struct {
-	SessionId EventImageSessionIdParam `json:"sessionId"`
-	Body      *GenerateEventImageUploadUrlJSONRequestBody
-}
-
-type GenerateEventImageUploadUrlResponseObject interface {
-	VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GenerateEventImageUploadUrl200JSONResponse GenerateEventImageUploadUrlResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmPhotoUpload(w, r, vehicleId, photoId)
+	}))
 
-func (response GenerateEventImageUploadUrl200JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GenerateEventImageUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+// GetVehicleShareLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleShareLinks(w http.ResponseWriter, r *http.Request) {
 
-func (response GenerateEventImageUploadUrl400JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type GenerateEventImageUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response GenerateEventImageUploadUrl401JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GenerateEventImageUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GenerateEventImageUploadUrl403JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleShareLinks(w, r, vehicleId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateEventRequestObject struct {
-	Body *CreateEventJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateEventResponseObject interface {
-	VisitCreateEventResponse(w http.ResponseWriter) error
-}
+// CreateShareLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateShareLink(w http.ResponseWriter, r *http.Request) {
 
-type CreateEvent201JSONResponse Event
+	var err error
 
-func (response CreateEvent201JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type CreateEvent400JSONResponse struct{ BadRequestJSONResponse }
+	ctx := r.Context()
 
-func (response CreateEvent400JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateShareLink(w, r, vehicleId)
+	}))
 
-func (response CreateEvent401JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateEvent403JSONResponse struct{ ForbiddenJSONResponse }
+// RevokeShareLink operation middleware
+func (siw *ServerInterfaceWrapper) RevokeShareLink(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateEvent403JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type CreateEvent404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-func (response CreateEvent404JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Path parameter "shareLinkId" -------------
+	var shareLinkId ShareLinkIdParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "shareLinkId", r.PathValue("shareLinkId"), &shareLinkId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shareLinkId", Err: err})
+		return
+	}
 
-type GetEventRequestObject struct {
-	EventId EventIdParam `json:"eventId"`
-}
+	ctx := r.Context()
 
-type GetEventResponseObject interface {
-	VisitGetEventResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetEvent200JSONResponse Event
+	r = r.WithContext(ctx)
 
-func (response GetEvent200JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeShareLink(w, r, vehicleId, shareLinkId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetEvent404JSONResponse struct{ NotFoundJSONResponse }
+	handler.ServeHTTP(w, r)
+}
 
-func (response GetEvent404JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetVehicleStolenReports operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleStolenReports(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetEventImagesRequestObject struct {
-	EventId EventIdParam `json:"eventId"`
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type GetEventImagesResponseObject interface {
-	VisitGetEventImagesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type GetEventImages200JSONResponse EventImageListResponse
+	ctx := r.Context()
 
-func (response GetEventImages200JSONResponse) VisitGetEventImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetEventImages404JSONResponse struct{ NotFoundJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleStolenReports(w, r, vehicleId)
+	}))
 
-func (response GetEventImages404JSONResponse) VisitGetEventImagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetHealthRequestObject struct {
-}
+// FileStolenReport operation middleware
+func (siw *ServerInterfaceWrapper) FileStolenReport(w http.ResponseWriter, r *http.Request) {
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetHealth200JSONResponse HealthResponse
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ClaimInvitationsRequestObject struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ClaimInvitationsResponseObject interface {
-	VisitClaimInvitationsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type ClaimInvitations200JSONResponse ClaimInvitationsResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FileStolenReport(w, r, vehicleId)
+	}))
 
-func (response ClaimInvitations200JSONResponse) VisitClaimInvitationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ClaimInvitations401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetVehicleTransfers operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleTransfers(w http.ResponseWriter, r *http.Request) {
 
-func (response ClaimInvitations401JSONResponse) VisitClaimInvitationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type ValidateInvitationRequestObject struct {
-	Params ValidateInvitationParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type ValidateInvitationResponseObject interface {
-	VisitValidateInvitationResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type ValidateInvitation200JSONResponse InvitationValidationResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ValidateInvitation200JSONResponse) VisitValidateInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ValidateInvitation400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response ValidateInvitation400JSONResponse) VisitValidateInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type ValidateInvitation404JSONResponse struct{ NotFoundJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleTransfers(w, r, vehicleId)
+	}))
 
-func (response ValidateInvitation404JSONResponse) VisitValidateInvitationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetMeRequestObject struct {
-}
+// InitiateVehicleTransfer operation middleware
+func (siw *ServerInterfaceWrapper) InitiateVehicleTransfer(w http.ResponseWriter, r *http.Request) {
 
-type GetMeResponseObject interface {
-	VisitGetMeResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetMe200JSONResponse UserProfile
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetMe401JSONResponse struct{ UnauthorizedJSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InitiateVehicleTransfer(w, r, vehicleId)
+	}))
 
-type GetPublicEntitiesRequestObject struct {
-	Params GetPublicEntitiesParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetPublicEntitiesResponseObject interface {
-	VisitGetPublicEntitiesResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetPublicEntities200JSONResponse PublicEntityListResponse
+// CancelVehicleTransfer operation middleware
+func (siw *ServerInterfaceWrapper) CancelVehicleTransfer(w http.ResponseWriter, r *http.Request) {
 
-func (response GetPublicEntities200JSONResponse) VisitGetPublicEntitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-type GetVehiclePassportRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type GetVehiclePassportResponseObject interface {
-	VisitGetVehiclePassportResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "transferId" -------------
+	var transferId TransferIdParam
 
-type GetVehiclePassport200JSONResponse SharedVehicleResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", r.PathValue("transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
 
-func (response GetVehiclePassport200JSONResponse) VisitGetVehiclePassportResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetVehiclePassport404JSONResponse struct{ NotFoundJSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GetVehiclePassport404JSONResponse) VisitGetVehiclePassportResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelVehicleTransfer(w, r, vehicleId, transferId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetSharedVehicleRequestObject struct {
-	Token ShareTokenParam `json:"token"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetSharedVehicleResponseObject interface {
-	VisitGetSharedVehicleResponse(w http.ResponseWriter) error
-}
+// GetVehicleVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetVehicleVersions(w http.ResponseWriter, r *http.Request) {
 
-type GetSharedVehicle200JSONResponse SharedVehicleResponse
+	var err error
 
-func (response GetSharedVehicle200JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "vehicleId" -------------
+	var vehicleId VehicleIdParam
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "vehicleId", r.PathValue("vehicleId"), &vehicleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vehicleId", Err: err})
+		return
+	}
 
-type GetSharedVehicle400JSONResponse struct{ BadRequestJSONResponse }
+	ctx := r.Context()
 
-func (response GetSharedVehicle400JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetSharedVehicle404JSONResponse struct{ NotFoundJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVehicleVersions(w, r, vehicleId)
+	}))
 
-func (response GetSharedVehicle404JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetSharedVehicle410JSONResponse ErrorResponse
-
-func (response GetSharedVehicle410JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(410)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type GetVehiclesRequestObject struct {
-	Params GetVehiclesParams
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetVehiclesResponseObject interface {
-	VisitGetVehiclesResponse(w http.ResponseWriter) error
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetVehicles200JSONResponse VehicleListResponse
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
 
-func (response GetVehicles200JSONResponse) VisitGetVehiclesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
 
-	return json.NewEncoder(w).Encode(response)
+type RequiredParamError struct {
+	ParamName string
 }
 
-type GetVehicles401JSONResponse struct{ UnauthorizedJSONResponse }
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
 
-func (response GetVehicles401JSONResponse) VisitGetVehiclesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type GetVehicles403JSONResponse struct{ ForbiddenJSONResponse }
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
 
-func (response GetVehicles403JSONResponse) VisitGetVehiclesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
 }
 
-type CreateVehicleRequestObject struct {
-	Body *CreateVehicleJSONRequestBody
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type CreateVehicleResponseObject interface {
-	VisitCreateVehicleResponse(w http.ResponseWriter) error
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
 }
 
-type CreateVehicle201JSONResponse Vehicle
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
 
-func (response CreateVehicle201JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
 
-	return json.NewEncoder(w).Encode(response)
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
-type CreateVehicle400JSONResponse struct{ BadRequestJSONResponse }
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-func (response CreateVehicle400JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
 
-	return json.NewEncoder(w).Encode(response)
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
 }
 
-type CreateVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
 
-func (response CreateVehicle401JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/admin-invitations/{token}", wrapper.GetAdminInvitation)
+	m.HandleFunc("POST "+options.BaseURL+"/admin-invitations/{token}", wrapper.ClaimAdminInvitation)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/anchors/failed", wrapper.GetFailedAnchors)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/anchors/requeue", wrapper.RequeueAnchors)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/anchors/{recordType}/{recordId}/requeue", wrapper.RequeueAnchor)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/lifecycle-requests", wrapper.GetAdminLifecycleRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/lifecycle-requests/{requestId}/approve", wrapper.ApproveAdminLifecycleRequest)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/lifecycle-requests/{requestId}/reject", wrapper.RejectAdminLifecycleRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/stolen-reports", wrapper.GetAdminStolenReports)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/stolen-reports/{reportId}/approve", wrapper.ApproveStolenReport)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/stolen-reports/{reportId}/reject", wrapper.RejectStolenReport)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/stolen-reports/{reportId}/resolve", wrapper.ResolveStolenReport)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/users", wrapper.GetAdminUsers)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/users", wrapper.CreateAdminUser)
+	m.HandleFunc("POST "+options.BaseURL+"/certifiers/vehicles", wrapper.CreateCertifierVehicle)
+	m.HandleFunc("PUT "+options.BaseURL+"/certifiers/vehicles/{vehicleId}", wrapper.UpdateCertifierVehicle)
+	m.HandleFunc("GET "+options.BaseURL+"/certifiers/vehicles/{vehicleId}/invitation", wrapper.GetCertifierVehicleInvitation)
+	m.HandleFunc("GET "+options.BaseURL+"/entities", wrapper.GetEntities)
+	m.HandleFunc("POST "+options.BaseURL+"/entities", wrapper.CreateEntity)
+	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}", wrapper.DeleteEntity)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}", wrapper.GetEntity)
+	m.HandleFunc("PUT "+options.BaseURL+"/entities/{entityId}", wrapper.UpdateEntity)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/certification-requests", wrapper.GetEntityCertificationRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/certification-requests/{requestId}/decline", wrapper.DeclineCertificationRequest)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/certification-requests/{requestId}/endorse", wrapper.EndorseCertificationRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/event-types", wrapper.GetEntityEventTypes)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/event-types", wrapper.RegisterEntityEventType)
+	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/event-types/{key}", wrapper.RetireEntityEventType)
+	m.HandleFunc("PATCH "+options.BaseURL+"/entities/{entityId}/event-types/{key}", wrapper.UpdateEntityEventType)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/lifecycle-requests", wrapper.GetEntityLifecycleRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/lifecycle-requests/{requestId}/approve", wrapper.ApproveEntityLifecycleRequest)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/lifecycle-requests/{requestId}/reject", wrapper.RejectEntityLifecycleRequest)
+	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/logo", wrapper.DeleteEntityLogo)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/logo/upload-url", wrapper.GenerateEntityLogoUploadUrl)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/members", wrapper.GetEntityMembers)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/members", wrapper.AddEntityMember)
+	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/members/{userId}", wrapper.RemoveEntityMember)
+	m.HandleFunc("PATCH "+options.BaseURL+"/entities/{entityId}/members/{userId}", wrapper.UpdateEntityMemberRole)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/oauth2/clients", wrapper.ListEntityOAuth2Clients)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/oauth2/clients", wrapper.CreateEntityOAuth2Client)
+	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/oauth2/clients/{clientId}", wrapper.DeleteEntityOAuth2Client)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/oauth2/clients/{clientId}", wrapper.GetEntityOAuth2Client)
+	m.HandleFunc("GET "+options.BaseURL+"/entities/{entityId}/signing-keys", wrapper.GetEntitySigningKeys)
+	m.HandleFunc("POST "+options.BaseURL+"/entities/{entityId}/signing-keys", wrapper.RegisterEntitySigningKey)
+	m.HandleFunc("DELETE "+options.BaseURL+"/entities/{entityId}/signing-keys/{keyId}", wrapper.RevokeEntitySigningKey)
+	m.HandleFunc("POST "+options.BaseURL+"/event-images/upload-session", wrapper.CreateEventImageUploadSession)
+	m.HandleFunc("DELETE "+options.BaseURL+"/event-images/{imageId}", wrapper.DeleteEventImage)
+	m.HandleFunc("POST "+options.BaseURL+"/event-images/{imageId}/confirm", wrapper.ConfirmEventImageUpload)
+	m.HandleFunc("GET "+options.BaseURL+"/event-images/{sessionId}", wrapper.GetEventImagesBySession)
+	m.HandleFunc("POST "+options.BaseURL+"/event-images/{sessionId}/upload-url", wrapper.GenerateEventImageUploadUrl)
+	m.HandleFunc("GET "+options.BaseURL+"/event-metadata-schemas", wrapper.GetEventMetadataSchemas)
+	m.HandleFunc("POST "+options.BaseURL+"/events", wrapper.CreateEvent)
+	m.HandleFunc("GET "+options.BaseURL+"/events/{eventId}", wrapper.GetEvent)
+	m.HandleFunc("POST "+options.BaseURL+"/events/{eventId}/accept", wrapper.AcceptEvent)
+	m.HandleFunc("POST "+options.BaseURL+"/events/{eventId}/amendments", wrapper.AmendEvent)
+	m.HandleFunc("GET "+options.BaseURL+"/events/{eventId}/certification-requests", wrapper.GetEventCertificationRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/events/{eventId}/certification-requests", wrapper.RequestEventCertification)
+	m.HandleFunc("GET "+options.BaseURL+"/events/{eventId}/images", wrapper.GetEventImages)
+	m.HandleFunc("POST "+options.BaseURL+"/events/{eventId}/reject", wrapper.RejectEvent)
+	m.HandleFunc("POST "+options.BaseURL+"/events/{eventId}/revocation", wrapper.RevokeEvent)
+	m.HandleFunc("POST "+options.BaseURL+"/events/{eventId}/signature", wrapper.SignEvent)
+	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
+	m.HandleFunc("POST "+options.BaseURL+"/invitations/claim", wrapper.ClaimInvitations)
+	m.HandleFunc("GET "+options.BaseURL+"/invitations/validate", wrapper.ValidateInvitation)
+	m.HandleFunc("GET "+options.BaseURL+"/me", wrapper.GetMe)
+	m.HandleFunc("DELETE "+options.BaseURL+"/me/wallet", wrapper.UnlinkMyWallet)
+	m.HandleFunc("GET "+options.BaseURL+"/me/wallet", wrapper.GetMyWallet)
+	m.HandleFunc("POST "+options.BaseURL+"/me/wallet", wrapper.LinkMyWallet)
+	m.HandleFunc("POST "+options.BaseURL+"/me/wallet/challenge", wrapper.CreateWalletChallenge)
+	m.HandleFunc("GET "+options.BaseURL+"/public/asset-metadata/{vehicleId}", wrapper.GetVehicleAssetMetadata)
+	m.HandleFunc("GET "+options.BaseURL+"/public/entities", wrapper.GetPublicEntities)
+	m.HandleFunc("GET "+options.BaseURL+"/public/event-types", wrapper.GetPublicEventTypes)
+	m.HandleFunc("GET "+options.BaseURL+"/public/passport/{vehicleId}", wrapper.GetVehiclePassport)
+	m.HandleFunc("GET "+options.BaseURL+"/public/signing-keys/{keyId}", wrapper.GetSigningKey)
+	m.HandleFunc("GET "+options.BaseURL+"/public/stolen-vehicles/lookup", wrapper.LookupStolenVehicle)
+	m.HandleFunc("GET "+options.BaseURL+"/public/transfers/{token}", wrapper.GetOwnershipTransferByToken)
+	m.HandleFunc("GET "+options.BaseURL+"/public/verify/events/{eventId}", wrapper.VerifyEvent)
+	m.HandleFunc("GET "+options.BaseURL+"/public/verify/vehicles/{vehicleId}", wrapper.VerifyVehicle)
+	m.HandleFunc("GET "+options.BaseURL+"/public/verify/vehicles/{vehicleId}/chain", wrapper.VerifyVehicleChain)
+	m.HandleFunc("GET "+options.BaseURL+"/shared/vehicles/{token}", wrapper.GetSharedVehicle)
+	m.HandleFunc("GET "+options.BaseURL+"/stolen-vehicles/lookup", wrapper.LookupStolenVehicleAuthenticated)
+	m.HandleFunc("POST "+options.BaseURL+"/transfers/{token}/accept", wrapper.AcceptOwnershipTransfer)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles", wrapper.GetVehicles)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles", wrapper.CreateVehicle)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}", wrapper.GetVehicle)
+	m.HandleFunc("PUT "+options.BaseURL+"/vehicles/{vehicleId}", wrapper.UpdateVehicle)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/anchors", wrapper.GetVehicleAnchors)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/custody", wrapper.GetVehicleCustody)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/custody", wrapper.RequestVehicleAssetDelivery)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/documents", wrapper.GetVehicleDocuments)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/documents/upload-url", wrapper.GenerateDocumentUploadUrl)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vehicles/{vehicleId}/documents/{documentId}", wrapper.DeleteVehicleDocument)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/documents/{documentId}/confirm", wrapper.ConfirmDocumentUpload)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/events", wrapper.GetVehicleEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/events", wrapper.CreateOwnerEvent)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/lifecycle-requests", wrapper.GetVehicleLifecycleRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/lifecycle-requests", wrapper.RequestVehicleLifecycleChange)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/owners", wrapper.GetVehicleOwners)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/photos", wrapper.GetVehiclePhotos)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/photos/upload-url", wrapper.GeneratePhotoUploadUrl)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vehicles/{vehicleId}/photos/{photoId}", wrapper.DeleteVehiclePhoto)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/photos/{photoId}/confirm", wrapper.ConfirmPhotoUpload)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/share-links", wrapper.GetVehicleShareLinks)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/share-links", wrapper.CreateShareLink)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vehicles/{vehicleId}/share-links/{shareLinkId}", wrapper.RevokeShareLink)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/stolen-reports", wrapper.GetVehicleStolenReports)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/stolen-reports", wrapper.FileStolenReport)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/transfers", wrapper.GetVehicleTransfers)
+	m.HandleFunc("POST "+options.BaseURL+"/vehicles/{vehicleId}/transfers", wrapper.InitiateVehicleTransfer)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vehicles/{vehicleId}/transfers/{transferId}", wrapper.CancelVehicleTransfer)
+	m.HandleFunc("GET "+options.BaseURL+"/vehicles/{vehicleId}/versions", wrapper.GetVehicleVersions)
+
+	return m
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type ConflictJSONResponse ErrorResponse
+
+type ForbiddenJSONResponse ErrorResponse
+
+type NotFoundJSONResponse ErrorResponse
+
+type TooManyRequestsJSONResponse ErrorResponse
+
+type UnauthorizedJSONResponse ErrorResponse
+
+type GetAdminInvitationRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetAdminInvitationResponseObject interface {
+	VisitGetAdminInvitationResponse(w http.ResponseWriter) error
+}
+
+type GetAdminInvitation200JSONResponse AdminInvitationResponse
+
+func (response GetAdminInvitation200JSONResponse) VisitGetAdminInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+type GetAdminInvitation404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateVehicle403JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+func (response GetAdminInvitation404JSONResponse) VisitGetAdminInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateVehicle409JSONResponse struct{ ConflictJSONResponse }
+type GetAdminInvitation409JSONResponse ErrorResponse
 
-func (response CreateVehicle409JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+func (response GetAdminInvitation409JSONResponse) VisitGetAdminInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
+type ClaimAdminInvitationRequestObject struct {
+	Token string `json:"token"`
+	Body  *ClaimAdminInvitationJSONRequestBody
 }
 
-type GetVehicleResponseObject interface {
-	VisitGetVehicleResponse(w http.ResponseWriter) error
+type ClaimAdminInvitationResponseObject interface {
+	VisitClaimAdminInvitationResponse(w http.ResponseWriter) error
 }
 
-type GetVehicle200JSONResponse Vehicle
+type ClaimAdminInvitation200JSONResponse ClaimAdminInvitationResponse
 
-func (response GetVehicle200JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+func (response ClaimAdminInvitation200JSONResponse) VisitClaimAdminInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+type ClaimAdminInvitation404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetVehicle401JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+func (response ClaimAdminInvitation404JSONResponse) VisitClaimAdminInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+type ClaimAdminInvitation409JSONResponse ErrorResponse
 
-func (response GetVehicle403JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+func (response ClaimAdminInvitation409JSONResponse) VisitClaimAdminInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicle404JSONResponse struct{ NotFoundJSONResponse }
+type GetFailedAnchorsRequestObject struct {
+	Params GetFailedAnchorsParams
+}
 
-func (response GetVehicle404JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+type GetFailedAnchorsResponseObject interface {
+	VisitGetFailedAnchorsResponse(w http.ResponseWriter) error
+}
+
+type GetFailedAnchors200JSONResponse FailedAnchorListResponse
+
+func (response GetFailedAnchors200JSONResponse) VisitGetFailedAnchorsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateVehicleRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	Body      *UpdateVehicleJSONRequestBody
-}
+type GetFailedAnchors401JSONResponse struct{ UnauthorizedJSONResponse }
 
-type UpdateVehicleResponseObject interface {
-	VisitUpdateVehicleResponse(w http.ResponseWriter) error
+func (response GetFailedAnchors401JSONResponse) VisitGetFailedAnchorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateVehicle200JSONResponse Vehicle
+type GetFailedAnchors403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpdateVehicle200JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+func (response GetFailedAnchors403JSONResponse) VisitGetFailedAnchorsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateVehicle400JSONResponse struct{ BadRequestJSONResponse }
+type RequeueAnchorsRequestObject struct {
+	Body *RequeueAnchorsJSONRequestBody
+}
 
-func (response UpdateVehicle400JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+type RequeueAnchorsResponseObject interface {
+	VisitRequeueAnchorsResponse(w http.ResponseWriter) error
+}
+
+type RequeueAnchors200JSONResponse RequeueAnchorsResponse
+
+func (response RequeueAnchors200JSONResponse) VisitRequeueAnchorsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+type RequeueAnchors400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateVehicle401JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+func (response RequeueAnchors400JSONResponse) VisitRequeueAnchorsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+type RequeueAnchors401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateVehicle403JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+func (response RequeueAnchors401JSONResponse) VisitRequeueAnchorsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateVehicle404JSONResponse struct{ NotFoundJSONResponse }
+type RequeueAnchors403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpdateVehicle404JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+func (response RequeueAnchors403JSONResponse) VisitRequeueAnchorsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleDocumentsRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
+type RequeueAnchorRequestObject struct {
+	RecordType RequeueAnchorParamsRecordType `json:"recordType"`
+	RecordId   AnchorRecordIdParam           `json:"recordId"`
 }
 
-type GetVehicleDocumentsResponseObject interface {
-	VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error
+type RequeueAnchorResponseObject interface {
+	VisitRequeueAnchorResponse(w http.ResponseWriter) error
 }
 
-type GetVehicleDocuments200JSONResponse DocumentListResponse
+type RequeueAnchor200JSONResponse FailedAnchor
 
-func (response GetVehicleDocuments200JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+func (response RequeueAnchor200JSONResponse) VisitRequeueAnchorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleDocuments401JSONResponse struct{ UnauthorizedJSONResponse }
+type RequeueAnchor401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetVehicleDocuments401JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+func (response RequeueAnchor401JSONResponse) VisitRequeueAnchorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleDocuments403JSONResponse struct{ ForbiddenJSONResponse }
+type RequeueAnchor403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetVehicleDocuments403JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+func (response RequeueAnchor403JSONResponse) VisitRequeueAnchorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleDocuments404JSONResponse struct{ NotFoundJSONResponse }
+type RequeueAnchor404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetVehicleDocuments404JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+func (response RequeueAnchor404JSONResponse) VisitRequeueAnchorResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GenerateDocumentUploadUrlRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	Body      *GenerateDocumentUploadUrlJSONRequestBody
+type RequeueAnchor409JSONResponse ErrorResponse
+
+func (response RequeueAnchor409JSONResponse) VisitRequeueAnchorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GenerateDocumentUploadUrlResponseObject interface {
-	VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error
+type GetAdminLifecycleRequestsRequestObject struct {
+	Params GetAdminLifecycleRequestsParams
 }
 
-type GenerateDocumentUploadUrl200JSONResponse GenerateDocumentUploadUrlResponse
+type GetAdminLifecycleRequestsResponseObject interface {
+	VisitGetAdminLifecycleRequestsResponse(w http.ResponseWriter) error
+}
 
-func (response GenerateDocumentUploadUrl200JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+type GetAdminLifecycleRequests200JSONResponse []LifecycleRequest
+
+func (response GetAdminLifecycleRequests200JSONResponse) VisitGetAdminLifecycleRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GenerateDocumentUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+type GetAdminLifecycleRequests401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GenerateDocumentUploadUrl400JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+func (response GetAdminLifecycleRequests401JSONResponse) VisitGetAdminLifecycleRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GenerateDocumentUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAdminLifecycleRequests403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GenerateDocumentUploadUrl401JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+func (response GetAdminLifecycleRequests403JSONResponse) VisitGetAdminLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveAdminLifecycleRequestRequestObject struct {
+	RequestId LifecycleRequestIdParam `json:"requestId"`
+}
+
+type ApproveAdminLifecycleRequestResponseObject interface {
+	VisitApproveAdminLifecycleRequestResponse(w http.ResponseWriter) error
+}
+
+type ApproveAdminLifecycleRequest200JSONResponse LifecycleRequest
+
+func (response ApproveAdminLifecycleRequest200JSONResponse) VisitApproveAdminLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveAdminLifecycleRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveAdminLifecycleRequest401JSONResponse) VisitApproveAdminLifecycleRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GenerateDocumentUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+type ApproveAdminLifecycleRequest403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GenerateDocumentUploadUrl403JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+func (response ApproveAdminLifecycleRequest403JSONResponse) VisitApproveAdminLifecycleRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GenerateDocumentUploadUrl404JSONResponse struct{ NotFoundJSONResponse }
+type ApproveAdminLifecycleRequest404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GenerateDocumentUploadUrl404JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+func (response ApproveAdminLifecycleRequest404JSONResponse) VisitApproveAdminLifecycleRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehicleDocumentRequestObject struct {
-	VehicleId  VehicleIdParam  `json:"vehicleId"`
-	DocumentId DocumentIdParam `json:"documentId"`
-}
+type ApproveAdminLifecycleRequest409JSONResponse struct{ ConflictJSONResponse }
 
-type DeleteVehicleDocumentResponseObject interface {
-	VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error
+func (response ApproveAdminLifecycleRequest409JSONResponse) VisitApproveAdminLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehicleDocument204Response struct {
+type RejectAdminLifecycleRequestRequestObject struct {
+	RequestId LifecycleRequestIdParam `json:"requestId"`
+	Body      *RejectAdminLifecycleRequestJSONRequestBody
 }
 
-func (response DeleteVehicleDocument204Response) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type RejectAdminLifecycleRequestResponseObject interface {
+	VisitRejectAdminLifecycleRequestResponse(w http.ResponseWriter) error
 }
 
-type DeleteVehicleDocument400JSONResponse struct{ BadRequestJSONResponse }
+type RejectAdminLifecycleRequest200JSONResponse LifecycleRequest
 
-func (response DeleteVehicleDocument400JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+func (response RejectAdminLifecycleRequest200JSONResponse) VisitRejectAdminLifecycleRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehicleDocument401JSONResponse struct{ UnauthorizedJSONResponse }
+type RejectAdminLifecycleRequest401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteVehicleDocument401JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+func (response RejectAdminLifecycleRequest401JSONResponse) VisitRejectAdminLifecycleRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehicleDocument403JSONResponse struct{ ForbiddenJSONResponse }
+type RejectAdminLifecycleRequest403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteVehicleDocument403JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+func (response RejectAdminLifecycleRequest403JSONResponse) VisitRejectAdminLifecycleRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehicleDocument404JSONResponse struct{ NotFoundJSONResponse }
+type RejectAdminLifecycleRequest404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteVehicleDocument404JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+func (response RejectAdminLifecycleRequest404JSONResponse) VisitRejectAdminLifecycleRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmDocumentUploadRequestObject struct {
-	VehicleId  VehicleIdParam  `json:"vehicleId"`
-	DocumentId DocumentIdParam `json:"documentId"`
+type RejectAdminLifecycleRequest409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RejectAdminLifecycleRequest409JSONResponse) VisitRejectAdminLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmDocumentUploadResponseObject interface {
-	VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error
+type GetAdminStolenReportsRequestObject struct {
+	Params GetAdminStolenReportsParams
 }
 
-type ConfirmDocumentUpload200JSONResponse Document
+type GetAdminStolenReportsResponseObject interface {
+	VisitGetAdminStolenReportsResponse(w http.ResponseWriter) error
+}
 
-func (response ConfirmDocumentUpload200JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+type GetAdminStolenReports200JSONResponse []StolenReport
+
+func (response GetAdminStolenReports200JSONResponse) VisitGetAdminStolenReportsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmDocumentUpload400JSONResponse struct{ BadRequestJSONResponse }
+type GetAdminStolenReports401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ConfirmDocumentUpload400JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+func (response GetAdminStolenReports401JSONResponse) VisitGetAdminStolenReportsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmDocumentUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAdminStolenReports403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ConfirmDocumentUpload401JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+func (response GetAdminStolenReports403JSONResponse) VisitGetAdminStolenReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveStolenReportRequestObject struct {
+	ReportId StolenReportIdParam `json:"reportId"`
+}
+
+type ApproveStolenReportResponseObject interface {
+	VisitApproveStolenReportResponse(w http.ResponseWriter) error
+}
+
+type ApproveStolenReport200JSONResponse StolenReport
+
+func (response ApproveStolenReport200JSONResponse) VisitApproveStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveStolenReport401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveStolenReport401JSONResponse) VisitApproveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmDocumentUpload403JSONResponse struct{ ForbiddenJSONResponse }
+type ApproveStolenReport403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ConfirmDocumentUpload403JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+func (response ApproveStolenReport403JSONResponse) VisitApproveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmDocumentUpload404JSONResponse struct{ NotFoundJSONResponse }
+type ApproveStolenReport404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ConfirmDocumentUpload404JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+func (response ApproveStolenReport404JSONResponse) VisitApproveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleEventsRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	Params    GetVehicleEventsParams
+type ApproveStolenReport409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ApproveStolenReport409JSONResponse) VisitApproveStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleEventsResponseObject interface {
-	VisitGetVehicleEventsResponse(w http.ResponseWriter) error
+type RejectStolenReportRequestObject struct {
+	ReportId StolenReportIdParam `json:"reportId"`
+	Body     *RejectStolenReportJSONRequestBody
 }
 
-type GetVehicleEvents200JSONResponse EventListResponse
+type RejectStolenReportResponseObject interface {
+	VisitRejectStolenReportResponse(w http.ResponseWriter) error
+}
 
-func (response GetVehicleEvents200JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+type RejectStolenReport200JSONResponse StolenReport
+
+func (response RejectStolenReport200JSONResponse) VisitRejectStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+type RejectStolenReport401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetVehicleEvents401JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+func (response RejectStolenReport401JSONResponse) VisitRejectStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleEvents403JSONResponse struct{ ForbiddenJSONResponse }
+type RejectStolenReport403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetVehicleEvents403JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+func (response RejectStolenReport403JSONResponse) VisitRejectStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleEvents404JSONResponse struct{ NotFoundJSONResponse }
+type RejectStolenReport404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetVehicleEvents404JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+func (response RejectStolenReport404JSONResponse) VisitRejectStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOwnerEventRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	Body      *CreateOwnerEventJSONRequestBody
+type RejectStolenReport409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RejectStolenReport409JSONResponse) VisitRejectStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOwnerEventResponseObject interface {
-	VisitCreateOwnerEventResponse(w http.ResponseWriter) error
+type ResolveStolenReportRequestObject struct {
+	ReportId StolenReportIdParam `json:"reportId"`
+	Body     *ResolveStolenReportJSONRequestBody
 }
 
-type CreateOwnerEvent201JSONResponse Event
+type ResolveStolenReportResponseObject interface {
+	VisitResolveStolenReportResponse(w http.ResponseWriter) error
+}
 
-func (response CreateOwnerEvent201JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+type ResolveStolenReport200JSONResponse StolenReport
+
+func (response ResolveStolenReport200JSONResponse) VisitResolveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOwnerEvent400JSONResponse struct{ BadRequestJSONResponse }
+type ResolveStolenReport401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateOwnerEvent400JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+func (response ResolveStolenReport401JSONResponse) VisitResolveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOwnerEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+type ResolveStolenReport403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response CreateOwnerEvent401JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+func (response ResolveStolenReport403JSONResponse) VisitResolveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOwnerEvent403JSONResponse struct{ ForbiddenJSONResponse }
+type ResolveStolenReport404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateOwnerEvent403JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+func (response ResolveStolenReport404JSONResponse) VisitResolveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateOwnerEvent404JSONResponse struct{ NotFoundJSONResponse }
+type ResolveStolenReport409JSONResponse struct{ ConflictJSONResponse }
 
-func (response CreateOwnerEvent404JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+func (response ResolveStolenReport409JSONResponse) VisitResolveStolenReportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehiclePhotosRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
+type GetAdminUsersRequestObject struct {
+	Params GetAdminUsersParams
 }
 
-type GetVehiclePhotosResponseObject interface {
-	VisitGetVehiclePhotosResponse(w http.ResponseWriter) error
+type GetAdminUsersResponseObject interface {
+	VisitGetAdminUsersResponse(w http.ResponseWriter) error
 }
 
-type GetVehiclePhotos200JSONResponse PhotoListResponse
+type GetAdminUsers200JSONResponse AdminUserListResponse
 
-func (response GetVehiclePhotos200JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
+func (response GetAdminUsers200JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehiclePhotos401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAdminUsers401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetVehiclePhotos401JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
+func (response GetAdminUsers401JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehiclePhotos403JSONResponse struct{ ForbiddenJSONResponse }
+type GetAdminUsers403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetVehiclePhotos403JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
+func (response GetAdminUsers403JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehiclePhotos404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetVehiclePhotos404JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GeneratePhotoUploadUrlRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	Body      *GeneratePhotoUploadUrlJSONRequestBody
+type CreateAdminUserRequestObject struct {
+	Body *CreateAdminUserJSONRequestBody
 }
 
-type GeneratePhotoUploadUrlResponseObject interface {
-	VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error
+type CreateAdminUserResponseObject interface {
+	VisitCreateAdminUserResponse(w http.ResponseWriter) error
 }
 
-type GeneratePhotoUploadUrl200JSONResponse GenerateUploadUrlResponse
+type CreateAdminUser201JSONResponse AdminUser
 
-func (response GeneratePhotoUploadUrl200JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+func (response CreateAdminUser201JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GeneratePhotoUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+type CreateAdminUser400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GeneratePhotoUploadUrl400JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+func (response CreateAdminUser400JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GeneratePhotoUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateAdminUser401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GeneratePhotoUploadUrl401JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+func (response CreateAdminUser401JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GeneratePhotoUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+type CreateAdminUser403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GeneratePhotoUploadUrl403JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+func (response CreateAdminUser403JSONResponse) VisitCreateAdminUserResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GeneratePhotoUploadUrl404JSONResponse struct{ NotFoundJSONResponse }
+type CreateCertifierVehicleRequestObject struct {
+	Body *CreateCertifierVehicleJSONRequestBody
+}
 
-func (response GeneratePhotoUploadUrl404JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+type CreateCertifierVehicleResponseObject interface {
+	VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error
+}
+
+type CreateCertifierVehicle201JSONResponse Vehicle
+
+func (response CreateCertifierVehicle201JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehiclePhotoRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	PhotoId   PhotoIdParam   `json:"photoId"`
-}
-
-type DeleteVehiclePhotoResponseObject interface {
-	VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error
-}
+type CreateCertifierVehicle400JSONResponse struct{ BadRequestJSONResponse }
 
-type DeleteVehiclePhoto204Response struct {
-}
+func (response CreateCertifierVehicle400JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-func (response DeleteVehiclePhoto204Response) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehiclePhoto401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateCertifierVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteVehiclePhoto401JSONResponse) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
+func (response CreateCertifierVehicle401JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehiclePhoto403JSONResponse struct{ ForbiddenJSONResponse }
+type CreateCertifierVehicle403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteVehiclePhoto403JSONResponse) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
+func (response CreateCertifierVehicle403JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteVehiclePhoto404JSONResponse struct{ NotFoundJSONResponse }
+type CreateCertifierVehicle409JSONResponse struct{ ConflictJSONResponse }
 
-func (response DeleteVehiclePhoto404JSONResponse) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
+func (response CreateCertifierVehicle409JSONResponse) VisitCreateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmPhotoUploadRequestObject struct {
+type UpdateCertifierVehicleRequestObject struct {
 	VehicleId VehicleIdParam `json:"vehicleId"`
-	PhotoId   PhotoIdParam   `json:"photoId"`
+	Body      *UpdateCertifierVehicleJSONRequestBody
 }
 
-type ConfirmPhotoUploadResponseObject interface {
-	VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error
+type UpdateCertifierVehicleResponseObject interface {
+	VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error
 }
 
-type ConfirmPhotoUpload200JSONResponse Photo
+type UpdateCertifierVehicle200JSONResponse Vehicle
 
-func (response ConfirmPhotoUpload200JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+func (response UpdateCertifierVehicle200JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmPhotoUpload400JSONResponse struct{ BadRequestJSONResponse }
+type UpdateCertifierVehicle400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ConfirmPhotoUpload400JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+func (response UpdateCertifierVehicle400JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmPhotoUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpdateCertifierVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ConfirmPhotoUpload401JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+func (response UpdateCertifierVehicle401JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmPhotoUpload403JSONResponse struct{ ForbiddenJSONResponse }
+type UpdateCertifierVehicle403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ConfirmPhotoUpload403JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+func (response UpdateCertifierVehicle403JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ConfirmPhotoUpload404JSONResponse struct{ NotFoundJSONResponse }
+type UpdateCertifierVehicle404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ConfirmPhotoUpload404JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+func (response UpdateCertifierVehicle404JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleShareLinksRequestObject struct {
+type UpdateCertifierVehicle409JSONResponse struct{ ConflictJSONResponse }
+
+func (response UpdateCertifierVehicle409JSONResponse) VisitUpdateCertifierVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCertifierVehicleInvitationRequestObject struct {
 	VehicleId VehicleIdParam `json:"vehicleId"`
 }
 
-type GetVehicleShareLinksResponseObject interface {
-	VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error
+type GetCertifierVehicleInvitationResponseObject interface {
+	VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error
 }
 
-type GetVehicleShareLinks200JSONResponse ShareLinkListResponse
+type GetCertifierVehicleInvitation200JSONResponse VehicleInvitationResponse
 
-func (response GetVehicleShareLinks200JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+func (response GetCertifierVehicleInvitation200JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleShareLinks401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetCertifierVehicleInvitation401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetVehicleShareLinks401JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+func (response GetCertifierVehicleInvitation401JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleShareLinks403JSONResponse struct{ ForbiddenJSONResponse }
+type GetCertifierVehicleInvitation403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetVehicleShareLinks403JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+func (response GetCertifierVehicleInvitation403JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVehicleShareLinks404JSONResponse struct{ NotFoundJSONResponse }
+type GetCertifierVehicleInvitation404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetVehicleShareLinks404JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+func (response GetCertifierVehicleInvitation404JSONResponse) VisitGetCertifierVehicleInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateShareLinkRequestObject struct {
-	VehicleId VehicleIdParam `json:"vehicleId"`
-	Body      *CreateShareLinkJSONRequestBody
+type GetEntitiesRequestObject struct {
+	Params GetEntitiesParams
 }
 
-type CreateShareLinkResponseObject interface {
-	VisitCreateShareLinkResponse(w http.ResponseWriter) error
+type GetEntitiesResponseObject interface {
+	VisitGetEntitiesResponse(w http.ResponseWriter) error
 }
 
-type CreateShareLink201JSONResponse CreateShareLinkResponse
+type GetEntities200JSONResponse EntityListResponse
 
-func (response CreateShareLink201JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+func (response GetEntities200JSONResponse) VisitGetEntitiesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateShareLink400JSONResponse struct{ BadRequestJSONResponse }
+type CreateEntityRequestObject struct {
+	Body *CreateEntityJSONRequestBody
+}
 
-func (response CreateShareLink400JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+type CreateEntityResponseObject interface {
+	VisitCreateEntityResponse(w http.ResponseWriter) error
+}
+
+type CreateEntity201JSONResponse Entity
+
+func (response CreateEntity201JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateShareLink401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateEntity400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CreateShareLink401JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+func (response CreateEntity400JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateShareLink403JSONResponse struct{ ForbiddenJSONResponse }
+type CreateEntity401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateShareLink403JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+func (response CreateEntity401JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateShareLink404JSONResponse struct{ NotFoundJSONResponse }
+type CreateEntity403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response CreateShareLink404JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+func (response CreateEntity403JSONResponse) VisitCreateEntityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeShareLinkRequestObject struct {
-	VehicleId   VehicleIdParam   `json:"vehicleId"`
-	ShareLinkId ShareLinkIdParam `json:"shareLinkId"`
+type DeleteEntityRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
 }
 
-type RevokeShareLinkResponseObject interface {
-	VisitRevokeShareLinkResponse(w http.ResponseWriter) error
+type DeleteEntityResponseObject interface {
+	VisitDeleteEntityResponse(w http.ResponseWriter) error
 }
 
-type RevokeShareLink204Response struct {
+type DeleteEntity204Response struct {
 }
 
-func (response RevokeShareLink204Response) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+func (response DeleteEntity204Response) VisitDeleteEntityResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type RevokeShareLink401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteEntity401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response RevokeShareLink401JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+func (response DeleteEntity401JSONResponse) VisitDeleteEntityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeShareLink403JSONResponse struct{ ForbiddenJSONResponse }
+type DeleteEntity403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response RevokeShareLink403JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+func (response DeleteEntity403JSONResponse) VisitDeleteEntityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeShareLink404JSONResponse struct{ NotFoundJSONResponse }
+type DeleteEntity404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response RevokeShareLink404JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+func (response DeleteEntity404JSONResponse) VisitDeleteEntityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get admin invitation details by token
-	// (GET /admin-invitations/{token})
-	GetAdminInvitation(ctx context.Context, request GetAdminInvitationRequestObject) (GetAdminInvitationResponseObject, error)
-	// Claim admin invitation and create user account
-	// (POST /admin-invitations/{token})
-	ClaimAdminInvitation(ctx context.Context, request ClaimAdminInvitationRequestObject) (ClaimAdminInvitationResponseObject, error)
-	// List admin users
-	// (GET /admin/users)
-	GetAdminUsers(ctx context.Context, request GetAdminUsersRequestObject) (GetAdminUsersResponseObject, error)
-	// Invite a new admin user
-	// (POST /admin/users)
-	CreateAdminUser(ctx context.Context, request CreateAdminUserRequestObject) (CreateAdminUserResponseObject, error)
-	// Create vehicle for certification (unclaimed)
-	// (POST /certifiers/vehicles)
-	CreateCertifierVehicle(ctx context.Context, request CreateCertifierVehicleRequestObject) (CreateCertifierVehicleResponseObject, error)
-	// Update vehicle for certification
-	// (PUT /certifiers/vehicles/{vehicleId})
-	UpdateCertifierVehicle(ctx context.Context, request UpdateCertifierVehicleRequestObject) (UpdateCertifierVehicleResponseObject, error)
-	// Get pending invitation for a vehicle
-	// (GET /certifiers/vehicles/{vehicleId}/invitation)
-	GetCertifierVehicleInvitation(ctx context.Context, request GetCertifierVehicleInvitationRequestObject) (GetCertifierVehicleInvitationResponseObject, error)
-	// List entities
-	// (GET /entities)
-	GetEntities(ctx context.Context, request GetEntitiesRequestObject) (GetEntitiesResponseObject, error)
-	// Create a new entity
-	// (POST /entities)
-	CreateEntity(ctx context.Context, request CreateEntityRequestObject) (CreateEntityResponseObject, error)
-	// Delete entity
-	// (DELETE /entities/{entityId})
-	DeleteEntity(ctx context.Context, request DeleteEntityRequestObject) (DeleteEntityResponseObject, error)
-	// Get entity by ID
-	// (GET /entities/{entityId})
-	GetEntity(ctx context.Context, request GetEntityRequestObject) (GetEntityResponseObject, error)
-	// Update entity
-	// (PUT /entities/{entityId})
-	UpdateEntity(ctx context.Context, request UpdateEntityRequestObject) (UpdateEntityResponseObject, error)
-	// Delete entity logo
-	// (DELETE /entities/{entityId}/logo)
-	DeleteEntityLogo(ctx context.Context, request DeleteEntityLogoRequestObject) (DeleteEntityLogoResponseObject, error)
-	// Generate entity logo upload URL
-	// (POST /entities/{entityId}/logo/upload-url)
-	GenerateEntityLogoUploadUrl(ctx context.Context, request GenerateEntityLogoUploadUrlRequestObject) (GenerateEntityLogoUploadUrlResponseObject, error)
-	// List entity members
-	// (GET /entities/{entityId}/members)
-	GetEntityMembers(ctx context.Context, request GetEntityMembersRequestObject) (GetEntityMembersResponseObject, error)
-	// Add member to entity
-	// (POST /entities/{entityId}/members)
-	AddEntityMember(ctx context.Context, request AddEntityMemberRequestObject) (AddEntityMemberResponseObject, error)
-	// Remove member from entity
-	// (DELETE /entities/{entityId}/members/{userId})
-	RemoveEntityMember(ctx context.Context, request RemoveEntityMemberRequestObject) (RemoveEntityMemberResponseObject, error)
-	// Update member role
-	// (PATCH /entities/{entityId}/members/{userId})
-	UpdateEntityMemberRole(ctx context.Context, request UpdateEntityMemberRoleRequestObject) (UpdateEntityMemberRoleResponseObject, error)
-	// List OAuth2 clients for entity
-	// (GET /entities/{entityId}/oauth2/clients)
-	ListEntityOAuth2Clients(ctx context.Context, request ListEntityOAuth2ClientsRequestObject) (ListEntityOAuth2ClientsResponseObject, error)
-	// Create a new OAuth2 client for entity
-	// (POST /entities/{entityId}/oauth2/clients)
-	CreateEntityOAuth2Client(ctx context.Context, request CreateEntityOAuth2ClientRequestObject) (CreateEntityOAuth2ClientResponseObject, error)
-	// Delete OAuth2 client
-	// (DELETE /entities/{entityId}/oauth2/clients/{clientId})
-	DeleteEntityOAuth2Client(ctx context.Context, request DeleteEntityOAuth2ClientRequestObject) (DeleteEntityOAuth2ClientResponseObject, error)
-	// Get OAuth2 client details
-	// (GET /entities/{entityId}/oauth2/clients/{clientId})
-	GetEntityOAuth2Client(ctx context.Context, request GetEntityOAuth2ClientRequestObject) (GetEntityOAuth2ClientResponseObject, error)
-	// Create an upload session for event images
-	// (POST /event-images/upload-session)
-	CreateEventImageUploadSession(ctx context.Context, request CreateEventImageUploadSessionRequestObject) (CreateEventImageUploadSessionResponseObject, error)
-	// Delete an event image
-	// (DELETE /event-images/{imageId})
-	DeleteEventImage(ctx context.Context, request DeleteEventImageRequestObject) (DeleteEventImageResponseObject, error)
-	// Confirm an event image upload
-	// (POST /event-images/{imageId}/confirm)
-	ConfirmEventImageUpload(ctx context.Context, request ConfirmEventImageUploadRequestObject) (ConfirmEventImageUploadResponseObject, error)
-	// Get images in an upload session
-	// (GET /event-images/{sessionId})
-	GetEventImagesBySession(ctx context.Context, request GetEventImagesBySessionRequestObject) (GetEventImagesBySessionResponseObject, error)
-	// Generate a pre-signed URL for uploading an event image
-	// (POST /event-images/{sessionId}/upload-url)
-	GenerateEventImageUploadUrl(ctx context.Context, request GenerateEventImageUploadUrlRequestObject) (GenerateEventImageUploadUrlResponseObject, error)
-	// Create a new history event
-	// (POST /events)
-	CreateEvent(ctx context.Context, request CreateEventRequestObject) (CreateEventResponseObject, error)
-	// Get event by ID
-	// (GET /events/{eventId})
-	GetEvent(ctx context.Context, request GetEventRequestObject) (GetEventResponseObject, error)
-	// Get images for an event
-	// (GET /events/{eventId}/images)
-	GetEventImages(ctx context.Context, request GetEventImagesRequestObject) (GetEventImagesResponseObject, error)
-	// Health check endpoint
-	// (GET /health)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
-	// Claim pending invitations
-	// (POST /invitations/claim)
-	ClaimInvitations(ctx context.Context, request ClaimInvitationsRequestObject) (ClaimInvitationsResponseObject, error)
-	// Validate invitation token
-	// (GET /invitations/validate)
-	ValidateInvitation(ctx context.Context, request ValidateInvitationRequestObject) (ValidateInvitationResponseObject, error)
-	// Get current user profile
-	// (GET /me)
-	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
-	// List public entities
-	// (GET /public/entities)
-	GetPublicEntities(ctx context.Context, request GetPublicEntitiesRequestObject) (GetPublicEntitiesResponseObject, error)
-	// Get public vehicle passport
-	// (GET /public/passport/{vehicleId})
-	GetVehiclePassport(ctx context.Context, request GetVehiclePassportRequestObject) (GetVehiclePassportResponseObject, error)
-	// Access shared vehicle data
-	// (GET /shared/vehicles/{token})
-	GetSharedVehicle(ctx context.Context, request GetSharedVehicleRequestObject) (GetSharedVehicleResponseObject, error)
-	// List vehicles
-	// (GET /vehicles)
-	GetVehicles(ctx context.Context, request GetVehiclesRequestObject) (GetVehiclesResponseObject, error)
-	// Register a new vehicle
-	// (POST /vehicles)
-	CreateVehicle(ctx context.Context, request CreateVehicleRequestObject) (CreateVehicleResponseObject, error)
-	// Get vehicle by ID
-	// (GET /vehicles/{vehicleId})
-	GetVehicle(ctx context.Context, request GetVehicleRequestObject) (GetVehicleResponseObject, error)
-	// Update vehicle
-	// (PUT /vehicles/{vehicleId})
-	UpdateVehicle(ctx context.Context, request UpdateVehicleRequestObject) (UpdateVehicleResponseObject, error)
-	// Get vehicle documents
-	// (GET /vehicles/{vehicleId}/documents)
-	GetVehicleDocuments(ctx context.Context, request GetVehicleDocumentsRequestObject) (GetVehicleDocumentsResponseObject, error)
-	// Generate document upload URL
-	// (POST /vehicles/{vehicleId}/documents/upload-url)
-	GenerateDocumentUploadUrl(ctx context.Context, request GenerateDocumentUploadUrlRequestObject) (GenerateDocumentUploadUrlResponseObject, error)
-	// Delete vehicle document
-	// (DELETE /vehicles/{vehicleId}/documents/{documentId})
-	DeleteVehicleDocument(ctx context.Context, request DeleteVehicleDocumentRequestObject) (DeleteVehicleDocumentResponseObject, error)
-	// Confirm document upload
-	// (POST /vehicles/{vehicleId}/documents/{documentId}/confirm)
-	ConfirmDocumentUpload(ctx context.Context, request ConfirmDocumentUploadRequestObject) (ConfirmDocumentUploadResponseObject, error)
-	// Get vehicle history events
-	// (GET /vehicles/{vehicleId}/events)
-	GetVehicleEvents(ctx context.Context, request GetVehicleEventsRequestObject) (GetVehicleEventsResponseObject, error)
-	// Create a new (owner documented) history event
-	// (POST /vehicles/{vehicleId}/events)
-	CreateOwnerEvent(ctx context.Context, request CreateOwnerEventRequestObject) (CreateOwnerEventResponseObject, error)
-	// Get vehicle photos
-	// (GET /vehicles/{vehicleId}/photos)
-	GetVehiclePhotos(ctx context.Context, request GetVehiclePhotosRequestObject) (GetVehiclePhotosResponseObject, error)
-	// Generate photo upload URL
-	// (POST /vehicles/{vehicleId}/photos/upload-url)
-	GeneratePhotoUploadUrl(ctx context.Context, request GeneratePhotoUploadUrlRequestObject) (GeneratePhotoUploadUrlResponseObject, error)
-	// Delete vehicle photo
-	// (DELETE /vehicles/{vehicleId}/photos/{photoId})
-	DeleteVehiclePhoto(ctx context.Context, request DeleteVehiclePhotoRequestObject) (DeleteVehiclePhotoResponseObject, error)
-	// Confirm photo upload
-	// (POST /vehicles/{vehicleId}/photos/{photoId}/confirm)
-	ConfirmPhotoUpload(ctx context.Context, request ConfirmPhotoUploadRequestObject) (ConfirmPhotoUploadResponseObject, error)
-	// List vehicle share links
-	// (GET /vehicles/{vehicleId}/share-links)
-	GetVehicleShareLinks(ctx context.Context, request GetVehicleShareLinksRequestObject) (GetVehicleShareLinksResponseObject, error)
-	// Create a temporary share link
-	// (POST /vehicles/{vehicleId}/share-links)
-	CreateShareLink(ctx context.Context, request CreateShareLinkRequestObject) (CreateShareLinkResponseObject, error)
-	// Revoke a share link
-	// (DELETE /vehicles/{vehicleId}/share-links/{shareLinkId})
-	RevokeShareLink(ctx context.Context, request RevokeShareLinkRequestObject) (RevokeShareLinkResponseObject, error)
+type GetEntityRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
-
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type GetEntityResponseObject interface {
+	VisitGetEntityResponse(w http.ResponseWriter) error
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+type GetEntity200JSONResponse Entity
+
+func (response GetEntity200JSONResponse) VisitGetEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEntity404JSONResponse) VisitGetEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Body     *UpdateEntityJSONRequestBody
+}
+
+type UpdateEntityResponseObject interface {
+	VisitUpdateEntityResponse(w http.ResponseWriter) error
+}
+
+type UpdateEntity200JSONResponse Entity
+
+func (response UpdateEntity200JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateEntity400JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateEntity401JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntity403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateEntity403JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateEntity404JSONResponse) VisitUpdateEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityCertificationRequestsRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Params   GetEntityCertificationRequestsParams
+}
+
+type GetEntityCertificationRequestsResponseObject interface {
+	VisitGetEntityCertificationRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetEntityCertificationRequests200JSONResponse []CertificationRequest
+
+func (response GetEntityCertificationRequests200JSONResponse) VisitGetEntityCertificationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityCertificationRequests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEntityCertificationRequests401JSONResponse) VisitGetEntityCertificationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityCertificationRequests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEntityCertificationRequests403JSONResponse) VisitGetEntityCertificationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineCertificationRequestRequestObject struct {
+	EntityId  EntityIdParam               `json:"entityId"`
+	RequestId CertificationRequestIdParam `json:"requestId"`
+	Body      *DeclineCertificationRequestJSONRequestBody
+}
+
+type DeclineCertificationRequestResponseObject interface {
+	VisitDeclineCertificationRequestResponse(w http.ResponseWriter) error
+}
+
+type DeclineCertificationRequest200JSONResponse CertificationRequest
+
+func (response DeclineCertificationRequest200JSONResponse) VisitDeclineCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineCertificationRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeclineCertificationRequest401JSONResponse) VisitDeclineCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineCertificationRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeclineCertificationRequest403JSONResponse) VisitDeclineCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineCertificationRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeclineCertificationRequest404JSONResponse) VisitDeclineCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeclineCertificationRequest409JSONResponse struct{ ConflictJSONResponse }
+
+func (response DeclineCertificationRequest409JSONResponse) VisitDeclineCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndorseCertificationRequestRequestObject struct {
+	EntityId  EntityIdParam               `json:"entityId"`
+	RequestId CertificationRequestIdParam `json:"requestId"`
+	Body      *EndorseCertificationRequestJSONRequestBody
+}
+
+type EndorseCertificationRequestResponseObject interface {
+	VisitEndorseCertificationRequestResponse(w http.ResponseWriter) error
+}
+
+type EndorseCertificationRequest200JSONResponse CertificationRequest
+
+func (response EndorseCertificationRequest200JSONResponse) VisitEndorseCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndorseCertificationRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EndorseCertificationRequest400JSONResponse) VisitEndorseCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndorseCertificationRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response EndorseCertificationRequest401JSONResponse) VisitEndorseCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndorseCertificationRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response EndorseCertificationRequest403JSONResponse) VisitEndorseCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndorseCertificationRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response EndorseCertificationRequest404JSONResponse) VisitEndorseCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EndorseCertificationRequest409JSONResponse struct{ ConflictJSONResponse }
+
+func (response EndorseCertificationRequest409JSONResponse) VisitEndorseCertificationRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityEventTypesRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+}
+
+type GetEntityEventTypesResponseObject interface {
+	VisitGetEntityEventTypesResponse(w http.ResponseWriter) error
+}
+
+type GetEntityEventTypes200JSONResponse []CustomEventType
+
+func (response GetEntityEventTypes200JSONResponse) VisitGetEntityEventTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityEventTypes401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEntityEventTypes401JSONResponse) VisitGetEntityEventTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityEventTypes403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEntityEventTypes403JSONResponse) VisitGetEntityEventTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntityEventTypeRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Body     *RegisterEntityEventTypeJSONRequestBody
+}
+
+type RegisterEntityEventTypeResponseObject interface {
+	VisitRegisterEntityEventTypeResponse(w http.ResponseWriter) error
+}
+
+type RegisterEntityEventType201JSONResponse CustomEventType
+
+func (response RegisterEntityEventType201JSONResponse) VisitRegisterEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntityEventType400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RegisterEntityEventType400JSONResponse) VisitRegisterEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntityEventType401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RegisterEntityEventType401JSONResponse) VisitRegisterEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntityEventType403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RegisterEntityEventType403JSONResponse) VisitRegisterEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntityEventType404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RegisterEntityEventType404JSONResponse) VisitRegisterEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntityEventType409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RegisterEntityEventType409JSONResponse) VisitRegisterEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetireEntityEventTypeRequestObject struct {
+	EntityId EntityIdParam     `json:"entityId"`
+	Key      EventTypeKeyParam `json:"key"`
+}
+
+type RetireEntityEventTypeResponseObject interface {
+	VisitRetireEntityEventTypeResponse(w http.ResponseWriter) error
+}
+
+type RetireEntityEventType204Response struct {
+}
+
+func (response RetireEntityEventType204Response) VisitRetireEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RetireEntityEventType401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RetireEntityEventType401JSONResponse) VisitRetireEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetireEntityEventType403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RetireEntityEventType403JSONResponse) VisitRetireEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetireEntityEventType404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RetireEntityEventType404JSONResponse) VisitRetireEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityEventTypeRequestObject struct {
+	EntityId EntityIdParam     `json:"entityId"`
+	Key      EventTypeKeyParam `json:"key"`
+	Body     *UpdateEntityEventTypeJSONRequestBody
+}
+
+type UpdateEntityEventTypeResponseObject interface {
+	VisitUpdateEntityEventTypeResponse(w http.ResponseWriter) error
+}
+
+type UpdateEntityEventType200JSONResponse CustomEventType
+
+func (response UpdateEntityEventType200JSONResponse) VisitUpdateEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityEventType400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateEntityEventType400JSONResponse) VisitUpdateEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityEventType401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateEntityEventType401JSONResponse) VisitUpdateEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityEventType403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateEntityEventType403JSONResponse) VisitUpdateEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityEventType404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateEntityEventType404JSONResponse) VisitUpdateEntityEventTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityLifecycleRequestsRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Params   GetEntityLifecycleRequestsParams
+}
+
+type GetEntityLifecycleRequestsResponseObject interface {
+	VisitGetEntityLifecycleRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetEntityLifecycleRequests200JSONResponse []LifecycleRequest
+
+func (response GetEntityLifecycleRequests200JSONResponse) VisitGetEntityLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityLifecycleRequests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEntityLifecycleRequests401JSONResponse) VisitGetEntityLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityLifecycleRequests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEntityLifecycleRequests403JSONResponse) VisitGetEntityLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEntityLifecycleRequestRequestObject struct {
+	EntityId  EntityIdParam           `json:"entityId"`
+	RequestId LifecycleRequestIdParam `json:"requestId"`
+}
+
+type ApproveEntityLifecycleRequestResponseObject interface {
+	VisitApproveEntityLifecycleRequestResponse(w http.ResponseWriter) error
+}
+
+type ApproveEntityLifecycleRequest200JSONResponse LifecycleRequest
+
+func (response ApproveEntityLifecycleRequest200JSONResponse) VisitApproveEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEntityLifecycleRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApproveEntityLifecycleRequest400JSONResponse) VisitApproveEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEntityLifecycleRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveEntityLifecycleRequest401JSONResponse) VisitApproveEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEntityLifecycleRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ApproveEntityLifecycleRequest403JSONResponse) VisitApproveEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEntityLifecycleRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApproveEntityLifecycleRequest404JSONResponse) VisitApproveEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEntityLifecycleRequest409JSONResponse struct{ ConflictJSONResponse }
+
+func (response ApproveEntityLifecycleRequest409JSONResponse) VisitApproveEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEntityLifecycleRequestRequestObject struct {
+	EntityId  EntityIdParam           `json:"entityId"`
+	RequestId LifecycleRequestIdParam `json:"requestId"`
+	Body      *RejectEntityLifecycleRequestJSONRequestBody
+}
+
+type RejectEntityLifecycleRequestResponseObject interface {
+	VisitRejectEntityLifecycleRequestResponse(w http.ResponseWriter) error
+}
+
+type RejectEntityLifecycleRequest200JSONResponse LifecycleRequest
+
+func (response RejectEntityLifecycleRequest200JSONResponse) VisitRejectEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEntityLifecycleRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RejectEntityLifecycleRequest401JSONResponse) VisitRejectEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEntityLifecycleRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RejectEntityLifecycleRequest403JSONResponse) VisitRejectEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEntityLifecycleRequest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RejectEntityLifecycleRequest404JSONResponse) VisitRejectEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEntityLifecycleRequest409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RejectEntityLifecycleRequest409JSONResponse) VisitRejectEntityLifecycleRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEntityLogoRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+}
+
+type DeleteEntityLogoResponseObject interface {
+	VisitDeleteEntityLogoResponse(w http.ResponseWriter) error
+}
+
+type DeleteEntityLogo204Response struct {
+}
+
+func (response DeleteEntityLogo204Response) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteEntityLogo401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteEntityLogo401JSONResponse) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEntityLogo403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteEntityLogo403JSONResponse) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEntityLogo404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteEntityLogo404JSONResponse) VisitDeleteEntityLogoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEntityLogoUploadUrlRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Body     *GenerateEntityLogoUploadUrlJSONRequestBody
+}
+
+type GenerateEntityLogoUploadUrlResponseObject interface {
+	VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error
+}
+
+type GenerateEntityLogoUploadUrl200JSONResponse EntityLogoUploadUrlResponse
+
+func (response GenerateEntityLogoUploadUrl200JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEntityLogoUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GenerateEntityLogoUploadUrl400JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEntityLogoUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GenerateEntityLogoUploadUrl401JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEntityLogoUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GenerateEntityLogoUploadUrl403JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEntityLogoUploadUrl404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GenerateEntityLogoUploadUrl404JSONResponse) VisitGenerateEntityLogoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityMembersRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+}
+
+type GetEntityMembersResponseObject interface {
+	VisitGetEntityMembersResponse(w http.ResponseWriter) error
+}
+
+type GetEntityMembers200JSONResponse []EntityMember
+
+func (response GetEntityMembers200JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEntityMembers401JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityMembers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEntityMembers403JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEntityMembers404JSONResponse) VisitGetEntityMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddEntityMemberRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Body     *AddEntityMemberJSONRequestBody
+}
+
+type AddEntityMemberResponseObject interface {
+	VisitAddEntityMemberResponse(w http.ResponseWriter) error
+}
+
+type AddEntityMember201JSONResponse EntityMember
+
+func (response AddEntityMember201JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddEntityMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddEntityMember400JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddEntityMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddEntityMember401JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddEntityMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddEntityMember403JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddEntityMember404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddEntityMember404JSONResponse) VisitAddEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveEntityMemberRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	UserId   UserIdParam   `json:"userId"`
+}
+
+type RemoveEntityMemberResponseObject interface {
+	VisitRemoveEntityMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveEntityMember204Response struct {
+}
+
+func (response RemoveEntityMember204Response) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveEntityMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveEntityMember401JSONResponse) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveEntityMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RemoveEntityMember403JSONResponse) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveEntityMember404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveEntityMember404JSONResponse) VisitRemoveEntityMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityMemberRoleRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	UserId   UserIdParam   `json:"userId"`
+	Body     *UpdateEntityMemberRoleJSONRequestBody
+}
+
+type UpdateEntityMemberRoleResponseObject interface {
+	VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error
+}
+
+type UpdateEntityMemberRole200JSONResponse EntityMember
+
+func (response UpdateEntityMemberRole200JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityMemberRole400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateEntityMemberRole400JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityMemberRole401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateEntityMemberRole401JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityMemberRole403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateEntityMemberRole403JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEntityMemberRole404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateEntityMemberRole404JSONResponse) VisitUpdateEntityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEntityOAuth2ClientsRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Params   ListEntityOAuth2ClientsParams
+}
+
+type ListEntityOAuth2ClientsResponseObject interface {
+	VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error
+}
+
+type ListEntityOAuth2Clients200JSONResponse OAuth2ClientListResponse
+
+func (response ListEntityOAuth2Clients200JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEntityOAuth2Clients401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListEntityOAuth2Clients401JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEntityOAuth2Clients403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListEntityOAuth2Clients403JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEntityOAuth2Clients404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListEntityOAuth2Clients404JSONResponse) VisitListEntityOAuth2ClientsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEntityOAuth2ClientRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Body     *CreateEntityOAuth2ClientJSONRequestBody
+}
+
+type CreateEntityOAuth2ClientResponseObject interface {
+	VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error
+}
+
+type CreateEntityOAuth2Client201JSONResponse OAuth2ClientWithSecret
+
+func (response CreateEntityOAuth2Client201JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEntityOAuth2Client400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateEntityOAuth2Client400JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEntityOAuth2Client401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateEntityOAuth2Client401JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEntityOAuth2Client403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateEntityOAuth2Client403JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEntityOAuth2Client404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateEntityOAuth2Client404JSONResponse) VisitCreateEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEntityOAuth2ClientRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	ClientId ClientIdParam `json:"clientId"`
+}
+
+type DeleteEntityOAuth2ClientResponseObject interface {
+	VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error
+}
+
+type DeleteEntityOAuth2Client204Response struct {
+}
+
+func (response DeleteEntityOAuth2Client204Response) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteEntityOAuth2Client401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteEntityOAuth2Client401JSONResponse) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEntityOAuth2Client403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteEntityOAuth2Client403JSONResponse) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEntityOAuth2Client404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteEntityOAuth2Client404JSONResponse) VisitDeleteEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityOAuth2ClientRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	ClientId ClientIdParam `json:"clientId"`
+}
+
+type GetEntityOAuth2ClientResponseObject interface {
+	VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error
+}
+
+type GetEntityOAuth2Client200JSONResponse OAuth2Client
+
+func (response GetEntityOAuth2Client200JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityOAuth2Client401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEntityOAuth2Client401JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityOAuth2Client403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEntityOAuth2Client403JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntityOAuth2Client404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEntityOAuth2Client404JSONResponse) VisitGetEntityOAuth2ClientResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntitySigningKeysRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+}
+
+type GetEntitySigningKeysResponseObject interface {
+	VisitGetEntitySigningKeysResponse(w http.ResponseWriter) error
+}
+
+type GetEntitySigningKeys200JSONResponse []SigningKey
+
+func (response GetEntitySigningKeys200JSONResponse) VisitGetEntitySigningKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntitySigningKeys401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEntitySigningKeys401JSONResponse) VisitGetEntitySigningKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEntitySigningKeys403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEntitySigningKeys403JSONResponse) VisitGetEntitySigningKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntitySigningKeyRequestObject struct {
+	EntityId EntityIdParam `json:"entityId"`
+	Body     *RegisterEntitySigningKeyJSONRequestBody
+}
+
+type RegisterEntitySigningKeyResponseObject interface {
+	VisitRegisterEntitySigningKeyResponse(w http.ResponseWriter) error
+}
+
+type RegisterEntitySigningKey201JSONResponse SigningKey
+
+func (response RegisterEntitySigningKey201JSONResponse) VisitRegisterEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntitySigningKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RegisterEntitySigningKey400JSONResponse) VisitRegisterEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntitySigningKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RegisterEntitySigningKey401JSONResponse) VisitRegisterEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntitySigningKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RegisterEntitySigningKey403JSONResponse) VisitRegisterEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntitySigningKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RegisterEntitySigningKey404JSONResponse) VisitRegisterEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterEntitySigningKey409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RegisterEntitySigningKey409JSONResponse) VisitRegisterEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEntitySigningKeyRequestObject struct {
+	EntityId EntityIdParam     `json:"entityId"`
+	KeyId    SigningKeyIdParam `json:"keyId"`
+}
+
+type RevokeEntitySigningKeyResponseObject interface {
+	VisitRevokeEntitySigningKeyResponse(w http.ResponseWriter) error
+}
+
+type RevokeEntitySigningKey200JSONResponse SigningKey
+
+func (response RevokeEntitySigningKey200JSONResponse) VisitRevokeEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEntitySigningKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeEntitySigningKey401JSONResponse) VisitRevokeEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEntitySigningKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeEntitySigningKey403JSONResponse) VisitRevokeEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEntitySigningKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeEntitySigningKey404JSONResponse) VisitRevokeEntitySigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEventImageUploadSessionRequestObject struct {
+}
+
+type CreateEventImageUploadSessionResponseObject interface {
+	VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error
+}
+
+type CreateEventImageUploadSession201JSONResponse EventImageUploadSessionResponse
+
+func (response CreateEventImageUploadSession201JSONResponse) VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEventImageUploadSession401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateEventImageUploadSession401JSONResponse) VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEventImageUploadSession403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateEventImageUploadSession403JSONResponse) VisitCreateEventImageUploadSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEventImageRequestObject struct {
+	ImageId EventImageIdParam `json:"imageId"`
+}
+
+type DeleteEventImageResponseObject interface {
+	VisitDeleteEventImageResponse(w http.ResponseWriter) error
+}
+
+type DeleteEventImage204Response struct {
+}
+
+func (response DeleteEventImage204Response) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteEventImage400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteEventImage400JSONResponse) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEventImage401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteEventImage401JSONResponse) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEventImage404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteEventImage404JSONResponse) VisitDeleteEventImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmEventImageUploadRequestObject struct {
+	ImageId EventImageIdParam `json:"imageId"`
+}
+
+type ConfirmEventImageUploadResponseObject interface {
+	VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error
+}
+
+type ConfirmEventImageUpload200JSONResponse EventImage
+
+func (response ConfirmEventImageUpload200JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmEventImageUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConfirmEventImageUpload400JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmEventImageUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ConfirmEventImageUpload401JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmEventImageUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ConfirmEventImageUpload404JSONResponse) VisitConfirmEventImageUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventImagesBySessionRequestObject struct {
+	SessionId EventImageSessionIdParam `json:"sessionId"`
+}
+
+type GetEventImagesBySessionResponseObject interface {
+	VisitGetEventImagesBySessionResponse(w http.ResponseWriter) error
+}
+
+type GetEventImagesBySession200JSONResponse EventImageListResponse
+
+func (response GetEventImagesBySession200JSONResponse) VisitGetEventImagesBySessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventImagesBySession401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEventImagesBySession401JSONResponse) VisitGetEventImagesBySessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEventImageUploadUrlRequestObject struct {
+	SessionId EventImageSessionIdParam `json:"sessionId"`
+	Body      *GenerateEventImageUploadUrlJSONRequestBody
+}
+
+type GenerateEventImageUploadUrlResponseObject interface {
+	VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error
+}
+
+type GenerateEventImageUploadUrl200JSONResponse GenerateEventImageUploadUrlResponse
+
+func (response GenerateEventImageUploadUrl200JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEventImageUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GenerateEventImageUploadUrl400JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEventImageUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GenerateEventImageUploadUrl401JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateEventImageUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GenerateEventImageUploadUrl403JSONResponse) VisitGenerateEventImageUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventMetadataSchemasRequestObject struct {
+}
+
+type GetEventMetadataSchemasResponseObject interface {
+	VisitGetEventMetadataSchemasResponse(w http.ResponseWriter) error
+}
+
+type GetEventMetadataSchemas200JSONResponse []EventMetadataSchema
+
+func (response GetEventMetadataSchemas200JSONResponse) VisitGetEventMetadataSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventMetadataSchemas401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEventMetadataSchemas401JSONResponse) VisitGetEventMetadataSchemasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEventRequestObject struct {
+	Body *CreateEventJSONRequestBody
+}
+
+type CreateEventResponseObject interface {
+	VisitCreateEventResponse(w http.ResponseWriter) error
+}
+
+type CreateEvent201JSONResponse Event
+
+func (response CreateEvent201JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEvent400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateEvent400JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateEvent401JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateEvent403JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateEvent404JSONResponse) VisitCreateEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+}
+
+type GetEventResponseObject interface {
+	VisitGetEventResponse(w http.ResponseWriter) error
+}
+
+type GetEvent200JSONResponse Event
+
+func (response GetEvent200JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEvent404JSONResponse) VisitGetEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEventRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+}
+
+type AcceptEventResponseObject interface {
+	VisitAcceptEventResponse(w http.ResponseWriter) error
+}
+
+type AcceptEvent200JSONResponse Event
+
+func (response AcceptEvent200JSONResponse) VisitAcceptEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AcceptEvent401JSONResponse) VisitAcceptEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AcceptEvent403JSONResponse) VisitAcceptEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AcceptEvent404JSONResponse) VisitAcceptEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEvent409JSONResponse struct{ ConflictJSONResponse }
+
+func (response AcceptEvent409JSONResponse) VisitAcceptEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AmendEventRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+	Body    *AmendEventJSONRequestBody
+}
+
+type AmendEventResponseObject interface {
+	VisitAmendEventResponse(w http.ResponseWriter) error
+}
+
+type AmendEvent201JSONResponse Event
+
+func (response AmendEvent201JSONResponse) VisitAmendEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AmendEvent400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AmendEvent400JSONResponse) VisitAmendEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AmendEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AmendEvent401JSONResponse) VisitAmendEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AmendEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AmendEvent403JSONResponse) VisitAmendEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AmendEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AmendEvent404JSONResponse) VisitAmendEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AmendEvent409JSONResponse struct{ ConflictJSONResponse }
+
+func (response AmendEvent409JSONResponse) VisitAmendEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventCertificationRequestsRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+}
+
+type GetEventCertificationRequestsResponseObject interface {
+	VisitGetEventCertificationRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetEventCertificationRequests200JSONResponse []CertificationRequest
+
+func (response GetEventCertificationRequests200JSONResponse) VisitGetEventCertificationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventCertificationRequests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEventCertificationRequests401JSONResponse) VisitGetEventCertificationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventCertificationRequests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEventCertificationRequests403JSONResponse) VisitGetEventCertificationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventCertificationRequests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEventCertificationRequests404JSONResponse) VisitGetEventCertificationRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEventCertificationRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+	Body    *RequestEventCertificationJSONRequestBody
+}
+
+type RequestEventCertificationResponseObject interface {
+	VisitRequestEventCertificationResponse(w http.ResponseWriter) error
+}
+
+type RequestEventCertification201JSONResponse CertificationRequest
+
+func (response RequestEventCertification201JSONResponse) VisitRequestEventCertificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEventCertification400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RequestEventCertification400JSONResponse) VisitRequestEventCertificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEventCertification401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RequestEventCertification401JSONResponse) VisitRequestEventCertificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEventCertification403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RequestEventCertification403JSONResponse) VisitRequestEventCertificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEventCertification404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RequestEventCertification404JSONResponse) VisitRequestEventCertificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEventCertification409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RequestEventCertification409JSONResponse) VisitRequestEventCertificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventImagesRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+}
+
+type GetEventImagesResponseObject interface {
+	VisitGetEventImagesResponse(w http.ResponseWriter) error
+}
+
+type GetEventImages200JSONResponse EventImageListResponse
+
+func (response GetEventImages200JSONResponse) VisitGetEventImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventImages404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEventImages404JSONResponse) VisitGetEventImagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEventRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+}
+
+type RejectEventResponseObject interface {
+	VisitRejectEventResponse(w http.ResponseWriter) error
+}
+
+type RejectEvent200JSONResponse Event
+
+func (response RejectEvent200JSONResponse) VisitRejectEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RejectEvent401JSONResponse) VisitRejectEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RejectEvent403JSONResponse) VisitRejectEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RejectEvent404JSONResponse) VisitRejectEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEvent409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RejectEvent409JSONResponse) VisitRejectEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEventRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+	Body    *RevokeEventJSONRequestBody
+}
+
+type RevokeEventResponseObject interface {
+	VisitRevokeEventResponse(w http.ResponseWriter) error
+}
+
+type RevokeEvent201JSONResponse Event
+
+func (response RevokeEvent201JSONResponse) VisitRevokeEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEvent400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RevokeEvent400JSONResponse) VisitRevokeEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeEvent401JSONResponse) VisitRevokeEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeEvent403JSONResponse) VisitRevokeEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeEvent404JSONResponse) VisitRevokeEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEvent409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RevokeEvent409JSONResponse) VisitRevokeEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignEventRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+	Body    *SignEventJSONRequestBody
+}
+
+type SignEventResponseObject interface {
+	VisitSignEventResponse(w http.ResponseWriter) error
+}
+
+type SignEvent200JSONResponse Event
+
+func (response SignEvent200JSONResponse) VisitSignEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignEvent400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SignEvent400JSONResponse) VisitSignEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SignEvent401JSONResponse) VisitSignEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SignEvent403JSONResponse) VisitSignEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SignEvent404JSONResponse) VisitSignEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignEvent409JSONResponse struct{ ConflictJSONResponse }
+
+func (response SignEvent409JSONResponse) VisitSignEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse HealthResponse
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimInvitationsRequestObject struct {
+}
+
+type ClaimInvitationsResponseObject interface {
+	VisitClaimInvitationsResponse(w http.ResponseWriter) error
+}
+
+type ClaimInvitations200JSONResponse ClaimInvitationsResponse
+
+func (response ClaimInvitations200JSONResponse) VisitClaimInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimInvitations401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ClaimInvitations401JSONResponse) VisitClaimInvitationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateInvitationRequestObject struct {
+	Params ValidateInvitationParams
+}
+
+type ValidateInvitationResponseObject interface {
+	VisitValidateInvitationResponse(w http.ResponseWriter) error
+}
+
+type ValidateInvitation200JSONResponse InvitationValidationResponse
+
+func (response ValidateInvitation200JSONResponse) VisitValidateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateInvitation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ValidateInvitation400JSONResponse) VisitValidateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateInvitation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ValidateInvitation404JSONResponse) VisitValidateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeRequestObject struct {
+}
+
+type GetMeResponseObject interface {
+	VisitGetMeResponse(w http.ResponseWriter) error
+}
+
+type GetMe200JSONResponse UserProfile
+
+func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMe401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkMyWalletRequestObject struct {
+}
+
+type UnlinkMyWalletResponseObject interface {
+	VisitUnlinkMyWalletResponse(w http.ResponseWriter) error
+}
+
+type UnlinkMyWallet204Response struct {
+}
+
+func (response UnlinkMyWallet204Response) VisitUnlinkMyWalletResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnlinkMyWallet401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UnlinkMyWallet401JSONResponse) VisitUnlinkMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UnlinkMyWallet404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UnlinkMyWallet404JSONResponse) VisitUnlinkMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyWalletRequestObject struct {
+}
+
+type GetMyWalletResponseObject interface {
+	VisitGetMyWalletResponse(w http.ResponseWriter) error
+}
+
+type GetMyWallet200JSONResponse Wallet
+
+func (response GetMyWallet200JSONResponse) VisitGetMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyWallet401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMyWallet401JSONResponse) VisitGetMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyWallet404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMyWallet404JSONResponse) VisitGetMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkMyWalletRequestObject struct {
+	Body *LinkMyWalletJSONRequestBody
+}
+
+type LinkMyWalletResponseObject interface {
+	VisitLinkMyWalletResponse(w http.ResponseWriter) error
+}
+
+type LinkMyWallet200JSONResponse Wallet
+
+func (response LinkMyWallet200JSONResponse) VisitLinkMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkMyWallet400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LinkMyWallet400JSONResponse) VisitLinkMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkMyWallet401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response LinkMyWallet401JSONResponse) VisitLinkMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkMyWallet409JSONResponse struct{ ConflictJSONResponse }
+
+func (response LinkMyWallet409JSONResponse) VisitLinkMyWalletResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWalletChallengeRequestObject struct {
+	Body *CreateWalletChallengeJSONRequestBody
+}
+
+type CreateWalletChallengeResponseObject interface {
+	VisitCreateWalletChallengeResponse(w http.ResponseWriter) error
+}
+
+type CreateWalletChallenge201JSONResponse WalletChallenge
+
+func (response CreateWalletChallenge201JSONResponse) VisitCreateWalletChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWalletChallenge400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateWalletChallenge400JSONResponse) VisitCreateWalletChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWalletChallenge401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateWalletChallenge401JSONResponse) VisitCreateWalletChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleAssetMetadataRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleAssetMetadataResponseObject interface {
+	VisitGetVehicleAssetMetadataResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleAssetMetadata200JSONResponse AssetMetadata
+
+func (response GetVehicleAssetMetadata200JSONResponse) VisitGetVehicleAssetMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleAssetMetadata404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleAssetMetadata404JSONResponse) VisitGetVehicleAssetMetadataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublicEntitiesRequestObject struct {
+	Params GetPublicEntitiesParams
+}
+
+type GetPublicEntitiesResponseObject interface {
+	VisitGetPublicEntitiesResponse(w http.ResponseWriter) error
+}
+
+type GetPublicEntities200JSONResponse PublicEntityListResponse
+
+func (response GetPublicEntities200JSONResponse) VisitGetPublicEntitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPublicEventTypesRequestObject struct {
+}
+
+type GetPublicEventTypesResponseObject interface {
+	VisitGetPublicEventTypesResponse(w http.ResponseWriter) error
+}
+
+type GetPublicEventTypes200JSONResponse []CustomEventType
+
+func (response GetPublicEventTypes200JSONResponse) VisitGetPublicEventTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehiclePassportRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehiclePassportResponseObject interface {
+	VisitGetVehiclePassportResponse(w http.ResponseWriter) error
+}
+
+type GetVehiclePassport200JSONResponse SharedVehicleResponse
+
+func (response GetVehiclePassport200JSONResponse) VisitGetVehiclePassportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehiclePassport404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehiclePassport404JSONResponse) VisitGetVehiclePassportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSigningKeyRequestObject struct {
+	KeyId SigningKeyIdParam `json:"keyId"`
+}
+
+type GetSigningKeyResponseObject interface {
+	VisitGetSigningKeyResponse(w http.ResponseWriter) error
+}
+
+type GetSigningKey200JSONResponse SigningKey
+
+func (response GetSigningKey200JSONResponse) VisitGetSigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSigningKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSigningKey404JSONResponse) VisitGetSigningKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupStolenVehicleRequestObject struct {
+	Params LookupStolenVehicleParams
+}
+
+type LookupStolenVehicleResponseObject interface {
+	VisitLookupStolenVehicleResponse(w http.ResponseWriter) error
+}
+
+type LookupStolenVehicle200JSONResponse StolenVehicleAlert
+
+func (response LookupStolenVehicle200JSONResponse) VisitLookupStolenVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupStolenVehicle400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LookupStolenVehicle400JSONResponse) VisitLookupStolenVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupStolenVehicle429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response LookupStolenVehicle429JSONResponse) VisitLookupStolenVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnershipTransferByTokenRequestObject struct {
+	Token TransferTokenParam `json:"token"`
+}
+
+type GetOwnershipTransferByTokenResponseObject interface {
+	VisitGetOwnershipTransferByTokenResponse(w http.ResponseWriter) error
+}
+
+type GetOwnershipTransferByToken200JSONResponse OwnershipTransferPreview
+
+func (response GetOwnershipTransferByToken200JSONResponse) VisitGetOwnershipTransferByTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnershipTransferByToken404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOwnershipTransferByToken404JSONResponse) VisitGetOwnershipTransferByTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyEventRequestObject struct {
+	EventId EventIdParam `json:"eventId"`
+}
+
+type VerifyEventResponseObject interface {
+	VisitVerifyEventResponse(w http.ResponseWriter) error
+}
+
+type VerifyEvent200JSONResponse AnchorVerification
+
+func (response VerifyEvent200JSONResponse) VisitVerifyEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response VerifyEvent404JSONResponse) VisitVerifyEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVehicleRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type VerifyVehicleResponseObject interface {
+	VisitVerifyVehicleResponse(w http.ResponseWriter) error
+}
+
+type VerifyVehicle200JSONResponse VehicleVerificationResponse
+
+func (response VerifyVehicle200JSONResponse) VisitVerifyVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVehicle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response VerifyVehicle404JSONResponse) VisitVerifyVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVehicleChainRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type VerifyVehicleChainResponseObject interface {
+	VisitVerifyVehicleChainResponse(w http.ResponseWriter) error
+}
+
+type VerifyVehicleChain200JSONResponse VehicleChainResponse
+
+func (response VerifyVehicleChain200JSONResponse) VisitVerifyVehicleChainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVehicleChain404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response VerifyVehicleChain404JSONResponse) VisitVerifyVehicleChainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedVehicleRequestObject struct {
+	Token ShareTokenParam `json:"token"`
+}
+
+type GetSharedVehicleResponseObject interface {
+	VisitGetSharedVehicleResponse(w http.ResponseWriter) error
+}
+
+type GetSharedVehicle200JSONResponse SharedVehicleResponse
+
+func (response GetSharedVehicle200JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedVehicle400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetSharedVehicle400JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedVehicle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSharedVehicle404JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedVehicle410JSONResponse ErrorResponse
+
+func (response GetSharedVehicle410JSONResponse) VisitGetSharedVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupStolenVehicleAuthenticatedRequestObject struct {
+	Params LookupStolenVehicleAuthenticatedParams
+}
+
+type LookupStolenVehicleAuthenticatedResponseObject interface {
+	VisitLookupStolenVehicleAuthenticatedResponse(w http.ResponseWriter) error
+}
+
+type LookupStolenVehicleAuthenticated200JSONResponse StolenVehicleAlert
+
+func (response LookupStolenVehicleAuthenticated200JSONResponse) VisitLookupStolenVehicleAuthenticatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupStolenVehicleAuthenticated400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LookupStolenVehicleAuthenticated400JSONResponse) VisitLookupStolenVehicleAuthenticatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupStolenVehicleAuthenticated401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response LookupStolenVehicleAuthenticated401JSONResponse) VisitLookupStolenVehicleAuthenticatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupStolenVehicleAuthenticated403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response LookupStolenVehicleAuthenticated403JSONResponse) VisitLookupStolenVehicleAuthenticatedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOwnershipTransferRequestObject struct {
+	Token TransferTokenParam `json:"token"`
+}
+
+type AcceptOwnershipTransferResponseObject interface {
+	VisitAcceptOwnershipTransferResponse(w http.ResponseWriter) error
+}
+
+type AcceptOwnershipTransfer200JSONResponse OwnershipTransfer
+
+func (response AcceptOwnershipTransfer200JSONResponse) VisitAcceptOwnershipTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOwnershipTransfer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AcceptOwnershipTransfer401JSONResponse) VisitAcceptOwnershipTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOwnershipTransfer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AcceptOwnershipTransfer403JSONResponse) VisitAcceptOwnershipTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOwnershipTransfer404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AcceptOwnershipTransfer404JSONResponse) VisitAcceptOwnershipTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOwnershipTransfer409JSONResponse struct{ ConflictJSONResponse }
+
+func (response AcceptOwnershipTransfer409JSONResponse) VisitAcceptOwnershipTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehiclesRequestObject struct {
+	Params GetVehiclesParams
+}
+
+type GetVehiclesResponseObject interface {
+	VisitGetVehiclesResponse(w http.ResponseWriter) error
+}
+
+type GetVehicles200JSONResponse VehicleListResponse
+
+func (response GetVehicles200JSONResponse) VisitGetVehiclesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicles401JSONResponse) VisitGetVehiclesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicles403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicles403JSONResponse) VisitGetVehiclesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVehicleRequestObject struct {
+	Body *CreateVehicleJSONRequestBody
+}
+
+type CreateVehicleResponseObject interface {
+	VisitCreateVehicleResponse(w http.ResponseWriter) error
+}
+
+type CreateVehicle201JSONResponse Vehicle
+
+func (response CreateVehicle201JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVehicle400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateVehicle400JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateVehicle401JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateVehicle403JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVehicle409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateVehicle409JSONResponse) VisitCreateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleResponseObject interface {
+	VisitGetVehicleResponse(w http.ResponseWriter) error
+}
+
+type GetVehicle200JSONResponse Vehicle
+
+func (response GetVehicle200JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicle401JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicle403JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicle404JSONResponse) VisitGetVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVehicleRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *UpdateVehicleJSONRequestBody
+}
+
+type UpdateVehicleResponseObject interface {
+	VisitUpdateVehicleResponse(w http.ResponseWriter) error
+}
+
+type UpdateVehicle200JSONResponse Vehicle
+
+func (response UpdateVehicle200JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVehicle400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateVehicle400JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVehicle401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateVehicle401JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVehicle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateVehicle403JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVehicle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateVehicle404JSONResponse) VisitUpdateVehicleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleAnchorsRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleAnchorsResponseObject interface {
+	VisitGetVehicleAnchorsResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleAnchors200JSONResponse []AnchorTransaction
+
+func (response GetVehicleAnchors200JSONResponse) VisitGetVehicleAnchorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleAnchors401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleAnchors401JSONResponse) VisitGetVehicleAnchorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleAnchors403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleAnchors403JSONResponse) VisitGetVehicleAnchorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleAnchors404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleAnchors404JSONResponse) VisitGetVehicleAnchorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleCustodyRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleCustodyResponseObject interface {
+	VisitGetVehicleCustodyResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleCustody200JSONResponse VehicleCustody
+
+func (response GetVehicleCustody200JSONResponse) VisitGetVehicleCustodyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleCustody401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleCustody401JSONResponse) VisitGetVehicleCustodyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleCustody403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleCustody403JSONResponse) VisitGetVehicleCustodyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleCustody404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleCustody404JSONResponse) VisitGetVehicleCustodyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleAssetDeliveryRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type RequestVehicleAssetDeliveryResponseObject interface {
+	VisitRequestVehicleAssetDeliveryResponse(w http.ResponseWriter) error
+}
+
+type RequestVehicleAssetDelivery202JSONResponse VehicleCustody
+
+func (response RequestVehicleAssetDelivery202JSONResponse) VisitRequestVehicleAssetDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleAssetDelivery401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RequestVehicleAssetDelivery401JSONResponse) VisitRequestVehicleAssetDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleAssetDelivery403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RequestVehicleAssetDelivery403JSONResponse) VisitRequestVehicleAssetDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleAssetDelivery404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RequestVehicleAssetDelivery404JSONResponse) VisitRequestVehicleAssetDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleAssetDelivery409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RequestVehicleAssetDelivery409JSONResponse) VisitRequestVehicleAssetDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleDocumentsRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleDocumentsResponseObject interface {
+	VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleDocuments200JSONResponse DocumentListResponse
+
+func (response GetVehicleDocuments200JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleDocuments401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleDocuments401JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleDocuments403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleDocuments403JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleDocuments404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleDocuments404JSONResponse) VisitGetVehicleDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateDocumentUploadUrlRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *GenerateDocumentUploadUrlJSONRequestBody
+}
+
+type GenerateDocumentUploadUrlResponseObject interface {
+	VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error
+}
+
+type GenerateDocumentUploadUrl200JSONResponse GenerateDocumentUploadUrlResponse
+
+func (response GenerateDocumentUploadUrl200JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateDocumentUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GenerateDocumentUploadUrl400JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateDocumentUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GenerateDocumentUploadUrl401JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateDocumentUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GenerateDocumentUploadUrl403JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateDocumentUploadUrl404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GenerateDocumentUploadUrl404JSONResponse) VisitGenerateDocumentUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVehicleDocumentRequestObject struct {
+	VehicleId  VehicleIdParam  `json:"vehicleId"`
+	DocumentId DocumentIdParam `json:"documentId"`
+}
+
+type DeleteVehicleDocumentResponseObject interface {
+	VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error
+}
+
+type DeleteVehicleDocument204Response struct {
+}
+
+func (response DeleteVehicleDocument204Response) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteVehicleDocument400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteVehicleDocument400JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVehicleDocument401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVehicleDocument401JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVehicleDocument403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteVehicleDocument403JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVehicleDocument404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVehicleDocument404JSONResponse) VisitDeleteVehicleDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDocumentUploadRequestObject struct {
+	VehicleId  VehicleIdParam  `json:"vehicleId"`
+	DocumentId DocumentIdParam `json:"documentId"`
+}
+
+type ConfirmDocumentUploadResponseObject interface {
+	VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error
+}
+
+type ConfirmDocumentUpload200JSONResponse Document
+
+func (response ConfirmDocumentUpload200JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDocumentUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConfirmDocumentUpload400JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDocumentUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ConfirmDocumentUpload401JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDocumentUpload403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ConfirmDocumentUpload403JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmDocumentUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ConfirmDocumentUpload404JSONResponse) VisitConfirmDocumentUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleEventsRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Params    GetVehicleEventsParams
+}
+
+type GetVehicleEventsResponseObject interface {
+	VisitGetVehicleEventsResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleEvents200JSONResponse EventListResponse
+
+func (response GetVehicleEvents200JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleEvents401JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleEvents403JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleEvents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleEvents404JSONResponse) VisitGetVehicleEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwnerEventRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *CreateOwnerEventJSONRequestBody
+}
+
+type CreateOwnerEventResponseObject interface {
+	VisitCreateOwnerEventResponse(w http.ResponseWriter) error
+}
+
+type CreateOwnerEvent201JSONResponse Event
+
+func (response CreateOwnerEvent201JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwnerEvent400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateOwnerEvent400JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwnerEvent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateOwnerEvent401JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwnerEvent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateOwnerEvent403JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOwnerEvent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateOwnerEvent404JSONResponse) VisitCreateOwnerEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleLifecycleRequestsRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleLifecycleRequestsResponseObject interface {
+	VisitGetVehicleLifecycleRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleLifecycleRequests200JSONResponse []LifecycleRequest
+
+func (response GetVehicleLifecycleRequests200JSONResponse) VisitGetVehicleLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleLifecycleRequests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleLifecycleRequests401JSONResponse) VisitGetVehicleLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleLifecycleRequests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleLifecycleRequests403JSONResponse) VisitGetVehicleLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleLifecycleRequests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleLifecycleRequests404JSONResponse) VisitGetVehicleLifecycleRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleLifecycleChangeRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *RequestVehicleLifecycleChangeJSONRequestBody
+}
+
+type RequestVehicleLifecycleChangeResponseObject interface {
+	VisitRequestVehicleLifecycleChangeResponse(w http.ResponseWriter) error
+}
+
+type RequestVehicleLifecycleChange201JSONResponse LifecycleRequest
+
+func (response RequestVehicleLifecycleChange201JSONResponse) VisitRequestVehicleLifecycleChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleLifecycleChange400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RequestVehicleLifecycleChange400JSONResponse) VisitRequestVehicleLifecycleChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleLifecycleChange401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RequestVehicleLifecycleChange401JSONResponse) VisitRequestVehicleLifecycleChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleLifecycleChange403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RequestVehicleLifecycleChange403JSONResponse) VisitRequestVehicleLifecycleChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleLifecycleChange404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RequestVehicleLifecycleChange404JSONResponse) VisitRequestVehicleLifecycleChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestVehicleLifecycleChange409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RequestVehicleLifecycleChange409JSONResponse) VisitRequestVehicleLifecycleChangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleOwnersRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleOwnersResponseObject interface {
+	VisitGetVehicleOwnersResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleOwners200JSONResponse []VehicleOwnerPeriod
+
+func (response GetVehicleOwners200JSONResponse) VisitGetVehicleOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleOwners401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleOwners401JSONResponse) VisitGetVehicleOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleOwners403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleOwners403JSONResponse) VisitGetVehicleOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleOwners404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleOwners404JSONResponse) VisitGetVehicleOwnersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehiclePhotosRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehiclePhotosResponseObject interface {
+	VisitGetVehiclePhotosResponse(w http.ResponseWriter) error
+}
+
+type GetVehiclePhotos200JSONResponse PhotoListResponse
+
+func (response GetVehiclePhotos200JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehiclePhotos401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehiclePhotos401JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehiclePhotos403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehiclePhotos403JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehiclePhotos404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehiclePhotos404JSONResponse) VisitGetVehiclePhotosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GeneratePhotoUploadUrlRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *GeneratePhotoUploadUrlJSONRequestBody
+}
+
+type GeneratePhotoUploadUrlResponseObject interface {
+	VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error
+}
+
+type GeneratePhotoUploadUrl200JSONResponse GenerateUploadUrlResponse
+
+func (response GeneratePhotoUploadUrl200JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GeneratePhotoUploadUrl400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GeneratePhotoUploadUrl400JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GeneratePhotoUploadUrl401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GeneratePhotoUploadUrl401JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GeneratePhotoUploadUrl403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GeneratePhotoUploadUrl403JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GeneratePhotoUploadUrl404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GeneratePhotoUploadUrl404JSONResponse) VisitGeneratePhotoUploadUrlResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVehiclePhotoRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	PhotoId   PhotoIdParam   `json:"photoId"`
+}
+
+type DeleteVehiclePhotoResponseObject interface {
+	VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error
+}
+
+type DeleteVehiclePhoto204Response struct {
+}
+
+func (response DeleteVehiclePhoto204Response) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteVehiclePhoto401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVehiclePhoto401JSONResponse) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVehiclePhoto403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteVehiclePhoto403JSONResponse) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVehiclePhoto404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVehiclePhoto404JSONResponse) VisitDeleteVehiclePhotoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhotoUploadRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	PhotoId   PhotoIdParam   `json:"photoId"`
+}
+
+type ConfirmPhotoUploadResponseObject interface {
+	VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error
+}
+
+type ConfirmPhotoUpload200JSONResponse Photo
+
+func (response ConfirmPhotoUpload200JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhotoUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConfirmPhotoUpload400JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhotoUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ConfirmPhotoUpload401JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhotoUpload403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ConfirmPhotoUpload403JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmPhotoUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ConfirmPhotoUpload404JSONResponse) VisitConfirmPhotoUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleShareLinksRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleShareLinksResponseObject interface {
+	VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleShareLinks200JSONResponse ShareLinkListResponse
+
+func (response GetVehicleShareLinks200JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleShareLinks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleShareLinks401JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleShareLinks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleShareLinks403JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleShareLinks404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleShareLinks404JSONResponse) VisitGetVehicleShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLinkRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *CreateShareLinkJSONRequestBody
+}
+
+type CreateShareLinkResponseObject interface {
+	VisitCreateShareLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateShareLink201JSONResponse CreateShareLinkResponse
+
+func (response CreateShareLink201JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateShareLink400JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateShareLink401JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateShareLink403JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateShareLink404JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLinkRequestObject struct {
+	VehicleId   VehicleIdParam   `json:"vehicleId"`
+	ShareLinkId ShareLinkIdParam `json:"shareLinkId"`
+}
+
+type RevokeShareLinkResponseObject interface {
+	VisitRevokeShareLinkResponse(w http.ResponseWriter) error
+}
+
+type RevokeShareLink204Response struct {
+}
+
+func (response RevokeShareLink204Response) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeShareLink401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeShareLink401JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLink403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeShareLink403JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLink404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeShareLink404JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleStolenReportsRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleStolenReportsResponseObject interface {
+	VisitGetVehicleStolenReportsResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleStolenReports200JSONResponse []StolenReport
+
+func (response GetVehicleStolenReports200JSONResponse) VisitGetVehicleStolenReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleStolenReports401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleStolenReports401JSONResponse) VisitGetVehicleStolenReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleStolenReports403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleStolenReports403JSONResponse) VisitGetVehicleStolenReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleStolenReports404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleStolenReports404JSONResponse) VisitGetVehicleStolenReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FileStolenReportRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *FileStolenReportJSONRequestBody
+}
+
+type FileStolenReportResponseObject interface {
+	VisitFileStolenReportResponse(w http.ResponseWriter) error
+}
+
+type FileStolenReport201JSONResponse StolenReport
+
+func (response FileStolenReport201JSONResponse) VisitFileStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FileStolenReport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response FileStolenReport400JSONResponse) VisitFileStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FileStolenReport401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response FileStolenReport401JSONResponse) VisitFileStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FileStolenReport403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response FileStolenReport403JSONResponse) VisitFileStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FileStolenReport404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response FileStolenReport404JSONResponse) VisitFileStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FileStolenReport409JSONResponse struct{ ConflictJSONResponse }
+
+func (response FileStolenReport409JSONResponse) VisitFileStolenReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleTransfersRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleTransfersResponseObject interface {
+	VisitGetVehicleTransfersResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleTransfers200JSONResponse []OwnershipTransfer
+
+func (response GetVehicleTransfers200JSONResponse) VisitGetVehicleTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleTransfers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleTransfers401JSONResponse) VisitGetVehicleTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleTransfers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleTransfers403JSONResponse) VisitGetVehicleTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleTransfers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleTransfers404JSONResponse) VisitGetVehicleTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InitiateVehicleTransferRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+	Body      *InitiateVehicleTransferJSONRequestBody
+}
+
+type InitiateVehicleTransferResponseObject interface {
+	VisitInitiateVehicleTransferResponse(w http.ResponseWriter) error
+}
+
+type InitiateVehicleTransfer201JSONResponse OwnershipTransfer
+
+func (response InitiateVehicleTransfer201JSONResponse) VisitInitiateVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InitiateVehicleTransfer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response InitiateVehicleTransfer400JSONResponse) VisitInitiateVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InitiateVehicleTransfer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response InitiateVehicleTransfer401JSONResponse) VisitInitiateVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InitiateVehicleTransfer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response InitiateVehicleTransfer403JSONResponse) VisitInitiateVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InitiateVehicleTransfer404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response InitiateVehicleTransfer404JSONResponse) VisitInitiateVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InitiateVehicleTransfer409JSONResponse struct{ ConflictJSONResponse }
+
+func (response InitiateVehicleTransfer409JSONResponse) VisitInitiateVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelVehicleTransferRequestObject struct {
+	VehicleId  VehicleIdParam  `json:"vehicleId"`
+	TransferId TransferIdParam `json:"transferId"`
+}
+
+type CancelVehicleTransferResponseObject interface {
+	VisitCancelVehicleTransferResponse(w http.ResponseWriter) error
+}
+
+type CancelVehicleTransfer204Response struct {
+}
+
+func (response CancelVehicleTransfer204Response) VisitCancelVehicleTransferResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelVehicleTransfer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CancelVehicleTransfer401JSONResponse) VisitCancelVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelVehicleTransfer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CancelVehicleTransfer403JSONResponse) VisitCancelVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelVehicleTransfer404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelVehicleTransfer404JSONResponse) VisitCancelVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelVehicleTransfer409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CancelVehicleTransfer409JSONResponse) VisitCancelVehicleTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleVersionsRequestObject struct {
+	VehicleId VehicleIdParam `json:"vehicleId"`
+}
+
+type GetVehicleVersionsResponseObject interface {
+	VisitGetVehicleVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetVehicleVersions200JSONResponse []VehicleVersion
+
+func (response GetVehicleVersions200JSONResponse) VisitGetVehicleVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleVersions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVehicleVersions401JSONResponse) VisitGetVehicleVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleVersions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVehicleVersions403JSONResponse) VisitGetVehicleVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVehicleVersions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVehicleVersions404JSONResponse) VisitGetVehicleVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get admin invitation details by token
+	// (GET /admin-invitations/{token})
+	GetAdminInvitation(ctx context.Context, request GetAdminInvitationRequestObject) (GetAdminInvitationResponseObject, error)
+	// Claim admin invitation and create user account
+	// (POST /admin-invitations/{token})
+	ClaimAdminInvitation(ctx context.Context, request ClaimAdminInvitationRequestObject) (ClaimAdminInvitationResponseObject, error)
+	// List failed anchors
+	// (GET /admin/anchors/failed)
+	GetFailedAnchors(ctx context.Context, request GetFailedAnchorsRequestObject) (GetFailedAnchorsResponseObject, error)
+	// Requeue failed anchors in bulk
+	// (POST /admin/anchors/requeue)
+	RequeueAnchors(ctx context.Context, request RequeueAnchorsRequestObject) (RequeueAnchorsResponseObject, error)
+	// Requeue a single anchor
+	// (POST /admin/anchors/{recordType}/{recordId}/requeue)
+	RequeueAnchor(ctx context.Context, request RequeueAnchorRequestObject) (RequeueAnchorResponseObject, error)
+	// List lifecycle requests
+	// (GET /admin/lifecycle-requests)
+	GetAdminLifecycleRequests(ctx context.Context, request GetAdminLifecycleRequestsRequestObject) (GetAdminLifecycleRequestsResponseObject, error)
+	// Approve a lifecycle request
+	// (POST /admin/lifecycle-requests/{requestId}/approve)
+	ApproveAdminLifecycleRequest(ctx context.Context, request ApproveAdminLifecycleRequestRequestObject) (ApproveAdminLifecycleRequestResponseObject, error)
+	// Reject a lifecycle request
+	// (POST /admin/lifecycle-requests/{requestId}/reject)
+	RejectAdminLifecycleRequest(ctx context.Context, request RejectAdminLifecycleRequestRequestObject) (RejectAdminLifecycleRequestResponseObject, error)
+	// List stolen vehicle reports
+	// (GET /admin/stolen-reports)
+	GetAdminStolenReports(ctx context.Context, request GetAdminStolenReportsRequestObject) (GetAdminStolenReportsResponseObject, error)
+	// Approve a stolen vehicle report
+	// (POST /admin/stolen-reports/{reportId}/approve)
+	ApproveStolenReport(ctx context.Context, request ApproveStolenReportRequestObject) (ApproveStolenReportResponseObject, error)
+	// Reject a stolen vehicle report
+	// (POST /admin/stolen-reports/{reportId}/reject)
+	RejectStolenReport(ctx context.Context, request RejectStolenReportRequestObject) (RejectStolenReportResponseObject, error)
+	// Resolve a stolen vehicle report
+	// (POST /admin/stolen-reports/{reportId}/resolve)
+	ResolveStolenReport(ctx context.Context, request ResolveStolenReportRequestObject) (ResolveStolenReportResponseObject, error)
+	// List admin users
+	// (GET /admin/users)
+	GetAdminUsers(ctx context.Context, request GetAdminUsersRequestObject) (GetAdminUsersResponseObject, error)
+	// Invite a new admin user
+	// (POST /admin/users)
+	CreateAdminUser(ctx context.Context, request CreateAdminUserRequestObject) (CreateAdminUserResponseObject, error)
+	// Create vehicle for certification (unclaimed)
+	// (POST /certifiers/vehicles)
+	CreateCertifierVehicle(ctx context.Context, request CreateCertifierVehicleRequestObject) (CreateCertifierVehicleResponseObject, error)
+	// Update vehicle for certification
+	// (PUT /certifiers/vehicles/{vehicleId})
+	UpdateCertifierVehicle(ctx context.Context, request UpdateCertifierVehicleRequestObject) (UpdateCertifierVehicleResponseObject, error)
+	// Get pending invitation for a vehicle
+	// (GET /certifiers/vehicles/{vehicleId}/invitation)
+	GetCertifierVehicleInvitation(ctx context.Context, request GetCertifierVehicleInvitationRequestObject) (GetCertifierVehicleInvitationResponseObject, error)
+	// List entities
+	// (GET /entities)
+	GetEntities(ctx context.Context, request GetEntitiesRequestObject) (GetEntitiesResponseObject, error)
+	// Create a new entity
+	// (POST /entities)
+	CreateEntity(ctx context.Context, request CreateEntityRequestObject) (CreateEntityResponseObject, error)
+	// Delete entity
+	// (DELETE /entities/{entityId})
+	DeleteEntity(ctx context.Context, request DeleteEntityRequestObject) (DeleteEntityResponseObject, error)
+	// Get entity by ID
+	// (GET /entities/{entityId})
+	GetEntity(ctx context.Context, request GetEntityRequestObject) (GetEntityResponseObject, error)
+	// Update entity
+	// (PUT /entities/{entityId})
+	UpdateEntity(ctx context.Context, request UpdateEntityRequestObject) (UpdateEntityResponseObject, error)
+	// List the entity's certification review queue
+	// (GET /entities/{entityId}/certification-requests)
+	GetEntityCertificationRequests(ctx context.Context, request GetEntityCertificationRequestsRequestObject) (GetEntityCertificationRequestsResponseObject, error)
+	// Decline a certification request
+	// (POST /entities/{entityId}/certification-requests/{requestId}/decline)
+	DeclineCertificationRequest(ctx context.Context, request DeclineCertificationRequestRequestObject) (DeclineCertificationRequestResponseObject, error)
+	// Endorse a certification request
+	// (POST /entities/{entityId}/certification-requests/{requestId}/endorse)
+	EndorseCertificationRequest(ctx context.Context, request EndorseCertificationRequestRequestObject) (EndorseCertificationRequestResponseObject, error)
+	// List the entity's custom event types
+	// (GET /entities/{entityId}/event-types)
+	GetEntityEventTypes(ctx context.Context, request GetEntityEventTypesRequestObject) (GetEntityEventTypesResponseObject, error)
+	// Register a custom event type
+	// (POST /entities/{entityId}/event-types)
+	RegisterEntityEventType(ctx context.Context, request RegisterEntityEventTypeRequestObject) (RegisterEntityEventTypeResponseObject, error)
+	// Retire a custom event type
+	// (DELETE /entities/{entityId}/event-types/{key})
+	RetireEntityEventType(ctx context.Context, request RetireEntityEventTypeRequestObject) (RetireEntityEventTypeResponseObject, error)
+	// Update a custom event type
+	// (PATCH /entities/{entityId}/event-types/{key})
+	UpdateEntityEventType(ctx context.Context, request UpdateEntityEventTypeRequestObject) (UpdateEntityEventTypeResponseObject, error)
+	// List the entity's lifecycle review queue
+	// (GET /entities/{entityId}/lifecycle-requests)
+	GetEntityLifecycleRequests(ctx context.Context, request GetEntityLifecycleRequestsRequestObject) (GetEntityLifecycleRequestsResponseObject, error)
+	// Approve a lifecycle request
+	// (POST /entities/{entityId}/lifecycle-requests/{requestId}/approve)
+	ApproveEntityLifecycleRequest(ctx context.Context, request ApproveEntityLifecycleRequestRequestObject) (ApproveEntityLifecycleRequestResponseObject, error)
+	// Reject a lifecycle request
+	// (POST /entities/{entityId}/lifecycle-requests/{requestId}/reject)
+	RejectEntityLifecycleRequest(ctx context.Context, request RejectEntityLifecycleRequestRequestObject) (RejectEntityLifecycleRequestResponseObject, error)
+	// Delete entity logo
+	// (DELETE /entities/{entityId}/logo)
+	DeleteEntityLogo(ctx context.Context, request DeleteEntityLogoRequestObject) (DeleteEntityLogoResponseObject, error)
+	// Generate entity logo upload URL
+	// (POST /entities/{entityId}/logo/upload-url)
+	GenerateEntityLogoUploadUrl(ctx context.Context, request GenerateEntityLogoUploadUrlRequestObject) (GenerateEntityLogoUploadUrlResponseObject, error)
+	// List entity members
+	// (GET /entities/{entityId}/members)
+	GetEntityMembers(ctx context.Context, request GetEntityMembersRequestObject) (GetEntityMembersResponseObject, error)
+	// Add member to entity
+	// (POST /entities/{entityId}/members)
+	AddEntityMember(ctx context.Context, request AddEntityMemberRequestObject) (AddEntityMemberResponseObject, error)
+	// Remove member from entity
+	// (DELETE /entities/{entityId}/members/{userId})
+	RemoveEntityMember(ctx context.Context, request RemoveEntityMemberRequestObject) (RemoveEntityMemberResponseObject, error)
+	// Update member role
+	// (PATCH /entities/{entityId}/members/{userId})
+	UpdateEntityMemberRole(ctx context.Context, request UpdateEntityMemberRoleRequestObject) (UpdateEntityMemberRoleResponseObject, error)
+	// List OAuth2 clients for entity
+	// (GET /entities/{entityId}/oauth2/clients)
+	ListEntityOAuth2Clients(ctx context.Context, request ListEntityOAuth2ClientsRequestObject) (ListEntityOAuth2ClientsResponseObject, error)
+	// Create a new OAuth2 client for entity
+	// (POST /entities/{entityId}/oauth2/clients)
+	CreateEntityOAuth2Client(ctx context.Context, request CreateEntityOAuth2ClientRequestObject) (CreateEntityOAuth2ClientResponseObject, error)
+	// Delete OAuth2 client
+	// (DELETE /entities/{entityId}/oauth2/clients/{clientId})
+	DeleteEntityOAuth2Client(ctx context.Context, request DeleteEntityOAuth2ClientRequestObject) (DeleteEntityOAuth2ClientResponseObject, error)
+	// Get OAuth2 client details
+	// (GET /entities/{entityId}/oauth2/clients/{clientId})
+	GetEntityOAuth2Client(ctx context.Context, request GetEntityOAuth2ClientRequestObject) (GetEntityOAuth2ClientResponseObject, error)
+	// List the entity's signing keys
+	// (GET /entities/{entityId}/signing-keys)
+	GetEntitySigningKeys(ctx context.Context, request GetEntitySigningKeysRequestObject) (GetEntitySigningKeysResponseObject, error)
+	// Register a signing key
+	// (POST /entities/{entityId}/signing-keys)
+	RegisterEntitySigningKey(ctx context.Context, request RegisterEntitySigningKeyRequestObject) (RegisterEntitySigningKeyResponseObject, error)
+	// Revoke a signing key
+	// (DELETE /entities/{entityId}/signing-keys/{keyId})
+	RevokeEntitySigningKey(ctx context.Context, request RevokeEntitySigningKeyRequestObject) (RevokeEntitySigningKeyResponseObject, error)
+	// Create an upload session for event images
+	// (POST /event-images/upload-session)
+	CreateEventImageUploadSession(ctx context.Context, request CreateEventImageUploadSessionRequestObject) (CreateEventImageUploadSessionResponseObject, error)
+	// Delete an event image
+	// (DELETE /event-images/{imageId})
+	DeleteEventImage(ctx context.Context, request DeleteEventImageRequestObject) (DeleteEventImageResponseObject, error)
+	// Confirm an event image upload
+	// (POST /event-images/{imageId}/confirm)
+	ConfirmEventImageUpload(ctx context.Context, request ConfirmEventImageUploadRequestObject) (ConfirmEventImageUploadResponseObject, error)
+	// Get images in an upload session
+	// (GET /event-images/{sessionId})
+	GetEventImagesBySession(ctx context.Context, request GetEventImagesBySessionRequestObject) (GetEventImagesBySessionResponseObject, error)
+	// Generate a pre-signed URL for uploading an event image
+	// (POST /event-images/{sessionId}/upload-url)
+	GenerateEventImageUploadUrl(ctx context.Context, request GenerateEventImageUploadUrlRequestObject) (GenerateEventImageUploadUrlResponseObject, error)
+	// List event metadata schemas
+	// (GET /event-metadata-schemas)
+	GetEventMetadataSchemas(ctx context.Context, request GetEventMetadataSchemasRequestObject) (GetEventMetadataSchemasResponseObject, error)
+	// Create a new history event
+	// (POST /events)
+	CreateEvent(ctx context.Context, request CreateEventRequestObject) (CreateEventResponseObject, error)
+	// Get event by ID
+	// (GET /events/{eventId})
+	GetEvent(ctx context.Context, request GetEventRequestObject) (GetEventResponseObject, error)
+	// Accept a proposed event
+	// (POST /events/{eventId}/accept)
+	AcceptEvent(ctx context.Context, request AcceptEventRequestObject) (AcceptEventResponseObject, error)
+	// Amend an event
+	// (POST /events/{eventId}/amendments)
+	AmendEvent(ctx context.Context, request AmendEventRequestObject) (AmendEventResponseObject, error)
+	// List certification requests of an event
+	// (GET /events/{eventId}/certification-requests)
+	GetEventCertificationRequests(ctx context.Context, request GetEventCertificationRequestsRequestObject) (GetEventCertificationRequestsResponseObject, error)
+	// Request certification of an owner event
+	// (POST /events/{eventId}/certification-requests)
+	RequestEventCertification(ctx context.Context, request RequestEventCertificationRequestObject) (RequestEventCertificationResponseObject, error)
+	// Get images for an event
+	// (GET /events/{eventId}/images)
+	GetEventImages(ctx context.Context, request GetEventImagesRequestObject) (GetEventImagesResponseObject, error)
+	// Reject a proposed event
+	// (POST /events/{eventId}/reject)
+	RejectEvent(ctx context.Context, request RejectEventRequestObject) (RejectEventResponseObject, error)
+	// Revoke a certified event
+	// (POST /events/{eventId}/revocation)
+	RevokeEvent(ctx context.Context, request RevokeEventRequestObject) (RevokeEventResponseObject, error)
+	// Attach a signature to an event
+	// (POST /events/{eventId}/signature)
+	SignEvent(ctx context.Context, request SignEventRequestObject) (SignEventResponseObject, error)
+	// Health check endpoint
+	// (GET /health)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Claim pending invitations
+	// (POST /invitations/claim)
+	ClaimInvitations(ctx context.Context, request ClaimInvitationsRequestObject) (ClaimInvitationsResponseObject, error)
+	// Validate invitation token
+	// (GET /invitations/validate)
+	ValidateInvitation(ctx context.Context, request ValidateInvitationRequestObject) (ValidateInvitationResponseObject, error)
+	// Get current user profile
+	// (GET /me)
+	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
+	// Unlink wallet
+	// (DELETE /me/wallet)
+	UnlinkMyWallet(ctx context.Context, request UnlinkMyWalletRequestObject) (UnlinkMyWalletResponseObject, error)
+	// Get linked wallet
+	// (GET /me/wallet)
+	GetMyWallet(ctx context.Context, request GetMyWalletRequestObject) (GetMyWalletResponseObject, error)
+	// Link a wallet
+	// (POST /me/wallet)
+	LinkMyWallet(ctx context.Context, request LinkMyWalletRequestObject) (LinkMyWalletResponseObject, error)
+	// Request a wallet challenge
+	// (POST /me/wallet/challenge)
+	CreateWalletChallenge(ctx context.Context, request CreateWalletChallengeRequestObject) (CreateWalletChallengeResponseObject, error)
+	// Get vehicle asset metadata
+	// (GET /public/asset-metadata/{vehicleId})
+	GetVehicleAssetMetadata(ctx context.Context, request GetVehicleAssetMetadataRequestObject) (GetVehicleAssetMetadataResponseObject, error)
+	// List public entities
+	// (GET /public/entities)
+	GetPublicEntities(ctx context.Context, request GetPublicEntitiesRequestObject) (GetPublicEntitiesResponseObject, error)
+	// List custom event types
+	// (GET /public/event-types)
+	GetPublicEventTypes(ctx context.Context, request GetPublicEventTypesRequestObject) (GetPublicEventTypesResponseObject, error)
+	// Get public vehicle passport
+	// (GET /public/passport/{vehicleId})
+	GetVehiclePassport(ctx context.Context, request GetVehiclePassportRequestObject) (GetVehiclePassportResponseObject, error)
+	// Get a signing key
+	// (GET /public/signing-keys/{keyId})
+	GetSigningKey(ctx context.Context, request GetSigningKeyRequestObject) (GetSigningKeyResponseObject, error)
+	// Check whether a vehicle is flagged as stolen
+	// (GET /public/stolen-vehicles/lookup)
+	LookupStolenVehicle(ctx context.Context, request LookupStolenVehicleRequestObject) (LookupStolenVehicleResponseObject, error)
+	// Get ownership transfer details by token
+	// (GET /public/transfers/{token})
+	GetOwnershipTransferByToken(ctx context.Context, request GetOwnershipTransferByTokenRequestObject) (GetOwnershipTransferByTokenResponseObject, error)
+	// Verify a certified event against the blockchain
+	// (GET /public/verify/events/{eventId})
+	VerifyEvent(ctx context.Context, request VerifyEventRequestObject) (VerifyEventResponseObject, error)
+	// Verify a vehicle passport against the blockchain
+	// (GET /public/verify/vehicles/{vehicleId})
+	VerifyVehicle(ctx context.Context, request VerifyVehicleRequestObject) (VerifyVehicleResponseObject, error)
+	// Walk and validate a vehicle's record chain
+	// (GET /public/verify/vehicles/{vehicleId}/chain)
+	VerifyVehicleChain(ctx context.Context, request VerifyVehicleChainRequestObject) (VerifyVehicleChainResponseObject, error)
+	// Access shared vehicle data
+	// (GET /shared/vehicles/{token})
+	GetSharedVehicle(ctx context.Context, request GetSharedVehicleRequestObject) (GetSharedVehicleResponseObject, error)
+	// Check whether a vehicle is flagged as stolen (integrations)
+	// (GET /stolen-vehicles/lookup)
+	LookupStolenVehicleAuthenticated(ctx context.Context, request LookupStolenVehicleAuthenticatedRequestObject) (LookupStolenVehicleAuthenticatedResponseObject, error)
+	// Accept an ownership transfer
+	// (POST /transfers/{token}/accept)
+	AcceptOwnershipTransfer(ctx context.Context, request AcceptOwnershipTransferRequestObject) (AcceptOwnershipTransferResponseObject, error)
+	// List vehicles
+	// (GET /vehicles)
+	GetVehicles(ctx context.Context, request GetVehiclesRequestObject) (GetVehiclesResponseObject, error)
+	// Register a new vehicle
+	// (POST /vehicles)
+	CreateVehicle(ctx context.Context, request CreateVehicleRequestObject) (CreateVehicleResponseObject, error)
+	// Get vehicle by ID
+	// (GET /vehicles/{vehicleId})
+	GetVehicle(ctx context.Context, request GetVehicleRequestObject) (GetVehicleResponseObject, error)
+	// Update vehicle
+	// (PUT /vehicles/{vehicleId})
+	UpdateVehicle(ctx context.Context, request UpdateVehicleRequestObject) (UpdateVehicleResponseObject, error)
+	// Get vehicle anchor transactions
+	// (GET /vehicles/{vehicleId}/anchors)
+	GetVehicleAnchors(ctx context.Context, request GetVehicleAnchorsRequestObject) (GetVehicleAnchorsResponseObject, error)
+	// Get vehicle asset custody
+	// (GET /vehicles/{vehicleId}/custody)
+	GetVehicleCustody(ctx context.Context, request GetVehicleCustodyRequestObject) (GetVehicleCustodyResponseObject, error)
+	// Deliver the vehicle asset to the owner's wallet
+	// (POST /vehicles/{vehicleId}/custody)
+	RequestVehicleAssetDelivery(ctx context.Context, request RequestVehicleAssetDeliveryRequestObject) (RequestVehicleAssetDeliveryResponseObject, error)
+	// Get vehicle documents
+	// (GET /vehicles/{vehicleId}/documents)
+	GetVehicleDocuments(ctx context.Context, request GetVehicleDocumentsRequestObject) (GetVehicleDocumentsResponseObject, error)
+	// Generate document upload URL
+	// (POST /vehicles/{vehicleId}/documents/upload-url)
+	GenerateDocumentUploadUrl(ctx context.Context, request GenerateDocumentUploadUrlRequestObject) (GenerateDocumentUploadUrlResponseObject, error)
+	// Delete vehicle document
+	// (DELETE /vehicles/{vehicleId}/documents/{documentId})
+	DeleteVehicleDocument(ctx context.Context, request DeleteVehicleDocumentRequestObject) (DeleteVehicleDocumentResponseObject, error)
+	// Confirm document upload
+	// (POST /vehicles/{vehicleId}/documents/{documentId}/confirm)
+	ConfirmDocumentUpload(ctx context.Context, request ConfirmDocumentUploadRequestObject) (ConfirmDocumentUploadResponseObject, error)
+	// Get vehicle history events
+	// (GET /vehicles/{vehicleId}/events)
+	GetVehicleEvents(ctx context.Context, request GetVehicleEventsRequestObject) (GetVehicleEventsResponseObject, error)
+	// Create a new (owner documented) history event
+	// (POST /vehicles/{vehicleId}/events)
+	CreateOwnerEvent(ctx context.Context, request CreateOwnerEventRequestObject) (CreateOwnerEventResponseObject, error)
+	// List lifecycle requests of a vehicle
+	// (GET /vehicles/{vehicleId}/lifecycle-requests)
+	GetVehicleLifecycleRequests(ctx context.Context, request GetVehicleLifecycleRequestsRequestObject) (GetVehicleLifecycleRequestsResponseObject, error)
+	// Request a lifecycle status change
+	// (POST /vehicles/{vehicleId}/lifecycle-requests)
+	RequestVehicleLifecycleChange(ctx context.Context, request RequestVehicleLifecycleChangeRequestObject) (RequestVehicleLifecycleChangeResponseObject, error)
+	// Get vehicle ownership history
+	// (GET /vehicles/{vehicleId}/owners)
+	GetVehicleOwners(ctx context.Context, request GetVehicleOwnersRequestObject) (GetVehicleOwnersResponseObject, error)
+	// Get vehicle photos
+	// (GET /vehicles/{vehicleId}/photos)
+	GetVehiclePhotos(ctx context.Context, request GetVehiclePhotosRequestObject) (GetVehiclePhotosResponseObject, error)
+	// Generate photo upload URL
+	// (POST /vehicles/{vehicleId}/photos/upload-url)
+	GeneratePhotoUploadUrl(ctx context.Context, request GeneratePhotoUploadUrlRequestObject) (GeneratePhotoUploadUrlResponseObject, error)
+	// Delete vehicle photo
+	// (DELETE /vehicles/{vehicleId}/photos/{photoId})
+	DeleteVehiclePhoto(ctx context.Context, request DeleteVehiclePhotoRequestObject) (DeleteVehiclePhotoResponseObject, error)
+	// Confirm photo upload
+	// (POST /vehicles/{vehicleId}/photos/{photoId}/confirm)
+	ConfirmPhotoUpload(ctx context.Context, request ConfirmPhotoUploadRequestObject) (ConfirmPhotoUploadResponseObject, error)
+	// List vehicle share links
+	// (GET /vehicles/{vehicleId}/share-links)
+	GetVehicleShareLinks(ctx context.Context, request GetVehicleShareLinksRequestObject) (GetVehicleShareLinksResponseObject, error)
+	// Create a temporary share link
+	// (POST /vehicles/{vehicleId}/share-links)
+	CreateShareLink(ctx context.Context, request CreateShareLinkRequestObject) (CreateShareLinkResponseObject, error)
+	// Revoke a share link
+	// (DELETE /vehicles/{vehicleId}/share-links/{shareLinkId})
+	RevokeShareLink(ctx context.Context, request RevokeShareLinkRequestObject) (RevokeShareLinkResponseObject, error)
+	// List stolen vehicle reports of a vehicle
+	// (GET /vehicles/{vehicleId}/stolen-reports)
+	GetVehicleStolenReports(ctx context.Context, request GetVehicleStolenReportsRequestObject) (GetVehicleStolenReportsResponseObject, error)
+	// Report a vehicle stolen or missing
+	// (POST /vehicles/{vehicleId}/stolen-reports)
+	FileStolenReport(ctx context.Context, request FileStolenReportRequestObject) (FileStolenReportResponseObject, error)
+	// List vehicle ownership transfers
+	// (GET /vehicles/{vehicleId}/transfers)
+	GetVehicleTransfers(ctx context.Context, request GetVehicleTransfersRequestObject) (GetVehicleTransfersResponseObject, error)
+	// Transfer a vehicle to a new owner
+	// (POST /vehicles/{vehicleId}/transfers)
+	InitiateVehicleTransfer(ctx context.Context, request InitiateVehicleTransferRequestObject) (InitiateVehicleTransferResponseObject, error)
+	// Cancel an ownership transfer
+	// (DELETE /vehicles/{vehicleId}/transfers/{transferId})
+	CancelVehicleTransfer(ctx context.Context, request CancelVehicleTransferRequestObject) (CancelVehicleTransferResponseObject, error)
+	// Get vehicle record versions
+	// (GET /vehicles/{vehicleId}/versions)
+	GetVehicleVersions(ctx context.Context, request GetVehicleVersionsRequestObject) (GetVehicleVersionsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
 
 func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
 	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAdminInvitation operation middleware
+func (sh *strictHandler) GetAdminInvitation(w http.ResponseWriter, r *http.Request, token string) {
+	var request GetAdminInvitationRequestObject
+
+	request.Token = token
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminInvitation(ctx, request.(GetAdminInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminInvitationResponseObject); ok {
+		if err := validResponse.VisitGetAdminInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ClaimAdminInvitation operation middleware
+func (sh *strictHandler) ClaimAdminInvitation(w http.ResponseWriter, r *http.Request, token string) {
+	var request ClaimAdminInvitationRequestObject
+
+	request.Token = token
+
+	var body ClaimAdminInvitationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ClaimAdminInvitation(ctx, request.(ClaimAdminInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClaimAdminInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ClaimAdminInvitationResponseObject); ok {
+		if err := validResponse.VisitClaimAdminInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFailedAnchors operation middleware
+func (sh *strictHandler) GetFailedAnchors(w http.ResponseWriter, r *http.Request, params GetFailedAnchorsParams) {
+	var request GetFailedAnchorsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFailedAnchors(ctx, request.(GetFailedAnchorsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFailedAnchors")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFailedAnchorsResponseObject); ok {
+		if err := validResponse.VisitGetFailedAnchorsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequeueAnchors operation middleware
+func (sh *strictHandler) RequeueAnchors(w http.ResponseWriter, r *http.Request) {
+	var request RequeueAnchorsRequestObject
+
+	var body RequeueAnchorsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequeueAnchors(ctx, request.(RequeueAnchorsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequeueAnchors")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequeueAnchorsResponseObject); ok {
+		if err := validResponse.VisitRequeueAnchorsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequeueAnchor operation middleware
+func (sh *strictHandler) RequeueAnchor(w http.ResponseWriter, r *http.Request, recordType RequeueAnchorParamsRecordType, recordId AnchorRecordIdParam) {
+	var request RequeueAnchorRequestObject
+
+	request.RecordType = recordType
+	request.RecordId = recordId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequeueAnchor(ctx, request.(RequeueAnchorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequeueAnchor")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequeueAnchorResponseObject); ok {
+		if err := validResponse.VisitRequeueAnchorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminLifecycleRequests operation middleware
+func (sh *strictHandler) GetAdminLifecycleRequests(w http.ResponseWriter, r *http.Request, params GetAdminLifecycleRequestsParams) {
+	var request GetAdminLifecycleRequestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminLifecycleRequests(ctx, request.(GetAdminLifecycleRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminLifecycleRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminLifecycleRequestsResponseObject); ok {
+		if err := validResponse.VisitGetAdminLifecycleRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveAdminLifecycleRequest operation middleware
+func (sh *strictHandler) ApproveAdminLifecycleRequest(w http.ResponseWriter, r *http.Request, requestId LifecycleRequestIdParam) {
+	var request ApproveAdminLifecycleRequestRequestObject
+
+	request.RequestId = requestId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveAdminLifecycleRequest(ctx, request.(ApproveAdminLifecycleRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveAdminLifecycleRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveAdminLifecycleRequestResponseObject); ok {
+		if err := validResponse.VisitApproveAdminLifecycleRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RejectAdminLifecycleRequest operation middleware
+func (sh *strictHandler) RejectAdminLifecycleRequest(w http.ResponseWriter, r *http.Request, requestId LifecycleRequestIdParam) {
+	var request RejectAdminLifecycleRequestRequestObject
+
+	request.RequestId = requestId
+
+	var body RejectAdminLifecycleRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectAdminLifecycleRequest(ctx, request.(RejectAdminLifecycleRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectAdminLifecycleRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectAdminLifecycleRequestResponseObject); ok {
+		if err := validResponse.VisitRejectAdminLifecycleRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminStolenReports operation middleware
+func (sh *strictHandler) GetAdminStolenReports(w http.ResponseWriter, r *http.Request, params GetAdminStolenReportsParams) {
+	var request GetAdminStolenReportsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminStolenReports(ctx, request.(GetAdminStolenReportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminStolenReports")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminStolenReportsResponseObject); ok {
+		if err := validResponse.VisitGetAdminStolenReportsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveStolenReport operation middleware
+func (sh *strictHandler) ApproveStolenReport(w http.ResponseWriter, r *http.Request, reportId StolenReportIdParam) {
+	var request ApproveStolenReportRequestObject
+
+	request.ReportId = reportId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveStolenReport(ctx, request.(ApproveStolenReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveStolenReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveStolenReportResponseObject); ok {
+		if err := validResponse.VisitApproveStolenReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RejectStolenReport operation middleware
+func (sh *strictHandler) RejectStolenReport(w http.ResponseWriter, r *http.Request, reportId StolenReportIdParam) {
+	var request RejectStolenReportRequestObject
+
+	request.ReportId = reportId
+
+	var body RejectStolenReportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectStolenReport(ctx, request.(RejectStolenReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectStolenReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectStolenReportResponseObject); ok {
+		if err := validResponse.VisitRejectStolenReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResolveStolenReport operation middleware
+func (sh *strictHandler) ResolveStolenReport(w http.ResponseWriter, r *http.Request, reportId StolenReportIdParam) {
+	var request ResolveStolenReportRequestObject
+
+	request.ReportId = reportId
+
+	var body ResolveStolenReportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveStolenReport(ctx, request.(ResolveStolenReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveStolenReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolveStolenReportResponseObject); ok {
+		if err := validResponse.VisitResolveStolenReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminUsers operation middleware
+func (sh *strictHandler) GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams) {
+	var request GetAdminUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminUsers(ctx, request.(GetAdminUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminUsers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminUsersResponseObject); ok {
+		if err := validResponse.VisitGetAdminUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAdminUser operation middleware
+func (sh *strictHandler) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
+	var request CreateAdminUserRequestObject
+
+	var body CreateAdminUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAdminUser(ctx, request.(CreateAdminUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAdminUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAdminUserResponseObject); ok {
+		if err := validResponse.VisitCreateAdminUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCertifierVehicle operation middleware
+func (sh *strictHandler) CreateCertifierVehicle(w http.ResponseWriter, r *http.Request) {
+	var request CreateCertifierVehicleRequestObject
+
+	var body CreateCertifierVehicleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCertifierVehicle(ctx, request.(CreateCertifierVehicleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCertifierVehicle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCertifierVehicleResponseObject); ok {
+		if err := validResponse.VisitCreateCertifierVehicleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCertifierVehicle operation middleware
+func (sh *strictHandler) UpdateCertifierVehicle(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request UpdateCertifierVehicleRequestObject
+
+	request.VehicleId = vehicleId
+
+	var body UpdateCertifierVehicleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCertifierVehicle(ctx, request.(UpdateCertifierVehicleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCertifierVehicle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCertifierVehicleResponseObject); ok {
+		if err := validResponse.VisitUpdateCertifierVehicleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCertifierVehicleInvitation operation middleware
+func (sh *strictHandler) GetCertifierVehicleInvitation(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetCertifierVehicleInvitationRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCertifierVehicleInvitation(ctx, request.(GetCertifierVehicleInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCertifierVehicleInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCertifierVehicleInvitationResponseObject); ok {
+		if err := validResponse.VisitGetCertifierVehicleInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntities operation middleware
+func (sh *strictHandler) GetEntities(w http.ResponseWriter, r *http.Request, params GetEntitiesParams) {
+	var request GetEntitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntities(ctx, request.(GetEntitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntities")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntitiesResponseObject); ok {
+		if err := validResponse.VisitGetEntitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateEntity operation middleware
+func (sh *strictHandler) CreateEntity(w http.ResponseWriter, r *http.Request) {
+	var request CreateEntityRequestObject
+
+	var body CreateEntityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEntity(ctx, request.(CreateEntityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEntity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEntityResponseObject); ok {
+		if err := validResponse.VisitCreateEntityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteEntity operation middleware
+func (sh *strictHandler) DeleteEntity(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request DeleteEntityRequestObject
+
+	request.EntityId = entityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteEntity(ctx, request.(DeleteEntityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteEntity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteEntityResponseObject); ok {
+		if err := validResponse.VisitDeleteEntityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntity operation middleware
+func (sh *strictHandler) GetEntity(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request GetEntityRequestObject
+
+	request.EntityId = entityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntity(ctx, request.(GetEntityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntityResponseObject); ok {
+		if err := validResponse.VisitGetEntityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateEntity operation middleware
+func (sh *strictHandler) UpdateEntity(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request UpdateEntityRequestObject
+
+	request.EntityId = entityId
+
+	var body UpdateEntityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEntity(ctx, request.(UpdateEntityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEntity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateEntityResponseObject); ok {
+		if err := validResponse.VisitUpdateEntityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntityCertificationRequests operation middleware
+func (sh *strictHandler) GetEntityCertificationRequests(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, params GetEntityCertificationRequestsParams) {
+	var request GetEntityCertificationRequestsRequestObject
+
+	request.EntityId = entityId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntityCertificationRequests(ctx, request.(GetEntityCertificationRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntityCertificationRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntityCertificationRequestsResponseObject); ok {
+		if err := validResponse.VisitGetEntityCertificationRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeclineCertificationRequest operation middleware
+func (sh *strictHandler) DeclineCertificationRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId CertificationRequestIdParam) {
+	var request DeclineCertificationRequestRequestObject
+
+	request.EntityId = entityId
+	request.RequestId = requestId
+
+	var body DeclineCertificationRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeclineCertificationRequest(ctx, request.(DeclineCertificationRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeclineCertificationRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeclineCertificationRequestResponseObject); ok {
+		if err := validResponse.VisitDeclineCertificationRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EndorseCertificationRequest operation middleware
+func (sh *strictHandler) EndorseCertificationRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId CertificationRequestIdParam) {
+	var request EndorseCertificationRequestRequestObject
+
+	request.EntityId = entityId
+	request.RequestId = requestId
+
+	var body EndorseCertificationRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EndorseCertificationRequest(ctx, request.(EndorseCertificationRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EndorseCertificationRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EndorseCertificationRequestResponseObject); ok {
+		if err := validResponse.VisitEndorseCertificationRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntityEventTypes operation middleware
+func (sh *strictHandler) GetEntityEventTypes(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request GetEntityEventTypesRequestObject
+
+	request.EntityId = entityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntityEventTypes(ctx, request.(GetEntityEventTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntityEventTypes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntityEventTypesResponseObject); ok {
+		if err := validResponse.VisitGetEntityEventTypesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterEntityEventType operation middleware
+func (sh *strictHandler) RegisterEntityEventType(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request RegisterEntityEventTypeRequestObject
+
+	request.EntityId = entityId
+
+	var body RegisterEntityEventTypeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterEntityEventType(ctx, request.(RegisterEntityEventTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterEntityEventType")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterEntityEventTypeResponseObject); ok {
+		if err := validResponse.VisitRegisterEntityEventTypeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RetireEntityEventType operation middleware
+func (sh *strictHandler) RetireEntityEventType(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, key EventTypeKeyParam) {
+	var request RetireEntityEventTypeRequestObject
+
+	request.EntityId = entityId
+	request.Key = key
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetireEntityEventType(ctx, request.(RetireEntityEventTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetireEntityEventType")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetireEntityEventTypeResponseObject); ok {
+		if err := validResponse.VisitRetireEntityEventTypeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateEntityEventType operation middleware
+func (sh *strictHandler) UpdateEntityEventType(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, key EventTypeKeyParam) {
+	var request UpdateEntityEventTypeRequestObject
+
+	request.EntityId = entityId
+	request.Key = key
+
+	var body UpdateEntityEventTypeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEntityEventType(ctx, request.(UpdateEntityEventTypeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEntityEventType")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateEntityEventTypeResponseObject); ok {
+		if err := validResponse.VisitUpdateEntityEventTypeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntityLifecycleRequests operation middleware
+func (sh *strictHandler) GetEntityLifecycleRequests(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, params GetEntityLifecycleRequestsParams) {
+	var request GetEntityLifecycleRequestsRequestObject
+
+	request.EntityId = entityId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntityLifecycleRequests(ctx, request.(GetEntityLifecycleRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntityLifecycleRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntityLifecycleRequestsResponseObject); ok {
+		if err := validResponse.VisitGetEntityLifecycleRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveEntityLifecycleRequest operation middleware
+func (sh *strictHandler) ApproveEntityLifecycleRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId LifecycleRequestIdParam) {
+	var request ApproveEntityLifecycleRequestRequestObject
+
+	request.EntityId = entityId
+	request.RequestId = requestId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveEntityLifecycleRequest(ctx, request.(ApproveEntityLifecycleRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveEntityLifecycleRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveEntityLifecycleRequestResponseObject); ok {
+		if err := validResponse.VisitApproveEntityLifecycleRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RejectEntityLifecycleRequest operation middleware
+func (sh *strictHandler) RejectEntityLifecycleRequest(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, requestId LifecycleRequestIdParam) {
+	var request RejectEntityLifecycleRequestRequestObject
+
+	request.EntityId = entityId
+	request.RequestId = requestId
+
+	var body RejectEntityLifecycleRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectEntityLifecycleRequest(ctx, request.(RejectEntityLifecycleRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectEntityLifecycleRequest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectEntityLifecycleRequestResponseObject); ok {
+		if err := validResponse.VisitRejectEntityLifecycleRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteEntityLogo operation middleware
+func (sh *strictHandler) DeleteEntityLogo(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request DeleteEntityLogoRequestObject
+
+	request.EntityId = entityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteEntityLogo(ctx, request.(DeleteEntityLogoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteEntityLogo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteEntityLogoResponseObject); ok {
+		if err := validResponse.VisitDeleteEntityLogoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GenerateEntityLogoUploadUrl operation middleware
+func (sh *strictHandler) GenerateEntityLogoUploadUrl(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request GenerateEntityLogoUploadUrlRequestObject
+
+	request.EntityId = entityId
+
+	var body GenerateEntityLogoUploadUrlJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GenerateEntityLogoUploadUrl(ctx, request.(GenerateEntityLogoUploadUrlRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GenerateEntityLogoUploadUrl")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GenerateEntityLogoUploadUrlResponseObject); ok {
+		if err := validResponse.VisitGenerateEntityLogoUploadUrlResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntityMembers operation middleware
+func (sh *strictHandler) GetEntityMembers(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request GetEntityMembersRequestObject
+
+	request.EntityId = entityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntityMembers(ctx, request.(GetEntityMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntityMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntityMembersResponseObject); ok {
+		if err := validResponse.VisitGetEntityMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddEntityMember operation middleware
+func (sh *strictHandler) AddEntityMember(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request AddEntityMemberRequestObject
+
+	request.EntityId = entityId
+
+	var body AddEntityMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddEntityMember(ctx, request.(AddEntityMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddEntityMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddEntityMemberResponseObject); ok {
+		if err := validResponse.VisitAddEntityMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveEntityMember operation middleware
+func (sh *strictHandler) RemoveEntityMember(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, userId UserIdParam) {
+	var request RemoveEntityMemberRequestObject
+
+	request.EntityId = entityId
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveEntityMember(ctx, request.(RemoveEntityMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveEntityMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveEntityMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveEntityMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetAdminInvitation operation middleware
-func (sh *strictHandler) GetAdminInvitation(w http.ResponseWriter, r *http.Request, token string) {
-	var request GetAdminInvitationRequestObject
+// UpdateEntityMemberRole operation middleware
+func (sh *strictHandler) UpdateEntityMemberRole(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, userId UserIdParam) {
+	var request UpdateEntityMemberRoleRequestObject
+
+	request.EntityId = entityId
+	request.UserId = userId
+
+	var body UpdateEntityMemberRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEntityMemberRole(ctx, request.(UpdateEntityMemberRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEntityMemberRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateEntityMemberRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateEntityMemberRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEntityOAuth2Clients operation middleware
+func (sh *strictHandler) ListEntityOAuth2Clients(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, params ListEntityOAuth2ClientsParams) {
+	var request ListEntityOAuth2ClientsRequestObject
+
+	request.EntityId = entityId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEntityOAuth2Clients(ctx, request.(ListEntityOAuth2ClientsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEntityOAuth2Clients")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEntityOAuth2ClientsResponseObject); ok {
+		if err := validResponse.VisitListEntityOAuth2ClientsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateEntityOAuth2Client operation middleware
+func (sh *strictHandler) CreateEntityOAuth2Client(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request CreateEntityOAuth2ClientRequestObject
+
+	request.EntityId = entityId
+
+	var body CreateEntityOAuth2ClientJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEntityOAuth2Client(ctx, request.(CreateEntityOAuth2ClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEntityOAuth2Client")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEntityOAuth2ClientResponseObject); ok {
+		if err := validResponse.VisitCreateEntityOAuth2ClientResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteEntityOAuth2Client operation middleware
+func (sh *strictHandler) DeleteEntityOAuth2Client(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, clientId ClientIdParam) {
+	var request DeleteEntityOAuth2ClientRequestObject
+
+	request.EntityId = entityId
+	request.ClientId = clientId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteEntityOAuth2Client(ctx, request.(DeleteEntityOAuth2ClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteEntityOAuth2Client")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteEntityOAuth2ClientResponseObject); ok {
+		if err := validResponse.VisitDeleteEntityOAuth2ClientResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntityOAuth2Client operation middleware
+func (sh *strictHandler) GetEntityOAuth2Client(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, clientId ClientIdParam) {
+	var request GetEntityOAuth2ClientRequestObject
+
+	request.EntityId = entityId
+	request.ClientId = clientId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntityOAuth2Client(ctx, request.(GetEntityOAuth2ClientRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntityOAuth2Client")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntityOAuth2ClientResponseObject); ok {
+		if err := validResponse.VisitGetEntityOAuth2ClientResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEntitySigningKeys operation middleware
+func (sh *strictHandler) GetEntitySigningKeys(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request GetEntitySigningKeysRequestObject
+
+	request.EntityId = entityId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEntitySigningKeys(ctx, request.(GetEntitySigningKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEntitySigningKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEntitySigningKeysResponseObject); ok {
+		if err := validResponse.VisitGetEntitySigningKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterEntitySigningKey operation middleware
+func (sh *strictHandler) RegisterEntitySigningKey(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
+	var request RegisterEntitySigningKeyRequestObject
+
+	request.EntityId = entityId
+
+	var body RegisterEntitySigningKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterEntitySigningKey(ctx, request.(RegisterEntitySigningKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterEntitySigningKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterEntitySigningKeyResponseObject); ok {
+		if err := validResponse.VisitRegisterEntitySigningKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeEntitySigningKey operation middleware
+func (sh *strictHandler) RevokeEntitySigningKey(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, keyId SigningKeyIdParam) {
+	var request RevokeEntitySigningKeyRequestObject
+
+	request.EntityId = entityId
+	request.KeyId = keyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeEntitySigningKey(ctx, request.(RevokeEntitySigningKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeEntitySigningKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeEntitySigningKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeEntitySigningKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	request.Token = token
+// CreateEventImageUploadSession operation middleware
+func (sh *strictHandler) CreateEventImageUploadSession(w http.ResponseWriter, r *http.Request) {
+	var request CreateEventImageUploadSessionRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminInvitation(ctx, request.(GetAdminInvitationRequestObject))
+		return sh.ssi.CreateEventImageUploadSession(ctx, request.(CreateEventImageUploadSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminInvitation")
+		handler = middleware(handler, "CreateEventImageUploadSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminInvitationResponseObject); ok {
-		if err := validResponse.VisitGetAdminInvitationResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateEventImageUploadSessionResponseObject); ok {
+		if err := validResponse.VisitCreateEventImageUploadSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5737,32 +13046,25 @@ func (sh *strictHandler) GetAdminInvitation(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ClaimAdminInvitation operation middleware
-func (sh *strictHandler) ClaimAdminInvitation(w http.ResponseWriter, r *http.Request, token string) {
-	var request ClaimAdminInvitationRequestObject
-
-	request.Token = token
+// DeleteEventImage operation middleware
+func (sh *strictHandler) DeleteEventImage(w http.ResponseWriter, r *http.Request, imageId EventImageIdParam) {
+	var request DeleteEventImageRequestObject
 
-	var body ClaimAdminInvitationJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.ImageId = imageId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ClaimAdminInvitation(ctx, request.(ClaimAdminInvitationRequestObject))
+		return sh.ssi.DeleteEventImage(ctx, request.(DeleteEventImageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ClaimAdminInvitation")
+		handler = middleware(handler, "DeleteEventImage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ClaimAdminInvitationResponseObject); ok {
-		if err := validResponse.VisitClaimAdminInvitationResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteEventImageResponseObject); ok {
+		if err := validResponse.VisitDeleteEventImageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5770,25 +13072,25 @@ func (sh *strictHandler) ClaimAdminInvitation(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetAdminUsers operation middleware
-func (sh *strictHandler) GetAdminUsers(w http.ResponseWriter, r *http.Request, params GetAdminUsersParams) {
-	var request GetAdminUsersRequestObject
+// ConfirmEventImageUpload operation middleware
+func (sh *strictHandler) ConfirmEventImageUpload(w http.ResponseWriter, r *http.Request, imageId EventImageIdParam) {
+	var request ConfirmEventImageUploadRequestObject
 
-	request.Params = params
+	request.ImageId = imageId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAdminUsers(ctx, request.(GetAdminUsersRequestObject))
+		return sh.ssi.ConfirmEventImageUpload(ctx, request.(ConfirmEventImageUploadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAdminUsers")
+		handler = middleware(handler, "ConfirmEventImageUpload")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAdminUsersResponseObject); ok {
-		if err := validResponse.VisitGetAdminUsersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ConfirmEventImageUploadResponseObject); ok {
+		if err := validResponse.VisitConfirmEventImageUploadResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5796,30 +13098,25 @@ func (sh *strictHandler) GetAdminUsers(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// CreateAdminUser operation middleware
-func (sh *strictHandler) CreateAdminUser(w http.ResponseWriter, r *http.Request) {
-	var request CreateAdminUserRequestObject
+// GetEventImagesBySession operation middleware
+func (sh *strictHandler) GetEventImagesBySession(w http.ResponseWriter, r *http.Request, sessionId EventImageSessionIdParam) {
+	var request GetEventImagesBySessionRequestObject
 
-	var body CreateAdminUserJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.SessionId = sessionId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAdminUser(ctx, request.(CreateAdminUserRequestObject))
+		return sh.ssi.GetEventImagesBySession(ctx, request.(GetEventImagesBySessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAdminUser")
+		handler = middleware(handler, "GetEventImagesBySession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAdminUserResponseObject); ok {
-		if err := validResponse.VisitCreateAdminUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEventImagesBySessionResponseObject); ok {
+		if err := validResponse.VisitGetEventImagesBySessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5827,11 +13124,13 @@ func (sh *strictHandler) CreateAdminUser(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// CreateCertifierVehicle operation middleware
-func (sh *strictHandler) CreateCertifierVehicle(w http.ResponseWriter, r *http.Request) {
-	var request CreateCertifierVehicleRequestObject
+// GenerateEventImageUploadUrl operation middleware
+func (sh *strictHandler) GenerateEventImageUploadUrl(w http.ResponseWriter, r *http.Request, sessionId EventImageSessionIdParam) {
+	var request GenerateEventImageUploadUrlRequestObject
 
-	var body CreateCertifierVehicleJSONRequestBody
+	request.SessionId = sessionId
+
+	var body GenerateEventImageUploadUrlJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5839,18 +13138,18 @@ func (sh *strictHandler) CreateCertifierVehicle(w http.ResponseWriter, r *http.R
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCertifierVehicle(ctx, request.(CreateCertifierVehicleRequestObject))
+		return sh.ssi.GenerateEventImageUploadUrl(ctx, request.(GenerateEventImageUploadUrlRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCertifierVehicle")
+		handler = middleware(handler, "GenerateEventImageUploadUrl")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCertifierVehicleResponseObject); ok {
-		if err := validResponse.VisitCreateCertifierVehicleResponse(w); err != nil {
+	} else if validResponse, ok := response.(GenerateEventImageUploadUrlResponseObject); ok {
+		if err := validResponse.VisitGenerateEventImageUploadUrlResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5858,32 +13157,23 @@ func (sh *strictHandler) CreateCertifierVehicle(w http.ResponseWriter, r *http.R
 	}
 }
 
-// UpdateCertifierVehicle operation middleware
-func (sh *strictHandler) UpdateCertifierVehicle(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
-	var request UpdateCertifierVehicleRequestObject
-
-	request.VehicleId = vehicleId
-
-	var body UpdateCertifierVehicleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetEventMetadataSchemas operation middleware
+func (sh *strictHandler) GetEventMetadataSchemas(w http.ResponseWriter, r *http.Request) {
+	var request GetEventMetadataSchemasRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCertifierVehicle(ctx, request.(UpdateCertifierVehicleRequestObject))
+		return sh.ssi.GetEventMetadataSchemas(ctx, request.(GetEventMetadataSchemasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCertifierVehicle")
+		handler = middleware(handler, "GetEventMetadataSchemas")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCertifierVehicleResponseObject); ok {
-		if err := validResponse.VisitUpdateCertifierVehicleResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEventMetadataSchemasResponseObject); ok {
+		if err := validResponse.VisitGetEventMetadataSchemasResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5891,25 +13181,30 @@ func (sh *strictHandler) UpdateCertifierVehicle(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetCertifierVehicleInvitation operation middleware
-func (sh *strictHandler) GetCertifierVehicleInvitation(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
-	var request GetCertifierVehicleInvitationRequestObject
+// CreateEvent operation middleware
+func (sh *strictHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	var request CreateEventRequestObject
 
-	request.VehicleId = vehicleId
+	var body CreateEventJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCertifierVehicleInvitation(ctx, request.(GetCertifierVehicleInvitationRequestObject))
+		return sh.ssi.CreateEvent(ctx, request.(CreateEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCertifierVehicleInvitation")
+		handler = middleware(handler, "CreateEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCertifierVehicleInvitationResponseObject); ok {
-		if err := validResponse.VisitGetCertifierVehicleInvitationResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateEventResponseObject); ok {
+		if err := validResponse.VisitCreateEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5917,25 +13212,25 @@ func (sh *strictHandler) GetCertifierVehicleInvitation(w http.ResponseWriter, r
 	}
 }
 
-// GetEntities operation middleware
-func (sh *strictHandler) GetEntities(w http.ResponseWriter, r *http.Request, params GetEntitiesParams) {
-	var request GetEntitiesRequestObject
+// GetEvent operation middleware
+func (sh *strictHandler) GetEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request GetEventRequestObject
 
-	request.Params = params
+	request.EventId = eventId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEntities(ctx, request.(GetEntitiesRequestObject))
+		return sh.ssi.GetEvent(ctx, request.(GetEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEntities")
+		handler = middleware(handler, "GetEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEntitiesResponseObject); ok {
-		if err := validResponse.VisitGetEntitiesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEventResponseObject); ok {
+		if err := validResponse.VisitGetEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5943,30 +13238,25 @@ func (sh *strictHandler) GetEntities(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// CreateEntity operation middleware
-func (sh *strictHandler) CreateEntity(w http.ResponseWriter, r *http.Request) {
-	var request CreateEntityRequestObject
+// AcceptEvent operation middleware
+func (sh *strictHandler) AcceptEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request AcceptEventRequestObject
 
-	var body CreateEntityJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.EventId = eventId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateEntity(ctx, request.(CreateEntityRequestObject))
+		return sh.ssi.AcceptEvent(ctx, request.(AcceptEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateEntity")
+		handler = middleware(handler, "AcceptEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateEntityResponseObject); ok {
-		if err := validResponse.VisitCreateEntityResponse(w); err != nil {
+	} else if validResponse, ok := response.(AcceptEventResponseObject); ok {
+		if err := validResponse.VisitAcceptEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5974,25 +13264,32 @@ func (sh *strictHandler) CreateEntity(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteEntity operation middleware
-func (sh *strictHandler) DeleteEntity(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request DeleteEntityRequestObject
+// AmendEvent operation middleware
+func (sh *strictHandler) AmendEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request AmendEventRequestObject
 
-	request.EntityId = entityId
+	request.EventId = eventId
+
+	var body AmendEventJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteEntity(ctx, request.(DeleteEntityRequestObject))
+		return sh.ssi.AmendEvent(ctx, request.(AmendEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteEntity")
+		handler = middleware(handler, "AmendEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteEntityResponseObject); ok {
-		if err := validResponse.VisitDeleteEntityResponse(w); err != nil {
+	} else if validResponse, ok := response.(AmendEventResponseObject); ok {
+		if err := validResponse.VisitAmendEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6000,25 +13297,25 @@ func (sh *strictHandler) DeleteEntity(w http.ResponseWriter, r *http.Request, en
 	}
 }
 
-// GetEntity operation middleware
-func (sh *strictHandler) GetEntity(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request GetEntityRequestObject
+// GetEventCertificationRequests operation middleware
+func (sh *strictHandler) GetEventCertificationRequests(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request GetEventCertificationRequestsRequestObject
 
-	request.EntityId = entityId
+	request.EventId = eventId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEntity(ctx, request.(GetEntityRequestObject))
+		return sh.ssi.GetEventCertificationRequests(ctx, request.(GetEventCertificationRequestsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEntity")
+		handler = middleware(handler, "GetEventCertificationRequests")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEntityResponseObject); ok {
-		if err := validResponse.VisitGetEntityResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEventCertificationRequestsResponseObject); ok {
+		if err := validResponse.VisitGetEventCertificationRequestsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6026,13 +13323,13 @@ func (sh *strictHandler) GetEntity(w http.ResponseWriter, r *http.Request, entit
 	}
 }
 
-// UpdateEntity operation middleware
-func (sh *strictHandler) UpdateEntity(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request UpdateEntityRequestObject
+// RequestEventCertification operation middleware
+func (sh *strictHandler) RequestEventCertification(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request RequestEventCertificationRequestObject
 
-	request.EntityId = entityId
+	request.EventId = eventId
 
-	var body UpdateEntityJSONRequestBody
+	var body RequestEventCertificationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6040,18 +13337,18 @@ func (sh *strictHandler) UpdateEntity(w http.ResponseWriter, r *http.Request, en
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateEntity(ctx, request.(UpdateEntityRequestObject))
+		return sh.ssi.RequestEventCertification(ctx, request.(RequestEventCertificationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateEntity")
+		handler = middleware(handler, "RequestEventCertification")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateEntityResponseObject); ok {
-		if err := validResponse.VisitUpdateEntityResponse(w); err != nil {
+	} else if validResponse, ok := response.(RequestEventCertificationResponseObject); ok {
+		if err := validResponse.VisitRequestEventCertificationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6059,25 +13356,25 @@ func (sh *strictHandler) UpdateEntity(w http.ResponseWriter, r *http.Request, en
 	}
 }
 
-// DeleteEntityLogo operation middleware
-func (sh *strictHandler) DeleteEntityLogo(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request DeleteEntityLogoRequestObject
+// GetEventImages operation middleware
+func (sh *strictHandler) GetEventImages(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request GetEventImagesRequestObject
 
-	request.EntityId = entityId
+	request.EventId = eventId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteEntityLogo(ctx, request.(DeleteEntityLogoRequestObject))
+		return sh.ssi.GetEventImages(ctx, request.(GetEventImagesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteEntityLogo")
+		handler = middleware(handler, "GetEventImages")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteEntityLogoResponseObject); ok {
-		if err := validResponse.VisitDeleteEntityLogoResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetEventImagesResponseObject); ok {
+		if err := validResponse.VisitGetEventImagesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6085,32 +13382,25 @@ func (sh *strictHandler) DeleteEntityLogo(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GenerateEntityLogoUploadUrl operation middleware
-func (sh *strictHandler) GenerateEntityLogoUploadUrl(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request GenerateEntityLogoUploadUrlRequestObject
-
-	request.EntityId = entityId
+// RejectEvent operation middleware
+func (sh *strictHandler) RejectEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request RejectEventRequestObject
 
-	var body GenerateEntityLogoUploadUrlJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.EventId = eventId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GenerateEntityLogoUploadUrl(ctx, request.(GenerateEntityLogoUploadUrlRequestObject))
+		return sh.ssi.RejectEvent(ctx, request.(RejectEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GenerateEntityLogoUploadUrl")
+		handler = middleware(handler, "RejectEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GenerateEntityLogoUploadUrlResponseObject); ok {
-		if err := validResponse.VisitGenerateEntityLogoUploadUrlResponse(w); err != nil {
+	} else if validResponse, ok := response.(RejectEventResponseObject); ok {
+		if err := validResponse.VisitRejectEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6118,25 +13408,32 @@ func (sh *strictHandler) GenerateEntityLogoUploadUrl(w http.ResponseWriter, r *h
 	}
 }
 
-// GetEntityMembers operation middleware
-func (sh *strictHandler) GetEntityMembers(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request GetEntityMembersRequestObject
+// RevokeEvent operation middleware
+func (sh *strictHandler) RevokeEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request RevokeEventRequestObject
 
-	request.EntityId = entityId
+	request.EventId = eventId
+
+	var body RevokeEventJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEntityMembers(ctx, request.(GetEntityMembersRequestObject))
+		return sh.ssi.RevokeEvent(ctx, request.(RevokeEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEntityMembers")
+		handler = middleware(handler, "RevokeEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEntityMembersResponseObject); ok {
-		if err := validResponse.VisitGetEntityMembersResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokeEventResponseObject); ok {
+		if err := validResponse.VisitRevokeEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6144,13 +13441,13 @@ func (sh *strictHandler) GetEntityMembers(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// AddEntityMember operation middleware
-func (sh *strictHandler) AddEntityMember(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request AddEntityMemberRequestObject
+// SignEvent operation middleware
+func (sh *strictHandler) SignEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request SignEventRequestObject
 
-	request.EntityId = entityId
+	request.EventId = eventId
 
-	var body AddEntityMemberJSONRequestBody
+	var body SignEventJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6158,18 +13455,18 @@ func (sh *strictHandler) AddEntityMember(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddEntityMember(ctx, request.(AddEntityMemberRequestObject))
+		return sh.ssi.SignEvent(ctx, request.(SignEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddEntityMember")
+		handler = middleware(handler, "SignEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddEntityMemberResponseObject); ok {
-		if err := validResponse.VisitAddEntityMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(SignEventResponseObject); ok {
+		if err := validResponse.VisitSignEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6177,26 +13474,23 @@ func (sh *strictHandler) AddEntityMember(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// RemoveEntityMember operation middleware
-func (sh *strictHandler) RemoveEntityMember(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, userId UserIdParam) {
-	var request RemoveEntityMemberRequestObject
-
-	request.EntityId = entityId
-	request.UserId = userId
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveEntityMember(ctx, request.(RemoveEntityMemberRequestObject))
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveEntityMember")
+		handler = middleware(handler, "GetHealth")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RemoveEntityMemberResponseObject); ok {
-		if err := validResponse.VisitRemoveEntityMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6204,33 +13498,23 @@ func (sh *strictHandler) RemoveEntityMember(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// UpdateEntityMemberRole operation middleware
-func (sh *strictHandler) UpdateEntityMemberRole(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, userId UserIdParam) {
-	var request UpdateEntityMemberRoleRequestObject
-
-	request.EntityId = entityId
-	request.UserId = userId
-
-	var body UpdateEntityMemberRoleJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// ClaimInvitations operation middleware
+func (sh *strictHandler) ClaimInvitations(w http.ResponseWriter, r *http.Request) {
+	var request ClaimInvitationsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateEntityMemberRole(ctx, request.(UpdateEntityMemberRoleRequestObject))
+		return sh.ssi.ClaimInvitations(ctx, request.(ClaimInvitationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateEntityMemberRole")
+		handler = middleware(handler, "ClaimInvitations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateEntityMemberRoleResponseObject); ok {
-		if err := validResponse.VisitUpdateEntityMemberRoleResponse(w); err != nil {
+	} else if validResponse, ok := response.(ClaimInvitationsResponseObject); ok {
+		if err := validResponse.VisitClaimInvitationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6238,26 +13522,25 @@ func (sh *strictHandler) UpdateEntityMemberRole(w http.ResponseWriter, r *http.R
 	}
 }
 
-// ListEntityOAuth2Clients operation middleware
-func (sh *strictHandler) ListEntityOAuth2Clients(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, params ListEntityOAuth2ClientsParams) {
-	var request ListEntityOAuth2ClientsRequestObject
+// ValidateInvitation operation middleware
+func (sh *strictHandler) ValidateInvitation(w http.ResponseWriter, r *http.Request, params ValidateInvitationParams) {
+	var request ValidateInvitationRequestObject
 
-	request.EntityId = entityId
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListEntityOAuth2Clients(ctx, request.(ListEntityOAuth2ClientsRequestObject))
+		return sh.ssi.ValidateInvitation(ctx, request.(ValidateInvitationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListEntityOAuth2Clients")
+		handler = middleware(handler, "ValidateInvitation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListEntityOAuth2ClientsResponseObject); ok {
-		if err := validResponse.VisitListEntityOAuth2ClientsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ValidateInvitationResponseObject); ok {
+		if err := validResponse.VisitValidateInvitationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6265,32 +13548,23 @@ func (sh *strictHandler) ListEntityOAuth2Clients(w http.ResponseWriter, r *http.
 	}
 }
 
-// CreateEntityOAuth2Client operation middleware
-func (sh *strictHandler) CreateEntityOAuth2Client(w http.ResponseWriter, r *http.Request, entityId EntityIdParam) {
-	var request CreateEntityOAuth2ClientRequestObject
-
-	request.EntityId = entityId
-
-	var body CreateEntityOAuth2ClientJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetMe operation middleware
+func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	var request GetMeRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateEntityOAuth2Client(ctx, request.(CreateEntityOAuth2ClientRequestObject))
+		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateEntityOAuth2Client")
+		handler = middleware(handler, "GetMe")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateEntityOAuth2ClientResponseObject); ok {
-		if err := validResponse.VisitCreateEntityOAuth2ClientResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMeResponseObject); ok {
+		if err := validResponse.VisitGetMeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6298,26 +13572,23 @@ func (sh *strictHandler) CreateEntityOAuth2Client(w http.ResponseWriter, r *http
 	}
 }
 
-// DeleteEntityOAuth2Client operation middleware
-func (sh *strictHandler) DeleteEntityOAuth2Client(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, clientId ClientIdParam) {
-	var request DeleteEntityOAuth2ClientRequestObject
-
-	request.EntityId = entityId
-	request.ClientId = clientId
+// UnlinkMyWallet operation middleware
+func (sh *strictHandler) UnlinkMyWallet(w http.ResponseWriter, r *http.Request) {
+	var request UnlinkMyWalletRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteEntityOAuth2Client(ctx, request.(DeleteEntityOAuth2ClientRequestObject))
+		return sh.ssi.UnlinkMyWallet(ctx, request.(UnlinkMyWalletRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteEntityOAuth2Client")
+		handler = middleware(handler, "UnlinkMyWallet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteEntityOAuth2ClientResponseObject); ok {
-		if err := validResponse.VisitDeleteEntityOAuth2ClientResponse(w); err != nil {
+	} else if validResponse, ok := response.(UnlinkMyWalletResponseObject); ok {
+		if err := validResponse.VisitUnlinkMyWalletResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6325,26 +13596,23 @@ func (sh *strictHandler) DeleteEntityOAuth2Client(w http.ResponseWriter, r *http
 	}
 }
 
-// GetEntityOAuth2Client operation middleware
-func (sh *strictHandler) GetEntityOAuth2Client(w http.ResponseWriter, r *http.Request, entityId EntityIdParam, clientId ClientIdParam) {
-	var request GetEntityOAuth2ClientRequestObject
-
-	request.EntityId = entityId
-	request.ClientId = clientId
+// GetMyWallet operation middleware
+func (sh *strictHandler) GetMyWallet(w http.ResponseWriter, r *http.Request) {
+	var request GetMyWalletRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEntityOAuth2Client(ctx, request.(GetEntityOAuth2ClientRequestObject))
+		return sh.ssi.GetMyWallet(ctx, request.(GetMyWalletRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEntityOAuth2Client")
+		handler = middleware(handler, "GetMyWallet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEntityOAuth2ClientResponseObject); ok {
-		if err := validResponse.VisitGetEntityOAuth2ClientResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMyWalletResponseObject); ok {
+		if err := validResponse.VisitGetMyWalletResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6352,23 +13620,30 @@ func (sh *strictHandler) GetEntityOAuth2Client(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// CreateEventImageUploadSession operation middleware
-func (sh *strictHandler) CreateEventImageUploadSession(w http.ResponseWriter, r *http.Request) {
-	var request CreateEventImageUploadSessionRequestObject
+// LinkMyWallet operation middleware
+func (sh *strictHandler) LinkMyWallet(w http.ResponseWriter, r *http.Request) {
+	var request LinkMyWalletRequestObject
+
+	var body LinkMyWalletJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateEventImageUploadSession(ctx, request.(CreateEventImageUploadSessionRequestObject))
+		return sh.ssi.LinkMyWallet(ctx, request.(LinkMyWalletRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateEventImageUploadSession")
+		handler = middleware(handler, "LinkMyWallet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateEventImageUploadSessionResponseObject); ok {
-		if err := validResponse.VisitCreateEventImageUploadSessionResponse(w); err != nil {
+	} else if validResponse, ok := response.(LinkMyWalletResponseObject); ok {
+		if err := validResponse.VisitLinkMyWalletResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6376,25 +13651,30 @@ func (sh *strictHandler) CreateEventImageUploadSession(w http.ResponseWriter, r
 	}
 }
 
-// DeleteEventImage operation middleware
-func (sh *strictHandler) DeleteEventImage(w http.ResponseWriter, r *http.Request, imageId EventImageIdParam) {
-	var request DeleteEventImageRequestObject
+// CreateWalletChallenge operation middleware
+func (sh *strictHandler) CreateWalletChallenge(w http.ResponseWriter, r *http.Request) {
+	var request CreateWalletChallengeRequestObject
 
-	request.ImageId = imageId
+	var body CreateWalletChallengeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteEventImage(ctx, request.(DeleteEventImageRequestObject))
+		return sh.ssi.CreateWalletChallenge(ctx, request.(CreateWalletChallengeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteEventImage")
+		handler = middleware(handler, "CreateWalletChallenge")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteEventImageResponseObject); ok {
-		if err := validResponse.VisitDeleteEventImageResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWalletChallengeResponseObject); ok {
+		if err := validResponse.VisitCreateWalletChallengeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6402,25 +13682,25 @@ func (sh *strictHandler) DeleteEventImage(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ConfirmEventImageUpload operation middleware
-func (sh *strictHandler) ConfirmEventImageUpload(w http.ResponseWriter, r *http.Request, imageId EventImageIdParam) {
-	var request ConfirmEventImageUploadRequestObject
+// GetVehicleAssetMetadata operation middleware
+func (sh *strictHandler) GetVehicleAssetMetadata(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleAssetMetadataRequestObject
 
-	request.ImageId = imageId
+	request.VehicleId = vehicleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ConfirmEventImageUpload(ctx, request.(ConfirmEventImageUploadRequestObject))
+		return sh.ssi.GetVehicleAssetMetadata(ctx, request.(GetVehicleAssetMetadataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ConfirmEventImageUpload")
+		handler = middleware(handler, "GetVehicleAssetMetadata")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ConfirmEventImageUploadResponseObject); ok {
-		if err := validResponse.VisitConfirmEventImageUploadResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVehicleAssetMetadataResponseObject); ok {
+		if err := validResponse.VisitGetVehicleAssetMetadataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6428,25 +13708,25 @@ func (sh *strictHandler) ConfirmEventImageUpload(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetEventImagesBySession operation middleware
-func (sh *strictHandler) GetEventImagesBySession(w http.ResponseWriter, r *http.Request, sessionId EventImageSessionIdParam) {
-	var request GetEventImagesBySessionRequestObject
+// GetPublicEntities operation middleware
+func (sh *strictHandler) GetPublicEntities(w http.ResponseWriter, r *http.Request, params GetPublicEntitiesParams) {
+	var request GetPublicEntitiesRequestObject
 
-	request.SessionId = sessionId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEventImagesBySession(ctx, request.(GetEventImagesBySessionRequestObject))
+		return sh.ssi.GetPublicEntities(ctx, request.(GetPublicEntitiesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEventImagesBySession")
+		handler = middleware(handler, "GetPublicEntities")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEventImagesBySessionResponseObject); ok {
-		if err := validResponse.VisitGetEventImagesBySessionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPublicEntitiesResponseObject); ok {
+		if err := validResponse.VisitGetPublicEntitiesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6454,32 +13734,23 @@ func (sh *strictHandler) GetEventImagesBySession(w http.ResponseWriter, r *http.
 	}
 }
 
-// GenerateEventImageUploadUrl operation middleware
-func (sh *strictHandler) GenerateEventImageUploadUrl(w http.ResponseWriter, r *http.Request, sessionId EventImageSessionIdParam) {
-	var request GenerateEventImageUploadUrlRequestObject
-
-	request.SessionId = sessionId
-
-	var body GenerateEventImageUploadUrlJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetPublicEventTypes operation middleware
+func (sh *strictHandler) GetPublicEventTypes(w http.ResponseWriter, r *http.Request) {
+	var request GetPublicEventTypesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GenerateEventImageUploadUrl(ctx, request.(GenerateEventImageUploadUrlRequestObject))
+		return sh.ssi.GetPublicEventTypes(ctx, request.(GetPublicEventTypesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GenerateEventImageUploadUrl")
+		handler = middleware(handler, "GetPublicEventTypes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GenerateEventImageUploadUrlResponseObject); ok {
-		if err := validResponse.VisitGenerateEventImageUploadUrlResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPublicEventTypesResponseObject); ok {
+		if err := validResponse.VisitGetPublicEventTypesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6487,30 +13758,25 @@ func (sh *strictHandler) GenerateEventImageUploadUrl(w http.ResponseWriter, r *h
 	}
 }
 
-// CreateEvent operation middleware
-func (sh *strictHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
-	var request CreateEventRequestObject
+// GetVehiclePassport operation middleware
+func (sh *strictHandler) GetVehiclePassport(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehiclePassportRequestObject
 
-	var body CreateEventJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.VehicleId = vehicleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateEvent(ctx, request.(CreateEventRequestObject))
+		return sh.ssi.GetVehiclePassport(ctx, request.(GetVehiclePassportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateEvent")
+		handler = middleware(handler, "GetVehiclePassport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateEventResponseObject); ok {
-		if err := validResponse.VisitCreateEventResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVehiclePassportResponseObject); ok {
+		if err := validResponse.VisitGetVehiclePassportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6518,25 +13784,25 @@ func (sh *strictHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetEvent operation middleware
-func (sh *strictHandler) GetEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
-	var request GetEventRequestObject
+// GetSigningKey operation middleware
+func (sh *strictHandler) GetSigningKey(w http.ResponseWriter, r *http.Request, keyId SigningKeyIdParam) {
+	var request GetSigningKeyRequestObject
 
-	request.EventId = eventId
+	request.KeyId = keyId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEvent(ctx, request.(GetEventRequestObject))
+		return sh.ssi.GetSigningKey(ctx, request.(GetSigningKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEvent")
+		handler = middleware(handler, "GetSigningKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEventResponseObject); ok {
-		if err := validResponse.VisitGetEventResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSigningKeyResponseObject); ok {
+		if err := validResponse.VisitGetSigningKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6544,25 +13810,25 @@ func (sh *strictHandler) GetEvent(w http.ResponseWriter, r *http.Request, eventI
 	}
 }
 
-// GetEventImages operation middleware
-func (sh *strictHandler) GetEventImages(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
-	var request GetEventImagesRequestObject
+// LookupStolenVehicle operation middleware
+func (sh *strictHandler) LookupStolenVehicle(w http.ResponseWriter, r *http.Request, params LookupStolenVehicleParams) {
+	var request LookupStolenVehicleRequestObject
 
-	request.EventId = eventId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetEventImages(ctx, request.(GetEventImagesRequestObject))
+		return sh.ssi.LookupStolenVehicle(ctx, request.(LookupStolenVehicleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetEventImages")
+		handler = middleware(handler, "LookupStolenVehicle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetEventImagesResponseObject); ok {
-		if err := validResponse.VisitGetEventImagesResponse(w); err != nil {
+	} else if validResponse, ok := response.(LookupStolenVehicleResponseObject); ok {
+		if err := validResponse.VisitLookupStolenVehicleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6570,23 +13836,25 @@ func (sh *strictHandler) GetEventImages(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// GetOwnershipTransferByToken operation middleware
+func (sh *strictHandler) GetOwnershipTransferByToken(w http.ResponseWriter, r *http.Request, token TransferTokenParam) {
+	var request GetOwnershipTransferByTokenRequestObject
+
+	request.Token = token
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.GetOwnershipTransferByToken(ctx, request.(GetOwnershipTransferByTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "GetOwnershipTransferByToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOwnershipTransferByTokenResponseObject); ok {
+		if err := validResponse.VisitGetOwnershipTransferByTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6594,23 +13862,25 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ClaimInvitations operation middleware
-func (sh *strictHandler) ClaimInvitations(w http.ResponseWriter, r *http.Request) {
-	var request ClaimInvitationsRequestObject
+// VerifyEvent operation middleware
+func (sh *strictHandler) VerifyEvent(w http.ResponseWriter, r *http.Request, eventId EventIdParam) {
+	var request VerifyEventRequestObject
+
+	request.EventId = eventId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ClaimInvitations(ctx, request.(ClaimInvitationsRequestObject))
+		return sh.ssi.VerifyEvent(ctx, request.(VerifyEventRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ClaimInvitations")
+		handler = middleware(handler, "VerifyEvent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ClaimInvitationsResponseObject); ok {
-		if err := validResponse.VisitClaimInvitationsResponse(w); err != nil {
+	} else if validResponse, ok := response.(VerifyEventResponseObject); ok {
+		if err := validResponse.VisitVerifyEventResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6618,25 +13888,25 @@ func (sh *strictHandler) ClaimInvitations(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ValidateInvitation operation middleware
-func (sh *strictHandler) ValidateInvitation(w http.ResponseWriter, r *http.Request, params ValidateInvitationParams) {
-	var request ValidateInvitationRequestObject
+// VerifyVehicle operation middleware
+func (sh *strictHandler) VerifyVehicle(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request VerifyVehicleRequestObject
 
-	request.Params = params
+	request.VehicleId = vehicleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ValidateInvitation(ctx, request.(ValidateInvitationRequestObject))
+		return sh.ssi.VerifyVehicle(ctx, request.(VerifyVehicleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ValidateInvitation")
+		handler = middleware(handler, "VerifyVehicle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ValidateInvitationResponseObject); ok {
-		if err := validResponse.VisitValidateInvitationResponse(w); err != nil {
+	} else if validResponse, ok := response.(VerifyVehicleResponseObject); ok {
+		if err := validResponse.VisitVerifyVehicleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6644,23 +13914,25 @@ func (sh *strictHandler) ValidateInvitation(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetMe operation middleware
-func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
-	var request GetMeRequestObject
+// VerifyVehicleChain operation middleware
+func (sh *strictHandler) VerifyVehicleChain(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request VerifyVehicleChainRequestObject
+
+	request.VehicleId = vehicleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+		return sh.ssi.VerifyVehicleChain(ctx, request.(VerifyVehicleChainRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMe")
+		handler = middleware(handler, "VerifyVehicleChain")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMeResponseObject); ok {
-		if err := validResponse.VisitGetMeResponse(w); err != nil {
+	} else if validResponse, ok := response.(VerifyVehicleChainResponseObject); ok {
+		if err := validResponse.VisitVerifyVehicleChainResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6668,25 +13940,25 @@ func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetPublicEntities operation middleware
-func (sh *strictHandler) GetPublicEntities(w http.ResponseWriter, r *http.Request, params GetPublicEntitiesParams) {
-	var request GetPublicEntitiesRequestObject
+// GetSharedVehicle operation middleware
+func (sh *strictHandler) GetSharedVehicle(w http.ResponseWriter, r *http.Request, token ShareTokenParam) {
+	var request GetSharedVehicleRequestObject
 
-	request.Params = params
+	request.Token = token
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPublicEntities(ctx, request.(GetPublicEntitiesRequestObject))
+		return sh.ssi.GetSharedVehicle(ctx, request.(GetSharedVehicleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPublicEntities")
+		handler = middleware(handler, "GetSharedVehicle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPublicEntitiesResponseObject); ok {
-		if err := validResponse.VisitGetPublicEntitiesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSharedVehicleResponseObject); ok {
+		if err := validResponse.VisitGetSharedVehicleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6694,25 +13966,25 @@ func (sh *strictHandler) GetPublicEntities(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetVehiclePassport operation middleware
-func (sh *strictHandler) GetVehiclePassport(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
-	var request GetVehiclePassportRequestObject
+// LookupStolenVehicleAuthenticated operation middleware
+func (sh *strictHandler) LookupStolenVehicleAuthenticated(w http.ResponseWriter, r *http.Request, params LookupStolenVehicleAuthenticatedParams) {
+	var request LookupStolenVehicleAuthenticatedRequestObject
 
-	request.VehicleId = vehicleId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetVehiclePassport(ctx, request.(GetVehiclePassportRequestObject))
+		return sh.ssi.LookupStolenVehicleAuthenticated(ctx, request.(LookupStolenVehicleAuthenticatedRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetVehiclePassport")
+		handler = middleware(handler, "LookupStolenVehicleAuthenticated")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetVehiclePassportResponseObject); ok {
-		if err := validResponse.VisitGetVehiclePassportResponse(w); err != nil {
+	} else if validResponse, ok := response.(LookupStolenVehicleAuthenticatedResponseObject); ok {
+		if err := validResponse.VisitLookupStolenVehicleAuthenticatedResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6720,25 +13992,25 @@ func (sh *strictHandler) GetVehiclePassport(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetSharedVehicle operation middleware
-func (sh *strictHandler) GetSharedVehicle(w http.ResponseWriter, r *http.Request, token ShareTokenParam) {
-	var request GetSharedVehicleRequestObject
+// AcceptOwnershipTransfer operation middleware
+func (sh *strictHandler) AcceptOwnershipTransfer(w http.ResponseWriter, r *http.Request, token TransferTokenParam) {
+	var request AcceptOwnershipTransferRequestObject
 
 	request.Token = token
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSharedVehicle(ctx, request.(GetSharedVehicleRequestObject))
+		return sh.ssi.AcceptOwnershipTransfer(ctx, request.(AcceptOwnershipTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSharedVehicle")
+		handler = middleware(handler, "AcceptOwnershipTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSharedVehicleResponseObject); ok {
-		if err := validResponse.VisitGetSharedVehicleResponse(w); err != nil {
+	} else if validResponse, ok := response.(AcceptOwnershipTransferResponseObject); ok {
+		if err := validResponse.VisitAcceptOwnershipTransferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6862,6 +14134,84 @@ func (sh *strictHandler) UpdateVehicle(w http.ResponseWriter, r *http.Request, v
 	}
 }
 
+// GetVehicleAnchors operation middleware
+func (sh *strictHandler) GetVehicleAnchors(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleAnchorsRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVehicleAnchors(ctx, request.(GetVehicleAnchorsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVehicleAnchors")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVehicleAnchorsResponseObject); ok {
+		if err := validResponse.VisitGetVehicleAnchorsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVehicleCustody operation middleware
+func (sh *strictHandler) GetVehicleCustody(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleCustodyRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVehicleCustody(ctx, request.(GetVehicleCustodyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVehicleCustody")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVehicleCustodyResponseObject); ok {
+		if err := validResponse.VisitGetVehicleCustodyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestVehicleAssetDelivery operation middleware
+func (sh *strictHandler) RequestVehicleAssetDelivery(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request RequestVehicleAssetDeliveryRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestVehicleAssetDelivery(ctx, request.(RequestVehicleAssetDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestVehicleAssetDelivery")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestVehicleAssetDeliveryResponseObject); ok {
+		if err := validResponse.VisitRequestVehicleAssetDeliveryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetVehicleDocuments operation middleware
 func (sh *strictHandler) GetVehicleDocuments(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
 	var request GetVehicleDocumentsRequestObject
@@ -7035,6 +14385,91 @@ func (sh *strictHandler) CreateOwnerEvent(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// GetVehicleLifecycleRequests operation middleware
+func (sh *strictHandler) GetVehicleLifecycleRequests(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleLifecycleRequestsRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVehicleLifecycleRequests(ctx, request.(GetVehicleLifecycleRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVehicleLifecycleRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVehicleLifecycleRequestsResponseObject); ok {
+		if err := validResponse.VisitGetVehicleLifecycleRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestVehicleLifecycleChange operation middleware
+func (sh *strictHandler) RequestVehicleLifecycleChange(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request RequestVehicleLifecycleChangeRequestObject
+
+	request.VehicleId = vehicleId
+
+	var body RequestVehicleLifecycleChangeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestVehicleLifecycleChange(ctx, request.(RequestVehicleLifecycleChangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestVehicleLifecycleChange")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestVehicleLifecycleChangeResponseObject); ok {
+		if err := validResponse.VisitRequestVehicleLifecycleChangeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVehicleOwners operation middleware
+func (sh *strictHandler) GetVehicleOwners(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleOwnersRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVehicleOwners(ctx, request.(GetVehicleOwnersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVehicleOwners")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVehicleOwnersResponseObject); ok {
+		if err := validResponse.VisitGetVehicleOwnersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetVehiclePhotos operation middleware
 func (sh *strictHandler) GetVehiclePhotos(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
 	var request GetVehiclePhotosRequestObject
@@ -7233,3 +14668,174 @@ func (sh *strictHandler) RevokeShareLink(w http.ResponseWriter, r *http.Request,
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetVehicleStolenReports operation middleware
+func (sh *strictHandler) GetVehicleStolenReports(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleStolenReportsRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVehicleStolenReports(ctx, request.(GetVehicleStolenReportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVehicleStolenReports")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVehicleStolenReportsResponseObject); ok {
+		if err := validResponse.VisitGetVehicleStolenReportsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FileStolenReport operation middleware
+func (sh *strictHandler) FileStolenReport(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request FileStolenReportRequestObject
+
+	request.VehicleId = vehicleId
+
+	var body FileStolenReportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FileStolenReport(ctx, request.(FileStolenReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FileStolenReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FileStolenReportResponseObject); ok {
+		if err := validResponse.VisitFileStolenReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVehicleTransfers operation middleware
+func (sh *strictHandler) GetVehicleTransfers(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleTransfersRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVehicleTransfers(ctx, request.(GetVehicleTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVehicleTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVehicleTransfersResponseObject); ok {
+		if err := validResponse.VisitGetVehicleTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InitiateVehicleTransfer operation middleware
+func (sh *strictHandler) InitiateVehicleTransfer(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request InitiateVehicleTransferRequestObject
+
+	request.VehicleId = vehicleId
+
+	var body InitiateVehicleTransferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InitiateVehicleTransfer(ctx, request.(InitiateVehicleTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InitiateVehicleTransfer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InitiateVehicleTransferResponseObject); ok {
+		if err := validResponse.VisitInitiateVehicleTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelVehicleTransfer operation middleware
+func (sh *strictHandler) CancelVehicleTransfer(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam, transferId TransferIdParam) {
+	var request CancelVehicleTransferRequestObject
+
+	request.VehicleId = vehicleId
+	request.TransferId = transferId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelVehicleTransfer(ctx, request.(CancelVehicleTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelVehicleTransfer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelVehicleTransferResponseObject); ok {
+		if err := validResponse.VisitCancelVehicleTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVehicleVersions operation middleware
+func (sh *strictHandler) GetVehicleVersions(w http.ResponseWriter, r *http.Request, vehicleId VehicleIdParam) {
+	var request GetVehicleVersionsRequestObject
+
+	request.VehicleId = vehicleId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVehicleVersions(ctx, request.(GetVehicleVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVehicleVersions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVehicleVersionsResponseObject); ok {
+		if err := validResponse.VisitGetVehicleVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
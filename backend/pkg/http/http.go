@@ -10,16 +10,25 @@ import (
 	"time"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchors"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/certification"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/custody"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/documents"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_images"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_types"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/photos"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/share_links"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/signing_keys"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/stolen_reports"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/transfer"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/user"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/user_invitation"
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/verification"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/kratos"
 	"github.com/google/uuid"
 	"github.com/rs/cors"
@@ -32,6 +41,8 @@ type Config struct {
 	IdleTimeout    time.Duration
 	MaxHeaderBytes int
 	CORS           CORSConfig
+	// LookupRateLimit applies to the public stolen vehicle lookup
+	LookupRateLimit RateLimitConfig
 }
 
 type CORSConfig struct {
@@ -65,7 +76,7 @@ func createResponseErrorHandler() func(w http.ResponseWriter, r *http.Request, e
 }
 
 // New creates a new HTTP server with the API server as its handler.
-func New(cfg Config, entityService *entity.Service, eventService *event.Service, vehicleService *vehicles.Service, photoService *photos.Service, documentService *documents.Service, shareLinksService *share_links.Service, userService *user.Service, invitationService *invitation.Service, userInvitationService *user_invitation.Service, eventImageService *event_images.Service, kratosClient *kratos.Client, authMiddleware *auth.Middleware, authorizer *auth.Authorizer) *http.Server {
+func New(cfg Config, entityService *entity.Service, eventService *event.Service, vehicleService *vehicles.Service, photoService *photos.Service, documentService *documents.Service, shareLinksService *share_links.Service, userService *user.Service, invitationService *invitation.Service, userInvitationService *user_invitation.Service, eventImageService *event_images.Service, verificationService *verification.Service, transferService *transfer.Service, certificationService *certification.Service, certificationTracker *certification.Tracker, eventTypeService *event_types.Service, anchorService *anchors.Service, signingKeyService *signing_keys.Service, custodyService *custody.Service, lifecycleService *lifecycle.Service, stolenReportService *stolen_reports.Service, kratosClient *kratos.Client, authMiddleware *auth.Middleware, authorizer *auth.Authorizer) *http.Server {
 	server := &apiServer{
 		entityService:         entityService,
 		eventService:          eventService,
@@ -77,6 +88,16 @@ func New(cfg Config, entityService *entity.Service, eventService *event.Service,
 		invitationService:     invitationService,
 		userInvitationService: userInvitationService,
 		eventImageService:     eventImageService,
+		verificationService:   verificationService,
+		transferService:       transferService,
+		certificationService:  certificationService,
+		certificationTracker:  certificationTracker,
+		eventTypeService:      eventTypeService,
+		anchorService:         anchorService,
+		signingKeyService:     signingKeyService,
+		custodyService:        custodyService,
+		lifecycleService:      lifecycleService,
+		stolenReportService:   stolenReportService,
 		kratosClient:          kratosClient,
 		authorizer:            authorizer,
 	}
@@ -101,6 +122,7 @@ func New(cfg Config, entityService *entity.Service, eventService *event.Service,
 
 	// Public endpoints (no auth middleware)
 	rootMux.Handle("/v1/public/", http.StripPrefix("/v1", LoggingMiddleware(handler)))
+	rootMux.Handle("/v1/public/stolen-vehicles/lookup", http.StripPrefix("/v1", RateLimitMiddleware(cfg.LookupRateLimit, LoggingMiddleware(handler))))
 	rootMux.Handle("/v1/shared/", http.StripPrefix("/v1", LoggingMiddleware(handler)))
 	rootMux.Handle("/v1/invitations/validate", http.StripPrefix("/v1", LoggingMiddleware(handler)))
 	rootMux.Handle("/v1/admin-invitations/", http.StripPrefix("/v1", LoggingMiddleware(handler)))
@@ -142,6 +164,16 @@ type apiServer struct {
 	invitationService     *invitation.Service
 	userInvitationService *user_invitation.Service
 	eventImageService     *event_images.Service
+	verificationService   *verification.Service
+	transferService       *transfer.Service
+	certificationService  *certification.Service
+	certificationTracker  *certification.Tracker
+	eventTypeService      *event_types.Service
+	anchorService         *anchors.Service
+	signingKeyService     *signing_keys.Service
+	custodyService        *custody.Service
+	lifecycleService      *lifecycle.Service
+	stolenReportService   *stolen_reports.Service
 	kratosClient          *kratos.Client
 	authorizer            *auth.Authorizer
 }
@@ -0,0 +1,388 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/google/uuid"
+)
+
+// authorizeLifecycleReviewer checks that the current user may decide lifecycle requests on behalf of the entity
+func (a apiServer) authorizeLifecycleReviewer(ctx context.Context, entityID uuid.UUID) error {
+	if err := a.authorizer.Authorize(ctx, ResourceEvents, ActionCreate); err != nil {
+		return err
+	}
+	return a.authorizer.AuthorizeEntityMembership(ctx, entityID, "")
+}
+
+func (a apiServer) GetVehicleLifecycleRequests(ctx context.Context, request GetVehicleLifecycleRequestsRequestObject) (GetVehicleLifecycleRequestsResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return GetVehicleLifecycleRequests404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !isVehicleOwner(ctx, vehicle) {
+		if err := a.authorizer.Authorize(ctx, ResourceLifecycle, ActionRead); err != nil {
+			return GetVehicleLifecycleRequests403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: "Forbidden: You don't have permission to access this vehicle's lifecycle requests",
+				},
+			}, nil
+		}
+	}
+
+	requests, err := a.lifecycleService.ListByVehicle(ctx, vehicle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetVehicleLifecycleRequests200JSONResponse(domainLifecycleRequestsToHTTP(requests)), nil
+}
+
+func (a apiServer) RequestVehicleLifecycleChange(ctx context.Context, request RequestVehicleLifecycleChangeRequestObject) (RequestVehicleLifecycleChangeResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return RequestVehicleLifecycleChange401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+	if request.Body == nil {
+		return RequestVehicleLifecycleChange400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return RequestVehicleLifecycleChange404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !isVehicleOwner(ctx, vehicle) {
+		return RequestVehicleLifecycleChange403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: only the vehicle owner can request a lifecycle change",
+			},
+		}, nil
+	}
+
+	created, err := a.lifecycleService.Submit(ctx, *vehicle, lifecycle.SubmitParams{
+		ToStatus:    string(request.Body.ToStatus),
+		Reason:      request.Body.Reason,
+		RequestedBy: identityID,
+		EntityID:    request.Body.EntityId,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrEntityNotFound):
+			return RequestVehicleLifecycleChange404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Entity not found",
+				},
+			}, nil
+		case errors.Is(err, lifecycle.ErrInvalidTransition),
+			errors.Is(err, lifecycle.ErrReasonRequired),
+			errors.Is(err, lifecycle.ErrNotCertifier):
+			return RequestVehicleLifecycleChange400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, lifecycle.ErrRequestAlreadyPending):
+			return RequestVehicleLifecycleChange409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RequestVehicleLifecycleChange201JSONResponse(domainLifecycleRequestToHTTP(*created)), nil
+}
+
+func (a apiServer) GetAdminLifecycleRequests(ctx context.Context, request GetAdminLifecycleRequestsRequestObject) (GetAdminLifecycleRequestsResponseObject, error) {
+	if err := a.authorizer.Authorize(ctx, ResourceLifecycle, ActionRead); err != nil {
+		return GetAdminLifecycleRequests403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	status := ""
+	if request.Params.Status != nil {
+		status = string(*request.Params.Status)
+	}
+
+	requests, err := a.lifecycleService.List(ctx, nil, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetAdminLifecycleRequests200JSONResponse(domainLifecycleRequestsToHTTP(requests)), nil
+}
+
+func (a apiServer) ApproveAdminLifecycleRequest(ctx context.Context, request ApproveAdminLifecycleRequestRequestObject) (ApproveAdminLifecycleRequestResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return ApproveAdminLifecycleRequest401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+	if err := a.authorizer.Authorize(ctx, ResourceLifecycle, ActionUpdate); err != nil {
+		return ApproveAdminLifecycleRequest403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	approved, err := a.lifecycleService.Approve(ctx, nil, request.RequestId, identityID)
+	if err != nil {
+		switch {
+		case errors.Is(err, lifecycle.ErrRequestNotFound):
+			return ApproveAdminLifecycleRequest404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Lifecycle request not found",
+				},
+			}, nil
+		case errors.Is(err, lifecycle.ErrRequestNotPending), errors.Is(err, vehicles.ErrLifecycleStatusChanged):
+			return ApproveAdminLifecycleRequest409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return ApproveAdminLifecycleRequest200JSONResponse(domainLifecycleRequestToHTTP(*approved)), nil
+}
+
+func (a apiServer) RejectAdminLifecycleRequest(ctx context.Context, request RejectAdminLifecycleRequestRequestObject) (RejectAdminLifecycleRequestResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return RejectAdminLifecycleRequest401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+	if err := a.authorizer.Authorize(ctx, ResourceLifecycle, ActionUpdate); err != nil {
+		return RejectAdminLifecycleRequest403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	var reason *string
+	if request.Body != nil {
+		reason = request.Body.Reason
+	}
+
+	rejected, err := a.lifecycleService.Reject(ctx, nil, request.RequestId, identityID, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, lifecycle.ErrRequestNotFound):
+			return RejectAdminLifecycleRequest404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Lifecycle request not found",
+				},
+			}, nil
+		case errors.Is(err, lifecycle.ErrRequestNotPending):
+			return RejectAdminLifecycleRequest409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RejectAdminLifecycleRequest200JSONResponse(domainLifecycleRequestToHTTP(*rejected)), nil
+}
+
+func (a apiServer) GetEntityLifecycleRequests(ctx context.Context, request GetEntityLifecycleRequestsRequestObject) (GetEntityLifecycleRequestsResponseObject, error) {
+	if err := a.authorizer.AuthorizeEntityMembership(ctx, request.EntityId, ""); err != nil {
+		return GetEntityLifecycleRequests403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to review its lifecycle requests",
+			},
+		}, nil
+	}
+
+	status := ""
+	if request.Params.Status != nil {
+		status = string(*request.Params.Status)
+	}
+
+	requests, err := a.lifecycleService.List(ctx, &request.EntityId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetEntityLifecycleRequests200JSONResponse(domainLifecycleRequestsToHTTP(requests)), nil
+}
+
+func (a apiServer) ApproveEntityLifecycleRequest(ctx context.Context, request ApproveEntityLifecycleRequestRequestObject) (ApproveEntityLifecycleRequestResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return ApproveEntityLifecycleRequest401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+
+	if err := a.authorizeLifecycleReviewer(ctx, request.EntityId); err != nil {
+		return ApproveEntityLifecycleRequest403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to approve its lifecycle requests",
+			},
+		}, nil
+	}
+
+	approved, err := a.lifecycleService.Approve(ctx, &request.EntityId, request.RequestId, identityID)
+	if err != nil {
+		if resp, ok := metadataValidationResponse(err); ok {
+			return ApproveEntityLifecycleRequest400JSONResponse{BadRequestJSONResponse: resp}, nil
+		}
+		switch {
+		case errors.Is(err, lifecycle.ErrRequestNotFound):
+			return ApproveEntityLifecycleRequest404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Lifecycle request not found",
+				},
+			}, nil
+		case errors.Is(err, lifecycle.ErrRequestNotPending), errors.Is(err, vehicles.ErrLifecycleStatusChanged):
+			return ApproveEntityLifecycleRequest409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return ApproveEntityLifecycleRequest200JSONResponse(domainLifecycleRequestToHTTP(*approved)), nil
+}
+
+func (a apiServer) RejectEntityLifecycleRequest(ctx context.Context, request RejectEntityLifecycleRequestRequestObject) (RejectEntityLifecycleRequestResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return RejectEntityLifecycleRequest401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+
+	if err := a.authorizeLifecycleReviewer(ctx, request.EntityId); err != nil {
+		return RejectEntityLifecycleRequest403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to reject its lifecycle requests",
+			},
+		}, nil
+	}
+
+	var reason *string
+	if request.Body != nil {
+		reason = request.Body.Reason
+	}
+
+	rejected, err := a.lifecycleService.Reject(ctx, &request.EntityId, request.RequestId, identityID, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, lifecycle.ErrRequestNotFound):
+			return RejectEntityLifecycleRequest404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Lifecycle request not found",
+				},
+			}, nil
+		case errors.Is(err, lifecycle.ErrRequestNotPending):
+			return RejectEntityLifecycleRequest409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RejectEntityLifecycleRequest200JSONResponse(domainLifecycleRequestToHTTP(*rejected)), nil
+}
+
+func domainLifecycleRequestsToHTTP(requests []lifecycle.Request) []LifecycleRequest {
+	result := make([]LifecycleRequest, len(requests))
+	for i, r := range requests {
+		result[i] = domainLifecycleRequestToHTTP(r)
+	}
+	return result
+}
+
+func domainLifecycleRequestToHTTP(r lifecycle.Request) LifecycleRequest {
+	result := LifecycleRequest{
+		Id:            r.ID,
+		VehicleId:     r.VehicleID,
+		FromStatus:    VehicleLifecycleStatus(r.FromStatus),
+		ToStatus:      VehicleLifecycleStatus(r.ToStatus),
+		Reason:        r.Reason,
+		EntityId:      r.EntityID,
+		Status:        LifecycleRequestStatus(r.Status),
+		DeclineReason: r.DeclineReason,
+		EventId:       r.EventID,
+		AssetTxId:     r.AssetTxID,
+		AssetError:    r.AssetError,
+		CreatedAt:     r.CreatedAt,
+		DecidedAt:     r.DecidedAt,
+	}
+	if r.AssetStatus != nil {
+		status := LifecycleAssetStatus(*r.AssetStatus)
+		result.AssetStatus = &status
+	}
+	return result
+}
+
+// passportWarning returns the warning shown on the passport of stolen and scrapped vehicles
+func passportWarning(vehicle vehicles.Vehicle) *PassportWarning {
+	switch vehicle.LifecycleStatus {
+	case vehicles.LifecycleStolen:
+		return &PassportWarning{
+			Code:    PassportWarningCodeStolen,
+			Message: "This vehicle has been reported stolen. Do not buy it and contact the police if you have information about it.",
+			Since:   vehicle.LifecycleStatusAt,
+		}
+	case vehicles.LifecycleScrapped:
+		return &PassportWarning{
+			Code:    PassportWarningCodeScrapped,
+			Message: "This vehicle has been scrapped and its record is closed.",
+			Since:   vehicle.LifecycleStatusAt,
+		}
+	}
+	return nil
+}
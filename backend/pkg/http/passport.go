@@ -1,65 +1,103 @@
-package http
-
-import (
-	"context"
-)
-
-func (a apiServer) GetVehiclePassport(ctx context.Context, request GetVehiclePassportRequestObject) (GetVehiclePassportResponseObject, error) {
-	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
-	if err != nil {
-		return GetVehiclePassport404JSONResponse{
-			NotFoundJSONResponse: NotFoundJSONResponse{
-				Error: "Vehicle not found",
-				Code:  "not_found",
-			},
-		}, nil
-	}
-	if vehicle == nil {
-		return GetVehiclePassport404JSONResponse{
-			NotFoundJSONResponse: NotFoundJSONResponse{
-				Error: "Vehicle not found",
-				Code:  "not_found",
-			},
-		}, nil
-	}
-
-	// Strip sensitive fields
-	vehicle.OwnerID = nil
-	vehicle.ChassisNumber = nil
-	vehicle.EngineNumber = nil
-	vehicle.TransmissionNumber = nil
-	vehicle.CIDSourceCBOR = nil
-	vehicle.CIDSourceJSON = nil
-
-	// Fetch photos
-	dbPhotos, err := a.photoService.GetByVehicleID(ctx, request.VehicleId)
-	var httpPhotos *[]Photo
-	if err == nil {
-		photos := make([]Photo, len(dbPhotos))
-		for i, p := range dbPhotos {
-			photos[i] = domainToHTTPPhoto(p)
-		}
-		httpPhotos = &photos
-	}
-
-	// Fetch events + images (only certified events for public view)
-	dbEvents, _, err := a.eventService.GetByVehicle(ctx, request.VehicleId, 100, 0)
-	var httpEvents *[]Event
-	if err == nil {
-		events := make([]Event, 0, len(dbEvents))
-		for _, e := range dbEvents {
-			if e.EntityID == nil {
-				continue
-			}
-			images, _ := a.eventImageService.ListByEvent(ctx, e.ID)
-			events = append(events, domainToHTTPEvent(e, images))
-		}
-		httpEvents = &events
-	}
-
-	return GetVehiclePassport200JSONResponse{
-		Vehicle: domainToHTTPVehicle(*vehicle),
-		Photos:  httpPhotos,
-		History: httpEvents,
-	}, nil
-}
+package http
+
+import (
+	"context"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+)
+
+func (a apiServer) GetVehiclePassport(ctx context.Context, request GetVehiclePassportRequestObject) (GetVehiclePassportResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		return GetVehiclePassport404JSONResponse{
+			NotFoundJSONResponse: NotFoundJSONResponse{
+				Error: "Vehicle not found",
+				Code:  "not_found",
+			},
+		}, nil
+	}
+	if vehicle == nil {
+		return GetVehiclePassport404JSONResponse{
+			NotFoundJSONResponse: NotFoundJSONResponse{
+				Error: "Vehicle not found",
+				Code:  "not_found",
+			},
+		}, nil
+	}
+
+	// Strip sensitive fields
+	vehicle.OwnerID = nil
+	vehicle.ChassisNumber = nil
+	vehicle.EngineNumber = nil
+	vehicle.TransmissionNumber = nil
+	vehicle.CIDSourceCBOR = nil
+	vehicle.CIDSourceJSON = nil
+
+	// Fetch photos
+	dbPhotos, err := a.photoService.GetByVehicleID(ctx, request.VehicleId)
+	var httpPhotos *[]Photo
+	if err == nil {
+		photos := make([]Photo, len(dbPhotos))
+		for i, p := range dbPhotos {
+			photos[i] = domainToHTTPPhoto(p)
+		}
+		httpPhotos = &photos
+	}
+
+	// Fetch events + images (only certified events and platform-recorded events for public view)
+	anchorSummary := a.vehicleAnchors(ctx, request.VehicleId)
+	dbEvents, _, err := a.eventService.GetByVehicle(ctx, request.VehicleId, 100, 0)
+	var httpEvents *[]Event
+	if err == nil {
+		events := make([]Event, 0, len(dbEvents))
+		for _, e := range dbEvents {
+			if (e.EntityID == nil && !e.RecordedByPlatform()) || !e.OnRecord() {
+				continue
+			}
+			images, _ := a.eventImageService.ListByEvent(ctx, e.ID)
+			httpEvent := domainToHTTPEvent(e, images)
+			httpEvent.Anchor = httpAnchorOf(anchorSummary.Events, e.ID)
+			events = append(events, httpEvent)
+		}
+		httpEvents = &events
+	}
+
+	// Chain of custody, with owners identified only by their position
+	provenance, err := a.vehicleService.GetProvenance(ctx, request.VehicleId)
+	var httpProvenance *[]ProvenancePeriod
+	if err == nil {
+		periods := domainToHTTPProvenance(provenance)
+		httpProvenance = &periods
+	}
+
+	// Current certification status
+	httpVehicle := domainToHTTPVehicle(*vehicle)
+	if certs, err := a.vehicleCertifications(ctx, request.VehicleId); err == nil {
+		httpVehicle.Certifications = certs
+	}
+	if anchorSummary.Genesis != nil {
+		anchor := domainAnchorToHTTP(*anchorSummary.Genesis)
+		httpVehicle.Anchor = &anchor
+	}
+
+	return GetVehiclePassport200JSONResponse{
+		Vehicle:    httpVehicle,
+		Photos:     httpPhotos,
+		History:    httpEvents,
+		Provenance: httpProvenance,
+		Warning:    passportWarning(*vehicle),
+	}, nil
+}
+
+func domainToHTTPProvenance(periods []vehicles.ProvenancePeriod) []ProvenancePeriod {
+	result := make([]ProvenancePeriod, len(periods))
+	for i, p := range periods {
+		result[i] = ProvenancePeriod{
+			OwnerNumber: p.OwnerNumber,
+			Source:      OwnershipSource(p.Source),
+			StartDate:   p.StartDate,
+			EndDate:     p.EndDate,
+		}
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+package http
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig limits each client to Requests per Window. A zero Requests disables the limit.
+type RateLimitConfig struct {
+	Requests int
+	Window   time.Duration
+	// TrustForwardedFor keys clients by the last X-Forwarded-For address, which the proxy in front
+	// of the API appends. Earlier entries are set by the client and are ignored.
+	TrustForwardedFor bool
+}
+
+// RateLimitMiddleware rejects clients that exceed the configured rate with 429 Too Many Requests
+func RateLimitMiddleware(cfg RateLimitConfig, next http.Handler) http.Handler {
+	if cfg.Requests <= 0 || cfg.Window <= 0 {
+		return next
+	}
+
+	limiter := newRateLimiter(cfg.Requests, cfg.Window)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		retryAfter, ok := limiter.allow(clientIP(r, cfg.TrustForwardedFor), time.Now())
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(APIErrorResponse{Error: "Too many requests, please try again later"})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rateLimiter counts requests per client in fixed windows
+type rateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	clients   map[string]*rateWindow
+	lastSweep time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		window:  window,
+		clients: make(map[string]*rateWindow),
+	}
+}
+
+// allow records a request from the client and reports whether it is within the limit. When it
+// is not, it also returns how long until the client's window resets.
+func (l *rateLimiter) allow(client string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Drop expired windows now and then so the map doesn't grow with every client ever seen
+	if now.Sub(l.lastSweep) >= l.window {
+		for key, w := range l.clients {
+			if now.Sub(w.start) >= l.window {
+				delete(l.clients, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	w, ok := l.clients[client]
+	if !ok || now.Sub(w.start) >= l.window {
+		l.clients[client] = &rateWindow{start: now, count: 1}
+		return 0, true
+	}
+
+	if w.count >= l.limit {
+		return w.start.Add(l.window).Sub(now), false
+	}
+	w.count++
+	return 0, true
+}
+
+// clientIP returns the address requests are counted against: the rightmost X-Forwarded-For entry
+// when the proxy is trusted, or the peer address otherwise
+func clientIP(r *http.Request, trustForwardedFor bool) string {
+	if trustForwardedFor {
+		if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			forwarded := strings.Split(values[len(values)-1], ",")
+			if ip := strings.TrimSpace(forwarded[len(forwarded)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
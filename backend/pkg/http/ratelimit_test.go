@@ -0,0 +1,164 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_LimitExceeded(t *testing.T) {
+	limiter := newRateLimiter(2, time.Minute)
+	start := time.Now()
+
+	_, ok := limiter.allow("203.0.113.7", start)
+	assert.True(t, ok)
+	_, ok = limiter.allow("203.0.113.7", start.Add(10*time.Second))
+	assert.True(t, ok)
+
+	retryAfter, ok := limiter.allow("203.0.113.7", start.Add(20*time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, 40*time.Second, retryAfter)
+
+	_, ok = limiter.allow("198.51.100.1", start.Add(20*time.Second))
+	assert.True(t, ok, "other clients have their own window")
+}
+
+func TestRateLimiter_WindowReset(t *testing.T) {
+	limiter := newRateLimiter(1, time.Minute)
+	start := time.Now()
+
+	_, ok := limiter.allow("203.0.113.7", start)
+	require.True(t, ok)
+	_, ok = limiter.allow("203.0.113.7", start.Add(59*time.Second))
+	require.False(t, ok)
+
+	_, ok = limiter.allow("203.0.113.7", start.Add(time.Minute))
+	assert.True(t, ok)
+	_, ok = limiter.allow("203.0.113.7", start.Add(time.Minute+time.Second))
+	assert.False(t, ok, "the new window counts from the first request after the reset")
+}
+
+func TestRateLimiter_SweepsExpiredWindows(t *testing.T) {
+	limiter := newRateLimiter(1, time.Minute)
+	start := time.Now()
+
+	limiter.allow("203.0.113.7", start)
+	limiter.allow("198.51.100.1", start.Add(2*time.Minute))
+
+	assert.NotContains(t, limiter.clients, "203.0.113.7")
+	assert.Contains(t, limiter.clients, "198.51.100.1")
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name              string
+		remoteAddr        string
+		forwardedFor      []string
+		trustForwardedFor bool
+		want              string
+	}{
+		{
+			name:       "peer address",
+			remoteAddr: "203.0.113.7:51234",
+			want:       "203.0.113.7",
+		},
+		{
+			name:         "forwarded for ignored without a trusted proxy",
+			remoteAddr:   "203.0.113.7:51234",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:              "address appended by the proxy",
+			remoteAddr:        "10.0.0.2:443",
+			forwardedFor:      []string{"198.51.100.1"},
+			trustForwardedFor: true,
+			want:              "198.51.100.1",
+		},
+		{
+			name:              "entries set by the client are ignored",
+			remoteAddr:        "10.0.0.2:443",
+			forwardedFor:      []string{"192.0.2.99, 192.0.2.100,198.51.100.1"},
+			trustForwardedFor: true,
+			want:              "198.51.100.1",
+		},
+		{
+			name:              "last of several headers",
+			remoteAddr:        "10.0.0.2:443",
+			forwardedFor:      []string{"192.0.2.99", "198.51.100.1"},
+			trustForwardedFor: true,
+			want:              "198.51.100.1",
+		},
+		{
+			name:              "peer address without forwarded for",
+			remoteAddr:        "10.0.0.2:443",
+			trustForwardedFor: true,
+			want:              "10.0.0.2",
+		},
+		{
+			name:              "peer address when the last entry is empty",
+			remoteAddr:        "10.0.0.2:443",
+			forwardedFor:      []string{"198.51.100.1, "},
+			trustForwardedFor: true,
+			want:              "10.0.0.2",
+		},
+		{
+			name:       "peer address without a port",
+			remoteAddr: "203.0.113.7",
+			want:       "203.0.113.7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/public/stolen-vehicles/lookup", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwardedFor {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+
+			assert.Equal(t, tt.want, clientIP(r, tt.trustForwardedFor))
+		})
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	handler := RateLimitMiddleware(RateLimitConfig{Requests: 1, Window: time.Minute}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/public/stolen-vehicles/lookup", nil)
+		r.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, http.StatusNoContent, request("203.0.113.7:51234").Code)
+
+	limited := request("203.0.113.7:51235")
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.NotEmpty(t, limited.Header().Get("Retry-After"))
+	assert.Equal(t, "application/json", limited.Header().Get("Content-Type"))
+
+	assert.Equal(t, http.StatusNoContent, request("198.51.100.1:40000").Code)
+}
+
+func TestRateLimitMiddleware_Disabled(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	handler := RateLimitMiddleware(RateLimitConfig{}, next)
+
+	assert.NotNil(t, handler)
+	for range 3 {
+		r := httptest.NewRequest(http.MethodGet, "/public/stolen-vehicles/lookup", nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
@@ -213,23 +213,35 @@ func (a apiServer) GetSharedVehicle(ctx context.Context, request GetSharedVehicl
 	}
 
 	var httpEvents *[]Event
+	var httpProvenance *[]ProvenancePeriod
 	if shareLink.CanViewHistory {
 		dbEvents, _, err := a.eventService.GetByVehicle(ctx, shareLink.VehicleID, 100, 0)
 		if err == nil {
-			events := make([]Event, len(dbEvents))
-			for i, e := range dbEvents {
+			events := make([]Event, 0, len(dbEvents))
+			for _, e := range dbEvents {
+				if !e.OnRecord() {
+					continue
+				}
 				images, _ := a.eventImageService.ListByEvent(ctx, e.ID)
-				events[i] = domainToHTTPEvent(e, images)
+				events = append(events, domainToHTTPEvent(e, images))
 			}
 			httpEvents = &events
 		}
+
+		provenance, err := a.vehicleService.GetProvenance(ctx, shareLink.VehicleID)
+		if err == nil {
+			periods := domainToHTTPProvenance(provenance)
+			httpProvenance = &periods
+		}
 	}
 
 	return GetSharedVehicle200JSONResponse{
-		Vehicle:   domainToHTTPVehicle(*vehicle),
-		Photos:    httpPhotos,
-		Documents: httpDocuments,
-		History:   httpEvents,
+		Vehicle:    domainToHTTPVehicle(*vehicle),
+		Photos:     httpPhotos,
+		Documents:  httpDocuments,
+		History:    httpEvents,
+		Provenance: httpProvenance,
+		Warning:    passportWarning(*vehicle),
 	}, nil
 }
 
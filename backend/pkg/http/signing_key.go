@@ -0,0 +1,218 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/entity"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/signing_keys"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/signing"
+	"github.com/google/uuid"
+)
+
+// authorizeSigningKeyManager checks that the current user may manage the entity's signing keys
+func (a apiServer) authorizeSigningKeyManager(ctx context.Context, entityID uuid.UUID) error {
+	if err := a.authorizer.Authorize(ctx, ResourceEntities, ActionUpdate); err != nil {
+		return err
+	}
+	return a.authorizer.AuthorizeEntityMembership(ctx, entityID, EntityRoleAdmin)
+}
+
+// authorizeEventSigner checks that the current caller may sign on behalf of the entity: an OAuth2
+// client of the entity with the events:write scope, or a member of the entity
+func (a apiServer) authorizeEventSigner(ctx context.Context, entityID uuid.UUID) bool {
+	if auth.IsOAuth2Request(ctx) {
+		clientEntityID, ok := auth.GetOAuth2EntityID(ctx)
+		return ok && clientEntityID == entityID && auth.HasScope(ctx, auth.ScopeEventsWrite)
+	}
+	return a.authorizer.AuthorizeEntityMembership(ctx, entityID, "") == nil
+}
+
+func (a apiServer) GetEntitySigningKeys(ctx context.Context, request GetEntitySigningKeysRequestObject) (GetEntitySigningKeysResponseObject, error) {
+	if err := a.authorizer.AuthorizeEntityMembership(ctx, request.EntityId, ""); err != nil {
+		return GetEntitySigningKeys403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be a member of the entity to view its signing keys",
+			},
+		}, nil
+	}
+
+	keys, err := a.signingKeyService.ListByEntity(ctx, request.EntityId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]SigningKey, len(keys))
+	for i, k := range keys {
+		result[i] = domainSigningKeyToHTTP(k)
+	}
+	return GetEntitySigningKeys200JSONResponse(result), nil
+}
+
+func (a apiServer) RegisterEntitySigningKey(ctx context.Context, request RegisterEntitySigningKeyRequestObject) (RegisterEntitySigningKeyResponseObject, error) {
+	if request.Body == nil {
+		return RegisterEntitySigningKey400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	if err := a.authorizeSigningKeyManager(ctx, request.EntityId); err != nil {
+		return RegisterEntitySigningKey403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be an admin of the entity to register signing keys",
+			},
+		}, nil
+	}
+
+	created, err := a.signingKeyService.Register(ctx, request.EntityId, signing_keys.RegisterParams{
+		Label:     request.Body.Label,
+		PublicKey: request.Body.PublicKey,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrEntityNotFound):
+			return RegisterEntitySigningKey404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Entity not found",
+				},
+			}, nil
+		case errors.Is(err, signing_keys.ErrLabelRequired),
+			errors.Is(err, signing_keys.ErrManagedKeysDisabled),
+			errors.Is(err, signing.ErrInvalidPublicKey):
+			return RegisterEntitySigningKey400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, signing_keys.ErrKeyExists):
+			return RegisterEntitySigningKey409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RegisterEntitySigningKey201JSONResponse(domainSigningKeyToHTTP(*created)), nil
+}
+
+func (a apiServer) RevokeEntitySigningKey(ctx context.Context, request RevokeEntitySigningKeyRequestObject) (RevokeEntitySigningKeyResponseObject, error) {
+	if err := a.authorizeSigningKeyManager(ctx, request.EntityId); err != nil {
+		return RevokeEntitySigningKey403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: must be an admin of the entity to revoke its signing keys",
+			},
+		}, nil
+	}
+
+	revoked, err := a.signingKeyService.Revoke(ctx, request.EntityId, request.KeyId)
+	if err != nil {
+		if errors.Is(err, signing_keys.ErrKeyNotFound) {
+			return RevokeEntitySigningKey404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Signing key not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RevokeEntitySigningKey200JSONResponse(domainSigningKeyToHTTP(*revoked)), nil
+}
+
+func (a apiServer) GetSigningKey(ctx context.Context, request GetSigningKeyRequestObject) (GetSigningKeyResponseObject, error) {
+	key, err := a.signingKeyService.GetByID(ctx, request.KeyId)
+	if err != nil {
+		if errors.Is(err, signing_keys.ErrKeyNotFound) {
+			return GetSigningKey404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Signing key not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return GetSigningKey200JSONResponse(domainSigningKeyToHTTP(*key)), nil
+}
+
+func (a apiServer) SignEvent(ctx context.Context, request SignEventRequestObject) (SignEventResponseObject, error) {
+	if request.Body == nil {
+		return SignEvent400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	evt, err := a.eventService.GetByID(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) {
+			return SignEvent404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+	if evt.EntityID == nil {
+		return SignEvent409JSONResponse{
+			ConflictJSONResponse: ConflictJSONResponse{
+				Error: "Only events issued by an entity can be signed",
+			},
+		}, nil
+	}
+
+	if !a.authorizeEventSigner(ctx, *evt.EntityID) {
+		return SignEvent403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: only the issuing entity can sign this event",
+			},
+		}, nil
+	}
+
+	signed, err := a.eventService.AttachSignature(ctx, evt.ID, *evt.EntityID, request.Body.KeyId, request.Body.Signature)
+	if err != nil {
+		switch {
+		case errors.Is(err, signing_keys.ErrKeyNotFound),
+			errors.Is(err, signing_keys.ErrKeyRevoked),
+			errors.Is(err, signing.ErrInvalidPublicKey),
+			errors.Is(err, signing.ErrInvalidSignature):
+			return SignEvent400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, event.ErrEventNotSignable),
+			errors.Is(err, event.ErrEventAlreadySigned):
+			return SignEvent409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	images, _ := a.eventImageService.ListByEvent(ctx, signed.ID)
+	return SignEvent200JSONResponse(domainToHTTPEvent(*signed, images)), nil
+}
+
+func domainSigningKeyToHTTP(k signing_keys.Key) SigningKey {
+	return SigningKey{
+		Id:        k.ID,
+		EntityId:  k.EntityID,
+		Label:     k.Label,
+		Algorithm: SigningKeyAlgorithm(k.Algorithm),
+		PublicKey: k.PublicKey,
+		Managed:   k.Managed,
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
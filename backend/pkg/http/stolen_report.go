@@ -0,0 +1,369 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/stolen_reports"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (a apiServer) GetVehicleStolenReports(ctx context.Context, request GetVehicleStolenReportsRequestObject) (GetVehicleStolenReportsResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return GetVehicleStolenReports404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !isVehicleOwner(ctx, vehicle) {
+		if err := a.authorizer.Authorize(ctx, ResourceStolenReports, ActionRead); err != nil {
+			return GetVehicleStolenReports403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: "Forbidden: You don't have permission to access this vehicle's stolen reports",
+				},
+			}, nil
+		}
+	}
+
+	reports, err := a.stolenReportService.ListByVehicle(ctx, vehicle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetVehicleStolenReports200JSONResponse(domainStolenReportsToHTTP(reports)), nil
+}
+
+func (a apiServer) FileStolenReport(ctx context.Context, request FileStolenReportRequestObject) (FileStolenReportResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return FileStolenReport401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+	if request.Body == nil {
+		return FileStolenReport400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return FileStolenReport404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if !isVehicleOwner(ctx, vehicle) {
+		return FileStolenReport403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: only the vehicle owner can report it stolen or missing",
+			},
+		}, nil
+	}
+
+	params := stolen_reports.FileParams{
+		Kind:            string(request.Body.Kind),
+		PoliceReference: request.Body.PoliceReference,
+		PoliceAuthority: request.Body.PoliceAuthority,
+		Location:        request.Body.Location,
+		Description:     request.Body.Description,
+		ReportedBy:      identityID,
+	}
+	if request.Body.IncidentDate != nil {
+		params.IncidentDate = &request.Body.IncidentDate.Time
+	}
+
+	report, err := a.stolenReportService.File(ctx, *vehicle, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, stolen_reports.ErrInvalidKind),
+			errors.Is(err, stolen_reports.ErrDescriptionRequired),
+			errors.Is(err, stolen_reports.ErrIncidentInFuture):
+			return FileStolenReport400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, stolen_reports.ErrReportAlreadyOpen), errors.Is(err, vehicles.ErrVehicleNotActive):
+			return FileStolenReport409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return FileStolenReport201JSONResponse(domainStolenReportToHTTP(*report)), nil
+}
+
+func (a apiServer) GetAdminStolenReports(ctx context.Context, request GetAdminStolenReportsRequestObject) (GetAdminStolenReportsResponseObject, error) {
+	if err := a.authorizer.Authorize(ctx, ResourceStolenReports, ActionRead); err != nil {
+		return GetAdminStolenReports403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	status := ""
+	if request.Params.Status != nil {
+		status = string(*request.Params.Status)
+	}
+
+	reports, err := a.stolenReportService.List(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetAdminStolenReports200JSONResponse(domainStolenReportsToHTTP(reports)), nil
+}
+
+func (a apiServer) ApproveStolenReport(ctx context.Context, request ApproveStolenReportRequestObject) (ApproveStolenReportResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return ApproveStolenReport401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+	if err := a.authorizer.Authorize(ctx, ResourceStolenReports, ActionUpdate); err != nil {
+		return ApproveStolenReport403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	report, err := a.stolenReportService.Approve(ctx, request.ReportId, identityID)
+	if err != nil {
+		switch {
+		case errors.Is(err, stolen_reports.ErrReportNotFound):
+			return ApproveStolenReport404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Stolen vehicle report not found",
+				},
+			}, nil
+		case errors.Is(err, stolen_reports.ErrReportNotPending),
+			errors.Is(err, vehicles.ErrVehicleNotActive),
+			errors.Is(err, vehicles.ErrLifecycleStatusChanged),
+			errors.Is(err, lifecycle.ErrRequestAlreadyPending):
+			return ApproveStolenReport409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return ApproveStolenReport200JSONResponse(domainStolenReportToHTTP(*report)), nil
+}
+
+func (a apiServer) RejectStolenReport(ctx context.Context, request RejectStolenReportRequestObject) (RejectStolenReportResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return RejectStolenReport401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+	if err := a.authorizer.Authorize(ctx, ResourceStolenReports, ActionUpdate); err != nil {
+		return RejectStolenReport403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	var reason *string
+	if request.Body != nil {
+		reason = request.Body.Reason
+	}
+
+	report, err := a.stolenReportService.Reject(ctx, request.ReportId, identityID, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, stolen_reports.ErrReportNotFound):
+			return RejectStolenReport404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Stolen vehicle report not found",
+				},
+			}, nil
+		case errors.Is(err, stolen_reports.ErrReportNotPending):
+			return RejectStolenReport409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return RejectStolenReport200JSONResponse(domainStolenReportToHTTP(*report)), nil
+}
+
+func (a apiServer) ResolveStolenReport(ctx context.Context, request ResolveStolenReportRequestObject) (ResolveStolenReportResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return ResolveStolenReport401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+	if err := a.authorizer.Authorize(ctx, ResourceStolenReports, ActionUpdate); err != nil {
+		return ResolveStolenReport403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "forbidden",
+			},
+		}, nil
+	}
+
+	var note *string
+	if request.Body != nil {
+		note = request.Body.Note
+	}
+
+	report, err := a.stolenReportService.Resolve(ctx, request.ReportId, identityID, note)
+	if err != nil {
+		switch {
+		case errors.Is(err, stolen_reports.ErrReportNotFound):
+			return ResolveStolenReport404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Stolen vehicle report not found",
+				},
+			}, nil
+		case errors.Is(err, stolen_reports.ErrReportNotApproved),
+			errors.Is(err, vehicles.ErrLifecycleStatusChanged),
+			errors.Is(err, lifecycle.ErrRequestAlreadyPending):
+			return ResolveStolenReport409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return ResolveStolenReport200JSONResponse(domainStolenReportToHTTP(*report)), nil
+}
+
+// LookupStolenVehicle is rate limited per client by the middleware in front of it
+func (a apiServer) LookupStolenVehicle(ctx context.Context, request LookupStolenVehicleRequestObject) (LookupStolenVehicleResponseObject, error) {
+	alert, err := a.lookupStolenVehicle(ctx, request.Params.ChassisNumber, request.Params.LicensePlate)
+	if err != nil {
+		if errors.Is(err, stolen_reports.ErrIdentifierRequired) {
+			return LookupStolenVehicle400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return LookupStolenVehicle200JSONResponse(*alert), nil
+}
+
+func (a apiServer) LookupStolenVehicleAuthenticated(ctx context.Context, request LookupStolenVehicleAuthenticatedRequestObject) (LookupStolenVehicleAuthenticatedResponseObject, error) {
+	if auth.IsOAuth2Request(ctx) && !auth.HasScope(ctx, auth.ScopeVehiclesRead) {
+		return LookupStolenVehicleAuthenticated403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: vehicles:read scope required",
+			},
+		}, nil
+	}
+	if err := a.authorizer.Authorize(ctx, ResourceStolenVehicles, ActionRead); err != nil {
+		return LookupStolenVehicleAuthenticated403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: only entities can use the integration lookup",
+			},
+		}, nil
+	}
+
+	alert, err := a.lookupStolenVehicle(ctx, request.Params.ChassisNumber, request.Params.LicensePlate)
+	if err != nil {
+		if errors.Is(err, stolen_reports.ErrIdentifierRequired) {
+			return LookupStolenVehicleAuthenticated400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return LookupStolenVehicleAuthenticated200JSONResponse(*alert), nil
+}
+
+func (a apiServer) lookupStolenVehicle(ctx context.Context, chassisNumber, licensePlate *string) (*StolenVehicleAlert, error) {
+	var chassis, plate string
+	if chassisNumber != nil {
+		chassis = *chassisNumber
+	}
+	if licensePlate != nil {
+		plate = *licensePlate
+	}
+
+	alert, err := a.stolenReportService.Lookup(ctx, chassis, plate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StolenVehicleAlert{
+		Status: StolenVehicleAlertStatus(alert.Status),
+		Since:  alert.Since,
+	}, nil
+}
+
+func domainStolenReportsToHTTP(reports []stolen_reports.Report) []StolenReport {
+	result := make([]StolenReport, len(reports))
+	for i, r := range reports {
+		result[i] = domainStolenReportToHTTP(r)
+	}
+	return result
+}
+
+func domainStolenReportToHTTP(r stolen_reports.Report) StolenReport {
+	result := StolenReport{
+		Id:                 r.ID,
+		VehicleId:          r.VehicleID,
+		Kind:               StolenReportKind(r.Kind),
+		PoliceReference:    r.PoliceReference,
+		PoliceAuthority:    r.PoliceAuthority,
+		Location:           r.Location,
+		Description:        r.Description,
+		Status:             StolenReportStatus(r.Status),
+		DeclineReason:      r.DeclineReason,
+		LifecycleRequestId: r.LifecycleRequestID,
+		ResolutionNote:     r.ResolutionNote,
+		CreatedAt:          r.CreatedAt,
+		DecidedAt:          r.DecidedAt,
+		ResolvedAt:         r.ResolvedAt,
+	}
+	if r.IncidentDate != nil {
+		result.IncidentDate = &openapi_types.Date{Time: *r.IncidentDate}
+	}
+	return result
+}
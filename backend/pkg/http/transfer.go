@@ -0,0 +1,241 @@
+package http
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/auth"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/transfer"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+)
+
+// authorizeVehicleTransfer checks that the current user is the vehicle owner or may transfer any vehicle
+func (a apiServer) authorizeVehicleTransfer(ctx context.Context, vehicle *vehicles.Vehicle) error {
+	if isVehicleOwner(ctx, vehicle) {
+		return nil
+	}
+	return a.authorizer.Authorize(ctx, ResourceVehicles, ActionTransfer)
+}
+
+func (a apiServer) GetVehicleTransfers(ctx context.Context, request GetVehicleTransfersRequestObject) (GetVehicleTransfersResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return GetVehicleTransfers404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if err := a.authorizeVehicleTransfer(ctx, vehicle); err != nil {
+		return GetVehicleTransfers403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: You don't have permission to access this vehicle's transfers",
+			},
+		}, nil
+	}
+
+	transfers, err := a.transferService.ListByVehicle(ctx, vehicle.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	httpTransfers := make([]OwnershipTransfer, len(transfers))
+	for i, t := range transfers {
+		httpTransfers[i] = domainTransferToHTTP(t)
+	}
+
+	return GetVehicleTransfers200JSONResponse(httpTransfers), nil
+}
+
+func (a apiServer) InitiateVehicleTransfer(ctx context.Context, request InitiateVehicleTransferRequestObject) (InitiateVehicleTransferResponseObject, error) {
+	if request.Body == nil {
+		return InitiateVehicleTransfer400JSONResponse{
+			BadRequestJSONResponse: BadRequestJSONResponse{
+				Error: "Request body is required",
+			},
+		}, nil
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return InitiateVehicleTransfer404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if err := a.authorizeVehicleTransfer(ctx, vehicle); err != nil {
+		return InitiateVehicleTransfer403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: You don't have permission to transfer this vehicle",
+			},
+		}, nil
+	}
+
+	created, err := a.transferService.Initiate(ctx, vehicle, string(request.Body.Email))
+	if err != nil {
+		if errors.Is(err, transfer.ErrVehicleHasNoOwner) || errors.Is(err, vehicles.ErrVehicleNotActive) {
+			return InitiateVehicleTransfer409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return InitiateVehicleTransfer201JSONResponse(domainTransferToHTTP(*created)), nil
+}
+
+func (a apiServer) CancelVehicleTransfer(ctx context.Context, request CancelVehicleTransferRequestObject) (CancelVehicleTransferResponseObject, error) {
+	vehicle, err := a.vehicleService.GetByID(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return CancelVehicleTransfer404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	if err := a.authorizeVehicleTransfer(ctx, vehicle); err != nil {
+		return CancelVehicleTransfer403JSONResponse{
+			ForbiddenJSONResponse: ForbiddenJSONResponse{
+				Error: "Forbidden: You don't have permission to cancel this vehicle's transfers",
+			},
+		}, nil
+	}
+
+	if _, err := a.transferService.Cancel(ctx, vehicle.ID, request.TransferId); err != nil {
+		if errors.Is(err, transfer.ErrTransferNotFound) {
+			return CancelVehicleTransfer404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Transfer not found",
+				},
+			}, nil
+		}
+		if errors.Is(err, transfer.ErrTransferNotPending) {
+			return CancelVehicleTransfer409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return CancelVehicleTransfer204Response{}, nil
+}
+
+func (a apiServer) GetOwnershipTransferByToken(ctx context.Context, request GetOwnershipTransferByTokenRequestObject) (GetOwnershipTransferByTokenResponseObject, error) {
+	t, err := a.transferService.GetByToken(ctx, request.Token)
+	if err != nil {
+		if errors.Is(err, transfer.ErrTransferNotFound) || errors.Is(err, transfer.ErrTransferNotPending) || errors.Is(err, transfer.ErrTransferExpired) {
+			return GetOwnershipTransferByToken404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Invalid or expired transfer token",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	vehicle, err := a.vehicleService.GetByID(ctx, t.VehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := InvitationVehicle{
+		VehicleId: vehicle.ID,
+	}
+	if vehicle.Make != "" {
+		item.Make = &vehicle.Make
+	}
+	if vehicle.Model != "" {
+		item.Model = &vehicle.Model
+	}
+	if vehicle.Year != 0 {
+		item.Year = &vehicle.Year
+	}
+	if vehicle.LicensePlate != nil && *vehicle.LicensePlate != "" {
+		item.LicensePlate = vehicle.LicensePlate
+	}
+
+	return GetOwnershipTransferByToken200JSONResponse{
+		ToEmail:   t.ToEmail,
+		ExpiresAt: t.TokenExpiresAt,
+		Vehicle:   item,
+	}, nil
+}
+
+func (a apiServer) AcceptOwnershipTransfer(ctx context.Context, request AcceptOwnershipTransferRequestObject) (AcceptOwnershipTransferResponseObject, error) {
+	identityID, ok := auth.GetIdentityID(ctx)
+	if !ok {
+		return AcceptOwnershipTransfer401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Code:  "unauthorized",
+				Error: "Authentication required",
+			},
+		}, nil
+	}
+
+	email, ok := auth.GetIdentityEmail(ctx)
+	if !ok || email == "" {
+		return AcceptOwnershipTransfer401JSONResponse{
+			UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+				Code:  "unauthorized",
+				Error: "Email not found in identity",
+			},
+		}, nil
+	}
+
+	accepted, err := a.transferService.Accept(ctx, request.Token, email, identityID)
+	if err != nil {
+		switch {
+		case errors.Is(err, transfer.ErrTransferNotFound), errors.Is(err, transfer.ErrTransferExpired):
+			return AcceptOwnershipTransfer404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Invalid or expired transfer token",
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrRecipientMismatch):
+			return AcceptOwnershipTransfer403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		case errors.Is(err, transfer.ErrTransferNotPending), errors.Is(err, transfer.ErrSelfTransfer), errors.Is(err, transfer.ErrOwnerChanged), errors.Is(err, vehicles.ErrVehicleNotActive):
+			return AcceptOwnershipTransfer409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return AcceptOwnershipTransfer200JSONResponse(domainTransferToHTTP(*accepted)), nil
+}
+
+func domainTransferToHTTP(t transfer.Transfer) OwnershipTransfer {
+	return OwnershipTransfer{
+		Id:          t.ID,
+		VehicleId:   t.VehicleID,
+		ToEmail:     t.ToEmail,
+		Status:      OwnershipTransferStatus(t.Status),
+		ExpiresAt:   t.TokenExpiresAt,
+		EventId:     t.EventID,
+		CreatedAt:   t.CreatedAt,
+		CompletedAt: t.CompletedAt,
+	}
+}
@@ -190,9 +190,120 @@ func (a apiServer) GetVehicle(ctx context.Context, request GetVehicleRequestObje
 	}
 
 	httpVehicle := domainToHTTPVehicle(*vehicle)
+	httpVehicle.Certifications, err = a.vehicleCertifications(ctx, vehicle.ID)
+	if err != nil {
+		return nil, err
+	}
+	if genesis := a.vehicleAnchors(ctx, vehicle.ID).Genesis; genesis != nil {
+		anchor := domainAnchorToHTTP(*genesis)
+		httpVehicle.Anchor = &anchor
+	}
 	return GetVehicle200JSONResponse(httpVehicle), nil
 }
 
+func (a apiServer) GetVehicleVersions(ctx context.Context, request GetVehicleVersionsRequestObject) (GetVehicleVersionsResponseObject, error) {
+	_, err := a.checkVehicleAccess(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, ErrVehicleNotFound) {
+			return GetVehicleVersions404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrAuthenticationRequired) {
+			return GetVehicleVersions401JSONResponse{
+				UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrForbiddenVehicleAccess) {
+			return GetVehicleVersions403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	versions, err := a.vehicleService.ListVersions(ctx, request.VehicleId)
+	if err != nil {
+		return nil, err
+	}
+
+	versionAnchors := a.vehicleAnchors(ctx, request.VehicleId).Versions
+	httpVersions := make([]VehicleVersion, len(versions))
+	for i, v := range versions {
+		httpVersions[i] = VehicleVersion{
+			Id:               v.ID,
+			Version:          v.Version,
+			Cid:              v.CID,
+			PreviousCid:      v.PreviousCID,
+			BlockchainTxId:   v.BlockchainTxID,
+			BlockchainStatus: VehicleVersionBlockchainStatus(v.BlockchainStatus),
+			Anchor:           httpAnchorOf(versionAnchors, v.ID),
+			CreatedAt:        v.CreatedAt,
+		}
+	}
+
+	return GetVehicleVersions200JSONResponse(httpVersions), nil
+}
+
+func (a apiServer) GetVehicleOwners(ctx context.Context, request GetVehicleOwnersRequestObject) (GetVehicleOwnersResponseObject, error) {
+	_, err := a.checkVehicleAccess(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, ErrVehicleNotFound) {
+			return GetVehicleOwners404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrAuthenticationRequired) {
+			return GetVehicleOwners401JSONResponse{
+				UnauthorizedJSONResponse: UnauthorizedJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(err, ErrForbiddenVehicleAccess) {
+			return GetVehicleOwners403JSONResponse{
+				ForbiddenJSONResponse: ForbiddenJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	owners, err := a.vehicleService.ListOwners(ctx, request.VehicleId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Owners are numbered as on the passport; identities are only shown to admins
+	provenance := vehicles.Provenance(owners)
+	httpOwners := make([]VehicleOwnerPeriod, len(owners))
+	for i, o := range owners {
+		httpOwners[i] = VehicleOwnerPeriod{
+			Id:          o.ID,
+			OwnerNumber: provenance[i].OwnerNumber,
+			Source:      OwnershipSource(o.Source),
+			StartDate:   o.StartDate,
+			EndDate:     o.EndDate,
+		}
+		if auth.IsAdmin(ctx) {
+			httpOwners[i].OwnerId = o.OwnerID
+		}
+	}
+
+	return GetVehicleOwners200JSONResponse(httpOwners), nil
+}
+
 func (a apiServer) UpdateVehicle(ctx context.Context, request UpdateVehicleRequestObject) (UpdateVehicleResponseObject, error) {
 	if request.Body == nil {
 		return nil, fmt.Errorf("request body is required")
@@ -244,6 +355,13 @@ func (a apiServer) UpdateVehicle(ctx context.Context, request UpdateVehicleReque
 
 	updatedVehicle, err := a.vehicleService.Update(ctx, request.VehicleId, params)
 	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleScrapped) {
+			return UpdateVehicle400JSONResponse{
+				BadRequestJSONResponse: BadRequestJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -426,6 +544,13 @@ func (a apiServer) UpdateCertifierVehicle(ctx context.Context, request UpdateCer
 
 	updatedVehicle, err := a.vehicleService.Update(ctx, request.VehicleId, params)
 	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleScrapped) {
+			return UpdateCertifierVehicle409JSONResponse{
+				ConflictJSONResponse: ConflictJSONResponse{
+					Error: err.Error(),
+				},
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -499,6 +624,7 @@ func (a apiServer) GetCertifierVehicleInvitation(ctx context.Context, request Ge
 // domainToHTTPVehicle converts a domain vehicle to HTTP vehicle
 func domainToHTTPVehicle(domainVehicle vehicles.Vehicle) Vehicle {
 	blockchainStatus := VehicleBlockchainStatus(domainVehicle.BlockchainStatus)
+	lifecycleStatus, lifecycleStatusAt := httpLifecycleStatus(domainVehicle)
 	return Vehicle{
 		BlockchainAssetId:  domainVehicle.BlockchainAssetID,
 		BlockchainStatus:   &blockchainStatus,
@@ -514,6 +640,8 @@ func domainToHTTPVehicle(domainVehicle vehicles.Vehicle) Vehicle {
 		GearType:           domainVehicle.GearType,
 		Id:                 domainVehicle.ID,
 		LicensePlate:       domainVehicle.LicensePlate,
+		LifecycleStatus:    &lifecycleStatus,
+		LifecycleStatusAt:  lifecycleStatusAt,
 		Make:               domainVehicle.Make,
 		Model:              domainVehicle.Model,
 		OwnerId:            domainVehicle.OwnerID,
@@ -533,6 +661,7 @@ func domainToHTTPVehicleWithStats(domainVehicle vehicles.VehicleWithStats) Vehic
 	ownerEventsCount := domainVehicle.OwnerEventsCount
 	activeCertificationsCount := domainVehicle.ActiveCertificationsCount
 	blockchainStatus := VehicleBlockchainStatus(domainVehicle.BlockchainStatus)
+	lifecycleStatus, lifecycleStatusAt := httpLifecycleStatus(domainVehicle.Vehicle)
 
 	return Vehicle{
 		BlockchainAssetId:         domainVehicle.BlockchainAssetID,
@@ -549,6 +678,8 @@ func domainToHTTPVehicleWithStats(domainVehicle vehicles.VehicleWithStats) Vehic
 		GearType:                  domainVehicle.GearType,
 		Id:                        domainVehicle.ID,
 		LicensePlate:              domainVehicle.LicensePlate,
+		LifecycleStatus:           &lifecycleStatus,
+		LifecycleStatusAt:         lifecycleStatusAt,
 		Make:                      domainVehicle.Make,
 		Model:                     domainVehicle.Model,
 		OwnerId:                   domainVehicle.OwnerID,
@@ -564,3 +695,18 @@ func domainToHTTPVehicleWithStats(domainVehicle vehicles.VehicleWithStats) Vehic
 		ActiveCertificationsCount: &activeCertificationsCount,
 	}
 }
+
+// httpLifecycleStatus returns the vehicle's lifecycle status, defaulting to active, and when it
+// entered it
+func httpLifecycleStatus(domainVehicle vehicles.Vehicle) (VehicleLifecycleStatus, *time.Time) {
+	status := VehicleLifecycleStatusActive
+	if domainVehicle.LifecycleStatus != "" {
+		status = VehicleLifecycleStatus(domainVehicle.LifecycleStatus)
+	}
+	var at *time.Time
+	if !domainVehicle.LifecycleStatusAt.IsZero() {
+		statusAt := domainVehicle.LifecycleStatusAt
+		at = &statusAt
+	}
+	return status, at
+}
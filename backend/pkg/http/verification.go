@@ -0,0 +1,155 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/verification"
+)
+
+func (a apiServer) VerifyVehicle(ctx context.Context, request VerifyVehicleRequestObject) (VerifyVehicleResponseObject, error) {
+	report, err := a.verificationService.VerifyVehicle(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return VerifyVehicle404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+					Code:  "not_found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	versions := make([]AnchorVerification, len(report.Versions))
+	for i, r := range report.Versions {
+		versions[i] = domainToHTTPAnchorVerification(r)
+	}
+
+	events := make([]AnchorVerification, len(report.Events))
+	for i, r := range report.Events {
+		events[i] = domainToHTTPAnchorVerification(r)
+	}
+
+	return VerifyVehicle200JSONResponse{
+		Vehicle:  domainToHTTPAnchorVerification(report.Vehicle),
+		Versions: versions,
+		Events:   events,
+	}, nil
+}
+
+func (a apiServer) VerifyEvent(ctx context.Context, request VerifyEventRequestObject) (VerifyEventResponseObject, error) {
+	result, err := a.verificationService.VerifyEvent(ctx, request.EventId)
+	if err != nil {
+		if errors.Is(err, event.ErrEventNotFound) || errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return VerifyEvent404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Event not found",
+					Code:  "not_found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	return VerifyEvent200JSONResponse(domainToHTTPAnchorVerification(*result)), nil
+}
+
+func domainToHTTPAnchorVerification(r verification.Result) AnchorVerification {
+	result := AnchorVerification{
+		RecordType:  AnchorVerificationRecordType(r.RecordType),
+		RecordId:    r.RecordID,
+		Verdict:     AnchorVerificationVerdict(r.Verdict),
+		Reason:      r.Reason,
+		StoredCid:   r.StoredCID,
+		ComputedCid: r.ComputedCID,
+		OnChainCid:  r.OnChainCID,
+		TxId:        r.TxID,
+		ConfirmedAt: r.ConfirmedAt,
+		MerkleRoot:  r.MerkleRoot,
+	}
+	if r.MerkleProof != nil {
+		steps := make([]MerkleProofStep, len(r.MerkleProof.Steps))
+		for i, step := range r.MerkleProof.Steps {
+			steps[i] = MerkleProofStep{Hash: step.Hash, Left: step.Left}
+		}
+		result.MerkleProof = &MerkleProof{
+			Root:  r.MerkleProof.Root,
+			Index: r.MerkleProof.Index,
+			Steps: steps,
+		}
+	}
+	if r.AssetID != nil {
+		assetID := strconv.FormatUint(*r.AssetID, 10)
+		result.AssetId = &assetID
+	}
+	if r.ConfirmedRound != nil {
+		round := int64(*r.ConfirmedRound)
+		result.ConfirmedRound = &round
+	}
+	if r.Signature != nil {
+		result.Signature = &EventSignatureVerification{
+			Valid:        r.Signature.Valid,
+			Reason:       r.Signature.Reason,
+			KeyId:        r.Signature.KeyID,
+			EntityId:     r.Signature.EntityID,
+			PublicKey:    r.Signature.PublicKey,
+			Signature:    r.Signature.Signature,
+			SignedAt:     r.Signature.SignedAt,
+			KeyRevokedAt: r.Signature.KeyRevokedAt,
+		}
+	}
+	return result
+}
+
+func (a apiServer) VerifyVehicleChain(ctx context.Context, request VerifyVehicleChainRequestObject) (VerifyVehicleChainResponseObject, error) {
+	report, err := a.verificationService.VerifyChain(ctx, request.VehicleId)
+	if err != nil {
+		if errors.Is(err, vehicles.ErrVehicleNotFound) {
+			return VerifyVehicleChain404JSONResponse{
+				NotFoundJSONResponse: NotFoundJSONResponse{
+					Error: "Vehicle not found",
+					Code:  "not_found",
+				},
+			}, nil
+		}
+		return nil, err
+	}
+
+	links := make([]ChainLink, len(report.Links))
+	for i, l := range report.Links {
+		links[i] = ChainLink{
+			RecordType:       ChainLinkRecordType(l.RecordType),
+			RecordId:         l.RecordID,
+			Cid:              l.CID,
+			PreviousCid:      l.PreviousCID,
+			BlockchainStatus: ChainLinkBlockchainStatus(l.BlockchainStatus),
+			CreatedAt:        l.CreatedAt,
+		}
+	}
+
+	issues := make([]ChainIssue, len(report.Issues))
+	for i, issue := range report.Issues {
+		issues[i] = ChainIssue{
+			RecordId: issue.RecordID,
+			Cid:      issue.CID,
+			Reason:   issue.Reason,
+		}
+		if issue.RecordType != "" {
+			recordType := ChainIssueRecordType(issue.RecordType)
+			issues[i].RecordType = &recordType
+		}
+	}
+
+	return VerifyVehicleChain200JSONResponse{
+		VehicleId:  report.VehicleID,
+		GenesisCid: report.GenesisCID,
+		HeadCid:    report.HeadCID,
+		Valid:      report.Valid,
+		Links:      links,
+		Issues:     issues,
+	}, nil
+}
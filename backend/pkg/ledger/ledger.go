@@ -0,0 +1,114 @@
+// Package ledger abstracts the chain the platform anchors records on, so the live Algorand
+// network can be swapped for an in-process simulated ledger in development, CI and benchmarks.
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/ledger/simulated"
+)
+
+const (
+	BackendAlgorand  = "algorand"
+	BackendSimulated = "simulated"
+)
+
+// Ledger is the set of chain operations used by the anchorer, the custody and lifecycle workers
+// and the verification service
+type Ledger interface {
+	// Address is the platform account that sends every anchoring transaction
+	Address() string
+	IsOnline(ctx context.Context) bool
+
+	SignAssetCreation(ctx context.Context, params algorand.AssetParams) (*algorand.SignedTransaction, error)
+	SubmitAssetCreation(ctx context.Context, stxn *algorand.SignedTransaction) (*algorand.Transaction, error)
+	AssetCreatedBy(ctx context.Context, txID string, lastValid uint64) (uint64, error)
+	SelfTransferAssets(ctx context.Context, transfers []algorand.SelfTransfer) ([]algorand.Transaction, error)
+	SelfPayment(ctx context.Context, note []byte) (*algorand.Transaction, error)
+
+	// TransferAsset, ClawbackAsset, AssetHolding and AssetHolder move vehicle assets between the
+	// platform account and owner wallets
+	TransferAsset(ctx context.Context, assetID uint64, recipient string, amount uint64, note []byte) (string, error)
+	ClawbackAsset(ctx context.Context, assetID uint64, holder, recipient string, amount uint64, note []byte) (string, error)
+	AssetHolding(ctx context.Context, address string, assetID uint64) (*algorand.AssetHolding, error)
+	AssetHolder(ctx context.Context, assetID uint64) (string, error)
+	// FreezeAsset and DestroyAsset enforce lifecycle changes of vehicles on their assets
+	FreezeAsset(ctx context.Context, assetID uint64, holder string, frozen bool, note []byte) (string, error)
+	DestroyAsset(ctx context.Context, assetID uint64, note []byte) (string, error)
+
+	FindAssetByName(ctx context.Context, name string) (uint64, error)
+	LookupTransaction(ctx context.Context, txID string) (*algorand.Transaction, error)
+	LookupAssetCreation(ctx context.Context, assetID uint64) (*algorand.Transaction, error)
+	// CreatedAssets and AssetTransactions scan the chain to rebuild anchor state
+	CreatedAssets(ctx context.Context, namePrefix string) ([]algorand.CreatedAsset, error)
+	AssetTransactions(ctx context.Context, assetID uint64) ([]algorand.Transaction, error)
+}
+
+// Reader is the read-only subset of Ledger used by the API, which verifies anchors and checks
+// wallet holdings but never signs transactions
+type Reader interface {
+	// Address is the platform account that sends every anchoring transaction
+	Address() string
+	LookupTransaction(ctx context.Context, txID string) (*algorand.Transaction, error)
+	LookupAssetCreation(ctx context.Context, assetID uint64) (*algorand.Transaction, error)
+	AssetHolding(ctx context.Context, address string, assetID uint64) (*algorand.AssetHolding, error)
+}
+
+// Config selects and configures the ledger backend
+type Config struct {
+	// Backend is BackendAlgorand or BackendSimulated. Defaults to BackendAlgorand.
+	Backend   string
+	Algorand  algorand.Config
+	Simulated simulated.Config
+}
+
+// New creates the ledger selected by cfg.Backend
+func New(cfg Config) (Ledger, error) {
+	switch cfg.Backend {
+	case "", BackendAlgorand:
+		client, err := algorand.New(cfg.Algorand)
+		if err != nil {
+			return nil, err
+		}
+		return client, nil
+	case BackendSimulated:
+		sim, err := simulated.New(cfg.Simulated)
+		if err != nil {
+			return nil, err
+		}
+		return sim, nil
+	default:
+		return nil, fmt.Errorf("unknown ledger backend %q", cfg.Backend)
+	}
+}
+
+// ReaderConfig selects and configures the backend of a read-only ledger
+type ReaderConfig struct {
+	// Backend is BackendAlgorand or BackendSimulated. Defaults to BackendAlgorand.
+	Backend   string
+	Algorand  algorand.ReaderConfig
+	Simulated simulated.Config
+}
+
+// NewReader creates the read-only ledger selected by cfg.Backend. The Algorand backend only
+// talks to the indexer and holds no key.
+func NewReader(cfg ReaderConfig) (Reader, error) {
+	switch cfg.Backend {
+	case "", BackendAlgorand:
+		reader, err := algorand.NewReader(cfg.Algorand)
+		if err != nil {
+			return nil, err
+		}
+		return reader, nil
+	case BackendSimulated:
+		sim, err := simulated.New(cfg.Simulated)
+		if err != nil {
+			return nil, err
+		}
+		return sim, nil
+	default:
+		return nil, fmt.Errorf("unknown ledger backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,710 @@
+// Package simulated is a deterministic, in-process stand-in for the Algorand network and indexer.
+//
+// Every submitted transaction, or atomic group, is confirmed immediately in a new round. Transaction
+// IDs, asset IDs, rounds and round times depend only on the sequence of submissions, so the same
+// run produces the same ledger. The state is kept in memory, or in a JSON file when Config.Path is
+// set so it survives restarts and can be read by other processes.
+package simulated
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const (
+	// MaxNoteSize is the largest note Algorand accepts on a transaction
+	MaxNoteSize = 1024
+
+	// MinFee is the fee, in microAlgos, charged for every transaction, as on Algorand at minimum
+	MinFee = 1000
+
+	// creationValidRounds matches how long the live client keeps a signed asset creation valid
+	creationValidRounds = 100
+
+	defaultRoundInterval = 3 * time.Second
+	defaultSeed          = "classicschain-simulated-ledger"
+)
+
+// DefaultGenesisTime is the time of round zero unless configured otherwise
+var DefaultGenesisTime = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var txIDEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Config configures the simulated ledger
+type Config struct {
+	// Path is the JSON file holding the ledger state. Empty keeps the state in memory only.
+	Path string
+	// Mnemonic derives the platform address like the live client does. Empty uses a fixed
+	// address derived from a built-in seed.
+	Mnemonic string
+	// Address sets the platform address instead of deriving it, for processes that only read
+	// the ledger
+	Address string
+	// GenesisTime is the time of round zero. Defaults to DefaultGenesisTime.
+	GenesisTime time.Time
+	// RoundInterval is the time between rounds. Defaults to 3s.
+	RoundInterval time.Duration
+}
+
+// Asset is an asset created on the simulated ledger
+type Asset struct {
+	ID        uint64 `json:"id"`
+	Name      string `json:"name"`
+	UnitName  string `json:"unitName"`
+	URL       string `json:"url"`
+	Total     uint64 `json:"total"`
+	Creator   string `json:"creator"`
+	CreatedAt uint64 `json:"createdAtRound"`
+	// DestroyedAt is the round the asset was destroyed in, zero while it exists
+	DestroyedAt uint64 `json:"destroyedAtRound,omitempty"`
+}
+
+// Holding is the balance of an asset held by an account that opted in to it
+type Holding struct {
+	AssetID uint64 `json:"assetId"`
+	Address string `json:"address"`
+	Amount  uint64 `json:"amount"`
+	Frozen  bool   `json:"frozen,omitempty"`
+}
+
+// state is the persisted part of the ledger
+type state struct {
+	Round        uint64                 `json:"round"`
+	Sequence     uint64                 `json:"sequence"`
+	NextAssetID  uint64                 `json:"nextAssetId"`
+	Assets       []Asset                `json:"assets"`
+	Holdings     []Holding              `json:"holdings,omitempty"`
+	Transactions []algorand.Transaction `json:"transactions"`
+}
+
+// signedCreation is a signed asset creation that has not been submitted yet
+type signedCreation struct {
+	params    algorand.AssetParams
+	lastValid uint64
+}
+
+// Ledger is the simulated ledger. It is safe for concurrent use.
+type Ledger struct {
+	mu            sync.Mutex
+	cfg           Config
+	address       string
+	state         state
+	modTime       time.Time
+	signed        map[string]signedCreation
+	transactionAt map[string]int
+}
+
+// New creates a simulated ledger, loading its state from cfg.Path when the file exists
+func New(cfg Config) (*Ledger, error) {
+	if cfg.GenesisTime.IsZero() {
+		cfg.GenesisTime = DefaultGenesisTime
+	}
+	if cfg.RoundInterval <= 0 {
+		cfg.RoundInterval = defaultRoundInterval
+	}
+
+	address := cfg.Address
+	if address == "" {
+		var err error
+		address, err = platformAddress(cfg.Mnemonic)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	l := &Ledger{
+		cfg:     cfg,
+		address: address,
+		state:   state{NextAssetID: 1001},
+		signed:  map[string]signedCreation{},
+	}
+	l.reindex()
+
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func platformAddress(seedPhrase string) (string, error) {
+	if seedPhrase != "" {
+		privateKey, err := mnemonic.ToPrivateKey(seedPhrase)
+		if err != nil {
+			return "", fmt.Errorf("invalid mnemonic: %w", err)
+		}
+		return types.Address(ed25519.PrivateKey(privateKey).Public().(ed25519.PublicKey)).String(), nil
+	}
+
+	seed := sha256.Sum256([]byte(defaultSeed))
+	publicKey := ed25519.NewKeyFromSeed(seed[:]).Public().(ed25519.PublicKey)
+	return types.Address(publicKey).String(), nil
+}
+
+// Address returns the simulated platform account
+func (l *Ledger) Address() string {
+	return l.address
+}
+
+// IsOnline always reports true, as the ledger runs in-process
+func (l *Ledger) IsOnline(_ context.Context) bool {
+	return true
+}
+
+// Round returns the latest confirmed round
+func (l *Ledger) Round() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_ = l.reload()
+	return l.state.Round
+}
+
+// SignAssetCreation records the asset creation so it can be submitted, or resolved while pending
+func (l *Ledger) SignAssetCreation(_ context.Context, params algorand.AssetParams) (*algorand.SignedTransaction, error) {
+	if len(params.Note) > MaxNoteSize {
+		return nil, fmt.Errorf("note of %d bytes exceeds the maximum of %d", len(params.Note), MaxNoteSize)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	id := l.nextTxID("acfg", 0, params.Note)
+	lastValid := l.state.Round + creationValidRounds
+	l.signed[id] = signedCreation{params: params, lastValid: lastValid}
+
+	return &algorand.SignedTransaction{ID: id, LastValid: lastValid}, nil
+}
+
+// SubmitAssetCreation confirms a signed asset creation in a new round and returns it with the
+// ID of the new asset
+func (l *Ledger) SubmitAssetCreation(_ context.Context, stxn *algorand.SignedTransaction) (*algorand.Transaction, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	creation, ok := l.signed[stxn.ID]
+	if !ok {
+		if _, confirmed := l.transactionAt[stxn.ID]; confirmed {
+			return nil, fmt.Errorf("send asset creation: transaction %s already in ledger", stxn.ID)
+		}
+		return nil, fmt.Errorf("send asset creation: unknown transaction %s", stxn.ID)
+	}
+	if l.state.Round >= creation.lastValid {
+		return nil, fmt.Errorf("send asset creation: %w", algorand.ErrTransactionExpired)
+	}
+
+	round := l.state.Round + 1
+	assetID := l.state.NextAssetID
+	l.state.Assets = append(l.state.Assets, Asset{
+		ID:        assetID,
+		Name:      creation.params.AssetName,
+		UnitName:  creation.params.UnitName,
+		URL:       creation.params.URL,
+		Total:     creation.params.Total,
+		Creator:   l.address,
+		CreatedAt: round,
+	})
+	l.state.NextAssetID++
+	l.state.Holdings = append(l.state.Holdings, Holding{AssetID: assetID, Address: l.address, Amount: creation.params.Total})
+	txn := l.confirm(round, algorand.Transaction{ID: stxn.ID, Type: "acfg", AssetID: assetID, Note: creation.params.Note})
+	delete(l.signed, stxn.ID)
+
+	if err := l.save(); err != nil {
+		return nil, err
+	}
+	return &txn, nil
+}
+
+// AssetCreatedBy resolves the asset created by a signed asset creation. A creation signed by
+// this process stays pending until its last valid round; one that was never submitted here, for
+// example before a restart, can no longer be confirmed and is reported as expired.
+func (l *Ledger) AssetCreatedBy(_ context.Context, txID string, lastValid uint64) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return 0, err
+	}
+
+	if i, ok := l.transactionAt[txID]; ok {
+		return l.state.Transactions[i].AssetID, nil
+	}
+	if _, ok := l.signed[txID]; ok && l.state.Round < lastValid {
+		return 0, algorand.ErrTransactionPending
+	}
+	return 0, algorand.ErrTransactionExpired
+}
+
+// SelfTransferAssets confirms the self-transfers as one atomic group in a new round
+func (l *Ledger) SelfTransferAssets(_ context.Context, transfers []algorand.SelfTransfer) ([]algorand.Transaction, error) {
+	if len(transfers) > algorand.MaxGroupSize {
+		return nil, fmt.Errorf("transaction group of %d exceeds the maximum of %d", len(transfers), algorand.MaxGroupSize)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	// The whole group is rejected when any transfer is invalid
+	for _, t := range transfers {
+		if len(t.Note) > MaxNoteSize {
+			return nil, fmt.Errorf("send transfers: note of %d bytes exceeds the maximum of %d", len(t.Note), MaxNoteSize)
+		}
+		if l.asset(t.AssetID) == nil {
+			return nil, fmt.Errorf("send transfers: asset %d does not exist", t.AssetID)
+		}
+	}
+
+	round := l.state.Round + 1
+	txns := make([]algorand.Transaction, len(transfers))
+	for i, t := range transfers {
+		txID := l.nextTxID("axfer", t.AssetID, t.Note)
+		txns[i] = l.confirm(round, algorand.Transaction{ID: txID, Type: "axfer", AssetID: t.AssetID, Note: t.Note})
+	}
+
+	if err := l.save(); err != nil {
+		return nil, err
+	}
+	return txns, nil
+}
+
+// SelfPayment confirms a zero-amount payment to the platform account in a new round
+func (l *Ledger) SelfPayment(_ context.Context, note []byte) (*algorand.Transaction, error) {
+	if len(note) > MaxNoteSize {
+		return nil, fmt.Errorf("send payment: note of %d bytes exceeds the maximum of %d", len(note), MaxNoteSize)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	txID := l.nextTxID("pay", 0, note)
+	txn := l.confirm(l.state.Round+1, algorand.Transaction{ID: txID, Type: "pay", Note: note})
+
+	if err := l.save(); err != nil {
+		return nil, err
+	}
+	return &txn, nil
+}
+
+// FindAssetByName returns the oldest existing asset with exactly the given name created by the
+// platform account
+func (l *Ledger) FindAssetByName(_ context.Context, name string) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return 0, err
+	}
+
+	for _, asset := range l.state.Assets {
+		if asset.Name == name && asset.Creator == l.address && asset.DestroyedAt == 0 {
+			return asset.ID, nil
+		}
+	}
+	return 0, algorand.ErrAssetNotFound
+}
+
+// LookupTransaction returns a confirmed transaction by its ID
+func (l *Ledger) LookupTransaction(_ context.Context, txID string) (*algorand.Transaction, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	i, ok := l.transactionAt[txID]
+	if !ok {
+		return nil, algorand.ErrTransactionNotFound
+	}
+	txn := l.state.Transactions[i]
+	return &txn, nil
+}
+
+// LookupAssetCreation returns the transaction that created the asset
+func (l *Ledger) LookupAssetCreation(_ context.Context, assetID uint64) (*algorand.Transaction, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	for _, txn := range l.state.Transactions {
+		if txn.Type == "acfg" && txn.AssetID == assetID {
+			return &txn, nil
+		}
+	}
+	return nil, algorand.ErrTransactionNotFound
+}
+
+// AssetTransactions returns every transaction of the asset in confirmation order, like the
+// indexer's /v2/assets/{id}/transactions
+func (l *Ledger) AssetTransactions(_ context.Context, assetID uint64) ([]algorand.Transaction, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	var txns []algorand.Transaction
+	for _, txn := range l.state.Transactions {
+		if txn.AssetID == assetID {
+			txns = append(txns, txn)
+		}
+	}
+	return txns, nil
+}
+
+// CreatedAssets returns the assets created by the platform account whose name starts with
+// namePrefix, including destroyed ones, ordered by asset ID
+func (l *Ledger) CreatedAssets(_ context.Context, namePrefix string) ([]algorand.CreatedAsset, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	var assets []algorand.CreatedAsset
+	for _, asset := range l.state.Assets {
+		if asset.Creator == l.address && strings.HasPrefix(asset.Name, namePrefix) {
+			assets = append(assets, algorand.CreatedAsset{ID: asset.ID, Name: asset.Name, Deleted: asset.DestroyedAt > 0})
+		}
+	}
+	return assets, nil
+}
+
+// OptIn simulates the account opting in to the asset from its own wallet, so it can receive it.
+// Opting in again is a no-op.
+func (l *Ledger) OptIn(_ context.Context, address string, assetID uint64) error {
+	if err := algorand.ValidateAddress(address); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return err
+	}
+
+	if l.asset(assetID) == nil {
+		return fmt.Errorf("opt in: asset %d does not exist", assetID)
+	}
+	if l.holding(assetID, address) != nil {
+		return nil
+	}
+
+	l.state.Holdings = append(l.state.Holdings, Holding{AssetID: assetID, Address: address})
+	txID := l.nextTxID("axfer", assetID, nil)
+	l.confirm(l.state.Round+1, algorand.Transaction{ID: txID, Type: "axfer", Sender: address, AssetID: assetID})
+
+	return l.save()
+}
+
+// TransferAsset sends units of the asset from the platform account to an opted-in recipient
+func (l *Ledger) TransferAsset(_ context.Context, assetID uint64, recipient string, amount uint64, note []byte) (string, error) {
+	return l.move(assetID, l.address, recipient, amount, note, false, "send transfer")
+}
+
+// ClawbackAsset moves units of the asset from holder to an opted-in recipient with the platform
+// account's clawback authority, which also applies to frozen holdings
+func (l *Ledger) ClawbackAsset(_ context.Context, assetID uint64, holder, recipient string, amount uint64, note []byte) (string, error) {
+	return l.move(assetID, holder, recipient, amount, note, true, "send clawback")
+}
+
+// FreezeAsset freezes or unfreezes the holder's units of the asset with the platform account's
+// freeze authority
+func (l *Ledger) FreezeAsset(_ context.Context, assetID uint64, holder string, frozen bool, note []byte) (string, error) {
+	if len(note) > MaxNoteSize {
+		return "", fmt.Errorf("send freeze: note of %d bytes exceeds the maximum of %d", len(note), MaxNoteSize)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return "", err
+	}
+
+	if l.asset(assetID) == nil {
+		return "", fmt.Errorf("send freeze: asset %d does not exist", assetID)
+	}
+	h := l.holding(assetID, holder)
+	if h == nil {
+		return "", fmt.Errorf("send freeze: %s has not opted in to asset %d", holder, assetID)
+	}
+
+	h.Frozen = frozen
+	txID := l.nextTxID("afrz", assetID, note)
+	l.confirm(l.state.Round+1, algorand.Transaction{ID: txID, Type: "afrz", AssetID: assetID, Note: note})
+
+	if err := l.save(); err != nil {
+		return "", err
+	}
+	return txID, nil
+}
+
+// DestroyAsset destroys the asset with the platform account's manager authority. The whole
+// supply must be back in the platform account.
+func (l *Ledger) DestroyAsset(_ context.Context, assetID uint64, note []byte) (string, error) {
+	if len(note) > MaxNoteSize {
+		return "", fmt.Errorf("send destroy: note of %d bytes exceeds the maximum of %d", len(note), MaxNoteSize)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return "", err
+	}
+
+	asset := l.asset(assetID)
+	if asset == nil {
+		return "", fmt.Errorf("send destroy: asset %d does not exist", assetID)
+	}
+	if h := l.holding(assetID, asset.Creator); h == nil || h.Amount != asset.Total {
+		return "", fmt.Errorf("send destroy: creator does not hold the whole supply of asset %d", assetID)
+	}
+
+	round := l.state.Round + 1
+	asset.DestroyedAt = round
+	l.state.Holdings = slices.DeleteFunc(l.state.Holdings, func(h Holding) bool { return h.AssetID == assetID })
+	txID := l.nextTxID("acfg", assetID, note)
+	l.confirm(round, algorand.Transaction{ID: txID, Type: "acfg", AssetID: assetID, Note: note})
+
+	if err := l.save(); err != nil {
+		return "", err
+	}
+	return txID, nil
+}
+
+// AssetHolding returns the account's holding of the asset
+func (l *Ledger) AssetHolding(_ context.Context, address string, assetID uint64) (*algorand.AssetHolding, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+
+	h := l.holding(assetID, address)
+	if h == nil {
+		return &algorand.AssetHolding{}, nil
+	}
+	return &algorand.AssetHolding{OptedIn: true, Amount: h.Amount, Frozen: h.Frozen}, nil
+}
+
+// AssetHolder returns the first account holding units of the asset
+func (l *Ledger) AssetHolder(_ context.Context, assetID uint64) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return "", err
+	}
+
+	for _, h := range l.state.Holdings {
+		if h.AssetID == assetID && h.Amount > 0 {
+			return h.Address, nil
+		}
+	}
+	return "", algorand.ErrAssetNotFound
+}
+
+// move confirms an asset transfer sent by the platform account from one holding to another.
+// Frozen holdings can neither send nor receive, unless clawback revokes units from them.
+func (l *Ledger) move(assetID uint64, from, to string, amount uint64, note []byte, clawback bool, action string) (string, error) {
+	if len(note) > MaxNoteSize {
+		return "", fmt.Errorf("%s: note of %d bytes exceeds the maximum of %d", action, len(note), MaxNoteSize)
+	}
+	if err := algorand.ValidateAddress(to); err != nil {
+		return "", fmt.Errorf("%s: invalid recipient: %w", action, err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.reload(); err != nil {
+		return "", err
+	}
+
+	if l.asset(assetID) == nil {
+		return "", fmt.Errorf("%s: asset %d does not exist", action, assetID)
+	}
+	source := l.holding(assetID, from)
+	if source == nil || source.Amount < amount {
+		return "", fmt.Errorf("%s: %s holds less than %d of asset %d", action, from, amount, assetID)
+	}
+	target := l.holding(assetID, to)
+	if target == nil {
+		return "", fmt.Errorf("%s: %s has not opted in to asset %d", action, to, assetID)
+	}
+	if source.Frozen && !clawback {
+		return "", fmt.Errorf("%s: holding of asset %d by %s is frozen", action, assetID, from)
+	}
+	if target.Frozen {
+		return "", fmt.Errorf("%s: holding of asset %d by %s is frozen", action, assetID, to)
+	}
+
+	source.Amount -= amount
+	target.Amount += amount
+	txID := l.nextTxID("axfer", assetID, note)
+	l.confirm(l.state.Round+1, algorand.Transaction{ID: txID, Type: "axfer", AssetID: assetID, Note: note})
+
+	if err := l.save(); err != nil {
+		return "", err
+	}
+	return txID, nil
+}
+
+func (l *Ledger) holding(assetID uint64, address string) *Holding {
+	for i := range l.state.Holdings {
+		if l.state.Holdings[i].AssetID == assetID && l.state.Holdings[i].Address == address {
+			return &l.state.Holdings[i]
+		}
+	}
+	return nil
+}
+
+// asset returns the asset unless it does not exist or was destroyed
+func (l *Ledger) asset(id uint64) *Asset {
+	for i := range l.state.Assets {
+		if l.state.Assets[i].ID == id && l.state.Assets[i].DestroyedAt == 0 {
+			return &l.state.Assets[i]
+		}
+	}
+	return nil
+}
+
+// confirm appends the transaction to the ledger in the given round and returns it as confirmed.
+// Transactions are sent by the platform account unless a sender is set.
+func (l *Ledger) confirm(round uint64, txn algorand.Transaction) algorand.Transaction {
+	if txn.Sender == "" {
+		txn.Sender = l.address
+	}
+	txn.Fee = MinFee
+	txn.ConfirmedRound = round
+	txn.RoundTime = l.cfg.GenesisTime.Add(time.Duration(round) * l.cfg.RoundInterval)
+
+	l.state.Round = round
+	l.transactionAt[txn.ID] = len(l.state.Transactions)
+	l.state.Transactions = append(l.state.Transactions, txn)
+	return txn
+}
+
+// nextTxID derives a unique, deterministic transaction ID in the same format as Algorand's
+func (l *Ledger) nextTxID(txType string, assetID uint64, note []byte) string {
+	l.state.Sequence++
+
+	h := sha256.New()
+	h.Write([]byte(txType))
+	binary.Write(h, binary.BigEndian, l.state.Sequence)
+	binary.Write(h, binary.BigEndian, assetID)
+	h.Write(note)
+	return txIDEncoding.EncodeToString(h.Sum(nil))
+}
+
+func (l *Ledger) reindex() {
+	l.transactionAt = make(map[string]int, len(l.state.Transactions))
+	for i, txn := range l.state.Transactions {
+		l.transactionAt[txn.ID] = i
+	}
+}
+
+// reload reads the state file when another process has written it since it was last read
+func (l *Ledger) reload() error {
+	if l.cfg.Path == "" {
+		return nil
+	}
+
+	info, err := os.Stat(l.cfg.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("stat simulated ledger: %w", err)
+	}
+	if info.ModTime().Equal(l.modTime) {
+		return nil
+	}
+
+	data, err := os.ReadFile(l.cfg.Path)
+	if err != nil {
+		return fmt.Errorf("read simulated ledger: %w", err)
+	}
+	var loaded state
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return fmt.Errorf("decode simulated ledger: %w", err)
+	}
+
+	l.state = loaded
+	l.modTime = info.ModTime()
+	l.reindex()
+	l.backfillHoldings()
+	return nil
+}
+
+// backfillHoldings gives the creator the supply of assets created before holdings were
+// recorded
+func (l *Ledger) backfillHoldings() {
+	held := make(map[uint64]bool, len(l.state.Holdings))
+	for _, h := range l.state.Holdings {
+		held[h.AssetID] = true
+	}
+	for _, asset := range l.state.Assets {
+		if !held[asset.ID] && asset.DestroyedAt == 0 {
+			l.state.Holdings = append(l.state.Holdings, Holding{AssetID: asset.ID, Address: asset.Creator, Amount: asset.Total})
+		}
+	}
+}
+
+// save atomically replaces the state file
+func (l *Ledger) save() error {
+	if l.cfg.Path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(l.state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode simulated ledger: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.cfg.Path), filepath.Base(l.cfg.Path)+".*")
+	if err != nil {
+		return fmt.Errorf("write simulated ledger: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write simulated ledger: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write simulated ledger: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), l.cfg.Path); err != nil {
+		return fmt.Errorf("write simulated ledger: %w", err)
+	}
+
+	if info, err := os.Stat(l.cfg.Path); err == nil {
+		l.modTime = info.ModTime()
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"path/filepath"
+	"testing"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/algorand"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLedger(t *testing.T, cfg Config) *Ledger {
+	t.Helper()
+	l, err := New(cfg)
+	require.NoError(t, err)
+	return l
+}
+
+func createAsset(t *testing.T, l *Ledger, name string) (uint64, string) {
+	t.Helper()
+	ctx := context.Background()
+	stxn, err := l.SignAssetCreation(ctx, algorand.AssetParams{AssetName: name, UnitName: "CCV", Total: 1, Note: []byte("type=genesis|cid=bafy")})
+	require.NoError(t, err)
+	created, err := l.SubmitAssetCreation(ctx, stxn)
+	require.NoError(t, err)
+	return created.AssetID, stxn.ID
+}
+
+func TestLedger_AssetCreationIsIndexed(t *testing.T) {
+	ctx := context.Background()
+	l := newLedger(t, Config{})
+
+	assetID, txID := createAsset(t, l, "CC_vehicle")
+
+	found, err := l.FindAssetByName(ctx, "CC_vehicle")
+	require.NoError(t, err)
+	assert.Equal(t, assetID, found)
+
+	creation, err := l.LookupAssetCreation(ctx, assetID)
+	require.NoError(t, err)
+	assert.Equal(t, txID, creation.ID)
+	assert.Equal(t, "acfg", creation.Type)
+	assert.Equal(t, l.Address(), creation.Sender)
+	assert.Equal(t, uint64(1), creation.ConfirmedRound)
+	assert.Equal(t, "type=genesis|cid=bafy", string(creation.Note))
+
+	resolved, err := l.AssetCreatedBy(ctx, txID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, assetID, resolved)
+}
+
+func TestLedger_SignedCreationIsPendingUntilSubmitted(t *testing.T) {
+	ctx := context.Background()
+	l := newLedger(t, Config{})
+
+	stxn, err := l.SignAssetCreation(ctx, algorand.AssetParams{AssetName: "CC_vehicle"})
+	require.NoError(t, err)
+
+	_, err = l.AssetCreatedBy(ctx, stxn.ID, stxn.LastValid)
+	assert.ErrorIs(t, err, algorand.ErrTransactionPending)
+
+	_, err = l.AssetCreatedBy(ctx, "UNKNOWN", stxn.LastValid)
+	assert.ErrorIs(t, err, algorand.ErrTransactionExpired)
+
+	_, err = l.FindAssetByName(ctx, "CC_vehicle")
+	assert.ErrorIs(t, err, algorand.ErrAssetNotFound)
+}
+
+func TestLedger_GroupIsConfirmedInOneRound(t *testing.T) {
+	ctx := context.Background()
+	l := newLedger(t, Config{})
+	assetID, _ := createAsset(t, l, "CC_vehicle")
+
+	confirmed, err := l.SelfTransferAssets(ctx, []algorand.SelfTransfer{
+		{AssetID: assetID, Note: []byte("type=new_event|cid=a")},
+		{AssetID: assetID, Note: []byte("type=new_event|cid=b")},
+	})
+	require.NoError(t, err)
+	require.Len(t, confirmed, 2)
+	assert.NotEqual(t, confirmed[0].ID, confirmed[1].ID)
+
+	first, err := l.LookupTransaction(ctx, confirmed[0].ID)
+	require.NoError(t, err)
+	second, err := l.LookupTransaction(ctx, confirmed[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, first.ConfirmedRound, second.ConfirmedRound)
+	assert.Equal(t, uint64(2), l.Round())
+
+	// The returned outcome is the one the indexer reports
+	assert.Equal(t, *first, confirmed[0])
+	assert.Equal(t, uint64(MinFee), first.Fee)
+	assert.Equal(t, DefaultGenesisTime.Add(2*defaultRoundInterval), first.RoundTime)
+
+	txns, err := l.AssetTransactions(ctx, assetID)
+	require.NoError(t, err)
+	assert.Len(t, txns, 3)
+}
+
+func TestLedger_InvalidTransferRejectsGroup(t *testing.T) {
+	ctx := context.Background()
+	l := newLedger(t, Config{})
+	assetID, _ := createAsset(t, l, "CC_vehicle")
+
+	_, err := l.SelfTransferAssets(ctx, []algorand.SelfTransfer{
+		{AssetID: assetID, Note: []byte("type=new_event|cid=a")},
+		{AssetID: 99, Note: []byte("type=new_event|cid=b")},
+	})
+	require.Error(t, err)
+
+	_, err = l.SelfPayment(ctx, make([]byte, MaxNoteSize+1))
+	require.Error(t, err)
+
+	assert.Equal(t, uint64(1), l.Round())
+}
+
+func TestLedger_Deterministic(t *testing.T) {
+	run := func() []string {
+		l := newLedger(t, Config{})
+		assetID, genesisTx := createAsset(t, l, "CC_vehicle")
+		transfers, err := l.SelfTransferAssets(context.Background(), []algorand.SelfTransfer{{AssetID: assetID, Note: []byte("n")}})
+		require.NoError(t, err)
+		payment, err := l.SelfPayment(context.Background(), []byte("type=merkle_root|root=ab"))
+		require.NoError(t, err)
+		return []string{l.Address(), genesisTx, transfers[0].ID, payment.ID}
+	}
+
+	assert.Equal(t, run(), run())
+}
+
+func TestLedger_StatePersistsOnDisk(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ledger.json")
+	writer := newLedger(t, Config{Path: path})
+	assetID, _ := createAsset(t, writer, "CC_vehicle")
+	payment, err := writer.SelfPayment(ctx, []byte("root"))
+	require.NoError(t, err)
+
+	reader := newLedger(t, Config{Path: path})
+
+	found, err := reader.FindAssetByName(ctx, "CC_vehicle")
+	require.NoError(t, err)
+	assert.Equal(t, assetID, found)
+	txn, err := reader.LookupTransaction(ctx, payment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pay", txn.Type)
+	assert.Equal(t, writer.Round(), reader.Round())
+
+	// A second asset continues the sequence instead of reusing IDs
+	next, _ := createAsset(t, reader, "CC_other")
+	assert.Equal(t, assetID+1, next)
+}
+
+func TestLedger_ReaderUsesConfiguredAddress(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ledger.json")
+	writer := newLedger(t, Config{Path: path})
+	_, txID := createAsset(t, writer, "CC_vehicle")
+
+	// Read-only processes get the platform address instead of the mnemonic
+	reader := newLedger(t, Config{Path: path, Address: writer.Address()})
+
+	assert.Equal(t, writer.Address(), reader.Address())
+	txn, err := reader.LookupTransaction(ctx, txID)
+	require.NoError(t, err)
+	assert.Equal(t, writer.Address(), txn.Sender)
+}
+
+func TestLedger_CustodyTransfers(t *testing.T) {
+	ctx := context.Background()
+	l := newLedger(t, Config{})
+	assetID, _ := createAsset(t, l, "CC_vehicle")
+	owner := testAddress(t, "owner")
+	buyer := testAddress(t, "buyer")
+
+	_, err := l.TransferAsset(ctx, assetID, owner, 1, []byte("type=custody"))
+	assert.Error(t, err, "recipient has not opted in")
+
+	require.NoError(t, l.OptIn(ctx, owner, assetID))
+	holding, err := l.AssetHolding(ctx, owner, assetID)
+	require.NoError(t, err)
+	assert.Equal(t, algorand.AssetHolding{OptedIn: true}, *holding)
+
+	_, err = l.TransferAsset(ctx, assetID, owner, 1, []byte("type=custody"))
+	require.NoError(t, err)
+	holder, err := l.AssetHolder(ctx, assetID)
+	require.NoError(t, err)
+	assert.Equal(t, owner, holder)
+
+	require.NoError(t, l.OptIn(ctx, buyer, assetID))
+	txID, err := l.ClawbackAsset(ctx, assetID, owner, buyer, 1, []byte("type=custody"))
+	require.NoError(t, err)
+	holder, err = l.AssetHolder(ctx, assetID)
+	require.NoError(t, err)
+	assert.Equal(t, buyer, holder)
+
+	clawback, err := l.LookupTransaction(ctx, txID)
+	require.NoError(t, err)
+	assert.Equal(t, l.Address(), clawback.Sender)
+
+	_, err = l.ClawbackAsset(ctx, assetID, owner, buyer, 1, nil)
+	assert.Error(t, err, "owner no longer holds the asset")
+
+	// Opt-ins are sent by the opting-in account
+	txns, err := l.AssetTransactions(ctx, assetID)
+	require.NoError(t, err)
+	assert.Equal(t, owner, txns[1].Sender)
+}
+
+func TestLedger_HoldingsSurviveRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ledger.json")
+	l := newLedger(t, Config{Path: path})
+	assetID, _ := createAsset(t, l, "CC_vehicle")
+	owner := testAddress(t, "owner")
+	require.NoError(t, l.OptIn(ctx, owner, assetID))
+	_, err := l.TransferAsset(ctx, assetID, owner, 1, nil)
+	require.NoError(t, err)
+
+	reopened := newLedger(t, Config{Path: path})
+	holder, err := reopened.AssetHolder(ctx, assetID)
+	require.NoError(t, err)
+	assert.Equal(t, owner, holder)
+}
+
+func TestLedger_FrozenHoldingOnlyYieldsToClawback(t *testing.T) {
+	ctx := context.Background()
+	l := newLedger(t, Config{})
+	assetID, _ := createAsset(t, l, "CC_vehicle")
+	owner := testAddress(t, "owner")
+	require.NoError(t, l.OptIn(ctx, owner, assetID))
+	_, err := l.TransferAsset(ctx, assetID, owner, 1, nil)
+	require.NoError(t, err)
+
+	_, err = l.FreezeAsset(ctx, assetID, owner, true, []byte("type=lifecycle"))
+	require.NoError(t, err)
+	holding, err := l.AssetHolding(ctx, owner, assetID)
+	require.NoError(t, err)
+	assert.True(t, holding.Frozen)
+
+	buyer := testAddress(t, "buyer")
+	require.NoError(t, l.OptIn(ctx, buyer, assetID))
+	_, err = l.FreezeAsset(ctx, assetID, buyer, true, nil)
+	require.NoError(t, err)
+	_, err = l.ClawbackAsset(ctx, assetID, owner, buyer, 1, nil)
+	assert.Error(t, err, "frozen holdings cannot receive")
+
+	_, err = l.ClawbackAsset(ctx, assetID, owner, l.Address(), 1, nil)
+	require.NoError(t, err)
+	holder, err := l.AssetHolder(ctx, assetID)
+	require.NoError(t, err)
+	assert.Equal(t, l.Address(), holder)
+}
+
+func TestLedger_DestroyedAssetStaysIndexed(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "ledger.json")
+	l := newLedger(t, Config{Path: path})
+	assetID, _ := createAsset(t, l, "CC_vehicle")
+	owner := testAddress(t, "owner")
+	require.NoError(t, l.OptIn(ctx, owner, assetID))
+	_, err := l.TransferAsset(ctx, assetID, owner, 1, nil)
+	require.NoError(t, err)
+
+	_, err = l.DestroyAsset(ctx, assetID, nil)
+	assert.Error(t, err, "the supply is not back in the platform account")
+
+	_, err = l.ClawbackAsset(ctx, assetID, owner, l.Address(), 1, nil)
+	require.NoError(t, err)
+	_, err = l.DestroyAsset(ctx, assetID, []byte("type=lifecycle"))
+	require.NoError(t, err)
+
+	_, err = l.SelfTransferAssets(ctx, []algorand.SelfTransfer{{AssetID: assetID}})
+	assert.Error(t, err)
+
+	reopened := newLedger(t, Config{Path: path})
+	_, err = reopened.AssetHolder(ctx, assetID)
+	assert.ErrorIs(t, err, algorand.ErrAssetNotFound)
+	assets, err := reopened.CreatedAssets(ctx, "CC_")
+	require.NoError(t, err)
+	assert.Equal(t, []algorand.CreatedAsset{{ID: assetID, Name: "CC_vehicle", Deleted: true}}, assets)
+}
+
+// testAddress derives a valid Algorand address from a name
+func testAddress(t *testing.T, name string) string {
+	t.Helper()
+	seed := sha256.Sum256([]byte(name))
+	publicKey := ed25519.NewKeyFromSeed(seed[:]).Public().(ed25519.PublicKey)
+	return types.Address(publicKey).String()
+}
@@ -3,8 +3,10 @@ package mailer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
+	"github.com/google/uuid"
 	"github.com/resend/resend-go/v3"
 )
 
@@ -101,3 +103,108 @@ func (m *Mailer) SendOwnerInvitation(ctx context.Context, to, token string, vehi
 
 	return nil
 }
+
+func (m *Mailer) SendOwnershipTransfer(ctx context.Context, to, token string, vehicle invitation.VehicleInfo) error {
+	baseURL := m.config.WebBaseURL
+	if baseURL == "" {
+		baseURL = m.config.BaseURL
+	}
+	transferURL := fmt.Sprintf("%s/transfer?transfer=%s", baseURL, token)
+
+	subject := "A classic vehicle is being transferred to you on Classics Chain"
+	htmlBody := RenderOwnershipTransferTemplate(transferURL, vehicle)
+
+	params := &resend.SendEmailRequest{
+		From:    fmt.Sprintf("%s <%s>", m.config.FromName, m.config.FromEmail),
+		To:      []string{to},
+		Subject: subject,
+		Html:    htmlBody,
+	}
+
+	_, err := m.client.Emails.Send(params)
+	if err != nil {
+		return fmt.Errorf("send ownership transfer email: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Mailer) SendEventProposal(ctx context.Context, to string, vehicleID, eventID uuid.UUID, vehicle invitation.VehicleInfo, eventTitle string) error {
+	baseURL := m.config.WebBaseURL
+	if baseURL == "" {
+		baseURL = m.config.BaseURL
+	}
+	reviewURL := fmt.Sprintf("%s/vehicles/%s?proposal=%s", baseURL, vehicleID, eventID)
+
+	subject := "An event awaits your approval on Classics Chain"
+	htmlBody := RenderEventProposalTemplate(reviewURL, vehicle, eventTitle)
+
+	params := &resend.SendEmailRequest{
+		From:    fmt.Sprintf("%s <%s>", m.config.FromName, m.config.FromEmail),
+		To:      []string{to},
+		Subject: subject,
+		Html:    htmlBody,
+	}
+
+	_, err := m.client.Emails.Send(params)
+	if err != nil {
+		return fmt.Errorf("send event proposal email: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Mailer) SendCertificationExpiryReminder(ctx context.Context, to string, vehicleID uuid.UUID, vehicle invitation.VehicleInfo, entityName string, certificateNumber *string, validUntil time.Time, daysLeft int) error {
+	baseURL := m.config.WebBaseURL
+	if baseURL == "" {
+		baseURL = m.config.BaseURL
+	}
+	passportURL := fmt.Sprintf("%s/p/%s", baseURL, vehicleID)
+
+	subject := fmt.Sprintf("A certification expires in %d days", daysLeft)
+	if daysLeft == 1 {
+		subject = "A certification expires tomorrow"
+	} else if daysLeft <= 0 {
+		subject = "A certification expires today"
+	}
+	htmlBody := RenderCertificationExpiryReminderTemplate(passportURL, vehicle, entityName, certificateNumber, validUntil)
+
+	params := &resend.SendEmailRequest{
+		From:    fmt.Sprintf("%s <%s>", m.config.FromName, m.config.FromEmail),
+		To:      []string{to},
+		Subject: subject,
+		Html:    htmlBody,
+	}
+
+	_, err := m.client.Emails.Send(params)
+	if err != nil {
+		return fmt.Errorf("send certification expiry reminder email: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Mailer) SendStolenVehicleAlert(ctx context.Context, to string, vehicleID uuid.UUID, vehicle invitation.VehicleInfo, entityName, kind string, policeReference *string) error {
+	baseURL := m.config.WebBaseURL
+	if baseURL == "" {
+		baseURL = m.config.BaseURL
+	}
+	passportURL := fmt.Sprintf("%s/p/%s", baseURL, vehicleID)
+
+	subject := fmt.Sprintf("A vehicle you worked on has been reported %s", kind)
+	htmlBody := RenderStolenVehicleAlertTemplate(passportURL, vehicle, entityName, kind, policeReference)
+
+	params := &resend.SendEmailRequest{
+		From:    fmt.Sprintf("%s <%s>", m.config.FromName, m.config.FromEmail),
+		To:      []string{to},
+		Subject: subject,
+		Html:    htmlBody,
+	}
+
+	_, err := m.client.Emails.Send(params)
+	if err != nil {
+		return fmt.Errorf("send stolen vehicle alert email: %w", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package mailer
 
 import (
 	"fmt"
+	"html"
+	"time"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/invitation"
 )
@@ -229,3 +231,332 @@ func RenderOwnerInvitationTemplate(email, invitationURL string, vehicles []invit
 </html>
 `, vehiclesWord, vehiclesWord, vehicleListHTML, invitationURL, invitationURL, invitationURL)
 }
+
+func RenderOwnershipTransferTemplate(transferURL string, vehicle invitation.VehicleInfo) string {
+	vehicleDesc := "Classic Vehicle"
+	if vehicle.Year > 0 && vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%d %s %s", vehicle.Year, vehicle.Make, vehicle.Model)
+	} else if vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%s %s", vehicle.Make, vehicle.Model)
+	}
+
+	plateInfo := ""
+	if vehicle.LicensePlate != "" {
+		plateInfo = fmt.Sprintf(" (License Plate: %s)", vehicle.LicensePlate)
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #f5f5f5; padding: 20px; border-radius: 5px; margin-bottom: 20px; }
+        .content { margin: 20px 0; }
+        .button {
+            display: inline-block;
+            padding: 12px 24px;
+            background-color: #ccc;
+            color: white;
+            text-decoration: none;
+            border-radius: 4px;
+            font-weight: 500;
+            margin: 20px 0;
+        }
+        .vehicle-list {
+            background-color: #e8f4f8;
+            padding: 15px;
+            border-radius: 5px;
+            margin: 20px 0;
+            border-left: 4px solid #2563eb;
+        }
+        .footer { margin-top: 30px; padding-top: 20px; border-top: 1px solid #ddd; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h2>Vehicle Ownership Transfer</h2>
+            <p>The owner of a classic vehicle wants to transfer it to you</p>
+        </div>
+
+        <div class="content">
+            <p>Hi there,</p>
+            <p>The current owner has started transferring the following vehicle to you on Classics Chain:</p>
+
+            <div class="vehicle-list">
+                <strong>•</strong> %s%s
+            </div>
+
+            <p>Accept the transfer to become the registered owner. The vehicle's history, certificates, and events move with it, and the transfer is recorded on the blockchain.</p>
+
+            <p style="text-align: center;">
+                <a href="%s" class="button">Accept Transfer</a>
+            </p>
+
+            <p style="color: #666; font-size: 14px;">Or copy and paste this link into your browser:<br>
+            <a href="%s" style="color: #2563eb; word-break: break-all;">%s</a></p>
+
+            <p style="color: #999; font-size: 12px; margin-top: 20px;">This transfer expires in 7 days. If you were not expecting it, you can ignore this email.</p>
+        </div>
+
+        <div class="footer">
+            <p>This is an automated message. Please do not reply to this email.</p>
+            <p>&copy; Classics Chain. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>
+`, vehicleDesc, plateInfo, transferURL, transferURL, transferURL)
+}
+
+func RenderEventProposalTemplate(reviewURL string, vehicle invitation.VehicleInfo, eventTitle string) string {
+	vehicleDesc := "Classic Vehicle"
+	if vehicle.Year > 0 && vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%d %s %s", vehicle.Year, vehicle.Make, vehicle.Model)
+	} else if vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%s %s", vehicle.Make, vehicle.Model)
+	}
+
+	plateInfo := ""
+	if vehicle.LicensePlate != "" {
+		plateInfo = fmt.Sprintf(" (License Plate: %s)", vehicle.LicensePlate)
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #f5f5f5; padding: 20px; border-radius: 5px; margin-bottom: 20px; }
+        .content { margin: 20px 0; }
+        .button {
+            display: inline-block;
+            padding: 12px 24px;
+            background-color: #ccc;
+            color: white;
+            text-decoration: none;
+            border-radius: 4px;
+            font-weight: 500;
+            margin: 20px 0;
+        }
+        .vehicle-list {
+            background-color: #e8f4f8;
+            padding: 15px;
+            border-radius: 5px;
+            margin: 20px 0;
+            border-left: 4px solid #2563eb;
+        }
+        .footer { margin-top: 30px; padding-top: 20px; border-top: 1px solid #ddd; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h2>Event Awaiting Your Approval</h2>
+            <p>An organisation wants to add an event to your vehicle's history</p>
+        </div>
+
+        <div class="content">
+            <p>Hi there,</p>
+            <p>The following event has been proposed for your vehicle <strong>%s%s</strong>:</p>
+
+            <div class="vehicle-list">
+                <strong>•</strong> %s
+            </div>
+
+            <p>The event only becomes part of the vehicle's permanent record, and is recorded on the blockchain, once you accept it. If you did not take part in it, you can reject it.</p>
+
+            <p style="text-align: center;">
+                <a href="%s" class="button">Review Event</a>
+            </p>
+
+            <p style="color: #666; font-size: 14px;">Or copy and paste this link into your browser:<br>
+            <a href="%s" style="color: #2563eb; word-break: break-all;">%s</a></p>
+        </div>
+
+        <div class="footer">
+            <p>This is an automated message. Please do not reply to this email.</p>
+            <p>&copy; Classics Chain. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>
+`, vehicleDesc, plateInfo, html.EscapeString(eventTitle), reviewURL, reviewURL, reviewURL)
+}
+
+func RenderCertificationExpiryReminderTemplate(passportURL string, vehicle invitation.VehicleInfo, entityName string, certificateNumber *string, validUntil time.Time) string {
+	vehicleDesc := "Classic Vehicle"
+	if vehicle.Year > 0 && vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%d %s %s", vehicle.Year, vehicle.Make, vehicle.Model)
+	} else if vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%s %s", vehicle.Make, vehicle.Model)
+	}
+
+	plateInfo := ""
+	if vehicle.LicensePlate != "" {
+		plateInfo = fmt.Sprintf(" (License Plate: %s)", vehicle.LicensePlate)
+	}
+
+	certificateInfo := ""
+	if certificateNumber != nil && *certificateNumber != "" {
+		certificateInfo = fmt.Sprintf(" (certificate %s)", html.EscapeString(*certificateNumber))
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #f5f5f5; padding: 20px; border-radius: 5px; margin-bottom: 20px; }
+        .content { margin: 20px 0; }
+        .button {
+            display: inline-block;
+            padding: 12px 24px;
+            background-color: #ccc;
+            color: white;
+            text-decoration: none;
+            border-radius: 4px;
+            font-weight: 500;
+            margin: 20px 0;
+        }
+        .vehicle-list {
+            background-color: #e8f4f8;
+            padding: 15px;
+            border-radius: 5px;
+            margin: 20px 0;
+            border-left: 4px solid #2563eb;
+        }
+        .footer { margin-top: 30px; padding-top: 20px; border-top: 1px solid #ddd; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h2>Certification Expiring Soon</h2>
+            <p>A certification recorded on Classics Chain is about to expire</p>
+        </div>
+
+        <div class="content">
+            <p>Hi there,</p>
+            <p>The certification issued by <strong>%s</strong>%s for the vehicle <strong>%s%s</strong> is valid until:</p>
+
+            <div class="vehicle-list">
+                <strong>•</strong> %s
+            </div>
+
+            <p>Once it expires it will no longer be shown as active on the vehicle's passport. Contact the certifier to arrange a renewal.</p>
+
+            <p style="text-align: center;">
+                <a href="%s" class="button">View Passport</a>
+            </p>
+
+            <p style="color: #666; font-size: 14px;">Or copy and paste this link into your browser:<br>
+            <a href="%s" style="color: #2563eb; word-break: break-all;">%s</a></p>
+        </div>
+
+        <div class="footer">
+            <p>This is an automated message. Please do not reply to this email.</p>
+            <p>&copy; Classics Chain. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>
+`, html.EscapeString(entityName), certificateInfo, vehicleDesc, plateInfo, validUntil.Format("2 January 2006"), passportURL, passportURL, passportURL)
+}
+
+func RenderStolenVehicleAlertTemplate(passportURL string, vehicle invitation.VehicleInfo, entityName, kind string, policeReference *string) string {
+	vehicleDesc := "Classic Vehicle"
+	if vehicle.Year > 0 && vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%d %s %s", vehicle.Year, vehicle.Make, vehicle.Model)
+	} else if vehicle.Make != "" && vehicle.Model != "" {
+		vehicleDesc = fmt.Sprintf("%s %s", vehicle.Make, vehicle.Model)
+	}
+
+	plateInfo := ""
+	if vehicle.LicensePlate != "" {
+		plateInfo = fmt.Sprintf(" (License Plate: %s)", html.EscapeString(vehicle.LicensePlate))
+	}
+
+	kindTitle := "Stolen"
+	if kind == "missing" {
+		kindTitle = "Missing"
+	}
+
+	policeInfo := "No police report reference was given."
+	if policeReference != nil && *policeReference != "" {
+		policeInfo = fmt.Sprintf("Police report reference: %s", html.EscapeString(*policeReference))
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #fdecea; padding: 20px; border-radius: 5px; margin-bottom: 20px; }
+        .content { margin: 20px 0; }
+        .button {
+            display: inline-block;
+            padding: 12px 24px;
+            background-color: #ccc;
+            color: white;
+            text-decoration: none;
+            border-radius: 4px;
+            font-weight: 500;
+            margin: 20px 0;
+        }
+        .vehicle-list {
+            background-color: #fdecea;
+            padding: 15px;
+            border-radius: 5px;
+            margin: 20px 0;
+            border-left: 4px solid #dc2626;
+        }
+        .footer { margin-top: 30px; padding-top: 20px; border-top: 1px solid #ddd; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h2>Vehicle Reported %s</h2>
+            <p>A vehicle that %s has recorded events for has been flagged on Classics Chain</p>
+        </div>
+
+        <div class="content">
+            <p>Hi there,</p>
+            <p>The owner of the vehicle <strong>%s%s</strong> has reported it %s, and the report has been approved. The vehicle cannot change hands on Classics Chain until it is recovered.</p>
+
+            <div class="vehicle-list">
+                <strong>•</strong> %s
+            </div>
+
+            <p>If the vehicle is brought to you, or you have any information about it, please contact the police.</p>
+
+            <p style="text-align: center;">
+                <a href="%s" class="button">View Passport</a>
+            </p>
+
+            <p style="color: #666; font-size: 14px;">Or copy and paste this link into your browser:<br>
+            <a href="%s" style="color: #2563eb; word-break: break-all;">%s</a></p>
+        </div>
+
+        <div class="footer">
+            <p>This is an automated message. Please do not reply to this email.</p>
+            <p>&copy; Classics Chain. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>
+`, kindTitle, html.EscapeString(entityName), vehicleDesc, plateInfo, kind, policeInfo, passportURL, passportURL, passportURL)
+}
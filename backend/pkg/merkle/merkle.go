@@ -0,0 +1,128 @@
+// Package merkle builds binary SHA-256 Merkle trees over record CIDs and the inclusion proofs
+// that tie a single CID to an anchored root.
+//
+// Leaves and inner nodes are hashed with distinct prefixes so an inner node can never be passed
+// off as a leaf. A node without a sibling is promoted to the next level unchanged.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+// Step is a sibling hash on the path from a leaf to the root
+type Step struct {
+	Hash string `json:"hash"`
+	// Left is true when the sibling is the left-hand node
+	Left bool `json:"left"`
+}
+
+// Proof shows that a leaf is included in the tree with the given root
+type Proof struct {
+	Root  string `json:"root"`
+	Index int    `json:"index"`
+	Steps []Step `json:"steps"`
+}
+
+// Tree is a Merkle tree over a list of CIDs
+type Tree struct {
+	// levels[0] holds the leaf hashes, the last level holds the root
+	levels [][][]byte
+}
+
+// Build creates a tree over the CIDs in order. It returns nil when there are no CIDs.
+func Build(cids []string) *Tree {
+	if len(cids) == 0 {
+		return nil
+	}
+
+	level := make([][]byte, len(cids))
+	for i, cid := range cids {
+		level[i] = LeafHash(cid)
+	}
+
+	levels := [][][]byte{level}
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, nodeHash(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &Tree{levels: levels}
+}
+
+// Root returns the hex-encoded root hash
+func (t *Tree) Root() string {
+	return hex.EncodeToString(t.levels[len(t.levels)-1][0])
+}
+
+// Proof returns the inclusion proof of the leaf at index
+func (t *Tree) Proof(index int) Proof {
+	proof := Proof{Root: t.Root(), Index: index, Steps: []Step{}}
+
+	i := index
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := i ^ 1
+		if sibling < len(level) {
+			proof.Steps = append(proof.Steps, Step{
+				Hash: hex.EncodeToString(level[sibling]),
+				Left: sibling < i,
+			})
+		}
+		i /= 2
+	}
+
+	return proof
+}
+
+// Verify checks that the proof leads from the CID to the proof's root
+func Verify(cid string, proof Proof) bool {
+	root, err := hex.DecodeString(proof.Root)
+	if err != nil {
+		return false
+	}
+
+	hash := LeafHash(cid)
+	for _, step := range proof.Steps {
+		sibling, err := hex.DecodeString(step.Hash)
+		if err != nil || len(sibling) != sha256.Size {
+			return false
+		}
+		if step.Left {
+			hash = nodeHash(sibling, hash)
+		} else {
+			hash = nodeHash(hash, sibling)
+		}
+	}
+
+	return bytes.Equal(hash, root)
+}
+
+// LeafHash returns the hash of a CID as a leaf of the tree
+func LeafHash(cid string) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write([]byte(cid))
+	return h.Sum(nil)
+}
+
+func nodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{nodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
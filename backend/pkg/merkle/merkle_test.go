@@ -0,0 +1,75 @@
+package merkle
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func cids(n int) []string {
+	result := make([]string, n)
+	for i := range result {
+		result[i] = fmt.Sprintf("bafyrecord%d", i)
+	}
+	return result
+}
+
+func TestBuild_Empty(t *testing.T) {
+	assert.Nil(t, Build(nil))
+}
+
+func TestBuild_SingleLeafIsRoot(t *testing.T) {
+	tree := Build([]string{"bafyonly"})
+
+	proof := tree.Proof(0)
+
+	assert.Empty(t, proof.Steps)
+	assert.True(t, Verify("bafyonly", proof))
+}
+
+func TestProof_VerifiesEveryLeaf(t *testing.T) {
+	for _, n := range []int{2, 3, 5, 16, 17, 100} {
+		leaves := cids(n)
+		tree := Build(leaves)
+		for i, cid := range leaves {
+			assert.True(t, Verify(cid, tree.Proof(i)), "leaf %d of %d", i, n)
+		}
+	}
+}
+
+func TestBuild_Deterministic(t *testing.T) {
+	assert.Equal(t, Build(cids(7)).Root(), Build(cids(7)).Root())
+	assert.NotEqual(t, Build(cids(7)).Root(), Build(cids(8)).Root())
+}
+
+func TestVerify_RejectsOtherCID(t *testing.T) {
+	tree := Build(cids(4))
+
+	assert.False(t, Verify("bafyforged", tree.Proof(1)))
+}
+
+func TestVerify_RejectsOtherRoot(t *testing.T) {
+	proof := Build(cids(4)).Proof(2)
+	proof.Root = Build(cids(5)).Root()
+
+	assert.False(t, Verify("bafyrecord2", proof))
+}
+
+func TestVerify_RejectsTamperedStep(t *testing.T) {
+	tree := Build(cids(4))
+	proof := tree.Proof(0)
+	require.NotEmpty(t, proof.Steps)
+	proof.Steps[0].Left = !proof.Steps[0].Left
+
+	assert.False(t, Verify("bafyrecord0", proof))
+}
+
+func TestVerify_RejectsInnerNodeAsLeaf(t *testing.T) {
+	tree := Build(cids(4))
+	inner := tree.Proof(0).Steps[1].Hash
+
+	// An inner node hash presented as a leaf must not verify against the root
+	assert.False(t, Verify(inner, Proof{Root: tree.Root(), Steps: []Step{tree.Proof(0).Steps[1]}}))
+}
@@ -0,0 +1,164 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: anchors.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAnchor = `-- name: CreateAnchor :one
+INSERT INTO anchors (
+    vehicle_id, record_type, event_id, vehicle_version_id, status, error, network, sender,
+    tx_id, tx_type, asset_id, confirmed_round, round_time, fee_microalgos
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, vehicle_id, record_type, event_id, vehicle_version_id, status, error, network, sender, tx_id, tx_type, asset_id, confirmed_round, round_time, fee_microalgos, created_at
+`
+
+type CreateAnchorParams struct {
+	VehicleID        uuid.UUID
+	RecordType       string
+	EventID          *uuid.UUID
+	VehicleVersionID *uuid.UUID
+	Status           string
+	Error            *string
+	Network          string
+	Sender           string
+	TxID             *string
+	TxType           *string
+	AssetID          *int64
+	ConfirmedRound   *int64
+	RoundTime        pgtype.Timestamptz
+	FeeMicroalgos    *int64
+}
+
+func (q *Queries) CreateAnchor(ctx context.Context, arg CreateAnchorParams) (Anchor, error) {
+	row := q.db.QueryRow(ctx, createAnchor,
+		arg.VehicleID,
+		arg.RecordType,
+		arg.EventID,
+		arg.VehicleVersionID,
+		arg.Status,
+		arg.Error,
+		arg.Network,
+		arg.Sender,
+		arg.TxID,
+		arg.TxType,
+		arg.AssetID,
+		arg.ConfirmedRound,
+		arg.RoundTime,
+		arg.FeeMicroalgos,
+	)
+	var i Anchor
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.RecordType,
+		&i.EventID,
+		&i.VehicleVersionID,
+		&i.Status,
+		&i.Error,
+		&i.Network,
+		&i.Sender,
+		&i.TxID,
+		&i.TxType,
+		&i.AssetID,
+		&i.ConfirmedRound,
+		&i.RoundTime,
+		&i.FeeMicroalgos,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAnchorsByEvent = `-- name: ListAnchorsByEvent :many
+SELECT id, vehicle_id, record_type, event_id, vehicle_version_id, status, error, network, sender, tx_id, tx_type, asset_id, confirmed_round, round_time, fee_microalgos, created_at FROM anchors
+WHERE event_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAnchorsByEvent(ctx context.Context, eventID *uuid.UUID) ([]Anchor, error) {
+	rows, err := q.db.Query(ctx, listAnchorsByEvent, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Anchor{}
+	for rows.Next() {
+		var i Anchor
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.RecordType,
+			&i.EventID,
+			&i.VehicleVersionID,
+			&i.Status,
+			&i.Error,
+			&i.Network,
+			&i.Sender,
+			&i.TxID,
+			&i.TxType,
+			&i.AssetID,
+			&i.ConfirmedRound,
+			&i.RoundTime,
+			&i.FeeMicroalgos,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAnchorsByVehicle = `-- name: ListAnchorsByVehicle :many
+SELECT id, vehicle_id, record_type, event_id, vehicle_version_id, status, error, network, sender, tx_id, tx_type, asset_id, confirmed_round, round_time, fee_microalgos, created_at FROM anchors
+WHERE vehicle_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAnchorsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Anchor, error) {
+	rows, err := q.db.Query(ctx, listAnchorsByVehicle, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Anchor{}
+	for rows.Next() {
+		var i Anchor
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.RecordType,
+			&i.EventID,
+			&i.VehicleVersionID,
+			&i.Status,
+			&i.Error,
+			&i.Network,
+			&i.Sender,
+			&i.TxID,
+			&i.TxType,
+			&i.AssetID,
+			&i.ConfirmedRound,
+			&i.RoundTime,
+			&i.FeeMicroalgos,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,226 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: certification_requests.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createCertificationRequest = `-- name: CreateCertificationRequest :one
+INSERT INTO event_certification_requests (event_id, vehicle_id, entity_id, requested_by, evidence_session_id, message)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, event_id, vehicle_id, entity_id, requested_by, evidence_session_id, message, status, decided_by, decline_reason, certified_event_id, created_at, decided_at
+`
+
+type CreateCertificationRequestParams struct {
+	EventID           uuid.UUID
+	VehicleID         uuid.UUID
+	EntityID          uuid.UUID
+	RequestedBy       uuid.UUID
+	EvidenceSessionID *uuid.UUID
+	Message           *string
+}
+
+func (q *Queries) CreateCertificationRequest(ctx context.Context, arg CreateCertificationRequestParams) (EventCertificationRequest, error) {
+	row := q.db.QueryRow(ctx, createCertificationRequest,
+		arg.EventID,
+		arg.VehicleID,
+		arg.EntityID,
+		arg.RequestedBy,
+		arg.EvidenceSessionID,
+		arg.Message,
+	)
+	var i EventCertificationRequest
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.VehicleID,
+		&i.EntityID,
+		&i.RequestedBy,
+		&i.EvidenceSessionID,
+		&i.Message,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.CertifiedEventID,
+		&i.CreatedAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const decideCertificationRequest = `-- name: DecideCertificationRequest :one
+UPDATE event_certification_requests
+SET status = $2,
+    decided_by = $3,
+    decline_reason = $4,
+    decided_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, event_id, vehicle_id, entity_id, requested_by, evidence_session_id, message, status, decided_by, decline_reason, certified_event_id, created_at, decided_at
+`
+
+type DecideCertificationRequestParams struct {
+	ID            uuid.UUID
+	Status        string
+	DecidedBy     *uuid.UUID
+	DeclineReason *string
+}
+
+func (q *Queries) DecideCertificationRequest(ctx context.Context, arg DecideCertificationRequestParams) (EventCertificationRequest, error) {
+	row := q.db.QueryRow(ctx, decideCertificationRequest,
+		arg.ID,
+		arg.Status,
+		arg.DecidedBy,
+		arg.DeclineReason,
+	)
+	var i EventCertificationRequest
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.VehicleID,
+		&i.EntityID,
+		&i.RequestedBy,
+		&i.EvidenceSessionID,
+		&i.Message,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.CertifiedEventID,
+		&i.CreatedAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const getCertificationRequest = `-- name: GetCertificationRequest :one
+SELECT id, event_id, vehicle_id, entity_id, requested_by, evidence_session_id, message, status, decided_by, decline_reason, certified_event_id, created_at, decided_at FROM event_certification_requests
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetCertificationRequest(ctx context.Context, id uuid.UUID) (EventCertificationRequest, error) {
+	row := q.db.QueryRow(ctx, getCertificationRequest, id)
+	var i EventCertificationRequest
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.VehicleID,
+		&i.EntityID,
+		&i.RequestedBy,
+		&i.EvidenceSessionID,
+		&i.Message,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.CertifiedEventID,
+		&i.CreatedAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const listCertificationRequestsByEntity = `-- name: ListCertificationRequestsByEntity :many
+SELECT id, event_id, vehicle_id, entity_id, requested_by, evidence_session_id, message, status, decided_by, decline_reason, certified_event_id, created_at, decided_at FROM event_certification_requests
+WHERE entity_id = $1
+  AND ($2::text IS NULL OR status = $2)
+ORDER BY created_at ASC
+`
+
+type ListCertificationRequestsByEntityParams struct {
+	EntityID uuid.UUID
+	Status   *string
+}
+
+func (q *Queries) ListCertificationRequestsByEntity(ctx context.Context, arg ListCertificationRequestsByEntityParams) ([]EventCertificationRequest, error) {
+	rows, err := q.db.Query(ctx, listCertificationRequestsByEntity, arg.EntityID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EventCertificationRequest{}
+	for rows.Next() {
+		var i EventCertificationRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.RequestedBy,
+			&i.EvidenceSessionID,
+			&i.Message,
+			&i.Status,
+			&i.DecidedBy,
+			&i.DeclineReason,
+			&i.CertifiedEventID,
+			&i.CreatedAt,
+			&i.DecidedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCertificationRequestsByEvent = `-- name: ListCertificationRequestsByEvent :many
+SELECT id, event_id, vehicle_id, entity_id, requested_by, evidence_session_id, message, status, decided_by, decline_reason, certified_event_id, created_at, decided_at FROM event_certification_requests
+WHERE event_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListCertificationRequestsByEvent(ctx context.Context, eventID uuid.UUID) ([]EventCertificationRequest, error) {
+	rows, err := q.db.Query(ctx, listCertificationRequestsByEvent, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EventCertificationRequest{}
+	for rows.Next() {
+		var i EventCertificationRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.RequestedBy,
+			&i.EvidenceSessionID,
+			&i.Message,
+			&i.Status,
+			&i.DecidedBy,
+			&i.DeclineReason,
+			&i.CertifiedEventID,
+			&i.CreatedAt,
+			&i.DecidedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setCertificationRequestEvent = `-- name: SetCertificationRequestEvent :exec
+UPDATE event_certification_requests
+SET certified_event_id = $2
+WHERE id = $1
+`
+
+type SetCertificationRequestEventParams struct {
+	ID               uuid.UUID
+	CertifiedEventID *uuid.UUID
+}
+
+func (q *Queries) SetCertificationRequestEvent(ctx context.Context, arg SetCertificationRequestEventParams) error {
+	_, err := q.db.Exec(ctx, setCertificationRequestEvent, arg.ID, arg.CertifiedEventID)
+	return err
+}
@@ -0,0 +1,206 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: certifications.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimCertificationReminders = `-- name: ClaimCertificationReminders :many
+UPDATE certifications
+SET last_reminder_days = $1::int,
+    updated_at = NOW()
+WHERE status = 'active'
+  AND valid_until >= $2::date
+  AND valid_until <= $2::date + $1::int
+  AND (last_reminder_days IS NULL OR last_reminder_days > $1::int)
+RETURNING id, event_id, vehicle_id, entity_id, certificate_number, valid_from, valid_until, status, last_reminder_days, created_at, updated_at
+`
+
+type ClaimCertificationRemindersParams struct {
+	LeadDays int32
+	Today    time.Time
+}
+
+func (q *Queries) ClaimCertificationReminders(ctx context.Context, arg ClaimCertificationRemindersParams) ([]Certification, error) {
+	rows, err := q.db.Query(ctx, claimCertificationReminders, arg.LeadDays, arg.Today)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Certification{}
+	for rows.Next() {
+		var i Certification
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.CertificateNumber,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.Status,
+			&i.LastReminderDays,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const expireCertifications = `-- name: ExpireCertifications :many
+UPDATE certifications
+SET status = 'expired',
+    updated_at = NOW()
+WHERE status = 'active'
+  AND valid_until < $1::date
+RETURNING id, event_id, vehicle_id, entity_id, certificate_number, valid_from, valid_until, status, last_reminder_days, created_at, updated_at
+`
+
+func (q *Queries) ExpireCertifications(ctx context.Context, today time.Time) ([]Certification, error) {
+	rows, err := q.db.Query(ctx, expireCertifications, today)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Certification{}
+	for rows.Next() {
+		var i Certification
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.CertificateNumber,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.Status,
+			&i.LastReminderDays,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCertificationsByVehicle = `-- name: ListCertificationsByVehicle :many
+SELECT id, event_id, vehicle_id, entity_id, certificate_number, valid_from, valid_until, status, last_reminder_days, created_at, updated_at FROM certifications
+WHERE vehicle_id = $1
+ORDER BY valid_from DESC, created_at DESC
+`
+
+func (q *Queries) ListCertificationsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Certification, error) {
+	rows, err := q.db.Query(ctx, listCertificationsByVehicle, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Certification{}
+	for rows.Next() {
+		var i Certification
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.CertificateNumber,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.Status,
+			&i.LastReminderDays,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeCertification = `-- name: RevokeCertification :exec
+UPDATE certifications
+SET status = 'revoked',
+    updated_at = NOW()
+WHERE event_id = $1
+`
+
+func (q *Queries) RevokeCertification(ctx context.Context, eventID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, revokeCertification, eventID)
+	return err
+}
+
+const upsertCertification = `-- name: UpsertCertification :one
+INSERT INTO certifications (event_id, vehicle_id, entity_id, certificate_number, valid_from, valid_until, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (event_id) DO UPDATE
+SET certificate_number = EXCLUDED.certificate_number,
+    valid_from = EXCLUDED.valid_from,
+    valid_until = EXCLUDED.valid_until,
+    status = CASE WHEN certifications.status = 'revoked' THEN 'revoked' ELSE EXCLUDED.status END,
+    last_reminder_days = CASE
+        WHEN certifications.valid_until IS DISTINCT FROM EXCLUDED.valid_until THEN NULL
+        ELSE certifications.last_reminder_days
+    END,
+    updated_at = NOW()
+RETURNING id, event_id, vehicle_id, entity_id, certificate_number, valid_from, valid_until, status, last_reminder_days, created_at, updated_at
+`
+
+type UpsertCertificationParams struct {
+	EventID           uuid.UUID
+	VehicleID         uuid.UUID
+	EntityID          uuid.UUID
+	CertificateNumber *string
+	ValidFrom         time.Time
+	ValidUntil        pgtype.Date
+	Status            string
+}
+
+func (q *Queries) UpsertCertification(ctx context.Context, arg UpsertCertificationParams) (Certification, error) {
+	row := q.db.QueryRow(ctx, upsertCertification,
+		arg.EventID,
+		arg.VehicleID,
+		arg.EntityID,
+		arg.CertificateNumber,
+		arg.ValidFrom,
+		arg.ValidUntil,
+		arg.Status,
+	)
+	var i Certification
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.VehicleID,
+		&i.EntityID,
+		&i.CertificateNumber,
+		&i.ValidFrom,
+		&i.ValidUntil,
+		&i.Status,
+		&i.LastReminderDays,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,235 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: custody.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createWalletChallenge = `-- name: CreateWalletChallenge :one
+INSERT INTO wallet_challenges (user_id, address, message, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, address, message, expires_at, used_at, created_at
+`
+
+type CreateWalletChallengeParams struct {
+	UserID    uuid.UUID
+	Address   string
+	Message   string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateWalletChallenge(ctx context.Context, arg CreateWalletChallengeParams) (WalletChallenge, error) {
+	row := q.db.QueryRow(ctx, createWalletChallenge,
+		arg.UserID,
+		arg.Address,
+		arg.Message,
+		arg.ExpiresAt,
+	)
+	var i WalletChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Address,
+		&i.Message,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteUserWallet = `-- name: DeleteUserWallet :execrows
+DELETE FROM user_wallets
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserWallet(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserWallet, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getActiveWalletChallenge = `-- name: GetActiveWalletChallenge :one
+SELECT id, user_id, address, message, expires_at, used_at, created_at FROM wallet_challenges
+WHERE user_id = $1
+  AND address = $2
+  AND used_at IS NULL
+  AND expires_at > NOW()
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetActiveWalletChallengeParams struct {
+	UserID  uuid.UUID
+	Address string
+}
+
+func (q *Queries) GetActiveWalletChallenge(ctx context.Context, arg GetActiveWalletChallengeParams) (WalletChallenge, error) {
+	row := q.db.QueryRow(ctx, getActiveWalletChallenge, arg.UserID, arg.Address)
+	var i WalletChallenge
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Address,
+		&i.Message,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getUserWallet = `-- name: GetUserWallet :one
+SELECT user_id, address, linked_at FROM user_wallets
+WHERE user_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserWallet(ctx context.Context, userID uuid.UUID) (UserWallet, error) {
+	row := q.db.QueryRow(ctx, getUserWallet, userID)
+	var i UserWallet
+	err := row.Scan(&i.UserID, &i.Address, &i.LinkedAt)
+	return i, err
+}
+
+const getVehicleCustody = `-- name: GetVehicleCustody :one
+SELECT vehicle_id, holder_address, status, tx_id, error, updated_at FROM vehicle_custody
+WHERE vehicle_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetVehicleCustody(ctx context.Context, vehicleID uuid.UUID) (VehicleCustody, error) {
+	row := q.db.QueryRow(ctx, getVehicleCustody, vehicleID)
+	var i VehicleCustody
+	err := row.Scan(
+		&i.VehicleID,
+		&i.HolderAddress,
+		&i.Status,
+		&i.TxID,
+		&i.Error,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const markVehicleCustodyPending = `-- name: MarkVehicleCustodyPending :one
+INSERT INTO vehicle_custody (vehicle_id, status)
+VALUES ($1, 'pending')
+ON CONFLICT (vehicle_id) DO UPDATE
+SET status = 'pending',
+    error = NULL,
+    updated_at = NOW()
+RETURNING vehicle_id, holder_address, status, tx_id, error, updated_at
+`
+
+func (q *Queries) MarkVehicleCustodyPending(ctx context.Context, vehicleID uuid.UUID) (VehicleCustody, error) {
+	row := q.db.QueryRow(ctx, markVehicleCustodyPending, vehicleID)
+	var i VehicleCustody
+	err := row.Scan(
+		&i.VehicleID,
+		&i.HolderAddress,
+		&i.Status,
+		&i.TxID,
+		&i.Error,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setVehicleCustodyFailed = `-- name: SetVehicleCustodyFailed :exec
+UPDATE vehicle_custody
+SET status = 'failed',
+    error = $2,
+    updated_at = NOW()
+WHERE vehicle_id = $1
+`
+
+type SetVehicleCustodyFailedParams struct {
+	VehicleID uuid.UUID
+	Error     *string
+}
+
+func (q *Queries) SetVehicleCustodyFailed(ctx context.Context, arg SetVehicleCustodyFailedParams) error {
+	_, err := q.db.Exec(ctx, setVehicleCustodyFailed, arg.VehicleID, arg.Error)
+	return err
+}
+
+const setVehicleCustodyHolder = `-- name: SetVehicleCustodyHolder :one
+INSERT INTO vehicle_custody (vehicle_id, holder_address, status, tx_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (vehicle_id) DO UPDATE
+SET holder_address = EXCLUDED.holder_address,
+    status = EXCLUDED.status,
+    tx_id = COALESCE(EXCLUDED.tx_id, vehicle_custody.tx_id),
+    error = NULL,
+    updated_at = NOW()
+RETURNING vehicle_id, holder_address, status, tx_id, error, updated_at
+`
+
+type SetVehicleCustodyHolderParams struct {
+	VehicleID     uuid.UUID
+	HolderAddress *string
+	Status        string
+	TxID          *string
+}
+
+func (q *Queries) SetVehicleCustodyHolder(ctx context.Context, arg SetVehicleCustodyHolderParams) (VehicleCustody, error) {
+	row := q.db.QueryRow(ctx, setVehicleCustodyHolder,
+		arg.VehicleID,
+		arg.HolderAddress,
+		arg.Status,
+		arg.TxID,
+	)
+	var i VehicleCustody
+	err := row.Scan(
+		&i.VehicleID,
+		&i.HolderAddress,
+		&i.Status,
+		&i.TxID,
+		&i.Error,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertUserWallet = `-- name: UpsertUserWallet :one
+INSERT INTO user_wallets (user_id, address)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET address = EXCLUDED.address,
+    linked_at = NOW()
+RETURNING user_id, address, linked_at
+`
+
+type UpsertUserWalletParams struct {
+	UserID  uuid.UUID
+	Address string
+}
+
+func (q *Queries) UpsertUserWallet(ctx context.Context, arg UpsertUserWalletParams) (UserWallet, error) {
+	row := q.db.QueryRow(ctx, upsertUserWallet, arg.UserID, arg.Address)
+	var i UserWallet
+	err := row.Scan(&i.UserID, &i.Address, &i.LinkedAt)
+	return i, err
+}
+
+const useWalletChallenge = `-- name: UseWalletChallenge :execrows
+UPDATE wallet_challenges
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) UseWalletChallenge(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, useWalletChallenge, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -15,7 +15,7 @@ const clearEntityLogo = `-- name: ClearEntityLogo :one
 UPDATE entities
 SET logo_object_key = NULL, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key
+RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key, owner_approval_event_types
 `
 
 func (q *Queries) ClearEntityLogo(ctx context.Context, id uuid.UUID) (Entity, error) {
@@ -33,6 +33,7 @@ func (q *Queries) ClearEntityLogo(ctx context.Context, id uuid.UUID) (Entity, er
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LogoObjectKey,
+		&i.OwnerApprovalEventTypes,
 	)
 	return i, err
 }
@@ -66,7 +67,7 @@ INSERT INTO entities (
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7
 )
-RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key
+RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key, owner_approval_event_types
 `
 
 type CreateEntityParams struct {
@@ -102,6 +103,7 @@ func (q *Queries) CreateEntity(ctx context.Context, arg CreateEntityParams) (Ent
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LogoObjectKey,
+		&i.OwnerApprovalEventTypes,
 	)
 	return i, err
 }
@@ -117,7 +119,7 @@ func (q *Queries) DeleteEntity(ctx context.Context, id uuid.UUID) error {
 }
 
 const getEntity = `-- name: GetEntity :one
-SELECT id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key FROM entities
+SELECT id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key, owner_approval_event_types FROM entities
 WHERE id = $1 LIMIT 1
 `
 
@@ -136,12 +138,13 @@ func (q *Queries) GetEntity(ctx context.Context, id uuid.UUID) (Entity, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LogoObjectKey,
+		&i.OwnerApprovalEventTypes,
 	)
 	return i, err
 }
 
 const listEntities = `-- name: ListEntities :many
-SELECT id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key FROM entities
+SELECT id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key, owner_approval_event_types FROM entities
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -172,6 +175,7 @@ func (q *Queries) ListEntities(ctx context.Context, arg ListEntitiesParams) ([]E
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LogoObjectKey,
+			&i.OwnerApprovalEventTypes,
 		); err != nil {
 			return nil, err
 		}
@@ -184,7 +188,7 @@ func (q *Queries) ListEntities(ctx context.Context, arg ListEntitiesParams) ([]E
 }
 
 const listEntitiesByType = `-- name: ListEntitiesByType :many
-SELECT id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key FROM entities
+SELECT id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key, owner_approval_event_types FROM entities
 WHERE entity_type = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -217,6 +221,7 @@ func (q *Queries) ListEntitiesByType(ctx context.Context, arg ListEntitiesByType
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.LogoObjectKey,
+			&i.OwnerApprovalEventTypes,
 		); err != nil {
 			return nil, err
 		}
@@ -230,18 +235,20 @@ func (q *Queries) ListEntitiesByType(ctx context.Context, arg ListEntitiesByType
 
 const updateEntity = `-- name: UpdateEntity :one
 UPDATE entities
-SET name = $2, description = $3, contact_email = $4, website = $5, address = $6, updated_at = NOW()
+SET name = $2, description = $3, contact_email = $4, website = $5, address = $6,
+    owner_approval_event_types = $7, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key
+RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key, owner_approval_event_types
 `
 
 type UpdateEntityParams struct {
-	ID           uuid.UUID
-	Name         string
-	Description  string
-	ContactEmail string
-	Website      string
-	Address      []byte
+	ID                      uuid.UUID
+	Name                    string
+	Description             string
+	ContactEmail            string
+	Website                 string
+	Address                 []byte
+	OwnerApprovalEventTypes []string
 }
 
 func (q *Queries) UpdateEntity(ctx context.Context, arg UpdateEntityParams) (Entity, error) {
@@ -252,6 +259,7 @@ func (q *Queries) UpdateEntity(ctx context.Context, arg UpdateEntityParams) (Ent
 		arg.ContactEmail,
 		arg.Website,
 		arg.Address,
+		arg.OwnerApprovalEventTypes,
 	)
 	var i Entity
 	err := row.Scan(
@@ -266,6 +274,7 @@ func (q *Queries) UpdateEntity(ctx context.Context, arg UpdateEntityParams) (Ent
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LogoObjectKey,
+		&i.OwnerApprovalEventTypes,
 	)
 	return i, err
 }
@@ -274,7 +283,7 @@ const updateEntityLogo = `-- name: UpdateEntityLogo :one
 UPDATE entities
 SET logo_object_key = $2, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key
+RETURNING id, name, entity_type, description, contact_email, website, address, certified_by, created_at, updated_at, logo_object_key, owner_approval_event_types
 `
 
 type UpdateEntityLogoParams struct {
@@ -297,6 +306,7 @@ func (q *Queries) UpdateEntityLogo(ctx context.Context, arg UpdateEntityLogoPara
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.LogoObjectKey,
+		&i.OwnerApprovalEventTypes,
 	)
 	return i, err
 }
@@ -0,0 +1,243 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: entity_event_types.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createEntityEventType = `-- name: CreateEntityEventType :one
+INSERT INTO entity_event_types (entity_id, key, display_name, icon, metadata_schema, definition_cid)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, entity_id, key, display_name, icon, metadata_schema, definition_cid, created_at, updated_at, retired_at
+`
+
+type CreateEntityEventTypeParams struct {
+	EntityID       uuid.UUID
+	Key            string
+	DisplayName    string
+	Icon           *string
+	MetadataSchema []byte
+	DefinitionCid  string
+}
+
+func (q *Queries) CreateEntityEventType(ctx context.Context, arg CreateEntityEventTypeParams) (EntityEventType, error) {
+	row := q.db.QueryRow(ctx, createEntityEventType,
+		arg.EntityID,
+		arg.Key,
+		arg.DisplayName,
+		arg.Icon,
+		arg.MetadataSchema,
+		arg.DefinitionCid,
+	)
+	var i EntityEventType
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Key,
+		&i.DisplayName,
+		&i.Icon,
+		&i.MetadataSchema,
+		&i.DefinitionCid,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RetiredAt,
+	)
+	return i, err
+}
+
+const getEntityEventType = `-- name: GetEntityEventType :one
+SELECT id, entity_id, key, display_name, icon, metadata_schema, definition_cid, created_at, updated_at, retired_at FROM entity_event_types
+WHERE entity_id = $1 AND key = $2 LIMIT 1
+`
+
+type GetEntityEventTypeParams struct {
+	EntityID uuid.UUID
+	Key      string
+}
+
+func (q *Queries) GetEntityEventType(ctx context.Context, arg GetEntityEventTypeParams) (EntityEventType, error) {
+	row := q.db.QueryRow(ctx, getEntityEventType, arg.EntityID, arg.Key)
+	var i EntityEventType
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Key,
+		&i.DisplayName,
+		&i.Icon,
+		&i.MetadataSchema,
+		&i.DefinitionCid,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RetiredAt,
+	)
+	return i, err
+}
+
+const listActiveEntityEventTypes = `-- name: ListActiveEntityEventTypes :many
+SELECT entity_event_types.id, entity_event_types.entity_id, entity_event_types.key, entity_event_types.display_name, entity_event_types.icon, entity_event_types.metadata_schema, entity_event_types.definition_cid, entity_event_types.created_at, entity_event_types.updated_at, entity_event_types.retired_at, entities.name AS entity_name
+FROM entity_event_types
+JOIN entities ON entities.id = entity_event_types.entity_id
+WHERE entity_event_types.retired_at IS NULL
+ORDER BY entities.name ASC, entity_event_types.key ASC
+`
+
+type ListActiveEntityEventTypesRow struct {
+	ID             uuid.UUID
+	EntityID       uuid.UUID
+	Key            string
+	DisplayName    string
+	Icon           *string
+	MetadataSchema []byte
+	DefinitionCid  string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	RetiredAt      pgtype.Timestamptz
+	EntityName     string
+}
+
+func (q *Queries) ListActiveEntityEventTypes(ctx context.Context) ([]ListActiveEntityEventTypesRow, error) {
+	rows, err := q.db.Query(ctx, listActiveEntityEventTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveEntityEventTypesRow{}
+	for rows.Next() {
+		var i ListActiveEntityEventTypesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityID,
+			&i.Key,
+			&i.DisplayName,
+			&i.Icon,
+			&i.MetadataSchema,
+			&i.DefinitionCid,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RetiredAt,
+			&i.EntityName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntityEventTypesByEntity = `-- name: ListEntityEventTypesByEntity :many
+SELECT id, entity_id, key, display_name, icon, metadata_schema, definition_cid, created_at, updated_at, retired_at FROM entity_event_types
+WHERE entity_id = $1
+ORDER BY key ASC
+`
+
+func (q *Queries) ListEntityEventTypesByEntity(ctx context.Context, entityID uuid.UUID) ([]EntityEventType, error) {
+	rows, err := q.db.Query(ctx, listEntityEventTypesByEntity, entityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EntityEventType{}
+	for rows.Next() {
+		var i EntityEventType
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityID,
+			&i.Key,
+			&i.DisplayName,
+			&i.Icon,
+			&i.MetadataSchema,
+			&i.DefinitionCid,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RetiredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retireEntityEventType = `-- name: RetireEntityEventType :one
+UPDATE entity_event_types
+SET retired_at = NOW(),
+    updated_at = NOW()
+WHERE entity_id = $1 AND key = $2 AND retired_at IS NULL
+RETURNING id, entity_id, key, display_name, icon, metadata_schema, definition_cid, created_at, updated_at, retired_at
+`
+
+type RetireEntityEventTypeParams struct {
+	EntityID uuid.UUID
+	Key      string
+}
+
+func (q *Queries) RetireEntityEventType(ctx context.Context, arg RetireEntityEventTypeParams) (EntityEventType, error) {
+	row := q.db.QueryRow(ctx, retireEntityEventType, arg.EntityID, arg.Key)
+	var i EntityEventType
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Key,
+		&i.DisplayName,
+		&i.Icon,
+		&i.MetadataSchema,
+		&i.DefinitionCid,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RetiredAt,
+	)
+	return i, err
+}
+
+const updateEntityEventType = `-- name: UpdateEntityEventType :one
+UPDATE entity_event_types
+SET display_name = $3,
+    icon = $4,
+    updated_at = NOW()
+WHERE entity_id = $1 AND key = $2
+RETURNING id, entity_id, key, display_name, icon, metadata_schema, definition_cid, created_at, updated_at, retired_at
+`
+
+type UpdateEntityEventTypeParams struct {
+	EntityID    uuid.UUID
+	Key         string
+	DisplayName string
+	Icon        *string
+}
+
+func (q *Queries) UpdateEntityEventType(ctx context.Context, arg UpdateEntityEventTypeParams) (EntityEventType, error) {
+	row := q.db.QueryRow(ctx, updateEntityEventType,
+		arg.EntityID,
+		arg.Key,
+		arg.DisplayName,
+		arg.Icon,
+	)
+	var i EntityEventType
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Key,
+		&i.DisplayName,
+		&i.Icon,
+		&i.MetadataSchema,
+		&i.DefinitionCid,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RetiredAt,
+	)
+	return i, err
+}
@@ -0,0 +1,176 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: entity_signing_keys.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createEntitySigningKey = `-- name: CreateEntitySigningKey :one
+INSERT INTO entity_signing_keys (entity_id, label, public_key, sealed_private_key)
+VALUES ($1, $2, $3, $4)
+RETURNING id, entity_id, label, algorithm, public_key, sealed_private_key, created_at, revoked_at
+`
+
+type CreateEntitySigningKeyParams struct {
+	EntityID         uuid.UUID
+	Label            string
+	PublicKey        string
+	SealedPrivateKey *string
+}
+
+func (q *Queries) CreateEntitySigningKey(ctx context.Context, arg CreateEntitySigningKeyParams) (EntitySigningKey, error) {
+	row := q.db.QueryRow(ctx, createEntitySigningKey,
+		arg.EntityID,
+		arg.Label,
+		arg.PublicKey,
+		arg.SealedPrivateKey,
+	)
+	var i EntitySigningKey
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Label,
+		&i.Algorithm,
+		&i.PublicKey,
+		&i.SealedPrivateKey,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getActiveManagedSigningKey = `-- name: GetActiveManagedSigningKey :one
+SELECT id, entity_id, label, algorithm, public_key, sealed_private_key, created_at, revoked_at FROM entity_signing_keys
+WHERE entity_id = $1
+  AND sealed_private_key IS NOT NULL
+  AND revoked_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetActiveManagedSigningKey(ctx context.Context, entityID uuid.UUID) (EntitySigningKey, error) {
+	row := q.db.QueryRow(ctx, getActiveManagedSigningKey, entityID)
+	var i EntitySigningKey
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Label,
+		&i.Algorithm,
+		&i.PublicKey,
+		&i.SealedPrivateKey,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getEntitySigningKey = `-- name: GetEntitySigningKey :one
+SELECT id, entity_id, label, algorithm, public_key, sealed_private_key, created_at, revoked_at FROM entity_signing_keys
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetEntitySigningKey(ctx context.Context, id uuid.UUID) (EntitySigningKey, error) {
+	row := q.db.QueryRow(ctx, getEntitySigningKey, id)
+	var i EntitySigningKey
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Label,
+		&i.Algorithm,
+		&i.PublicKey,
+		&i.SealedPrivateKey,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getEntitySigningKeyByPublicKey = `-- name: GetEntitySigningKeyByPublicKey :one
+SELECT id, entity_id, label, algorithm, public_key, sealed_private_key, created_at, revoked_at FROM entity_signing_keys
+WHERE public_key = $1 LIMIT 1
+`
+
+func (q *Queries) GetEntitySigningKeyByPublicKey(ctx context.Context, publicKey string) (EntitySigningKey, error) {
+	row := q.db.QueryRow(ctx, getEntitySigningKeyByPublicKey, publicKey)
+	var i EntitySigningKey
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Label,
+		&i.Algorithm,
+		&i.PublicKey,
+		&i.SealedPrivateKey,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const listEntitySigningKeysByEntity = `-- name: ListEntitySigningKeysByEntity :many
+SELECT id, entity_id, label, algorithm, public_key, sealed_private_key, created_at, revoked_at FROM entity_signing_keys
+WHERE entity_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListEntitySigningKeysByEntity(ctx context.Context, entityID uuid.UUID) ([]EntitySigningKey, error) {
+	rows, err := q.db.Query(ctx, listEntitySigningKeysByEntity, entityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EntitySigningKey{}
+	for rows.Next() {
+		var i EntitySigningKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityID,
+			&i.Label,
+			&i.Algorithm,
+			&i.PublicKey,
+			&i.SealedPrivateKey,
+			&i.CreatedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeEntitySigningKey = `-- name: RevokeEntitySigningKey :one
+UPDATE entity_signing_keys
+SET revoked_at = NOW()
+WHERE entity_id = $1 AND id = $2 AND revoked_at IS NULL
+RETURNING id, entity_id, label, algorithm, public_key, sealed_private_key, created_at, revoked_at
+`
+
+type RevokeEntitySigningKeyParams struct {
+	EntityID uuid.UUID
+	ID       uuid.UUID
+}
+
+func (q *Queries) RevokeEntitySigningKey(ctx context.Context, arg RevokeEntitySigningKeyParams) (EntitySigningKey, error) {
+	row := q.db.QueryRow(ctx, revokeEntitySigningKey, arg.EntityID, arg.ID)
+	var i EntitySigningKey
+	err := row.Scan(
+		&i.ID,
+		&i.EntityID,
+		&i.Label,
+		&i.Algorithm,
+		&i.PublicKey,
+		&i.SealedPrivateKey,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
@@ -13,6 +13,24 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countEventsByBlockchainStatus = `-- name: CountEventsByBlockchainStatus :one
+SELECT COUNT(*) FROM events
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => $2::float8)
+`
+
+type CountEventsByBlockchainStatusParams struct {
+	BlockchainStatus string
+	OlderThanSeconds float64
+}
+
+func (q *Queries) CountEventsByBlockchainStatus(ctx context.Context, arg CountEventsByBlockchainStatusParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countEventsByBlockchainStatus, arg.BlockchainStatus, arg.OlderThanSeconds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEvent = `-- name: CreateEvent :one
 INSERT INTO events (
     vehicle_id,
@@ -22,7 +40,11 @@ INSERT INTO events (
     description,
     event_date,
     location,
-    metadata
+    metadata,
+    kind,
+    revises_event_id,
+    reason,
+    approval_status
 ) VALUES (
     $1,
     $2,
@@ -31,20 +53,28 @@ INSERT INTO events (
     $5,
     $6,
     $7,
-    $8
+    $8,
+    $9,
+    $10,
+    $11,
+    $12
 )
-RETURNING id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status
+RETURNING id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at
 `
 
 type CreateEventParams struct {
-	VehicleID   uuid.UUID
-	EntityID    *uuid.UUID
-	EventType   string
-	Title       string
-	Description string
-	EventDate   time.Time
-	Location    string
-	Metadata    []byte
+	VehicleID      uuid.UUID
+	EntityID       *uuid.UUID
+	EventType      string
+	Title          string
+	Description    string
+	EventDate      time.Time
+	Location       string
+	Metadata       []byte
+	Kind           string
+	RevisesEventID *uuid.UUID
+	Reason         *string
+	ApprovalStatus string
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error) {
@@ -57,6 +87,10 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event
 		arg.EventDate,
 		arg.Location,
 		arg.Metadata,
+		arg.Kind,
+		arg.RevisesEventID,
+		arg.Reason,
+		arg.ApprovalStatus,
 	)
 	var i Event
 	err := row.Scan(
@@ -75,22 +109,62 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event
 		&i.BlockchainTxID,
 		&i.CreatedAt,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.PreviousCid,
+		&i.MerkleProof,
+		&i.Kind,
+		&i.RevisesEventID,
+		&i.Reason,
+		&i.ApprovalStatus,
+		&i.ApprovalDecidedAt,
+		&i.SigningKeyID,
+		&i.Signature,
+		&i.SignedAt,
 	)
 	return i, err
 }
 
-const deleteEvent = `-- name: DeleteEvent :exec
+const decideEventProposal = `-- name: DecideEventProposal :execrows
+UPDATE events
+SET approval_status = $1,
+    approval_decided_at = $2
+WHERE id = $3
+  AND approval_status = 'proposed'
+`
+
+type DecideEventProposalParams struct {
+	ApprovalStatus    string
+	ApprovalDecidedAt pgtype.Timestamptz
+	ID                uuid.UUID
+}
+
+// Only decides events still awaiting the owner's approval. The update locks the event, so of two
+// concurrent decisions the second finds it already decided.
+func (q *Queries) DecideEventProposal(ctx context.Context, arg DecideEventProposalParams) (int64, error) {
+	result, err := q.db.Exec(ctx, decideEventProposal, arg.ApprovalStatus, arg.ApprovalDecidedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteEvent = `-- name: DeleteEvent :execrows
 DELETE FROM events
 WHERE id = $1
+  AND cid IS NULL
 `
 
-func (q *Queries) DeleteEvent(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteEvent, id)
-	return err
+func (q *Queries) DeleteEvent(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEvent, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const getEvent = `-- name: GetEvent :one
-SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status FROM events
+SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at FROM events
 WHERE id = $1 LIMIT 1
 `
 
@@ -113,13 +187,203 @@ func (q *Queries) GetEvent(ctx context.Context, id uuid.UUID) (Event, error) {
 		&i.BlockchainTxID,
 		&i.CreatedAt,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.PreviousCid,
+		&i.MerkleProof,
+		&i.Kind,
+		&i.RevisesEventID,
+		&i.Reason,
+		&i.ApprovalStatus,
+		&i.ApprovalDecidedAt,
+		&i.SigningKeyID,
+		&i.Signature,
+		&i.SignedAt,
 	)
 	return i, err
 }
 
+const listEventRevisions = `-- name: ListEventRevisions :many
+SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at FROM events
+WHERE revises_event_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListEventRevisions(ctx context.Context, revisesEventID *uuid.UUID) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listEventRevisions, revisesEventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Event{}
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.EventType,
+			&i.Title,
+			&i.Description,
+			&i.EventDate,
+			&i.Location,
+			&i.Metadata,
+			&i.Cid,
+			&i.CidSourceJson,
+			&i.CidSourceCborB64,
+			&i.BlockchainTxID,
+			&i.CreatedAt,
+			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.PreviousCid,
+			&i.MerkleProof,
+			&i.Kind,
+			&i.RevisesEventID,
+			&i.Reason,
+			&i.ApprovalStatus,
+			&i.ApprovalDecidedAt,
+			&i.SigningKeyID,
+			&i.Signature,
+			&i.SignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventRevisionsByVehicle = `-- name: ListEventRevisionsByVehicle :many
+SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at FROM events
+WHERE vehicle_id = $1
+  AND kind <> 'original'
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListEventRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listEventRevisionsByVehicle, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Event{}
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.EventType,
+			&i.Title,
+			&i.Description,
+			&i.EventDate,
+			&i.Location,
+			&i.Metadata,
+			&i.Cid,
+			&i.CidSourceJson,
+			&i.CidSourceCborB64,
+			&i.BlockchainTxID,
+			&i.CreatedAt,
+			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.PreviousCid,
+			&i.MerkleProof,
+			&i.Kind,
+			&i.RevisesEventID,
+			&i.Reason,
+			&i.ApprovalStatus,
+			&i.ApprovalDecidedAt,
+			&i.SigningKeyID,
+			&i.Signature,
+			&i.SignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventsByBlockchainStatus = `-- name: ListEventsByBlockchainStatus :many
+SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at FROM events
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => $4::float8)
+ORDER BY blockchain_status_at ASC
+LIMIT $2 OFFSET $3
+`
+
+type ListEventsByBlockchainStatusParams struct {
+	BlockchainStatus string
+	Limit            int32
+	Offset           int32
+	OlderThanSeconds float64
+}
+
+func (q *Queries) ListEventsByBlockchainStatus(ctx context.Context, arg ListEventsByBlockchainStatusParams) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listEventsByBlockchainStatus,
+		arg.BlockchainStatus,
+		arg.Limit,
+		arg.Offset,
+		arg.OlderThanSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Event{}
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.EntityID,
+			&i.EventType,
+			&i.Title,
+			&i.Description,
+			&i.EventDate,
+			&i.Location,
+			&i.Metadata,
+			&i.Cid,
+			&i.CidSourceJson,
+			&i.CidSourceCborB64,
+			&i.BlockchainTxID,
+			&i.CreatedAt,
+			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.PreviousCid,
+			&i.MerkleProof,
+			&i.Kind,
+			&i.RevisesEventID,
+			&i.Reason,
+			&i.ApprovalStatus,
+			&i.ApprovalDecidedAt,
+			&i.SigningKeyID,
+			&i.Signature,
+			&i.SignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEventsByEntity = `-- name: ListEventsByEntity :many
-SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status FROM events
+SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at FROM events
 WHERE entity_id = $1
+  AND kind = 'original'
 ORDER BY event_date DESC
 `
 
@@ -148,6 +412,18 @@ func (q *Queries) ListEventsByEntity(ctx context.Context, entityID *uuid.UUID) (
 			&i.BlockchainTxID,
 			&i.CreatedAt,
 			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.PreviousCid,
+			&i.MerkleProof,
+			&i.Kind,
+			&i.RevisesEventID,
+			&i.Reason,
+			&i.ApprovalStatus,
+			&i.ApprovalDecidedAt,
+			&i.SigningKeyID,
+			&i.Signature,
+			&i.SignedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -160,8 +436,9 @@ func (q *Queries) ListEventsByEntity(ctx context.Context, entityID *uuid.UUID) (
 }
 
 const listEventsByVehicle = `-- name: ListEventsByVehicle :many
-SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status FROM events
+SELECT id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at FROM events
 WHERE vehicle_id = $1
+  AND kind = 'original'
 ORDER BY event_date DESC
 `
 
@@ -190,6 +467,18 @@ func (q *Queries) ListEventsByVehicle(ctx context.Context, vehicleID uuid.UUID)
 			&i.BlockchainTxID,
 			&i.CreatedAt,
 			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.PreviousCid,
+			&i.MerkleProof,
+			&i.Kind,
+			&i.RevisesEventID,
+			&i.Reason,
+			&i.ApprovalStatus,
+			&i.ApprovalDecidedAt,
+			&i.SigningKeyID,
+			&i.Signature,
+			&i.SignedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -203,12 +492,13 @@ func (q *Queries) ListEventsByVehicle(ctx context.Context, vehicleID uuid.UUID)
 
 const listEventsByVehicleWithEntity = `-- name: ListEventsByVehicleWithEntity :many
 SELECT
-    e.id, e.vehicle_id, e.entity_id, e.event_type, e.title, e.description, e.event_date, e.location, e.metadata, e.cid, e.cid_source_json, e.cid_source_cbor_b64, e.blockchain_tx_id, e.created_at, e.blockchain_status,
+    e.id, e.vehicle_id, e.entity_id, e.event_type, e.title, e.description, e.event_date, e.location, e.metadata, e.cid, e.cid_source_json, e.cid_source_cbor_b64, e.blockchain_tx_id, e.created_at, e.blockchain_status, e.blockchain_error, e.blockchain_status_at, e.previous_cid, e.merkle_proof, e.kind, e.revises_event_id, e.reason, e.approval_status, e.approval_decided_at, e.signing_key_id, e.signature, e.signed_at,
     ent.name AS entity_name,
     ent.logo_object_key AS entity_logo_object_key
 FROM events e
 LEFT JOIN entities ent ON e.entity_id = ent.id
 WHERE e.vehicle_id = $1
+  AND e.kind = 'original'
 ORDER BY e.event_date DESC
 `
 
@@ -228,6 +518,18 @@ type ListEventsByVehicleWithEntityRow struct {
 	BlockchainTxID      string
 	CreatedAt           pgtype.Timestamp
 	BlockchainStatus    string
+	BlockchainError     *string
+	BlockchainStatusAt  time.Time
+	PreviousCid         *string
+	MerkleProof         []byte
+	Kind                string
+	RevisesEventID      *uuid.UUID
+	Reason              *string
+	ApprovalStatus      string
+	ApprovalDecidedAt   pgtype.Timestamptz
+	SigningKeyID        *uuid.UUID
+	Signature           *string
+	SignedAt            pgtype.Timestamptz
 	EntityName          *string
 	EntityLogoObjectKey *string
 }
@@ -257,6 +559,18 @@ func (q *Queries) ListEventsByVehicleWithEntity(ctx context.Context, vehicleID u
 			&i.BlockchainTxID,
 			&i.CreatedAt,
 			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.PreviousCid,
+			&i.MerkleProof,
+			&i.Kind,
+			&i.RevisesEventID,
+			&i.Reason,
+			&i.ApprovalStatus,
+			&i.ApprovalDecidedAt,
+			&i.SigningKeyID,
+			&i.Signature,
+			&i.SignedAt,
 			&i.EntityName,
 			&i.EntityLogoObjectKey,
 		); err != nil {
@@ -270,6 +584,25 @@ func (q *Queries) ListEventsByVehicleWithEntity(ctx context.Context, vehicleID u
 	return items, nil
 }
 
+const setEventSignature = `-- name: SetEventSignature :exec
+UPDATE events
+SET signing_key_id = $2,
+    signature = $3,
+    signed_at = NOW()
+WHERE id = $1
+`
+
+type SetEventSignatureParams struct {
+	ID           uuid.UUID
+	SigningKeyID *uuid.UUID
+	Signature    *string
+}
+
+func (q *Queries) SetEventSignature(ctx context.Context, arg SetEventSignatureParams) error {
+	_, err := q.db.Exec(ctx, setEventSignature, arg.ID, arg.SigningKeyID, arg.Signature)
+	return err
+}
+
 const updateEvent = `-- name: UpdateEvent :one
 UPDATE events
 SET title = $2,
@@ -280,24 +613,36 @@ SET title = $2,
     cid = $7,
     cid_source_json = $8,
     cid_source_cbor_b64 = $9,
+    previous_cid = $14,
+    merkle_proof = $15,
+    approval_status = $16,
+    approval_decided_at = $17,
     blockchain_tx_id = $10,
-    blockchain_status = $11
+    blockchain_status = $11,
+    blockchain_error = $12,
+    blockchain_status_at = CASE WHEN blockchain_status = $11 THEN GREATEST(blockchain_status_at, $13) ELSE NOW() END
 WHERE id = $1
-RETURNING id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status
+RETURNING id, vehicle_id, entity_id, event_type, title, description, event_date, location, metadata, cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, created_at, blockchain_status, blockchain_error, blockchain_status_at, previous_cid, merkle_proof, kind, revises_event_id, reason, approval_status, approval_decided_at, signing_key_id, signature, signed_at
 `
 
 type UpdateEventParams struct {
-	ID               uuid.UUID
-	Title            string
-	Description      string
-	EventDate        time.Time
-	Location         string
-	Metadata         []byte
-	Cid              *string
-	CidSourceJson    *string
-	CidSourceCborB64 *string
-	BlockchainTxID   string
-	BlockchainStatus string
+	ID                 uuid.UUID
+	Title              string
+	Description        string
+	EventDate          time.Time
+	Location           string
+	Metadata           []byte
+	Cid                *string
+	CidSourceJson      *string
+	CidSourceCborB64   *string
+	BlockchainTxID     string
+	BlockchainStatus   string
+	BlockchainError    *string
+	BlockchainStatusAt time.Time
+	PreviousCid        *string
+	MerkleProof        []byte
+	ApprovalStatus     string
+	ApprovalDecidedAt  pgtype.Timestamptz
 }
 
 func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event, error) {
@@ -313,6 +658,12 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event
 		arg.CidSourceCborB64,
 		arg.BlockchainTxID,
 		arg.BlockchainStatus,
+		arg.BlockchainError,
+		arg.BlockchainStatusAt,
+		arg.PreviousCid,
+		arg.MerkleProof,
+		arg.ApprovalStatus,
+		arg.ApprovalDecidedAt,
 	)
 	var i Event
 	err := row.Scan(
@@ -331,6 +682,54 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event
 		&i.BlockchainTxID,
 		&i.CreatedAt,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.PreviousCid,
+		&i.MerkleProof,
+		&i.Kind,
+		&i.RevisesEventID,
+		&i.Reason,
+		&i.ApprovalStatus,
+		&i.ApprovalDecidedAt,
+		&i.SigningKeyID,
+		&i.Signature,
+		&i.SignedAt,
 	)
 	return i, err
 }
+
+const updateUnanchoredEventDetails = `-- name: UpdateUnanchoredEventDetails :execrows
+UPDATE events
+SET title = $2,
+    description = $3,
+    event_date = $4,
+    location = $5,
+    metadata = $6
+WHERE id = $1
+  AND cid IS NULL
+`
+
+type UpdateUnanchoredEventDetailsParams struct {
+	ID          uuid.UUID
+	Title       string
+	Description string
+	EventDate   time.Time
+	Location    string
+	Metadata    []byte
+}
+
+// Only events that were never submitted for anchoring can be edited; anchored events are amended.
+func (q *Queries) UpdateUnanchoredEventDetails(ctx context.Context, arg UpdateUnanchoredEventDetailsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateUnanchoredEventDetails,
+		arg.ID,
+		arg.Title,
+		arg.Description,
+		arg.EventDate,
+		arg.Location,
+		arg.Metadata,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,320 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lifecycle_requests.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createLifecycleRequest = `-- name: CreateLifecycleRequest :one
+INSERT INTO vehicle_lifecycle_requests (vehicle_id, from_status, to_status, reason, requested_by, entity_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, vehicle_id, from_status, to_status, reason, requested_by, entity_id, status, decided_by, decline_reason, event_id, asset_status, asset_tx_id, asset_error, created_at, decided_at
+`
+
+type CreateLifecycleRequestParams struct {
+	VehicleID   uuid.UUID
+	FromStatus  string
+	ToStatus    string
+	Reason      string
+	RequestedBy uuid.UUID
+	EntityID    *uuid.UUID
+}
+
+func (q *Queries) CreateLifecycleRequest(ctx context.Context, arg CreateLifecycleRequestParams) (VehicleLifecycleRequest, error) {
+	row := q.db.QueryRow(ctx, createLifecycleRequest,
+		arg.VehicleID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Reason,
+		arg.RequestedBy,
+		arg.EntityID,
+	)
+	var i VehicleLifecycleRequest
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Reason,
+		&i.RequestedBy,
+		&i.EntityID,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.EventID,
+		&i.AssetStatus,
+		&i.AssetTxID,
+		&i.AssetError,
+		&i.CreatedAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const decideLifecycleRequest = `-- name: DecideLifecycleRequest :one
+UPDATE vehicle_lifecycle_requests
+SET status = $2,
+    decided_by = $3,
+    decline_reason = $4,
+    decided_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, vehicle_id, from_status, to_status, reason, requested_by, entity_id, status, decided_by, decline_reason, event_id, asset_status, asset_tx_id, asset_error, created_at, decided_at
+`
+
+type DecideLifecycleRequestParams struct {
+	ID            uuid.UUID
+	Status        string
+	DecidedBy     *uuid.UUID
+	DeclineReason *string
+}
+
+func (q *Queries) DecideLifecycleRequest(ctx context.Context, arg DecideLifecycleRequestParams) (VehicleLifecycleRequest, error) {
+	row := q.db.QueryRow(ctx, decideLifecycleRequest,
+		arg.ID,
+		arg.Status,
+		arg.DecidedBy,
+		arg.DeclineReason,
+	)
+	var i VehicleLifecycleRequest
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Reason,
+		&i.RequestedBy,
+		&i.EntityID,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.EventID,
+		&i.AssetStatus,
+		&i.AssetTxID,
+		&i.AssetError,
+		&i.CreatedAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const getLifecycleRequest = `-- name: GetLifecycleRequest :one
+SELECT id, vehicle_id, from_status, to_status, reason, requested_by, entity_id, status, decided_by, decline_reason, event_id, asset_status, asset_tx_id, asset_error, created_at, decided_at FROM vehicle_lifecycle_requests
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetLifecycleRequest(ctx context.Context, id uuid.UUID) (VehicleLifecycleRequest, error) {
+	row := q.db.QueryRow(ctx, getLifecycleRequest, id)
+	var i VehicleLifecycleRequest
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Reason,
+		&i.RequestedBy,
+		&i.EntityID,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.EventID,
+		&i.AssetStatus,
+		&i.AssetTxID,
+		&i.AssetError,
+		&i.CreatedAt,
+		&i.DecidedAt,
+	)
+	return i, err
+}
+
+const listLifecycleRequests = `-- name: ListLifecycleRequests :many
+SELECT id, vehicle_id, from_status, to_status, reason, requested_by, entity_id, status, decided_by, decline_reason, event_id, asset_status, asset_tx_id, asset_error, created_at, decided_at FROM vehicle_lifecycle_requests
+WHERE ($1::uuid IS NULL OR entity_id = $1)
+  AND ($2::text IS NULL OR status = $2)
+ORDER BY created_at ASC
+`
+
+type ListLifecycleRequestsParams struct {
+	EntityID *uuid.UUID
+	Status   *string
+}
+
+// Without an entity every request is listed, as admins review them all
+func (q *Queries) ListLifecycleRequests(ctx context.Context, arg ListLifecycleRequestsParams) ([]VehicleLifecycleRequest, error) {
+	rows, err := q.db.Query(ctx, listLifecycleRequests, arg.EntityID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VehicleLifecycleRequest{}
+	for rows.Next() {
+		var i VehicleLifecycleRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Reason,
+			&i.RequestedBy,
+			&i.EntityID,
+			&i.Status,
+			&i.DecidedBy,
+			&i.DeclineReason,
+			&i.EventID,
+			&i.AssetStatus,
+			&i.AssetTxID,
+			&i.AssetError,
+			&i.CreatedAt,
+			&i.DecidedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLifecycleRequestsByAssetStatus = `-- name: ListLifecycleRequestsByAssetStatus :many
+SELECT id, vehicle_id, from_status, to_status, reason, requested_by, entity_id, status, decided_by, decline_reason, event_id, asset_status, asset_tx_id, asset_error, created_at, decided_at FROM vehicle_lifecycle_requests
+WHERE asset_status = $1
+  AND decided_at < NOW() - make_interval(secs => $3::float8)
+ORDER BY decided_at ASC
+LIMIT $2
+`
+
+type ListLifecycleRequestsByAssetStatusParams struct {
+	AssetStatus      *string
+	Limit            int32
+	OlderThanSeconds float64
+}
+
+func (q *Queries) ListLifecycleRequestsByAssetStatus(ctx context.Context, arg ListLifecycleRequestsByAssetStatusParams) ([]VehicleLifecycleRequest, error) {
+	rows, err := q.db.Query(ctx, listLifecycleRequestsByAssetStatus, arg.AssetStatus, arg.Limit, arg.OlderThanSeconds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VehicleLifecycleRequest{}
+	for rows.Next() {
+		var i VehicleLifecycleRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Reason,
+			&i.RequestedBy,
+			&i.EntityID,
+			&i.Status,
+			&i.DecidedBy,
+			&i.DeclineReason,
+			&i.EventID,
+			&i.AssetStatus,
+			&i.AssetTxID,
+			&i.AssetError,
+			&i.CreatedAt,
+			&i.DecidedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLifecycleRequestsByVehicle = `-- name: ListLifecycleRequestsByVehicle :many
+SELECT id, vehicle_id, from_status, to_status, reason, requested_by, entity_id, status, decided_by, decline_reason, event_id, asset_status, asset_tx_id, asset_error, created_at, decided_at FROM vehicle_lifecycle_requests
+WHERE vehicle_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListLifecycleRequestsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]VehicleLifecycleRequest, error) {
+	rows, err := q.db.Query(ctx, listLifecycleRequestsByVehicle, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VehicleLifecycleRequest{}
+	for rows.Next() {
+		var i VehicleLifecycleRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Reason,
+			&i.RequestedBy,
+			&i.EntityID,
+			&i.Status,
+			&i.DecidedBy,
+			&i.DeclineReason,
+			&i.EventID,
+			&i.AssetStatus,
+			&i.AssetTxID,
+			&i.AssetError,
+			&i.CreatedAt,
+			&i.DecidedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setLifecycleRequestAssetStatus = `-- name: SetLifecycleRequestAssetStatus :exec
+UPDATE vehicle_lifecycle_requests
+SET asset_status = $2,
+    asset_tx_id = COALESCE($4, asset_tx_id),
+    asset_error = $3
+WHERE id = $1
+`
+
+type SetLifecycleRequestAssetStatusParams struct {
+	ID          uuid.UUID
+	AssetStatus *string
+	AssetError  *string
+	AssetTxID   *string
+}
+
+func (q *Queries) SetLifecycleRequestAssetStatus(ctx context.Context, arg SetLifecycleRequestAssetStatusParams) error {
+	_, err := q.db.Exec(ctx, setLifecycleRequestAssetStatus,
+		arg.ID,
+		arg.AssetStatus,
+		arg.AssetError,
+		arg.AssetTxID,
+	)
+	return err
+}
+
+const setLifecycleRequestEvent = `-- name: SetLifecycleRequestEvent :exec
+UPDATE vehicle_lifecycle_requests
+SET event_id = $2,
+    asset_status = $3
+WHERE id = $1
+`
+
+type SetLifecycleRequestEventParams struct {
+	ID          uuid.UUID
+	EventID     *uuid.UUID
+	AssetStatus *string
+}
+
+func (q *Queries) SetLifecycleRequestEvent(ctx context.Context, arg SetLifecycleRequestEventParams) error {
+	_, err := q.db.Exec(ctx, setLifecycleRequestEvent, arg.ID, arg.EventID, arg.AssetStatus)
+	return err
+}
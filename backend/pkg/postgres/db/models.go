@@ -11,36 +11,122 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Anchor struct {
+	ID               uuid.UUID
+	VehicleID        uuid.UUID
+	RecordType       string
+	EventID          *uuid.UUID
+	VehicleVersionID *uuid.UUID
+	Status           string
+	Error            *string
+	Network          string
+	Sender           string
+	TxID             *string
+	TxType           *string
+	AssetID          *int64
+	ConfirmedRound   *int64
+	RoundTime        pgtype.Timestamptz
+	FeeMicroalgos    *int64
+	CreatedAt        time.Time
+}
+
+type Certification struct {
+	ID                uuid.UUID
+	EventID           uuid.UUID
+	VehicleID         uuid.UUID
+	EntityID          uuid.UUID
+	CertificateNumber *string
+	ValidFrom         time.Time
+	ValidUntil        pgtype.Date
+	Status            string
+	LastReminderDays  *int32
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
 type Entity struct {
-	ID            uuid.UUID
-	Name          string
-	EntityType    string
-	Description   string
-	ContactEmail  string
-	Website       string
-	Address       []byte
-	CertifiedBy   *uuid.UUID
-	CreatedAt     pgtype.Timestamp
-	UpdatedAt     pgtype.Timestamp
-	LogoObjectKey *string
+	ID                      uuid.UUID
+	Name                    string
+	EntityType              string
+	Description             string
+	ContactEmail            string
+	Website                 string
+	Address                 []byte
+	CertifiedBy             *uuid.UUID
+	CreatedAt               pgtype.Timestamp
+	UpdatedAt               pgtype.Timestamp
+	LogoObjectKey           *string
+	OwnerApprovalEventTypes []string
 }
 
-type Event struct {
+type EntityEventType struct {
+	ID             uuid.UUID
+	EntityID       uuid.UUID
+	Key            string
+	DisplayName    string
+	Icon           *string
+	MetadataSchema []byte
+	DefinitionCid  string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	RetiredAt      pgtype.Timestamptz
+}
+
+type EntitySigningKey struct {
 	ID               uuid.UUID
-	VehicleID        uuid.UUID
-	EntityID         *uuid.UUID
-	EventType        string
-	Title            string
-	Description      string
-	EventDate        time.Time
-	Location         string
-	Metadata         []byte
-	Cid              *string
-	CidSourceJson    *string
-	CidSourceCborB64 *string
-	BlockchainTxID   string
-	CreatedAt        pgtype.Timestamp
-	BlockchainStatus string
+	EntityID         uuid.UUID
+	Label            string
+	Algorithm        string
+	PublicKey        string
+	SealedPrivateKey *string
+	CreatedAt        time.Time
+	RevokedAt        pgtype.Timestamptz
+}
+
+type Event struct {
+	ID                 uuid.UUID
+	VehicleID          uuid.UUID
+	EntityID           *uuid.UUID
+	EventType          string
+	Title              string
+	Description        string
+	EventDate          time.Time
+	Location           string
+	Metadata           []byte
+	Cid                *string
+	CidSourceJson      *string
+	CidSourceCborB64   *string
+	BlockchainTxID     string
+	CreatedAt          pgtype.Timestamp
+	BlockchainStatus   string
+	BlockchainError    *string
+	BlockchainStatusAt time.Time
+	PreviousCid        *string
+	MerkleProof        []byte
+	Kind               string
+	RevisesEventID     *uuid.UUID
+	Reason             *string
+	ApprovalStatus     string
+	ApprovalDecidedAt  pgtype.Timestamptz
+	SigningKeyID       *uuid.UUID
+	Signature          *string
+	SignedAt           pgtype.Timestamptz
+}
+
+type EventCertificationRequest struct {
+	ID                uuid.UUID
+	EventID           uuid.UUID
+	VehicleID         uuid.UUID
+	EntityID          uuid.UUID
+	RequestedBy       uuid.UUID
+	EvidenceSessionID *uuid.UUID
+	Message           *string
+	Status            string
+	DecidedBy         *uuid.UUID
+	DeclineReason     *string
+	CertifiedEventID  *uuid.UUID
+	CreatedAt         time.Time
+	DecidedAt         pgtype.Timestamptz
 }
 
 type EventImage struct {
@@ -53,6 +139,38 @@ type EventImage struct {
 	CreatedAt       pgtype.Timestamp
 }
 
+type Outbox struct {
+	ID            int64
+	Subject       string
+	Payload       []byte
+	Attempts      int32
+	LastError     *string
+	CreatedAt     time.Time
+	PublishedAt   pgtype.Timestamptz
+	NextAttemptAt time.Time
+}
+
+type StolenVehicleReport struct {
+	ID                 uuid.UUID
+	VehicleID          uuid.UUID
+	Kind               string
+	PoliceReference    *string
+	PoliceAuthority    *string
+	IncidentDate       pgtype.Date
+	Location           *string
+	Description        string
+	ReportedBy         uuid.UUID
+	Status             string
+	DecidedBy          *uuid.UUID
+	DeclineReason      *string
+	LifecycleRequestID *uuid.UUID
+	ResolvedBy         *uuid.UUID
+	ResolutionNote     *string
+	CreatedAt          time.Time
+	DecidedAt          pgtype.Timestamptz
+	ResolvedAt         pgtype.Timestamptz
+}
+
 type User struct {
 	ID        uuid.UUID
 	IsAdmin   bool
@@ -80,32 +198,54 @@ type UserInvitation struct {
 	ClaimedAt      pgtype.Timestamp
 }
 
+type UserWallet struct {
+	UserID   uuid.UUID
+	Address  string
+	LinkedAt time.Time
+}
+
 type Vehicle struct {
-	ID                 uuid.UUID
-	OwnerID            *uuid.UUID
-	ChassisNumber      string
-	LicensePlate       string
-	EngineNumber       string
-	TransmissionNumber string
-	Make               string
-	Model              string
-	Year               int32
-	Color              string
-	BodyType           string
-	DriveType          string
-	GearType           string
-	SuspensionType     string
-	Cid                *string
-	CidSourceJson      *string
-	CidSourceCborB64   *string
-	BlockchainAssetID  string
-	CreatedAt          pgtype.Timestamp
-	UpdatedAt          pgtype.Timestamp
-	Fuel               string
-	EngineCc           *int32
-	EngineCylinders    *int32
-	EnginePowerHp      *int32
-	BlockchainStatus   string
+	ID                    uuid.UUID
+	OwnerID               *uuid.UUID
+	ChassisNumber         string
+	LicensePlate          string
+	EngineNumber          string
+	TransmissionNumber    string
+	Make                  string
+	Model                 string
+	Year                  int32
+	Color                 string
+	BodyType              string
+	DriveType             string
+	GearType              string
+	SuspensionType        string
+	Cid                   *string
+	CidSourceJson         *string
+	CidSourceCborB64      *string
+	BlockchainAssetID     string
+	CreatedAt             pgtype.Timestamp
+	UpdatedAt             pgtype.Timestamp
+	Fuel                  string
+	EngineCc              *int32
+	EngineCylinders       *int32
+	EnginePowerHp         *int32
+	BlockchainStatus      string
+	BlockchainError       *string
+	BlockchainStatusAt    time.Time
+	GenesisTxID           *string
+	GenesisLastValidRound *int64
+	ChainHeadCid          *string
+	LifecycleStatus       string
+	LifecycleStatusAt     time.Time
+}
+
+type VehicleCustody struct {
+	VehicleID     uuid.UUID
+	HolderAddress *string
+	Status        string
+	TxID          *string
+	Error         *string
+	UpdatedAt     time.Time
 }
 
 type VehicleDocument struct {
@@ -127,6 +267,49 @@ type VehicleInvitation struct {
 	TokenExpiresAt pgtype.Timestamp
 }
 
+type VehicleLifecycleRequest struct {
+	ID            uuid.UUID
+	VehicleID     uuid.UUID
+	FromStatus    string
+	ToStatus      string
+	Reason        string
+	RequestedBy   uuid.UUID
+	EntityID      *uuid.UUID
+	Status        string
+	DecidedBy     *uuid.UUID
+	DeclineReason *string
+	EventID       *uuid.UUID
+	AssetStatus   *string
+	AssetTxID     *string
+	AssetError    *string
+	CreatedAt     time.Time
+	DecidedAt     pgtype.Timestamptz
+}
+
+type VehicleOwner struct {
+	ID        uuid.UUID
+	VehicleID uuid.UUID
+	OwnerID   *uuid.UUID
+	StartDate time.Time
+	EndDate   pgtype.Timestamptz
+	CreatedAt time.Time
+	Source    string
+}
+
+type VehicleOwnershipTransfer struct {
+	ID             uuid.UUID
+	VehicleID      uuid.UUID
+	FromOwnerID    uuid.UUID
+	ToEmail        string
+	ToOwnerID      *uuid.UUID
+	Token          string
+	TokenExpiresAt time.Time
+	Status         string
+	EventID        *uuid.UUID
+	CreatedAt      time.Time
+	CompletedAt    pgtype.Timestamptz
+}
+
 type VehiclePhoto struct {
 	ID        uuid.UUID
 	VehicleID uuid.UUID
@@ -150,3 +333,28 @@ type VehicleShareLink struct {
 	LastAccessedAt   pgtype.Timestamp
 	RevokedAt        pgtype.Timestamp
 }
+
+type VehicleVersion struct {
+	ID                 uuid.UUID
+	VehicleID          uuid.UUID
+	Version            int32
+	Cid                string
+	PreviousCid        string
+	CidSourceJson      string
+	CidSourceCborB64   string
+	BlockchainTxID     *string
+	BlockchainStatus   string
+	BlockchainError    *string
+	BlockchainStatusAt time.Time
+	CreatedAt          time.Time
+}
+
+type WalletChallenge struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Address   string
+	Message   string
+	ExpiresAt time.Time
+	UsedAt    pgtype.Timestamptz
+	CreatedAt time.Time
+}
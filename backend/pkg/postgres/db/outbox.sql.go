@@ -0,0 +1,106 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: outbox.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimPendingOutboxMessages = `-- name: ClaimPendingOutboxMessages :many
+SELECT id, subject, payload, attempts, last_error, created_at, published_at, next_attempt_at FROM outbox
+WHERE published_at IS NULL AND next_attempt_at <= NOW()
+ORDER BY id ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) ClaimPendingOutboxMessages(ctx context.Context, limit int32) ([]Outbox, error) {
+	rows, err := q.db.Query(ctx, claimPendingOutboxMessages, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Outbox{}
+	for rows.Next() {
+		var i Outbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.Subject,
+			&i.Payload,
+			&i.Attempts,
+			&i.LastError,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.NextAttemptAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createOutboxMessage = `-- name: CreateOutboxMessage :exec
+INSERT INTO outbox (subject, payload)
+VALUES ($1, $2)
+`
+
+type CreateOutboxMessageParams struct {
+	Subject string
+	Payload []byte
+}
+
+func (q *Queries) CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) error {
+	_, err := q.db.Exec(ctx, createOutboxMessage, arg.Subject, arg.Payload)
+	return err
+}
+
+const deletePublishedOutboxMessages = `-- name: DeletePublishedOutboxMessages :execrows
+DELETE FROM outbox
+WHERE published_at IS NOT NULL AND published_at < $1
+`
+
+func (q *Queries) DeletePublishedOutboxMessages(ctx context.Context, publishedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePublishedOutboxMessages, publishedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markOutboxMessageFailed = `-- name: MarkOutboxMessageFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+WHERE id = $1
+`
+
+type MarkOutboxMessageFailedParams struct {
+	ID            int64
+	LastError     *string
+	NextAttemptAt time.Time
+}
+
+func (q *Queries) MarkOutboxMessageFailed(ctx context.Context, arg MarkOutboxMessageFailedParams) error {
+	_, err := q.db.Exec(ctx, markOutboxMessageFailed, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
+
+const markOutboxMessagePublished = `-- name: MarkOutboxMessagePublished :exec
+UPDATE outbox
+SET published_at = NOW(), attempts = attempts + 1, last_error = NULL
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxMessagePublished(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, markOutboxMessagePublished, id)
+	return err
+}
@@ -0,0 +1,226 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: ownership_transfers.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const acceptOwnershipTransfer = `-- name: AcceptOwnershipTransfer :one
+UPDATE vehicle_ownership_transfers
+SET status = 'accepted',
+    to_owner_id = $2,
+    completed_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, vehicle_id, from_owner_id, to_email, to_owner_id, token, token_expires_at, status, event_id, created_at, completed_at
+`
+
+type AcceptOwnershipTransferParams struct {
+	ID        uuid.UUID
+	ToOwnerID *uuid.UUID
+}
+
+func (q *Queries) AcceptOwnershipTransfer(ctx context.Context, arg AcceptOwnershipTransferParams) (VehicleOwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, acceptOwnershipTransfer, arg.ID, arg.ToOwnerID)
+	var i VehicleOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromOwnerID,
+		&i.ToEmail,
+		&i.ToOwnerID,
+		&i.Token,
+		&i.TokenExpiresAt,
+		&i.Status,
+		&i.EventID,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const cancelOwnershipTransfer = `-- name: CancelOwnershipTransfer :one
+UPDATE vehicle_ownership_transfers
+SET status = 'cancelled',
+    completed_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, vehicle_id, from_owner_id, to_email, to_owner_id, token, token_expires_at, status, event_id, created_at, completed_at
+`
+
+func (q *Queries) CancelOwnershipTransfer(ctx context.Context, id uuid.UUID) (VehicleOwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, cancelOwnershipTransfer, id)
+	var i VehicleOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromOwnerID,
+		&i.ToEmail,
+		&i.ToOwnerID,
+		&i.Token,
+		&i.TokenExpiresAt,
+		&i.Status,
+		&i.EventID,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const cancelPendingOwnershipTransfers = `-- name: CancelPendingOwnershipTransfers :exec
+UPDATE vehicle_ownership_transfers
+SET status = 'cancelled',
+    completed_at = NOW()
+WHERE vehicle_id = $1 AND status = 'pending'
+`
+
+func (q *Queries) CancelPendingOwnershipTransfers(ctx context.Context, vehicleID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, cancelPendingOwnershipTransfers, vehicleID)
+	return err
+}
+
+const createOwnershipTransfer = `-- name: CreateOwnershipTransfer :one
+INSERT INTO vehicle_ownership_transfers (vehicle_id, from_owner_id, to_email, token, token_expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, vehicle_id, from_owner_id, to_email, to_owner_id, token, token_expires_at, status, event_id, created_at, completed_at
+`
+
+type CreateOwnershipTransferParams struct {
+	VehicleID      uuid.UUID
+	FromOwnerID    uuid.UUID
+	ToEmail        string
+	Token          string
+	TokenExpiresAt time.Time
+}
+
+func (q *Queries) CreateOwnershipTransfer(ctx context.Context, arg CreateOwnershipTransferParams) (VehicleOwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, createOwnershipTransfer,
+		arg.VehicleID,
+		arg.FromOwnerID,
+		arg.ToEmail,
+		arg.Token,
+		arg.TokenExpiresAt,
+	)
+	var i VehicleOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromOwnerID,
+		&i.ToEmail,
+		&i.ToOwnerID,
+		&i.Token,
+		&i.TokenExpiresAt,
+		&i.Status,
+		&i.EventID,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getOwnershipTransfer = `-- name: GetOwnershipTransfer :one
+SELECT id, vehicle_id, from_owner_id, to_email, to_owner_id, token, token_expires_at, status, event_id, created_at, completed_at FROM vehicle_ownership_transfers
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetOwnershipTransfer(ctx context.Context, id uuid.UUID) (VehicleOwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, getOwnershipTransfer, id)
+	var i VehicleOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromOwnerID,
+		&i.ToEmail,
+		&i.ToOwnerID,
+		&i.Token,
+		&i.TokenExpiresAt,
+		&i.Status,
+		&i.EventID,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const getOwnershipTransferByToken = `-- name: GetOwnershipTransferByToken :one
+SELECT id, vehicle_id, from_owner_id, to_email, to_owner_id, token, token_expires_at, status, event_id, created_at, completed_at FROM vehicle_ownership_transfers
+WHERE token = $1 LIMIT 1
+`
+
+func (q *Queries) GetOwnershipTransferByToken(ctx context.Context, token string) (VehicleOwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, getOwnershipTransferByToken, token)
+	var i VehicleOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.FromOwnerID,
+		&i.ToEmail,
+		&i.ToOwnerID,
+		&i.Token,
+		&i.TokenExpiresAt,
+		&i.Status,
+		&i.EventID,
+		&i.CreatedAt,
+		&i.CompletedAt,
+	)
+	return i, err
+}
+
+const listOwnershipTransfersByVehicle = `-- name: ListOwnershipTransfersByVehicle :many
+SELECT id, vehicle_id, from_owner_id, to_email, to_owner_id, token, token_expires_at, status, event_id, created_at, completed_at FROM vehicle_ownership_transfers
+WHERE vehicle_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListOwnershipTransfersByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]VehicleOwnershipTransfer, error) {
+	rows, err := q.db.Query(ctx, listOwnershipTransfersByVehicle, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VehicleOwnershipTransfer{}
+	for rows.Next() {
+		var i VehicleOwnershipTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.FromOwnerID,
+			&i.ToEmail,
+			&i.ToOwnerID,
+			&i.Token,
+			&i.TokenExpiresAt,
+			&i.Status,
+			&i.EventID,
+			&i.CreatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setOwnershipTransferEvent = `-- name: SetOwnershipTransferEvent :exec
+UPDATE vehicle_ownership_transfers
+SET event_id = $2
+WHERE id = $1
+`
+
+type SetOwnershipTransferEventParams struct {
+	ID      uuid.UUID
+	EventID *uuid.UUID
+}
+
+func (q *Queries) SetOwnershipTransferEvent(ctx context.Context, arg SetOwnershipTransferEventParams) error {
+	_, err := q.db.Exec(ctx, setOwnershipTransferEvent, arg.ID, arg.EventID)
+	return err
+}
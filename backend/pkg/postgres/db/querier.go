@@ -6,18 +6,25 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AcceptOwnershipTransfer(ctx context.Context, arg AcceptOwnershipTransferParams) (VehicleOwnershipTransfer, error)
 	AddUserToEntity(ctx context.Context, arg AddUserToEntityParams) (UserEntity, error)
 	AttachEventImagesToEvent(ctx context.Context, arg AttachEventImagesToEventParams) error
+	CancelOwnershipTransfer(ctx context.Context, id uuid.UUID) (VehicleOwnershipTransfer, error)
+	CancelPendingOwnershipTransfers(ctx context.Context, vehicleID uuid.UUID) error
 	CheckUserEntityMembership(ctx context.Context, arg CheckUserEntityMembershipParams) (bool, error)
+	ClaimCertificationReminders(ctx context.Context, arg ClaimCertificationRemindersParams) ([]Certification, error)
 	ClaimInvitation(ctx context.Context, id uuid.UUID) (ClaimInvitationRow, error)
 	ClaimInvitationsByEmail(ctx context.Context, email string) error
+	ClaimPendingOutboxMessages(ctx context.Context, limit int32) ([]Outbox, error)
 	ClaimUserInvitation(ctx context.Context, token string) error
+	ClaimVehicleGenesis(ctx context.Context, arg ClaimVehicleGenesisParams) (int64, error)
 	ClearEntityLogo(ctx context.Context, id uuid.UUID) (Entity, error)
 	ConfirmDocumentUpload(ctx context.Context, id uuid.UUID) (VehicleDocument, error)
 	ConfirmEventImageUpload(ctx context.Context, arg ConfirmEventImageUploadParams) (EventImage, error)
@@ -26,40 +33,75 @@ type Querier interface {
 	CountEntities(ctx context.Context) (int64, error)
 	CountEntitiesByType(ctx context.Context, entityType string) (int64, error)
 	CountEventImagesBySession(ctx context.Context, uploadSessionID uuid.UUID) (int64, error)
+	CountEventsByBlockchainStatus(ctx context.Context, arg CountEventsByBlockchainStatusParams) (int64, error)
 	CountPhotosByVehicle(ctx context.Context, vehicleID uuid.UUID) (int64, error)
+	CountVehicleVersionsByBlockchainStatus(ctx context.Context, arg CountVehicleVersionsByBlockchainStatusParams) (int64, error)
 	CountVehicles(ctx context.Context) (int64, error)
+	CountVehiclesByBlockchainStatus(ctx context.Context, arg CountVehiclesByBlockchainStatusParams) (int64, error)
 	CountVehiclesByOwner(ctx context.Context, ownerID *uuid.UUID) (int64, error)
+	CreateAnchor(ctx context.Context, arg CreateAnchorParams) (Anchor, error)
+	CreateCertificationRequest(ctx context.Context, arg CreateCertificationRequestParams) (EventCertificationRequest, error)
 	CreateDocument(ctx context.Context, arg CreateDocumentParams) (VehicleDocument, error)
 	CreateEntity(ctx context.Context, arg CreateEntityParams) (Entity, error)
+	CreateEntityEventType(ctx context.Context, arg CreateEntityEventTypeParams) (EntityEventType, error)
+	CreateEntitySigningKey(ctx context.Context, arg CreateEntitySigningKeyParams) (EntitySigningKey, error)
 	CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error)
 	CreateEventImage(ctx context.Context, arg CreateEventImageParams) (EventImage, error)
 	CreateInvitation(ctx context.Context, arg CreateInvitationParams) (CreateInvitationRow, error)
+	CreateLifecycleRequest(ctx context.Context, arg CreateLifecycleRequestParams) (VehicleLifecycleRequest, error)
+	CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) error
+	CreateOwnershipTransfer(ctx context.Context, arg CreateOwnershipTransferParams) (VehicleOwnershipTransfer, error)
 	CreatePhoto(ctx context.Context, arg CreatePhotoParams) (VehiclePhoto, error)
 	CreateShareLink(ctx context.Context, arg CreateShareLinkParams) (VehicleShareLink, error)
+	CreateStolenVehicleReport(ctx context.Context, arg CreateStolenVehicleReportParams) (StolenVehicleReport, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateUserInvitation(ctx context.Context, arg CreateUserInvitationParams) (UserInvitation, error)
 	CreateVehicle(ctx context.Context, arg CreateVehicleParams) (Vehicle, error)
+	CreateVehicleOwner(ctx context.Context, arg CreateVehicleOwnerParams) (VehicleOwner, error)
+	// Version 1 is the genesis record held on the vehicle itself, so revisions start at 2
+	CreateVehicleVersion(ctx context.Context, arg CreateVehicleVersionParams) (VehicleVersion, error)
+	CreateWalletChallenge(ctx context.Context, arg CreateWalletChallengeParams) (WalletChallenge, error)
+	DecideCertificationRequest(ctx context.Context, arg DecideCertificationRequestParams) (EventCertificationRequest, error)
+	// Only decides events still awaiting the owner's approval. The update locks the event, so of two
+	// concurrent decisions the second finds it already decided.
+	DecideEventProposal(ctx context.Context, arg DecideEventProposalParams) (int64, error)
+	DecideLifecycleRequest(ctx context.Context, arg DecideLifecycleRequestParams) (VehicleLifecycleRequest, error)
+	DecideStolenVehicleReport(ctx context.Context, arg DecideStolenVehicleReportParams) (StolenVehicleReport, error)
 	DeleteDocument(ctx context.Context, id uuid.UUID) error
 	DeleteEntity(ctx context.Context, id uuid.UUID) error
-	DeleteEvent(ctx context.Context, id uuid.UUID) error
+	DeleteEvent(ctx context.Context, id uuid.UUID) (int64, error)
 	DeleteEventImage(ctx context.Context, id uuid.UUID) error
 	DeleteExpiredShareLinks(ctx context.Context) error
 	DeleteInvitation(ctx context.Context, id uuid.UUID) error
 	DeleteOrphanedEventImages(ctx context.Context, createdAt pgtype.Timestamp) error
 	DeletePhoto(ctx context.Context, id uuid.UUID) error
+	DeletePublishedOutboxMessages(ctx context.Context, publishedAt pgtype.Timestamptz) (int64, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
 	DeleteUserInvitation(ctx context.Context, id uuid.UUID) error
+	DeleteUserWallet(ctx context.Context, userID uuid.UUID) (int64, error)
 	DeleteVehicle(ctx context.Context, id uuid.UUID) error
+	EndVehicleOwnership(ctx context.Context, arg EndVehicleOwnershipParams) error
+	ExpireCertifications(ctx context.Context, today time.Time) ([]Certification, error)
+	GetActiveManagedSigningKey(ctx context.Context, entityID uuid.UUID) (EntitySigningKey, error)
+	GetActiveWalletChallenge(ctx context.Context, arg GetActiveWalletChallengeParams) (WalletChallenge, error)
 	GetAllPendingInvitations(ctx context.Context) ([]GetAllPendingInvitationsRow, error)
+	GetApprovedStolenVehicleReport(ctx context.Context, vehicleID uuid.UUID) (StolenVehicleReport, error)
+	GetCertificationRequest(ctx context.Context, id uuid.UUID) (EventCertificationRequest, error)
 	GetDocument(ctx context.Context, id uuid.UUID) (VehicleDocument, error)
 	GetDocumentByKey(ctx context.Context, arg GetDocumentByKeyParams) (VehicleDocument, error)
 	GetEntity(ctx context.Context, id uuid.UUID) (Entity, error)
+	GetEntityEventType(ctx context.Context, arg GetEntityEventTypeParams) (EntityEventType, error)
 	GetEntityMembers(ctx context.Context, entityID uuid.UUID) ([]GetEntityMembersRow, error)
+	GetEntitySigningKey(ctx context.Context, id uuid.UUID) (EntitySigningKey, error)
+	GetEntitySigningKeyByPublicKey(ctx context.Context, publicKey string) (EntitySigningKey, error)
 	GetEvent(ctx context.Context, id uuid.UUID) (Event, error)
 	GetEventImage(ctx context.Context, id uuid.UUID) (EventImage, error)
 	GetInvitationByID(ctx context.Context, id uuid.UUID) (GetInvitationByIDRow, error)
 	GetInvitationByToken(ctx context.Context, token *string) (GetInvitationByTokenRow, error)
 	GetInvitationsByEmailAndVehicle(ctx context.Context, arg GetInvitationsByEmailAndVehicleParams) ([]GetInvitationsByEmailAndVehicleRow, error)
+	GetLifecycleRequest(ctx context.Context, id uuid.UUID) (VehicleLifecycleRequest, error)
+	GetOwnershipTransfer(ctx context.Context, id uuid.UUID) (VehicleOwnershipTransfer, error)
+	GetOwnershipTransferByToken(ctx context.Context, token string) (VehicleOwnershipTransfer, error)
 	GetPendingInvitationByVehicleID(ctx context.Context, vehicleID uuid.UUID) (GetPendingInvitationByVehicleIDRow, error)
 	GetPendingInvitationsByEmail(ctx context.Context, email string) ([]GetPendingInvitationsByEmailRow, error)
 	GetPendingUserInvitationsByEmail(ctx context.Context, email string) ([]UserInvitation, error)
@@ -67,39 +109,97 @@ type Querier interface {
 	GetPhotoByKey(ctx context.Context, arg GetPhotoByKeyParams) (VehiclePhoto, error)
 	GetShareLinkByID(ctx context.Context, id uuid.UUID) (VehicleShareLink, error)
 	GetShareLinkByToken(ctx context.Context, token string) (VehicleShareLink, error)
+	GetStolenVehicleReport(ctx context.Context, id uuid.UUID) (StolenVehicleReport, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserEntityMemberships(ctx context.Context, userID uuid.UUID) ([]GetUserEntityMembershipsRow, error)
 	GetUserEntityRole(ctx context.Context, arg GetUserEntityRoleParams) (string, error)
 	GetUserInvitationByID(ctx context.Context, id uuid.UUID) (UserInvitation, error)
 	GetUserInvitationByToken(ctx context.Context, token string) (UserInvitation, error)
+	GetUserWallet(ctx context.Context, userID uuid.UUID) (UserWallet, error)
 	GetVehicle(ctx context.Context, id uuid.UUID) (Vehicle, error)
 	GetVehicleByChassisNumber(ctx context.Context, chassisNumber string) (Vehicle, error)
 	GetVehicleByLicensePlate(ctx context.Context, licensePlate string) (Vehicle, error)
+	GetVehicleCustody(ctx context.Context, vehicleID uuid.UUID) (VehicleCustody, error)
+	GetVehicleVersion(ctx context.Context, id uuid.UUID) (VehicleVersion, error)
 	IncrementShareLinkAccessCount(ctx context.Context, id uuid.UUID) (VehicleShareLink, error)
+	ListActiveEntityEventTypes(ctx context.Context) ([]ListActiveEntityEventTypesRow, error)
+	ListAnchorsByEvent(ctx context.Context, eventID *uuid.UUID) ([]Anchor, error)
+	ListAnchorsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Anchor, error)
+	ListCertificationRequestsByEntity(ctx context.Context, arg ListCertificationRequestsByEntityParams) ([]EventCertificationRequest, error)
+	ListCertificationRequestsByEvent(ctx context.Context, eventID uuid.UUID) ([]EventCertificationRequest, error)
+	ListCertificationsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Certification, error)
 	ListDocumentsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]VehicleDocument, error)
 	ListEntities(ctx context.Context, arg ListEntitiesParams) ([]Entity, error)
 	ListEntitiesByType(ctx context.Context, arg ListEntitiesByTypeParams) ([]Entity, error)
+	ListEntityEventTypesByEntity(ctx context.Context, entityID uuid.UUID) ([]EntityEventType, error)
+	ListEntitySigningKeysByEntity(ctx context.Context, entityID uuid.UUID) ([]EntitySigningKey, error)
 	ListEventImagesByEvent(ctx context.Context, eventID *uuid.UUID) ([]EventImage, error)
 	ListEventImagesBySession(ctx context.Context, uploadSessionID uuid.UUID) ([]EventImage, error)
+	ListEventRevisions(ctx context.Context, revisesEventID *uuid.UUID) ([]Event, error)
+	ListEventRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Event, error)
+	ListEventsByBlockchainStatus(ctx context.Context, arg ListEventsByBlockchainStatusParams) ([]Event, error)
 	ListEventsByEntity(ctx context.Context, entityID *uuid.UUID) ([]Event, error)
 	ListEventsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]Event, error)
 	ListEventsByVehicleWithEntity(ctx context.Context, vehicleID uuid.UUID) ([]ListEventsByVehicleWithEntityRow, error)
+	// Without an entity every request is listed, as admins review them all
+	ListLifecycleRequests(ctx context.Context, arg ListLifecycleRequestsParams) ([]VehicleLifecycleRequest, error)
+	ListLifecycleRequestsByAssetStatus(ctx context.Context, arg ListLifecycleRequestsByAssetStatusParams) ([]VehicleLifecycleRequest, error)
+	ListLifecycleRequestsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]VehicleLifecycleRequest, error)
 	ListOrphanedEventImages(ctx context.Context, createdAt pgtype.Timestamp) ([]EventImage, error)
+	ListOwnershipTransfersByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]VehicleOwnershipTransfer, error)
 	ListPhotosByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]VehiclePhoto, error)
 	ListShareLinksByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]VehicleShareLink, error)
+	ListStolenVehicleReports(ctx context.Context, status *string) ([]StolenVehicleReport, error)
+	ListStolenVehicleReportsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]StolenVehicleReport, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	// Entities that issued events on the vehicle, to be alerted when it is reported stolen
+	ListVehicleEventEntities(ctx context.Context, vehicleID uuid.UUID) ([]uuid.UUID, error)
+	ListVehicleOwners(ctx context.Context, vehicleID uuid.UUID) ([]VehicleOwner, error)
+	ListVehicleVersions(ctx context.Context, vehicleID uuid.UUID) ([]VehicleVersion, error)
+	ListVehicleVersionsByBlockchainStatus(ctx context.Context, arg ListVehicleVersionsByBlockchainStatusParams) ([]VehicleVersion, error)
 	ListVehicles(ctx context.Context, arg ListVehiclesParams) ([]Vehicle, error)
+	ListVehiclesByBlockchainStatus(ctx context.Context, arg ListVehiclesByBlockchainStatusParams) ([]Vehicle, error)
 	ListVehiclesByOwner(ctx context.Context, arg ListVehiclesByOwnerParams) ([]Vehicle, error)
 	ListVehiclesByOwnerWithStats(ctx context.Context, arg ListVehiclesByOwnerWithStatsParams) ([]ListVehiclesByOwnerWithStatsRow, error)
 	ListVehiclesWithStats(ctx context.Context, arg ListVehiclesWithStatsParams) ([]ListVehiclesWithStatsRow, error)
+	// Locks the vehicle until the end of the transaction so records are appended to its chain one at a time
+	LockVehicleChainHead(ctx context.Context, id uuid.UUID) (LockVehicleChainHeadRow, error)
+	MarkOutboxMessageFailed(ctx context.Context, arg MarkOutboxMessageFailedParams) error
+	MarkOutboxMessagePublished(ctx context.Context, id int64) error
+	MarkVehicleCustodyPending(ctx context.Context, vehicleID uuid.UUID) (VehicleCustody, error)
 	RemoveUserFromEntity(ctx context.Context, arg RemoveUserFromEntityParams) error
+	ResolveStolenVehicleReport(ctx context.Context, arg ResolveStolenVehicleReportParams) (StolenVehicleReport, error)
+	RetireEntityEventType(ctx context.Context, arg RetireEntityEventTypeParams) (EntityEventType, error)
+	RevokeCertification(ctx context.Context, eventID uuid.UUID) error
+	RevokeEntitySigningKey(ctx context.Context, arg RevokeEntitySigningKeyParams) (EntitySigningKey, error)
 	RevokeShareLink(ctx context.Context, id uuid.UUID) (VehicleShareLink, error)
+	SetCertificationRequestEvent(ctx context.Context, arg SetCertificationRequestEventParams) error
+	SetEventSignature(ctx context.Context, arg SetEventSignatureParams) error
+	SetLifecycleRequestAssetStatus(ctx context.Context, arg SetLifecycleRequestAssetStatusParams) error
+	SetLifecycleRequestEvent(ctx context.Context, arg SetLifecycleRequestEventParams) error
+	SetOwnershipTransferEvent(ctx context.Context, arg SetOwnershipTransferEventParams) error
+	SetStolenVehicleReportLifecycleRequest(ctx context.Context, arg SetStolenVehicleReportLifecycleRequestParams) error
+	SetVehicleChainHead(ctx context.Context, arg SetVehicleChainHeadParams) error
+	SetVehicleCustodyFailed(ctx context.Context, arg SetVehicleCustodyFailedParams) error
+	SetVehicleCustodyHolder(ctx context.Context, arg SetVehicleCustodyHolderParams) (VehicleCustody, error)
+	// Fixes the genesis CID of a vehicle that has none yet, so the first record appended to its chain
+	// can link to it. Genesis later anchors the stored CID.
+	SetVehicleGenesisCID(ctx context.Context, arg SetVehicleGenesisCIDParams) error
+	// Only moves the vehicle out of the status the change was approved from
+	SetVehicleLifecycleStatus(ctx context.Context, arg SetVehicleLifecycleStatusParams) (int64, error)
 	UpdateEntity(ctx context.Context, arg UpdateEntityParams) (Entity, error)
+	UpdateEntityEventType(ctx context.Context, arg UpdateEntityEventTypeParams) (EntityEventType, error)
 	UpdateEntityLogo(ctx context.Context, arg UpdateEntityLogoParams) (Entity, error)
 	UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event, error)
+	// Only events that were never submitted for anchoring can be edited; anchored events are amended.
+	UpdateUnanchoredEventDetails(ctx context.Context, arg UpdateUnanchoredEventDetailsParams) (int64, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateUserEntityRole(ctx context.Context, arg UpdateUserEntityRoleParams) (UserEntity, error)
 	UpdateVehicle(ctx context.Context, arg UpdateVehicleParams) (Vehicle, error)
+	UpdateVehicleVersion(ctx context.Context, arg UpdateVehicleVersionParams) (VehicleVersion, error)
+	UpsertCertification(ctx context.Context, arg UpsertCertificationParams) (Certification, error)
+	UpsertUserWallet(ctx context.Context, arg UpsertUserWalletParams) (UserWallet, error)
+	UseWalletChallenge(ctx context.Context, id uuid.UUID) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
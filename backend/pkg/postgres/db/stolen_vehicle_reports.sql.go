@@ -0,0 +1,350 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: stolen_vehicle_reports.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createStolenVehicleReport = `-- name: CreateStolenVehicleReport :one
+INSERT INTO stolen_vehicle_reports (vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by, status, decided_by, decline_reason, lifecycle_request_id, resolved_by, resolution_note, created_at, decided_at, resolved_at
+`
+
+type CreateStolenVehicleReportParams struct {
+	VehicleID       uuid.UUID
+	Kind            string
+	PoliceReference *string
+	PoliceAuthority *string
+	IncidentDate    pgtype.Date
+	Location        *string
+	Description     string
+	ReportedBy      uuid.UUID
+}
+
+func (q *Queries) CreateStolenVehicleReport(ctx context.Context, arg CreateStolenVehicleReportParams) (StolenVehicleReport, error) {
+	row := q.db.QueryRow(ctx, createStolenVehicleReport,
+		arg.VehicleID,
+		arg.Kind,
+		arg.PoliceReference,
+		arg.PoliceAuthority,
+		arg.IncidentDate,
+		arg.Location,
+		arg.Description,
+		arg.ReportedBy,
+	)
+	var i StolenVehicleReport
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Kind,
+		&i.PoliceReference,
+		&i.PoliceAuthority,
+		&i.IncidentDate,
+		&i.Location,
+		&i.Description,
+		&i.ReportedBy,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.LifecycleRequestID,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.DecidedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const decideStolenVehicleReport = `-- name: DecideStolenVehicleReport :one
+UPDATE stolen_vehicle_reports
+SET status = $2,
+    decided_by = $3,
+    decline_reason = $4,
+    decided_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING id, vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by, status, decided_by, decline_reason, lifecycle_request_id, resolved_by, resolution_note, created_at, decided_at, resolved_at
+`
+
+type DecideStolenVehicleReportParams struct {
+	ID            uuid.UUID
+	Status        string
+	DecidedBy     *uuid.UUID
+	DeclineReason *string
+}
+
+func (q *Queries) DecideStolenVehicleReport(ctx context.Context, arg DecideStolenVehicleReportParams) (StolenVehicleReport, error) {
+	row := q.db.QueryRow(ctx, decideStolenVehicleReport,
+		arg.ID,
+		arg.Status,
+		arg.DecidedBy,
+		arg.DeclineReason,
+	)
+	var i StolenVehicleReport
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Kind,
+		&i.PoliceReference,
+		&i.PoliceAuthority,
+		&i.IncidentDate,
+		&i.Location,
+		&i.Description,
+		&i.ReportedBy,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.LifecycleRequestID,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.DecidedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const getApprovedStolenVehicleReport = `-- name: GetApprovedStolenVehicleReport :one
+SELECT id, vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by, status, decided_by, decline_reason, lifecycle_request_id, resolved_by, resolution_note, created_at, decided_at, resolved_at FROM stolen_vehicle_reports
+WHERE vehicle_id = $1 AND status = 'approved'
+LIMIT 1
+`
+
+func (q *Queries) GetApprovedStolenVehicleReport(ctx context.Context, vehicleID uuid.UUID) (StolenVehicleReport, error) {
+	row := q.db.QueryRow(ctx, getApprovedStolenVehicleReport, vehicleID)
+	var i StolenVehicleReport
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Kind,
+		&i.PoliceReference,
+		&i.PoliceAuthority,
+		&i.IncidentDate,
+		&i.Location,
+		&i.Description,
+		&i.ReportedBy,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.LifecycleRequestID,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.DecidedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const getStolenVehicleReport = `-- name: GetStolenVehicleReport :one
+SELECT id, vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by, status, decided_by, decline_reason, lifecycle_request_id, resolved_by, resolution_note, created_at, decided_at, resolved_at FROM stolen_vehicle_reports
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetStolenVehicleReport(ctx context.Context, id uuid.UUID) (StolenVehicleReport, error) {
+	row := q.db.QueryRow(ctx, getStolenVehicleReport, id)
+	var i StolenVehicleReport
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Kind,
+		&i.PoliceReference,
+		&i.PoliceAuthority,
+		&i.IncidentDate,
+		&i.Location,
+		&i.Description,
+		&i.ReportedBy,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.LifecycleRequestID,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.DecidedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const listStolenVehicleReports = `-- name: ListStolenVehicleReports :many
+SELECT id, vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by, status, decided_by, decline_reason, lifecycle_request_id, resolved_by, resolution_note, created_at, decided_at, resolved_at FROM stolen_vehicle_reports
+WHERE ($1::text IS NULL OR status = $1)
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListStolenVehicleReports(ctx context.Context, status *string) ([]StolenVehicleReport, error) {
+	rows, err := q.db.Query(ctx, listStolenVehicleReports, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StolenVehicleReport{}
+	for rows.Next() {
+		var i StolenVehicleReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.Kind,
+			&i.PoliceReference,
+			&i.PoliceAuthority,
+			&i.IncidentDate,
+			&i.Location,
+			&i.Description,
+			&i.ReportedBy,
+			&i.Status,
+			&i.DecidedBy,
+			&i.DeclineReason,
+			&i.LifecycleRequestID,
+			&i.ResolvedBy,
+			&i.ResolutionNote,
+			&i.CreatedAt,
+			&i.DecidedAt,
+			&i.ResolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStolenVehicleReportsByVehicle = `-- name: ListStolenVehicleReportsByVehicle :many
+SELECT id, vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by, status, decided_by, decline_reason, lifecycle_request_id, resolved_by, resolution_note, created_at, decided_at, resolved_at FROM stolen_vehicle_reports
+WHERE vehicle_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListStolenVehicleReportsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]StolenVehicleReport, error) {
+	rows, err := q.db.Query(ctx, listStolenVehicleReportsByVehicle, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []StolenVehicleReport{}
+	for rows.Next() {
+		var i StolenVehicleReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.Kind,
+			&i.PoliceReference,
+			&i.PoliceAuthority,
+			&i.IncidentDate,
+			&i.Location,
+			&i.Description,
+			&i.ReportedBy,
+			&i.Status,
+			&i.DecidedBy,
+			&i.DeclineReason,
+			&i.LifecycleRequestID,
+			&i.ResolvedBy,
+			&i.ResolutionNote,
+			&i.CreatedAt,
+			&i.DecidedAt,
+			&i.ResolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVehicleEventEntities = `-- name: ListVehicleEventEntities :many
+SELECT DISTINCT entity_id::uuid FROM events
+WHERE vehicle_id = $1 AND entity_id IS NOT NULL
+`
+
+// Entities that issued events on the vehicle, to be alerted when it is reported stolen
+func (q *Queries) ListVehicleEventEntities(ctx context.Context, vehicleID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listVehicleEventEntities, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var entity_id uuid.UUID
+		if err := rows.Scan(&entity_id); err != nil {
+			return nil, err
+		}
+		items = append(items, entity_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveStolenVehicleReport = `-- name: ResolveStolenVehicleReport :one
+UPDATE stolen_vehicle_reports
+SET status = 'resolved',
+    resolved_by = $2,
+    resolution_note = $3,
+    resolved_at = NOW()
+WHERE id = $1 AND status = 'approved'
+RETURNING id, vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by, status, decided_by, decline_reason, lifecycle_request_id, resolved_by, resolution_note, created_at, decided_at, resolved_at
+`
+
+type ResolveStolenVehicleReportParams struct {
+	ID             uuid.UUID
+	ResolvedBy     *uuid.UUID
+	ResolutionNote *string
+}
+
+func (q *Queries) ResolveStolenVehicleReport(ctx context.Context, arg ResolveStolenVehicleReportParams) (StolenVehicleReport, error) {
+	row := q.db.QueryRow(ctx, resolveStolenVehicleReport, arg.ID, arg.ResolvedBy, arg.ResolutionNote)
+	var i StolenVehicleReport
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Kind,
+		&i.PoliceReference,
+		&i.PoliceAuthority,
+		&i.IncidentDate,
+		&i.Location,
+		&i.Description,
+		&i.ReportedBy,
+		&i.Status,
+		&i.DecidedBy,
+		&i.DeclineReason,
+		&i.LifecycleRequestID,
+		&i.ResolvedBy,
+		&i.ResolutionNote,
+		&i.CreatedAt,
+		&i.DecidedAt,
+		&i.ResolvedAt,
+	)
+	return i, err
+}
+
+const setStolenVehicleReportLifecycleRequest = `-- name: SetStolenVehicleReportLifecycleRequest :exec
+UPDATE stolen_vehicle_reports
+SET lifecycle_request_id = $2
+WHERE id = $1
+`
+
+type SetStolenVehicleReportLifecycleRequestParams struct {
+	ID                 uuid.UUID
+	LifecycleRequestID *uuid.UUID
+}
+
+func (q *Queries) SetStolenVehicleReportLifecycleRequest(ctx context.Context, arg SetStolenVehicleReportLifecycleRequestParams) error {
+	_, err := q.db.Exec(ctx, setStolenVehicleReportLifecycleRequest, arg.ID, arg.LifecycleRequestID)
+	return err
+}
@@ -0,0 +1,97 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: vehicle_owners.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createVehicleOwner = `-- name: CreateVehicleOwner :one
+INSERT INTO vehicle_owners (vehicle_id, owner_id, start_date, source)
+VALUES ($1, $2, $3, $4)
+RETURNING id, vehicle_id, owner_id, start_date, end_date, created_at, source
+`
+
+type CreateVehicleOwnerParams struct {
+	VehicleID uuid.UUID
+	OwnerID   *uuid.UUID
+	StartDate time.Time
+	Source    string
+}
+
+func (q *Queries) CreateVehicleOwner(ctx context.Context, arg CreateVehicleOwnerParams) (VehicleOwner, error) {
+	row := q.db.QueryRow(ctx, createVehicleOwner,
+		arg.VehicleID,
+		arg.OwnerID,
+		arg.StartDate,
+		arg.Source,
+	)
+	var i VehicleOwner
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.OwnerID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.Source,
+	)
+	return i, err
+}
+
+const endVehicleOwnership = `-- name: EndVehicleOwnership :exec
+UPDATE vehicle_owners
+SET end_date = $2
+WHERE vehicle_id = $1 AND end_date IS NULL
+`
+
+type EndVehicleOwnershipParams struct {
+	VehicleID uuid.UUID
+	EndDate   pgtype.Timestamptz
+}
+
+func (q *Queries) EndVehicleOwnership(ctx context.Context, arg EndVehicleOwnershipParams) error {
+	_, err := q.db.Exec(ctx, endVehicleOwnership, arg.VehicleID, arg.EndDate)
+	return err
+}
+
+const listVehicleOwners = `-- name: ListVehicleOwners :many
+SELECT id, vehicle_id, owner_id, start_date, end_date, created_at, source FROM vehicle_owners
+WHERE vehicle_id = $1
+ORDER BY start_date ASC, created_at ASC
+`
+
+func (q *Queries) ListVehicleOwners(ctx context.Context, vehicleID uuid.UUID) ([]VehicleOwner, error) {
+	rows, err := q.db.Query(ctx, listVehicleOwners, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VehicleOwner{}
+	for rows.Next() {
+		var i VehicleOwner
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.OwnerID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.CreatedAt,
+			&i.Source,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
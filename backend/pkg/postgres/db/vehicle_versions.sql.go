@@ -0,0 +1,238 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: vehicle_versions.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countVehicleVersionsByBlockchainStatus = `-- name: CountVehicleVersionsByBlockchainStatus :one
+SELECT COUNT(*) FROM vehicle_versions
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => $2::float8)
+`
+
+type CountVehicleVersionsByBlockchainStatusParams struct {
+	BlockchainStatus string
+	OlderThanSeconds float64
+}
+
+func (q *Queries) CountVehicleVersionsByBlockchainStatus(ctx context.Context, arg CountVehicleVersionsByBlockchainStatusParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countVehicleVersionsByBlockchainStatus, arg.BlockchainStatus, arg.OlderThanSeconds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createVehicleVersion = `-- name: CreateVehicleVersion :one
+INSERT INTO vehicle_versions (vehicle_id, version, cid, previous_cid, cid_source_json, cid_source_cbor_b64, blockchain_status)
+SELECT $1, COALESCE(MAX(version), 1) + 1, $2, $3,
+       $4, $5, $6
+FROM vehicle_versions
+WHERE vehicle_id = $1
+RETURNING id, vehicle_id, version, cid, previous_cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, blockchain_status, blockchain_error, blockchain_status_at, created_at
+`
+
+type CreateVehicleVersionParams struct {
+	VehicleID        uuid.UUID
+	Cid              string
+	PreviousCid      string
+	CidSourceJson    string
+	CidSourceCborB64 string
+	BlockchainStatus string
+}
+
+// Version 1 is the genesis record held on the vehicle itself, so revisions start at 2
+func (q *Queries) CreateVehicleVersion(ctx context.Context, arg CreateVehicleVersionParams) (VehicleVersion, error) {
+	row := q.db.QueryRow(ctx, createVehicleVersion,
+		arg.VehicleID,
+		arg.Cid,
+		arg.PreviousCid,
+		arg.CidSourceJson,
+		arg.CidSourceCborB64,
+		arg.BlockchainStatus,
+	)
+	var i VehicleVersion
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Version,
+		&i.Cid,
+		&i.PreviousCid,
+		&i.CidSourceJson,
+		&i.CidSourceCborB64,
+		&i.BlockchainTxID,
+		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getVehicleVersion = `-- name: GetVehicleVersion :one
+SELECT id, vehicle_id, version, cid, previous_cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, blockchain_status, blockchain_error, blockchain_status_at, created_at FROM vehicle_versions
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetVehicleVersion(ctx context.Context, id uuid.UUID) (VehicleVersion, error) {
+	row := q.db.QueryRow(ctx, getVehicleVersion, id)
+	var i VehicleVersion
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Version,
+		&i.Cid,
+		&i.PreviousCid,
+		&i.CidSourceJson,
+		&i.CidSourceCborB64,
+		&i.BlockchainTxID,
+		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listVehicleVersions = `-- name: ListVehicleVersions :many
+SELECT id, vehicle_id, version, cid, previous_cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, blockchain_status, blockchain_error, blockchain_status_at, created_at FROM vehicle_versions
+WHERE vehicle_id = $1
+ORDER BY version ASC
+`
+
+func (q *Queries) ListVehicleVersions(ctx context.Context, vehicleID uuid.UUID) ([]VehicleVersion, error) {
+	rows, err := q.db.Query(ctx, listVehicleVersions, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VehicleVersion{}
+	for rows.Next() {
+		var i VehicleVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.Version,
+			&i.Cid,
+			&i.PreviousCid,
+			&i.CidSourceJson,
+			&i.CidSourceCborB64,
+			&i.BlockchainTxID,
+			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVehicleVersionsByBlockchainStatus = `-- name: ListVehicleVersionsByBlockchainStatus :many
+SELECT id, vehicle_id, version, cid, previous_cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, blockchain_status, blockchain_error, blockchain_status_at, created_at FROM vehicle_versions
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => $4::float8)
+ORDER BY blockchain_status_at ASC
+LIMIT $2 OFFSET $3
+`
+
+type ListVehicleVersionsByBlockchainStatusParams struct {
+	BlockchainStatus string
+	Limit            int32
+	Offset           int32
+	OlderThanSeconds float64
+}
+
+func (q *Queries) ListVehicleVersionsByBlockchainStatus(ctx context.Context, arg ListVehicleVersionsByBlockchainStatusParams) ([]VehicleVersion, error) {
+	rows, err := q.db.Query(ctx, listVehicleVersionsByBlockchainStatus,
+		arg.BlockchainStatus,
+		arg.Limit,
+		arg.Offset,
+		arg.OlderThanSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []VehicleVersion{}
+	for rows.Next() {
+		var i VehicleVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.VehicleID,
+			&i.Version,
+			&i.Cid,
+			&i.PreviousCid,
+			&i.CidSourceJson,
+			&i.CidSourceCborB64,
+			&i.BlockchainTxID,
+			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateVehicleVersion = `-- name: UpdateVehicleVersion :one
+UPDATE vehicle_versions
+SET blockchain_tx_id = $2,
+    blockchain_status = $3,
+    blockchain_error = $4,
+    blockchain_status_at = CASE WHEN blockchain_status = $3 THEN GREATEST(blockchain_status_at, $5) ELSE NOW() END
+WHERE id = $1
+RETURNING id, vehicle_id, version, cid, previous_cid, cid_source_json, cid_source_cbor_b64, blockchain_tx_id, blockchain_status, blockchain_error, blockchain_status_at, created_at
+`
+
+type UpdateVehicleVersionParams struct {
+	ID                 uuid.UUID
+	BlockchainTxID     *string
+	BlockchainStatus   string
+	BlockchainError    *string
+	BlockchainStatusAt time.Time
+}
+
+func (q *Queries) UpdateVehicleVersion(ctx context.Context, arg UpdateVehicleVersionParams) (VehicleVersion, error) {
+	row := q.db.QueryRow(ctx, updateVehicleVersion,
+		arg.ID,
+		arg.BlockchainTxID,
+		arg.BlockchainStatus,
+		arg.BlockchainError,
+		arg.BlockchainStatusAt,
+	)
+	var i VehicleVersion
+	err := row.Scan(
+		&i.ID,
+		&i.VehicleID,
+		&i.Version,
+		&i.Cid,
+		&i.PreviousCid,
+		&i.CidSourceJson,
+		&i.CidSourceCborB64,
+		&i.BlockchainTxID,
+		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
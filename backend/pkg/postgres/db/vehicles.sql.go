@@ -7,11 +7,49 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimVehicleGenesis = `-- name: ClaimVehicleGenesis :execrows
+UPDATE vehicles
+SET genesis_tx_id = $1,
+    genesis_last_valid_round = $2,
+    cid = $3, cid_source_json = $4, cid_source_cbor_b64 = $5,
+    chain_head_cid = COALESCE(chain_head_cid, $3)
+WHERE id = $6
+  AND blockchain_asset_id = ''
+  AND genesis_tx_id IS NOT DISTINCT FROM $7
+`
+
+type ClaimVehicleGenesisParams struct {
+	GenesisTxID           *string
+	GenesisLastValidRound *int64
+	Cid                   *string
+	CidSourceJson         *string
+	CidSourceCborB64      *string
+	ID                    uuid.UUID
+	PreviousGenesisTxID   *string
+}
+
+func (q *Queries) ClaimVehicleGenesis(ctx context.Context, arg ClaimVehicleGenesisParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimVehicleGenesis,
+		arg.GenesisTxID,
+		arg.GenesisLastValidRound,
+		arg.Cid,
+		arg.CidSourceJson,
+		arg.CidSourceCborB64,
+		arg.ID,
+		arg.PreviousGenesisTxID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const countVehicles = `-- name: CountVehicles :one
 SELECT COUNT(*) FROM vehicles
 `
@@ -23,6 +61,24 @@ func (q *Queries) CountVehicles(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const countVehiclesByBlockchainStatus = `-- name: CountVehiclesByBlockchainStatus :one
+SELECT COUNT(*) FROM vehicles
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => $2::float8)
+`
+
+type CountVehiclesByBlockchainStatusParams struct {
+	BlockchainStatus string
+	OlderThanSeconds float64
+}
+
+func (q *Queries) CountVehiclesByBlockchainStatus(ctx context.Context, arg CountVehiclesByBlockchainStatusParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countVehiclesByBlockchainStatus, arg.BlockchainStatus, arg.OlderThanSeconds)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countVehiclesByOwner = `-- name: CountVehiclesByOwner :one
 SELECT COUNT(*) FROM vehicles
 WHERE owner_id = $1
@@ -73,7 +129,7 @@ $15,
 $16,
 $17
 )
-RETURNING id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status
+RETURNING id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at
 `
 
 type CreateVehicleParams struct {
@@ -143,6 +199,13 @@ func (q *Queries) CreateVehicle(ctx context.Context, arg CreateVehicleParams) (V
 		&i.EngineCylinders,
 		&i.EnginePowerHp,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.GenesisTxID,
+		&i.GenesisLastValidRound,
+		&i.ChainHeadCid,
+		&i.LifecycleStatus,
+		&i.LifecycleStatusAt,
 	)
 	return i, err
 }
@@ -158,7 +221,7 @@ func (q *Queries) DeleteVehicle(ctx context.Context, id uuid.UUID) error {
 }
 
 const getVehicle = `-- name: GetVehicle :one
-SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status FROM vehicles
+SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at FROM vehicles
 WHERE id = $1 LIMIT 1
 `
 
@@ -191,12 +254,19 @@ func (q *Queries) GetVehicle(ctx context.Context, id uuid.UUID) (Vehicle, error)
 		&i.EngineCylinders,
 		&i.EnginePowerHp,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.GenesisTxID,
+		&i.GenesisLastValidRound,
+		&i.ChainHeadCid,
+		&i.LifecycleStatus,
+		&i.LifecycleStatusAt,
 	)
 	return i, err
 }
 
 const getVehicleByChassisNumber = `-- name: GetVehicleByChassisNumber :one
-SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status FROM vehicles
+SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at FROM vehicles
 WHERE chassis_number = $1 LIMIT 1
 `
 
@@ -229,12 +299,19 @@ func (q *Queries) GetVehicleByChassisNumber(ctx context.Context, chassisNumber s
 		&i.EngineCylinders,
 		&i.EnginePowerHp,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.GenesisTxID,
+		&i.GenesisLastValidRound,
+		&i.ChainHeadCid,
+		&i.LifecycleStatus,
+		&i.LifecycleStatusAt,
 	)
 	return i, err
 }
 
 const getVehicleByLicensePlate = `-- name: GetVehicleByLicensePlate :one
-SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status FROM vehicles
+SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at FROM vehicles
 WHERE license_plate = $1 LIMIT 1
 `
 
@@ -267,12 +344,19 @@ func (q *Queries) GetVehicleByLicensePlate(ctx context.Context, licensePlate str
 		&i.EngineCylinders,
 		&i.EnginePowerHp,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.GenesisTxID,
+		&i.GenesisLastValidRound,
+		&i.ChainHeadCid,
+		&i.LifecycleStatus,
+		&i.LifecycleStatusAt,
 	)
 	return i, err
 }
 
 const listVehicles = `-- name: ListVehicles :many
-SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status FROM vehicles
+SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at FROM vehicles
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -317,6 +401,86 @@ func (q *Queries) ListVehicles(ctx context.Context, arg ListVehiclesParams) ([]V
 			&i.EngineCylinders,
 			&i.EnginePowerHp,
 			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.GenesisTxID,
+			&i.GenesisLastValidRound,
+			&i.ChainHeadCid,
+			&i.LifecycleStatus,
+			&i.LifecycleStatusAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVehiclesByBlockchainStatus = `-- name: ListVehiclesByBlockchainStatus :many
+SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at FROM vehicles
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => $4::float8)
+ORDER BY blockchain_status_at ASC
+LIMIT $2 OFFSET $3
+`
+
+type ListVehiclesByBlockchainStatusParams struct {
+	BlockchainStatus string
+	Limit            int32
+	Offset           int32
+	OlderThanSeconds float64
+}
+
+func (q *Queries) ListVehiclesByBlockchainStatus(ctx context.Context, arg ListVehiclesByBlockchainStatusParams) ([]Vehicle, error) {
+	rows, err := q.db.Query(ctx, listVehiclesByBlockchainStatus,
+		arg.BlockchainStatus,
+		arg.Limit,
+		arg.Offset,
+		arg.OlderThanSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Vehicle{}
+	for rows.Next() {
+		var i Vehicle
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.ChassisNumber,
+			&i.LicensePlate,
+			&i.EngineNumber,
+			&i.TransmissionNumber,
+			&i.Make,
+			&i.Model,
+			&i.Year,
+			&i.Color,
+			&i.BodyType,
+			&i.DriveType,
+			&i.GearType,
+			&i.SuspensionType,
+			&i.Cid,
+			&i.CidSourceJson,
+			&i.CidSourceCborB64,
+			&i.BlockchainAssetID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Fuel,
+			&i.EngineCc,
+			&i.EngineCylinders,
+			&i.EnginePowerHp,
+			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.GenesisTxID,
+			&i.GenesisLastValidRound,
+			&i.ChainHeadCid,
+			&i.LifecycleStatus,
+			&i.LifecycleStatusAt,
 		); err != nil {
 			return nil, err
 		}
@@ -329,7 +493,7 @@ func (q *Queries) ListVehicles(ctx context.Context, arg ListVehiclesParams) ([]V
 }
 
 const listVehiclesByOwner = `-- name: ListVehiclesByOwner :many
-SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status FROM vehicles
+SELECT id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at FROM vehicles
 WHERE owner_id = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -376,6 +540,13 @@ func (q *Queries) ListVehiclesByOwner(ctx context.Context, arg ListVehiclesByOwn
 			&i.EngineCylinders,
 			&i.EnginePowerHp,
 			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.GenesisTxID,
+			&i.GenesisLastValidRound,
+			&i.ChainHeadCid,
+			&i.LifecycleStatus,
+			&i.LifecycleStatusAt,
 		); err != nil {
 			return nil, err
 		}
@@ -389,26 +560,28 @@ func (q *Queries) ListVehiclesByOwner(ctx context.Context, arg ListVehiclesByOwn
 
 const listVehiclesByOwnerWithStats = `-- name: ListVehiclesByOwnerWithStats :many
 SELECT
-    v.id, v.owner_id, v.chassis_number, v.license_plate, v.engine_number, v.transmission_number, v.make, v.model, v.year, v.color, v.body_type, v.drive_type, v.gear_type, v.suspension_type, v.cid, v.cid_source_json, v.cid_source_cbor_b64, v.blockchain_asset_id, v.created_at, v.updated_at, v.fuel, v.engine_cc, v.engine_cylinders, v.engine_power_hp, v.blockchain_status,
+    v.id, v.owner_id, v.chassis_number, v.license_plate, v.engine_number, v.transmission_number, v.make, v.model, v.year, v.color, v.body_type, v.drive_type, v.gear_type, v.suspension_type, v.cid, v.cid_source_json, v.cid_source_cbor_b64, v.blockchain_asset_id, v.created_at, v.updated_at, v.fuel, v.engine_cc, v.engine_cylinders, v.engine_power_hp, v.blockchain_status, v.blockchain_error, v.blockchain_status_at, v.genesis_tx_id, v.genesis_last_valid_round, v.chain_head_cid, v.lifecycle_status, v.lifecycle_status_at,
     COALESCE(stats.certified_events_count, 0)::bigint AS certified_events_count,
     COALESCE(stats.owner_events_count, 0)::bigint AS owner_events_count,
-    COALESCE(stats.active_certifications_count, 0)::bigint AS active_certifications_count
+    COALESCE(certs.active_certifications_count, 0)::bigint AS active_certifications_count
 FROM vehicles v
 LEFT JOIN (
     SELECT
         e.vehicle_id,
         COUNT(*) FILTER (WHERE e.entity_id IS NOT NULL) AS certified_events_count,
-        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count,
-        COUNT(*) FILTER (
-            WHERE e.event_type = 'certification'
-            AND (
-                e.metadata->>'validityEndDate' IS NULL
-                OR (e.metadata->>'validityEndDate')::date >= CURRENT_DATE
-            )
-        ) AS active_certifications_count
+        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count
     FROM events e
+    WHERE e.kind = 'original'
+      AND e.approval_status IN ('not_required', 'accepted')
     GROUP BY e.vehicle_id
 ) stats ON v.id = stats.vehicle_id
+LEFT JOIN (
+    SELECT c.vehicle_id, COUNT(*) AS active_certifications_count
+    FROM certifications c
+    WHERE c.status = 'active'
+      AND (c.valid_until IS NULL OR c.valid_until >= CURRENT_DATE)
+    GROUP BY c.vehicle_id
+) certs ON v.id = certs.vehicle_id
 WHERE v.owner_id = $1
 ORDER BY v.created_at DESC
 LIMIT $2 OFFSET $3
@@ -446,6 +619,13 @@ type ListVehiclesByOwnerWithStatsRow struct {
 	EngineCylinders           *int32
 	EnginePowerHp             *int32
 	BlockchainStatus          string
+	BlockchainError           *string
+	BlockchainStatusAt        time.Time
+	GenesisTxID               *string
+	GenesisLastValidRound     *int64
+	ChainHeadCid              *string
+	LifecycleStatus           string
+	LifecycleStatusAt         time.Time
 	CertifiedEventsCount      int64
 	OwnerEventsCount          int64
 	ActiveCertificationsCount int64
@@ -486,6 +666,13 @@ func (q *Queries) ListVehiclesByOwnerWithStats(ctx context.Context, arg ListVehi
 			&i.EngineCylinders,
 			&i.EnginePowerHp,
 			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.GenesisTxID,
+			&i.GenesisLastValidRound,
+			&i.ChainHeadCid,
+			&i.LifecycleStatus,
+			&i.LifecycleStatusAt,
 			&i.CertifiedEventsCount,
 			&i.OwnerEventsCount,
 			&i.ActiveCertificationsCount,
@@ -502,26 +689,28 @@ func (q *Queries) ListVehiclesByOwnerWithStats(ctx context.Context, arg ListVehi
 
 const listVehiclesWithStats = `-- name: ListVehiclesWithStats :many
 SELECT
-    v.id, v.owner_id, v.chassis_number, v.license_plate, v.engine_number, v.transmission_number, v.make, v.model, v.year, v.color, v.body_type, v.drive_type, v.gear_type, v.suspension_type, v.cid, v.cid_source_json, v.cid_source_cbor_b64, v.blockchain_asset_id, v.created_at, v.updated_at, v.fuel, v.engine_cc, v.engine_cylinders, v.engine_power_hp, v.blockchain_status,
+    v.id, v.owner_id, v.chassis_number, v.license_plate, v.engine_number, v.transmission_number, v.make, v.model, v.year, v.color, v.body_type, v.drive_type, v.gear_type, v.suspension_type, v.cid, v.cid_source_json, v.cid_source_cbor_b64, v.blockchain_asset_id, v.created_at, v.updated_at, v.fuel, v.engine_cc, v.engine_cylinders, v.engine_power_hp, v.blockchain_status, v.blockchain_error, v.blockchain_status_at, v.genesis_tx_id, v.genesis_last_valid_round, v.chain_head_cid, v.lifecycle_status, v.lifecycle_status_at,
     COALESCE(stats.certified_events_count, 0)::bigint AS certified_events_count,
     COALESCE(stats.owner_events_count, 0)::bigint AS owner_events_count,
-    COALESCE(stats.active_certifications_count, 0)::bigint AS active_certifications_count
+    COALESCE(certs.active_certifications_count, 0)::bigint AS active_certifications_count
 FROM vehicles v
 LEFT JOIN (
     SELECT
         e.vehicle_id,
         COUNT(*) FILTER (WHERE e.entity_id IS NOT NULL) AS certified_events_count,
-        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count,
-        COUNT(*) FILTER (
-            WHERE e.event_type = 'certification'
-            AND (
-                e.metadata->>'validityEndDate' IS NULL
-                OR (e.metadata->>'validityEndDate')::date >= CURRENT_DATE
-            )
-        ) AS active_certifications_count
+        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count
     FROM events e
+    WHERE e.kind = 'original'
+      AND e.approval_status IN ('not_required', 'accepted')
     GROUP BY e.vehicle_id
 ) stats ON v.id = stats.vehicle_id
+LEFT JOIN (
+    SELECT c.vehicle_id, COUNT(*) AS active_certifications_count
+    FROM certifications c
+    WHERE c.status = 'active'
+      AND (c.valid_until IS NULL OR c.valid_until >= CURRENT_DATE)
+    GROUP BY c.vehicle_id
+) certs ON v.id = certs.vehicle_id
 ORDER BY v.created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -557,6 +746,13 @@ type ListVehiclesWithStatsRow struct {
 	EngineCylinders           *int32
 	EnginePowerHp             *int32
 	BlockchainStatus          string
+	BlockchainError           *string
+	BlockchainStatusAt        time.Time
+	GenesisTxID               *string
+	GenesisLastValidRound     *int64
+	ChainHeadCid              *string
+	LifecycleStatus           string
+	LifecycleStatusAt         time.Time
 	CertifiedEventsCount      int64
 	OwnerEventsCount          int64
 	ActiveCertificationsCount int64
@@ -597,6 +793,13 @@ func (q *Queries) ListVehiclesWithStats(ctx context.Context, arg ListVehiclesWit
 			&i.EngineCylinders,
 			&i.EnginePowerHp,
 			&i.BlockchainStatus,
+			&i.BlockchainError,
+			&i.BlockchainStatusAt,
+			&i.GenesisTxID,
+			&i.GenesisLastValidRound,
+			&i.ChainHeadCid,
+			&i.LifecycleStatus,
+			&i.LifecycleStatusAt,
 			&i.CertifiedEventsCount,
 			&i.OwnerEventsCount,
 			&i.ActiveCertificationsCount,
@@ -611,17 +814,106 @@ func (q *Queries) ListVehiclesWithStats(ctx context.Context, arg ListVehiclesWit
 	return items, nil
 }
 
+const lockVehicleChainHead = `-- name: LockVehicleChainHead :one
+SELECT chain_head_cid, cid FROM vehicles
+WHERE id = $1
+FOR UPDATE
+`
+
+type LockVehicleChainHeadRow struct {
+	ChainHeadCid *string
+	Cid          *string
+}
+
+// Locks the vehicle until the end of the transaction so records are appended to its chain one at a time
+func (q *Queries) LockVehicleChainHead(ctx context.Context, id uuid.UUID) (LockVehicleChainHeadRow, error) {
+	row := q.db.QueryRow(ctx, lockVehicleChainHead, id)
+	var i LockVehicleChainHeadRow
+	err := row.Scan(&i.ChainHeadCid, &i.Cid)
+	return i, err
+}
+
+const setVehicleChainHead = `-- name: SetVehicleChainHead :exec
+UPDATE vehicles
+SET chain_head_cid = $2
+WHERE id = $1
+`
+
+type SetVehicleChainHeadParams struct {
+	ID           uuid.UUID
+	ChainHeadCid *string
+}
+
+func (q *Queries) SetVehicleChainHead(ctx context.Context, arg SetVehicleChainHeadParams) error {
+	_, err := q.db.Exec(ctx, setVehicleChainHead, arg.ID, arg.ChainHeadCid)
+	return err
+}
+
+const setVehicleGenesisCID = `-- name: SetVehicleGenesisCID :exec
+UPDATE vehicles
+SET cid = $1, cid_source_json = $2, cid_source_cbor_b64 = $3,
+    chain_head_cid = COALESCE(chain_head_cid, $1)
+WHERE id = $4
+  AND cid IS NULL
+`
+
+type SetVehicleGenesisCIDParams struct {
+	Cid              *string
+	CidSourceJson    *string
+	CidSourceCborB64 *string
+	ID               uuid.UUID
+}
+
+// Fixes the genesis CID of a vehicle that has none yet, so the first record appended to its chain
+// can link to it. Genesis later anchors the stored CID.
+func (q *Queries) SetVehicleGenesisCID(ctx context.Context, arg SetVehicleGenesisCIDParams) error {
+	_, err := q.db.Exec(ctx, setVehicleGenesisCID,
+		arg.Cid,
+		arg.CidSourceJson,
+		arg.CidSourceCborB64,
+		arg.ID,
+	)
+	return err
+}
+
+const setVehicleLifecycleStatus = `-- name: SetVehicleLifecycleStatus :execrows
+UPDATE vehicles
+SET lifecycle_status = $1,
+    lifecycle_status_at = NOW(),
+    updated_at = NOW()
+WHERE id = $2 AND lifecycle_status = $3
+`
+
+type SetVehicleLifecycleStatusParams struct {
+	ToStatus   string
+	ID         uuid.UUID
+	FromStatus string
+}
+
+// Only moves the vehicle out of the status the change was approved from
+func (q *Queries) SetVehicleLifecycleStatus(ctx context.Context, arg SetVehicleLifecycleStatusParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setVehicleLifecycleStatus, arg.ToStatus, arg.ID, arg.FromStatus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateVehicle = `-- name: UpdateVehicle :one
 UPDATE vehicles
 SET license_plate = $2, chassis_number = $3, make = $4, model = $5,
     year = $6, color = $7, engine_number = $8, transmission_number = $9,
     body_type = $10, drive_type = $11, gear_type = $12, suspension_type = $13,
     fuel = $14, engine_cc = $15, engine_cylinders = $16, engine_power_hp = $17,
-    owner_id = $18, blockchain_asset_id = $19, cid = $20, cid_source_json = $21, cid_source_cbor_b64 = $22,
+    owner_id = $18,
+    -- the asset ID is written once by genesis and never cleared by a stale update
+    blockchain_asset_id = CASE WHEN blockchain_asset_id = '' THEN $19 ELSE blockchain_asset_id END, cid = $20, cid_source_json = $21, cid_source_cbor_b64 = $22,
     blockchain_status = $23,
+    blockchain_error = $24,
+    blockchain_status_at = CASE WHEN blockchain_status = $23 THEN GREATEST(blockchain_status_at, $25) ELSE NOW() END,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status
+RETURNING id, owner_id, chassis_number, license_plate, engine_number, transmission_number, make, model, year, color, body_type, drive_type, gear_type, suspension_type, cid, cid_source_json, cid_source_cbor_b64, blockchain_asset_id, created_at, updated_at, fuel, engine_cc, engine_cylinders, engine_power_hp, blockchain_status, blockchain_error, blockchain_status_at, genesis_tx_id, genesis_last_valid_round, chain_head_cid, lifecycle_status, lifecycle_status_at
 `
 
 type UpdateVehicleParams struct {
@@ -648,6 +940,8 @@ type UpdateVehicleParams struct {
 	CidSourceJson      *string
 	CidSourceCborB64   *string
 	BlockchainStatus   string
+	BlockchainError    *string
+	BlockchainStatusAt time.Time
 }
 
 func (q *Queries) UpdateVehicle(ctx context.Context, arg UpdateVehicleParams) (Vehicle, error) {
@@ -675,6 +969,8 @@ func (q *Queries) UpdateVehicle(ctx context.Context, arg UpdateVehicleParams) (V
 		arg.CidSourceJson,
 		arg.CidSourceCborB64,
 		arg.BlockchainStatus,
+		arg.BlockchainError,
+		arg.BlockchainStatusAt,
 	)
 	var i Vehicle
 	err := row.Scan(
@@ -703,6 +999,13 @@ func (q *Queries) UpdateVehicle(ctx context.Context, arg UpdateVehicleParams) (V
 		&i.EngineCylinders,
 		&i.EnginePowerHp,
 		&i.BlockchainStatus,
+		&i.BlockchainError,
+		&i.BlockchainStatusAt,
+		&i.GenesisTxID,
+		&i.GenesisLastValidRound,
+		&i.ChainHeadCid,
+		&i.LifecycleStatus,
+		&i.LifecycleStatusAt,
 	)
 	return i, err
 }
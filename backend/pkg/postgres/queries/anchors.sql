@@ -0,0 +1,17 @@
+-- name: CreateAnchor :one
+INSERT INTO anchors (
+    vehicle_id, record_type, event_id, vehicle_version_id, status, error, network, sender,
+    tx_id, tx_type, asset_id, confirmed_round, round_time, fee_microalgos
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
+
+-- name: ListAnchorsByVehicle :many
+SELECT * FROM anchors
+WHERE vehicle_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListAnchorsByEvent :many
+SELECT * FROM anchors
+WHERE event_id = $1
+ORDER BY created_at DESC;
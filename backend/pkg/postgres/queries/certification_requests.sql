@@ -0,0 +1,33 @@
+-- name: CreateCertificationRequest :one
+INSERT INTO event_certification_requests (event_id, vehicle_id, entity_id, requested_by, evidence_session_id, message)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetCertificationRequest :one
+SELECT * FROM event_certification_requests
+WHERE id = $1 LIMIT 1;
+
+-- name: ListCertificationRequestsByEvent :many
+SELECT * FROM event_certification_requests
+WHERE event_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListCertificationRequestsByEntity :many
+SELECT * FROM event_certification_requests
+WHERE entity_id = $1
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at ASC;
+
+-- name: DecideCertificationRequest :one
+UPDATE event_certification_requests
+SET status = $2,
+    decided_by = $3,
+    decline_reason = $4,
+    decided_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: SetCertificationRequestEvent :exec
+UPDATE event_certification_requests
+SET certified_event_id = $2
+WHERE id = $1;
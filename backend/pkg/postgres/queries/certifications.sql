@@ -0,0 +1,43 @@
+-- name: UpsertCertification :one
+INSERT INTO certifications (event_id, vehicle_id, entity_id, certificate_number, valid_from, valid_until, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (event_id) DO UPDATE
+SET certificate_number = EXCLUDED.certificate_number,
+    valid_from = EXCLUDED.valid_from,
+    valid_until = EXCLUDED.valid_until,
+    status = CASE WHEN certifications.status = 'revoked' THEN 'revoked' ELSE EXCLUDED.status END,
+    last_reminder_days = CASE
+        WHEN certifications.valid_until IS DISTINCT FROM EXCLUDED.valid_until THEN NULL
+        ELSE certifications.last_reminder_days
+    END,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: RevokeCertification :exec
+UPDATE certifications
+SET status = 'revoked',
+    updated_at = NOW()
+WHERE event_id = $1;
+
+-- name: ListCertificationsByVehicle :many
+SELECT * FROM certifications
+WHERE vehicle_id = $1
+ORDER BY valid_from DESC, created_at DESC;
+
+-- name: ExpireCertifications :many
+UPDATE certifications
+SET status = 'expired',
+    updated_at = NOW()
+WHERE status = 'active'
+  AND valid_until < sqlc.arg(today)::date
+RETURNING *;
+
+-- name: ClaimCertificationReminders :many
+UPDATE certifications
+SET last_reminder_days = sqlc.arg(lead_days)::int,
+    updated_at = NOW()
+WHERE status = 'active'
+  AND valid_until >= sqlc.arg(today)::date
+  AND valid_until <= sqlc.arg(today)::date + sqlc.arg(lead_days)::int
+  AND (last_reminder_days IS NULL OR last_reminder_days > sqlc.arg(lead_days)::int)
+RETURNING *;
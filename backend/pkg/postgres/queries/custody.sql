@@ -0,0 +1,65 @@
+-- name: CreateWalletChallenge :one
+INSERT INTO wallet_challenges (user_id, address, message, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetActiveWalletChallenge :one
+SELECT * FROM wallet_challenges
+WHERE user_id = $1
+  AND address = $2
+  AND used_at IS NULL
+  AND expires_at > NOW()
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: UseWalletChallenge :execrows
+UPDATE wallet_challenges
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL;
+
+-- name: UpsertUserWallet :one
+INSERT INTO user_wallets (user_id, address)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET address = EXCLUDED.address,
+    linked_at = NOW()
+RETURNING *;
+
+-- name: GetUserWallet :one
+SELECT * FROM user_wallets
+WHERE user_id = $1 LIMIT 1;
+
+-- name: DeleteUserWallet :execrows
+DELETE FROM user_wallets
+WHERE user_id = $1;
+
+-- name: GetVehicleCustody :one
+SELECT * FROM vehicle_custody
+WHERE vehicle_id = $1 LIMIT 1;
+
+-- name: MarkVehicleCustodyPending :one
+INSERT INTO vehicle_custody (vehicle_id, status)
+VALUES ($1, 'pending')
+ON CONFLICT (vehicle_id) DO UPDATE
+SET status = 'pending',
+    error = NULL,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: SetVehicleCustodyHolder :one
+INSERT INTO vehicle_custody (vehicle_id, holder_address, status, tx_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (vehicle_id) DO UPDATE
+SET holder_address = EXCLUDED.holder_address,
+    status = EXCLUDED.status,
+    tx_id = COALESCE(EXCLUDED.tx_id, vehicle_custody.tx_id),
+    error = NULL,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: SetVehicleCustodyFailed :exec
+UPDATE vehicle_custody
+SET status = 'failed',
+    error = $2,
+    updated_at = NOW()
+WHERE vehicle_id = $1;
@@ -30,7 +30,8 @@ RETURNING *;
 
 -- name: UpdateEntity :one
 UPDATE entities
-SET name = $2, description = $3, contact_email = $4, website = $5, address = $6, updated_at = NOW()
+SET name = $2, description = $3, contact_email = $4, website = $5, address = $6,
+    owner_approval_event_types = $7, updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
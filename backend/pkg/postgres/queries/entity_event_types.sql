@@ -0,0 +1,35 @@
+-- name: CreateEntityEventType :one
+INSERT INTO entity_event_types (entity_id, key, display_name, icon, metadata_schema, definition_cid)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetEntityEventType :one
+SELECT * FROM entity_event_types
+WHERE entity_id = $1 AND key = $2 LIMIT 1;
+
+-- name: ListEntityEventTypesByEntity :many
+SELECT * FROM entity_event_types
+WHERE entity_id = $1
+ORDER BY key ASC;
+
+-- name: ListActiveEntityEventTypes :many
+SELECT entity_event_types.*, entities.name AS entity_name
+FROM entity_event_types
+JOIN entities ON entities.id = entity_event_types.entity_id
+WHERE entity_event_types.retired_at IS NULL
+ORDER BY entities.name ASC, entity_event_types.key ASC;
+
+-- name: UpdateEntityEventType :one
+UPDATE entity_event_types
+SET display_name = $3,
+    icon = $4,
+    updated_at = NOW()
+WHERE entity_id = $1 AND key = $2
+RETURNING *;
+
+-- name: RetireEntityEventType :one
+UPDATE entity_event_types
+SET retired_at = NOW(),
+    updated_at = NOW()
+WHERE entity_id = $1 AND key = $2 AND retired_at IS NULL
+RETURNING *;
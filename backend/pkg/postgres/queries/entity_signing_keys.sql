@@ -0,0 +1,31 @@
+-- name: CreateEntitySigningKey :one
+INSERT INTO entity_signing_keys (entity_id, label, public_key, sealed_private_key)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetEntitySigningKey :one
+SELECT * FROM entity_signing_keys
+WHERE id = $1 LIMIT 1;
+
+-- name: ListEntitySigningKeysByEntity :many
+SELECT * FROM entity_signing_keys
+WHERE entity_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetActiveManagedSigningKey :one
+SELECT * FROM entity_signing_keys
+WHERE entity_id = $1
+  AND sealed_private_key IS NOT NULL
+  AND revoked_at IS NULL
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: RevokeEntitySigningKey :one
+UPDATE entity_signing_keys
+SET revoked_at = NOW()
+WHERE entity_id = $1 AND id = $2 AND revoked_at IS NULL
+RETURNING *;
+
+-- name: GetEntitySigningKeyByPublicKey :one
+SELECT * FROM entity_signing_keys
+WHERE public_key = $1 LIMIT 1;
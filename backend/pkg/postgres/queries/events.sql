@@ -5,6 +5,7 @@ WHERE id = $1 LIMIT 1;
 -- name: ListEventsByVehicle :many
 SELECT * FROM events
 WHERE vehicle_id = $1
+  AND kind = 'original'
 ORDER BY event_date DESC;
 
 -- name: ListEventsByVehicleWithEntity :many
@@ -15,11 +16,24 @@ SELECT
 FROM events e
 LEFT JOIN entities ent ON e.entity_id = ent.id
 WHERE e.vehicle_id = $1
+  AND e.kind = 'original'
 ORDER BY e.event_date DESC;
 
+-- name: ListEventRevisionsByVehicle :many
+SELECT * FROM events
+WHERE vehicle_id = $1
+  AND kind <> 'original'
+ORDER BY created_at ASC;
+
+-- name: ListEventRevisions :many
+SELECT * FROM events
+WHERE revises_event_id = $1
+ORDER BY created_at ASC;
+
 -- name: ListEventsByEntity :many
 SELECT * FROM events
 WHERE entity_id = $1
+  AND kind = 'original'
 ORDER BY event_date DESC;
 
 -- name: CreateEvent :one
@@ -31,7 +45,11 @@ INSERT INTO events (
     description,
     event_date,
     location,
-    metadata
+    metadata,
+    kind,
+    revises_event_id,
+    reason,
+    approval_status
 ) VALUES (
     $1,
     $2,
@@ -40,7 +58,11 @@ INSERT INTO events (
     $5,
     $6,
     $7,
-    $8
+    $8,
+    $9,
+    $10,
+    $11,
+    $12
 )
 RETURNING *;
 
@@ -54,11 +76,57 @@ SET title = $2,
     cid = $7,
     cid_source_json = $8,
     cid_source_cbor_b64 = $9,
+    previous_cid = sqlc.narg(previous_cid),
+    merkle_proof = sqlc.narg(merkle_proof),
+    approval_status = sqlc.arg(approval_status),
+    approval_decided_at = sqlc.narg(approval_decided_at),
     blockchain_tx_id = $10,
-    blockchain_status = $11
+    blockchain_status = $11,
+    blockchain_error = $12,
+    blockchain_status_at = CASE WHEN blockchain_status = $11 THEN GREATEST(blockchain_status_at, $13) ELSE NOW() END
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteEvent :exec
+-- name: DecideEventProposal :execrows
+-- Only decides events still awaiting the owner's approval. The update locks the event, so of two
+-- concurrent decisions the second finds it already decided.
+UPDATE events
+SET approval_status = sqlc.arg(approval_status),
+    approval_decided_at = sqlc.arg(approval_decided_at)
+WHERE id = sqlc.arg(id)
+  AND approval_status = 'proposed';
+
+-- name: UpdateUnanchoredEventDetails :execrows
+-- Only events that were never submitted for anchoring can be edited; anchored events are amended.
+UPDATE events
+SET title = $2,
+    description = $3,
+    event_date = $4,
+    location = $5,
+    metadata = $6
+WHERE id = $1
+  AND cid IS NULL;
+
+-- name: DeleteEvent :execrows
 DELETE FROM events
+WHERE id = $1
+  AND cid IS NULL;
+
+-- name: ListEventsByBlockchainStatus :many
+SELECT * FROM events
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => sqlc.arg(older_than_seconds)::float8)
+ORDER BY blockchain_status_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CountEventsByBlockchainStatus :one
+SELECT COUNT(*) FROM events
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => sqlc.arg(older_than_seconds)::float8);
+
+-- name: SetEventSignature :exec
+UPDATE events
+SET signing_key_id = $2,
+    signature = $3,
+    signed_at = NOW()
 WHERE id = $1;
@@ -0,0 +1,49 @@
+-- name: CreateLifecycleRequest :one
+INSERT INTO vehicle_lifecycle_requests (vehicle_id, from_status, to_status, reason, requested_by, entity_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetLifecycleRequest :one
+SELECT * FROM vehicle_lifecycle_requests
+WHERE id = $1 LIMIT 1;
+
+-- name: ListLifecycleRequestsByVehicle :many
+SELECT * FROM vehicle_lifecycle_requests
+WHERE vehicle_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListLifecycleRequests :many
+-- Without an entity every request is listed, as admins review them all
+SELECT * FROM vehicle_lifecycle_requests
+WHERE (sqlc.narg(entity_id)::uuid IS NULL OR entity_id = sqlc.narg(entity_id))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at ASC;
+
+-- name: DecideLifecycleRequest :one
+UPDATE vehicle_lifecycle_requests
+SET status = $2,
+    decided_by = $3,
+    decline_reason = $4,
+    decided_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: SetLifecycleRequestEvent :exec
+UPDATE vehicle_lifecycle_requests
+SET event_id = $2,
+    asset_status = $3
+WHERE id = $1;
+
+-- name: SetLifecycleRequestAssetStatus :exec
+UPDATE vehicle_lifecycle_requests
+SET asset_status = $2,
+    asset_tx_id = COALESCE(sqlc.narg(asset_tx_id), asset_tx_id),
+    asset_error = $3
+WHERE id = $1;
+
+-- name: ListLifecycleRequestsByAssetStatus :many
+SELECT * FROM vehicle_lifecycle_requests
+WHERE asset_status = $1
+  AND decided_at < NOW() - make_interval(secs => sqlc.arg(older_than_seconds)::float8)
+ORDER BY decided_at ASC
+LIMIT $2;
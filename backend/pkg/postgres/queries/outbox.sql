@@ -0,0 +1,24 @@
+-- name: CreateOutboxMessage :exec
+INSERT INTO outbox (subject, payload)
+VALUES ($1, $2);
+
+-- name: ClaimPendingOutboxMessages :many
+SELECT * FROM outbox
+WHERE published_at IS NULL AND next_attempt_at <= NOW()
+ORDER BY id ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxMessagePublished :exec
+UPDATE outbox
+SET published_at = NOW(), attempts = attempts + 1, last_error = NULL
+WHERE id = $1;
+
+-- name: MarkOutboxMessageFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+WHERE id = $1;
+
+-- name: DeletePublishedOutboxMessages :execrows
+DELETE FROM outbox
+WHERE published_at IS NOT NULL AND published_at < $1;
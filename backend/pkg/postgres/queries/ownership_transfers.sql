@@ -0,0 +1,43 @@
+-- name: CreateOwnershipTransfer :one
+INSERT INTO vehicle_ownership_transfers (vehicle_id, from_owner_id, to_email, token, token_expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetOwnershipTransfer :one
+SELECT * FROM vehicle_ownership_transfers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetOwnershipTransferByToken :one
+SELECT * FROM vehicle_ownership_transfers
+WHERE token = $1 LIMIT 1;
+
+-- name: ListOwnershipTransfersByVehicle :many
+SELECT * FROM vehicle_ownership_transfers
+WHERE vehicle_id = $1
+ORDER BY created_at DESC;
+
+-- name: AcceptOwnershipTransfer :one
+UPDATE vehicle_ownership_transfers
+SET status = 'accepted',
+    to_owner_id = $2,
+    completed_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: SetOwnershipTransferEvent :exec
+UPDATE vehicle_ownership_transfers
+SET event_id = $2
+WHERE id = $1;
+
+-- name: CancelOwnershipTransfer :one
+UPDATE vehicle_ownership_transfers
+SET status = 'cancelled',
+    completed_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: CancelPendingOwnershipTransfers :exec
+UPDATE vehicle_ownership_transfers
+SET status = 'cancelled',
+    completed_at = NOW()
+WHERE vehicle_id = $1 AND status = 'pending';
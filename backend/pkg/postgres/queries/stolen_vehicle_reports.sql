@@ -0,0 +1,51 @@
+-- name: CreateStolenVehicleReport :one
+INSERT INTO stolen_vehicle_reports (vehicle_id, kind, police_reference, police_authority, incident_date, location, description, reported_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetStolenVehicleReport :one
+SELECT * FROM stolen_vehicle_reports
+WHERE id = $1 LIMIT 1;
+
+-- name: GetApprovedStolenVehicleReport :one
+SELECT * FROM stolen_vehicle_reports
+WHERE vehicle_id = $1 AND status = 'approved'
+LIMIT 1;
+
+-- name: ListStolenVehicleReportsByVehicle :many
+SELECT * FROM stolen_vehicle_reports
+WHERE vehicle_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListStolenVehicleReports :many
+SELECT * FROM stolen_vehicle_reports
+WHERE (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY created_at ASC;
+
+-- name: DecideStolenVehicleReport :one
+UPDATE stolen_vehicle_reports
+SET status = $2,
+    decided_by = $3,
+    decline_reason = $4,
+    decided_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: SetStolenVehicleReportLifecycleRequest :exec
+UPDATE stolen_vehicle_reports
+SET lifecycle_request_id = $2
+WHERE id = $1;
+
+-- name: ResolveStolenVehicleReport :one
+UPDATE stolen_vehicle_reports
+SET status = 'resolved',
+    resolved_by = $2,
+    resolution_note = $3,
+    resolved_at = NOW()
+WHERE id = $1 AND status = 'approved'
+RETURNING *;
+
+-- name: ListVehicleEventEntities :many
+-- Entities that issued events on the vehicle, to be alerted when it is reported stolen
+SELECT DISTINCT entity_id::uuid FROM events
+WHERE vehicle_id = $1 AND entity_id IS NOT NULL;
@@ -0,0 +1,14 @@
+-- name: CreateVehicleOwner :one
+INSERT INTO vehicle_owners (vehicle_id, owner_id, start_date, source)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: EndVehicleOwnership :exec
+UPDATE vehicle_owners
+SET end_date = $2
+WHERE vehicle_id = $1 AND end_date IS NULL;
+
+-- name: ListVehicleOwners :many
+SELECT * FROM vehicle_owners
+WHERE vehicle_id = $1
+ORDER BY start_date ASC, created_at ASC;
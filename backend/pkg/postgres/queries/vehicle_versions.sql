@@ -0,0 +1,38 @@
+-- name: CreateVehicleVersion :one
+-- Version 1 is the genesis record held on the vehicle itself, so revisions start at 2
+INSERT INTO vehicle_versions (vehicle_id, version, cid, previous_cid, cid_source_json, cid_source_cbor_b64, blockchain_status)
+SELECT sqlc.arg(vehicle_id), COALESCE(MAX(version), 1) + 1, sqlc.arg(cid), sqlc.arg(previous_cid),
+       sqlc.arg(cid_source_json), sqlc.arg(cid_source_cbor_b64), sqlc.arg(blockchain_status)
+FROM vehicle_versions
+WHERE vehicle_id = sqlc.arg(vehicle_id)
+RETURNING *;
+
+-- name: GetVehicleVersion :one
+SELECT * FROM vehicle_versions
+WHERE id = $1 LIMIT 1;
+
+-- name: ListVehicleVersions :many
+SELECT * FROM vehicle_versions
+WHERE vehicle_id = $1
+ORDER BY version ASC;
+
+-- name: UpdateVehicleVersion :one
+UPDATE vehicle_versions
+SET blockchain_tx_id = $2,
+    blockchain_status = $3,
+    blockchain_error = $4,
+    blockchain_status_at = CASE WHEN blockchain_status = $3 THEN GREATEST(blockchain_status_at, $5) ELSE NOW() END
+WHERE id = $1
+RETURNING *;
+
+-- name: ListVehicleVersionsByBlockchainStatus :many
+SELECT * FROM vehicle_versions
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => sqlc.arg(older_than_seconds)::float8)
+ORDER BY blockchain_status_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CountVehicleVersionsByBlockchainStatus :one
+SELECT COUNT(*) FROM vehicle_versions
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => sqlc.arg(older_than_seconds)::float8);
@@ -66,12 +66,54 @@ SET license_plate = $2, chassis_number = $3, make = $4, model = $5,
     year = $6, color = $7, engine_number = $8, transmission_number = $9,
     body_type = $10, drive_type = $11, gear_type = $12, suspension_type = $13,
     fuel = $14, engine_cc = $15, engine_cylinders = $16, engine_power_hp = $17,
-    owner_id = $18, blockchain_asset_id = $19, cid = $20, cid_source_json = $21, cid_source_cbor_b64 = $22,
+    owner_id = $18,
+    -- the asset ID is written once by genesis and never cleared by a stale update
+    blockchain_asset_id = CASE WHEN blockchain_asset_id = '' THEN $19 ELSE blockchain_asset_id END, cid = $20, cid_source_json = $21, cid_source_cbor_b64 = $22,
     blockchain_status = $23,
+    blockchain_error = $24,
+    blockchain_status_at = CASE WHEN blockchain_status = $23 THEN GREATEST(blockchain_status_at, $25) ELSE NOW() END,
     updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
+-- name: ClaimVehicleGenesis :execrows
+UPDATE vehicles
+SET genesis_tx_id = sqlc.arg(genesis_tx_id),
+    genesis_last_valid_round = sqlc.arg(genesis_last_valid_round),
+    cid = sqlc.arg(cid), cid_source_json = sqlc.arg(cid_source_json), cid_source_cbor_b64 = sqlc.arg(cid_source_cbor_b64),
+    chain_head_cid = COALESCE(chain_head_cid, sqlc.arg(cid))
+WHERE id = sqlc.arg(id)
+  AND blockchain_asset_id = ''
+  AND genesis_tx_id IS NOT DISTINCT FROM sqlc.narg(previous_genesis_tx_id);
+
+-- name: LockVehicleChainHead :one
+-- Locks the vehicle until the end of the transaction so records are appended to its chain one at a time
+SELECT chain_head_cid, cid FROM vehicles
+WHERE id = $1
+FOR UPDATE;
+
+-- name: SetVehicleGenesisCID :exec
+-- Fixes the genesis CID of a vehicle that has none yet, so the first record appended to its chain
+-- can link to it. Genesis later anchors the stored CID.
+UPDATE vehicles
+SET cid = sqlc.arg(cid), cid_source_json = sqlc.arg(cid_source_json), cid_source_cbor_b64 = sqlc.arg(cid_source_cbor_b64),
+    chain_head_cid = COALESCE(chain_head_cid, sqlc.arg(cid))
+WHERE id = sqlc.arg(id)
+  AND cid IS NULL;
+
+-- name: SetVehicleChainHead :exec
+UPDATE vehicles
+SET chain_head_cid = $2
+WHERE id = $1;
+
+-- name: SetVehicleLifecycleStatus :execrows
+-- Only moves the vehicle out of the status the change was approved from
+UPDATE vehicles
+SET lifecycle_status = sqlc.arg(to_status),
+    lifecycle_status_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND lifecycle_status = sqlc.arg(from_status);
+
 -- name: DeleteVehicle :exec
 DELETE FROM vehicles
 WHERE id = $1;
@@ -89,23 +131,25 @@ SELECT
     v.*,
     COALESCE(stats.certified_events_count, 0)::bigint AS certified_events_count,
     COALESCE(stats.owner_events_count, 0)::bigint AS owner_events_count,
-    COALESCE(stats.active_certifications_count, 0)::bigint AS active_certifications_count
+    COALESCE(certs.active_certifications_count, 0)::bigint AS active_certifications_count
 FROM vehicles v
 LEFT JOIN (
     SELECT
         e.vehicle_id,
         COUNT(*) FILTER (WHERE e.entity_id IS NOT NULL) AS certified_events_count,
-        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count,
-        COUNT(*) FILTER (
-            WHERE e.event_type = 'certification'
-            AND (
-                e.metadata->>'validityEndDate' IS NULL
-                OR (e.metadata->>'validityEndDate')::date >= CURRENT_DATE
-            )
-        ) AS active_certifications_count
+        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count
     FROM events e
+    WHERE e.kind = 'original'
+      AND e.approval_status IN ('not_required', 'accepted')
     GROUP BY e.vehicle_id
 ) stats ON v.id = stats.vehicle_id
+LEFT JOIN (
+    SELECT c.vehicle_id, COUNT(*) AS active_certifications_count
+    FROM certifications c
+    WHERE c.status = 'active'
+      AND (c.valid_until IS NULL OR c.valid_until >= CURRENT_DATE)
+    GROUP BY c.vehicle_id
+) certs ON v.id = certs.vehicle_id
 ORDER BY v.created_at DESC
 LIMIT $1 OFFSET $2;
 
@@ -114,23 +158,37 @@ SELECT
     v.*,
     COALESCE(stats.certified_events_count, 0)::bigint AS certified_events_count,
     COALESCE(stats.owner_events_count, 0)::bigint AS owner_events_count,
-    COALESCE(stats.active_certifications_count, 0)::bigint AS active_certifications_count
+    COALESCE(certs.active_certifications_count, 0)::bigint AS active_certifications_count
 FROM vehicles v
 LEFT JOIN (
     SELECT
         e.vehicle_id,
         COUNT(*) FILTER (WHERE e.entity_id IS NOT NULL) AS certified_events_count,
-        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count,
-        COUNT(*) FILTER (
-            WHERE e.event_type = 'certification'
-            AND (
-                e.metadata->>'validityEndDate' IS NULL
-                OR (e.metadata->>'validityEndDate')::date >= CURRENT_DATE
-            )
-        ) AS active_certifications_count
+        COUNT(*) FILTER (WHERE e.entity_id IS NULL) AS owner_events_count
     FROM events e
+    WHERE e.kind = 'original'
+      AND e.approval_status IN ('not_required', 'accepted')
     GROUP BY e.vehicle_id
 ) stats ON v.id = stats.vehicle_id
+LEFT JOIN (
+    SELECT c.vehicle_id, COUNT(*) AS active_certifications_count
+    FROM certifications c
+    WHERE c.status = 'active'
+      AND (c.valid_until IS NULL OR c.valid_until >= CURRENT_DATE)
+    GROUP BY c.vehicle_id
+) certs ON v.id = certs.vehicle_id
 WHERE v.owner_id = $1
 ORDER BY v.created_at DESC
 LIMIT $2 OFFSET $3;
+
+-- name: ListVehiclesByBlockchainStatus :many
+SELECT * FROM vehicles
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => sqlc.arg(older_than_seconds)::float8)
+ORDER BY blockchain_status_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CountVehiclesByBlockchainStatus :one
+SELECT COUNT(*) FROM vehicles
+WHERE blockchain_status = $1
+  AND blockchain_status_at < NOW() - make_interval(secs => sqlc.arg(older_than_seconds)::float8);
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/queue"
@@ -15,6 +16,15 @@ const (
 	DefaultStreamName = "ANCHOR"
 	DefaultAckWait    = 30 * time.Second
 	DefaultMaxDeliver = 5
+
+	// inProgressInterval is how often a message still being handled has its ack deadline reset.
+	// Handlers may wait on a batch window, submission and confirmation for longer than AckWait.
+	inProgressInterval = DefaultAckWait / 3
+
+	// DefaultNakDelay is the delay before the first redelivery of a failed message.
+	// It doubles on every further attempt, up to maxNakDelay.
+	DefaultNakDelay = 5 * time.Second
+	maxNakDelay     = time.Minute
 )
 
 var DefaultSubjects = []string{"anchor.>"}
@@ -23,6 +33,8 @@ type Config struct {
 	URL        string
 	StreamName string
 	Subjects   []string
+	// MaxConcurrent is how many messages of a subscription are handled at once. Defaults to 1.
+	MaxConcurrent int
 }
 
 func (c Config) streamName() string {
@@ -81,10 +93,12 @@ func (p *Publisher) Close() error {
 }
 
 type Subscriber struct {
-	nc       *nats.Conn
-	js       jetstream.JetStream
-	stream   string
-	contexts []jetstream.ConsumeContext
+	nc            *nats.Conn
+	js            jetstream.JetStream
+	stream        string
+	maxConcurrent int
+	contexts      []jetstream.ConsumeContext
+	handlers      sync.WaitGroup
 }
 
 func NewSubscriber(ctx context.Context, cfg Config) (*Subscriber, error) {
@@ -99,7 +113,12 @@ func NewSubscriber(ctx context.Context, cfg Config) (*Subscriber, error) {
 		return nil, fmt.Errorf("jetstream init: %w", err)
 	}
 
-	return &Subscriber{nc: nc, js: js, stream: cfg.streamName()}, nil
+	maxConcurrent := cfg.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+
+	return &Subscriber{nc: nc, js: js, stream: cfg.streamName(), maxConcurrent: maxConcurrent}, nil
 }
 
 func (s *Subscriber) Subscribe(ctx context.Context, subject string, handler queue.MessageHandler) error {
@@ -114,27 +133,18 @@ func (s *Subscriber) Subscribe(ctx context.Context, subject string, handler queu
 		return fmt.Errorf("create consumer for %s: %w", subject, err)
 	}
 
+	// Blocks the consume callback while all slots are taken
+	slots := make(chan struct{}, s.maxConcurrent)
 	cc, err := cons.Consume(func(msg jetstream.Msg) {
-		deliveryCount := 1
-		if meta, err := msg.Metadata(); err == nil {
-			deliveryCount = int(meta.NumDelivered)
-		}
-
-		qMsg := queue.Message{
-			Subject:       msg.Subject(),
-			Data:          msg.Data(),
-			DeliveryCount: deliveryCount,
-		}
-
-		if err := handler(ctx, qMsg); err != nil {
-			if nakErr := msg.Nak(); nakErr != nil {
-				log.Printf("nats: failed to nak message on %s: %v", subject, nakErr)
-			}
-			return
-		}
-		if ackErr := msg.Ack(); ackErr != nil {
-			log.Printf("nats: failed to ack message on %s: %v", subject, ackErr)
-		}
+		slots <- struct{}{}
+		s.handlers.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				s.handlers.Done()
+			}()
+			s.handle(ctx, subject, msg, handler)
+		}()
 	})
 	if err != nil {
 		return fmt.Errorf("consume %s: %w", subject, err)
@@ -144,10 +154,61 @@ func (s *Subscriber) Subscribe(ctx context.Context, subject string, handler queu
 	return nil
 }
 
+func (s *Subscriber) handle(ctx context.Context, subject string, msg jetstream.Msg, handler queue.MessageHandler) {
+	deliveryCount := 1
+	if meta, err := msg.Metadata(); err == nil {
+		deliveryCount = int(meta.NumDelivered)
+	}
+
+	qMsg := queue.Message{
+		Subject:       msg.Subject(),
+		Data:          msg.Data(),
+		DeliveryCount: deliveryCount,
+	}
+
+	// Keep JetStream from redelivering the message to another handler while this one still runs
+	done := make(chan struct{})
+	defer close(done)
+	go heartbeat(msg, subject, inProgressInterval, done)
+
+	if err := handler(ctx, qMsg); err != nil {
+		if nakErr := msg.NakWithDelay(redeliveryDelay(deliveryCount)); nakErr != nil {
+			log.Printf("nats: failed to nak message on %s: %v", subject, nakErr)
+		}
+		return
+	}
+	if ackErr := msg.Ack(); ackErr != nil {
+		log.Printf("nats: failed to ack message on %s: %v", subject, ackErr)
+	}
+}
+
+// inProgresser is the part of a JetStream message that resets its ack deadline
+type inProgresser interface {
+	InProgress() error
+}
+
+// heartbeat marks the message as in progress every interval until done is closed
+func heartbeat(msg inProgresser, subject string, interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := msg.InProgress(); err != nil {
+				log.Printf("nats: failed to extend ack deadline of message on %s: %v", subject, err)
+			}
+		}
+	}
+}
+
+// Close stops consuming and waits for the messages being handled before closing the connection
 func (s *Subscriber) Close() error {
 	for _, cc := range s.contexts {
 		cc.Stop()
 	}
+	s.handlers.Wait()
 	s.nc.Close()
 	return nil
 }
@@ -164,3 +225,11 @@ func consumerName(subject string) string {
 	}
 	return name
 }
+
+func redeliveryDelay(deliveryCount int) time.Duration {
+	delay := DefaultNakDelay
+	for i := 1; i < deliveryCount && delay < maxNakDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxNakDelay)
+}
@@ -0,0 +1,42 @@
+package nats
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingMsg struct {
+	inProgress atomic.Int32
+}
+
+func (m *countingMsg) InProgress() error {
+	m.inProgress.Add(1)
+	return nil
+}
+
+func TestHeartbeat_ExtendsAckDeadlineUntilDone(t *testing.T) {
+	msg := &countingMsg{}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		heartbeat(msg, "anchor.event", 10*time.Millisecond, done)
+		close(stopped)
+	}()
+
+	assert.Eventually(t, func() bool { return msg.inProgress.Load() >= 3 }, time.Second, 5*time.Millisecond)
+
+	close(done)
+	<-stopped
+	count := msg.inProgress.Load()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, count, msg.inProgress.Load())
+}
+
+func TestRedeliveryDelay(t *testing.T) {
+	assert.Equal(t, DefaultNakDelay, redeliveryDelay(1))
+	assert.Equal(t, 2*DefaultNakDelay, redeliveryDelay(2))
+	assert.Equal(t, maxNakDelay, redeliveryDelay(10))
+}
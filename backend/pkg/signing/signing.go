@@ -0,0 +1,111 @@
+// Package signing signs record CIDs with Ed25519 keys held by entities and seals the private keys
+// the platform manages on their behalf.
+//
+// The signed message is the UTF-8 encoding of the CID string, so a signature can be checked with
+// any Ed25519 implementation given the CID, the public key and the signature. Keys and signatures
+// are exchanged in standard base64.
+package signing
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidPublicKey = errors.New("public key must be a base64-encoded 32-byte Ed25519 key")
+	ErrInvalidSignature = errors.New("signature does not verify against the key")
+)
+
+// GenerateKey creates a new Ed25519 key pair
+func GenerateKey() (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	return ed25519.GenerateKey(rand.Reader)
+}
+
+// EncodePublicKey returns the base64 form of a public key
+func EncodePublicKey(key ed25519.PublicKey) string {
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// ParsePublicKey decodes a base64 Ed25519 public key
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	decoded, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(decoded) != ed25519.PublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+	return ed25519.PublicKey(decoded), nil
+}
+
+// SignCID signs the CID and returns the base64 signature
+func SignCID(key ed25519.PrivateKey, cid string) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(key, []byte(cid)))
+}
+
+// VerifyCID checks a base64 signature of the CID against a base64 public key
+func VerifyCID(publicKey, cid, signature string) error {
+	key, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || !ed25519.Verify(key, []byte(cid), sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Sealer encrypts the private keys of managed signing keys with AES-256-GCM, so a database dump
+// alone cannot be used to sign on behalf of an entity
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// NewSealer creates a sealer from a 32-byte secret
+func NewSealer(secret []byte) (*Sealer, error) {
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("sealing secret must be 32 bytes, got %d", len(secret))
+	}
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+	return &Sealer{aead: aead}, nil
+}
+
+// SealPrivateKey encrypts the private key and returns the nonce and ciphertext in base64
+func (s *Sealer) SealPrivateKey(key ed25519.PrivateKey) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := s.aead.Seal(nonce, nonce, key.Seed(), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// OpenPrivateKey decrypts a private key sealed by SealPrivateKey
+func (s *Sealer) OpenPrivateKey(sealed string) (ed25519.PrivateKey, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("decode sealed key: %w", err)
+	}
+	if len(data) < s.aead.NonceSize() {
+		return nil, errors.New("sealed key is too short")
+	}
+	nonce, ciphertext := data[:s.aead.NonceSize()], data[s.aead.NonceSize():]
+	seed, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open sealed key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.New("sealed key has the wrong size")
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
@@ -0,0 +1,56 @@
+package signing
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignCID_VerifiesAgainstPublicKey(t *testing.T) {
+	pub, priv, err := GenerateKey()
+	require.NoError(t, err)
+	publicKey := EncodePublicKey(pub)
+
+	sig := SignCID(priv, "bafyevent")
+
+	assert.NoError(t, VerifyCID(publicKey, "bafyevent", sig))
+	assert.ErrorIs(t, VerifyCID(publicKey, "bafyother", sig), ErrInvalidSignature)
+	assert.ErrorIs(t, VerifyCID(publicKey, "bafyevent", "not base64!"), ErrInvalidSignature)
+
+	otherPub, _, err := GenerateKey()
+	require.NoError(t, err)
+	assert.ErrorIs(t, VerifyCID(EncodePublicKey(otherPub), "bafyevent", sig), ErrInvalidSignature)
+}
+
+func TestParsePublicKey_RejectsWrongSize(t *testing.T) {
+	_, err := ParsePublicKey("AAAA")
+	assert.ErrorIs(t, err, ErrInvalidPublicKey)
+
+	_, err = ParsePublicKey("%%%")
+	assert.ErrorIs(t, err, ErrInvalidPublicKey)
+}
+
+func TestSealer_RoundTrip(t *testing.T) {
+	sealer, err := NewSealer(bytes.Repeat([]byte{7}, 32))
+	require.NoError(t, err)
+	_, priv, err := GenerateKey()
+	require.NoError(t, err)
+
+	sealed, err := sealer.SealPrivateKey(priv)
+	require.NoError(t, err)
+	opened, err := sealer.OpenPrivateKey(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, priv, opened)
+
+	other, err := NewSealer(bytes.Repeat([]byte{8}, 32))
+	require.NoError(t, err)
+	_, err = other.OpenPrivateKey(sealed)
+	assert.Error(t, err)
+}
+
+func TestNewSealer_RequiresThirtyTwoBytes(t *testing.T) {
+	_, err := NewSealer([]byte("short"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/anchors"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type AnchorRepository struct {
+	queries db.Querier
+}
+
+func NewAnchorRepository(queries db.Querier) *AnchorRepository {
+	return &AnchorRepository{queries: queries}
+}
+
+func (r *AnchorRepository) Create(ctx context.Context, anchor anchors.Anchor) (*anchors.Anchor, error) {
+	created, err := querier(ctx, r.queries).CreateAnchor(ctx, db.CreateAnchorParams{
+		VehicleID:        anchor.VehicleID,
+		RecordType:       anchor.RecordType,
+		EventID:          anchor.EventID,
+		VehicleVersionID: anchor.VehicleVersionID,
+		Status:           anchor.Status,
+		Error:            anchor.Error,
+		Network:          anchor.Network,
+		Sender:           anchor.Sender,
+		TxID:             anchor.TxID,
+		TxType:           anchor.TxType,
+		AssetID:          uint64ToInt64Ptr(anchor.AssetID),
+		ConfirmedRound:   uint64ToInt64Ptr(anchor.ConfirmedRound),
+		RoundTime:        timePtrToTimestamptz(anchor.RoundTime),
+		FeeMicroalgos:    uint64ToInt64Ptr(anchor.FeeMicroAlgos),
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create anchor")
+	}
+
+	result := toAnchorDomain(created)
+	return &result, nil
+}
+
+func (r *AnchorRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]anchors.Anchor, error) {
+	rows, err := querier(ctx, r.queries).ListAnchorsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list anchors by vehicle")
+	}
+	return toAnchorsDomain(rows), nil
+}
+
+func (r *AnchorRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]anchors.Anchor, error) {
+	rows, err := querier(ctx, r.queries).ListAnchorsByEvent(ctx, &eventID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list anchors by event")
+	}
+	return toAnchorsDomain(rows), nil
+}
+
+func toAnchorsDomain(rows []db.Anchor) []anchors.Anchor {
+	result := make([]anchors.Anchor, len(rows))
+	for i, a := range rows {
+		result[i] = toAnchorDomain(a)
+	}
+	return result
+}
+
+func toAnchorDomain(a db.Anchor) anchors.Anchor {
+	return anchors.Anchor{
+		ID:               a.ID,
+		VehicleID:        a.VehicleID,
+		RecordType:       a.RecordType,
+		EventID:          a.EventID,
+		VehicleVersionID: a.VehicleVersionID,
+		Status:           a.Status,
+		Error:            a.Error,
+		Network:          a.Network,
+		Sender:           a.Sender,
+		TxID:             a.TxID,
+		TxType:           a.TxType,
+		AssetID:          int64ToUint64Ptr(a.AssetID),
+		ConfirmedRound:   int64ToUint64Ptr(a.ConfirmedRound),
+		RoundTime:        timestamptzToTimePtr(a.RoundTime),
+		FeeMicroAlgos:    int64ToUint64Ptr(a.FeeMicroalgos),
+		CreatedAt:        a.CreatedAt,
+	}
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/certification"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type CertificationRequestRepository struct {
+	queries db.Querier
+}
+
+func NewCertificationRequestRepository(queries db.Querier) *CertificationRequestRepository {
+	return &CertificationRequestRepository{queries: queries}
+}
+
+func (r *CertificationRequestRepository) Create(ctx context.Context, params certification.CreateRequestParams) (*certification.Request, error) {
+	req, err := querier(ctx, r.queries).CreateCertificationRequest(ctx, db.CreateCertificationRequestParams{
+		EventID:           params.EventID,
+		VehicleID:         params.VehicleID,
+		EntityID:          params.EntityID,
+		RequestedBy:       params.RequestedBy,
+		EvidenceSessionID: params.EvidenceSessionID,
+		Message:           params.Message,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create certification request")
+	}
+
+	result := toCertificationRequestDomain(req)
+	return &result, nil
+}
+
+func (r *CertificationRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*certification.Request, error) {
+	req, err := querier(ctx, r.queries).GetCertificationRequest(ctx, id)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, certification.ErrRequestNotFound
+		}
+		return nil, postgres.WrapError(err, "get certification request")
+	}
+
+	result := toCertificationRequestDomain(req)
+	return &result, nil
+}
+
+func (r *CertificationRequestRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]certification.Request, error) {
+	reqs, err := querier(ctx, r.queries).ListCertificationRequestsByEvent(ctx, eventID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list certification requests by event")
+	}
+
+	result := make([]certification.Request, len(reqs))
+	for i, req := range reqs {
+		result[i] = toCertificationRequestDomain(req)
+	}
+	return result, nil
+}
+
+func (r *CertificationRequestRepository) ListByEntity(ctx context.Context, entityID uuid.UUID, status string) ([]certification.Request, error) {
+	reqs, err := querier(ctx, r.queries).ListCertificationRequestsByEntity(ctx, db.ListCertificationRequestsByEntityParams{
+		EntityID: entityID,
+		Status:   nullableToStringPtr(status),
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "list certification requests by entity")
+	}
+
+	result := make([]certification.Request, len(reqs))
+	for i, req := range reqs {
+		result[i] = toCertificationRequestDomain(req)
+	}
+	return result, nil
+}
+
+func (r *CertificationRequestRepository) Decide(ctx context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*certification.Request, error) {
+	req, err := querier(ctx, r.queries).DecideCertificationRequest(ctx, db.DecideCertificationRequestParams{
+		ID:            id,
+		Status:        status,
+		DecidedBy:     &decidedBy,
+		DeclineReason: declineReason,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, certification.ErrRequestNotPending
+		}
+		return nil, postgres.WrapError(err, "decide certification request")
+	}
+
+	result := toCertificationRequestDomain(req)
+	return &result, nil
+}
+
+func (r *CertificationRequestRepository) SetCertifiedEvent(ctx context.Context, id, eventID uuid.UUID) error {
+	err := querier(ctx, r.queries).SetCertificationRequestEvent(ctx, db.SetCertificationRequestEventParams{
+		ID:               id,
+		CertifiedEventID: &eventID,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "set certification request event")
+	}
+	return nil
+}
+
+func toCertificationRequestDomain(r db.EventCertificationRequest) certification.Request {
+	return certification.Request{
+		ID:                r.ID,
+		EventID:           r.EventID,
+		VehicleID:         r.VehicleID,
+		EntityID:          r.EntityID,
+		RequestedBy:       r.RequestedBy,
+		EvidenceSessionID: r.EvidenceSessionID,
+		Message:           r.Message,
+		Status:            r.Status,
+		DecidedBy:         r.DecidedBy,
+		DeclineReason:     r.DeclineReason,
+		CertifiedEventID:  r.CertifiedEventID,
+		CreatedAt:         r.CreatedAt,
+		DecidedAt:         timestamptzToTimePtr(r.DecidedAt),
+	}
+}
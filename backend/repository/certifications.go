@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/certification"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type CertificationRepository struct {
+	queries db.Querier
+}
+
+func NewCertificationRepository(queries db.Querier) *CertificationRepository {
+	return &CertificationRepository{queries: queries}
+}
+
+func (r *CertificationRepository) Upsert(ctx context.Context, c certification.Certification) (*certification.Certification, error) {
+	stored, err := querier(ctx, r.queries).UpsertCertification(ctx, db.UpsertCertificationParams{
+		EventID:           c.EventID,
+		VehicleID:         c.VehicleID,
+		EntityID:          c.EntityID,
+		CertificateNumber: c.CertificateNumber,
+		ValidFrom:         c.ValidFrom,
+		ValidUntil:        timePtrToDate(c.ValidUntil),
+		Status:            c.Status,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "upsert certification")
+	}
+
+	result := toCertificationDomain(stored)
+	return &result, nil
+}
+
+func (r *CertificationRepository) Revoke(ctx context.Context, eventID uuid.UUID) error {
+	if err := querier(ctx, r.queries).RevokeCertification(ctx, eventID); err != nil {
+		return postgres.WrapError(err, "revoke certification")
+	}
+	return nil
+}
+
+func (r *CertificationRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]certification.Certification, error) {
+	certs, err := querier(ctx, r.queries).ListCertificationsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list certifications by vehicle")
+	}
+	return toCertificationsDomain(certs), nil
+}
+
+func (r *CertificationRepository) Expire(ctx context.Context, today time.Time) ([]certification.Certification, error) {
+	certs, err := querier(ctx, r.queries).ExpireCertifications(ctx, today)
+	if err != nil {
+		return nil, postgres.WrapError(err, "expire certifications")
+	}
+	return toCertificationsDomain(certs), nil
+}
+
+func (r *CertificationRepository) ClaimReminders(ctx context.Context, today time.Time, leadDays int) ([]certification.Certification, error) {
+	certs, err := querier(ctx, r.queries).ClaimCertificationReminders(ctx, db.ClaimCertificationRemindersParams{
+		Today:    today,
+		LeadDays: int32(leadDays),
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "claim certification reminders")
+	}
+	return toCertificationsDomain(certs), nil
+}
+
+func toCertificationsDomain(certs []db.Certification) []certification.Certification {
+	result := make([]certification.Certification, len(certs))
+	for i, c := range certs {
+		result[i] = toCertificationDomain(c)
+	}
+	return result
+}
+
+func toCertificationDomain(c db.Certification) certification.Certification {
+	return certification.Certification{
+		ID:                c.ID,
+		EventID:           c.EventID,
+		VehicleID:         c.VehicleID,
+		EntityID:          c.EntityID,
+		CertificateNumber: c.CertificateNumber,
+		ValidFrom:         c.ValidFrom,
+		ValidUntil:        dateToTimePtr(c.ValidUntil),
+		Status:            c.Status,
+		LastReminderDays:  nullableToIntPtr(c.LastReminderDays),
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/custody"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type CustodyRepository struct {
+	queries db.Querier
+}
+
+func NewCustodyRepository(queries db.Querier) *CustodyRepository {
+	return &CustodyRepository{queries: queries}
+}
+
+func (r *CustodyRepository) CreateChallenge(ctx context.Context, params custody.CreateChallengeParams) (*custody.Challenge, error) {
+	c, err := querier(ctx, r.queries).CreateWalletChallenge(ctx, db.CreateWalletChallengeParams{
+		UserID:    params.UserID,
+		Address:   params.Address,
+		Message:   params.Message,
+		ExpiresAt: params.ExpiresAt,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create wallet challenge")
+	}
+
+	challenge := toWalletChallengeDomain(c)
+	return &challenge, nil
+}
+
+func (r *CustodyRepository) GetActiveChallenge(ctx context.Context, userID uuid.UUID, address string) (*custody.Challenge, error) {
+	c, err := querier(ctx, r.queries).GetActiveWalletChallenge(ctx, db.GetActiveWalletChallengeParams{
+		UserID:  userID,
+		Address: address,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, custody.ErrChallengeNotFound
+		}
+		return nil, postgres.WrapError(err, "get active wallet challenge")
+	}
+
+	challenge := toWalletChallengeDomain(c)
+	return &challenge, nil
+}
+
+func (r *CustodyRepository) UseChallenge(ctx context.Context, id uuid.UUID) error {
+	n, err := querier(ctx, r.queries).UseWalletChallenge(ctx, id)
+	if err != nil {
+		return postgres.WrapError(err, "use wallet challenge")
+	}
+	if n == 0 {
+		return custody.ErrChallengeNotFound
+	}
+	return nil
+}
+
+func (r *CustodyRepository) UpsertWallet(ctx context.Context, userID uuid.UUID, address string) (*custody.Wallet, error) {
+	w, err := querier(ctx, r.queries).UpsertUserWallet(ctx, db.UpsertUserWalletParams{
+		UserID:  userID,
+		Address: address,
+	})
+	if err != nil {
+		err = postgres.WrapError(err, "upsert user wallet")
+		if errors.Is(err, postgres.ErrDuplicateKey) {
+			return nil, custody.ErrWalletInUse
+		}
+		return nil, err
+	}
+
+	wallet := toUserWalletDomain(w)
+	return &wallet, nil
+}
+
+func (r *CustodyRepository) GetWallet(ctx context.Context, userID uuid.UUID) (*custody.Wallet, error) {
+	w, err := querier(ctx, r.queries).GetUserWallet(ctx, userID)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, custody.ErrWalletNotLinked
+		}
+		return nil, postgres.WrapError(err, "get user wallet")
+	}
+
+	wallet := toUserWalletDomain(w)
+	return &wallet, nil
+}
+
+func (r *CustodyRepository) DeleteWallet(ctx context.Context, userID uuid.UUID) error {
+	n, err := querier(ctx, r.queries).DeleteUserWallet(ctx, userID)
+	if err != nil {
+		return postgres.WrapError(err, "delete user wallet")
+	}
+	if n == 0 {
+		return custody.ErrWalletNotLinked
+	}
+	return nil
+}
+
+func (r *CustodyRepository) GetCustody(ctx context.Context, vehicleID uuid.UUID) (*custody.Custody, error) {
+	c, err := querier(ctx, r.queries).GetVehicleCustody(ctx, vehicleID)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, postgres.WrapError(err, "get vehicle custody")
+	}
+
+	result := toCustodyDomain(c)
+	return &result, nil
+}
+
+func (r *CustodyRepository) MarkPending(ctx context.Context, vehicleID uuid.UUID) (*custody.Custody, error) {
+	c, err := querier(ctx, r.queries).MarkVehicleCustodyPending(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "mark vehicle custody pending")
+	}
+
+	result := toCustodyDomain(c)
+	return &result, nil
+}
+
+func (r *CustodyRepository) SetHolder(ctx context.Context, vehicleID uuid.UUID, holder *string, status string, txID *string) (*custody.Custody, error) {
+	c, err := querier(ctx, r.queries).SetVehicleCustodyHolder(ctx, db.SetVehicleCustodyHolderParams{
+		VehicleID:     vehicleID,
+		HolderAddress: holder,
+		Status:        status,
+		TxID:          txID,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "set vehicle custody holder")
+	}
+
+	result := toCustodyDomain(c)
+	return &result, nil
+}
+
+func (r *CustodyRepository) SetFailed(ctx context.Context, vehicleID uuid.UUID, reason string) error {
+	err := querier(ctx, r.queries).SetVehicleCustodyFailed(ctx, db.SetVehicleCustodyFailedParams{
+		VehicleID: vehicleID,
+		Error:     &reason,
+	})
+	return postgres.WrapError(err, "set vehicle custody failed")
+}
+
+func toWalletChallengeDomain(c db.WalletChallenge) custody.Challenge {
+	return custody.Challenge{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Address:   c.Address,
+		Message:   c.Message,
+		ExpiresAt: c.ExpiresAt,
+	}
+}
+
+func toUserWalletDomain(w db.UserWallet) custody.Wallet {
+	return custody.Wallet{
+		UserID:   w.UserID,
+		Address:  w.Address,
+		LinkedAt: w.LinkedAt,
+	}
+}
+
+func toCustodyDomain(c db.VehicleCustody) custody.Custody {
+	return custody.Custody{
+		VehicleID:     c.VehicleID,
+		HolderAddress: c.HolderAddress,
+		Status:        c.Status,
+		TxID:          c.TxID,
+		Error:         c.Error,
+		UpdatedAt:     c.UpdatedAt,
+	}
+}
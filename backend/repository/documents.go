@@ -19,7 +19,7 @@ func NewDocumentRepository(queries db.Querier) *DocumentRepository {
 }
 
 func (r *DocumentRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]documents.Document, error) {
-	dbDocuments, err := r.queries.ListDocumentsByVehicle(ctx, vehicleID)
+	dbDocuments, err := querier(ctx, r.queries).ListDocumentsByVehicle(ctx, vehicleID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "list documents by vehicle")
 	}
@@ -33,7 +33,7 @@ func (r *DocumentRepository) ListByVehicle(ctx context.Context, vehicleID uuid.U
 }
 
 func (r *DocumentRepository) Get(ctx context.Context, id uuid.UUID) (*documents.Document, error) {
-	d, err := r.queries.GetDocument(ctx, id)
+	d, err := querier(ctx, r.queries).GetDocument(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, documents.ErrDocumentNotFound
@@ -46,7 +46,7 @@ func (r *DocumentRepository) Get(ctx context.Context, id uuid.UUID) (*documents.
 }
 
 func (r *DocumentRepository) GetByKey(ctx context.Context, vehicleID uuid.UUID, objectKey string) (*documents.Document, error) {
-	d, err := r.queries.GetDocumentByKey(ctx, db.GetDocumentByKeyParams{
+	d, err := querier(ctx, r.queries).GetDocumentByKey(ctx, db.GetDocumentByKeyParams{
 		VehicleID: vehicleID,
 		ObjectKey: objectKey,
 	})
@@ -62,7 +62,7 @@ func (r *DocumentRepository) GetByKey(ctx context.Context, vehicleID uuid.UUID,
 }
 
 func (r *DocumentRepository) Create(ctx context.Context, params documents.CreateDocumentParams) (*documents.Document, error) {
-	created, err := r.queries.CreateDocument(ctx, db.CreateDocumentParams{
+	created, err := querier(ctx, r.queries).CreateDocument(ctx, db.CreateDocumentParams{
 		VehicleID: params.VehicleID,
 		ObjectKey: params.ObjectKey,
 		Filename:  params.Filename,
@@ -77,7 +77,7 @@ func (r *DocumentRepository) Create(ctx context.Context, params documents.Create
 }
 
 func (r *DocumentRepository) ConfirmUpload(ctx context.Context, id uuid.UUID) (*documents.Document, error) {
-	confirmed, err := r.queries.ConfirmDocumentUpload(ctx, id)
+	confirmed, err := querier(ctx, r.queries).ConfirmDocumentUpload(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, documents.ErrDocumentNotFound
@@ -90,11 +90,11 @@ func (r *DocumentRepository) ConfirmUpload(ctx context.Context, id uuid.UUID) (*
 }
 
 func (r *DocumentRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return postgres.WrapError(r.queries.DeleteDocument(ctx, id), "delete document")
+	return postgres.WrapError(querier(ctx, r.queries).DeleteDocument(ctx, id), "delete document")
 }
 
 func (r *DocumentRepository) CountByVehicle(ctx context.Context, vehicleID uuid.UUID) (int, error) {
-	count, err := r.queries.CountDocumentsByVehicle(ctx, vehicleID)
+	count, err := querier(ctx, r.queries).CountDocumentsByVehicle(ctx, vehicleID)
 	if err != nil {
 		return 0, postgres.WrapError(err, "count documents by vehicle")
 	}
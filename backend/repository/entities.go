@@ -26,7 +26,7 @@ func (r *EntityRepository) GetAll(ctx context.Context, limit, offset int, entity
 	var total int64
 
 	if entityType != nil {
-		entities, err = r.queries.ListEntitiesByType(ctx, db.ListEntitiesByTypeParams{
+		entities, err = querier(ctx, r.queries).ListEntitiesByType(ctx, db.ListEntitiesByTypeParams{
 			EntityType: string(*entityType),
 			Limit:      int32(limit),
 			Offset:     int32(offset),
@@ -34,19 +34,19 @@ func (r *EntityRepository) GetAll(ctx context.Context, limit, offset int, entity
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "list entities by type")
 		}
-		total, err = r.queries.CountEntitiesByType(ctx, string(*entityType))
+		total, err = querier(ctx, r.queries).CountEntitiesByType(ctx, string(*entityType))
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "count entities by type")
 		}
 	} else {
-		entities, err = r.queries.ListEntities(ctx, db.ListEntitiesParams{
+		entities, err = querier(ctx, r.queries).ListEntities(ctx, db.ListEntitiesParams{
 			Limit:  int32(limit),
 			Offset: int32(offset),
 		})
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "list entities")
 		}
-		total, err = r.queries.CountEntities(ctx)
+		total, err = querier(ctx, r.queries).CountEntities(ctx)
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "count entities")
 		}
@@ -61,7 +61,7 @@ func (r *EntityRepository) GetAll(ctx context.Context, limit, offset int, entity
 }
 
 func (r *EntityRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Entity, error) {
-	e, err := r.queries.GetEntity(ctx, id)
+	e, err := querier(ctx, r.queries).GetEntity(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, entity.ErrEntityNotFound
@@ -79,7 +79,7 @@ func (r *EntityRepository) Create(ctx context.Context, ent *entity.Entity) error
 		return fmt.Errorf("marshal address: %w", err)
 	}
 
-	created, err := r.queries.CreateEntity(ctx, db.CreateEntityParams{
+	created, err := querier(ctx, r.queries).CreateEntity(ctx, db.CreateEntityParams{
 		Name:         ent.Name,
 		EntityType:   string(ent.Type),
 		Description:  stringToNullable(ent.Description),
@@ -102,13 +102,14 @@ func (r *EntityRepository) Update(ctx context.Context, ent *entity.Entity) error
 		return fmt.Errorf("marshal address: %w", err)
 	}
 
-	updated, err := r.queries.UpdateEntity(ctx, db.UpdateEntityParams{
-		ID:           ent.ID,
-		Name:         ent.Name,
-		Description:  stringToNullable(ent.Description),
-		ContactEmail: ent.ContactEmail,
-		Website:      stringToNullable(ent.Website),
-		Address:      addressJSON,
+	updated, err := querier(ctx, r.queries).UpdateEntity(ctx, db.UpdateEntityParams{
+		ID:                      ent.ID,
+		Name:                    ent.Name,
+		Description:             stringToNullable(ent.Description),
+		ContactEmail:            ent.ContactEmail,
+		Website:                 stringToNullable(ent.Website),
+		Address:                 addressJSON,
+		OwnerApprovalEventTypes: ownerApprovalEventTypes(ent.OwnerApprovalEventTypes),
 	})
 	if err != nil {
 		return postgres.WrapError(err, "update entity")
@@ -119,7 +120,7 @@ func (r *EntityRepository) Update(ctx context.Context, ent *entity.Entity) error
 }
 
 func (r *EntityRepository) UpdateLogo(ctx context.Context, id uuid.UUID, objectKey string) (*entity.Entity, error) {
-	updated, err := r.queries.UpdateEntityLogo(ctx, db.UpdateEntityLogoParams{
+	updated, err := querier(ctx, r.queries).UpdateEntityLogo(ctx, db.UpdateEntityLogoParams{
 		ID:            id,
 		LogoObjectKey: &objectKey,
 	})
@@ -134,7 +135,7 @@ func (r *EntityRepository) UpdateLogo(ctx context.Context, id uuid.UUID, objectK
 }
 
 func (r *EntityRepository) ClearLogo(ctx context.Context, id uuid.UUID) error {
-	_, err := r.queries.ClearEntityLogo(ctx, id)
+	_, err := querier(ctx, r.queries).ClearEntityLogo(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return entity.ErrEntityNotFound
@@ -145,7 +146,7 @@ func (r *EntityRepository) ClearLogo(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *EntityRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return postgres.WrapError(r.queries.DeleteEntity(ctx, id), "delete entity")
+	return postgres.WrapError(querier(ctx, r.queries).DeleteEntity(ctx, id), "delete entity")
 }
 
 func toEntityDomain(e db.Entity) entity.Entity {
@@ -157,16 +158,25 @@ func toEntityDomain(e db.Entity) entity.Entity {
 	}
 
 	return entity.Entity{
-		ID:            e.ID,
-		Name:          e.Name,
-		Type:          entity.EntityType(e.EntityType),
-		Description:   nullableToStringPtr(e.Description),
-		ContactEmail:  e.ContactEmail,
-		Website:       nullableToStringPtr(e.Website),
-		Address:       addr,
-		CertifiedBy:   e.CertifiedBy,
-		LogoObjectKey: e.LogoObjectKey,
-		CreatedAt:     e.CreatedAt.Time,
-		UpdatedAt:     e.UpdatedAt.Time,
+		ID:                      e.ID,
+		Name:                    e.Name,
+		Type:                    entity.EntityType(e.EntityType),
+		Description:             nullableToStringPtr(e.Description),
+		ContactEmail:            e.ContactEmail,
+		Website:                 nullableToStringPtr(e.Website),
+		Address:                 addr,
+		CertifiedBy:             e.CertifiedBy,
+		LogoObjectKey:           e.LogoObjectKey,
+		CreatedAt:               e.CreatedAt.Time,
+		UpdatedAt:               e.UpdatedAt.Time,
+		OwnerApprovalEventTypes: e.OwnerApprovalEventTypes,
+	}
+}
+
+// ownerApprovalEventTypes keeps the column NOT NULL when no event type requires approval
+func ownerApprovalEventTypes(types []string) []string {
+	if types == nil {
+		return []string{}
 	}
+	return types
 }
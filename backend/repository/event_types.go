@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/event_types"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type EventTypeRepository struct {
+	queries db.Querier
+}
+
+func NewEventTypeRepository(queries db.Querier) *EventTypeRepository {
+	return &EventTypeRepository{queries: queries}
+}
+
+func (r *EventTypeRepository) Create(ctx context.Context, params event_types.CreateParams) (*event_types.CustomType, error) {
+	schemaJSON, err := json.Marshal(params.MetadataSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata schema: %w", err)
+	}
+
+	t, err := querier(ctx, r.queries).CreateEntityEventType(ctx, db.CreateEntityEventTypeParams{
+		EntityID:       params.EntityID,
+		Key:            params.Key,
+		DisplayName:    params.DisplayName,
+		Icon:           params.Icon,
+		MetadataSchema: schemaJSON,
+		DefinitionCid:  params.DefinitionCID,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create event type")
+	}
+
+	return toEventTypeDomain(t)
+}
+
+func (r *EventTypeRepository) Get(ctx context.Context, entityID uuid.UUID, key string) (*event_types.CustomType, error) {
+	t, err := querier(ctx, r.queries).GetEntityEventType(ctx, db.GetEntityEventTypeParams{
+		EntityID: entityID,
+		Key:      key,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, event_types.ErrEventTypeNotFound
+		}
+		return nil, postgres.WrapError(err, "get event type")
+	}
+
+	return toEventTypeDomain(t)
+}
+
+func (r *EventTypeRepository) ListByEntity(ctx context.Context, entityID uuid.UUID) ([]event_types.CustomType, error) {
+	types, err := querier(ctx, r.queries).ListEntityEventTypesByEntity(ctx, entityID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list event types by entity")
+	}
+
+	result := make([]event_types.CustomType, len(types))
+	for i, t := range types {
+		converted, err := toEventTypeDomain(t)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *converted
+	}
+	return result, nil
+}
+
+func (r *EventTypeRepository) ListActive(ctx context.Context) ([]event_types.CustomType, error) {
+	rows, err := querier(ctx, r.queries).ListActiveEntityEventTypes(ctx)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list active event types")
+	}
+
+	result := make([]event_types.CustomType, len(rows))
+	for i, row := range rows {
+		converted, err := toEventTypeDomain(db.EntityEventType{
+			ID:             row.ID,
+			EntityID:       row.EntityID,
+			Key:            row.Key,
+			DisplayName:    row.DisplayName,
+			Icon:           row.Icon,
+			MetadataSchema: row.MetadataSchema,
+			DefinitionCid:  row.DefinitionCid,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+			RetiredAt:      row.RetiredAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		converted.EntityName = &row.EntityName
+		result[i] = *converted
+	}
+	return result, nil
+}
+
+func (r *EventTypeRepository) Update(ctx context.Context, entityID uuid.UUID, key, displayName string, icon *string) (*event_types.CustomType, error) {
+	t, err := querier(ctx, r.queries).UpdateEntityEventType(ctx, db.UpdateEntityEventTypeParams{
+		EntityID:    entityID,
+		Key:         key,
+		DisplayName: displayName,
+		Icon:        icon,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, event_types.ErrEventTypeNotFound
+		}
+		return nil, postgres.WrapError(err, "update event type")
+	}
+
+	return toEventTypeDomain(t)
+}
+
+func (r *EventTypeRepository) Retire(ctx context.Context, entityID uuid.UUID, key string) (*event_types.CustomType, error) {
+	t, err := querier(ctx, r.queries).RetireEntityEventType(ctx, db.RetireEntityEventTypeParams{
+		EntityID: entityID,
+		Key:      key,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, event_types.ErrEventTypeNotFound
+		}
+		return nil, postgres.WrapError(err, "retire event type")
+	}
+
+	return toEventTypeDomain(t)
+}
+
+func toEventTypeDomain(t db.EntityEventType) (*event_types.CustomType, error) {
+	var schema map[string]interface{}
+	if err := json.Unmarshal(t.MetadataSchema, &schema); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal metadata schema: %w", err)
+	}
+
+	return &event_types.CustomType{
+		ID:             t.ID,
+		EntityID:       t.EntityID,
+		Key:            t.Key,
+		DisplayName:    t.DisplayName,
+		Icon:           t.Icon,
+		MetadataSchema: schema,
+		DefinitionCID:  t.DefinitionCid,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		RetiredAt:      timestamptzToTimePtr(t.RetiredAt),
+	}, nil
+}
@@ -19,7 +19,7 @@ func NewEventImageRepository(queries db.Querier) *EventImageRepository {
 }
 
 func (r *EventImageRepository) Create(ctx context.Context, params event_images.CreateEventImageParams) (*event_images.EventImage, error) {
-	created, err := r.queries.CreateEventImage(ctx, db.CreateEventImageParams{
+	created, err := querier(ctx, r.queries).CreateEventImage(ctx, db.CreateEventImageParams{
 		UploadSessionID: params.UploadSessionID,
 		ObjectKey:       params.ObjectKey,
 		UploadUrl:       &params.UploadURL,
@@ -33,7 +33,7 @@ func (r *EventImageRepository) Create(ctx context.Context, params event_images.C
 }
 
 func (r *EventImageRepository) Get(ctx context.Context, id uuid.UUID) (*event_images.EventImage, error) {
-	img, err := r.queries.GetEventImage(ctx, id)
+	img, err := querier(ctx, r.queries).GetEventImage(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, event_images.ErrEventImageNotFound
@@ -46,7 +46,7 @@ func (r *EventImageRepository) Get(ctx context.Context, id uuid.UUID) (*event_im
 }
 
 func (r *EventImageRepository) ListBySession(ctx context.Context, sessionID uuid.UUID) ([]event_images.EventImage, error) {
-	dbImages, err := r.queries.ListEventImagesBySession(ctx, sessionID)
+	dbImages, err := querier(ctx, r.queries).ListEventImagesBySession(ctx, sessionID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "list event images by session")
 	}
@@ -60,7 +60,7 @@ func (r *EventImageRepository) ListBySession(ctx context.Context, sessionID uuid
 }
 
 func (r *EventImageRepository) ListByEvent(ctx context.Context, eventID uuid.UUID) ([]event_images.EventImage, error) {
-	dbImages, err := r.queries.ListEventImagesByEvent(ctx, &eventID)
+	dbImages, err := querier(ctx, r.queries).ListEventImagesByEvent(ctx, &eventID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "list event images by event")
 	}
@@ -74,7 +74,7 @@ func (r *EventImageRepository) ListByEvent(ctx context.Context, eventID uuid.UUI
 }
 
 func (r *EventImageRepository) ConfirmUpload(ctx context.Context, id uuid.UUID, cid string) (*event_images.EventImage, error) {
-	confirmed, err := r.queries.ConfirmEventImageUpload(ctx, db.ConfirmEventImageUploadParams{
+	confirmed, err := querier(ctx, r.queries).ConfirmEventImageUpload(ctx, db.ConfirmEventImageUploadParams{
 		ID:  id,
 		Cid: &cid,
 	})
@@ -90,18 +90,18 @@ func (r *EventImageRepository) ConfirmUpload(ctx context.Context, id uuid.UUID,
 }
 
 func (r *EventImageRepository) AttachToEvent(ctx context.Context, sessionID, eventID uuid.UUID) error {
-	return postgres.WrapError(r.queries.AttachEventImagesToEvent(ctx, db.AttachEventImagesToEventParams{
+	return postgres.WrapError(querier(ctx, r.queries).AttachEventImagesToEvent(ctx, db.AttachEventImagesToEventParams{
 		UploadSessionID: sessionID,
 		EventID:         &eventID,
 	}), "attach event images to event")
 }
 
 func (r *EventImageRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return postgres.WrapError(r.queries.DeleteEventImage(ctx, id), "delete event image")
+	return postgres.WrapError(querier(ctx, r.queries).DeleteEventImage(ctx, id), "delete event image")
 }
 
 func (r *EventImageRepository) CountBySession(ctx context.Context, sessionID uuid.UUID) (int, error) {
-	count, err := r.queries.CountEventImagesBySession(ctx, sessionID)
+	count, err := querier(ctx, r.queries).CountEventImagesBySession(ctx, sessionID)
 	if err != nil {
 		return 0, postgres.WrapError(err, "count event images by session")
 	}
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/ClassicCarsRestore/ClassicsChain/internal/event"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/merkle"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
 	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
 )
@@ -22,7 +24,7 @@ func NewEventRepository(queries db.Querier) *EventRepository {
 }
 
 func (r *EventRepository) GetByVehicle(ctx context.Context, vehicleID uuid.UUID, limit, offset int) ([]event.Event, int, error) {
-	events, err := r.queries.ListEventsByVehicleWithEntity(ctx, vehicleID)
+	events, err := querier(ctx, r.queries).ListEventsByVehicleWithEntity(ctx, vehicleID)
 	if err != nil {
 		return nil, 0, postgres.WrapError(err, "list events by vehicle")
 	}
@@ -45,7 +47,7 @@ func (r *EventRepository) GetByVehicle(ctx context.Context, vehicleID uuid.UUID,
 }
 
 func (r *EventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Event, error) {
-	e, err := r.queries.GetEvent(ctx, id)
+	e, err := querier(ctx, r.queries).GetEvent(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, event.ErrEventNotFound
@@ -57,21 +59,62 @@ func (r *EventRepository) GetByID(ctx context.Context, id uuid.UUID) (*event.Eve
 	return &result, nil
 }
 
+// ListRevisions returns the amendments and revocations of an event, oldest first
+func (r *EventRepository) ListRevisions(ctx context.Context, eventID uuid.UUID) ([]event.Event, error) {
+	revisions, err := querier(ctx, r.queries).ListEventRevisions(ctx, &eventID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list event revisions")
+	}
+
+	result := make([]event.Event, len(revisions))
+	for i, e := range revisions {
+		result[i] = toEventDomain(e)
+	}
+	return result, nil
+}
+
+// ListRevisionsByVehicle returns the amendments and revocations of all events of a vehicle, oldest first
+func (r *EventRepository) ListRevisionsByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]event.Event, error) {
+	revisions, err := querier(ctx, r.queries).ListEventRevisionsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list event revisions by vehicle")
+	}
+
+	result := make([]event.Event, len(revisions))
+	for i, e := range revisions {
+		result[i] = toEventDomain(e)
+	}
+	return result, nil
+}
+
 func (r *EventRepository) Create(ctx context.Context, evt event.Event) (*event.Event, error) {
 	metadataJSON, err := json.Marshal(evt.Metadata)
 	if err != nil {
 		return nil, fmt.Errorf("marshal metadata: %w", err)
 	}
 
-	created, err := r.queries.CreateEvent(ctx, db.CreateEventParams{
-		VehicleID:   evt.VehicleID,
-		EntityID:    evt.EntityID,
-		EventType:   strings.ToLower(string(evt.Type)),
-		Title:       evt.Title,
-		Description: stringToNullable(evt.Description),
-		EventDate:   evt.Date,
-		Location:    stringToNullable(evt.Location),
-		Metadata:    metadataJSON,
+	kind := evt.Kind
+	if kind == "" {
+		kind = event.KindOriginal
+	}
+	approvalStatus := evt.ApprovalStatus
+	if approvalStatus == "" {
+		approvalStatus = event.ApprovalNotRequired
+	}
+
+	created, err := querier(ctx, r.queries).CreateEvent(ctx, db.CreateEventParams{
+		VehicleID:      evt.VehicleID,
+		EntityID:       evt.EntityID,
+		EventType:      strings.ToLower(string(evt.Type)),
+		Title:          evt.Title,
+		Description:    stringToNullable(evt.Description),
+		EventDate:      evt.Date,
+		Location:       stringToNullable(evt.Location),
+		Metadata:       metadataJSON,
+		Kind:           string(kind),
+		RevisesEventID: evt.RevisesEventID,
+		Reason:         evt.Reason,
+		ApprovalStatus: string(approvalStatus),
 	})
 	if err != nil {
 		return nil, postgres.WrapError(err, "create event")
@@ -87,12 +130,25 @@ func (r *EventRepository) Update(ctx context.Context, evt event.Event) error {
 		return fmt.Errorf("marshal metadata: %w", err)
 	}
 
+	var merkleProofJSON []byte
+	if evt.MerkleProof != nil {
+		merkleProofJSON, err = json.Marshal(evt.MerkleProof)
+		if err != nil {
+			return fmt.Errorf("marshal merkle proof: %w", err)
+		}
+	}
+
 	blockchainTxID := ""
 	if evt.BlockchainTxID != nil {
 		blockchainTxID = *evt.BlockchainTxID
 	}
 
-	_, err = r.queries.UpdateEvent(ctx, db.UpdateEventParams{
+	approvalStatus := evt.ApprovalStatus
+	if approvalStatus == "" {
+		approvalStatus = event.ApprovalNotRequired
+	}
+
+	_, err = querier(ctx, r.queries).UpdateEvent(ctx, db.UpdateEventParams{
 		ID:               evt.ID,
 		Title:            evt.Title,
 		Description:      stringToNullable(evt.Description),
@@ -102,8 +158,14 @@ func (r *EventRepository) Update(ctx context.Context, evt event.Event) error {
 		Cid:              evt.CID,
 		CidSourceJson:    evt.CIDSourceJSON,
 		CidSourceCborB64: evt.CIDSourceCBOR,
+		PreviousCid:      evt.PreviousCID,
+		MerkleProof:      merkleProofJSON,
 		BlockchainTxID:   blockchainTxID,
 		BlockchainStatus: evt.BlockchainStatus,
+		BlockchainError:  evt.BlockchainError,
+		BlockchainStatusAt: evt.BlockchainStatusAt,
+		ApprovalStatus:     string(approvalStatus),
+		ApprovalDecidedAt:  timePtrToTimestamptz(evt.ApprovalDecidedAt),
 	})
 	if err != nil {
 		return postgres.WrapError(err, "update event")
@@ -113,8 +175,130 @@ func (r *EventRepository) Update(ctx context.Context, evt event.Event) error {
 	return nil
 }
 
+func (r *EventRepository) ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]event.Event, int, error) {
+	evts, err := querier(ctx, r.queries).ListEventsByBlockchainStatus(ctx, db.ListEventsByBlockchainStatusParams{
+		BlockchainStatus: status,
+		OlderThanSeconds: olderThan.Seconds(),
+		Limit:            int32(limit),
+		Offset:           int32(offset),
+	})
+	if err != nil {
+		return nil, 0, postgres.WrapError(err, "list events by blockchain status")
+	}
+
+	total, err := querier(ctx, r.queries).CountEventsByBlockchainStatus(ctx, db.CountEventsByBlockchainStatusParams{
+		BlockchainStatus: status,
+		OlderThanSeconds: olderThan.Seconds(),
+	})
+	if err != nil {
+		return nil, 0, postgres.WrapError(err, "count events by blockchain status")
+	}
+
+	result := make([]event.Event, len(evts))
+	for i, e := range evts {
+		result[i] = toEventDomain(e)
+	}
+
+	return result, int(total), nil
+}
+
+// LockChainHead locks the event's vehicle for the rest of the transaction and returns the CID of
+// the latest record in its chain, falling back to the genesis CID
+func (r *EventRepository) LockChainHead(ctx context.Context, vehicleID uuid.UUID) (*string, error) {
+	row, err := querier(ctx, r.queries).LockVehicleChainHead(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "lock vehicle chain head")
+	}
+
+	if row.ChainHeadCid != nil {
+		return row.ChainHeadCid, nil
+	}
+	return row.Cid, nil
+}
+
+// SetGenesisCID stores the genesis CID of a vehicle that has none yet and makes it the chain head
+func (r *EventRepository) SetGenesisCID(ctx context.Context, vehicleID uuid.UUID, cid, sourceJSON, sourceCBOR string) error {
+	err := querier(ctx, r.queries).SetVehicleGenesisCID(ctx, db.SetVehicleGenesisCIDParams{
+		ID:               vehicleID,
+		Cid:              &cid,
+		CidSourceJson:    &sourceJSON,
+		CidSourceCborB64: &sourceCBOR,
+	})
+	return postgres.WrapError(err, "set vehicle genesis cid")
+}
+
+// SetChainHead records cid as the latest record in the vehicle's chain
+func (r *EventRepository) SetChainHead(ctx context.Context, vehicleID uuid.UUID, cid string) error {
+	err := querier(ctx, r.queries).SetVehicleChainHead(ctx, db.SetVehicleChainHeadParams{
+		ID:           vehicleID,
+		ChainHeadCid: &cid,
+	})
+	return postgres.WrapError(err, "set vehicle chain head")
+}
+
+// SetSignature stores the issuing entity's signature over the event's CID
+func (r *EventRepository) SetSignature(ctx context.Context, eventID, keyID uuid.UUID, signature string) error {
+	err := querier(ctx, r.queries).SetEventSignature(ctx, db.SetEventSignatureParams{
+		ID:           eventID,
+		SigningKeyID: &keyID,
+		Signature:    &signature,
+	})
+	return postgres.WrapError(err, "set event signature")
+}
+
+// DecideProposal records the owner's decision on a proposed event. It returns
+// event.ErrEventNotProposed when the event is no longer awaiting a decision.
+func (r *EventRepository) DecideProposal(ctx context.Context, id uuid.UUID, status event.ApprovalStatus, decidedAt time.Time) error {
+	rows, err := querier(ctx, r.queries).DecideEventProposal(ctx, db.DecideEventProposalParams{
+		ID:                id,
+		ApprovalStatus:    string(status),
+		ApprovalDecidedAt: timePtrToTimestamptz(&decidedAt),
+	})
+	if err != nil {
+		return postgres.WrapError(err, "decide event proposal")
+	}
+	if rows == 0 {
+		return event.ErrEventNotProposed
+	}
+	return nil
+}
+
+// UpdateDetails updates the editable fields of an event that was never submitted for anchoring.
+// It returns event.ErrEventImmutable when the event has a CID.
+func (r *EventRepository) UpdateDetails(ctx context.Context, evt event.Event) error {
+	metadataJSON, err := json.Marshal(evt.Metadata)
+	if err != nil {
+		return fmt.Errorf("marshal metadata: %w", err)
+	}
+
+	rows, err := querier(ctx, r.queries).UpdateUnanchoredEventDetails(ctx, db.UpdateUnanchoredEventDetailsParams{
+		ID:          evt.ID,
+		Title:       evt.Title,
+		Description: stringToNullable(evt.Description),
+		EventDate:   evt.Date,
+		Location:    stringToNullable(evt.Location),
+		Metadata:    metadataJSON,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "update event details")
+	}
+	if rows == 0 {
+		return event.ErrEventImmutable
+	}
+	return nil
+}
+
+// Delete deletes an event that was never submitted for anchoring. It returns
+// event.ErrEventImmutable when the event has a CID.
 func (r *EventRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return postgres.WrapError(r.queries.DeleteEvent(ctx, id), "delete event")
+	rows, err := querier(ctx, r.queries).DeleteEvent(ctx, id)
+	if err != nil {
+		return postgres.WrapError(err, "delete event")
+	}
+	if rows == 0 {
+		return event.ErrEventImmutable
+	}
+	return nil
 }
 
 func toEventDomain(e db.Event) event.Event {
@@ -135,10 +319,22 @@ func toEventDomain(e db.Event) event.Event {
 		Metadata:       metadata,
 		BlockchainTxID:   nullableToStringPtr(e.BlockchainTxID),
 		BlockchainStatus: e.BlockchainStatus,
+		BlockchainError:  e.BlockchainError,
+		BlockchainStatusAt: e.BlockchainStatusAt,
 		CID:              e.Cid,
 		CIDSourceJSON:    e.CidSourceJson,
 		CIDSourceCBOR:    e.CidSourceCborB64,
+		PreviousCID:      e.PreviousCid,
+		MerkleProof:      toMerkleProof(e.MerkleProof),
 		CreatedAt:        e.CreatedAt.Time,
+		Kind:             event.Kind(e.Kind),
+		RevisesEventID:   e.RevisesEventID,
+		Reason:           e.Reason,
+		ApprovalStatus:    event.ApprovalStatus(e.ApprovalStatus),
+		ApprovalDecidedAt: timestamptzToTimePtr(e.ApprovalDecidedAt),
+		SigningKeyID:      e.SigningKeyID,
+		Signature:         e.Signature,
+		SignedAt:          timestamptzToTimePtr(e.SignedAt),
 	}
 }
 
@@ -162,9 +358,33 @@ func toEventDomainWithEntity(e db.ListEventsByVehicleWithEntityRow) event.Event
 		Metadata:            metadata,
 		BlockchainTxID:      nullableToStringPtr(e.BlockchainTxID),
 		BlockchainStatus:    e.BlockchainStatus,
+		BlockchainError:     e.BlockchainError,
+		BlockchainStatusAt:  e.BlockchainStatusAt,
 		CID:                 e.Cid,
 		CIDSourceJSON:       e.CidSourceJson,
 		CIDSourceCBOR:       e.CidSourceCborB64,
+		PreviousCID:         e.PreviousCid,
+		MerkleProof:         toMerkleProof(e.MerkleProof),
 		CreatedAt:           e.CreatedAt.Time,
+		Kind:                event.Kind(e.Kind),
+		RevisesEventID:      e.RevisesEventID,
+		Reason:              e.Reason,
+		ApprovalStatus:      event.ApprovalStatus(e.ApprovalStatus),
+		ApprovalDecidedAt:   timestamptzToTimePtr(e.ApprovalDecidedAt),
+		SigningKeyID:        e.SigningKeyID,
+		Signature:           e.Signature,
+		SignedAt:            timestamptzToTimePtr(e.SignedAt),
+	}
+}
+
+func toMerkleProof(data []byte) *merkle.Proof {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var proof merkle.Proof
+	if err := json.Unmarshal(data, &proof); err != nil {
+		return nil
 	}
+	return &proof
 }
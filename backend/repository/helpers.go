@@ -1,5 +1,11 @@
 package repository
 
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
 // stringToNullable converts a string pointer to an empty string if nil
 func stringToNullable(s *string) string {
 	if s == nil {
@@ -41,3 +47,53 @@ func nullableToIntPtr(i *int32) *int {
 	v := int(*i)
 	return &v
 }
+
+// int64ToUint64Ptr converts *int64 to *uint64
+func int64ToUint64Ptr(i *int64) *uint64 {
+	if i == nil {
+		return nil
+	}
+	v := uint64(*i)
+	return &v
+}
+
+// uint64ToInt64Ptr converts *uint64 to *int64
+func uint64ToInt64Ptr(u *uint64) *int64 {
+	if u == nil {
+		return nil
+	}
+	v := int64(*u)
+	return &v
+}
+
+// timestamptzToTimePtr converts a nullable timestamptz to *time.Time
+func timestamptzToTimePtr(ts pgtype.Timestamptz) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	return &ts.Time
+}
+
+// timePtrToTimestamptz converts *time.Time to a nullable timestamptz
+func timePtrToTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+// dateToTimePtr converts a nullable date to *time.Time
+func dateToTimePtr(d pgtype.Date) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
+// timePtrToDate converts *time.Time to a nullable date
+func timePtrToDate(t *time.Time) pgtype.Date {
+	if t == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *t, Valid: true}
+}
@@ -20,7 +20,7 @@ func NewInvitationRepository(queries db.Querier) *InvitationRepository {
 }
 
 func (r *InvitationRepository) CreateInvitation(ctx context.Context, params invitation.CreateInvitationParams) (*invitation.Invitation, error) {
-	inv, err := r.queries.CreateInvitation(ctx, db.CreateInvitationParams{
+	inv, err := querier(ctx, r.queries).CreateInvitation(ctx, db.CreateInvitationParams{
 		VehicleID:      params.VehicleID,
 		Email:          params.Email,
 		Token:          &params.Token,
@@ -41,7 +41,7 @@ func (r *InvitationRepository) CreateInvitation(ctx context.Context, params invi
 }
 
 func (r *InvitationRepository) GetPendingInvitationsByEmail(ctx context.Context, email string) ([]invitation.Invitation, error) {
-	invs, err := r.queries.GetPendingInvitationsByEmail(ctx, email)
+	invs, err := querier(ctx, r.queries).GetPendingInvitationsByEmail(ctx, email)
 	if err != nil {
 		return nil, postgres.WrapError(err, "get pending invitations by email")
 	}
@@ -60,7 +60,7 @@ func (r *InvitationRepository) GetPendingInvitationsByEmail(ctx context.Context,
 }
 
 func (r *InvitationRepository) GetInvitationsByEmailAndVehicle(ctx context.Context, email string, vehicleIDs []uuid.UUID) ([]invitation.Invitation, error) {
-	invs, err := r.queries.GetInvitationsByEmailAndVehicle(ctx, db.GetInvitationsByEmailAndVehicleParams{
+	invs, err := querier(ctx, r.queries).GetInvitationsByEmailAndVehicle(ctx, db.GetInvitationsByEmailAndVehicleParams{
 		Email:   email,
 		Column2: vehicleIDs,
 	})
@@ -81,7 +81,7 @@ func (r *InvitationRepository) GetInvitationsByEmailAndVehicle(ctx context.Conte
 }
 
 func (r *InvitationRepository) ClaimInvitation(ctx context.Context, invitationID uuid.UUID) (*invitation.Invitation, error) {
-	inv, err := r.queries.ClaimInvitation(ctx, invitationID)
+	inv, err := querier(ctx, r.queries).ClaimInvitation(ctx, invitationID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "claim invitation")
 	}
@@ -95,7 +95,7 @@ func (r *InvitationRepository) ClaimInvitation(ctx context.Context, invitationID
 }
 
 func (r *InvitationRepository) ClaimInvitationsByEmail(ctx context.Context, email string) error {
-	err := r.queries.ClaimInvitationsByEmail(ctx, email)
+	err := querier(ctx, r.queries).ClaimInvitationsByEmail(ctx, email)
 	if err != nil {
 		return postgres.WrapError(err, "claim invitations by email")
 	}
@@ -103,7 +103,7 @@ func (r *InvitationRepository) ClaimInvitationsByEmail(ctx context.Context, emai
 }
 
 func (r *InvitationRepository) DeleteInvitation(ctx context.Context, id uuid.UUID) error {
-	err := r.queries.DeleteInvitation(ctx, id)
+	err := querier(ctx, r.queries).DeleteInvitation(ctx, id)
 	if err != nil {
 		return postgres.WrapError(err, "delete invitation")
 	}
@@ -111,7 +111,7 @@ func (r *InvitationRepository) DeleteInvitation(ctx context.Context, id uuid.UUI
 }
 
 func (r *InvitationRepository) GetInvitationByID(ctx context.Context, id uuid.UUID) (*invitation.Invitation, error) {
-	inv, err := r.queries.GetInvitationByID(ctx, id)
+	inv, err := querier(ctx, r.queries).GetInvitationByID(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, invitation.ErrInvitationNotFound
@@ -128,7 +128,7 @@ func (r *InvitationRepository) GetInvitationByID(ctx context.Context, id uuid.UU
 }
 
 func (r *InvitationRepository) GetAllPendingInvitations(ctx context.Context) ([]invitation.Invitation, error) {
-	invs, err := r.queries.GetAllPendingInvitations(ctx)
+	invs, err := querier(ctx, r.queries).GetAllPendingInvitations(ctx)
 	if err != nil {
 		return nil, postgres.WrapError(err, "get all pending invitations")
 	}
@@ -148,7 +148,7 @@ func (r *InvitationRepository) GetAllPendingInvitations(ctx context.Context) ([]
 }
 
 func (r *InvitationRepository) GetInvitationByToken(ctx context.Context, token string) (*invitation.Invitation, error) {
-	inv, err := r.queries.GetInvitationByToken(ctx, &token)
+	inv, err := querier(ctx, r.queries).GetInvitationByToken(ctx, &token)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, invitation.ErrInvitationNotFound
@@ -168,7 +168,7 @@ func (r *InvitationRepository) GetInvitationByToken(ctx context.Context, token s
 }
 
 func (r *InvitationRepository) GetPendingInvitationByVehicleID(ctx context.Context, vehicleID uuid.UUID) (*invitation.Invitation, error) {
-	inv, err := r.queries.GetPendingInvitationByVehicleID(ctx, vehicleID)
+	inv, err := querier(ctx, r.queries).GetPendingInvitationByVehicleID(ctx, vehicleID)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, nil
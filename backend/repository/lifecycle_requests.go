@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/lifecycle"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type LifecycleRequestRepository struct {
+	queries db.Querier
+}
+
+func NewLifecycleRequestRepository(queries db.Querier) *LifecycleRequestRepository {
+	return &LifecycleRequestRepository{queries: queries}
+}
+
+func (r *LifecycleRequestRepository) Create(ctx context.Context, params lifecycle.CreateRequestParams) (*lifecycle.Request, error) {
+	req, err := querier(ctx, r.queries).CreateLifecycleRequest(ctx, db.CreateLifecycleRequestParams{
+		VehicleID:   params.VehicleID,
+		FromStatus:  params.FromStatus,
+		ToStatus:    params.ToStatus,
+		Reason:      params.Reason,
+		RequestedBy: params.RequestedBy,
+		EntityID:    params.EntityID,
+	})
+	if err != nil {
+		err = postgres.WrapError(err, "create lifecycle request")
+		if errors.Is(err, postgres.ErrDuplicateKey) {
+			return nil, lifecycle.ErrRequestAlreadyPending
+		}
+		return nil, err
+	}
+
+	result := toLifecycleRequestDomain(req)
+	return &result, nil
+}
+
+func (r *LifecycleRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*lifecycle.Request, error) {
+	req, err := querier(ctx, r.queries).GetLifecycleRequest(ctx, id)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, lifecycle.ErrRequestNotFound
+		}
+		return nil, postgres.WrapError(err, "get lifecycle request")
+	}
+
+	result := toLifecycleRequestDomain(req)
+	return &result, nil
+}
+
+func (r *LifecycleRequestRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]lifecycle.Request, error) {
+	reqs, err := querier(ctx, r.queries).ListLifecycleRequestsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list lifecycle requests by vehicle")
+	}
+	return toLifecycleRequestsDomain(reqs), nil
+}
+
+func (r *LifecycleRequestRepository) List(ctx context.Context, entityID *uuid.UUID, status string) ([]lifecycle.Request, error) {
+	reqs, err := querier(ctx, r.queries).ListLifecycleRequests(ctx, db.ListLifecycleRequestsParams{
+		EntityID: entityID,
+		Status:   nullableToStringPtr(status),
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "list lifecycle requests")
+	}
+	return toLifecycleRequestsDomain(reqs), nil
+}
+
+func (r *LifecycleRequestRepository) ListByAssetStatus(ctx context.Context, assetStatus string, olderThan time.Duration, limit int) ([]lifecycle.Request, error) {
+	reqs, err := querier(ctx, r.queries).ListLifecycleRequestsByAssetStatus(ctx, db.ListLifecycleRequestsByAssetStatusParams{
+		AssetStatus:      &assetStatus,
+		OlderThanSeconds: olderThan.Seconds(),
+		Limit:            int32(limit),
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "list lifecycle requests by asset status")
+	}
+	return toLifecycleRequestsDomain(reqs), nil
+}
+
+func (r *LifecycleRequestRepository) Decide(ctx context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*lifecycle.Request, error) {
+	req, err := querier(ctx, r.queries).DecideLifecycleRequest(ctx, db.DecideLifecycleRequestParams{
+		ID:            id,
+		Status:        status,
+		DecidedBy:     &decidedBy,
+		DeclineReason: declineReason,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, lifecycle.ErrRequestNotPending
+		}
+		return nil, postgres.WrapError(err, "decide lifecycle request")
+	}
+
+	result := toLifecycleRequestDomain(req)
+	return &result, nil
+}
+
+func (r *LifecycleRequestRepository) SetEvent(ctx context.Context, id, eventID uuid.UUID, assetStatus *string) error {
+	err := querier(ctx, r.queries).SetLifecycleRequestEvent(ctx, db.SetLifecycleRequestEventParams{
+		ID:          id,
+		EventID:     &eventID,
+		AssetStatus: assetStatus,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "set lifecycle request event")
+	}
+	return nil
+}
+
+func (r *LifecycleRequestRepository) SetAssetStatus(ctx context.Context, id uuid.UUID, assetStatus string, txID, assetError *string) error {
+	err := querier(ctx, r.queries).SetLifecycleRequestAssetStatus(ctx, db.SetLifecycleRequestAssetStatusParams{
+		ID:          id,
+		AssetStatus: &assetStatus,
+		AssetTxID:   txID,
+		AssetError:  assetError,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "set lifecycle request asset status")
+	}
+	return nil
+}
+
+func toLifecycleRequestsDomain(reqs []db.VehicleLifecycleRequest) []lifecycle.Request {
+	result := make([]lifecycle.Request, len(reqs))
+	for i, req := range reqs {
+		result[i] = toLifecycleRequestDomain(req)
+	}
+	return result
+}
+
+func toLifecycleRequestDomain(r db.VehicleLifecycleRequest) lifecycle.Request {
+	return lifecycle.Request{
+		ID:            r.ID,
+		VehicleID:     r.VehicleID,
+		FromStatus:    r.FromStatus,
+		ToStatus:      r.ToStatus,
+		Reason:        r.Reason,
+		RequestedBy:   r.RequestedBy,
+		EntityID:      r.EntityID,
+		Status:        r.Status,
+		DecidedBy:     r.DecidedBy,
+		DeclineReason: r.DeclineReason,
+		EventID:       r.EventID,
+		AssetStatus:   r.AssetStatus,
+		AssetTxID:     r.AssetTxID,
+		AssetError:    r.AssetError,
+		CreatedAt:     r.CreatedAt,
+		DecidedAt:     timestamptzToTimePtr(r.DecidedAt),
+	}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/outbox"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+)
+
+// OutboxRepository stores queue messages in the outbox table. It implements
+// queue.Publisher so services can enqueue messages in the same transaction as
+// the rows they refer to.
+type OutboxRepository struct {
+	queries db.Querier
+}
+
+func NewOutboxRepository(queries db.Querier) *OutboxRepository {
+	return &OutboxRepository{queries: queries}
+}
+
+// Publish writes the message to the outbox; the relay delivers it to the queue later
+func (r *OutboxRepository) Publish(ctx context.Context, subject string, data []byte) error {
+	err := querier(ctx, r.queries).CreateOutboxMessage(ctx, db.CreateOutboxMessageParams{
+		Subject: subject,
+		Payload: data,
+	})
+	return postgres.WrapError(err, "create outbox message")
+}
+
+func (r *OutboxRepository) Close() error {
+	return nil
+}
+
+// ClaimPending locks up to limit messages due for publishing. Rows locked by another relay are
+// skipped, and the locks are held until the surrounding transaction ends.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, limit int) ([]outbox.Message, error) {
+	rows, err := querier(ctx, r.queries).ClaimPendingOutboxMessages(ctx, int32(limit))
+	if err != nil {
+		return nil, postgres.WrapError(err, "claim pending outbox messages")
+	}
+
+	result := make([]outbox.Message, len(rows))
+	for i, row := range rows {
+		result[i] = outbox.Message{
+			ID:        row.ID,
+			Subject:   row.Subject,
+			Payload:   row.Payload,
+			Attempts:  int(row.Attempts),
+			LastError: row.LastError,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id int64) error {
+	err := querier(ctx, r.queries).MarkOutboxMessagePublished(ctx, id)
+	return postgres.WrapError(err, "mark outbox message published")
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, lastError string, retryAt time.Time) error {
+	err := querier(ctx, r.queries).MarkOutboxMessageFailed(ctx, db.MarkOutboxMessageFailedParams{
+		ID:            id,
+		LastError:     &lastError,
+		NextAttemptAt: retryAt,
+	})
+	return postgres.WrapError(err, "mark outbox message failed")
+}
+
+func (r *OutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int, error) {
+	n, err := querier(ctx, r.queries).DeletePublishedOutboxMessages(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return 0, postgres.WrapError(err, "delete published outbox messages")
+	}
+	return int(n), nil
+}
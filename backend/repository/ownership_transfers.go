@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/transfer"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type OwnershipTransferRepository struct {
+	queries db.Querier
+}
+
+func NewOwnershipTransferRepository(queries db.Querier) *OwnershipTransferRepository {
+	return &OwnershipTransferRepository{queries: queries}
+}
+
+func (r *OwnershipTransferRepository) Create(ctx context.Context, params transfer.CreateTransferParams) (*transfer.Transfer, error) {
+	t, err := querier(ctx, r.queries).CreateOwnershipTransfer(ctx, db.CreateOwnershipTransferParams{
+		VehicleID:      params.VehicleID,
+		FromOwnerID:    params.FromOwnerID,
+		ToEmail:        params.ToEmail,
+		Token:          params.Token,
+		TokenExpiresAt: params.TokenExpiresAt,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create ownership transfer")
+	}
+
+	result := toTransferDomain(t)
+	return &result, nil
+}
+
+func (r *OwnershipTransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	t, err := querier(ctx, r.queries).GetOwnershipTransfer(ctx, id)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, transfer.ErrTransferNotFound
+		}
+		return nil, postgres.WrapError(err, "get ownership transfer")
+	}
+
+	result := toTransferDomain(t)
+	return &result, nil
+}
+
+func (r *OwnershipTransferRepository) GetByToken(ctx context.Context, token string) (*transfer.Transfer, error) {
+	t, err := querier(ctx, r.queries).GetOwnershipTransferByToken(ctx, token)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, transfer.ErrTransferNotFound
+		}
+		return nil, postgres.WrapError(err, "get ownership transfer by token")
+	}
+
+	result := toTransferDomain(t)
+	return &result, nil
+}
+
+func (r *OwnershipTransferRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]transfer.Transfer, error) {
+	transfers, err := querier(ctx, r.queries).ListOwnershipTransfersByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list ownership transfers")
+	}
+
+	result := make([]transfer.Transfer, len(transfers))
+	for i, t := range transfers {
+		result[i] = toTransferDomain(t)
+	}
+	return result, nil
+}
+
+func (r *OwnershipTransferRepository) Accept(ctx context.Context, id, toOwnerID uuid.UUID) (*transfer.Transfer, error) {
+	t, err := querier(ctx, r.queries).AcceptOwnershipTransfer(ctx, db.AcceptOwnershipTransferParams{
+		ID:        id,
+		ToOwnerID: &toOwnerID,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, transfer.ErrTransferNotPending
+		}
+		return nil, postgres.WrapError(err, "accept ownership transfer")
+	}
+
+	result := toTransferDomain(t)
+	return &result, nil
+}
+
+func (r *OwnershipTransferRepository) Cancel(ctx context.Context, id uuid.UUID) (*transfer.Transfer, error) {
+	t, err := querier(ctx, r.queries).CancelOwnershipTransfer(ctx, id)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, transfer.ErrTransferNotPending
+		}
+		return nil, postgres.WrapError(err, "cancel ownership transfer")
+	}
+
+	result := toTransferDomain(t)
+	return &result, nil
+}
+
+func (r *OwnershipTransferRepository) CancelPending(ctx context.Context, vehicleID uuid.UUID) error {
+	if err := querier(ctx, r.queries).CancelPendingOwnershipTransfers(ctx, vehicleID); err != nil {
+		return postgres.WrapError(err, "cancel pending ownership transfers")
+	}
+	return nil
+}
+
+func (r *OwnershipTransferRepository) SetEvent(ctx context.Context, id, eventID uuid.UUID) error {
+	err := querier(ctx, r.queries).SetOwnershipTransferEvent(ctx, db.SetOwnershipTransferEventParams{
+		ID:      id,
+		EventID: &eventID,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "set ownership transfer event")
+	}
+	return nil
+}
+
+func toTransferDomain(t db.VehicleOwnershipTransfer) transfer.Transfer {
+	return transfer.Transfer{
+		ID:             t.ID,
+		VehicleID:      t.VehicleID,
+		FromOwnerID:    t.FromOwnerID,
+		ToEmail:        t.ToEmail,
+		ToOwnerID:      t.ToOwnerID,
+		Token:          t.Token,
+		TokenExpiresAt: t.TokenExpiresAt,
+		Status:         t.Status,
+		EventID:        t.EventID,
+		CreatedAt:      t.CreatedAt,
+		CompletedAt:    timestamptzToTimePtr(t.CompletedAt),
+	}
+}
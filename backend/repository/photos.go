@@ -19,7 +19,7 @@ func NewPhotoRepository(queries db.Querier) *PhotoRepository {
 }
 
 func (r *PhotoRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]photos.Photo, error) {
-	dbPhotos, err := r.queries.ListPhotosByVehicle(ctx, vehicleID)
+	dbPhotos, err := querier(ctx, r.queries).ListPhotosByVehicle(ctx, vehicleID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "list photos by vehicle")
 	}
@@ -33,7 +33,7 @@ func (r *PhotoRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID
 }
 
 func (r *PhotoRepository) Get(ctx context.Context, id uuid.UUID) (*photos.Photo, error) {
-	p, err := r.queries.GetPhoto(ctx, id)
+	p, err := querier(ctx, r.queries).GetPhoto(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, photos.ErrPhotoNotFound
@@ -46,7 +46,7 @@ func (r *PhotoRepository) Get(ctx context.Context, id uuid.UUID) (*photos.Photo,
 }
 
 func (r *PhotoRepository) GetByKey(ctx context.Context, vehicleID uuid.UUID, objectKey string) (*photos.Photo, error) {
-	p, err := r.queries.GetPhotoByKey(ctx, db.GetPhotoByKeyParams{
+	p, err := querier(ctx, r.queries).GetPhotoByKey(ctx, db.GetPhotoByKeyParams{
 		VehicleID: vehicleID,
 		ObjectKey: objectKey,
 	})
@@ -62,7 +62,7 @@ func (r *PhotoRepository) GetByKey(ctx context.Context, vehicleID uuid.UUID, obj
 }
 
 func (r *PhotoRepository) Create(ctx context.Context, params photos.CreatePhotoParams) (*photos.Photo, error) {
-	created, err := r.queries.CreatePhoto(ctx, db.CreatePhotoParams{
+	created, err := querier(ctx, r.queries).CreatePhoto(ctx, db.CreatePhotoParams{
 		VehicleID: params.VehicleID,
 		ObjectKey: params.ObjectKey,
 		UploadUrl: &params.UploadURL,
@@ -76,7 +76,7 @@ func (r *PhotoRepository) Create(ctx context.Context, params photos.CreatePhotoP
 }
 
 func (r *PhotoRepository) ConfirmUpload(ctx context.Context, id uuid.UUID) (*photos.Photo, error) {
-	confirmed, err := r.queries.ConfirmPhotoUpload(ctx, id)
+	confirmed, err := querier(ctx, r.queries).ConfirmPhotoUpload(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, photos.ErrPhotoNotFound
@@ -89,11 +89,11 @@ func (r *PhotoRepository) ConfirmUpload(ctx context.Context, id uuid.UUID) (*pho
 }
 
 func (r *PhotoRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return postgres.WrapError(r.queries.DeletePhoto(ctx, id), "delete photo")
+	return postgres.WrapError(querier(ctx, r.queries).DeletePhoto(ctx, id), "delete photo")
 }
 
 func (r *PhotoRepository) CountByVehicle(ctx context.Context, vehicleID uuid.UUID) (int, error) {
-	count, err := r.queries.CountPhotosByVehicle(ctx, vehicleID)
+	count, err := querier(ctx, r.queries).CountPhotosByVehicle(ctx, vehicleID)
 	if err != nil {
 		return 0, postgres.WrapError(err, "count photos by vehicle")
 	}
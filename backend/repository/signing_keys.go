@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/signing_keys"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type SigningKeyRepository struct {
+	queries db.Querier
+}
+
+func NewSigningKeyRepository(queries db.Querier) *SigningKeyRepository {
+	return &SigningKeyRepository{queries: queries}
+}
+
+func (r *SigningKeyRepository) Create(ctx context.Context, params signing_keys.CreateParams) (*signing_keys.Key, error) {
+	k, err := querier(ctx, r.queries).CreateEntitySigningKey(ctx, db.CreateEntitySigningKeyParams{
+		EntityID:         params.EntityID,
+		Label:            params.Label,
+		PublicKey:        params.PublicKey,
+		SealedPrivateKey: params.SealedPrivateKey,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create signing key")
+	}
+
+	key := toSigningKeyDomain(k)
+	return &key, nil
+}
+
+func (r *SigningKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*signing_keys.Key, error) {
+	k, err := querier(ctx, r.queries).GetEntitySigningKey(ctx, id)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, signing_keys.ErrKeyNotFound
+		}
+		return nil, postgres.WrapError(err, "get signing key")
+	}
+
+	key := toSigningKeyDomain(k)
+	return &key, nil
+}
+
+func (r *SigningKeyRepository) GetByPublicKey(ctx context.Context, publicKey string) (*signing_keys.Key, error) {
+	k, err := querier(ctx, r.queries).GetEntitySigningKeyByPublicKey(ctx, publicKey)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, signing_keys.ErrKeyNotFound
+		}
+		return nil, postgres.WrapError(err, "get signing key by public key")
+	}
+
+	key := toSigningKeyDomain(k)
+	return &key, nil
+}
+
+func (r *SigningKeyRepository) ListByEntity(ctx context.Context, entityID uuid.UUID) ([]signing_keys.Key, error) {
+	keys, err := querier(ctx, r.queries).ListEntitySigningKeysByEntity(ctx, entityID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list signing keys by entity")
+	}
+
+	result := make([]signing_keys.Key, len(keys))
+	for i, k := range keys {
+		result[i] = toSigningKeyDomain(k)
+	}
+	return result, nil
+}
+
+func (r *SigningKeyRepository) GetActiveManaged(ctx context.Context, entityID uuid.UUID) (*signing_keys.Key, string, error) {
+	k, err := querier(ctx, r.queries).GetActiveManagedSigningKey(ctx, entityID)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, "", signing_keys.ErrKeyNotFound
+		}
+		return nil, "", postgres.WrapError(err, "get active managed signing key")
+	}
+
+	key := toSigningKeyDomain(k)
+	return &key, *k.SealedPrivateKey, nil
+}
+
+func (r *SigningKeyRepository) Revoke(ctx context.Context, entityID, id uuid.UUID) (*signing_keys.Key, error) {
+	k, err := querier(ctx, r.queries).RevokeEntitySigningKey(ctx, db.RevokeEntitySigningKeyParams{
+		EntityID: entityID,
+		ID:       id,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, signing_keys.ErrKeyNotFound
+		}
+		return nil, postgres.WrapError(err, "revoke signing key")
+	}
+
+	key := toSigningKeyDomain(k)
+	return &key, nil
+}
+
+func toSigningKeyDomain(k db.EntitySigningKey) signing_keys.Key {
+	return signing_keys.Key{
+		ID:        k.ID,
+		EntityID:  k.EntityID,
+		Label:     k.Label,
+		Algorithm: k.Algorithm,
+		PublicKey: k.PublicKey,
+		Managed:   k.SealedPrivateKey != nil,
+		CreatedAt: k.CreatedAt,
+		RevokedAt: timestamptzToTimePtr(k.RevokedAt),
+	}
+}
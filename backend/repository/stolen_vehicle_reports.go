@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/stolen_reports"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+type StolenVehicleReportRepository struct {
+	queries db.Querier
+}
+
+func NewStolenVehicleReportRepository(queries db.Querier) *StolenVehicleReportRepository {
+	return &StolenVehicleReportRepository{queries: queries}
+}
+
+func (r *StolenVehicleReportRepository) Create(ctx context.Context, params stolen_reports.CreateReportParams) (*stolen_reports.Report, error) {
+	report, err := querier(ctx, r.queries).CreateStolenVehicleReport(ctx, db.CreateStolenVehicleReportParams{
+		VehicleID:       params.VehicleID,
+		Kind:            params.Kind,
+		PoliceReference: params.PoliceReference,
+		PoliceAuthority: params.PoliceAuthority,
+		IncidentDate:    timePtrToDate(params.IncidentDate),
+		Location:        params.Location,
+		Description:     params.Description,
+		ReportedBy:      params.ReportedBy,
+	})
+	if err != nil {
+		err = postgres.WrapError(err, "create stolen vehicle report")
+		if errors.Is(err, postgres.ErrDuplicateKey) {
+			return nil, stolen_reports.ErrReportAlreadyOpen
+		}
+		return nil, err
+	}
+
+	result := toStolenVehicleReportDomain(report)
+	return &result, nil
+}
+
+func (r *StolenVehicleReportRepository) GetByID(ctx context.Context, id uuid.UUID) (*stolen_reports.Report, error) {
+	report, err := querier(ctx, r.queries).GetStolenVehicleReport(ctx, id)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, stolen_reports.ErrReportNotFound
+		}
+		return nil, postgres.WrapError(err, "get stolen vehicle report")
+	}
+
+	result := toStolenVehicleReportDomain(report)
+	return &result, nil
+}
+
+func (r *StolenVehicleReportRepository) GetApprovedByVehicle(ctx context.Context, vehicleID uuid.UUID) (*stolen_reports.Report, error) {
+	report, err := querier(ctx, r.queries).GetApprovedStolenVehicleReport(ctx, vehicleID)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, stolen_reports.ErrReportNotFound
+		}
+		return nil, postgres.WrapError(err, "get approved stolen vehicle report")
+	}
+
+	result := toStolenVehicleReportDomain(report)
+	return &result, nil
+}
+
+func (r *StolenVehicleReportRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]stolen_reports.Report, error) {
+	reports, err := querier(ctx, r.queries).ListStolenVehicleReportsByVehicle(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list stolen vehicle reports by vehicle")
+	}
+	return toStolenVehicleReportsDomain(reports), nil
+}
+
+func (r *StolenVehicleReportRepository) List(ctx context.Context, status string) ([]stolen_reports.Report, error) {
+	reports, err := querier(ctx, r.queries).ListStolenVehicleReports(ctx, nullableToStringPtr(status))
+	if err != nil {
+		return nil, postgres.WrapError(err, "list stolen vehicle reports")
+	}
+	return toStolenVehicleReportsDomain(reports), nil
+}
+
+func (r *StolenVehicleReportRepository) Decide(ctx context.Context, id uuid.UUID, status string, decidedBy uuid.UUID, declineReason *string) (*stolen_reports.Report, error) {
+	report, err := querier(ctx, r.queries).DecideStolenVehicleReport(ctx, db.DecideStolenVehicleReportParams{
+		ID:            id,
+		Status:        status,
+		DecidedBy:     &decidedBy,
+		DeclineReason: declineReason,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, stolen_reports.ErrReportNotPending
+		}
+		return nil, postgres.WrapError(err, "decide stolen vehicle report")
+	}
+
+	result := toStolenVehicleReportDomain(report)
+	return &result, nil
+}
+
+func (r *StolenVehicleReportRepository) SetLifecycleRequest(ctx context.Context, id, lifecycleRequestID uuid.UUID) error {
+	err := querier(ctx, r.queries).SetStolenVehicleReportLifecycleRequest(ctx, db.SetStolenVehicleReportLifecycleRequestParams{
+		ID:                 id,
+		LifecycleRequestID: &lifecycleRequestID,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "set stolen vehicle report lifecycle request")
+	}
+	return nil
+}
+
+func (r *StolenVehicleReportRepository) Resolve(ctx context.Context, id, resolvedBy uuid.UUID, note *string) (*stolen_reports.Report, error) {
+	report, err := querier(ctx, r.queries).ResolveStolenVehicleReport(ctx, db.ResolveStolenVehicleReportParams{
+		ID:             id,
+		ResolvedBy:     &resolvedBy,
+		ResolutionNote: note,
+	})
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, stolen_reports.ErrReportNotApproved
+		}
+		return nil, postgres.WrapError(err, "resolve stolen vehicle report")
+	}
+
+	result := toStolenVehicleReportDomain(report)
+	return &result, nil
+}
+
+func (r *StolenVehicleReportRepository) ListIssuingEntities(ctx context.Context, vehicleID uuid.UUID) ([]uuid.UUID, error) {
+	entityIDs, err := querier(ctx, r.queries).ListVehicleEventEntities(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list vehicle event entities")
+	}
+	return entityIDs, nil
+}
+
+func toStolenVehicleReportsDomain(reports []db.StolenVehicleReport) []stolen_reports.Report {
+	result := make([]stolen_reports.Report, len(reports))
+	for i, report := range reports {
+		result[i] = toStolenVehicleReportDomain(report)
+	}
+	return result
+}
+
+func toStolenVehicleReportDomain(r db.StolenVehicleReport) stolen_reports.Report {
+	return stolen_reports.Report{
+		ID:                 r.ID,
+		VehicleID:          r.VehicleID,
+		Kind:               r.Kind,
+		PoliceReference:    r.PoliceReference,
+		PoliceAuthority:    r.PoliceAuthority,
+		IncidentDate:       dateToTimePtr(r.IncidentDate),
+		Location:           r.Location,
+		Description:        r.Description,
+		ReportedBy:         r.ReportedBy,
+		Status:             r.Status,
+		DecidedBy:          r.DecidedBy,
+		DeclineReason:      r.DeclineReason,
+		LifecycleRequestID: r.LifecycleRequestID,
+		ResolvedBy:         r.ResolvedBy,
+		ResolutionNote:     r.ResolutionNote,
+		CreatedAt:          r.CreatedAt,
+		DecidedAt:          timestamptzToTimePtr(r.DecidedAt),
+		ResolvedAt:         timestamptzToTimePtr(r.ResolvedAt),
+	}
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+)
+
+// querier returns queries bound to the transaction carried by ctx, or the default
+// queries when the call is not part of a transaction
+func querier(ctx context.Context, queries db.Querier) db.Querier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return db.New(tx)
+	}
+	return queries
+}
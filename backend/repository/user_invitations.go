@@ -19,7 +19,7 @@ func NewUserInvitationRepository(queries db.Querier) *UserInvitationRepository {
 }
 
 func (r *UserInvitationRepository) CreateUserInvitation(ctx context.Context, params user_invitation.CreateUserInvitationParams) (*user_invitation.UserInvitation, error) {
-	inv, err := r.queries.CreateUserInvitation(ctx, db.CreateUserInvitationParams{
+	inv, err := querier(ctx, r.queries).CreateUserInvitation(ctx, db.CreateUserInvitationParams{
 		Email:          params.Email,
 		Name:           params.Name,
 		Token:          params.Token,
@@ -35,7 +35,7 @@ func (r *UserInvitationRepository) CreateUserInvitation(ctx context.Context, par
 }
 
 func (r *UserInvitationRepository) GetPendingUserInvitationsByEmail(ctx context.Context, email string) ([]user_invitation.UserInvitation, error) {
-	invs, err := r.queries.GetPendingUserInvitationsByEmail(ctx, email)
+	invs, err := querier(ctx, r.queries).GetPendingUserInvitationsByEmail(ctx, email)
 	if err != nil {
 		return nil, postgres.WrapError(err, "get pending user invitations by email")
 	}
@@ -48,7 +48,7 @@ func (r *UserInvitationRepository) GetPendingUserInvitationsByEmail(ctx context.
 }
 
 func (r *UserInvitationRepository) GetUserInvitationByToken(ctx context.Context, token string) (*user_invitation.UserInvitation, error) {
-	inv, err := r.queries.GetUserInvitationByToken(ctx, token)
+	inv, err := querier(ctx, r.queries).GetUserInvitationByToken(ctx, token)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, user_invitation.ErrInvitationNotFound
@@ -59,7 +59,7 @@ func (r *UserInvitationRepository) GetUserInvitationByToken(ctx context.Context,
 }
 
 func (r *UserInvitationRepository) ClaimUserInvitation(ctx context.Context, token string) error {
-	err := r.queries.ClaimUserInvitation(ctx, token)
+	err := querier(ctx, r.queries).ClaimUserInvitation(ctx, token)
 	if err != nil {
 		return postgres.WrapError(err, "claim user invitation")
 	}
@@ -67,7 +67,7 @@ func (r *UserInvitationRepository) ClaimUserInvitation(ctx context.Context, toke
 }
 
 func (r *UserInvitationRepository) GetUserInvitationByID(ctx context.Context, id uuid.UUID) (*user_invitation.UserInvitation, error) {
-	inv, err := r.queries.GetUserInvitationByID(ctx, id)
+	inv, err := querier(ctx, r.queries).GetUserInvitationByID(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, user_invitation.ErrInvitationNotFound
@@ -78,7 +78,7 @@ func (r *UserInvitationRepository) GetUserInvitationByID(ctx context.Context, id
 }
 
 func (r *UserInvitationRepository) DeleteUserInvitation(ctx context.Context, id uuid.UUID) error {
-	err := r.queries.DeleteUserInvitation(ctx, id)
+	err := querier(ctx, r.queries).DeleteUserInvitation(ctx, id)
 	if err != nil {
 		return postgres.WrapError(err, "delete user invitation")
 	}
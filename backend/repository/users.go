@@ -19,7 +19,7 @@ func NewUserRepository(queries db.Querier) *UserRepository {
 }
 
 func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
-	u, err := r.queries.GetUserByID(ctx, id)
+	u, err := querier(ctx, r.queries).GetUserByID(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, user.ErrUserNotFound
@@ -32,7 +32,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*user.User,
 }
 
 func (r *UserRepository) Create(ctx context.Context, usr *user.User) error {
-	created, err := r.queries.CreateUser(ctx, db.CreateUserParams{
+	created, err := querier(ctx, r.queries).CreateUser(ctx, db.CreateUserParams{
 		ID:      usr.ID,
 		IsAdmin: usr.IsAdmin,
 	})
@@ -45,7 +45,7 @@ func (r *UserRepository) Create(ctx context.Context, usr *user.User) error {
 }
 
 func (r *UserRepository) GetUserEntityMemberships(ctx context.Context, userID uuid.UUID) ([]user.EntityMembership, error) {
-	memberships, err := r.queries.GetUserEntityMemberships(ctx, userID)
+	memberships, err := querier(ctx, r.queries).GetUserEntityMemberships(ctx, userID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "get user entity memberships")
 	}
@@ -59,7 +59,7 @@ func (r *UserRepository) GetUserEntityMemberships(ctx context.Context, userID uu
 }
 
 func (r *UserRepository) GetEntityMembers(ctx context.Context, entityID uuid.UUID) ([]user.EntityMembership, error) {
-	members, err := r.queries.GetEntityMembers(ctx, entityID)
+	members, err := querier(ctx, r.queries).GetEntityMembers(ctx, entityID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "get entity members")
 	}
@@ -77,7 +77,7 @@ func (r *UserRepository) GetEntityMembers(ctx context.Context, entityID uuid.UUI
 }
 
 func (r *UserRepository) AddUserToEntity(ctx context.Context, userID, entityID uuid.UUID, role string) error {
-	_, err := r.queries.AddUserToEntity(ctx, db.AddUserToEntityParams{
+	_, err := querier(ctx, r.queries).AddUserToEntity(ctx, db.AddUserToEntityParams{
 		UserID:   userID,
 		EntityID: entityID,
 		Role:     role,
@@ -86,14 +86,14 @@ func (r *UserRepository) AddUserToEntity(ctx context.Context, userID, entityID u
 }
 
 func (r *UserRepository) RemoveUserFromEntity(ctx context.Context, userID, entityID uuid.UUID) error {
-	return postgres.WrapError(r.queries.RemoveUserFromEntity(ctx, db.RemoveUserFromEntityParams{
+	return postgres.WrapError(querier(ctx, r.queries).RemoveUserFromEntity(ctx, db.RemoveUserFromEntityParams{
 		UserID:   userID,
 		EntityID: entityID,
 	}), "remove user from entity")
 }
 
 func (r *UserRepository) UpdateUserEntityRole(ctx context.Context, userID, entityID uuid.UUID, role string) error {
-	_, err := r.queries.UpdateUserEntityRole(ctx, db.UpdateUserEntityRoleParams{
+	_, err := querier(ctx, r.queries).UpdateUserEntityRole(ctx, db.UpdateUserEntityRoleParams{
 		UserID:   userID,
 		EntityID: entityID,
 		Role:     role,
@@ -102,7 +102,7 @@ func (r *UserRepository) UpdateUserEntityRole(ctx context.Context, userID, entit
 }
 
 func (r *UserRepository) GetUserEntityRole(ctx context.Context, userID, entityID uuid.UUID) (string, error) {
-	role, err := r.queries.GetUserEntityRole(ctx, db.GetUserEntityRoleParams{
+	role, err := querier(ctx, r.queries).GetUserEntityRole(ctx, db.GetUserEntityRoleParams{
 		UserID:   userID,
 		EntityID: entityID,
 	})
@@ -113,7 +113,7 @@ func (r *UserRepository) GetUserEntityRole(ctx context.Context, userID, entityID
 }
 
 func (r *UserRepository) CheckUserEntityMembership(ctx context.Context, userID, entityID uuid.UUID) (bool, error) {
-	isMember, err := r.queries.CheckUserEntityMembership(ctx, db.CheckUserEntityMembershipParams{
+	isMember, err := querier(ctx, r.queries).CheckUserEntityMembership(ctx, db.CheckUserEntityMembershipParams{
 		UserID:   userID,
 		EntityID: entityID,
 	})
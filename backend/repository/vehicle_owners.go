@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (r *VehicleRepository) CreateOwner(ctx context.Context, owner vehicles.Owner) (*vehicles.Owner, error) {
+	created, err := querier(ctx, r.queries).CreateVehicleOwner(ctx, db.CreateVehicleOwnerParams{
+		VehicleID: owner.VehicleID,
+		OwnerID:   owner.OwnerID,
+		StartDate: owner.StartDate,
+		Source:    owner.Source,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create vehicle owner")
+	}
+
+	result := toVehicleOwnerDomain(created)
+	return &result, nil
+}
+
+func (r *VehicleRepository) EndOwnership(ctx context.Context, vehicleID uuid.UUID, endDate time.Time) error {
+	err := querier(ctx, r.queries).EndVehicleOwnership(ctx, db.EndVehicleOwnershipParams{
+		VehicleID: vehicleID,
+		EndDate:   pgtype.Timestamptz{Time: endDate, Valid: true},
+	})
+	if err != nil {
+		return postgres.WrapError(err, "end vehicle ownership")
+	}
+	return nil
+}
+
+func (r *VehicleRepository) ListOwners(ctx context.Context, vehicleID uuid.UUID) ([]vehicles.Owner, error) {
+	owners, err := querier(ctx, r.queries).ListVehicleOwners(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list vehicle owners")
+	}
+
+	result := make([]vehicles.Owner, len(owners))
+	for i, o := range owners {
+		result[i] = toVehicleOwnerDomain(o)
+	}
+
+	return result, nil
+}
+
+func toVehicleOwnerDomain(o db.VehicleOwner) vehicles.Owner {
+	return vehicles.Owner{
+		ID:        o.ID,
+		VehicleID: o.VehicleID,
+		OwnerID:   o.OwnerID,
+		Source:    o.Source,
+		StartDate: o.StartDate,
+		EndDate:   timestamptzToTimePtr(o.EndDate),
+	}
+}
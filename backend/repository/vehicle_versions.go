@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ClassicCarsRestore/ClassicsChain/internal/vehicles"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres"
+	"github.com/ClassicCarsRestore/ClassicsChain/pkg/postgres/db"
+	"github.com/google/uuid"
+)
+
+func (r *VehicleRepository) CreateVersion(ctx context.Context, version vehicles.Version) (*vehicles.Version, error) {
+	created, err := querier(ctx, r.queries).CreateVehicleVersion(ctx, db.CreateVehicleVersionParams{
+		VehicleID:        version.VehicleID,
+		Cid:              version.CID,
+		PreviousCid:      version.PreviousCID,
+		CidSourceJson:    version.CIDSourceJSON,
+		CidSourceCborB64: version.CIDSourceCBOR,
+		BlockchainStatus: version.BlockchainStatus,
+	})
+	if err != nil {
+		return nil, postgres.WrapError(err, "create vehicle version")
+	}
+
+	result := toVehicleVersionDomain(created)
+	return &result, nil
+}
+
+func (r *VehicleRepository) GetVersion(ctx context.Context, id uuid.UUID) (*vehicles.Version, error) {
+	v, err := querier(ctx, r.queries).GetVehicleVersion(ctx, id)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, vehicles.ErrVersionNotFound
+		}
+		return nil, postgres.WrapError(err, "get vehicle version")
+	}
+
+	result := toVehicleVersionDomain(v)
+	return &result, nil
+}
+
+func (r *VehicleRepository) ListVersions(ctx context.Context, vehicleID uuid.UUID) ([]vehicles.Version, error) {
+	versions, err := querier(ctx, r.queries).ListVehicleVersions(ctx, vehicleID)
+	if err != nil {
+		return nil, postgres.WrapError(err, "list vehicle versions")
+	}
+
+	result := make([]vehicles.Version, len(versions))
+	for i, v := range versions {
+		result[i] = toVehicleVersionDomain(v)
+	}
+
+	return result, nil
+}
+
+func (r *VehicleRepository) UpdateVersion(ctx context.Context, version *vehicles.Version) error {
+	updated, err := querier(ctx, r.queries).UpdateVehicleVersion(ctx, db.UpdateVehicleVersionParams{
+		ID:                 version.ID,
+		BlockchainTxID:     version.BlockchainTxID,
+		BlockchainStatus:   version.BlockchainStatus,
+		BlockchainError:    version.BlockchainError,
+		BlockchainStatusAt: version.BlockchainStatusAt,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "update vehicle version")
+	}
+
+	*version = toVehicleVersionDomain(updated)
+	return nil
+}
+
+func (r *VehicleRepository) ListVersionsByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]vehicles.Version, int, error) {
+	versions, err := querier(ctx, r.queries).ListVehicleVersionsByBlockchainStatus(ctx, db.ListVehicleVersionsByBlockchainStatusParams{
+		BlockchainStatus: status,
+		OlderThanSeconds: olderThan.Seconds(),
+		Limit:            int32(limit),
+		Offset:           int32(offset),
+	})
+	if err != nil {
+		return nil, 0, postgres.WrapError(err, "list vehicle versions by blockchain status")
+	}
+
+	total, err := querier(ctx, r.queries).CountVehicleVersionsByBlockchainStatus(ctx, db.CountVehicleVersionsByBlockchainStatusParams{
+		BlockchainStatus: status,
+		OlderThanSeconds: olderThan.Seconds(),
+	})
+	if err != nil {
+		return nil, 0, postgres.WrapError(err, "count vehicle versions by blockchain status")
+	}
+
+	result := make([]vehicles.Version, len(versions))
+	for i, v := range versions {
+		result[i] = toVehicleVersionDomain(v)
+	}
+
+	return result, int(total), nil
+}
+
+func toVehicleVersionDomain(v db.VehicleVersion) vehicles.Version {
+	return vehicles.Version{
+		ID:                 v.ID,
+		VehicleID:          v.VehicleID,
+		Version:            int(v.Version),
+		CID:                v.Cid,
+		PreviousCID:        v.PreviousCid,
+		CIDSourceJSON:      v.CidSourceJson,
+		CIDSourceCBOR:      v.CidSourceCborB64,
+		BlockchainTxID:     v.BlockchainTxID,
+		BlockchainStatus:   v.BlockchainStatus,
+		BlockchainError:    v.BlockchainError,
+		BlockchainStatusAt: v.BlockchainStatusAt,
+		CreatedAt:          v.CreatedAt,
+	}
+}
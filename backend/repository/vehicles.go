@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -25,7 +26,7 @@ func (r *VehicleRepository) GetAll(ctx context.Context, limit, offset int, owner
 	var total int64
 
 	if ownerID != nil {
-		vhcls, err = r.queries.ListVehiclesByOwner(ctx, db.ListVehiclesByOwnerParams{
+		vhcls, err = querier(ctx, r.queries).ListVehiclesByOwner(ctx, db.ListVehiclesByOwnerParams{
 			OwnerID: ownerID,
 			Limit:   int32(limit),
 			Offset:  int32(offset),
@@ -33,19 +34,19 @@ func (r *VehicleRepository) GetAll(ctx context.Context, limit, offset int, owner
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "list vehicles by owner")
 		}
-		total, err = r.queries.CountVehiclesByOwner(ctx, ownerID)
+		total, err = querier(ctx, r.queries).CountVehiclesByOwner(ctx, ownerID)
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "count vehicles by owner")
 		}
 	} else {
-		vhcls, err = r.queries.ListVehicles(ctx, db.ListVehiclesParams{
+		vhcls, err = querier(ctx, r.queries).ListVehicles(ctx, db.ListVehiclesParams{
 			Limit:  int32(limit),
 			Offset: int32(offset),
 		})
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "list vehicles")
 		}
-		total, err = r.queries.CountVehicles(ctx)
+		total, err = querier(ctx, r.queries).CountVehicles(ctx)
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "count vehicles")
 		}
@@ -64,7 +65,7 @@ func (r *VehicleRepository) GetAllWithStats(ctx context.Context, limit, offset i
 	var err error
 
 	if ownerID != nil {
-		vhcls, err := r.queries.ListVehiclesByOwnerWithStats(ctx, db.ListVehiclesByOwnerWithStatsParams{
+		vhcls, err := querier(ctx, r.queries).ListVehiclesByOwnerWithStats(ctx, db.ListVehiclesByOwnerWithStatsParams{
 			OwnerID: ownerID,
 			Limit:   int32(limit),
 			Offset:  int32(offset),
@@ -72,7 +73,7 @@ func (r *VehicleRepository) GetAllWithStats(ctx context.Context, limit, offset i
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "list vehicles by owner with stats")
 		}
-		total, err = r.queries.CountVehiclesByOwner(ctx, ownerID)
+		total, err = querier(ctx, r.queries).CountVehiclesByOwner(ctx, ownerID)
 		if err != nil {
 			return nil, 0, postgres.WrapError(err, "count vehicles by owner")
 		}
@@ -84,14 +85,14 @@ func (r *VehicleRepository) GetAllWithStats(ctx context.Context, limit, offset i
 		return result, int(total), nil
 	}
 
-	vhcls, err := r.queries.ListVehiclesWithStats(ctx, db.ListVehiclesWithStatsParams{
+	vhcls, err := querier(ctx, r.queries).ListVehiclesWithStats(ctx, db.ListVehiclesWithStatsParams{
 		Limit:  int32(limit),
 		Offset: int32(offset),
 	})
 	if err != nil {
 		return nil, 0, postgres.WrapError(err, "list vehicles with stats")
 	}
-	total, err = r.queries.CountVehicles(ctx)
+	total, err = querier(ctx, r.queries).CountVehicles(ctx)
 	if err != nil {
 		return nil, 0, postgres.WrapError(err, "count vehicles")
 	}
@@ -105,7 +106,7 @@ func (r *VehicleRepository) GetAllWithStats(ctx context.Context, limit, offset i
 }
 
 func (r *VehicleRepository) GetByID(ctx context.Context, id uuid.UUID) (*vehicles.Vehicle, error) {
-	v, err := r.queries.GetVehicle(ctx, id)
+	v, err := querier(ctx, r.queries).GetVehicle(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, vehicles.ErrVehicleNotFound
@@ -118,7 +119,7 @@ func (r *VehicleRepository) GetByID(ctx context.Context, id uuid.UUID) (*vehicle
 }
 
 func (r *VehicleRepository) GetByOwnerID(ctx context.Context, ownerID uuid.UUID, limit, offset int) ([]vehicles.Vehicle, int, error) {
-	vhcls, err := r.queries.ListVehiclesByOwner(ctx, db.ListVehiclesByOwnerParams{
+	vhcls, err := querier(ctx, r.queries).ListVehiclesByOwner(ctx, db.ListVehiclesByOwnerParams{
 		OwnerID: &ownerID,
 		Limit:   int32(limit),
 		Offset:  int32(offset),
@@ -127,7 +128,7 @@ func (r *VehicleRepository) GetByOwnerID(ctx context.Context, ownerID uuid.UUID,
 		return nil, 0, postgres.WrapError(err, "list vehicles by owner")
 	}
 
-	total, err := r.queries.CountVehiclesByOwner(ctx, &ownerID)
+	total, err := querier(ctx, r.queries).CountVehiclesByOwner(ctx, &ownerID)
 	if err != nil {
 		return nil, 0, postgres.WrapError(err, "count vehicles by owner")
 	}
@@ -141,7 +142,7 @@ func (r *VehicleRepository) GetByOwnerID(ctx context.Context, ownerID uuid.UUID,
 }
 
 func (r *VehicleRepository) Create(ctx context.Context, vehicle *vehicles.Vehicle) (*vehicles.Vehicle, error) {
-	created, err := r.queries.CreateVehicle(ctx, db.CreateVehicleParams{
+	created, err := querier(ctx, r.queries).CreateVehicle(ctx, db.CreateVehicleParams{
 		LicensePlate:       stringToNullable(vehicle.LicensePlate),
 		ChassisNumber:      stringToNullable(vehicle.ChassisNumber),
 		Make:               vehicle.Make,
@@ -172,7 +173,7 @@ func (r *VehicleRepository) Create(ctx context.Context, vehicle *vehicles.Vehicl
 }
 
 func (r *VehicleRepository) Update(ctx context.Context, vehicle *vehicles.Vehicle) error {
-	updated, err := r.queries.UpdateVehicle(ctx, db.UpdateVehicleParams{
+	updated, err := querier(ctx, r.queries).UpdateVehicle(ctx, db.UpdateVehicleParams{
 		ID:                 vehicle.ID,
 		LicensePlate:       stringToNullable(vehicle.LicensePlate),
 		ChassisNumber:      stringToNullable(vehicle.ChassisNumber),
@@ -196,6 +197,8 @@ func (r *VehicleRepository) Update(ctx context.Context, vehicle *vehicles.Vehicl
 		CidSourceJson:      vehicle.CIDSourceJSON,
 		CidSourceCborB64:   vehicle.CIDSourceCBOR,
 		BlockchainStatus:   vehicle.BlockchainStatus,
+		BlockchainError:    vehicle.BlockchainError,
+		BlockchainStatusAt: vehicle.BlockchainStatusAt,
 	})
 	if err != nil {
 		return postgres.WrapError(err, "update vehicle")
@@ -205,12 +208,103 @@ func (r *VehicleRepository) Update(ctx context.Context, vehicle *vehicles.Vehicl
 	return nil
 }
 
+// ClaimGenesis records a signed genesis transaction and the CID it anchors before the transaction
+// is submitted. The claim only succeeds if the vehicle has no asset yet and its recorded genesis
+// transaction is still previousTxID, so concurrent workers cannot both mint an asset.
+func (r *VehicleRepository) ClaimGenesis(ctx context.Context, vehicleID uuid.UUID, previousTxID *string, txID string, lastValid uint64, cid, cidSourceJSON, cidSourceCBOR string) (bool, error) {
+	lastValidRound := int64(lastValid)
+	rows, err := querier(ctx, r.queries).ClaimVehicleGenesis(ctx, db.ClaimVehicleGenesisParams{
+		ID:                    vehicleID,
+		PreviousGenesisTxID:   previousTxID,
+		GenesisTxID:           &txID,
+		GenesisLastValidRound: &lastValidRound,
+		Cid:                   &cid,
+		CidSourceJson:         &cidSourceJSON,
+		CidSourceCborB64:      &cidSourceCBOR,
+	})
+	if err != nil {
+		return false, postgres.WrapError(err, "claim vehicle genesis")
+	}
+
+	return rows == 1, nil
+}
+
+// LockChainHead locks the vehicle for the rest of the transaction and returns the CID of the
+// latest record in its chain, falling back to the genesis CID
+func (r *VehicleRepository) LockChainHead(ctx context.Context, vehicleID uuid.UUID) (*string, error) {
+	row, err := querier(ctx, r.queries).LockVehicleChainHead(ctx, vehicleID)
+	if err != nil {
+		if postgres.IsNotFoundError(err) {
+			return nil, vehicles.ErrVehicleNotFound
+		}
+		return nil, postgres.WrapError(err, "lock vehicle chain head")
+	}
+
+	if row.ChainHeadCid != nil {
+		return row.ChainHeadCid, nil
+	}
+	return row.Cid, nil
+}
+
+// SetChainHead records cid as the latest record in the vehicle's chain
+func (r *VehicleRepository) SetChainHead(ctx context.Context, vehicleID uuid.UUID, cid string) error {
+	err := querier(ctx, r.queries).SetVehicleChainHead(ctx, db.SetVehicleChainHeadParams{
+		ID:           vehicleID,
+		ChainHeadCid: &cid,
+	})
+	return postgres.WrapError(err, "set vehicle chain head")
+}
+
+func (r *VehicleRepository) ListByBlockchainStatus(ctx context.Context, status string, olderThan time.Duration, limit, offset int) ([]vehicles.Vehicle, int, error) {
+	vhcls, err := querier(ctx, r.queries).ListVehiclesByBlockchainStatus(ctx, db.ListVehiclesByBlockchainStatusParams{
+		BlockchainStatus: status,
+		OlderThanSeconds: olderThan.Seconds(),
+		Limit:            int32(limit),
+		Offset:           int32(offset),
+	})
+	if err != nil {
+		return nil, 0, postgres.WrapError(err, "list vehicles by blockchain status")
+	}
+
+	total, err := querier(ctx, r.queries).CountVehiclesByBlockchainStatus(ctx, db.CountVehiclesByBlockchainStatusParams{
+		BlockchainStatus: status,
+		OlderThanSeconds: olderThan.Seconds(),
+	})
+	if err != nil {
+		return nil, 0, postgres.WrapError(err, "count vehicles by blockchain status")
+	}
+
+	result := make([]vehicles.Vehicle, len(vhcls))
+	for i, v := range vhcls {
+		result[i] = toVehicleDomain(v)
+	}
+
+	return result, int(total), nil
+}
+
+// SetLifecycleStatus moves the vehicle from one lifecycle status to another. It returns
+// ErrLifecycleStatusChanged when the vehicle is no longer in the from status.
+func (r *VehicleRepository) SetLifecycleStatus(ctx context.Context, id uuid.UUID, from, to string) error {
+	rows, err := querier(ctx, r.queries).SetVehicleLifecycleStatus(ctx, db.SetVehicleLifecycleStatusParams{
+		ID:         id,
+		FromStatus: from,
+		ToStatus:   to,
+	})
+	if err != nil {
+		return postgres.WrapError(err, "set vehicle lifecycle status")
+	}
+	if rows == 0 {
+		return vehicles.ErrLifecycleStatusChanged
+	}
+	return nil
+}
+
 func (r *VehicleRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return postgres.WrapError(r.queries.DeleteVehicle(ctx, id), "delete vehicle")
+	return postgres.WrapError(querier(ctx, r.queries).DeleteVehicle(ctx, id), "delete vehicle")
 }
 
 func (r *VehicleRepository) GetByChassisNumber(ctx context.Context, chassisNumber string) (*vehicles.Vehicle, error) {
-	v, err := r.queries.GetVehicleByChassisNumber(ctx, stringToNullable(&chassisNumber))
+	v, err := querier(ctx, r.queries).GetVehicleByChassisNumber(ctx, stringToNullable(&chassisNumber))
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, vehicles.ErrVehicleNotFound
@@ -223,7 +317,7 @@ func (r *VehicleRepository) GetByChassisNumber(ctx context.Context, chassisNumbe
 }
 
 func (r *VehicleRepository) GetByLicensePlate(ctx context.Context, licensePlate string) (*vehicles.Vehicle, error) {
-	v, err := r.queries.GetVehicleByLicensePlate(ctx, stringToNullable(&licensePlate))
+	v, err := querier(ctx, r.queries).GetVehicleByLicensePlate(ctx, stringToNullable(&licensePlate))
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, vehicles.ErrVehicleNotFound
@@ -257,9 +351,16 @@ func toVehicleDomain(v db.Vehicle) vehicles.Vehicle {
 		OwnerID:            v.OwnerID,
 		BlockchainAssetID:  nullableToStringPtr(v.BlockchainAssetID),
 		BlockchainStatus:   v.BlockchainStatus,
+		BlockchainError:    v.BlockchainError,
+		BlockchainStatusAt: v.BlockchainStatusAt,
+		GenesisTxID:        v.GenesisTxID,
+		GenesisLastValid:   int64ToUint64Ptr(v.GenesisLastValidRound),
+		ChainHeadCID:       v.ChainHeadCid,
 		CID:                v.Cid,
 		CIDSourceJSON:      v.CidSourceJson,
 		CIDSourceCBOR:      v.CidSourceCborB64,
+		LifecycleStatus:    v.LifecycleStatus,
+		LifecycleStatusAt:  v.LifecycleStatusAt,
 		CreatedAt:          v.CreatedAt.Time,
 		UpdatedAt:          v.UpdatedAt.Time,
 	}
@@ -288,9 +389,16 @@ func toVehicleWithStatsDomainFromRow(v db.ListVehiclesWithStatsRow) vehicles.Veh
 			OwnerID:            v.OwnerID,
 			BlockchainAssetID:  nullableToStringPtr(v.BlockchainAssetID),
 			BlockchainStatus:   v.BlockchainStatus,
+			BlockchainError:    v.BlockchainError,
+			BlockchainStatusAt: v.BlockchainStatusAt,
+			GenesisTxID:        v.GenesisTxID,
+			GenesisLastValid:   int64ToUint64Ptr(v.GenesisLastValidRound),
+			ChainHeadCID:       v.ChainHeadCid,
 			CID:                v.Cid,
 			CIDSourceJSON:      v.CidSourceJson,
 			CIDSourceCBOR:      v.CidSourceCborB64,
+			LifecycleStatus:    v.LifecycleStatus,
+			LifecycleStatusAt:  v.LifecycleStatusAt,
 			CreatedAt:          v.CreatedAt.Time,
 			UpdatedAt:          v.UpdatedAt.Time,
 		},
@@ -323,9 +431,16 @@ func toVehicleWithStatsDomain(v db.ListVehiclesByOwnerWithStatsRow) vehicles.Veh
 			OwnerID:            v.OwnerID,
 			BlockchainAssetID:  nullableToStringPtr(v.BlockchainAssetID),
 			BlockchainStatus:   v.BlockchainStatus,
+			BlockchainError:    v.BlockchainError,
+			BlockchainStatusAt: v.BlockchainStatusAt,
+			GenesisTxID:        v.GenesisTxID,
+			GenesisLastValid:   int64ToUint64Ptr(v.GenesisLastValidRound),
+			ChainHeadCID:       v.ChainHeadCid,
 			CID:                v.Cid,
 			CIDSourceJSON:      v.CidSourceJson,
 			CIDSourceCBOR:      v.CidSourceCborB64,
+			LifecycleStatus:    v.LifecycleStatus,
+			LifecycleStatusAt:  v.LifecycleStatusAt,
 			CreatedAt:          v.CreatedAt.Time,
 			UpdatedAt:          v.UpdatedAt.Time,
 		},
@@ -37,7 +37,7 @@ func (r *ShareLinkRepository) Create(ctx context.Context, params share_links.Cre
 		return nil, err
 	}
 
-	dbShareLink, err := r.queries.CreateShareLink(ctx, db.CreateShareLinkParams{
+	dbShareLink, err := querier(ctx, r.queries).CreateShareLink(ctx, db.CreateShareLinkParams{
 		VehicleID:        params.VehicleID,
 		Token:            token,
 		CanViewDetails:   params.CanViewDetails,
@@ -55,7 +55,7 @@ func (r *ShareLinkRepository) Create(ctx context.Context, params share_links.Cre
 }
 
 func (r *ShareLinkRepository) GetByToken(ctx context.Context, token string) (*share_links.ShareLink, error) {
-	dbShareLink, err := r.queries.GetShareLinkByToken(ctx, token)
+	dbShareLink, err := querier(ctx, r.queries).GetShareLinkByToken(ctx, token)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, share_links.ErrShareLinkNotFound
@@ -67,7 +67,7 @@ func (r *ShareLinkRepository) GetByToken(ctx context.Context, token string) (*sh
 }
 
 func (r *ShareLinkRepository) GetByID(ctx context.Context, id uuid.UUID) (*share_links.ShareLink, error) {
-	dbShareLink, err := r.queries.GetShareLinkByID(ctx, id)
+	dbShareLink, err := querier(ctx, r.queries).GetShareLinkByID(ctx, id)
 	if err != nil {
 		if postgres.IsNotFoundError(err) {
 			return nil, share_links.ErrShareLinkNotFound
@@ -79,7 +79,7 @@ func (r *ShareLinkRepository) GetByID(ctx context.Context, id uuid.UUID) (*share
 }
 
 func (r *ShareLinkRepository) ListByVehicle(ctx context.Context, vehicleID uuid.UUID) ([]share_links.ShareLink, error) {
-	dbShareLinks, err := r.queries.ListShareLinksByVehicle(ctx, vehicleID)
+	dbShareLinks, err := querier(ctx, r.queries).ListShareLinksByVehicle(ctx, vehicleID)
 	if err != nil {
 		return nil, postgres.WrapError(err, "list share links by vehicle")
 	}
@@ -93,12 +93,12 @@ func (r *ShareLinkRepository) ListByVehicle(ctx context.Context, vehicleID uuid.
 }
 
 func (r *ShareLinkRepository) IncrementAccessCount(ctx context.Context, id uuid.UUID) error {
-	_, err := r.queries.IncrementShareLinkAccessCount(ctx, id)
+	_, err := querier(ctx, r.queries).IncrementShareLinkAccessCount(ctx, id)
 	return postgres.WrapError(err, "increment share link access count")
 }
 
 func (r *ShareLinkRepository) Revoke(ctx context.Context, id uuid.UUID) (*share_links.ShareLink, error) {
-	dbShareLink, err := r.queries.RevokeShareLink(ctx, id)
+	dbShareLink, err := querier(ctx, r.queries).RevokeShareLink(ctx, id)
 	if err != nil {
 		return nil, postgres.WrapError(err, "revoke share link")
 	}
@@ -36,7 +36,6 @@ export function VehicleEventCreateModal({
     'modification',
     'race_participation',
     'show_participation',
-    'ownership_transfer',
   ];
 
   const getEventTypeLabel = (type: EventType): string => {
@@ -1,8 +1,9 @@
 import { useParams, useNavigate } from 'react-router-dom';
 import { useTranslation } from 'react-i18next';
-import { ArrowLeft, AlertCircle, Loader2 } from 'lucide-react';
+import { ArrowLeft, AlertCircle, AlertTriangle, Loader2 } from 'lucide-react';
 import { useVehiclePassport } from '@/features/vehicles/hooks/useVehiclePassport';
 import { VehiclePassport } from '@/components/vehicle/VehiclePassport';
+import type { PassportWarning } from '@/types/shareLink';
 
 export function PassportPage() {
   const { vehicleId } = useParams<{ vehicleId: string }>();
@@ -53,12 +54,13 @@ function PassportView({ vehicleId }: { vehicleId: string }) {
     );
   }
 
-  const { vehicle, photos, history } = data;
+  const { vehicle, photos, history, warning } = data;
 
   return (
     <div className="min-h-screen bg-background">
       <div className="container mx-auto max-w-3xl px-4 py-8">
         <BackButton />
+        {warning && <WarningBanner warning={warning} />}
         <VehiclePassport
           vehicle={vehicle}
           photos={photos}
@@ -72,6 +74,30 @@ function PassportView({ vehicleId }: { vehicleId: string }) {
   );
 }
 
+function WarningBanner({ warning }: { warning: PassportWarning }) {
+  const { t, i18n } = useTranslation('vehicle');
+  const date = new Date(warning.since).toLocaleDateString(i18n.language);
+
+  return (
+    <div
+      role="alert"
+      className="mb-6 rounded-lg border-2 border-red-600 bg-red-600/15 p-6"
+    >
+      <div className="flex items-start gap-4">
+        <AlertTriangle className="h-8 w-8 flex-shrink-0 text-red-600" />
+        <div>
+          <h2 className="text-lg font-bold uppercase tracking-wide text-red-600 mb-1">
+            {t(`passport.warning.${warning.code}`)}
+          </h2>
+          <p className="text-sm text-foreground">
+            {t(`passport.warning.${warning.code}Description`, { date })}
+          </p>
+        </div>
+      </div>
+    </div>
+  );
+}
+
 function BackButton() {
   const { t } = useTranslation('vehicle');
   const navigate = useNavigate();
@@ -57,9 +57,16 @@ export interface SharedDocument {
   createdAt: string;
 }
 
+export interface PassportWarning {
+  code: 'stolen' | 'scrapped';
+  message: string;
+  since: string;
+}
+
 export interface SharedVehicleData {
   vehicle: Vehicle;
   photos?: SharedPhoto[];
   documents?: SharedDocument[];
   history?: Event[];
+  warning?: PassportWarning;
 }
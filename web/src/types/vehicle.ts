@@ -1,3 +1,10 @@
+export type VehicleLifecycleStatus =
+  | 'active'
+  | 'stolen'
+  | 'scrapped'
+  | 'exported'
+  | 'archived';
+
 export interface Vehicle {
   id: string;
   licensePlate?: string;
@@ -18,6 +25,8 @@ export interface Vehicle {
   enginePowerHp?: number;
   ownerId: string;
   blockchainAssetId?: string;
+  lifecycleStatus?: VehicleLifecycleStatus;
+  lifecycleStatusAt?: string;
   cid?: string;
   cidSourceJson?: string;
   cidSourceCbor?: string;